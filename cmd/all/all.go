@@ -10,8 +10,11 @@ import (
 	_ "github.com/ubccr/grendel/cmd/bmc"
 	_ "github.com/ubccr/grendel/cmd/db"
 	_ "github.com/ubccr/grendel/cmd/discover"
+	_ "github.com/ubccr/grendel/cmd/events"
 	_ "github.com/ubccr/grendel/cmd/image"
 	_ "github.com/ubccr/grendel/cmd/node"
 	_ "github.com/ubccr/grendel/cmd/serve"
 	_ "github.com/ubccr/grendel/cmd/status"
+	_ "github.com/ubccr/grendel/cmd/topology"
+	_ "github.com/ubccr/grendel/cmd/validate"
 )
@@ -12,6 +12,7 @@ import (
 
 var (
 	tags   []string
+	async  bool
 	log    = logger.GetLogger("BMC")
 	bmcCmd = &cobra.Command{
 		Use:   "bmc",
@@ -24,3 +25,9 @@ func init() {
 
 	cmd.Root.AddCommand(bmcCmd)
 }
+
+// addAsyncFlag adds the --async flag to commands that can run as a background
+// task
+func addAsyncFlag(c *cobra.Command) {
+	c.Flags().BoolVar(&async, "async", false, "run as a background task and print its id, see bmc task wait")
+}
@@ -6,7 +6,6 @@ package bmc
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -24,7 +23,7 @@ var (
 		Short: "Set iDRAC to Auto configure",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(command *cobra.Command, args []string) error {
-			gc, err := cmd.NewOgenClient()
+			gc, progress, err := newJobClient()
 			if err != nil {
 				return err
 			}
@@ -36,16 +35,14 @@ var (
 			params := client.POSTV1BmcConfigureAutoParams{
 				Nodeset: client.NewOptString(nodeset),
 				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Async:   client.NewOptBool(async),
 			}
 			res, err := gc.POSTV1BmcConfigureAuto(context.Background(), params)
+			progress.done(res)
 			if err != nil {
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
-			}
-
 			return nil
 		},
 	}
@@ -58,7 +55,7 @@ var (
 		`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(command *cobra.Command, args []string) error {
-			gc, err := cmd.NewOgenClient()
+			gc, progress, err := newJobClient()
 			if err != nil {
 				return err
 			}
@@ -74,16 +71,14 @@ var (
 			params := client.POSTV1BmcConfigureImportParams{
 				Nodeset: client.NewOptString(nodeset),
 				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Async:   client.NewOptBool(async),
 			}
 			res, err := gc.POSTV1BmcConfigureImport(context.Background(), req, params)
+			progress.done(res)
 			if err != nil {
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
-			}
-
 			return nil
 		},
 	}
@@ -93,4 +88,6 @@ func init() {
 	bmcCmd.AddCommand(configureCmd)
 	configureCmd.AddCommand(configureAutoCmd)
 	configureCmd.AddCommand(configureImportCmd)
+	addAsyncFlag(configureAutoCmd)
+	addAsyncFlag(configureImportCmd)
 }
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	credentialTag bool
+	credentialCmd = &cobra.Command{
+		Use:   "credentials",
+		Short: "BMC credential commands",
+		Long: `BMC credential commands. Credentials are stored per node or per tag,
+encrypted with bmc.credential_key. A node uses its own credential, then the
+credential of its first tag that has one, then the global bmc.user and
+bmc.password`,
+	}
+
+	credentialListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List stored BMC credentials",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1BmcCredentials(context.Background(), client.GETV1BmcCredentialsParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"Scope", "Name", "Username", "Updated"})
+			for _, c := range res {
+				t.AppendRow(table.Row{
+					c.Scope.Value,
+					c.Name.Value,
+					c.Username.Value,
+					c.UpdatedAt.Value.Local().Format(time.DateTime),
+				})
+			}
+			t.SetStyle(table.StyleLight)
+			t.Render()
+
+			return nil
+		},
+	}
+
+	credentialUser     string
+	credentialPassword string
+	credentialSetCmd   = &cobra.Command{
+		Use:   "set <node | tag>",
+		Short: "Store the BMC credential of a node, or of all nodes with a tag",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.BmcCredentialRequest{
+				Scope:    client.NewOptString(credentialScope()),
+				Name:     client.NewOptString(args[0]),
+				Username: client.NewOptString(credentialUser),
+				Password: client.NewOptString(credentialPassword),
+			}
+			res, err := gc.POSTV1BmcCredentials(context.Background(), req, client.POSTV1BmcCredentialsParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+
+	credentialDeleteCmd = &cobra.Command{
+		Use:   "delete <node | tag>",
+		Short: "Delete the BMC credential of a node or tag",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.DELETEV1BmcCredentialsScopeNameParams{
+				Scope: credentialScope(),
+				Name:  args[0],
+			}
+			res, err := gc.DELETEV1BmcCredentialsScopeName(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+
+	rotateFactory       bool
+	rotateUser          string
+	rotatePassword      string
+	rotateLength        int
+	credentialRotateCmd = &cobra.Command{
+		Use:   "rotate {nodeset | all}",
+		Short: "Change the BMC password of nodes to new random passwords",
+		Long: `Change the BMC password of nodes to new random passwords. Each new
+password is stored as the credential of its node before it is set on the BMC.
+Use --factory to take over BMCs that still have the factory default password`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if rotateFactory && rotateUser != "" {
+				return fmt.Errorf("--factory can not be used with --user")
+			}
+
+			gc, progress, err := newJobClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.BmcCredentialRotateRequest{
+				Factory:         client.NewOptBool(rotateFactory),
+				CurrentUsername: client.NewOptString(rotateUser),
+				CurrentPassword: client.NewOptString(rotatePassword),
+				Length:          client.NewOptInt(rotateLength),
+			}
+			nodeset := args[0]
+			if nodeset == "all" {
+				nodeset = ""
+			}
+			params := client.POSTV1BmcCredentialsRotateParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Async:   client.NewOptBool(async),
+			}
+			res, err := gc.POSTV1BmcCredentialsRotate(context.Background(), req, params)
+			progress.done(res)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return nil
+		},
+	}
+)
+
+func credentialScope() string {
+	if credentialTag {
+		return "tag"
+	}
+
+	return "host"
+}
+
+func init() {
+	bmcCmd.AddCommand(credentialCmd)
+	credentialCmd.AddCommand(credentialListCmd)
+	credentialCmd.AddCommand(credentialSetCmd)
+	credentialCmd.AddCommand(credentialDeleteCmd)
+	credentialCmd.AddCommand(credentialRotateCmd)
+	addAsyncFlag(credentialRotateCmd)
+
+	for _, c := range []*cobra.Command{credentialSetCmd, credentialDeleteCmd} {
+		c.Flags().BoolVar(&credentialTag, "tag", false, "the argument is a tag instead of a node name")
+	}
+
+	credentialSetCmd.Flags().StringVarP(&credentialUser, "user", "u", "", "BMC user name")
+	credentialSetCmd.Flags().StringVarP(&credentialPassword, "password", "p", "", "BMC password")
+	credentialSetCmd.MarkFlagRequired("user")
+	credentialSetCmd.MarkFlagRequired("password")
+
+	credentialRotateCmd.Flags().BoolVar(&rotateFactory, "factory", false, "log in with the factory default credentials")
+	credentialRotateCmd.Flags().StringVarP(&rotateUser, "user", "u", "", "log in as this user instead of with the node's credential, its password is rotated")
+	credentialRotateCmd.Flags().StringVarP(&rotatePassword, "password", "p", "", "current password of --user")
+	credentialRotateCmd.Flags().IntVar(&rotateLength, "length", 16, "length of the new passwords")
+}
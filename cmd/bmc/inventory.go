@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	inventoryCmd = &cobra.Command{
+		Use:   "inventory {nodeset | all}",
+		Short: "Collect the hardware inventory of nodes",
+		Long: `Collect the DIMMs, CPUs, PCIe devices, NICs, drives and firmware of nodes
+from their BMCs now instead of waiting for bmc.inventory_interval. See
+grendel node inventory`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, progress, err := newJobClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset := args[0]
+			if nodeset == "all" {
+				nodeset = ""
+			}
+			params := client.POSTV1BmcInventoryParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Async:   client.NewOptBool(async),
+			}
+			res, err := gc.POSTV1BmcInventory(context.Background(), params)
+			progress.done(res)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	bmcCmd.AddCommand(inventoryCmd)
+	addAsyncFlag(inventoryCmd)
+}
@@ -98,7 +98,7 @@ var (
 				args = append(args, "JID_CLEARALL")
 			}
 			var err error
-			gc, err := cmd.NewOgenClient()
+			gc, progress, err := newJobClient()
 			if err != nil {
 				return err
 			}
@@ -110,17 +110,15 @@ var (
 			params := client.DELETEV1BmcJobsJidsParams{
 				Nodeset: client.NewOptString(nodeset),
 				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Async:   client.NewOptBool(async),
 				Jids:    strings.Join(args, ","),
 			}
 			res, err := gc.DELETEV1BmcJobsJids(context.Background(), params)
+			progress.done(res)
 			if err != nil {
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
-			}
-
 			return nil
 		},
 	}
@@ -130,4 +128,5 @@ func init() {
 	bmcCmd.AddCommand(jobCmd)
 	jobCmd.AddCommand(jobShowCmd)
 	jobCmd.AddCommand(jobClearCmd)
+	addAsyncFlag(jobClearCmd)
 }
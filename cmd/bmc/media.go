@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	mediaCmd = &cobra.Command{
+		Use:   "media",
+		Short: "BMC virtual media commands",
+		Long:  `BMC virtual media commands. Inserted media is ejected when the node completes provisioning`,
+	}
+
+	mediaBoot      bool
+	mediaInsertCmd = &cobra.Command{
+		Use:   "insert {nodeset | all} <image>",
+		Short: "Insert an ISO into the virtual CD/DVD drive of nodes",
+		Long: `Insert an ISO into the virtual CD/DVD drive of nodes through Redfish.
+The image is a file in the provision repo dir served by Grendel, or a URL`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, progress, err := newJobClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset := args[0]
+			if nodeset == "all" {
+				nodeset = ""
+			}
+			req := &client.BmcMediaInsertRequest{
+				Image: client.NewOptString(args[1]),
+				Boot:  client.NewOptBool(mediaBoot),
+			}
+			params := client.POSTV1BmcMediaParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Async:   client.NewOptBool(async),
+			}
+			res, err := gc.POSTV1BmcMedia(context.Background(), req, params)
+			progress.done(res)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return nil
+		},
+	}
+
+	mediaEjectCmd = &cobra.Command{
+		Use:   "eject {nodeset | all}",
+		Short: "Eject the virtual CD/DVD of nodes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, progress, err := newJobClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset := args[0]
+			if nodeset == "all" {
+				nodeset = ""
+			}
+			params := client.DELETEV1BmcMediaParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Async:   client.NewOptBool(async),
+			}
+			res, err := gc.DELETEV1BmcMedia(context.Background(), params)
+			progress.done(res)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	bmcCmd.AddCommand(mediaCmd)
+	mediaCmd.AddCommand(mediaInsertCmd)
+	mediaCmd.AddCommand(mediaEjectCmd)
+	addAsyncFlag(mediaInsertCmd)
+	addAsyncFlag(mediaEjectCmd)
+
+	mediaInsertCmd.Flags().BoolVar(&mediaBoot, "boot", false, "restart the nodes from the virtual media once inserted")
+}
@@ -6,7 +6,6 @@ package bmc
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -23,7 +22,7 @@ var (
 		Args:  cobra.ExactArgs(2),
 		RunE: func(command *cobra.Command, args []string) error {
 			var err error
-			gc, err := cmd.NewOgenClient()
+			gc, progress, err := newJobClient()
 			if err != nil {
 				return err
 			}
@@ -53,15 +52,14 @@ var (
 			params := client.POSTV1BmcPowerOsParams{
 				Nodeset: client.NewOptString(nodeset),
 				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Async:   client.NewOptBool(async),
 			}
 			res, err := gc.POSTV1BmcPowerOs(context.Background(), req, params)
+			progress.done(res)
 			if err != nil {
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
-			}
 			return nil
 		},
 	}
@@ -69,5 +67,6 @@ var (
 
 func init() {
 	powerCmd.PersistentFlags().StringVarP(&override, "override", "o", "None", "Set redfish boot override. Valid options: None, Pxe, BiosSetup, Utilities, Diags")
+	addAsyncFlag(powerCmd)
 	bmcCmd.AddCommand(powerCmd)
 }
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/segmentio/ksuid"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/api"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+// jobProgress prints the result of each host of a BMC job as it finishes by
+// following the API event stream
+type jobProgress struct {
+	stream *cmd.EventStream
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	seen   map[string]bool
+}
+
+// newJobClient returns an API client tagging its requests with a new job id,
+// and the progress of that job. If the event stream is unavailable results
+// are only printed once the job is done. With --async the job runs as a task
+// and no progress is shown.
+func newJobClient() (*client.Client, *jobProgress, error) {
+	jobID := ksuid.New().String()
+	p := &jobProgress{seen: make(map[string]bool)}
+
+	if async {
+		gc, err := cmd.NewOgenClient()
+		return gc, p, err
+	}
+
+	stream, err := cmd.OpenEventStream(context.Background(), url.Values{"types": {"job"}, "job": {jobID}})
+	if err != nil {
+		cmd.Log.Debugf("event stream unavailable, not showing progress: %s", err)
+	} else {
+		p.stream = stream
+		p.wg.Add(1)
+		go p.follow()
+	}
+
+	gc, err := cmd.NewOgenClientWithHeader(api.JobIDHeader, jobID)
+	if err != nil {
+		p.stop()
+		return nil, nil, err
+	}
+
+	return gc, p, nil
+}
+
+func (p *jobProgress) follow() {
+	defer p.wg.Done()
+	for {
+		m, err := p.stream.Next()
+		if err != nil {
+			return
+		}
+		if m.Job == nil {
+			continue
+		}
+
+		p.mu.Lock()
+		p.seen[m.Job.Host] = true
+		p.mu.Unlock()
+		printJobMessage(m.Job.Host, m.Job.Status, m.Job.Msg)
+	}
+}
+
+func (p *jobProgress) stop() {
+	if p.stream != nil {
+		p.stream.Close()
+		p.wg.Wait()
+	}
+}
+
+// done stops following the job and prints the results not yet received from
+// the event stream, or the id of the task submitted with --async
+func (p *jobProgress) done(res []client.JobMessage) {
+	p.stop()
+
+	if async {
+		for _, m := range res {
+			fmt.Printf("%s: %s\n", m.Msg.Value, m.Data.Value)
+		}
+		return
+	}
+
+	for _, jobMessage := range res {
+		if p.seen[jobMessage.Host.Value] {
+			continue
+		}
+		printJobMessage(jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
+	}
+}
+
+func printJobMessage(host, status, msg string) {
+	fmt.Printf("%s\t %s\t %s\n", host, status, msg)
+}
@@ -6,7 +6,6 @@ package bmc
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -22,7 +21,7 @@ var (
 		RunE: func(command *cobra.Command, args []string) error {
 
 			var err error
-			gc, err := cmd.NewOgenClient()
+			gc, progress, err := newJobClient()
 			if err != nil {
 				return err
 			}
@@ -34,20 +33,20 @@ var (
 			params := client.POSTV1BmcPowerBmcParams{
 				Nodeset: client.NewOptString(nodeset),
 				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Async:   client.NewOptBool(async),
 			}
 			res, err := gc.POSTV1BmcPowerBmc(context.Background(), params)
+			progress.done(res)
 			if err != nil {
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
-			}
 			return nil
 		},
 	}
 )
 
 func init() {
+	addAsyncFlag(powerBmcCmd)
 	bmcCmd.AddCommand(powerBmcCmd)
 }
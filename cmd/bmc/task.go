@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	taskStatus   string
+	taskLimit    int
+	taskInterval time.Duration
+	taskTimeout  time.Duration
+	taskCmd      = &cobra.Command{
+		Use:   "task",
+		Short: "BMC background task commands",
+		Long:  `BMC background task commands. Submit a task with the --async flag of the power, reboot-bmc, configure, job clear and firmware upgrade commands`,
+	}
+
+	taskListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List BMC tasks, newest first",
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1BmcTasksParams{
+				Limit: client.NewOptInt(taskLimit),
+			}
+			if taskStatus != "" {
+				params.Status = client.NewOptString(taskStatus)
+			}
+			res, err := gc.GETV1BmcTasks(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"ID", "Operation", "Status", "User", "Created", "Updated", "Error"})
+			for _, task := range res {
+				t.AppendRow(table.Row{
+					task.ID.Value,
+					task.Operation.Value,
+					task.Status.Value,
+					task.User.Value,
+					task.CreatedAt.Value.Local().Format(time.DateTime),
+					task.UpdatedAt.Value.Local().Format(time.DateTime),
+					task.Error.Value,
+				})
+			}
+			t.SetStyle(table.StyleLight)
+			t.Render()
+
+			return nil
+		},
+	}
+
+	taskShowCmd = &cobra.Command{
+		Use:   "show <id>",
+		Short: "Show the status of a BMC task on each node",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			task, err := gc.GETV1BmcTasksID(context.Background(), client.GETV1BmcTasksIDParams{ID: args[0]})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			printTask(task)
+			for _, m := range task.Hosts {
+				printJobMessage(m.Host.Value, m.Status.Value, m.Msg.Value)
+			}
+
+			return nil
+		},
+	}
+
+	taskWaitCmd = &cobra.Command{
+		Use:   "wait <id>",
+		Short: "Wait for a BMC task to finish",
+		Long:  `Wait for a BMC task to finish, printing the result of each node as it finishes`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			if taskTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, taskTimeout)
+				defer cancel()
+			}
+
+			ticker := time.NewTicker(taskInterval)
+			defer ticker.Stop()
+
+			printed := make(map[string]bool)
+			for {
+				task, err := gc.GETV1BmcTasksID(ctx, client.GETV1BmcTasksIDParams{ID: args[0]})
+				if err != nil {
+					return cmd.NewApiError(err)
+				}
+
+				for _, m := range task.Hosts {
+					if m.Status.Value == model.BmcTaskHostPending || printed[m.Host.Value] {
+						continue
+					}
+					printed[m.Host.Value] = true
+					printJobMessage(m.Host.Value, m.Status.Value, m.Msg.Value)
+				}
+
+				switch task.Status.Value {
+				case model.BmcTaskQueued, model.BmcTaskRunning:
+				case model.BmcTaskComplete:
+					return nil
+				default:
+					printTask(task)
+					return fmt.Errorf("task %s", task.Status.Value)
+				}
+
+				select {
+				case <-ctx.Done():
+					return fmt.Errorf("timed out waiting for task %s", args[0])
+				case <-ticker.C:
+				}
+			}
+		},
+	}
+
+	taskCancelCmd = &cobra.Command{
+		Use:   "cancel <id>",
+		Short: "Cancel a BMC task",
+		Long:  `Cancel a BMC task. Nodes the task already started on finish, the rest are skipped`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.DELETEV1BmcTasksID(context.Background(), client.DELETEV1BmcTasksIDParams{ID: args[0]})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func printTask(task *client.BmcTask) {
+	fmt.Printf("Task:      %s\n", task.ID.Value)
+	fmt.Printf("Operation: %s\n", task.Operation.Value)
+	fmt.Printf("Status:    %s\n", task.Status.Value)
+	fmt.Printf("User:      %s\n", task.User.Value)
+	fmt.Printf("Owner:     %s\n", task.Owner.Value)
+	fmt.Printf("Created:   %s\n", task.CreatedAt.Value.Local().Format(time.DateTime))
+	fmt.Printf("Updated:   %s\n", task.UpdatedAt.Value.Local().Format(time.DateTime))
+	if task.Error.Value != "" {
+		fmt.Printf("Error:     %s\n", task.Error.Value)
+	}
+}
+
+func init() {
+	taskListCmd.Flags().StringVar(&taskStatus, "status", "", "filter by status (queued, running, complete, canceled, failed)")
+	taskListCmd.Flags().IntVar(&taskLimit, "limit", 50, "maximum number of tasks to show")
+	taskWaitCmd.Flags().DurationVar(&taskInterval, "interval", 2*time.Second, "how often to check the task")
+	taskWaitCmd.Flags().DurationVar(&taskTimeout, "timeout", 0, "give up waiting after this duration, 0 waits forever")
+
+	bmcCmd.AddCommand(taskCmd)
+	taskCmd.AddCommand(taskListCmd)
+	taskCmd.AddCommand(taskShowCmd)
+	taskCmd.AddCommand(taskWaitCmd)
+	taskCmd.AddCommand(taskCancelCmd)
+}
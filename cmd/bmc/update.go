@@ -8,7 +8,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
@@ -99,7 +101,7 @@ Must run bmc upgrade <nodeset> to populate firmware data`,
 		Short: "Upgrade firmware on Dell servers",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(command *cobra.Command, args []string) error {
-			gc, err := cmd.NewOgenClient()
+			gc, progress, err := newJobClient()
 			if err != nil {
 				return err
 			}
@@ -117,21 +119,127 @@ Must run bmc upgrade <nodeset> to populate firmware data`,
 			params := client.POSTV1BmcUpgradeDellInstallfromrepoParams{
 				Nodeset: client.NewOptString(nodeset),
 				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Async:   client.NewOptBool(async),
 			}
 			res, err := gc.POSTV1BmcUpgradeDellInstallfromrepo(context.Background(), &req, params)
+			progress.done(res)
 			if err != nil {
 				return cmd.NewApiError(err)
 			}
 
-			for _, jobMessage := range res {
-				fmt.Printf("%s\t %s\t %s\n", jobMessage.Host.Value, jobMessage.Status.Value, jobMessage.Msg.Value)
+			return nil
+		},
+	}
+
+	firmwareUpdateImage     string
+	firmwareUpdatePush      bool
+	firmwareUpdateTargets   []string
+	firmwareUpdateForce     bool
+	firmwareUpdateApplyTime string
+	firmwareUpdateWait      bool
+	firmwareUpdateInterval  time.Duration
+	firmwareUpdateCmd       = &cobra.Command{
+		Use:   "update <nodeset>",
+		Short: "Update firmware through the Redfish UpdateService",
+		Long: `Update firmware on any vendor's BMC through the Redfish UpdateService.
+The image is a file in the provision repo dir, or a URL for simple updates.
+By default the BMC downloads the image from the provision server, --push
+uploads it to the BMC instead`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			if firmwareUpdateWait && async {
+				return fmt.Errorf("--wait can not be used with --async")
 			}
 
-			return nil
+			gc, progress, err := newJobClient()
+			if err != nil {
+				return err
+			}
+
+			method := "simple"
+			if firmwareUpdatePush {
+				method = "push"
+			}
+
+			req := client.BmcFirmwareUpdateRequest{
+				Image:       client.NewOptString(firmwareUpdateImage),
+				Method:      client.NewOptString(method),
+				Targets:     firmwareUpdateTargets,
+				ForceUpdate: client.NewOptBool(firmwareUpdateForce),
+				ApplyTime:   client.NewOptString(firmwareUpdateApplyTime),
+			}
+			params := client.POSTV1BmcUpgradeRedfishParams{
+				Nodeset: client.NewOptString(args[0]),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Async:   client.NewOptBool(async),
+			}
+			res, err := gc.POSTV1BmcUpgradeRedfish(context.Background(), &req, params)
+			progress.done(res)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if !firmwareUpdateWait {
+				return nil
+			}
+
+			tasks := make(client.BmcFirmwareTasksRequestTasks)
+			for _, m := range res {
+				if m.Status.Value == "success" && m.Data.Value != "" {
+					tasks[m.Host.Value] = m.Data.Value
+				}
+			}
+
+			return waitFirmwareTasks(gc, tasks)
 		},
 	}
 )
 
+// waitFirmwareTasks polls the firmware update tasks until they all finish,
+// printing each node's final state
+func waitFirmwareTasks(gc *client.Client, tasks client.BmcFirmwareTasksRequestTasks) error {
+	failed := 0
+	for len(tasks) > 0 {
+		time.Sleep(firmwareUpdateInterval)
+
+		res, err := gc.POSTV1BmcUpgradeRedfishTasks(context.Background(), &client.BmcFirmwareTasksRequest{
+			Tasks: client.NewOptBmcFirmwareTasksRequestTasks(tasks),
+		}, client.POSTV1BmcUpgradeRedfishTasksParams{})
+		if err != nil {
+			return cmd.NewApiError(err)
+		}
+
+		for _, t := range res {
+			name := t.Name.Value
+			switch {
+			case t.Status.Value != "success":
+				printJobMessage(name, t.Status.Value, t.Message.Value)
+			case slices.Contains(runningTaskStates, t.TaskState.Value):
+				continue
+			default:
+				msg := t.TaskState.Value
+				if n := len(t.Messages.Value); n > 0 {
+					msg = fmt.Sprintf("%s: %s", msg, t.Messages.Value[n-1].Value)
+				}
+				printJobMessage(name, t.TaskStatus.Value, msg)
+				if t.TaskState.Value != "Completed" || t.TaskStatus.Value == "Critical" {
+					failed++
+				}
+			}
+			delete(tasks, name)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("firmware update failed on %d node(s)", failed)
+	}
+
+	return nil
+}
+
+// runningTaskStates are the Redfish task states of unfinished tasks
+var runningTaskStates = []string{"New", "Starting", "Running", "Suspended", "Interrupted", "Pending", "Stopping", "Cancelling", "Service"}
+
 func colorVersion(v1, v2 string) string {
 	if v1 != v2 {
 		return text.FgHiRed.Sprint(v2)
@@ -143,6 +251,9 @@ func init() {
 	bmcCmd.AddCommand(firmwareCmd)
 	firmwareCmd.AddCommand(firmwareCheckCmd)
 	firmwareCmd.AddCommand(firmwareUpgradeCmd)
+	firmwareCmd.AddCommand(firmwareUpdateCmd)
+	addAsyncFlag(firmwareUpgradeCmd)
+	addAsyncFlag(firmwareUpdateCmd)
 
 	firmwareUpgradeCmd.Flags().BoolVarP(&firmwareUpgradeApplyUpdate, "apply-update", "a", false, "By default only check for updates, do not queue them. Pass this flag to apply available updates.")
 	firmwareUpgradeCmd.Flags().StringVar(&firmwareUpgradeCatalogFile, "catalog-file", "", "Update catalog name. Defaults to Catalog.xml")
@@ -152,4 +263,13 @@ func init() {
 	firmwareUpgradeCmd.Flags().BoolVar(&firmwareUpgradeClearJobs, "clear-jobs", false, "Clear all jobs in the job queue before upgrading. apply-update must be true")
 	firmwareUpgradeCmd.Flags().StringVar(&firmwareUpgradeShareName, "share-name", "", "")
 	firmwareUpgradeCmd.Flags().StringVar(&firmwareUpgradeShareType, "share-type", "HTTPS", "Valid options: HTTPS, HTTP, NFS, or CIFS")
+
+	firmwareUpdateCmd.Flags().StringVarP(&firmwareUpdateImage, "image", "i", "", "Firmware image file in the provision repo dir, or a URL")
+	firmwareUpdateCmd.Flags().BoolVar(&firmwareUpdatePush, "push", false, "Upload the image to the BMC instead of having the BMC download it")
+	firmwareUpdateCmd.Flags().StringSliceVar(&firmwareUpdateTargets, "target", []string{}, "Software inventory URI to update. Defaults to all applicable components")
+	firmwareUpdateCmd.Flags().BoolVar(&firmwareUpdateForce, "force", false, "Bypass update policies such as downgrade protection")
+	firmwareUpdateCmd.Flags().StringVar(&firmwareUpdateApplyTime, "apply-time", "", "When to apply pushed updates, e.g. Immediate or OnReset")
+	firmwareUpdateCmd.Flags().BoolVarP(&firmwareUpdateWait, "wait", "w", false, "Wait for the update tasks to finish")
+	firmwareUpdateCmd.Flags().DurationVar(&firmwareUpdateInterval, "interval", 10*time.Second, "How often to check the update tasks with --wait")
+	firmwareUpdateCmd.MarkFlagRequired("image")
 }
@@ -8,6 +8,7 @@ import (
 	"bufio"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -15,11 +16,13 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/internal/tors"
+	"github.com/ubccr/grendel/pkg/model"
 )
 
 var (
 	mappingFile  string
 	bmcSubnetStr string
+	switchName   string
 	switchCmd    = &cobra.Command{
 		Use:   "switch",
 		Short: "Auto-discover hosts from switch",
@@ -36,7 +39,7 @@ var (
 			if strings.HasPrefix(endpoint, "http") {
 				switchClient, err = tors.NewDellOS10(endpoint, viper.GetString("discovery.user"), viper.GetString("discovery.password"), "", true)
 			} else {
-				switchClient, err = tors.NewGeneric(endpoint, "public")
+				switchClient, err = tors.NewGeneric(endpoint, tors.NewSNMPConfig())
 			}
 
 			if err != nil {
@@ -54,6 +57,10 @@ var (
 			// TODO make this configurable?
 			netmask := net.IPv4Mask(255, 255, 0, 0)
 
+			if switchName == "" {
+				switchName = endpointHostName(endpoint)
+			}
+
 			return discoverFromSwitch(mappingFile, viper.GetString("discovery.domain"), subnet, bmcSubnet, netmask, switchClient)
 		},
 	}
@@ -66,9 +73,12 @@ func init() {
 	viper.BindPFlag("discovery.password", switchCmd.Flags().Lookup("password"))
 	switchCmd.Flags().StringP("endpoint", "e", "", "switch api endpoint")
 	viper.BindPFlag("discovery.endpoint", switchCmd.Flags().Lookup("endpoint"))
+	switchCmd.Flags().StringP("community", "c", "public", "SNMP community of the switch, see the snmp section of the config for SNMPv3")
+	viper.BindPFlag("snmp.community", switchCmd.Flags().Lookup("community"))
 
 	switchCmd.Flags().StringVarP(&mappingFile, "mapping", "m", "", "hostname to portnumber mapping file")
 	switchCmd.Flags().StringVarP(&bmcSubnetStr, "bmc-subnet", "b", "", "subnet for bmc")
+	switchCmd.Flags().StringVar(&switchName, "switch-name", "", "host name of the switch recorded as the intended switch of discovered interfaces (default short name of the endpoint)")
 
 	switchCmd.MarkFlagRequired("endpoint")
 	switchCmd.MarkFlagRequired("mapping")
@@ -145,6 +155,7 @@ func discoverFromSwitch(file, domain string, subnet, bmcSubnet net.IP, netmask n
 			ip[2] += uint8(switchID)
 			ip[3] += uint8(port)
 			addNic(hostName, fqdn, entry.MAC, ip, isBMC)
+			setCabling(hostName, entry)
 			continue
 
 		}
@@ -152,3 +163,33 @@ func discoverFromSwitch(file, domain string, subnet, bmcSubnet net.IP, netmask n
 
 	return nil
 }
+
+// setCabling records the switch port a discovered interface was found on as
+// its intended switch port, validated by grendel validate cabling
+func setCabling(name string, entry *model.MACTableEntry) {
+	nic := hosts[name].Interface(entry.MAC)
+	if nic == nil || switchName == "" {
+		return
+	}
+
+	nic.Switch = switchName
+	nic.SwitchPort = entry.Ifname
+	if nic.SwitchPort == "" {
+		nic.SwitchPort = strconv.Itoa(entry.Port)
+	}
+}
+
+// endpointHostName returns the short host name of a switch API URL or address
+func endpointHostName(endpoint string) string {
+	host := endpoint
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		host = u.Hostname()
+	}
+
+	if net.ParseIP(host) != nil {
+		return host
+	}
+
+	short, _, _ := strings.Cut(host, ".")
+	return short
+}
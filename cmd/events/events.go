@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	user      string
+	severity  string
+	host      string
+	since     time.Duration
+	until     time.Duration
+	limit     int
+	offset    int
+	jobs      bool
+	follow    bool
+	eventsCmd = &cobra.Command{
+		Use:   "events",
+		Short: "Show audit events",
+		Long:  `Show audit events recorded by the API, newest first. Filter by user, severity, host and time range`,
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			if follow {
+				return followEvents()
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1GrendelEventsParams{
+				Limit:  client.NewOptInt(limit),
+				Offset: client.NewOptInt(offset),
+			}
+			if user != "" {
+				params.User = client.NewOptString(user)
+			}
+			if severity != "" {
+				params.Severity = client.NewOptString(severity)
+			}
+			if host != "" {
+				params.Host = client.NewOptString(host)
+			}
+			if since > 0 {
+				params.Since = client.NewOptString(time.Now().Add(-since).UTC().Format(time.RFC3339))
+			}
+			if until > 0 {
+				params.Until = client.NewOptString(time.Now().Add(-until).UTC().Format(time.RFC3339))
+			}
+
+			res, err := gc.GETV1GrendelEvents(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"ID", "Time", "Severity", "User", "Message", "Hosts"})
+
+			for _, event := range res {
+				t.AppendRow(table.Row{
+					event.ID.Value,
+					event.Time.Value.Local().Format(time.DateTime),
+					event.Severity.Value,
+					event.User.Value,
+					event.Message.Value,
+					strings.Join(event.Hosts, ","),
+				})
+
+				if !jobs {
+					continue
+				}
+				for _, m := range event.JobMessages {
+					t.AppendRow(table.Row{"", "", m.Status.Value, "", m.Msg.Value, m.Host.Value})
+				}
+			}
+			t.SetStyle(table.StyleLight)
+			t.Render()
+
+			return nil
+		},
+	}
+)
+
+// followEvents prints new events and provision state changes until
+// interrupted
+func followEvents() error {
+	query := url.Values{"types": {model.StreamTypeEvent + "," + model.StreamTypeProvision}}
+	if host != "" {
+		query.Set("host", host)
+	}
+
+	stream, err := cmd.OpenEventStream(context.Background(), query)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	for {
+		m, err := stream.Next()
+		if err != nil {
+			return err
+		}
+
+		ts := m.Time.Local().Format(time.DateTime)
+		switch {
+		case m.Event != nil:
+			if (user != "" && m.Event.User != user) || (severity != "" && m.Event.Severity != severity) {
+				continue
+			}
+			fmt.Printf("%s\t %s\t %s\t %s\t %s\n", ts, m.Event.Severity, m.Event.User, m.Event.Message, strings.Join(m.Event.Hosts, ","))
+			if !jobs {
+				continue
+			}
+			for _, jm := range m.Event.JobMessages {
+				fmt.Printf("\t %s\t %s\t %s\n", jm.Status, jm.Host, jm.Msg)
+			}
+		case m.Type == model.StreamTypeProvision:
+			if user != "" || severity != "" {
+				continue
+			}
+			fmt.Printf("%s\t provision\t %s\t %s\n", ts, m.Host, m.State)
+		}
+	}
+}
+
+func init() {
+	eventsCmd.Flags().StringVar(&user, "user", "", "filter by username")
+	eventsCmd.Flags().StringVar(&severity, "severity", "", "filter by severity (Success, Info, Warning, Error)")
+	eventsCmd.Flags().StringVar(&host, "host", "", "filter by host name")
+	eventsCmd.Flags().DurationVar(&since, "since", 0, "only show events newer than this duration")
+	eventsCmd.Flags().DurationVar(&until, "until", 0, "only show events older than this duration")
+	eventsCmd.Flags().IntVar(&limit, "limit", 50, "maximum number of events to show")
+	eventsCmd.Flags().IntVar(&offset, "offset", 0, "number of events to skip")
+	eventsCmd.Flags().BoolVar(&jobs, "jobs", false, "show the per host results of each event")
+	eventsCmd.Flags().BoolVarP(&follow, "follow", "f", false, "stream new events and provision state changes as they happen")
+	cmd.Root.AddCommand(eventsCmd)
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package image
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	artifactsCmd = &cobra.Command{
+		Use:   "artifacts",
+		Short: "List image artifacts",
+		Long:  `List the image files uploaded with grendel image import --upload, stored under their SHA-256 checksum`,
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1ImagesArtifacts(context.Background(), client.GETV1ImagesArtifactsParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			printArtifacts(res)
+			return nil
+		},
+	}
+	gcCmd = &cobra.Command{
+		Use:   "gc",
+		Short: "Delete unreferenced image artifacts",
+		Long:  `Delete the image artifacts no image references. Artifacts uploaded within provision.artifact_gc_grace are kept.`,
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.POSTV1ImagesArtifactsGc(context.Background(), client.POSTV1ImagesArtifactsGcParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			cmd.Log.Infof("Deleted %d unreferenced artifacts", len(res))
+			printArtifacts(res)
+			return nil
+		},
+	}
+)
+
+func init() {
+	imageCmd.AddCommand(artifactsCmd)
+	imageCmd.AddCommand(gcCmd)
+}
+
+func printArtifacts(artifacts []client.Artifact) {
+	if len(artifacts) == 0 {
+		return
+	}
+
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.AppendHeader(table.Row{"SHA256", "Size", "Time"})
+	for _, a := range artifacts {
+		t.AppendRow(table.Row{
+			a.SHA256.Value,
+			humanize.IBytes(uint64(a.Size.Value)),
+			a.Time.Value.Local().Format(time.DateTime),
+		})
+	}
+	t.SetStyle(table.StyleLight)
+	t.Render()
+}
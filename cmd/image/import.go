@@ -16,11 +16,15 @@ import (
 )
 
 var (
-	importCmd = &cobra.Command{
+	importUpload bool
+	importCmd    = &cobra.Command{
 		Use:   "import <filenames>...",
 		Short: "import images",
-		Long:  `import images`,
-		Args:  cobra.MinimumNArgs(1),
+		Long: `import images
+
+With --upload the kernel, initrds and live image of each image are read from
+local files, uploaded to Grendel and referenced by their SHA-256 checksum.`,
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(command *cobra.Command, args []string) error {
 			gc, err := cmd.NewOgenClient()
 			if err != nil {
@@ -41,6 +45,17 @@ var (
 					return err
 				}
 
+				if importUpload {
+					for i := range images {
+						if images[i].Null {
+							continue
+						}
+						if err := uploadArtifacts(gc, &images[i].Value); err != nil {
+							return err
+						}
+					}
+				}
+
 				req := &client.BootImageAddRequest{
 					BootImages: images,
 				}
@@ -59,5 +74,61 @@ var (
 )
 
 func init() {
+	importCmd.Flags().BoolVar(&importUpload, "upload", false, "upload the image files and reference them by checksum")
 	imageCmd.AddCommand(importCmd)
 }
+
+// uploadArtifacts uploads the files of an image and replaces their paths with
+// checksums, which Grendel resolves to the stored artifacts
+func uploadArtifacts(gc *client.Client, image *client.BootImageAddRequestBootImagesItem) error {
+	if image.Kernel.Value != "" {
+		sum, err := uploadArtifact(gc, image.Kernel.Value)
+		if err != nil {
+			return err
+		}
+		image.KernelSHA256 = client.NewOptString(sum)
+		image.Kernel = client.OptString{}
+	}
+
+	if len(image.Initrd) > 0 {
+		image.InitrdSHA256 = make([]string, 0, len(image.Initrd))
+		for _, path := range image.Initrd {
+			sum, err := uploadArtifact(gc, path)
+			if err != nil {
+				return err
+			}
+			image.InitrdSHA256 = append(image.InitrdSHA256, sum)
+		}
+		image.Initrd = nil
+	}
+
+	if image.Liveimg.Value != "" {
+		sum, err := uploadArtifact(gc, image.Liveimg.Value)
+		if err != nil {
+			return err
+		}
+		image.LiveimgSHA256 = client.NewOptString(sum)
+		image.Liveimg = client.OptString{}
+	}
+
+	return nil
+}
+
+func uploadArtifact(gc *client.Client, path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	cmd.Log.Infof("Uploading file: %s", path)
+
+	res, err := gc.POSTV1ImagesArtifacts(context.Background(), client.POSTV1ImagesArtifactsReq{Data: file})
+	if err != nil {
+		return "", cmd.NewApiError(err)
+	}
+
+	cmd.Log.Infof("Stored %s as %s", path, res.SHA256.Value)
+
+	return res.SHA256.Value, nil
+}
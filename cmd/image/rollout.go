@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	rolloutTags      []string
+	rolloutPercent   int
+	rolloutProvision bool
+	rolloutAll       bool
+	rolloutCmd       = &cobra.Command{
+		Use:   "rollout",
+		Short: "Staged rollouts of image versions",
+		Long: `Roll out a version of an image to a canary percentage of a nodeset, watch the
+install results of the canary nodes and then promote or roll back the version`,
+	}
+	rolloutStartCmd = &cobra.Command{
+		Use:   "start {nodeset | all} <name> <version>",
+		Short: "Pin a percentage of the nodes booting an image to a version",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(command *cobra.Command, args []string) error {
+			version, err := strconv.Atoi(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid version: %s", args[2])
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset := args[0]
+			if args[0] == "all" {
+				nodeset = ""
+			}
+			req := &client.RolloutStartRequest{
+				Image:     client.NewOptString(args[1]),
+				Version:   client.NewOptInt(version),
+				Percent:   client.NewOptInt(rolloutPercent),
+				Provision: client.NewOptBool(rolloutProvision),
+			}
+			params := client.POSTV1ImagesRolloutsParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(rolloutTags, ",")),
+			}
+			res, err := gc.POSTV1ImagesRollouts(context.Background(), req, params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			cmd.Log.Infof("Pinned %s to %s@%d", res.Canary.Value, res.Image.Value, res.Version.Value)
+			return nil
+		},
+	}
+	rolloutStatusCmd = &cobra.Command{
+		Use:   "status [names...]",
+		Short: "Show the install results of the canary nodes of rollouts",
+		Long:  `Show running rollouts and the install results of their canary nodes. Use --all to include finished rollouts.`,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1ImagesRollouts(context.Background(), client.GETV1ImagesRolloutsParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"Image", "Version", "State", "Canary", "Complete", "Failed", "Pending", "Success", "Started"})
+			for _, r := range res {
+				if len(args) > 0 && !slices.Contains(args, r.Image.Value) {
+					continue
+				}
+				if !rolloutAll && r.State.Value != model.RolloutRunning {
+					continue
+				}
+
+				failed := strconv.Itoa(r.Failed.Value)
+				if r.Failed.Value > 0 {
+					failed = text.FgRed.Sprint(failed)
+				}
+				t.AppendRow(table.Row{
+					r.Image.Value,
+					fmt.Sprintf("%d -> %d", r.FromVersion.Value, r.Version.Value),
+					r.State.Value,
+					r.Canary.Value,
+					r.Complete.Value,
+					failed,
+					r.Pending.Value,
+					fmt.Sprintf("%.0f%%", r.SuccessRate.Value*100),
+					r.Time.Value.Local().Format(time.DateTime),
+				})
+			}
+			t.SetStyle(table.StyleLight)
+			t.Render()
+
+			return nil
+		},
+	}
+	rolloutPromoteCmd = &cobra.Command{
+		Use:   "promote <name>",
+		Short: "Make the rollout version current and unpin the canary nodes",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.POSTV1ImagesRolloutsImagePromote(context.Background(), client.POSTV1ImagesRolloutsImagePromoteParams{Image: args[0]})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+	rolloutRollbackCmd = &cobra.Command{
+		Use:   "rollback <name>",
+		Short: "Restore the boot image the canary nodes had before the rollout",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.POSTV1ImagesRolloutsImageRollback(context.Background(), client.POSTV1ImagesRolloutsImageRollbackParams{Image: args[0]})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	rolloutStartCmd.Flags().StringSliceVarP(&rolloutTags, "tags", "t", []string{}, "filter by tags")
+	rolloutStartCmd.Flags().IntVar(&rolloutPercent, "percent", 10, "percentage of nodes to pin to the version")
+	rolloutStartCmd.Flags().BoolVar(&rolloutProvision, "provision", false, "set the canary nodes to provision")
+	rolloutStatusCmd.Flags().BoolVar(&rolloutAll, "all", false, "include finished rollouts")
+
+	rolloutCmd.AddCommand(rolloutStartCmd)
+	rolloutCmd.AddCommand(rolloutStatusCmd)
+	rolloutCmd.AddCommand(rolloutPromoteCmd)
+	rolloutCmd.AddCommand(rolloutRollbackCmd)
+	imageCmd.AddCommand(rolloutCmd)
+}
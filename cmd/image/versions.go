@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package image
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	versionsJSON bool
+	versionsCmd  = &cobra.Command{
+		Use:   "versions <name>",
+		Short: "List the versions of an image",
+		Long: `List the versions of an image. A version is recorded each time the kernel,
+initrds, command line or templates of the image change. Nodes can be pinned to a
+version with grendel node image <nodeset> <name>@<version>.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1ImagesVersionsName(context.Background(), client.GETV1ImagesVersionsNameParams{Name: args[0]})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if versionsJSON {
+				return output(res)
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"Version", "Current", "Time", "Kernel", "Initrd"})
+			for _, v := range res {
+				current := ""
+				if v.Current.Value {
+					current = "*"
+				}
+				image := v.Image.Value
+				t.AppendRow(table.Row{
+					v.Version.Value,
+					current,
+					v.Time.Value.Local().Format(time.DateTime),
+					image.Kernel.Value,
+					strings.Join(image.Initrd, "\n"),
+				})
+			}
+			t.SetStyle(table.StyleLight)
+			t.Render()
+
+			return nil
+		},
+	}
+	currentCmd = &cobra.Command{
+		Use:   "current <name> <version>",
+		Short: "Set the current version of an image",
+		Long:  `Set the current version of an image, used by all nodes not pinned to a version`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(command *cobra.Command, args []string) error {
+			version, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid version: %s", args[1])
+			}
+
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			req := &client.BootImageCurrentRequest{
+				Name:    client.NewOptString(args[0]),
+				Version: client.NewOptInt(version),
+			}
+			res, err := gc.PATCHV1ImagesCurrent(context.Background(), req, client.PATCHV1ImagesCurrentParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	versionsCmd.Flags().BoolVar(&versionsJSON, "json", false, "output the versions as JSON")
+	imageCmd.AddCommand(versionsCmd)
+	imageCmd.AddCommand(currentCmd)
+}
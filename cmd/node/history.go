@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	historySince time.Duration
+	historyCmd   = &cobra.Command{
+		Use:   "history {name}",
+		Short: "Show DHCP boot history of a node",
+		Long: `Show the DHCP, DHCPv6 and proxy PXE messages sent to and received from a node,
+oldest first, with the address, relay and detected PXE firmware of each.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1NodesHistoryNameParams{
+				Name:  args[0],
+				Since: client.NewOptString(time.Now().Add(-historySince).UTC().Format(time.RFC3339)),
+			}
+			res, err := gc.GETV1NodesHistoryName(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"Time", "Message", "MAC", "IP", "Relay", "Firmware"})
+
+			for _, event := range res {
+				t.AppendRow(table.Row{
+					event.Time.Value.Local().Format(time.DateTime),
+					event.MessageType.Value,
+					event.MAC.Value,
+					event.IP.Value,
+					event.RelayIP.Value,
+					event.Firmware.Value,
+				})
+			}
+			t.SetStyle(table.StyleLight)
+			t.Render()
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	historyCmd.Flags().DurationVar(&historySince, "since", 24*time.Hour, "show history for this duration")
+	nodeCmd.AddCommand(historyCmd)
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	inventoryKind      string
+	inventoryName      string
+	inventoryOlderThan string
+	inventoryCmd       = &cobra.Command{
+		Use:   "inventory {nodeset | all}",
+		Short: "Show the hardware inventory of nodes",
+		Long: `Show the DIMMs, CPUs, PCIe devices, NICs, drives and firmware of nodes
+collected from their BMCs, see grendel bmc inventory. For example to find the
+nodes with a BIOS older than 2.19.1:
+
+  grendel node inventory all --name BIOS --older-than 2.19.1`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1NodesInventoryParams{
+				Nodeset:   client.NewOptString(inventoryNodeset(args[0])),
+				Tags:      client.NewOptString(strings.Join(tags, ",")),
+				Kind:      client.NewOptString(inventoryKind),
+				Name:      client.NewOptString(inventoryName),
+				VersionLt: client.NewOptString(inventoryOlderThan),
+			}
+			res, err := gc.GETV1NodesInventory(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"Host", "Kind", "Slot", "Name", "Model", "Serial", "Version", "Size", "MAC"})
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{
+					Name:      "Host",
+					AutoMerge: true,
+				},
+			})
+
+			for _, c := range res {
+				size := ""
+				if c.Capacity.Value > 0 {
+					size = humanize.IBytes(uint64(c.Capacity.Value))
+				}
+				t.AppendRow(table.Row{
+					c.Host.Value,
+					c.Kind.Value,
+					c.Slot.Value,
+					c.Name.Value,
+					strings.TrimSpace(c.Manufacturer.Value + " " + c.Model.Value),
+					c.Serial.Value,
+					c.Version.Value,
+					size,
+					c.Address.Value,
+				})
+			}
+			t.SetStyle(table.StyleLight)
+			t.Render()
+
+			return nil
+		},
+	}
+
+	inventorySince      time.Duration
+	inventoryChangesCmd = &cobra.Command{
+		Use:   "changes {nodeset | all}",
+		Short: "Show hardware inventory changes of nodes",
+		Long:  `Show the parts added, removed, replaced or updated between inventory collections`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			params := client.GETV1NodesInventoryChangesParams{
+				Nodeset: client.NewOptString(inventoryNodeset(args[0])),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+				Since:   client.NewOptString(time.Now().Add(-inventorySince).UTC().Format(time.RFC3339)),
+			}
+			res, err := gc.GETV1NodesInventoryChanges(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"Time", "Host", "Kind", "Slot", "Change", "Old", "New"})
+
+			for _, c := range res {
+				t.AppendRow(table.Row{
+					c.DetectedAt.Value.Local().Format(time.DateTime),
+					c.Host.Value,
+					c.Kind.Value,
+					c.Slot.Value,
+					c.Change.Value,
+					c.Old.Value,
+					c.New.Value,
+				})
+			}
+			t.SetStyle(table.StyleLight)
+			t.Render()
+
+			return nil
+		},
+	}
+)
+
+func inventoryNodeset(nodeset string) string {
+	if nodeset == "all" {
+		return ""
+	}
+
+	return nodeset
+}
+
+func init() {
+	inventoryCmd.Flags().StringVar(&inventoryKind, "kind", "", "only show this kind: cpu, dimm, pcie, nic, drive or firmware")
+	inventoryCmd.Flags().StringVar(&inventoryName, "name", "", "only show components with this name or slot")
+	inventoryCmd.Flags().StringVar(&inventoryOlderThan, "older-than", "", "only show components with a version older than this")
+	inventoryChangesCmd.Flags().DurationVar(&inventorySince, "since", 30*24*time.Hour, "show changes for this duration")
+
+	inventoryCmd.AddCommand(inventoryChangesCmd)
+	nodeCmd.AddCommand(inventoryCmd)
+}
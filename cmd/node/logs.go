@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var (
+	logsFile     string
+	logsProgress bool
+	logsCmd      = &cobra.Command{
+		Use:   "logs {name}",
+		Short: "Show install progress and logs of a node",
+		Long: `Show the progress messages and log files sent by a node to the provision server
+while it installs, since it was last set to provision. Use --file to print only
+the last upload of one log file.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.GETV1NodesLogsName(context.Background(), client.GETV1NodesLogsNameParams{Name: args[0]})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			if logsFile != "" {
+				for i := len(res) - 1; i >= 0; i-- {
+					if res[i].Kind.Value == model.ProvisionLogFile && res[i].Name.Value == logsFile {
+						fmt.Print(res[i].Content.Value)
+						return nil
+					}
+				}
+				return fmt.Errorf("no log %s found for node %s", logsFile, args[0])
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"Time", "Progress"})
+
+			files := make([]client.ProvisionLog, 0)
+			for _, l := range res {
+				if l.Kind.Value == model.ProvisionLogFile {
+					files = append(files, l)
+					continue
+				}
+				t.AppendRow(table.Row{
+					l.Time.Value.Local().Format(time.DateTime),
+					l.Content.Value,
+				})
+			}
+			t.SetStyle(table.StyleLight)
+			t.Render()
+
+			if logsProgress {
+				return nil
+			}
+
+			for _, l := range files {
+				fmt.Printf("\n==> %s (%s) <==\n", l.Name.Value, l.Time.Value.Local().Format(time.DateTime))
+				fmt.Print(l.Content.Value)
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	logsCmd.Flags().StringVar(&logsFile, "file", "", "only print this log file")
+	logsCmd.Flags().BoolVar(&logsProgress, "progress", false, "only show progress messages")
+	nodeCmd.AddCommand(logsCmd)
+}
@@ -81,6 +81,43 @@ func (o ogenAuth) CookieAuth(ctx context.Context, operationName string, c *clien
 }
 
 func NewOgenClient() (*client.Client, error) {
+	return newOgenClient(nil)
+}
+
+// NewOgenClientWithHeader returns a new API client sending the given header
+// with every request
+func NewOgenClientWithHeader(key, value string) (*client.Client, error) {
+	header := http.Header{}
+	header.Set(key, value)
+	return newOgenClient(header)
+}
+
+func newOgenClient(header http.Header) (*client.Client, error) {
+	httpClient, endpoint, err := newHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(header) > 0 {
+		httpClient.Transport = &headerTransport{header: header, next: httpClient.Transport}
+	}
+
+	// rclient needs some error handler to work properly with ogen convenient errors
+	// rclient := retryablehttp.NewClient()
+	// rclient.RetryMax = 1
+	// rclient.HTTPClient = &http.Client{Timeout: time.Second * 3600, Transport: tr}
+	// rclient.Logger = Log
+	// httpClient := rclient.StandardClient()
+	client, err := client.NewClient(endpoint, newAuthHandler(), client.WithClient(httpClient))
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// newHTTPClient returns an http client for the configured API endpoint along
+// with the base URL of the endpoint
+func newHTTPClient() (*http.Client, string, error) {
 	tr := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: viper.GetBool("client.insecure")}}
 
 	cacert := viper.GetString("client.cacert")
@@ -88,7 +125,7 @@ func NewOgenClient() (*client.Client, error) {
 	if err == nil {
 		certPool := x509.NewCertPool()
 		if !certPool.AppendCertsFromPEM(pem) {
-			return nil, fmt.Errorf("Failed to read cacert: %s", cacert)
+			return nil, "", fmt.Errorf("Failed to read cacert: %s", cacert)
 		}
 
 		tr = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: certPool, InsecureSkipVerify: false}}
@@ -104,18 +141,22 @@ func NewOgenClient() (*client.Client, error) {
 		endpoint = "http://localhost"
 	}
 
-	httpClient := &http.Client{Timeout: time.Second * 3600, Transport: tr}
-	// rclient needs some error handler to work properly with ogen convenient errors
-	// rclient := retryablehttp.NewClient()
-	// rclient.RetryMax = 1
-	// rclient.HTTPClient = &http.Client{Timeout: time.Second * 3600, Transport: tr}
-	// rclient.Logger = Log
-	// httpClient := rclient.StandardClient()
-	client, err := client.NewClient(endpoint, newAuthHandler(), client.WithClient(httpClient))
-	if err != nil {
-		return nil, err
+	return &http.Client{Timeout: time.Second * 3600, Transport: tr}, endpoint, nil
+}
+
+// headerTransport adds headers to every request
+type headerTransport struct {
+	header http.Header
+	next   http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range t.header {
+		req.Header[k] = v
 	}
-	return client, nil
+
+	return t.next.RoundTrip(req)
 }
 
 func NewApiError(apiError error) error {
@@ -13,6 +13,8 @@ import (
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/cmd"
 	"github.com/ubccr/grendel/internal/api"
+	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/internal/topology"
 	"gopkg.in/tomb.v2"
 )
 
@@ -25,6 +27,12 @@ func init() {
 	viper.BindPFlag("api.cert", apiCmd.PersistentFlags().Lookup("api-cert"))
 	apiCmd.PersistentFlags().String("api-key", "", "path to ssl key")
 	viper.BindPFlag("api.key", apiCmd.PersistentFlags().Lookup("api-key"))
+	apiCmd.PersistentFlags().String("api-event-retention", "8760h", "how long to keep audit events, finished BMC tasks and install logs, 0 keeps them forever")
+	viper.BindPFlag("api.event_retention", apiCmd.PersistentFlags().Lookup("api-event-retention"))
+	apiCmd.PersistentFlags().String("bmc-inventory-interval", "0", "how often to collect the hardware inventory of all nodes from their BMCs, 0 disables")
+	viper.BindPFlag("bmc.inventory_interval", apiCmd.PersistentFlags().Lookup("bmc-inventory-interval"))
+	apiCmd.PersistentFlags().String("topology-interval", "0", "how often to walk the switches for the network topology, 0 disables")
+	viper.BindPFlag("topology.interval", apiCmd.PersistentFlags().Lookup("topology-interval"))
 
 	serveCmd.AddCommand(apiCmd)
 }
@@ -53,6 +61,8 @@ func serveAPI(t *tomb.Tomb) error {
 		return err
 	}
 
+	apiServer.HA = Elector
+	runElector(t)
 	apiServer.KeyFile = viper.GetString("api.key")
 	apiServer.CertFile = viper.GetString("api.cert")
 	apiServer.CORS = viper.GetBool("api.cors")
@@ -62,6 +72,120 @@ func serveAPI(t *tomb.Tomb) error {
 		cmd.Log.Warn("client.api_key is not set, CLI authentication will not work. Either bind the API to a unix socket or signup for an account in the web ui and create a token")
 	}
 
+	retention, err := time.ParseDuration(viper.GetString("api.event_retention"))
+	if err != nil {
+		return err
+	}
+
+	if retention > 0 {
+		cmd.Log.Infof("Keeping audit events, BMC tasks and install logs for: %s", retention)
+		t.Go(func() error {
+			ticker := time.NewTicker(time.Hour)
+			defer ticker.Stop()
+			for {
+				if n, err := DB.PruneEvents(time.Now().Add(-retention)); err != nil {
+					cmd.Log.Errorf("Failed pruning audit events: %s", err)
+				} else if n > 0 {
+					cmd.Log.Infof("Pruned %d audit events", n)
+				}
+				if n, err := DB.PruneBmcTasks(time.Now().Add(-retention)); err != nil {
+					cmd.Log.Errorf("Failed pruning BMC tasks: %s", err)
+				} else if n > 0 {
+					cmd.Log.Infof("Pruned %d BMC tasks", n)
+				}
+				if n, err := DB.PruneProvisionLogs(time.Now().Add(-retention)); err != nil {
+					cmd.Log.Errorf("Failed pruning install logs: %s", err)
+				} else if n > 0 {
+					cmd.Log.Infof("Pruned %d install logs", n)
+				}
+
+				select {
+				case <-t.Dying():
+					return nil
+				case <-ticker.C:
+				}
+			}
+		})
+	}
+
+	inventoryInterval, err := time.ParseDuration(viper.GetString("bmc.inventory_interval"))
+	if err != nil {
+		return err
+	}
+
+	if inventoryInterval > 0 {
+		vault, err := bmc.NewVault(DB, viper.GetString("bmc.credential_key"))
+		if err != nil {
+			return err
+		}
+
+		cmd.Log.Infof("Collecting BMC inventory every: %s", inventoryInterval)
+		t.Go(func() error {
+			ticker := time.NewTicker(inventoryInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-t.Dying():
+					return nil
+				case <-ticker.C:
+				}
+
+				// Only the active instance collects in high availability setups
+				if Elector != nil && !Elector.IsLeader() {
+					continue
+				}
+
+				output, err := bmc.CollectInventory(DB, vault)
+				if err != nil {
+					cmd.Log.Errorf("Failed collecting BMC inventory: %s", err)
+					continue
+				}
+
+				failed := 0
+				for _, m := range output {
+					if m.Status != "success" {
+						failed++
+						cmd.Log.Debugf("Failed collecting BMC inventory of %s: %s", m.Host, m.Msg)
+					}
+				}
+				cmd.Log.Infof("Collected BMC inventory of %d nodes, %d failed", len(output)-failed, failed)
+			}
+		})
+	}
+
+	topologyInterval, err := time.ParseDuration(viper.GetString("topology.interval"))
+	if err != nil {
+		return err
+	}
+
+	if topologyInterval > 0 {
+		cmd.Log.Infof("Walking switches for the network topology every: %s", topologyInterval)
+		t.Go(func() error {
+			ticker := time.NewTicker(topologyInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-t.Dying():
+					return nil
+				case <-ticker.C:
+				}
+
+				// Only the active instance walks in high availability setups
+				if Elector != nil && !Elector.IsLeader() {
+					continue
+				}
+
+				res, err := topology.Walk(DB)
+				if err != nil {
+					cmd.Log.Errorf("Failed walking switches: %s", err)
+					continue
+				}
+
+				cmd.Log.Infof("Found %d links on %d switches, %d failed, %d hosts moved", res.Links, res.Switches-len(res.Failed), len(res.Failed), len(res.Moved))
+			}
+		})
+	}
+
 	t.Go(func() error {
 		time.Sleep(1 * time.Second)
 		<-t.Dying()
@@ -6,10 +6,13 @@ package serve
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/dhcp"
 	"github.com/ubccr/grendel/internal/logger"
 	"gopkg.in/tomb.v2"
@@ -20,6 +23,12 @@ func init() {
 	viper.BindPFlag("dhcp.listen", dhcpCmd.PersistentFlags().Lookup("dhcp-listen"))
 	dhcpCmd.PersistentFlags().String("dhcp-lease-time", "24h", "default lease time")
 	viper.BindPFlag("dhcp.lease_time", dhcpCmd.PersistentFlags().Lookup("dhcp-lease-time"))
+	dhcpCmd.PersistentFlags().String("dhcp-dynamic-lease-time", "1h", "lease time for dynamic pool addresses")
+	viper.BindPFlag("dhcp.dynamic_lease_time", dhcpCmd.PersistentFlags().Lookup("dhcp-dynamic-lease-time"))
+	dhcpCmd.PersistentFlags().Bool("dhcp-history", true, "record DHCP boot history")
+	viper.BindPFlag("dhcp.history", dhcpCmd.PersistentFlags().Lookup("dhcp-history"))
+	dhcpCmd.PersistentFlags().String("dhcp-history-retention", "720h", "how long to keep DHCP boot history")
+	viper.BindPFlag("dhcp.history_retention", dhcpCmd.PersistentFlags().Lookup("dhcp-history-retention"))
 	dhcpCmd.PersistentFlags().StringSlice("dhcp-dns-servers", []string{}, "dns servers list")
 	viper.BindPFlag("dhcp.dns_servers", dhcpCmd.PersistentFlags().Lookup("dhcp-dns-servers"))
 	dhcpCmd.PersistentFlags().StringSlice("dhcp-domain-search", []string{}, "domain name search list")
@@ -39,8 +48,9 @@ func init() {
 }
 
 var (
-	dhcpLog = logger.GetLogger("DHCP")
-	dhcpCmd = &cobra.Command{
+	historyOnce sync.Once
+	dhcpLog     = logger.GetLogger("DHCP")
+	dhcpCmd     = &cobra.Command{
 		Use:   "dhcp",
 		Short: "Run DHCP server",
 		Long:  `Run DHCP server`,
@@ -71,11 +81,45 @@ func serveDHCP(t *tomb.Tomb) error {
 	srv.LeaseTime = leaseTime
 	dhcpLog.Infof("Default lease time: %s", srv.LeaseTime)
 
+	dynamicLease, err := time.ParseDuration(viper.GetString("dhcp.dynamic_lease_time"))
+	if err != nil {
+		return err
+	}
+
+	srv.DynamicLease = dynamicLease
+
+	if Elector != nil {
+		srv.IsLeader = Elector.IsLeader
+		runElector(t)
+	}
+
+	if enrollEnabled() {
+		vault, err := bmc.NewVault(DB, viper.GetString("bmc.credential_key"))
+		if err != nil {
+			return err
+		}
+
+		enroller, err := bmc.NewEnroller(DB, vault)
+		if err != nil {
+			return err
+		}
+
+		srv.Enroll = enroller.Enroll
+		dhcpLog.Infof("Enrolling new BMCs with %d mapped hosts", len(enroller.Mapping))
+	}
+
 	srv.ProxyOnly = viper.GetBool("dhcp.proxy_only")
 	if srv.ProxyOnly {
 		dhcpLog.Infof("Running in ProxyOnly mode")
 	}
 
+	srv.History = viper.GetBool("dhcp.history")
+	if srv.History {
+		if err := pruneDHCPHistory(t); err != nil {
+			return err
+		}
+	}
+
 	t.Go(srv.Serve)
 	t.Go(func() error {
 		time.Sleep(1 * time.Second)
@@ -94,3 +138,45 @@ func serveDHCP(t *tomb.Tomb) error {
 
 	return nil
 }
+
+// enrollEnabled returns true if any subnet enrolls the BMCs handed an address
+// from its dynamic pool
+func enrollEnabled() bool {
+	for _, subnet := range config.Subnets {
+		if subnet.Enroll {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pruneDHCPHistory starts pruning the DHCP boot history recorded by the DHCP,
+// DHCPv6 and PXE servers. Only one pruner runs per process.
+func pruneDHCPHistory(t *tomb.Tomb) error {
+	retention, err := time.ParseDuration(viper.GetString("dhcp.history_retention"))
+	if err != nil {
+		return err
+	}
+
+	historyOnce.Do(func() {
+		dhcpLog.Infof("Recording DHCP boot history for: %s", retention)
+		t.Go(func() error {
+			ticker := time.NewTicker(time.Hour)
+			defer ticker.Stop()
+			for {
+				if err := DB.PruneDHCPEvents(time.Now().Add(-retention)); err != nil {
+					dhcpLog.Errorf("Failed pruning DHCP boot history: %s", err)
+				}
+
+				select {
+				case <-t.Dying():
+					return nil
+				case <-ticker.C:
+				}
+			}
+		})
+	})
+
+	return nil
+}
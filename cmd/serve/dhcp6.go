@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/dhcp"
+	"github.com/ubccr/grendel/internal/logger"
+	"gopkg.in/tomb.v2"
+)
+
+func init() {
+	dhcp6Cmd.PersistentFlags().String("dhcp6-listen", "[::]:547", "address to listen on")
+	viper.BindPFlag("dhcp6.listen", dhcp6Cmd.PersistentFlags().Lookup("dhcp6-listen"))
+	dhcp6Cmd.PersistentFlags().String("dhcp6-lease-time", "24h", "default lease time")
+	viper.BindPFlag("dhcp6.lease_time", dhcp6Cmd.PersistentFlags().Lookup("dhcp6-lease-time"))
+	dhcp6Cmd.PersistentFlags().StringSlice("dhcp6-dns-servers", []string{}, "IPv6 dns servers list")
+	viper.BindPFlag("dhcp6.dns_servers", dhcp6Cmd.PersistentFlags().Lookup("dhcp6-dns-servers"))
+
+	serveCmd.AddCommand(dhcp6Cmd)
+}
+
+var (
+	dhcp6Log = logger.GetLogger("DHCP6")
+	dhcp6Cmd = &cobra.Command{
+		Use:   "dhcp6",
+		Short: "Run DHCPv6 server",
+		Long:  `Run DHCPv6 server`,
+		RunE: func(command *cobra.Command, args []string) error {
+			t := NewInterruptTomb()
+			t.Go(func() error { return serveDHCP6(t) })
+			return t.Wait()
+		},
+	}
+)
+
+func serveDHCP6(t *tomb.Tomb) error {
+	srv, err := dhcp.NewServer6(DB, viper.GetString("dhcp6.listen"))
+	if err != nil {
+		return err
+	}
+
+	leaseTime, err := time.ParseDuration(viper.GetString("dhcp6.lease_time"))
+	if err != nil {
+		return err
+	}
+
+	srv.LeaseTime = leaseTime
+	dhcp6Log.Infof("Default lease time: %s", srv.LeaseTime)
+
+	for _, d := range viper.GetStringSlice("dhcp6.dns_servers") {
+		ip := net.ParseIP(d)
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("Invalid IPv6 dns server address: %s", d)
+		}
+		srv.DNS = append(srv.DNS, ip)
+	}
+
+	if Elector != nil {
+		srv.IsLeader = Elector.IsLeader
+		runElector(t)
+	}
+
+	srv.History = viper.GetBool("dhcp.history")
+	if srv.History {
+		if err := pruneDHCPHistory(t); err != nil {
+			return err
+		}
+	}
+
+	t.Go(srv.Serve)
+	t.Go(func() error {
+		time.Sleep(1 * time.Second)
+		<-t.Dying()
+		dhcp6Log.Info("Shutting down DHCPv6 server...")
+		ctxShutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if err := srv.Shutdown(ctxShutdown); err != nil {
+			dhcp6Log.Errorf("Failed shutting down DHCPv6 server: %s", err)
+			return err
+		}
+
+		return nil
+	})
+
+	return nil
+}
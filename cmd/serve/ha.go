@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/ha"
+	"github.com/ubccr/grendel/internal/logger"
+	"gopkg.in/tomb.v2"
+)
+
+func init() {
+	serveCmd.PersistentFlags().Bool("ha", false, "enable active/standby high availability")
+	viper.BindPFlag("ha.enabled", serveCmd.PersistentFlags().Lookup("ha"))
+	serveCmd.PersistentFlags().String("ha-id", "", "unique id of this instance (default hostname-pid)")
+	viper.BindPFlag("ha.id", serveCmd.PersistentFlags().Lookup("ha-id"))
+	serveCmd.PersistentFlags().String("ha-lease-ttl", ha.DefaultLeaseTTL.String(), "leader lease ttl")
+	viper.BindPFlag("ha.lease_ttl", serveCmd.PersistentFlags().Lookup("ha-lease-ttl"))
+}
+
+var (
+	haLog       = logger.GetLogger("HA")
+	Elector     *ha.Elector
+	electorOnce sync.Once
+)
+
+// setupHA creates the leader elector if high availability is enabled
+func setupHA() error {
+	if !viper.GetBool("ha.enabled") {
+		return nil
+	}
+
+	if viper.GetString("dbtype") != "postgres" {
+		haLog.Warn("High availability requires a database shared by all instances, use dbtype = \"postgres\"")
+	}
+
+	ttl, err := time.ParseDuration(viper.GetString("ha.lease_ttl"))
+	if err != nil {
+		return err
+	}
+
+	Elector, err = ha.NewElector(DB, viper.GetString("ha.id"), ttl)
+	return err
+}
+
+// runElector starts taking part in the leader election. Every instance with
+// high availability enabled takes part whatever services it runs, as DHCP and
+// the inventory and topology collectors of the API all wait to be leader.
+func runElector(t *tomb.Tomb) {
+	if Elector == nil {
+		return
+	}
+
+	electorOnce.Do(func() {
+		t.Go(func() error {
+			return Elector.Run(t.Context(nil))
+		})
+	})
+}
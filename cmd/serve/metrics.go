@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package serve
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/metrics"
+	"gopkg.in/tomb.v2"
+)
+
+func init() {
+	metricsCmd.PersistentFlags().String("metrics-listen", "0.0.0.0:9180", "address to listen on")
+	viper.BindPFlag("metrics.listen", metricsCmd.PersistentFlags().Lookup("metrics-listen"))
+
+	serveCmd.AddCommand(metricsCmd)
+}
+
+var (
+	metricsLog = logger.GetLogger("METRICS")
+	metricsCmd = &cobra.Command{
+		Use:   "metrics",
+		Short: "Run Prometheus metrics server",
+		Long:  `Run Prometheus metrics server`,
+		RunE: func(command *cobra.Command, args []string) error {
+			t := NewInterruptTomb()
+			t.Go(func() error { return serveMetrics(t) })
+			return t.Wait()
+		},
+	}
+)
+
+func serveMetrics(t *tomb.Tomb) error {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+
+	srv := &http.Server{
+		Addr:              viper.GetString("metrics.listen"),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	t.Go(func() error {
+		metricsLog.Infof("Listening on http://%s/metrics", srv.Addr)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			metricsLog.Errorf("Failed to start metrics server: %s", err)
+			return err
+		}
+
+		return nil
+	})
+	t.Go(func() error {
+		<-t.Dying()
+		metricsLog.Info("Shutting down metrics server...")
+		ctxShutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if err := srv.Shutdown(ctxShutdown); err != nil {
+			metricsLog.Errorf("Failed shutting down metrics server: %s", err)
+			return err
+		}
+
+		return nil
+	})
+
+	return nil
+}
@@ -6,11 +6,13 @@ package serve
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/internal/artifact"
 	"github.com/ubccr/grendel/internal/provision"
 	"gopkg.in/tomb.v2"
 )
@@ -31,6 +33,7 @@ func init() {
 }
 
 var (
+	verifyOnce   sync.Once
 	provisionCmd = &cobra.Command{
 		Use:   "provision",
 		Short: "Run Provision server",
@@ -58,6 +61,8 @@ func serveProvision(t *tomb.Tomb) error {
 	srv.CertFile = viper.GetString("provision.cert")
 	srv.RepoDir = viper.GetString("provision.repo_dir")
 
+	verifyArtifacts(t)
+
 	t.Go(func() error {
 		time.Sleep(1 * time.Second)
 		<-t.Dying()
@@ -75,3 +80,20 @@ func serveProvision(t *tomb.Tomb) error {
 
 	return srv.Serve(viper.GetString("provision.default_image"))
 }
+
+// verifyArtifacts verifies the files of all boot images that have a checksum
+// in the background, so boot requests only have to check the cache
+func verifyArtifacts(t *tomb.Tomb) {
+	verifyOnce.Do(func() {
+		t.Go(func() error {
+			images, err := DB.BootImages()
+			if err != nil {
+				cmd.Log.Errorf("Failed to load boot images to verify: %s", err)
+				return nil
+			}
+
+			artifact.VerifyImages(images)
+			return nil
+		})
+	})
+}
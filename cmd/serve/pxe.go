@@ -46,6 +46,13 @@ func servePXE(t *tomb.Tomb) error {
 		return err
 	}
 
+	srv.History = viper.GetBool("dhcp.history")
+	if srv.History {
+		if err := pruneDHCPHistory(t); err != nil {
+			return err
+		}
+	}
+
 	t.Go(func() error {
 		time.Sleep(1 * time.Second)
 		<-t.Dying()
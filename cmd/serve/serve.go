@@ -53,7 +53,7 @@ var (
 func init() {
 	serveCmd.PersistentFlags().String("dbtype", "sqlite", "database backend to use")
 	viper.BindPFlag("dbtype", serveCmd.PersistentFlags().Lookup("dbtype"))
-	serveCmd.PersistentFlags().String("dbpath", ":memory:", "path to database file")
+	serveCmd.PersistentFlags().String("dbpath", ":memory:", "path to database file or postgres connection string")
 	viper.BindPFlag("dbpath", serveCmd.PersistentFlags().Lookup("dbpath"))
 	serveCmd.PersistentFlags().StringVar(&hostsFile, "hosts", "", "path to hosts file")
 	serveCmd.PersistentFlags().StringVar(&imagesFile, "images", "", "path to boot images file")
@@ -75,12 +75,20 @@ func init() {
 			if err != nil {
 				return err
 			}
+			cmd.Log.Infof("Using %s database path: %s", dbType, viper.GetString("dbpath"))
+		case "postgres":
+			// dbpath is a connection string which may contain a password so
+			// it's not logged
+			DB, err = sqlstore.New(viper.GetString("dbpath"), sqlstore.ConfigPostgres)
+			if err != nil {
+				return err
+			}
+			cmd.Log.Infof("Using %s database", dbType)
 		default:
 			cmd.Log.Fatalf("unsupported dbtype: %s", dbType)
 		}
 
-		cmd.Log.Infof("Using %s database path: %s", dbType, viper.GetString("dbpath"))
-		return nil
+		return setupHA()
 	}
 
 	serveCmd.PersistentPostRunE = func(command *cobra.Command, args []string) error {
@@ -161,13 +169,20 @@ func loadImageJSON() error {
 
 func runServices() error {
 	t := NewInterruptTomb()
+	runElector(t)
 	t.Go(func() error {
 		t.Go(func() error { return serveTFTP(t) })
 		t.Go(func() error { return serveDNS(t) })
 		t.Go(func() error { return serveDHCP(t) })
+		if viper.GetBool("dhcp6.enabled") {
+			t.Go(func() error { return serveDHCP6(t) })
+		}
 		t.Go(func() error { return servePXE(t) })
 		t.Go(func() error { return serveAPI(t) })
 		t.Go(func() error { return serveProvision(t) })
+		if viper.GetBool("metrics.enabled") {
+			t.Go(func() error { return serveMetrics(t) })
+		}
 		return nil
 	})
 	return t.Wait()
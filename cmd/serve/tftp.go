@@ -46,6 +46,7 @@ func serveTFTP(t *tomb.Tomb) error {
 		return err
 	}
 
+	verifyArtifacts(t)
 	t.Go(tftpServer.Serve)
 
 	t.Go(func() error {
@@ -15,12 +15,14 @@ import (
 	"github.com/ubccr/grendel/cmd"
 	"github.com/ubccr/grendel/internal/api"
 	"github.com/ubccr/grendel/pkg/client"
+	"github.com/ubccr/grendel/pkg/model"
 	"github.com/ubccr/grendel/pkg/nodeset"
 )
 
 type StatTag struct {
 	provision   *nodeset.NodeSet
 	unprovision *nodeset.NodeSet
+	failed      *nodeset.NodeSet
 }
 
 var (
@@ -48,17 +50,32 @@ var (
 				return cmd.NewApiError(err)
 			}
 
+			stateList, err := gc.GETV1NodesProvision(context.Background(), client.GETV1NodesProvisionParams{
+				Nodeset: req.Nodeset,
+				Tags:    req.Tags,
+			})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			states := make(map[string]client.ProvisionEvent, len(stateList))
+			for _, s := range stateList {
+				states[s.Host.Value] = s
+			}
+
 			stats := make(map[string]*StatTag)
 
 			nodes := 0
 			for _, host := range hostList {
+				failed := host.Provision.Value && states[host.Name.Value].State.Value == model.ProvisionStateFailed
+
 				for _, tag := range host.Tags.Value {
 					if inputTags != "" && !strings.Contains(inputTags, tag) {
 						continue
 					}
 
 					if _, ok := stats[tag]; !ok {
-						stats[tag] = &StatTag{provision: nodeset.EmptyNodeSet(), unprovision: nodeset.EmptyNodeSet()}
+						stats[tag] = &StatTag{provision: nodeset.EmptyNodeSet(), unprovision: nodeset.EmptyNodeSet(), failed: nodeset.EmptyNodeSet()}
 					}
 
 					if host.Provision.Value {
@@ -66,11 +83,14 @@ var (
 					} else {
 						stats[tag].unprovision.Add(host.Name.Value)
 					}
+					if failed {
+						stats[tag].failed.Add(host.Name.Value)
+					}
 				}
 
 				if len(host.Tags.Value) == 0 {
 					if _, ok := stats[""]; !ok {
-						stats[""] = &StatTag{provision: nodeset.EmptyNodeSet(), unprovision: nodeset.EmptyNodeSet()}
+						stats[""] = &StatTag{provision: nodeset.EmptyNodeSet(), unprovision: nodeset.EmptyNodeSet(), failed: nodeset.EmptyNodeSet()}
 					}
 
 					if host.Provision.Value {
@@ -78,6 +98,9 @@ var (
 					} else {
 						stats[""].unprovision.Add(host.Name.Value)
 					}
+					if failed {
+						stats[""].failed.Add(host.Name.Value)
+					}
 				}
 
 				nodes++
@@ -87,7 +110,7 @@ var (
 			fmt.Printf("Nodes: %s\n\n", humanize.Comma(int64(nodes)))
 
 			if nodeLong {
-				fmt.Printf("%-20s%-19s%-17s%-11s%-20s%-25s\n", "Name", "MAC", "IP", "Provision", "Image", "Tags")
+				fmt.Printf("%-20s%-19s%-17s%-11s%-26s%-20s%-25s\n", "Name", "MAC", "IP", "Provision", "State", "Image", "Tags")
 				for _, host := range hostList {
 					ipAddr := ""
 					macAddr := ""
@@ -102,16 +125,25 @@ var (
 						bi = defaultImage
 					}
 
+					state := ""
+					if s, ok := states[host.Name.Value]; ok {
+						state = fmt.Sprintf("%s (%s)", s.State.Value, humanize.Time(s.Time.Value))
+					}
+
 					printer := cyan
 					if host.Provision.Value {
 						printer = yellow
+						if states[host.Name.Value].State.Value == model.ProvisionStateFailed {
+							printer = red
+						}
 					}
 
-					printer.Printf("%-20s%-19s%-17s%-11s%-20s%-25s\n",
+					printer.Printf("%-20s%-19s%-17s%-11s%-26s%-20s%-25s\n",
 						host.Name.Value,
 						macAddr,
 						ipAddr,
 						fmt.Sprintf("%#v", host.Provision.Value),
+						state,
 						bi,
 						strings.Join(host.Tags.Value, ","))
 
@@ -127,6 +159,9 @@ var (
 				}
 				fmt.Printf("Provision: %s\n", stat.provision.String())
 				fmt.Printf("Unprovision: %s\n", stat.unprovision.String())
+				if stat.failed.Len() > 0 {
+					red.Printf("Failed: %s\n", stat.failed.String())
+				}
 				fmt.Println()
 			}
 
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// maxStreamMessage is the largest message read from the event stream, events
+// carry the results of every host of a BMC job
+const maxStreamMessage = 16 * 1024 * 1024
+
+// EventStream reads messages from the API event stream
+type EventStream struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+}
+
+// OpenEventStream connects to the API event stream with the given query
+// filters. Once it returns the server is subscribed, so no messages caused by
+// requests made afterwards are missed.
+func OpenEventStream(ctx context.Context, query url.Values) (*EventStream, error) {
+	httpClient, endpoint, err := newHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	// Streams stay open until closed
+	httpClient.Timeout = 0
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/v1/grendel/events/stream?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if token := viper.GetString("client.api_key"); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		return nil, fmt.Errorf("API Error: status=%d %s", res.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 64*1024), maxStreamMessage)
+
+	return &EventStream{body: res.Body, scanner: scanner}, nil
+}
+
+// Next blocks until the next message arrives. It returns io.EOF once the
+// stream is closed.
+func (s *EventStream) Next() (*model.StreamMessage, error) {
+	var data strings.Builder
+	for s.scanner.Scan() {
+		line := s.scanner.Text()
+		switch {
+		case line == "":
+			if data.Len() == 0 {
+				continue
+			}
+			m := &model.StreamMessage{}
+			if err := json.Unmarshal([]byte(data.String()), m); err != nil {
+				return nil, err
+			}
+			return m, nil
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+
+	if err := s.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
+
+// Close closes the stream
+func (s *EventStream) Close() error {
+	return s.body.Close()
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package topology
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	dryRun     bool
+	adminState string
+	portsCmd   = &cobra.Command{
+		Use:   "ports {nodeset | all}",
+		Short: "Configure the switch ports of nodes",
+		Long: `Set the access VLAN, MTU and description of the intended switch ports of node
+interfaces from their VLAN, MTU and FQDN. Only settings that differ from the
+switch are changed. Supported on switches running Arista EOS, SONiC and Dell
+OS10. Use --dry-run to show the changes without configuring the switches.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset := args[0]
+			if nodeset == "all" {
+				nodeset = ""
+			}
+			params := client.POSTV1SwitchPortsParams{
+				Nodeset:    client.NewOptString(nodeset),
+				Tags:       client.NewOptString(strings.Join(tags, ",")),
+				DryRun:     client.NewOptBool(dryRun),
+				AdminState: client.NewOptString(adminState),
+			}
+			res, err := gc.POSTV1SwitchPorts(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"Status", "Host", "Interface", "Switch", "Port", "Changes"})
+
+			failed := 0
+			for _, port := range res.Ports {
+				if port.Null {
+					continue
+				}
+				p := port.Value
+				changes := strings.Join(p.Changes, "\n")
+				if p.Status.Value == "failed" {
+					failed++
+					changes = p.Error.Value
+				}
+
+				t.AppendRow(table.Row{
+					p.Status.Value,
+					p.Host.Value,
+					p.Ifname.Value,
+					p.Switch.Value,
+					p.Port.Value,
+					changes,
+				})
+			}
+			t.SetStyle(table.StyleLight)
+			if t.Length() > 0 {
+				t.Render()
+			}
+
+			if len(res.Failed) > 0 {
+				cmd.Log.Warnf("Failed to query switches: %s", strings.Join(res.Failed, ","))
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("failed to configure %d switch ports", failed)
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	portsCmd.Flags().StringSliceVarP(&tags, "tags", "t", []string{}, "select nodes by tags")
+	portsCmd.Flags().BoolVar(&dryRun, "dry-run", false, "only show the changes")
+	portsCmd.Flags().StringVar(&adminState, "admin-state", "", "also set the admin state of the ports: up or down")
+
+	topologyCmd.AddCommand(portsCmd)
+}
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package topology
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	switchName  string
+	moved       bool
+	dot         bool
+	tags        []string
+	topologyCmd = &cobra.Command{
+		Use:   "topology",
+		Short: "Show the network topology",
+		Long: `Show the MAC addresses and LLDP neighbors seen on the ports of hosts tagged as
+switches, and the hosts they belong to. Hosts whose MAC address is seen on
+another port than expected are marked moved. --dot prints a Graphviz graph:
+
+  grendel topology --dot | dot -Tsvg > topology.svg`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			if dot {
+				params := client.GETV1TopologyDotParams{
+					Switch: client.NewOptString(switchName),
+					Moved:  client.NewOptBool(moved),
+				}
+				res, err := gc.GETV1TopologyDot(context.Background(), params)
+				if err != nil {
+					return cmd.NewApiError(err)
+				}
+
+				_, err = io.Copy(os.Stdout, res)
+				return err
+			}
+
+			params := client.GETV1TopologyParams{
+				Switch: client.NewOptString(switchName),
+				Moved:  client.NewOptBool(moved),
+			}
+			res, err := gc.GETV1Topology(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"Switch", "Port", "Host", "MAC", "VLAN", "Neighbor", "Expected", "Last Seen"})
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{
+					Name:      "Switch",
+					AutoMerge: true,
+				},
+			})
+
+			for _, link := range res.Links {
+				if link.Null {
+					continue
+				}
+				l := link.Value
+				neighbor := strings.TrimSpace(l.Neighbor.Value + " " + l.NeighborPort.Value)
+				expected := ""
+				if l.Moved.Value {
+					expected = "MOVED from " + l.ExpectedSwitch.Value + " " + l.ExpectedPort.Value
+				}
+				t.AppendRow(table.Row{
+					l.Switch.Value,
+					l.Port.Value,
+					l.Host.Value,
+					l.MAC.Value,
+					l.Vlan.Value,
+					neighbor,
+					expected,
+					l.LastSeen.Value.Local().Format(time.DateTime),
+				})
+			}
+			t.SetStyle(table.StyleLight)
+			t.Render()
+
+			return nil
+		},
+	}
+
+	walkCmd = &cobra.Command{
+		Use:   "walk",
+		Short: "Walk all switches now",
+		Long:  `Query the MAC address tables and LLDP neighbors of all switches now instead of waiting for topology.interval`,
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			res, err := gc.POSTV1TopologyWalk(context.Background(), client.POSTV1TopologyWalkParams{})
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+
+	acceptCmd = &cobra.Command{
+		Use:   "accept {nodeset | all}",
+		Short: "Accept the switch ports nodes are seen on",
+		Long:  `Make the switch ports the MAC addresses of nodes are seen on now their expected ports, for example after recabling`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset := args[0]
+			if nodeset == "all" {
+				nodeset = ""
+			}
+			params := client.POSTV1TopologyAcceptParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.POSTV1TopologyAccept(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			return cmd.NewApiResponse(res)
+		},
+	}
+)
+
+func init() {
+	topologyCmd.Flags().StringVar(&switchName, "switch", "", "only show links seen on this switch")
+	topologyCmd.Flags().BoolVar(&moved, "moved", false, "only show hosts seen on another port than expected")
+	topologyCmd.Flags().BoolVar(&dot, "dot", false, "print the topology as a Graphviz DOT graph")
+
+	acceptCmd.Flags().StringSliceVarP(&tags, "tags", "t", []string{}, "select nodes by tags")
+
+	topologyCmd.AddCommand(walkCmd)
+	topologyCmd.AddCommand(acceptCmd)
+	cmd.Root.AddCommand(topologyCmd)
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package validate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+	"github.com/ubccr/grendel/pkg/client"
+)
+
+var (
+	showAll    bool
+	cablingCmd = &cobra.Command{
+		Use:   "cabling {nodeset | all}",
+		Short: "Validate cabling against the intended switch ports",
+		Long: `Compare the intended switch ports of node interfaces with the MAC address
+tables and LLDP neighbors of their switches. Interfaces seen on another port
+are miswired, interfaces not seen at all missing. Links seen on a port no
+interface is intended to be cabled to are unexpected. Exits with an error if
+any problems are found.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			gc, err := cmd.NewOgenClient()
+			if err != nil {
+				return err
+			}
+
+			nodeset := args[0]
+			if nodeset == "all" {
+				nodeset = ""
+			}
+			params := client.GETV1TopologyCablingParams{
+				Nodeset: client.NewOptString(nodeset),
+				Tags:    client.NewOptString(strings.Join(tags, ",")),
+			}
+			res, err := gc.GETV1TopologyCabling(context.Background(), params)
+			if err != nil {
+				return cmd.NewApiError(err)
+			}
+
+			t := table.NewWriter()
+			t.SetOutputMirror(os.Stdout)
+			t.AppendHeader(table.Row{"Status", "Host", "Interface", "MAC", "Intended", "Seen"})
+
+			problems := 0
+			for _, link := range res.Links {
+				if link.Null {
+					continue
+				}
+				l := link.Value
+				if l.Status.Value != "ok" {
+					problems++
+				} else if !showAll {
+					continue
+				}
+
+				t.AppendRow(table.Row{
+					l.Status.Value,
+					l.Host.Value,
+					l.Ifname.Value,
+					l.MAC.Value,
+					strings.TrimSpace(l.Switch.Value + " " + l.Port.Value),
+					strings.TrimSpace(l.ActualSwitch.Value + " " + l.ActualPort.Value),
+				})
+			}
+			t.SetStyle(table.StyleLight)
+			if t.Length() > 0 {
+				t.Render()
+			}
+
+			if len(res.Failed) > 0 {
+				cmd.Log.Warnf("Failed to query switches: %s", strings.Join(res.Failed, ","))
+			}
+
+			if problems > 0 {
+				return fmt.Errorf("found %d cabling problems", problems)
+			}
+
+			fmt.Printf("Validated cabling of %d interfaces\n", len(res.Links))
+			return nil
+		},
+	}
+)
+
+func init() {
+	cablingCmd.Flags().BoolVar(&showAll, "all", false, "also show interfaces cabled as intended")
+
+	validateCmd.AddCommand(cablingCmd)
+}
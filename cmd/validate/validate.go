@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package validate
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/ubccr/grendel/cmd"
+)
+
+var (
+	tags        []string
+	validateCmd = &cobra.Command{
+		Use:   "validate",
+		Short: "Validate commands",
+		Long:  `Validate commands`,
+	}
+)
+
+func init() {
+	validateCmd.PersistentFlags().StringSliceVarP(&tags, "tags", "t", []string{}, "filter by tags")
+
+	cmd.Root.AddCommand(validateCmd)
+}
@@ -5,8 +5,10 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"slices"
 	"strings"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/stmcginnis/gofish/oem/dell"
 	"github.com/stmcginnis/gofish/schemas"
 	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/pkg/model"
 	"github.com/ubccr/grendel/pkg/nodeset"
 )
@@ -27,6 +30,67 @@ type BmcImportConfigurationRequest struct {
 	File         string `json:"file" description:"template file relative to templates directory" example:"idrac-config.json.tmpl"`
 }
 
+// newBmcJob returns a new BMC job using the job id sent by the client, if any,
+// so the client can follow the results on the event stream as they arrive
+func (h *Handler) newBmcJob(r *http.Request) *bmc.Job {
+	job := bmc.NewJob()
+	job.Vault = h.Vault
+	if id := r.Header.Get(JobIDHeader); id != "" {
+		job.ID = id
+	}
+
+	return job
+}
+
+// bmcJobContext is the part of the request context used to run BMC jobs
+type bmcJobContext interface {
+	Context() context.Context
+	Request() *http.Request
+	QueryParamBool(name string) bool
+}
+
+// runBmcJob runs fn and returns its results, or submits it as a background
+// task on the given server hosts when the async query parameter is set. Async
+// requests return a single queued message with the task id in its data.
+func (h *Handler) runBmcJob(c bmcJobContext, operation string, hosts []string, fn func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error)) (model.JobMessageList, error) {
+	if !c.QueryParamBool("async") {
+		return fn(c.Context(), h.newBmcJob(c.Request()))
+	}
+
+	// Events are written once the task is done, long after the request
+	ctx := context.WithoutCancel(c.Context())
+	task, err := h.Tasks.Submit(operation, requestUser(ctx), hosts, func(job *bmc.Job) (model.JobMessageList, error) {
+		return fn(ctx, job)
+	})
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to submit bmc task",
+		}
+	}
+
+	return model.JobMessageList{
+		{
+			Status: task.Status,
+			Msg:    fmt.Sprintf("Submitted %s task on %d node(s)", operation, len(task.Hosts)),
+			Data:   task.ID,
+		},
+	}, nil
+}
+
+// serverNames returns the names of the hosts BMC jobs run on
+func serverNames(hostList model.HostList) []string {
+	names := make([]string, 0, len(hostList))
+	for _, host := range hostList {
+		if host.HostType() == "server" {
+			names = append(names, host.Name)
+		}
+	}
+
+	return names
+}
+
 func (h *Handler) BmcOsPower(c fuego.ContextWithBody[BmcOsPowerBody]) (model.JobMessageList, error) {
 	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
@@ -55,19 +119,19 @@ func (h *Handler) BmcOsPower(c fuego.ContextWithBody[BmcOsPowerBody]) (model.Job
 		}
 	}
 
-	job := bmc.NewJob()
-
-	output, err := job.PowerControl(hostList, body.BootOption, body.PowerOption)
-	if err != nil {
-		return nil, fuego.HTTPError{
-			Err:    err,
-			Title:  "Error",
-			Detail: "failed to submit redfish job",
+	return h.runBmcJob(c, "power_os", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.PowerControl(hostList, body.BootOption, body.PowerOption)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to submit redfish job",
+			}
 		}
-	}
 
-	h.writeEvent(c.Context(), "Success", "Successfully sent OS power command to node(s)", output...)
-	return output, nil
+		h.writeEvent(ctx, "Success", "Successfully sent OS power command to node(s)", output...)
+		return output, nil
+	})
 }
 
 func (h *Handler) BmcPower(c fuego.ContextNoBody) (model.JobMessageList, error) {
@@ -89,19 +153,19 @@ func (h *Handler) BmcPower(c fuego.ContextNoBody) (model.JobMessageList, error)
 		}
 	}
 
-	job := bmc.NewJob()
-
-	output, err := job.PowerCycleBmc(hostList)
-	if err != nil {
-		return nil, fuego.HTTPError{
-			Err:    err,
-			Title:  "Error",
-			Detail: "failed to reboot bmc",
+	return h.runBmcJob(c, "power_bmc", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.PowerCycleBmc(hostList)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to reboot bmc",
+			}
 		}
-	}
 
-	h.writeEvent(c.Context(), "Success", "Successfully sent BMC power command to node(s)", output...)
-	return output, nil
+		h.writeEvent(ctx, "Success", "Successfully sent BMC power command to node(s)", output...)
+		return output, nil
+	})
 }
 
 func (h *Handler) BmcSelClear(c fuego.ContextNoBody) (model.JobMessageList, error) {
@@ -123,19 +187,19 @@ func (h *Handler) BmcSelClear(c fuego.ContextNoBody) (model.JobMessageList, erro
 		}
 	}
 
-	job := bmc.NewJob()
-
-	output, err := job.ClearSel(hostList)
-	if err != nil {
-		return nil, fuego.HTTPError{
-			Err:    err,
-			Title:  "Error",
-			Detail: "failed to submit redfish job",
+	return h.runBmcJob(c, "clear_sel", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.ClearSel(hostList)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to submit redfish job",
+			}
 		}
-	}
 
-	h.writeEvent(c.Context(), "Success", "Successfully sent SEL Clear command to node(s)", output...)
-	return output, nil
+		h.writeEvent(ctx, "Success", "Successfully sent SEL Clear command to node(s)", output...)
+		return output, nil
+	})
 }
 
 func (h *Handler) BmcJobList(c fuego.ContextNoBody) (model.RedfishJobList, error) {
@@ -157,7 +221,7 @@ func (h *Handler) BmcJobList(c fuego.ContextNoBody) (model.RedfishJobList, error
 		}
 	}
 
-	job := bmc.NewJob()
+	job := h.newBmcJob(c.Request())
 
 	output, err := job.GetJobs(hostList)
 	if err != nil {
@@ -190,20 +254,20 @@ func (h *Handler) BmcJobDelete(c fuego.ContextNoBody) (model.JobMessageList, err
 		}
 	}
 
-	job := bmc.NewJob()
-
 	jids := strings.Split(c.PathParam("jids"), ",")
-	output, err := job.ClearJobs(hostList, jids)
-	if err != nil {
-		return nil, fuego.HTTPError{
-			Err:    err,
-			Title:  "Error",
-			Detail: "failed to clear jobs",
+	return h.runBmcJob(c, "clear_jobs", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.ClearJobs(hostList, jids)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to clear jobs",
+			}
 		}
-	}
 
-	h.writeEvent(c.Context(), "Success", "Successfully sent job delete command to node(s)", output...)
-	return output, nil
+		h.writeEvent(ctx, "Success", "Successfully sent job delete command to node(s)", output...)
+		return output, nil
+	})
 }
 
 type BmcJobDeleteRequest struct {
@@ -221,6 +285,7 @@ func (h *Handler) BmcJobDeleteMany(c fuego.ContextWithBody[BmcJobDeleteRequest])
 	}
 
 	nodeJobList := make(map[*model.Host][]string)
+	hosts := make([]string, 0, len(body.NodeJobList))
 
 	for nodeName, jids := range body.NodeJobList {
 		ns, err := nodeset.NewNodeSet(nodeName)
@@ -248,21 +313,24 @@ func (h *Handler) BmcJobDeleteMany(c fuego.ContextWithBody[BmcJobDeleteRequest])
 		}
 
 		nodeJobList[hostList[0]] = jids
+		if hostList[0].HostType() == "server" {
+			hosts = append(hosts, hostList[0].Name)
+		}
 	}
 
-	job := bmc.NewJob()
-
-	output, err := job.ClearManyJobs(nodeJobList)
-	if err != nil {
-		return nil, fuego.HTTPError{
-			Err:    err,
-			Title:  "Error",
-			Detail: "failed to clear jobs",
+	return h.runBmcJob(c, "clear_jobs", hosts, func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.ClearManyJobs(nodeJobList)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to clear jobs",
+			}
 		}
-	}
 
-	h.writeEvent(c.Context(), "Success", "Successfully sent job delete command to node(s)", output...)
-	return output, nil
+		h.writeEvent(ctx, "Success", "Successfully sent job delete command to node(s)", output...)
+		return output, nil
+	})
 }
 
 func (h *Handler) BmcQuery(c fuego.ContextNoBody) (model.RedfishSystemList, error) {
@@ -284,7 +352,7 @@ func (h *Handler) BmcQuery(c fuego.ContextNoBody) (model.RedfishSystemList, erro
 		}
 	}
 
-	job := bmc.NewJob()
+	job := h.newBmcJob(c.Request())
 
 	output, err := job.BmcStatus(hostList)
 	if err != nil {
@@ -354,19 +422,19 @@ func (h *Handler) BmcAutoConfigure(c fuego.ContextNoBody) (model.JobMessageList,
 		}
 	}
 
-	job := bmc.NewJob()
-
-	output, err := job.BmcAutoConfigure(hostList)
-	if err != nil {
-		return nil, fuego.HTTPError{
-			Err:    err,
-			Title:  "Error",
-			Detail: "failed to get metric reports",
+	return h.runBmcJob(c, "configure_auto", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.BmcAutoConfigure(hostList)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to get metric reports",
+			}
 		}
-	}
 
-	h.writeEvent(c.Context(), "Success", "Successfully sent BMC AutoConfigure command to node(s)", output...)
-	return output, nil
+		h.writeEvent(ctx, "Success", "Successfully sent BMC AutoConfigure command to node(s)", output...)
+		return output, nil
+	})
 }
 
 func (h *Handler) BmcImportConfiguration(c fuego.ContextWithBody[BmcImportConfigurationRequest]) (model.JobMessageList, error) {
@@ -397,19 +465,19 @@ func (h *Handler) BmcImportConfiguration(c fuego.ContextWithBody[BmcImportConfig
 		}
 	}
 
-	job := bmc.NewJob()
-
-	output, err := job.BmcImportConfiguration(hostList, body.ShutdownType, body.File)
-	if err != nil {
-		return nil, fuego.HTTPError{
-			Err:    err,
-			Title:  "Error",
-			Detail: "failed to get metric reports",
+	return h.runBmcJob(c, "configure_import", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.BmcImportConfiguration(hostList, body.ShutdownType, body.File)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to get metric reports",
+			}
 		}
-	}
 
-	h.writeEvent(c.Context(), "Success", "Successfully sent BMC Import Configuration to node(s)", output...)
-	return output, nil
+		h.writeEvent(ctx, "Success", "Successfully sent BMC Import Configuration to node(s)", output...)
+		return output, nil
+	})
 }
 
 func (h *Handler) BmcMetricReports(c fuego.ContextNoBody) (model.RedfishMetricReportList, error) {
@@ -431,7 +499,7 @@ func (h *Handler) BmcMetricReports(c fuego.ContextNoBody) (model.RedfishMetricRe
 		}
 	}
 
-	job := bmc.NewJob()
+	job := h.newBmcJob(c.Request())
 
 	output, err := job.BmcGetMetricReports(hostList)
 	if err != nil {
@@ -503,36 +571,96 @@ func (h *Handler) BmcDellInstallFromRepo(c fuego.ContextWithBody[BmcDellInstallF
 		}
 	}
 
-	job := bmc.NewJob()
+	return h.runBmcJob(c, "dell_install_from_repo", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		if body.ClearJobQueue && body.ApplyUpdate {
+			jl, err := job.ClearJobs(hostList, []string{"JID_CLEARALL"})
+			if err != nil {
+				return nil, fuego.HTTPError{
+					Err:    err,
+					Title:  "Error",
+					Detail: "failed to clear job queue",
+				}
+			}
+			h.writeEvent(ctx, "Success", "Successfully sent clear job request", jl...)
+		}
 
-	if body.ClearJobQueue && body.ApplyUpdate {
-		jl, err := job.ClearJobs(hostList, []string{"JID_CLEARALL"})
+		output, err := job.DellInstallFromRepo(hostList, redfishBody)
 		if err != nil {
 			return nil, fuego.HTTPError{
 				Err:    err,
 				Title:  "Error",
-				Detail: "failed to clear job queue",
+				Detail: "failed to get firmware list",
 			}
 		}
-		h.writeEvent(c.Context(), "Success", "Successfully sent clear job request", jl...)
+
+		if body.ApplyUpdate {
+			h.writeEvent(ctx, "Success", "Successfully sent firmware upgrade to node(s)", output...)
+		}
+		return output, nil
+	})
+}
+
+func (h *Handler) BmcDellGetRepoUpdateList(c fuego.ContextNoBody) (model.RedfishDellUpgradeFirmwareList, error) {
+	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
 	}
 
-	output, err := job.DellInstallFromRepo(hostList, redfishBody)
+	hostList, err := h.DB.FindHosts(ns)
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
 			Title:  "Error",
-			Detail: "failed to get firmware list",
+			Detail: "failed to find nodes",
 		}
 	}
 
-	if body.ApplyUpdate {
-		h.writeEvent(c.Context(), "Success", "Successfully sent firmware upgrade to node(s)", output...)
+	job := h.newBmcJob(c.Request())
+
+	output, err := job.DellGetRepoUpdateList(hostList)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get firmware list",
+		}
 	}
+
 	return output, nil
 }
 
-func (h *Handler) BmcDellGetRepoUpdateList(c fuego.ContextNoBody) (model.RedfishDellUpgradeFirmwareList, error) {
+type BmcFirmwareUpdateRequest struct {
+	Image       string   `json:"image" description:"firmware image file in the provision repo dir, or a URL for simple updates"`
+	Method      string   `json:"method" description:"simple = BMC downloads the image with SimpleUpdate, push = upload the image to the BMC. Defaults to simple"`
+	Targets     []string `json:"targets" description:"software inventory URIs to update, all applicable components when empty"`
+	ForceUpdate bool     `json:"force_update" description:"bypass update policies such as downgrade protection"`
+	ApplyTime   string   `json:"apply_time" description:"Redfish apply time of push updates, e.g. Immediate or OnReset"`
+}
+
+func (h *Handler) BmcFirmwareUpdate(c fuego.ContextWithBody[BmcFirmwareUpdateRequest]) (model.JobMessageList, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	update, err := bmc.NewUpdateRequest(body.Method, body.Image, body.Targets, body.ForceUpdate, body.ApplyTime)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("invalid firmware update: %s", err),
+		}
+	}
+
 	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
 	if err != nil {
 		return nil, fuego.HTTPError{
@@ -551,16 +679,184 @@ func (h *Handler) BmcDellGetRepoUpdateList(c fuego.ContextNoBody) (model.Redfish
 		}
 	}
 
-	job := bmc.NewJob()
+	return h.runBmcJob(c, "firmware_update", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.UpdateFirmware(hostList, update)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to update firmware",
+			}
+		}
 
-	output, err := job.DellGetRepoUpdateList(hostList)
+		h.writeEvent(ctx, "Success", fmt.Sprintf("Sent firmware update %s to node(s)", body.Image), output...)
+		return output, nil
+	})
+}
+
+type BmcFirmwareTasksRequest struct {
+	Tasks map[string]string `json:"tasks" description:"task URI returned by the firmware update of each node, by node name"`
+}
+
+func (h *Handler) BmcFirmwareTasks(c fuego.ContextWithBody[BmcFirmwareTasksRequest]) (model.RedfishFirmwareTaskList, error) {
+	body, err := c.Body()
 	if err != nil {
 		return nil, fuego.HTTPError{
 			Err:    err,
 			Title:  "Error",
-			Detail: "failed to get firmware list",
+			Detail: "failed to parse body",
+		}
+	}
+
+	names := make([]string, 0, len(body.Tasks))
+	for name := range body.Tasks {
+		names = append(names, name)
+	}
+
+	ns, err := nodeset.NewNodeSet(strings.Join(names, ","))
+	if err != nil || len(names) == 0 {
+		return nil, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    err,
+			Title:  "Error",
+			Detail: "invalid node names in tasks",
+		}
+	}
+
+	hostList, err := h.DB.FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	job := h.newBmcJob(c.Request())
+
+	output, err := job.FirmwareTasks(hostList, body.Tasks)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get firmware update tasks",
 		}
 	}
 
 	return output, nil
 }
+
+type BmcMediaInsertRequest struct {
+	Image string `json:"image" description:"ISO in the provision repo dir, or a URL" example:"rocky-9.5-x86_64-minimal.iso"`
+	Boot  bool   `json:"boot" description:"restart the node from the virtual media once inserted"`
+}
+
+func (h *Handler) BmcMediaInsert(c fuego.ContextWithBody[BmcMediaInsertRequest]) (model.JobMessageList, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	image, err := bmc.NewMediaImage(body.Image)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("invalid media image: %s", err),
+		}
+	}
+
+	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hostList, err := h.DB.FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	return h.runBmcJob(c, "media_insert", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.InsertMedia(hostList, image, body.Boot)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to insert virtual media",
+			}
+		}
+
+		h.recordMedia(output, image)
+		h.writeEvent(ctx, "Success", fmt.Sprintf("Inserted virtual media %s on node(s)", body.Image), output...)
+		return output, nil
+	})
+}
+
+func (h *Handler) BmcMediaEject(c fuego.ContextNoBody) (model.JobMessageList, error) {
+	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hostList, err := h.DB.FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	return h.runBmcJob(c, "media_eject", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.EjectMedia(hostList)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to eject virtual media",
+			}
+		}
+
+		h.recordMedia(output, "")
+		h.writeEvent(ctx, "Success", "Ejected virtual media on node(s)", output...)
+		return output, nil
+	})
+}
+
+// recordMedia records the virtual media inserted on the nodes the job
+// succeeded on, or deletes it if image is empty, so provisioning can eject
+// the media when it completes
+func (h *Handler) recordMedia(output model.JobMessageList, image string) {
+	for _, m := range output {
+		if m.Status != "success" {
+			continue
+		}
+
+		var err error
+		if image != "" {
+			err = h.DB.StoreBmcMedia(&model.BmcMedia{Host: m.Host, Image: image})
+		} else {
+			err = h.DB.DeleteBmcMedia(m.Host)
+		}
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			log.Errorf("Failed to record the virtual media of %s: %s", m.Host, err)
+		}
+	}
+}
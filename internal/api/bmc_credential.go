@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// DefaultBmcPasswordLength is the length of rotated BMC passwords when no
+// length is given
+const DefaultBmcPasswordLength = 16
+
+func (h *Handler) BmcCredentialList(c fuego.ContextNoBody) (model.BmcCredentialList, error) {
+	creds, err := h.DB.BmcCredentials()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get bmc credentials",
+		}
+	}
+
+	for _, cred := range creds {
+		cred.Password = ""
+	}
+
+	return creds, nil
+}
+
+type BmcCredentialRequest struct {
+	Scope    string `json:"scope" description:"host or tag" example:"host"`
+	Name     string `json:"name" description:"host name or tag" example:"cpn-d13-02"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+func (h *Handler) BmcCredentialStore(c fuego.ContextWithBody[BmcCredentialRequest]) (*GenericResponse, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	if body.Scope == model.BmcCredentialPending {
+		return nil, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "pending bmc credentials are only stored by rotate",
+		}
+	}
+
+	err = h.Vault.Set(body.Scope, body.Name, body.Username, body.Password)
+	if errors.Is(err, bmc.ErrVaultDisabled) || errors.Is(err, store.ErrInvalidData) {
+		return nil, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    err,
+			Title:  "Error",
+			Detail: err.Error(),
+		}
+	}
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to store bmc credential",
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Stored BMC credential of %s %s", body.Scope, body.Name))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  "stored bmc credential",
+		Changed: 1,
+	}, nil
+}
+
+func (h *Handler) BmcCredentialDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
+	scope := c.PathParam("scope")
+	name := c.PathParam("name")
+
+	err := h.DB.DeleteBmcCredential(scope, name)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, fuego.HTTPError{
+			Status: http.StatusNotFound,
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("bmc credential not found: %s %s", scope, name),
+		}
+	}
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to delete bmc credential",
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Deleted BMC credential of %s %s", scope, name))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  "deleted bmc credential",
+		Changed: 1,
+	}, nil
+}
+
+type BmcCredentialRotateRequest struct {
+	Factory         bool   `json:"factory" description:"log in with the factory default credentials"`
+	CurrentUsername string `json:"current_username" description:"log in with this user name instead of the node's credentials. The password of this account is rotated"`
+	CurrentPassword string `json:"current_password"`
+	Length          int    `json:"length" description:"length of the new passwords" example:"16"`
+}
+
+func (h *Handler) BmcCredentialRotate(c fuego.ContextWithBody[BmcCredentialRotateRequest]) (model.JobMessageList, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	if !h.Vault.Enabled() {
+		return nil, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    bmc.ErrVaultDisabled,
+			Title:  "Error",
+			Detail: bmc.ErrVaultDisabled.Error(),
+		}
+	}
+
+	req := &bmc.RotateRequest{
+		Username: body.CurrentUsername,
+		Password: body.CurrentPassword,
+		Length:   body.Length,
+	}
+	if body.Factory {
+		req.Username = bmc.FactoryUser
+		req.Password = bmc.FactoryPassword
+	}
+	if req.Length == 0 {
+		req.Length = DefaultBmcPasswordLength
+	}
+
+	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hostList, err := h.DB.FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	return h.runBmcJob(c, "rotate_credentials", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.RotateCredentials(hostList, req)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to rotate bmc credentials",
+			}
+		}
+
+		h.writeEvent(ctx, "Success", "Rotated BMC credentials of node(s)", output...)
+		return output, nil
+	})
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// DefaultBmcTaskLimit is the number of BMC tasks returned when no limit is
+// given
+const DefaultBmcTaskLimit = 50
+
+func (h *Handler) BmcTaskList(c fuego.ContextNoBody) (model.BmcTaskList, error) {
+	limit, err := parseIntParam(c, "limit", DefaultBmcTaskLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := h.DB.BmcTasks(c.QueryParam("status"), limit)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get bmc tasks",
+		}
+	}
+
+	return tasks, nil
+}
+
+func (h *Handler) BmcTaskGet(c fuego.ContextNoBody) (*model.BmcTask, error) {
+	return h.loadBmcTask(c.PathParam("id"))
+}
+
+func (h *Handler) BmcTaskCancel(c fuego.ContextNoBody) (*GenericResponse, error) {
+	task, err := h.loadBmcTask(c.PathParam("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	if task.Done() {
+		return nil, fuego.HTTPError{
+			Status: http.StatusConflict,
+			Title:  "Error",
+			Detail: fmt.Sprintf("task already %s", task.Status),
+		}
+	}
+
+	if task.Owner != h.Tasks.Owner {
+		return nil, fuego.HTTPError{
+			Status: http.StatusConflict,
+			Title:  "Error",
+			Detail: fmt.Sprintf("task is running on %s", task.Owner),
+		}
+	}
+
+	if err := h.Tasks.Cancel(task.ID); err != nil {
+		return nil, fuego.HTTPError{
+			Status: http.StatusConflict,
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to cancel task",
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Canceled %s task %s", task.Operation, task.ID))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  "canceled bmc task",
+		Changed: 1,
+	}, nil
+}
+
+func (h *Handler) loadBmcTask(id string) (*model.BmcTask, error) {
+	task, err := h.DB.LoadBmcTask(id)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, fuego.HTTPError{
+			Status: http.StatusNotFound,
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("bmc task not found: %s", id),
+		}
+	}
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get bmc task",
+		}
+	}
+
+	return task, nil
+}
@@ -5,11 +5,14 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"slices"
 	"strings"
 
 	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/artifact"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
@@ -27,6 +30,17 @@ func (h *Handler) BootImageAdd(c fuego.ContextWithBody[BootImageAddRequest]) (*G
 		}
 	}
 
+	for _, image := range images.BootImages {
+		if err := artifact.Resolve(image); err != nil {
+			return nil, fuego.HTTPError{
+				Status: http.StatusBadRequest,
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("invalid artifact in image %s: %s", image.Name, err),
+			}
+		}
+	}
+
 	err = h.DB.StoreBootImages(images.BootImages)
 	if err != nil {
 		return nil, fuego.HTTPError{
@@ -36,6 +50,11 @@ func (h *Handler) BootImageAdd(c fuego.ContextWithBody[BootImageAddRequest]) (*G
 		}
 	}
 
+	h.gcArtifacts()
+
+	// Hash the new files now rather than in the first boot requests
+	go artifact.VerifyImages(images.BootImages)
+
 	var names []string
 	for _, image := range images.BootImages {
 		names = append(names, image.Name)
@@ -100,9 +119,71 @@ func (h *Handler) BootImageDelete(c fuego.ContextNoBody) (*GenericResponse, erro
 
 	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully deleted image(s): %s", names))
 
+	h.gcArtifacts()
+
 	return &GenericResponse{
 		Title:   "Success",
 		Detail:  "successfully deleted image(s)",
 		Changed: len(names),
 	}, err
 }
+
+// ArtifactUpload stores the request body as a boot image artifact under its
+// SHA-256 checksum
+func (h *Handler) ArtifactUpload(w http.ResponseWriter, r *http.Request) {
+	a, err := artifact.Put(h.DB, r.Body)
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to store artifact",
+		})
+		return
+	}
+
+	h.writeEvent(r.Context(), "Success", fmt.Sprintf("Successfully stored artifact %s (%d bytes)", a.SHA256, a.Size))
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(a)
+}
+
+func (h *Handler) ArtifactList(c fuego.ContextNoBody) (model.ArtifactList, error) {
+	artifacts, err := h.DB.Artifacts()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get artifacts",
+		}
+	}
+
+	for _, a := range artifacts {
+		a.Path = artifact.Path(a.SHA256)
+	}
+
+	return artifacts, nil
+}
+
+func (h *Handler) ArtifactGC(c fuego.ContextNoBody) (model.ArtifactList, error) {
+	deleted, err := artifact.GC(h.DB)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to delete unreferenced artifacts",
+		}
+	}
+
+	if len(deleted) > 0 {
+		h.writeEvent(c.Context(), "Success", fmt.Sprintf("Deleted %d unreferenced artifact(s)", len(deleted)))
+	}
+
+	return deleted, nil
+}
+
+// gcArtifacts deletes the artifacts no longer referenced by any image
+func (h *Handler) gcArtifacts() {
+	if _, err := artifact.GC(h.DB); err != nil {
+		log.Errorf("Failed to delete unreferenced artifacts: %s", err)
+	}
+}
@@ -7,6 +7,10 @@ package api
 const (
 	DefaultPort = 8080
 
+	// JobIDHeader is the request header a client can set to pick the id of
+	// the BMC job results sent on the event stream
+	JobIDHeader = "X-Grendel-Job-ID"
+
 	ContextKeyUsername GrendelAuthContext = "username"
 	ContextKeyRole     GrendelAuthContext = "role"
 )
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func (h *Handler) DHCPLeaseList(c fuego.ContextNoBody) (model.DHCPLeaseList, error) {
+	leases, err := h.DB.DHCPLeases()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get dhcp leases",
+		}
+	}
+
+	return leases, nil
+}
+
+func (h *Handler) NodeHistory(c fuego.ContextNoBody) (model.DHCPEventList, error) {
+	since := time.Now().Add(-24 * time.Hour)
+	if s := c.QueryParam("since"); s != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Status: http.StatusBadRequest,
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("invalid since timestamp: %s", s),
+			}
+		}
+	}
+
+	events, err := h.DB.DHCPEvents(c.PathParam("name"), since)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get node history",
+		}
+	}
+
+	return events, nil
+}
@@ -6,30 +6,153 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/stream"
 	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
 )
 
+// DefaultEventLimit is the number of events returned when no limit is given
+const DefaultEventLimit = 50
+
+// MaxEventLimit is the most events returned by a single request
+const MaxEventLimit = 1000
+
+// unixSocketUser is recorded as the user of events from requests made over
+// the unix socket, which are not authenticated
+const unixSocketUser = "unix-socket"
+
 func (h *Handler) GetEvents(c fuego.ContextNoBody) (model.EventList, error) {
-	return h.Events.GetEvents(), nil
+	filter := model.EventFilter{
+		User:     c.QueryParam("user"),
+		Severity: c.QueryParam("severity"),
+		Host:     c.QueryParam("host"),
+		Limit:    DefaultEventLimit,
+	}
+
+	var err error
+	if filter.Since, err = parseTimeParam(c, "since"); err != nil {
+		return nil, err
+	}
+	if filter.Until, err = parseTimeParam(c, "until"); err != nil {
+		return nil, err
+	}
+	if filter.Limit, err = parseIntParam(c, "limit", DefaultEventLimit); err != nil {
+		return nil, err
+	}
+	if filter.Limit < 1 || filter.Limit > MaxEventLimit {
+		return nil, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("invalid limit: must be between 1 and %d", MaxEventLimit),
+		}
+	}
+	if filter.Offset, err = parseIntParam(c, "offset", 0); err != nil {
+		return nil, err
+	}
+
+	events, err := h.DB.Events(filter)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get events",
+		}
+	}
+
+	return events, nil
 }
 
-func (h *Handler) writeEvent(ctx context.Context, severity, msg string, jobMessages ...model.JobMessage) {
-	username, ok := ctx.Value(ContextKeyUsername).(string)
-	if !ok {
-		log.Warn("failed to get username from http context, ignoring writeEvent")
-		return
+func parseTimeParam(c fuego.ContextNoBody, name string) (time.Time, error) {
+	s := c.QueryParam(name)
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("invalid %s timestamp: %s", name, s),
+		}
+	}
+
+	return t, nil
+}
+
+func parseIntParam(c fuego.ContextNoBody, name string, def int) (int, error) {
+	s := c.QueryParam(name)
+	if s == "" {
+		return def, nil
+	}
+
+	i, err := strconv.Atoi(s)
+	if err != nil || i < 0 {
+		return 0, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("invalid %s: %s", name, s),
+		}
 	}
 
-	newEvent := model.Event{
+	return i, nil
+}
+
+func (h *Handler) writeEvent(ctx context.Context, severity, msg string, jobMessages ...model.JobMessage) {
+	h.storeEvent(ctx, model.Event{
 		Severity:    severity,
-		User:        username,
-		Time:        time.Now().UTC(),
 		Message:     msg,
 		JobMessages: jobMessages,
+	})
+}
+
+// writeNodeEvent records an event referring to the nodes in the given nodeset.
+// A nil nodeset refers to all nodes and records no hosts.
+func (h *Handler) writeNodeEvent(ctx context.Context, severity, msg string, ns *nodeset.NodeSet) {
+	if ns == nil {
+		h.writeEvent(ctx, severity, msg)
+		return
+	}
+
+	h.storeEvent(ctx, model.Event{
+		Severity: severity,
+		Message:  msg,
+		Hosts:    ns.Iterator().StringSlice(),
+	})
+}
+
+// requestUser returns the user making the request
+func requestUser(ctx context.Context) string {
+	username, ok := ctx.Value(ContextKeyUsername).(string)
+	if !ok {
+		return unixSocketUser
+	}
+
+	return username
+}
+
+func (h *Handler) storeEvent(ctx context.Context, event model.Event) {
+	event.User = requestUser(ctx)
+	event.Time = time.Now().UTC()
+
+	for _, m := range event.JobMessages {
+		if m.Host != "" && !slices.Contains(event.Hosts, m.Host) {
+			event.Hosts = append(event.Hosts, m.Host)
+		}
+	}
+
+	if err := h.DB.StoreEvent(&event); err != nil {
+		log.Errorf("failed to store event: %s", err)
 	}
 
-	h.Events.StoreEvents(newEvent)
+	stream.PublishEvent(event)
 }
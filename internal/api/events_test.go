@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-fuego/fuego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEventsLimit(t *testing.T) {
+	assert := assert.New(t)
+
+	h := newTestHandler(t)
+
+	for _, limit := range []string{"0", "-1", "1001", "all"} {
+		c := fuego.NewMockContextNoBody()
+		c.SetQueryParam("limit", limit)
+
+		_, err := h.GetEvents(c)
+
+		var httpErr fuego.HTTPError
+		if assert.ErrorAs(err, &httpErr, limit) {
+			assert.Equal(http.StatusBadRequest, httpErr.StatusCode(), limit)
+		}
+	}
+
+	c := fuego.NewMockContextNoBody()
+	c.SetQueryParam("limit", "1000")
+
+	_, err := h.GetEvents(c)
+	assert.NoError(err)
+}
@@ -12,18 +12,35 @@ import (
 	"github.com/go-fuego/fuego/option"
 	"github.com/go-fuego/fuego/param"
 	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/internal/ha"
 	"github.com/ubccr/grendel/internal/store"
-	"github.com/ubccr/grendel/internal/store/eventstore"
+	"github.com/ubccr/grendel/pkg/model"
 )
 
 type Handler struct {
-	DB     store.Store
-	Events eventstore.Store
+	DB    store.Store
+	HA    *ha.Elector
+	Tasks *bmc.TaskQueue
+	Vault *bmc.Vault
 }
 
 func NewHandler(db store.Store) (*Handler, error) {
+	tasks, err := bmc.NewTaskQueue(db, viper.GetString("ha.id"), viper.GetInt("bmc.max_tasks"))
+	if err != nil {
+		return nil, err
+	}
+
+	vault, err := bmc.NewVault(db, viper.GetString("bmc.credential_key"))
+	if err != nil {
+		return nil, err
+	}
+	tasks.Vault = vault
+
 	h := &Handler{
-		DB: db,
+		DB:    db,
+		Tasks: tasks,
+		Vault: vault,
 	}
 
 	return h, nil
@@ -43,7 +60,16 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 
 	// Params
 	filterNodes := fuego.GroupOptions(option.Query("nodeset", "Filter by nodeset. Minimum of one query parameter is required", nsExample), option.Query("tags", "Filter by tags. Minimum of one query parameter is required", param.Example("tags", "a01,ib,test")))
+	async := option.QueryBool("async", "Run in the background and return the id of the task in the data of a single queued message")
 	filterNames := fuego.GroupOptions(option.Query("names", "Filter by name", param.Example("names", "image1,image2")))
+	artifactRequestBody := func(r *fuego.BaseRoute) {
+		r.Operation.RequestBody = &openapi3.RequestBodyRef{
+			Value: openapi3.NewRequestBody().
+				WithRequired(true).
+				WithDescription("Contents of the file").
+				WithContent(openapi3.NewContentWithSchema(openapi3.NewStringSchema().WithFormat("binary"), []string{"application/octet-stream"})),
+		}
+	}
 
 	globalOptions := fuego.GroupOptions(
 		option.RequestContentType("application/json"),
@@ -68,9 +94,28 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 	bmc := fuego.Group(v1, "/bmc", option.Middleware(h.authMiddleware), globalOptions)
 	roles := fuego.Group(v1, "/roles", option.Middleware(h.authMiddleware), globalOptions)
 	sw := fuego.Group(v1, "/switch", option.Middleware(h.authMiddleware), globalOptions)
+	dhcp := fuego.Group(v1, "/dhcp", option.Middleware(h.authMiddleware), globalOptions)
+	topology := fuego.Group(v1, "/topology", option.Middleware(h.authMiddleware), globalOptions)
 
 	// Routes
-	fuego.Get(grendel, "/events", h.GetEvents)
+	fuego.Get(grendel, "/events", h.GetEvents,
+		option.Description("Get audit events, newest first"),
+		option.Query("user", "Filter by username", param.Example("user", "admin")),
+		option.Query("severity", "Filter by severity", param.Example("severity", "Success")),
+		option.Query("host", "Filter by host name", param.Example("host", "cpn-i10-04")),
+		option.Query("since", "Only return events at or after this RFC3339 timestamp", param.Example("since", "2025-01-02T15:04:05Z")),
+		option.Query("until", "Only return events at or before this RFC3339 timestamp", param.Example("until", "2025-01-03T15:04:05Z")),
+		option.QueryInt("limit", "Maximum number of events to return, at most 1000. Defaults to 50", param.Example("limit", 50)),
+		option.QueryInt("offset", "Number of events to skip", param.Example("offset", 0)),
+	)
+	fuego.GetStd(grendel, "/events/stream", h.EventStream,
+		option.Description("Stream audit events, BMC job results and provision state changes as server-sent events"),
+		option.Query("types", "Filter by message type", param.Example("types", "event,job,provision")),
+		option.Query("host", "Filter by host name", param.Example("host", "cpn-i10-04")),
+		option.Query("job", "Filter by BMC job id, set by the client with the X-Grendel-Job-ID request header", param.Example("job", "2fPFv0b5hOXGqJlzIq2qyDe6ANv")),
+		fuego.OptionAddResponse(http.StatusOK, "Stream of server-sent events, the data of each event is a StreamMessage", fuego.Response{Type: model.StreamMessage{}, ContentTypes: []string{"text/event-stream"}}),
+	)
+	fuego.Get(grendel, "/status", h.GrendelStatus, option.Description("Get the status of this Grendel instance and the current HA leader"))
 
 	fuego.Post(nodes, "", h.NodeAdd, option.Description("Add nodes"))
 	fuego.Get(nodes, "", h.NodeList, option.Description("List all nodes"))
@@ -86,6 +131,18 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 		option.Description("Provision / Unprovision nodes by nodeset and/or tags"),
 		filterNodes,
 	)
+	fuego.Get(nodes, "/provision", h.NodeProvisionStates,
+		option.Description("Get the current provision state of nodes by nodeset and/or tags"),
+		filterNodes,
+	)
+	fuego.Get(nodes, "/provision/{name}", h.NodeProvisionHistory,
+		option.Description("Get the provision states of a node since it was last set to provision"),
+		option.Path("name", "node name", param.Example("name", "cpn-i10-04")),
+	)
+	fuego.Get(nodes, "/logs/{name}", h.NodeLogs,
+		option.Description("Get the install progress messages and logs sent by a node since it was last set to provision"),
+		option.Path("name", "node name", param.Example("name", "cpn-i10-04")),
+	)
 	fuego.Patch(nodes, "/tags/{action}", h.NodeTags,
 		option.Description("Update nodes tags by nodeset and/or tags"),
 		option.Path("action", "option to add or remove tags", param.Example("action", "add | remove")),
@@ -100,11 +157,56 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 		option.Description("Update nodes boot image by nodeset and/or tags"),
 		filterNodes,
 	)
+	fuego.Get(nodes, "/history/{name}", h.NodeHistory,
+		option.Description("Get the DHCP, DHCPv6 and proxy PXE messages sent to and received from a node, oldest first"),
+		option.Path("name", "node name", param.Example("name", "cpn-i10-04")),
+		option.Query("since", "Only return events after this RFC3339 timestamp. Defaults to the last 24 hours", param.Example("since", "2025-01-02T15:04:05Z")),
+	)
+
+	fuego.Get(nodes, "/inventory", h.NodeInventory,
+		option.Description("Get the hardware inventory of nodes collected from their BMCs"),
+		option.Query("nodeset", "Filter by nodeset", nsExample),
+		option.Query("tags", "Filter by tags", param.Example("tags", "a01,ib,test")),
+		option.Query("kind", "Filter by kind: cpu, dimm, pcie, nic, drive or firmware", param.Example("kind", "firmware")),
+		option.Query("name", "Filter by component name or slot", param.Example("name", "BIOS")),
+		option.Query("version_lt", "Only return components with a version older than this", param.Example("version_lt", "2.19.1")),
+	)
+	fuego.Get(nodes, "/inventory/changes", h.NodeInventoryChanges,
+		option.Description("Get hardware inventory changes found between collections, such as replaced parts"),
+		option.Query("nodeset", "Filter by nodeset", nsExample),
+		option.Query("tags", "Filter by tags", param.Example("tags", "a01,ib,test")),
+		option.Query("since", "Only return changes after this RFC3339 timestamp. Defaults to the last 30 days", param.Example("since", "2025-01-02T15:04:05Z")),
+	)
 
 	fuego.Post(images, "", h.BootImageAdd, option.Description("Add images"))
 	fuego.Get(images, "", h.BootImageList, option.Description("List all images"))
 	fuego.Delete(images, "", h.BootImageDelete, option.Description("Delete images by name"), filterNames)
 	fuego.Get(images, "/find", h.BootImageFind, option.Description("Find images by name"), filterNames)
+	fuego.PostStd(images, "/artifacts", h.ArtifactUpload,
+		option.Description("Upload a kernel, initrd or live image file. The file is stored under its SHA-256 checksum, which images reference in kernel_sha256, initrd_sha256 and liveimg_sha256"),
+		artifactRequestBody,
+		fuego.OptionAddResponse(http.StatusOK, "Stored artifact", fuego.Response{Type: model.Artifact{}}),
+	)
+	fuego.Get(images, "/artifacts", h.ArtifactList, option.Description("List the stored image artifacts"))
+	fuego.Post(images, "/artifacts/gc", h.ArtifactGC, option.Description("Delete the image artifacts no image references"))
+	fuego.Get(images, "/versions/{name}", h.BootImageVersions,
+		option.Description("List the versions of an image"),
+		option.Path("name", "image name", param.Example("name", "rocky9")),
+	)
+	fuego.Patch(images, "/current", h.BootImageCurrent, option.Description("Set the current version of an image"))
+	fuego.Get(images, "/rollouts", h.RolloutList, option.Description("List image rollouts and the provision states of their canary nodes"))
+	fuego.Post(images, "/rollouts", h.RolloutStart,
+		option.Description("Start a rollout of an image version to a percentage of nodes by nodeset and/or tags"),
+		filterNodes,
+	)
+	fuego.Post(images, "/rollouts/{image}/promote", h.RolloutPromote,
+		option.Description("Make the version of a running rollout current and move all nodes of the rollout to it"),
+		option.Path("image", "image name", param.Example("image", "rocky9")),
+	)
+	fuego.Post(images, "/rollouts/{image}/rollback", h.RolloutRollback,
+		option.Description("Move the canary nodes of a running rollout back to the current version of the image"),
+		option.Path("image", "image name", param.Example("image", "rocky9")),
+	)
 
 	fuego.Post(users, "", h.UserStore, option.Description("Add new user"))
 	fuego.Get(users, "", h.UserList, option.Description("List all users"), option.Query("usernames", "Filter by usernames", param.Example("username", "admin,user")))
@@ -152,14 +254,17 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 	fuego.Post(bmc, "/power/os", h.BmcOsPower,
 		option.Description("Change power status of node(s)"),
 		filterNodes,
+		async,
 	)
 	fuego.Post(bmc, "/power/bmc", h.BmcPower,
 		option.Description("Reboot node(s) BMC"),
 		filterNodes,
+		async,
 	)
 	fuego.Delete(bmc, "/sel", h.BmcSelClear,
 		option.Description("Clear system event log on node(s)"),
 		filterNodes,
+		async,
 	)
 	fuego.Get(bmc, "/jobs", h.BmcJobList,
 		option.Description("Get redfish jobs from node(s)"),
@@ -171,18 +276,22 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 			param.Example("jids", "JID_000000000001,JID_000000000002"),
 		),
 		filterNodes,
+		async,
 	)
 	fuego.Delete(bmc, "/jobs", h.BmcJobDeleteMany,
 		option.Description("Delete redfish jobs from many node(s)"),
 		filterNodes,
+		async,
 	)
 	fuego.Post(bmc, "/configure/auto", h.BmcAutoConfigure,
 		option.Description("Set BMC to autoconfigure"),
 		filterNodes,
+		async,
 	)
 	fuego.Post(bmc, "/configure/import", h.BmcImportConfiguration,
 		option.Description("Manually import system configuration to BMC"),
 		filterNodes,
+		async,
 	)
 	fuego.Get(bmc, "/metrics", h.BmcMetricReports,
 		option.Description("Get metric reports by nodeset"),
@@ -192,6 +301,7 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 	fuego.Post(bmc, "/upgrade/dell/installfromrepo", h.BmcDellInstallFromRepo,
 		option.Description("Request iDRAC to download the latest firmware catalog and compare firmware versions."),
 		filterNodes,
+		async,
 	)
 
 	fuego.Get(bmc, "/upgrade/dell/repo", h.BmcDellGetRepoUpdateList,
@@ -199,11 +309,99 @@ func (h *Handler) SetupRoutes(s *fuego.Server) {
 		filterNodes,
 	)
 
+	fuego.Post(bmc, "/upgrade/redfish", h.BmcFirmwareUpdate,
+		option.Description("Update firmware through the Redfish UpdateService. The data of each node's result is the URI of its update task"),
+		filterNodes,
+		async,
+	)
+	fuego.Post(bmc, "/upgrade/redfish/tasks", h.BmcFirmwareTasks,
+		option.Description("Get the state of Redfish firmware update tasks"),
+	)
+
+	fuego.Post(bmc, "/media", h.BmcMediaInsert,
+		option.Description("Insert an ISO into the virtual CD/DVD drive of nodes. The media is ejected when the node completes provisioning"),
+		filterNodes,
+		async,
+	)
+	fuego.Delete(bmc, "/media", h.BmcMediaEject,
+		option.Description("Eject the virtual CD/DVD of nodes"),
+		filterNodes,
+		async,
+	)
+
+	fuego.Post(bmc, "/inventory", h.BmcCollectInventory,
+		option.Description("Collect the hardware inventory of nodes from their BMCs now"),
+		filterNodes,
+		async,
+	)
+
+	fuego.Get(bmc, "/credentials", h.BmcCredentialList,
+		option.Description("Get stored BMC credentials, without passwords"),
+	)
+	fuego.Post(bmc, "/credentials", h.BmcCredentialStore,
+		option.Description("Store the BMC credential of a node or of all nodes with a tag"),
+	)
+	fuego.Delete(bmc, "/credentials/{scope}/{name}", h.BmcCredentialDelete,
+		option.Description("Delete a BMC credential"),
+		option.Path("scope", "host or tag"),
+		option.Path("name", "node name or tag"),
+	)
+	fuego.Post(bmc, "/credentials/rotate", h.BmcCredentialRotate,
+		option.Description("Change the BMC password of nodes to new random passwords stored with the node"),
+		filterNodes,
+		async,
+	)
+
+	fuego.Get(bmc, "/tasks", h.BmcTaskList,
+		option.Description("Get BMC tasks, newest first"),
+		option.Query("status", "Filter by status", param.Example("status", "running")),
+		option.QueryInt("limit", "Maximum number of tasks to return. Defaults to 50", param.Example("limit", 50)),
+	)
+	fuego.Get(bmc, "/tasks/{id}", h.BmcTaskGet,
+		option.Description("Get a BMC task with the status of each node"),
+		option.Path("id", "task id"),
+	)
+	fuego.Delete(bmc, "/tasks/{id}", h.BmcTaskCancel,
+		option.Description("Cancel a BMC task. Nodes the task has not started on are skipped"),
+		option.Path("id", "task id"),
+	)
+
 	fuego.Get(sw, "/{nodeset}/lldp", h.SwitchGetLLDP,
 		option.Description("Get switch LLDP info"),
 		option.Query("ports", "Filter by port name", param.Example("ports", "Et1,Et2")),
 		// filterNodes,
 	)
+	fuego.Post(sw, "/ports", h.SwitchConfigurePorts,
+		option.Description("Set the access VLAN, MTU and description of the intended switch ports of node interfaces from their VLAN, MTU and FQDN"),
+		filterNodes,
+		option.QueryBool("dry_run", "Only return the changes without configuring the switches"),
+		option.Query("admin_state", "Also set the admin state of the ports", param.Example("admin_state", "up")),
+	)
+
+	fuego.Get(dhcp, "/leases", h.DHCPLeaseList, option.Description("List dynamic DHCP leases"))
+
+	topologyFilter := fuego.GroupOptions(
+		option.Query("switch", "Only return links seen on this switch", param.Example("switch", "swe-d13-25")),
+		option.QueryBool("moved", "Only return links of hosts seen on another switch port than expected"),
+	)
+	fuego.Get(topology, "", h.Topology,
+		option.Description("Get the network topology graph of the links seen on switch ports"),
+		topologyFilter,
+	)
+	fuego.GetStd(topology, "/dot", h.TopologyDot,
+		option.Description("Get the network topology graph in the Graphviz DOT language"),
+		topologyFilter,
+		fuego.OptionAddResponse(http.StatusOK, "Graphviz DOT graph", fuego.Response{Type: "", ContentTypes: []string{"text/vnd.graphviz"}}),
+	)
+	fuego.Post(topology, "/walk", h.TopologyWalk, option.Description("Walk the MAC address tables and LLDP neighbors of all switches now"))
+	fuego.Post(topology, "/accept", h.TopologyAccept,
+		option.Description("Accept the switch ports nodes are seen on as their expected ports"),
+		filterNodes,
+	)
+	fuego.Get(topology, "/cabling", h.TopologyCabling,
+		option.Description("Validate the intended switch ports of node interfaces against the MAC address tables and LLDP neighbors of their switches"),
+		filterNodes,
+	)
 
 	fuego.Get(roles, "", h.GetRoles,
 		option.Description("Get roles and permissions"),
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func (h *Handler) NodeInventory(c fuego.ContextNoBody) (model.InventoryComponentList, error) {
+	hosts, err := h.inventoryHosts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := h.DB.Inventory("", c.QueryParam("kind"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get inventory",
+		}
+	}
+
+	name := c.QueryParam("name")
+	before := c.QueryParam("version_lt")
+
+	inv := make(model.InventoryComponentList, 0)
+	for _, comp := range components {
+		if hosts != nil && !hosts[comp.Host] {
+			continue
+		}
+		if name != "" && !strings.EqualFold(comp.Name, name) && !strings.EqualFold(comp.Slot, name) {
+			continue
+		}
+		if before != "" && model.CompareVersions(comp.Version, before) >= 0 {
+			continue
+		}
+		inv = append(inv, comp)
+	}
+
+	return inv, nil
+}
+
+func (h *Handler) NodeInventoryChanges(c fuego.ContextNoBody) (model.InventoryChangeList, error) {
+	hosts, err := h.inventoryHosts(c)
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().Add(-30 * 24 * time.Hour)
+	if s := c.QueryParam("since"); s != "" {
+		since, err = time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Status: http.StatusBadRequest,
+				Err:    err,
+				Title:  "Error",
+				Detail: fmt.Sprintf("invalid since timestamp: %s", s),
+			}
+		}
+	}
+
+	changes, err := h.DB.InventoryChanges("", since)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get inventory changes",
+		}
+	}
+
+	if hosts == nil {
+		return changes, nil
+	}
+
+	filtered := make(model.InventoryChangeList, 0)
+	for _, change := range changes {
+		if hosts[change.Host] {
+			filtered = append(filtered, change)
+		}
+	}
+
+	return filtered, nil
+}
+
+func (h *Handler) BmcCollectInventory(c fuego.ContextNoBody) (model.JobMessageList, error) {
+	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hostList, err := h.DB.FindHosts(ns)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	return h.runBmcJob(c, "inventory", serverNames(hostList), func(ctx context.Context, job *bmc.Job) (model.JobMessageList, error) {
+		output, err := job.CollectInventory(hostList, h.DB)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to collect inventory",
+			}
+		}
+
+		h.writeEvent(ctx, "Success", "Collected inventory of node(s)", output...)
+		return output, nil
+	})
+}
+
+// inventoryHosts returns the names of the nodes matching the nodeset and
+// tags query parameters, or nil for all nodes when neither is given
+func (h *Handler) inventoryHosts(c fuego.ContextNoBody) (map[string]bool, error) {
+	if c.QueryParam("nodeset") == "" && c.QueryParam("tags") == "" {
+		return nil, nil
+	}
+
+	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	hosts := make(map[string]bool, ns.Len())
+	for _, name := range ns.Iterator().StringSlice() {
+		hosts[name] = true
+	}
+
+	return hosts, nil
+}
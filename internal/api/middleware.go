@@ -10,10 +10,12 @@ import (
 	"net/http"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-fuego/fuego"
 	"github.com/rs/cors"
 	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/metrics"
 )
 
 func (h *Handler) authMiddleware(next http.Handler) http.Handler {
@@ -127,6 +129,41 @@ func logMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// metricsMiddleware observes request latency by the route pattern matched by
+// the mux, so path parameters do not create a new series per host
+func metricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r)
+
+		route := r.Pattern
+		if _, path, ok := strings.Cut(route, " "); ok {
+			route = path
+		}
+		if route == "" {
+			route = "unmatched"
+		}
+
+		metrics.Since(metrics.APIDuration.WithLabelValues(route, r.Method, metrics.Code(sw.status)), start)
+	})
+}
+
+// statusWriter records the status code written to a response
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	w.status = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func corsMiddleware(enabled bool) func(h http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -7,10 +7,12 @@ package api
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"slices"
 	"strings"
 
 	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/pkg/model"
 	"github.com/ubccr/grendel/pkg/nodeset"
 )
@@ -59,7 +61,7 @@ func (h *Handler) NodeAdd(c fuego.ContextWithBody[NodeAddRequest]) (*GenericResp
 
 	ns, err := body.NodeList.ToNodeSet()
 	if err == nil {
-		h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully saved node(s): %s", ns.String()))
+		h.writeNodeEvent(c.Context(), "Success", fmt.Sprintf("Successfully saved node(s): %s", ns.String()), ns)
 	}
 
 	return &GenericResponse{
@@ -130,7 +132,7 @@ func (h *Handler) NodeDelete(c fuego.ContextNoBody) (*GenericResponse, error) {
 		}
 	}
 
-	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Successfully deleted node(s): %s", ns.String()))
+	h.writeNodeEvent(c.Context(), "Success", fmt.Sprintf("Successfully deleted node(s): %s", ns.String()), ns)
 
 	return &GenericResponse{
 		Title:   "Success",
@@ -164,6 +166,13 @@ func (h *Handler) NodeProvision(c fuego.ContextWithBody[NodeProvisionRequest]) (
 			Detail: "failed to change provision on node(s)",
 		}
 	}
+
+	if body.Provision {
+		hostList, err := h.DB.FindHosts(ns)
+		if err == nil {
+			h.requestProvision(hostList)
+		}
+	}
 	return &GenericResponse{
 		Title:   "Success",
 		Detail:  fmt.Sprintf("successfully changed node(s) provision to %t", body.Provision),
@@ -351,3 +360,32 @@ func (h *Handler) filterByNodesetAndTags(f1, f2 string) (*nodeset.NodeSet, error
 
 	return nodeset.NewNodeSet(strings.Join(combined, ","))
 }
+
+// filterNodes is like filterByNodesetAndTags for handlers that act on all
+// nodes when no filter is given. It returns a nil nodeset when both filters are
+// empty and rejects filters that match no nodes, so a typo in a tag is never
+// mistaken for all nodes.
+func (h *Handler) filterNodes(f1, f2 string) (*nodeset.NodeSet, error) {
+	if f1 == "" && f2 == "" {
+		return nil, nil
+	}
+
+	ns, err := h.filterByNodesetAndTags(f1, f2)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+	if err != nil || ns.Len() == 0 {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "no nodes matched the nodeset and tags filter",
+		}
+	}
+
+	return ns, nil
+}
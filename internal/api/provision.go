@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func (h *Handler) NodeProvisionStates(c fuego.ContextNoBody) (model.ProvisionEventList, error) {
+	ns, err := h.filterByNodesetAndTags(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to filter nodes",
+		}
+	}
+
+	states, err := h.DB.ProvisionStates()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get provision states",
+		}
+	}
+
+	if c.QueryParam("nodeset") == "" && c.QueryParam("tags") == "" {
+		return states, nil
+	}
+
+	names := make(map[string]bool, ns.Len())
+	for _, name := range ns.Iterator().StringSlice() {
+		names[name] = true
+	}
+
+	filtered := make(model.ProvisionEventList, 0, len(states))
+	for _, s := range states {
+		if names[s.Host] {
+			filtered = append(filtered, s)
+		}
+	}
+
+	return filtered, nil
+}
+
+func (h *Handler) NodeProvisionHistory(c fuego.ContextNoBody) (model.ProvisionEventList, error) {
+	events, err := h.DB.ProvisionEvents(c.PathParam("name"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get node provision history",
+		}
+	}
+
+	return events, nil
+}
+
+func (h *Handler) NodeLogs(c fuego.ContextNoBody) (model.ProvisionLogList, error) {
+	logs, err := h.DB.ProvisionLogs(c.PathParam("name"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get node logs",
+		}
+	}
+
+	return logs, nil
+}
+
+// requestProvision starts a new provision of the hosts in the provision
+// history and clears the logs sent during their previous install
+func (h *Handler) requestProvision(hostList model.HostList) {
+	for _, host := range hostList {
+		err := h.DB.StoreProvisionEvent(&model.ProvisionEvent{
+			Host:  host.Name,
+			State: model.ProvisionStateRequested,
+		})
+		if err != nil {
+			log.Errorf("Failed to record provision request of host %s: %s", host.Name, err)
+		}
+
+		err = h.DB.DeleteProvisionLogs(host.Name)
+		if err != nil {
+			log.Errorf("Failed to delete provision logs of host %s: %s", host.Name, err)
+		}
+	}
+}
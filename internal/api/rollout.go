@@ -0,0 +1,442 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+	"github.com/ubccr/grendel/pkg/nodeset"
+)
+
+type BootImageCurrentRequest struct {
+	Name    string `json:"name"`
+	Version int    `json:"version"`
+}
+
+type RolloutStartRequest struct {
+	Image     string `json:"image"`
+	Version   int    `json:"version"`
+	Percent   int    `json:"percent"`
+	Provision bool   `json:"provision"`
+}
+
+func (h *Handler) BootImageVersions(c fuego.ContextNoBody) (model.BootImageVersionList, error) {
+	versions, err := h.DB.BootImageVersions(c.PathParam("name"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get image versions",
+		}
+	}
+
+	return versions, nil
+}
+
+func (h *Handler) BootImageCurrent(c fuego.ContextWithBody[BootImageCurrentRequest]) (*GenericResponse, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	err = h.DB.SetBootImageVersion(body.Name, body.Version)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to set current version of image %s to %d", body.Name, body.Version),
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Set current version of image %s to %d", body.Name, body.Version))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully set current version of image %s to %d", body.Name, body.Version),
+		Changed: 1,
+	}, nil
+}
+
+// RolloutStart pins a percentage of the filtered nodes booting a boot image to
+// a version of the image and records the rollout
+func (h *Handler) RolloutStart(c fuego.ContextWithBody[RolloutStartRequest]) (*model.Rollout, error) {
+	body, err := c.Body()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse body",
+		}
+	}
+
+	if body.Percent < 1 || body.Percent > 100 {
+		return nil, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: "percent must be between 1 and 100",
+		}
+	}
+
+	current, err := h.DB.LoadBootImage(body.Image)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to find image %s", body.Image),
+		}
+	}
+
+	pin := fmt.Sprintf("%s@%d", body.Image, body.Version)
+	if _, err := h.DB.LoadBootImage(pin); err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to find image version %s", pin),
+		}
+	}
+
+	if body.Version == current.Version {
+		return nil, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("version %d is the current version of image %s", body.Version, body.Image),
+		}
+	}
+
+	latest, err := h.DB.LoadRollout(body.Image)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get rollouts",
+		}
+	}
+	if latest != nil && latest.State == model.RolloutRunning {
+		return nil, fuego.HTTPError{
+			Status: http.StatusConflict,
+			Title:  "Error",
+			Detail: fmt.Sprintf("rollout of image %s to version %d is running", body.Image, latest.Version),
+		}
+	}
+
+	ns, err := h.filterNodes(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, err
+	}
+
+	var hostList model.HostList
+	if ns == nil {
+		hostList, err = h.DB.Hosts()
+	} else {
+		hostList, err = h.DB.FindHosts(ns)
+	}
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	// Only hosts booting the image or one of its versions take part
+	nodes := make([]string, 0, len(hostList))
+	bootImages := make(map[string]string, len(hostList))
+	for _, host := range hostList {
+		name, _, err := model.ParseBootImageName(host.BootImage)
+		if err != nil || name != body.Image {
+			continue
+		}
+		nodes = append(nodes, host.Name)
+		bootImages[host.Name] = host.BootImage
+	}
+	if len(nodes) == 0 {
+		return nil, fuego.HTTPError{
+			Status: http.StatusBadRequest,
+			Title:  "Error",
+			Detail: fmt.Sprintf("no nodes boot image %s", body.Image),
+		}
+	}
+
+	ns, err = nodeset.NewNodeSet(strings.Join(nodes, ","))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to select rollout nodes",
+		}
+	}
+
+	nodes = ns.Iterator().StringSlice()
+	count := int(math.Ceil(float64(len(nodes)*body.Percent) / 100))
+	canary, err := nodeset.NewNodeSet(strings.Join(nodes[:count], ","))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to select canary nodes",
+		}
+	}
+
+	previous := make(map[string]string, count)
+	for _, name := range nodes[:count] {
+		previous[name] = bootImages[name]
+	}
+
+	err = h.DB.SetBootImage(canary, pin)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to update boot images",
+		}
+	}
+
+	if body.Provision {
+		err = h.DB.ProvisionHosts(canary, true)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to update node provision status",
+			}
+		}
+		hostList, err = h.DB.FindHosts(canary)
+		if err == nil {
+			h.requestProvision(hostList)
+		}
+	}
+
+	rollout := &model.Rollout{
+		Image:       body.Image,
+		Version:     body.Version,
+		FromVersion: current.Version,
+		Nodes:       ns.String(),
+		Canary:      canary.String(),
+		Percent:     body.Percent,
+		State:       model.RolloutRunning,
+		Previous:    previous,
+	}
+	err = h.DB.StoreRollout(rollout)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to store rollout",
+		}
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Started rollout of image %s version %d to %s", body.Image, body.Version, canary.String()))
+
+	return rollout, nil
+}
+
+func (h *Handler) RolloutList(c fuego.ContextNoBody) (model.RolloutList, error) {
+	rollouts, err := h.DB.Rollouts()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get rollouts",
+		}
+	}
+
+	states, err := h.DB.ProvisionStates()
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get provision states",
+		}
+	}
+
+	latest := make(map[string]*model.ProvisionEvent, len(states))
+	for _, s := range states {
+		latest[s.Host] = s
+	}
+
+	for _, r := range rollouts {
+		canary, err := nodeset.NewNodeSet(r.Canary)
+		if err != nil {
+			continue
+		}
+
+		// Only states entered after the rollout started count
+		for _, name := range canary.Iterator().StringSlice() {
+			s, ok := latest[name]
+			switch {
+			case !ok || s.Time.Before(r.Time):
+				r.Pending++
+			case s.State == model.ProvisionStateComplete:
+				r.Complete++
+			case s.State == model.ProvisionStateFailed:
+				r.Failed++
+			default:
+				r.Pending++
+			}
+		}
+
+		if canary.Len() > 0 {
+			r.SuccessRate = float64(r.Complete) / float64(canary.Len())
+		}
+	}
+
+	return rollouts, nil
+}
+
+// RolloutPromote makes the version of a running rollout the current version of
+// its boot image. The canary nodes are unpinned so they follow the current
+// version like the other nodes of the image.
+func (h *Handler) RolloutPromote(c fuego.ContextNoBody) (*GenericResponse, error) {
+	rollout, err := h.runningRollout(c.PathParam("image"))
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.DB.SetBootImageVersion(rollout.Image, rollout.Version)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to set current version of image %s to %d", rollout.Image, rollout.Version),
+		}
+	}
+
+	ns, err := h.finishRollout(rollout, model.RolloutPromoted, func(string) string {
+		return rollout.Image
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Promoted rollout of image %s version %d to %s", rollout.Image, rollout.Version, rollout.Nodes))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully promoted image %s version %d", rollout.Image, rollout.Version),
+		Changed: ns.Len(),
+	}, nil
+}
+
+// RolloutRollback moves the canary nodes of a running rollout back to the boot
+// image they had before the rollout
+func (h *Handler) RolloutRollback(c fuego.ContextNoBody) (*GenericResponse, error) {
+	rollout, err := h.runningRollout(c.PathParam("image"))
+	if err != nil {
+		return nil, err
+	}
+
+	ns, err := h.finishRollout(rollout, model.RolloutRolledBack, func(name string) string {
+		if image, ok := rollout.Previous[name]; ok {
+			return image
+		}
+		return rollout.Image
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h.writeEvent(c.Context(), "Success", fmt.Sprintf("Rolled back rollout of image %s version %d on %s", rollout.Image, rollout.Version, rollout.Canary))
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("successfully rolled back image %s version %d", rollout.Image, rollout.Version),
+		Changed: ns.Len(),
+	}, nil
+}
+
+func (h *Handler) runningRollout(image string) (*model.Rollout, error) {
+	rollout, err := h.DB.LoadRollout(image)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to find rollout of image %s", image),
+		}
+	}
+
+	if rollout.State != model.RolloutRunning {
+		return nil, fuego.HTTPError{
+			Status: http.StatusConflict,
+			Title:  "Error",
+			Detail: fmt.Sprintf("rollout of image %s is %s", image, rollout.State),
+		}
+	}
+
+	return rollout, nil
+}
+
+// finishRollout moves the canary nodes still pinned to the rollout version to
+// the boot image returned by target and records the final state of the
+// rollout. Canary nodes whose boot image was changed since the rollout started
+// are left alone.
+func (h *Handler) finishRollout(rollout *model.Rollout, state string, target func(name string) string) (*nodeset.NodeSet, error) {
+	canary, err := nodeset.NewNodeSet(rollout.Canary)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to parse rollout nodes",
+		}
+	}
+
+	changed := nodeset.EmptyNodeSet()
+	if canary.Len() > 0 {
+		hostList, err := h.DB.FindHosts(canary)
+		if err != nil {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to find rollout nodes",
+			}
+		}
+
+		pin := fmt.Sprintf("%s@%d", rollout.Image, rollout.Version)
+		byImage := make(map[string]*nodeset.NodeSet)
+		for _, host := range hostList {
+			if host.BootImage != pin {
+				continue
+			}
+			image := target(host.Name)
+			if _, ok := byImage[image]; !ok {
+				byImage[image] = nodeset.EmptyNodeSet()
+			}
+			byImage[image].Add(host.Name)
+			changed.Add(host.Name)
+		}
+
+		for image, ns := range byImage {
+			err = h.DB.SetBootImage(ns, image)
+			if err != nil {
+				return nil, fuego.HTTPError{
+					Err:    err,
+					Title:  "Error",
+					Detail: "failed to update boot images",
+				}
+			}
+		}
+	}
+
+	err = h.DB.SetRolloutState(rollout.ID, state)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to update rollout",
+		}
+	}
+
+	return changed, nil
+}
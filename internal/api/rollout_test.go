@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-fuego/fuego"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestRolloutRestoresBootImages(t *testing.T) {
+	assert := assert.New(t)
+
+	h := newTestHandler(t)
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	image.Name = "rocky9"
+	for i := 1; i <= 3; i++ {
+		image.KernelPath = fmt.Sprintf("/var/lib/grendel/vmlinuz-%d", i)
+		assert.NoError(h.DB.StoreBootImage(image))
+	}
+
+	other := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	other.Name = "centos9"
+	assert.NoError(h.DB.StoreBootImage(other))
+
+	bootImages := map[string]string{
+		"cpn-01": "rocky9",
+		"cpn-02": "rocky9@1",
+		"cpn-03": "centos9",
+		"cpn-04": "rocky9",
+	}
+	for name, bootImage := range bootImages {
+		host := tests.HostFactory.MustCreate().(*model.Host)
+		host.Name = name
+		host.BootImage = bootImage
+		assert.NoError(h.DB.StoreHost(host))
+	}
+
+	assertBootImages := func(want map[string]string) {
+		for name, bootImage := range want {
+			host, err := h.DB.LoadHostFromName(name)
+			if assert.NoError(err) {
+				assert.Equal(bootImage, host.BootImage, name)
+			}
+		}
+	}
+
+	start := func(percent int) *model.Rollout {
+		c := fuego.NewMockContext(RolloutStartRequest{Image: "rocky9", Version: 2, Percent: percent})
+		c.SetQueryParam("nodeset", "cpn-[01-04]")
+		rollout, err := h.RolloutStart(c)
+		assert.NoError(err)
+		return rollout
+	}
+
+	rollout := start(100)
+	if assert.NotNil(rollout) {
+		assert.Equal("cpn-[01-02,04]", rollout.Nodes)
+		assert.Equal("cpn-[01-02,04]", rollout.Canary)
+	}
+	assertBootImages(map[string]string{
+		"cpn-01": "rocky9@2",
+		"cpn-02": "rocky9@2",
+		"cpn-03": "centos9",
+		"cpn-04": "rocky9@2",
+	})
+
+	c := fuego.NewMockContextNoBody()
+	c.PathParams["image"] = "rocky9"
+	_, err := h.RolloutRollback(c)
+	assert.NoError(err)
+	assertBootImages(bootImages)
+
+	rollout = start(50)
+	if assert.NotNil(rollout) {
+		assert.Equal("cpn-[01-02]", rollout.Canary)
+	}
+
+	_, err = h.RolloutPromote(c)
+	assert.NoError(err)
+	assertBootImages(map[string]string{
+		"cpn-01": "rocky9",
+		"cpn-02": "rocky9",
+		"cpn-03": "centos9",
+		"cpn-04": "rocky9",
+	})
+
+	current, err := h.DB.LoadBootImage("rocky9")
+	if assert.NoError(err) {
+		assert.Equal(2, current.Version)
+	}
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3gen"
 	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/ha"
 	"github.com/ubccr/grendel/internal/logger"
 	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/internal/util"
@@ -32,6 +33,7 @@ type Server struct {
 	CertFile      string
 	Hostname      string
 	DB            store.Store
+	HA            *ha.Elector
 	server        *fuego.Server
 	SwaggerUI     bool
 	CORS          bool
@@ -122,6 +124,7 @@ func (s *Server) Serve() error {
 		fuego.WithGlobalMiddlewares(
 			corsMiddleware(s.CORS),
 			logMiddleware,
+			metricsMiddleware,
 		),
 		fuego.WithSecurity(setupSecurity()),
 	)
@@ -134,6 +137,7 @@ func (s *Server) Serve() error {
 	if err != nil {
 		return err
 	}
+	h.HA = s.HA
 
 	h.SetupRoutes(s.server)
 	if s.CertFile != "" && s.KeyFile != "" {
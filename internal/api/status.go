@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"os"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/ha"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func (h *Handler) GrendelStatus(c fuego.ContextNoBody) (*model.GrendelStatus, error) {
+	hostname, _ := os.Hostname()
+	status := &model.GrendelStatus{
+		Version:  Version,
+		Hostname: hostname,
+		Role:     ha.RoleStandalone,
+		Active:   true,
+	}
+
+	if h.HA == nil {
+		return status, nil
+	}
+
+	status.HA = true
+	status.ID = h.HA.ID
+	status.Role = h.HA.Role()
+	status.Active = h.HA.IsLeader()
+
+	// Instances not taking part in the election, for example when only
+	// running the API, report the leader from the database
+	lease := h.HA.Lease()
+	if lease == nil {
+		var err error
+		lease, err = h.DB.LoadLeaderLease(ha.LeaseName)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			return nil, fuego.HTTPError{
+				Err:    err,
+				Title:  "Error",
+				Detail: "failed to get leader lease",
+			}
+		}
+	}
+
+	if lease != nil {
+		status.Leader = lease.Holder
+		status.LeaderSince = lease.AcquiredAt
+		status.LeaseExpiresAt = lease.ExpiresAt
+	}
+
+	return status, nil
+}
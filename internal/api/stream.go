@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ubccr/grendel/internal/stream"
+)
+
+// streamKeepAlive is how often a comment is sent on idle event streams so
+// proxies don't close the connection
+const streamKeepAlive = 30 * time.Second
+
+// EventStream sends audit events, BMC job results and provision state changes
+// as server-sent events as they happen
+func (h *Handler) EventStream(w http.ResponseWriter, r *http.Request) {
+	filter := stream.Filter{
+		Host:  r.URL.Query().Get("host"),
+		JobID: r.URL.Query().Get("job"),
+	}
+	if types := r.URL.Query().Get("types"); types != "" {
+		filter.Types = strings.Split(types, ",")
+	}
+
+	// Subscribe before sending the headers so a client can start a job as
+	// soon as the stream is open without missing any results
+	ch, cancel := stream.Default.Subscribe(filter)
+	defer cancel()
+
+	// Streams outlive the server write timeout
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		log.Debugf("failed to clear write deadline on event stream: %s", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		log.Errorf("event stream not supported: %s", err)
+		return
+	}
+
+	ticker := time.NewTicker(streamKeepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+		case m := <-ch:
+			data, err := json.Marshal(m)
+			if err != nil {
+				log.Errorf("failed to marshal stream message: %s", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", m.Type, data); err != nil {
+				return
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
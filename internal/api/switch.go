@@ -3,11 +3,13 @@ package api
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"slices"
 	"strings"
 
 	"github.com/facette/natsort"
 	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/topology"
 	"github.com/ubccr/grendel/internal/tors"
 	"github.com/ubccr/grendel/pkg/model"
 	"github.com/ubccr/grendel/pkg/nodeset"
@@ -98,3 +100,51 @@ func (h *Handler) SwitchGetLLDP(c fuego.ContextNoBody) (*model.SwitchLLDPList, e
 
 	return &resSlice, nil
 }
+
+func (h *Handler) SwitchConfigurePorts(c fuego.ContextNoBody) (*model.SwitchPortReport, error) {
+	ns, err := h.filterNodes(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, err
+	}
+
+	var hostList model.HostList
+	if ns == nil {
+		hostList, err = h.DB.Hosts()
+	} else {
+		hostList, err = h.DB.FindHosts(ns)
+	}
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	dryRun := c.QueryParamBool("dry_run")
+	report, err := topology.ConfigurePorts(h.DB, hostList, c.QueryParam("admin_state"), dryRun)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, topology.ErrNoCabling) || errors.Is(err, topology.ErrInvalidAdminState) {
+			status = http.StatusBadRequest
+		}
+		return nil, fuego.HTTPError{
+			Status: status,
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to configure switch ports: %s", err),
+		}
+	}
+
+	if !dryRun {
+		changed := 0
+		for _, p := range report.Ports {
+			if p.Status == model.PortChanged {
+				changed++
+			}
+		}
+		h.writeNodeEvent(c.Context(), "Success", fmt.Sprintf("Configured %d switch ports of node(s)", changed), ns)
+	}
+
+	return report, nil
+}
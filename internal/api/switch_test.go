@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-fuego/fuego"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/internal/tests"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func newTestHandler(t *testing.T) *Handler {
+	db, err := sqlstore.New(":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Name = "cpn-a01"
+	host.Tags = []string{"compute"}
+	err = db.StoreHost(host)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Handler{DB: db}
+}
+
+func TestSwitchConfigurePortsUnmatchedFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	h := newTestHandler(t)
+
+	filters := []struct {
+		nodeset string
+		tags    string
+	}{
+		{"", "typo"},
+		{"cpn-b01", "compute"},
+	}
+
+	for _, f := range filters {
+		c := fuego.NewMockContextNoBody()
+		c.SetQueryParam("nodeset", f.nodeset)
+		c.SetQueryParam("tags", f.tags)
+		c.SetQueryParam("admin_state", model.PortAdminDown)
+
+		_, err := h.SwitchConfigurePorts(c)
+
+		var httpErr fuego.HTTPError
+		if assert.ErrorAs(err, &httpErr) {
+			assert.Equal(http.StatusBadRequest, httpErr.StatusCode())
+			assert.Contains(httpErr.Detail, "no nodes matched")
+		}
+	}
+}
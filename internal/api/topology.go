@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-fuego/fuego"
+	"github.com/ubccr/grendel/internal/topology"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func (h *Handler) Topology(c fuego.ContextNoBody) (*model.TopologyGraph, error) {
+	links, err := h.topologyLinks(c.QueryParam("switch"), c.QueryParamBool("moved"))
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get topology",
+		}
+	}
+
+	return model.NewTopologyGraph(links), nil
+}
+
+// TopologyDot sends the topology graph in the Graphviz DOT language
+func (h *Handler) TopologyDot(w http.ResponseWriter, r *http.Request) {
+	links, err := h.topologyLinks(r.URL.Query().Get("switch"), r.URL.Query().Get("moved") == "true")
+	if err != nil {
+		ErrorSerializer(w, r, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to get topology",
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/vnd.graphviz")
+	fmt.Fprint(w, model.NewTopologyGraph(links).DOT())
+}
+
+func (h *Handler) TopologyWalk(c fuego.ContextNoBody) (*GenericResponse, error) {
+	res, err := topology.Walk(h.DB)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, topology.ErrNoSwitches) {
+			status = http.StatusBadRequest
+		}
+		return nil, fuego.HTTPError{
+			Status: status,
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to walk switches: %s", err),
+		}
+	}
+
+	detail := fmt.Sprintf("Found %d links on %d switches, %d hosts moved", res.Links, res.Switches-len(res.Failed), len(res.Moved))
+	if len(res.Failed) > 0 {
+		detail += fmt.Sprintf(", failed to walk %s", strings.Join(res.Failed, ","))
+	}
+	h.writeEvent(c.Context(), "Success", detail)
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  detail,
+		Changed: res.Links,
+	}, nil
+}
+
+func (h *Handler) TopologyAccept(c fuego.ContextNoBody) (*GenericResponse, error) {
+	ns, err := h.filterNodes(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, err
+	}
+
+	// No nodeset or tags accepts all nodes
+	var hosts []string
+	if ns != nil {
+		hosts = ns.Iterator().StringSlice()
+	}
+
+	accepted, err := h.DB.AcceptTopology(hosts)
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to accept topology",
+		}
+	}
+
+	h.writeNodeEvent(c.Context(), "Success", "Accepted current switch ports of node(s)", ns)
+
+	return &GenericResponse{
+		Title:   "Success",
+		Detail:  fmt.Sprintf("accepted the current switch ports of %d links", accepted),
+		Changed: accepted,
+	}, nil
+}
+
+func (h *Handler) TopologyCabling(c fuego.ContextNoBody) (*model.CablingReport, error) {
+	ns, err := h.filterNodes(c.QueryParam("nodeset"), c.QueryParam("tags"))
+	if err != nil {
+		return nil, err
+	}
+
+	var hostList model.HostList
+	if ns == nil {
+		hostList, err = h.DB.Hosts()
+	} else {
+		hostList, err = h.DB.FindHosts(ns)
+	}
+	if err != nil {
+		return nil, fuego.HTTPError{
+			Err:    err,
+			Title:  "Error",
+			Detail: "failed to find nodes",
+		}
+	}
+
+	report, err := topology.ValidateCabling(h.DB, hostList)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, topology.ErrNoCabling) {
+			status = http.StatusBadRequest
+		}
+		return nil, fuego.HTTPError{
+			Status: status,
+			Err:    err,
+			Title:  "Error",
+			Detail: fmt.Sprintf("failed to validate cabling: %s", err),
+		}
+	}
+
+	return report, nil
+}
+
+func (h *Handler) topologyLinks(switchName string, moved bool) (model.TopologyLinkList, error) {
+	links, err := h.DB.Topology(switchName)
+	if err != nil || !moved {
+		return links, err
+	}
+
+	filtered := make(model.TopologyLinkList, 0)
+	for _, l := range links {
+		if l.Moved {
+			filtered = append(filtered, l)
+		}
+	}
+
+	return filtered, nil
+}
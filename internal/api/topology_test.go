@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-fuego/fuego"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopologyAcceptUnmatchedFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	h := newTestHandler(t)
+
+	c := fuego.NewMockContextNoBody()
+	c.SetQueryParam("tags", "typo")
+
+	_, err := h.TopologyAccept(c)
+
+	var httpErr fuego.HTTPError
+	if assert.ErrorAs(err, &httpErr) {
+		assert.Equal(http.StatusBadRequest, httpErr.StatusCode())
+	}
+
+	_, err = h.TopologyCabling(c)
+	if assert.ErrorAs(err, &httpErr) {
+		assert.Equal(http.StatusBadRequest, httpErr.StatusCode())
+		assert.Contains(httpErr.Detail, "no nodes matched")
+	}
+}
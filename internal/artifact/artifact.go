@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package artifact stores boot image files in a directory managed by Grendel
+// keyed by their SHA-256 checksum, and verifies files against their checksum
+// before they are served.
+package artifact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+	"golang.org/x/sync/singleflight"
+)
+
+var log = logger.GetLogger("ARTIFACT")
+
+var (
+	// ErrChecksumMismatch is returned when a file does not match its checksum
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+
+	// ErrInvalidChecksum is returned for checksums that are not a hex encoded
+	// SHA-256
+	ErrInvalidChecksum = errors.New("invalid sha256 checksum")
+)
+
+// verified caches the files that matched their checksum, keyed by path. A
+// file is hashed again when its size or modification time change.
+var (
+	verified   = make(map[string]verifiedFile)
+	verifiedMu sync.Mutex
+	hashing    singleflight.Group
+)
+
+type verifiedFile struct {
+	sum     string
+	size    int64
+	modTime time.Time
+}
+
+func init() {
+	viper.SetDefault("provision.artifact_dir", "/var/lib/grendel/artifacts")
+	viper.SetDefault("provision.artifact_gc_grace", "1h")
+}
+
+// Dir returns the directory artifacts are stored in
+func Dir() string {
+	return viper.GetString("provision.artifact_dir")
+}
+
+// ValidChecksum returns true if sum is a hex encoded SHA-256 checksum
+func ValidChecksum(sum string) bool {
+	if len(sum) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(sum)
+	return err == nil
+}
+
+// Path returns the path of the artifact with the given checksum
+func Path(sum string) string {
+	return filepath.Join(Dir(), sum[:2], sum)
+}
+
+// Put stores the data read from r in the artifact directory and records it in
+// the data store. It returns the artifact, which is not stored twice if it
+// already exists.
+func Put(db store.Store, r io.Reader) (*model.Artifact, error) {
+	dir := Dir()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), r)
+	if err != nil {
+		return nil, err
+	}
+	if err := tmp.Sync(); err != nil {
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))
+	path := Path(sum)
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, err
+		}
+		if err := os.Chmod(tmp.Name(), 0644); err != nil {
+			return nil, err
+		}
+		if err := os.Rename(tmp.Name(), path); err != nil {
+			return nil, err
+		}
+		log.Infof("Stored artifact %s (%d bytes)", sum, size)
+	}
+
+	artifact := &model.Artifact{
+		SHA256: sum,
+		Size:   size,
+		Path:   path,
+	}
+	if err := db.StoreArtifact(artifact); err != nil {
+		return nil, err
+	}
+
+	return artifact, nil
+}
+
+// Verify checks that the file at path matches the checksum sum. Files that
+// matched are not hashed again until they change, and concurrent calls for
+// the same file share a single hash of it.
+func Verify(path, sum string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	verifiedMu.Lock()
+	v, ok := verified[path]
+	verifiedMu.Unlock()
+	if ok && v.sum == sum && v.size == info.Size() && v.modTime.Equal(info.ModTime()) {
+		return nil
+	}
+
+	_, err, _ = hashing.Do(path+"\x00"+sum, func() (interface{}, error) {
+		return nil, verify(path, sum, info)
+	})
+
+	return err
+}
+
+func verify(path, sum string, info os.FileInfo) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return err
+	}
+
+	if got := hex.EncodeToString(hash.Sum(nil)); got != sum {
+		log.Errorf("File %s has checksum %s, expected %s", path, got, sum)
+		return fmt.Errorf("%w: %s", ErrChecksumMismatch, path)
+	}
+
+	verifiedMu.Lock()
+	verified[path] = verifiedFile{sum: sum, size: info.Size(), modTime: info.ModTime()}
+	verifiedMu.Unlock()
+
+	return nil
+}
+
+// VerifyPath checks a boot image file against its checksum, if it has one,
+// before it is served and logs the files that are refused
+func VerifyPath(path, sum string) error {
+	if sum == "" {
+		return nil
+	}
+
+	if err := Verify(path, sum); err != nil {
+		log.Errorf("Refusing to serve %s: %s", path, err)
+		return err
+	}
+
+	return nil
+}
+
+// VerifyImages verifies the files of the boot images that have a checksum, so
+// serving them only has to check the cache. Files failing verification are
+// logged and refused when requested.
+func VerifyImages(images model.BootImageList) {
+	for _, image := range images {
+		files := map[string]string{
+			image.KernelPath: image.KernelSHA256,
+			image.LiveImage:  image.LiveImageSHA256,
+		}
+		for i, sum := range image.InitrdSHA256 {
+			if i < len(image.InitrdPaths) {
+				files[image.InitrdPaths[i]] = sum
+			}
+		}
+
+		for path, sum := range files {
+			if path == "" || sum == "" {
+				continue
+			}
+			if err := Verify(path, sum); err != nil {
+				log.Warnf("Boot image %s file %s failed verification: %s", image.Name, path, err)
+			}
+		}
+	}
+}
+
+// Resolve sets the paths of the files of a boot image that are given by
+// checksum to their artifacts. Files with both a path and a checksum that are
+// not stored as artifacts keep their path and are verified when served.
+func Resolve(image *model.BootImage) error {
+	var err error
+	image.KernelPath, err = resolve(image.KernelPath, image.KernelSHA256)
+	if err != nil {
+		return fmt.Errorf("kernel: %w", err)
+	}
+
+	image.LiveImage, err = resolve(image.LiveImage, image.LiveImageSHA256)
+	if err != nil {
+		return fmt.Errorf("liveimg: %w", err)
+	}
+
+	if len(image.InitrdSHA256) == 0 {
+		return nil
+	}
+	if len(image.InitrdPaths) == 0 {
+		image.InitrdPaths = make([]string, len(image.InitrdSHA256))
+	}
+	if len(image.InitrdPaths) != len(image.InitrdSHA256) {
+		return fmt.Errorf("got %d initrd checksums for %d initrds: %w", len(image.InitrdSHA256), len(image.InitrdPaths), store.ErrInvalidData)
+	}
+	for i := range image.InitrdSHA256 {
+		image.InitrdPaths[i], err = resolve(image.InitrdPaths[i], image.InitrdSHA256[i])
+		if err != nil {
+			return fmt.Errorf("initrd %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func resolve(path, sum string) (string, error) {
+	if sum == "" {
+		return path, nil
+	}
+	if !ValidChecksum(sum) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidChecksum, sum)
+	}
+
+	if _, err := os.Stat(Path(sum)); err == nil {
+		return Path(sum), nil
+	}
+	if path == "" {
+		return "", fmt.Errorf("artifact %s: %w", sum, store.ErrNotFound)
+	}
+
+	return path, nil
+}
+
+// GC deletes the artifacts that no boot image references. Artifacts stored
+// within the provision.artifact_gc_grace period are kept so that images can be
+// added after their files are uploaded.
+func GC(db store.Store) (model.ArtifactList, error) {
+	before := time.Now().Add(-viper.GetDuration("provision.artifact_gc_grace"))
+	artifacts, err := db.UnreferencedArtifacts(before)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := make(model.ArtifactList, 0, len(artifacts))
+	for _, a := range artifacts {
+		if !ValidChecksum(a.SHA256) {
+			continue
+		}
+
+		path := Path(a.SHA256)
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Errorf("Failed to delete artifact %s: %s", a.SHA256, err)
+			continue
+		}
+		if err := db.DeleteArtifact(a.SHA256); err != nil {
+			log.Errorf("Failed to delete artifact %s: %s", a.SHA256, err)
+			continue
+		}
+
+		verifiedMu.Lock()
+		delete(verified, path)
+		verifiedMu.Unlock()
+
+		log.Infof("Deleted unreferenced artifact %s", a.SHA256)
+		a.Path = path
+		deleted = append(deleted, a)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package artifact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func newTestDB(t *testing.T) store.Store {
+	db, err := sqlstore.New(":memory:")
+	if err != nil {
+		assert.Fail(t, err.Error())
+	}
+
+	return db
+}
+
+func checksum(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestPutVerify(t *testing.T) {
+	assert := assert.New(t)
+	viper.Set("provision.artifact_dir", t.TempDir())
+	db := newTestDB(t)
+
+	a, err := Put(db, strings.NewReader("vmlinuz"))
+	if assert.NoError(err) {
+		assert.Equal(checksum("vmlinuz"), a.SHA256)
+		assert.Equal(int64(7), a.Size)
+		assert.Equal(Path(a.SHA256), a.Path)
+		assert.NoError(Verify(a.Path, a.SHA256))
+	}
+
+	again, err := Put(db, strings.NewReader("vmlinuz"))
+	if assert.NoError(err) {
+		assert.Equal(a.SHA256, again.SHA256)
+	}
+
+	artifacts, err := db.Artifacts()
+	if assert.NoError(err) {
+		assert.Len(artifacts, 1)
+	}
+
+	err = os.WriteFile(a.Path, []byte("tampered"), 0644)
+	assert.NoError(err)
+	assert.ErrorIs(Verify(a.Path, a.SHA256), ErrChecksumMismatch)
+	assert.ErrorIs(VerifyPath(a.Path, a.SHA256), ErrChecksumMismatch)
+
+	// Files without a checksum are served as they are
+	assert.NoError(VerifyPath(a.Path, ""))
+}
+
+func TestVerifyImages(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	kernel := filepath.Join(dir, "vmlinuz")
+	initrd := filepath.Join(dir, "initrd.img")
+	assert.NoError(os.WriteFile(kernel, []byte("vmlinuz"), 0644))
+	assert.NoError(os.WriteFile(initrd, []byte("initrd"), 0644))
+
+	VerifyImages(model.BootImageList{{
+		Name:         "rocky",
+		KernelPath:   kernel,
+		KernelSHA256: checksum("vmlinuz"),
+		InitrdPaths:  []string{initrd},
+		InitrdSHA256: []string{checksum("bad")},
+	}})
+
+	verifiedMu.Lock()
+	_, kernelOK := verified[kernel]
+	_, initrdOK := verified[initrd]
+	verifiedMu.Unlock()
+	assert.True(kernelOK)
+	assert.False(initrdOK)
+
+	// Concurrent calls sharing a hash all get its result
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.ErrorIs(Verify(initrd, checksum("bad")), ErrChecksumMismatch)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestResolve(t *testing.T) {
+	assert := assert.New(t)
+	viper.Set("provision.artifact_dir", t.TempDir())
+	db := newTestDB(t)
+
+	kernel, err := Put(db, strings.NewReader("vmlinuz"))
+	assert.NoError(err)
+
+	external := filepath.Join(t.TempDir(), "initrd.img")
+	err = os.WriteFile(external, []byte("initrd"), 0644)
+	assert.NoError(err)
+
+	image := &model.BootImage{
+		Name:         "rocky",
+		KernelSHA256: kernel.SHA256,
+		InitrdPaths:  []string{external},
+		InitrdSHA256: []string{checksum("initrd")},
+	}
+	if assert.NoError(Resolve(image)) {
+		assert.Equal(kernel.Path, image.KernelPath)
+		assert.Equal(external, image.InitrdPaths[0])
+	}
+
+	image.LiveImageSHA256 = checksum("missing")
+	assert.ErrorIs(Resolve(image), store.ErrNotFound)
+
+	image.LiveImageSHA256 = "abc"
+	assert.ErrorIs(Resolve(image), ErrInvalidChecksum)
+}
+
+func TestGC(t *testing.T) {
+	assert := assert.New(t)
+	viper.Set("provision.artifact_dir", t.TempDir())
+	viper.Set("provision.artifact_gc_grace", "0s")
+	defer viper.Set("provision.artifact_gc_grace", "1h")
+	db := newTestDB(t)
+
+	kernel, err := Put(db, strings.NewReader("vmlinuz"))
+	assert.NoError(err)
+	orphan, err := Put(db, strings.NewReader("old initrd"))
+	assert.NoError(err)
+
+	err = db.StoreBootImage(&model.BootImage{
+		Name:         "rocky",
+		KernelPath:   kernel.Path,
+		KernelSHA256: kernel.SHA256,
+	})
+	assert.NoError(err)
+
+	time.Sleep(10 * time.Millisecond)
+
+	deleted, err := GC(db)
+	if assert.NoError(err) && assert.Len(deleted, 1) {
+		assert.Equal(orphan.SHA256, deleted[0].SHA256)
+	}
+
+	assert.NoFileExists(orphan.Path)
+	assert.FileExists(kernel.Path)
+}
@@ -5,10 +5,18 @@
 package bmc
 
 import (
+	"github.com/spf13/viper"
 	"github.com/stmcginnis/gofish"
 	"github.com/stmcginnis/gofish/schemas"
 )
 
+// FactoryUser and FactoryPassword are the factory default credentials of
+// Dell iDRACs
+const (
+	FactoryUser     = "root"
+	FactoryPassword = "calvin"
+)
+
 type Redfish struct {
 	config  gofish.ClientConfig
 	client  *gofish.APIClient
@@ -47,10 +55,12 @@ func NewRedfishClient(ip, user, pass string, insecure bool) (*Redfish, error) {
 	client, err := gofish.Connect(config)
 	if err != nil {
 		e := ParseRedfishError(err)
-		// Try with default credentials
-		if e.Code == "401" {
-			config.Username = "root"
-			config.Password = "calvin"
+		// Try Dell's factory default credentials only when enabled, rotate
+		// them with grendel bmc credentials rotate --factory
+		if e.Code == "401" && viper.GetBool("bmc.factory_fallback") {
+			log.Warnf("Login to BMC %s failed, trying factory default credentials", ip)
+			config.Username = FactoryUser
+			config.Password = FactoryPassword
 			client, err = gofish.Connect(config)
 			if err != nil {
 				log.Debug("default credentials failed")
@@ -67,3 +77,21 @@ func NewRedfishClient(ip, user, pass string, insecure bool) (*Redfish, error) {
 		service: client.GetService(),
 	}, nil
 }
+
+// checkLogin logs in to the BMC and out again without falling back to the
+// factory default credentials
+func checkLogin(ip, user, pass string, insecure bool) error {
+	client, err := gofish.Connect(gofish.ClientConfig{
+		Endpoint: "https://" + ip,
+		Username: user,
+		Password: pass,
+		Insecure: insecure,
+	})
+	if err != nil {
+		return err
+	}
+
+	client.Logout()
+
+	return nil
+}
@@ -7,11 +7,13 @@ package bmc
 import "github.com/spf13/viper"
 
 const (
-	delay  = 1
-	fanout = 5
+	delay    = 1
+	fanout   = 5
+	maxTasks = 4
 )
 
 func init() {
 	viper.SetDefault("bmc.delay", delay)
 	viper.SetDefault("bmc.fanout", fanout)
+	viper.SetDefault("bmc.max_tasks", maxTasks)
 }
@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// ErrVaultDisabled is returned when storing credentials without a
+// bmc.credential_key
+var ErrVaultDisabled = errors.New("bmc credential vault is disabled, set bmc.credential_key")
+
+// Vault stores BMC credentials per host or per tag in the database with the
+// passwords encrypted with AES-256-GCM
+type Vault struct {
+	DB   store.Store
+	aead cipher.AEAD
+}
+
+// NewVault returns a vault using the base64 encoded 32 byte key. The vault is
+// disabled when the key is empty and every host uses the global bmc.user and
+// bmc.password.
+func NewVault(db store.Store, key string) (*Vault, error) {
+	v := &Vault{DB: db}
+	if key == "" {
+		return v, nil
+	}
+
+	k, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bmc.credential_key: %w", err)
+	}
+	if len(k) != 32 {
+		return nil, errors.New("invalid bmc.credential_key: must be 32 bytes base64 encoded")
+	}
+
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, err
+	}
+
+	v.aead, err = cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Enabled returns true if the vault has a key
+func (v *Vault) Enabled() bool {
+	return v != nil && v.aead != nil
+}
+
+// Set encrypts and stores the credential of a host or tag
+func (v *Vault) Set(scope, name, username, password string) error {
+	if !v.Enabled() {
+		return ErrVaultDisabled
+	}
+
+	enc, err := v.encrypt(scope, name, password)
+	if err != nil {
+		return err
+	}
+
+	return v.DB.StoreBmcCredential(&model.BmcCredential{
+		Scope:    scope,
+		Name:     name,
+		Username: username,
+		Password: enc,
+	})
+}
+
+// Get returns the decrypted credential of a host or tag
+func (v *Vault) Get(scope, name string) (*model.BmcCredential, error) {
+	if !v.Enabled() {
+		return nil, ErrVaultDisabled
+	}
+
+	cred, err := v.DB.LoadBmcCredential(scope, name)
+	if err != nil {
+		return nil, err
+	}
+
+	cred.Password, err = v.decrypt(cred)
+	if err != nil {
+		return nil, err
+	}
+
+	return cred, nil
+}
+
+// SetPending stores a rotated password of a host until the BMC is known to
+// use it
+func (v *Vault) SetPending(name, username, password string) error {
+	return v.Set(model.BmcCredentialPending, name, username, password)
+}
+
+// ConfirmPending makes the pending password of a host its credential
+func (v *Vault) ConfirmPending(name string) error {
+	cred, err := v.Get(model.BmcCredentialPending, name)
+	if err != nil {
+		return err
+	}
+
+	if err := v.Set(model.BmcCredentialHost, name, cred.Username, cred.Password); err != nil {
+		return err
+	}
+
+	return v.DB.DeleteBmcCredential(model.BmcCredentialPending, name)
+}
+
+// DiscardPending deletes the pending password of a host the BMC refused
+func (v *Vault) DiscardPending(name string) error {
+	return v.DB.DeleteBmcCredential(model.BmcCredentialPending, name)
+}
+
+// snapshot loads every credential for the hosts of a single job
+func (v *Vault) snapshot() (*credentialSet, error) {
+	if !v.Enabled() {
+		return nil, nil
+	}
+
+	creds, err := v.DB.BmcCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	set := &credentialSet{
+		vault: v,
+		creds: make(map[string]*model.BmcCredential, len(creds)),
+	}
+	for _, c := range creds {
+		set.creds[c.Scope+"/"+c.Name] = c
+	}
+
+	return set, nil
+}
+
+// encrypt seals the password with the scope and name as additional data so
+// an encrypted password can't be moved to another host
+func (v *Vault) encrypt(scope, name, password string) (string, error) {
+	nonce := make([]byte, v.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := v.aead.Seal(nonce, nonce, []byte(password), []byte(scope+"/"+name))
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (v *Vault) decrypt(cred *model.BmcCredential) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(cred.Password)
+	if err != nil || len(sealed) < v.aead.NonceSize() {
+		return "", fmt.Errorf("invalid encrypted password for %s %s", cred.Scope, cred.Name)
+	}
+
+	n := v.aead.NonceSize()
+	password, err := v.aead.Open(nil, sealed[:n], sealed[n:], []byte(cred.Scope+"/"+cred.Name))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt password for %s %s, wrong bmc.credential_key?", cred.Scope, cred.Name)
+	}
+
+	return string(password), nil
+}
+
+// credentialSet is the vault contents loaded for a single job
+type credentialSet struct {
+	vault *Vault
+	creds map[string]*model.BmcCredential
+}
+
+// lookup returns the credential of the host, or of its first tag with a
+// credential
+func (s *credentialSet) lookup(host *model.Host) (string, string, bool) {
+	candidates := []string{model.BmcCredentialHost + "/" + host.Name}
+	for _, tag := range host.Tags {
+		candidates = append(candidates, model.BmcCredentialTag+"/"+tag)
+	}
+
+	for _, key := range candidates {
+		cred, ok := s.creds[key]
+		if !ok {
+			continue
+		}
+
+		password, err := s.vault.decrypt(cred)
+		if err != nil {
+			log.Errorf("Ignoring BMC credential of %s: %s", host.Name, err)
+			continue
+		}
+
+		return cred.Username, password, true
+	}
+
+	return "", "", false
+}
+
+const passwordChars = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// GeneratePassword returns a random password of the given length with at
+// least one lower case letter, upper case letter and digit, which most BMC
+// password policies require
+func GeneratePassword(length int) (string, error) {
+	if length < 8 {
+		return "", errors.New("bmc passwords must be at least 8 characters")
+	}
+
+	max := big.NewInt(int64(len(passwordChars)))
+	for {
+		b := make([]byte, length)
+		var lower, upper, digit bool
+		for i := range b {
+			n, err := rand.Int(rand.Reader, max)
+			if err != nil {
+				return "", err
+			}
+			c := passwordChars[n.Int64()]
+			switch {
+			case c >= 'a' && c <= 'z':
+				lower = true
+			case c >= 'A' && c <= 'Z':
+				upper = true
+			default:
+				digit = true
+			}
+			b[i] = c
+		}
+
+		if lower && upper && digit {
+			return string(b), nil
+		}
+	}
+}
+
+// RotateRequest changes the password of the BMC account of each host to a
+// new random password stored in the vault
+type RotateRequest struct {
+	// Username and Password log in to the BMC instead of the credentials of
+	// the host, for example the factory defaults. The password of this
+	// account is rotated.
+	Username string
+	Password string
+
+	// Length of the new passwords
+	Length int
+}
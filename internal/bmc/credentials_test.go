@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestVault(t *testing.T) {
+	assert := assert.New(t)
+
+	db, err := sqlstore.New(":memory:")
+	if err != nil {
+		assert.Fail(err.Error())
+	}
+
+	disabled, err := NewVault(db, "")
+	if assert.NoError(err) {
+		assert.False(disabled.Enabled())
+		assert.ErrorIs(disabled.Set(model.BmcCredentialHost, "cpn-01", "root", "secret"), ErrVaultDisabled)
+	}
+
+	_, err = NewVault(db, base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.Error(err)
+
+	key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+	v, err := NewVault(db, key)
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.NoError(v.Set(model.BmcCredentialHost, "cpn-01", "root", "host-secret"))
+	assert.NoError(v.Set(model.BmcCredentialTag, "supermicro", "ADMIN", "tag-secret"))
+
+	// Passwords are stored encrypted
+	stored, err := db.LoadBmcCredential(model.BmcCredentialHost, "cpn-01")
+	if assert.NoError(err) {
+		assert.NotEqual("host-secret", stored.Password)
+	}
+
+	cred, err := v.Get(model.BmcCredentialHost, "cpn-01")
+	if assert.NoError(err) {
+		assert.Equal("root", cred.Username)
+		assert.Equal("host-secret", cred.Password)
+	}
+
+	other, err := NewVault(db, base64.StdEncoding.EncodeToString([]byte(strings.Repeat("x", 32))))
+	if assert.NoError(err) {
+		_, err = other.Get(model.BmcCredentialHost, "cpn-01")
+		assert.Error(err)
+	}
+
+	set, err := v.snapshot()
+	if !assert.NoError(err) {
+		return
+	}
+
+	user, pass, ok := set.lookup(&model.Host{Name: "cpn-01", Tags: []string{"supermicro"}})
+	if assert.True(ok) {
+		assert.Equal("root", user)
+		assert.Equal("host-secret", pass)
+	}
+
+	user, pass, ok = set.lookup(&model.Host{Name: "cpn-02", Tags: []string{"ib", "supermicro"}})
+	if assert.True(ok) {
+		assert.Equal("ADMIN", user)
+		assert.Equal("tag-secret", pass)
+	}
+
+	_, _, ok = set.lookup(&model.Host{Name: "cpn-03"})
+	assert.False(ok)
+}
+
+func TestGeneratePassword(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := GeneratePassword(4)
+	assert.Error(err)
+
+	p, err := GeneratePassword(16)
+	if assert.NoError(err) {
+		assert.Len(p, 16)
+		assert.True(strings.ContainsAny(p, "0123456789"))
+		assert.NotEqual(strings.ToLower(p), p)
+		assert.NotEqual(strings.ToUpper(p), p)
+	}
+}
+
+func TestVaultPending(t *testing.T) {
+	assert := assert.New(t)
+
+	db, err := sqlstore.New(":memory:")
+	if err != nil {
+		assert.Fail(err.Error())
+	}
+
+	v, err := NewVault(db, base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))))
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.NoError(v.Set(model.BmcCredentialHost, "cpn-01", "root", "old-secret"))
+	assert.NoError(v.SetPending("cpn-01", "root", "new-secret"))
+
+	// Pending passwords are never used to log in
+	set, err := v.snapshot()
+	if assert.NoError(err) {
+		_, pass, ok := set.lookup(&model.Host{Name: "cpn-01"})
+		assert.True(ok)
+		assert.Equal("old-secret", pass)
+	}
+
+	if assert.NoError(v.ConfirmPending("cpn-01")) {
+		cred, err := v.Get(model.BmcCredentialHost, "cpn-01")
+		if assert.NoError(err) {
+			assert.Equal("new-secret", cred.Password)
+		}
+		_, err = v.Get(model.BmcCredentialPending, "cpn-01")
+		assert.ErrorIs(err, store.ErrNotFound)
+	}
+
+	assert.NoError(v.SetPending("cpn-01", "root", "refused"))
+	assert.NoError(v.DiscardPending("cpn-01"))
+	cred, err := v.Get(model.BmcCredentialHost, "cpn-01")
+	if assert.NoError(err) {
+		assert.Equal("new-secret", cred.Password)
+	}
+}
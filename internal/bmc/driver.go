@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	DriverRedfish = "redfish"
+	DriverIPMI    = "ipmi"
+)
+
+var (
+	// TagDriverRedfish and TagDriverIPMI select the BMC driver of a host.
+	// Hosts without either tag try Redfish and fall back to IPMI.
+	TagDriverRedfish = "grendel:bmc=" + DriverRedfish
+	TagDriverIPMI    = "grendel:bmc=" + DriverIPMI
+
+	// ErrNotSupported is returned by drivers for operations the BMC protocol
+	// does not provide
+	ErrNotSupported = errors.New("operation not supported by the bmc driver")
+
+	// detected caches the driver found for BMC addresses without a driver
+	// tag, with the time it was detected
+	detected sync.Map
+)
+
+// ipmiDetectRetry is how long a BMC detected as IPMI only is used with IPMI
+// before Redfish is tried again, as Redfish may have been down for a BMC
+// reboot or firmware update
+const ipmiDetectRetry = 15 * time.Minute
+
+type detection struct {
+	driver string
+	at     time.Time
+}
+
+// Driver controls a host through its BMC. Jobs, configuration import,
+// metric reports and firmware updates are Redfish only and use the Redfish
+// client directly.
+type Driver interface {
+	// PowerControl sets the boot override of the next boot, if not none,
+	// and changes the power state
+	PowerControl(resetType schemas.ResetType, bootOverride schemas.BootSource) error
+
+	// GetSystem returns the system summary, power state and health
+	GetSystem() (*model.RedfishSystem, error)
+
+	// ClearSel clears the system event log
+	ClearSel() error
+
+	// PowerCycleBmc reboots the BMC
+	PowerCycleBmc() error
+
+	// Logout closes the session with the BMC
+	Logout()
+}
+
+// Logout closes the Redfish session
+func (r *Redfish) Logout() {
+	r.client.Logout()
+}
+
+// driver connects to the BMC of host with the driver selected by its tags,
+// or the one detected earlier for its address. Undetected BMCs try Redfish
+// first and fall back to IPMI when Redfish is unavailable.
+func (r *jobRunner) driver(host *model.Host) (Driver, error) {
+	bmc := host.InterfaceBMC()
+	if bmc == nil {
+		return nil, errors.New("failed to find bmc interface to query")
+	}
+	ip := bmc.AddrString()
+	user, pass := r.credentials(host)
+
+	switch {
+	case host.HasTags(TagDriverIPMI):
+		return NewIPMIClient(ip, user, pass)
+	case host.HasTags(TagDriverRedfish):
+		return NewRedfishClient(ip, user, pass, r.insecure)
+	}
+
+	if ipmiDetected(ip, time.Now()) {
+		return NewIPMIClient(ip, user, pass)
+	}
+
+	rf, err := NewRedfishClient(ip, user, pass, r.insecure)
+	if err == nil {
+		detected.Store(ip, detection{driver: DriverRedfish, at: time.Now()})
+		return rf, nil
+	}
+
+	if d, ok := detected.Load(ip); ok && d.(detection).driver == DriverRedfish {
+		// Redfish worked before, don't mask the error with an IPMI one
+		return nil, err
+	}
+
+	log.Debugf("Redfish failed on %s, trying IPMI: %s", host.Name, err)
+	ic, ierr := NewIPMIClient(ip, user, pass)
+	if ierr != nil {
+		return nil, fmt.Errorf("%w (ipmi: %s)", err, ierr)
+	}
+
+	log.Infof("Detected IPMI only BMC on %s", host.Name)
+	detected.Store(ip, detection{driver: DriverIPMI, at: time.Now()})
+
+	return ic, nil
+}
+
+// ipmiDetected returns true if the BMC at ip was detected as IPMI only less
+// than ipmiDetectRetry ago
+func ipmiDetected(ip string, now time.Time) bool {
+	d, ok := detected.Load(ip)
+	if !ok {
+		return false
+	}
+
+	det := d.(detection)
+	return det.driver == DriverIPMI && now.Sub(det.at) < ipmiDetectRetry
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPMIDetected(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	detected.Store("10.64.0.1", detection{driver: DriverIPMI, at: now.Add(-time.Minute)})
+	detected.Store("10.64.0.2", detection{driver: DriverIPMI, at: now.Add(-ipmiDetectRetry)})
+	detected.Store("10.64.0.3", detection{driver: DriverRedfish, at: now})
+	defer func() {
+		for _, ip := range []string{"10.64.0.1", "10.64.0.2", "10.64.0.3"} {
+			detected.Delete(ip)
+		}
+	}()
+
+	assert.True(ipmiDetected("10.64.0.1", now))
+
+	// Redfish is tried again once the IPMI detection expires
+	assert.False(ipmiDetected("10.64.0.2", now))
+
+	assert.False(ipmiDetected("10.64.0.3", now))
+	assert.False(ipmiDetected("10.64.0.4", now))
+}
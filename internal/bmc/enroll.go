@@ -0,0 +1,439 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/stream"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// ErrNoMapping is returned when an enrolling BMC matches no entry of the
+// enrollment mapping
+var ErrNoMapping = errors.New("no enrollment mapping")
+
+// BmcIdentity is what a BMC reports about the server it manages, used to
+// enroll it as a new host
+type BmcIdentity struct {
+	Serial   string
+	SKU      string
+	Location string
+	NICs     []HostNIC
+}
+
+// HostNIC is a host NIC reported by the BMC with its Redfish
+// EthernetInterface id
+type HostNIC struct {
+	ID  string
+	MAC net.HardwareAddr
+}
+
+// Keys returns the serial numbers and location the identity can be mapped by
+func (id *BmcIdentity) Keys() []string {
+	var keys []string
+	for _, k := range []string{id.SKU, id.Serial, id.Location} {
+		if k != "" {
+			keys = append(keys, k)
+		}
+	}
+
+	return keys
+}
+
+// EnrollEntry is a line of the enrollment mapping
+type EnrollEntry struct {
+	Key     string
+	Name    string
+	BootIP  netip.Prefix
+	BmcIP   netip.Prefix
+	BmcName string
+	BootNIC string
+}
+
+// EnrollMapping maps a serial number, service tag or rack location to the
+// name and addresses of a host
+type EnrollMapping map[string]*EnrollEntry
+
+// LoadEnrollMapping reads the enrollment mapping from a file
+func LoadEnrollMapping(path string) (EnrollMapping, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ParseEnrollMapping(file)
+}
+
+// ParseEnrollMapping parses an enrollment mapping. Each line has a serial
+// number, service tag or rack/offset location, the host name, the boot and BMC
+// addresses in CIDR notation, optionally the BMC name and optionally the boot
+// NIC. A BMC name of - means none. Blank lines and lines starting with # are
+// ignored.
+func ParseEnrollMapping(r io.Reader) (EnrollMapping, error) {
+	mapping := make(EnrollMapping)
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		cols := strings.Fields(text)
+		if len(cols) < 4 || len(cols) > 6 {
+			return nil, fmt.Errorf("line %d: expected 4 to 6 columns, got %d", line, len(cols))
+		}
+
+		var err error
+		entry := &EnrollEntry{
+			Key:  cols[0],
+			Name: cols[1],
+		}
+
+		entry.BootIP, err = netip.ParsePrefix(cols[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid boot address %s", line, cols[2])
+		}
+
+		entry.BmcIP, err = netip.ParsePrefix(cols[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid BMC address %s", line, cols[3])
+		}
+
+		if len(cols) >= 5 && cols[4] != "-" {
+			entry.BmcName = cols[4]
+		}
+
+		if len(cols) == 6 {
+			entry.BootNIC = cols[5]
+		}
+
+		key := strings.ToLower(entry.Key)
+		if _, ok := mapping[key]; ok {
+			return nil, fmt.Errorf("line %d: duplicate key %s", line, entry.Key)
+		}
+		mapping[key] = entry
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return mapping, nil
+}
+
+// Lookup returns the entry for the first key of the identity found in the
+// mapping
+func (m EnrollMapping) Lookup(id *BmcIdentity) (*EnrollEntry, error) {
+	for _, k := range id.Keys() {
+		if entry, ok := m[strings.ToLower(k)]; ok {
+			return entry, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w for serial %q service tag %q location %q", ErrNoMapping, id.Serial, id.SKU, id.Location)
+}
+
+// bootNIC returns the index of the host NIC named by the boot NIC of the
+// mapping entry: its Redfish EthernetInterface id, or else its index in the
+// order of the ids starting at 0. Without a boot NIC it's the first NIC.
+func (e *EnrollEntry) bootNIC(nics []HostNIC) (int, error) {
+	if e.BootNIC == "" {
+		return 0, nil
+	}
+
+	for i, n := range nics {
+		if strings.EqualFold(n.ID, e.BootNIC) {
+			return i, nil
+		}
+	}
+
+	if i, err := strconv.Atoi(e.BootNIC); err == nil && i >= 0 && i < len(nics) {
+		return i, nil
+	}
+
+	ids := make([]string, 0, len(nics))
+	for _, n := range nics {
+		ids = append(ids, n.ID)
+	}
+
+	return 0, fmt.Errorf("%w: boot NIC %s of %s not found in %s", ErrNoMapping, e.BootNIC, e.Name, strings.Join(ids, ", "))
+}
+
+// NewEnrolledHost returns the host for a BMC with the given MAC address,
+// identity and mapping entry. The boot NIC of the entry, or else the first
+// host NIC reported by the BMC, becomes the boot interface and the other host
+// NICs are added without addresses.
+func NewEnrolledHost(bmcMAC net.HardwareAddr, id *BmcIdentity, entry *EnrollEntry, domain string) (*model.Host, error) {
+	if len(id.NICs) == 0 {
+		return nil, fmt.Errorf("BMC %s reported no host NICs", bmcMAC)
+	}
+
+	boot, err := entry.bootNIC(id.NICs)
+	if err != nil {
+		return nil, err
+	}
+
+	fqdn := func(name string) string {
+		if name == "" || domain == "" {
+			return name
+		}
+		return name + "." + domain
+	}
+
+	// The boot interface comes first, see model.Host.BootInterface
+	interfaces := []*model.NetInterface{
+		{
+			MAC:  id.NICs[boot].MAC,
+			IP:   entry.BootIP,
+			FQDN: fqdn(entry.Name),
+		},
+		{
+			MAC:  bmcMAC,
+			IP:   entry.BmcIP,
+			FQDN: fqdn(entry.BmcName),
+			BMC:  true,
+		},
+	}
+	for i, n := range id.NICs {
+		if i != boot {
+			interfaces = append(interfaces, &model.NetInterface{MAC: n.MAC})
+		}
+	}
+
+	return &model.Host{
+		Name:       entry.Name,
+		Interfaces: interfaces,
+		Bonds:      []*model.Bond{},
+		Tags:       []string{},
+	}, nil
+}
+
+// Identity returns the serial number, service tag, rack location and host NIC
+// MAC addresses of the server managed by the BMC
+func (r *Redfish) Identity() (*BmcIdentity, error) {
+	id := &BmcIdentity{}
+
+	ss, err := r.service.Systems()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range ss {
+		id.Serial = firstOf(id.Serial, strings.TrimSpace(s.SerialNumber))
+		id.SKU = firstOf(id.SKU, strings.TrimSpace(s.SKU))
+
+		ifaces, err := s.EthernetInterfaces()
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range ifaces {
+			mac, err := net.ParseMAC(firstOf(n.PermanentMACAddress, n.MACAddress))
+			if err != nil {
+				continue
+			}
+			id.NICs = append(id.NICs, HostNIC{ID: n.ID, MAC: mac})
+		}
+	}
+
+	slices.SortStableFunc(id.NICs, func(a, b HostNIC) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	chassis, err := r.service.Chassis()
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range chassis {
+		id.Serial = firstOf(id.Serial, strings.TrimSpace(c.SerialNumber))
+		id.SKU = firstOf(id.SKU, strings.TrimSpace(c.SKU))
+
+		p := c.Location.Placement
+		if id.Location == "" && p.Rack != "" && p.RackOffset != nil {
+			id.Location = p.Rack + "/" + strconv.Itoa(*p.RackOffset)
+		}
+		id.Location = firstOf(id.Location, c.Location.PartLocation.ServiceLabel)
+	}
+
+	return id, nil
+}
+
+// Enroller creates hosts for new BMCs that were handed a temporary address
+// from a dynamic DHCP pool. The BMC is queried over Redfish for the serial
+// number, location and NICs of its server, which are matched against the
+// enrollment mapping for the host name and addresses.
+type Enroller struct {
+	DB       store.Store
+	Vault    *Vault
+	Mapping  EnrollMapping
+	Domain   string
+	Tags     []string
+	Image    string
+	Attempts int
+	Interval time.Duration
+	pending  map[string]bool
+	mu       sync.Mutex
+}
+
+// NewEnroller returns an enroller configured from the discovery settings
+func NewEnroller(db store.Store, vault *Vault) (*Enroller, error) {
+	path := viper.GetString("discovery.enroll_mapping")
+	if path == "" {
+		return nil, errors.New("discovery.enroll_mapping is not set")
+	}
+
+	mapping, err := LoadEnrollMapping(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid enrollment mapping %s: %w", path, err)
+	}
+
+	return &Enroller{
+		DB:       db,
+		Vault:    vault,
+		Mapping:  mapping,
+		Domain:   viper.GetString("discovery.domain"),
+		Tags:     viper.GetStringSlice("discovery.enroll_tags"),
+		Image:    viper.GetString("discovery.enroll_image"),
+		Attempts: 20,
+		Interval: 30 * time.Second,
+		pending:  make(map[string]bool),
+	}, nil
+}
+
+// Enroll starts enrolling the BMC with the given MAC address and temporary
+// address in the background unless it is already being enrolled. The BMC is
+// retried until its Redfish service answers.
+func (e *Enroller) Enroll(mac net.HardwareAddr, ip netip.Addr) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.pending[mac.String()] {
+		return
+	}
+	e.pending[mac.String()] = true
+
+	go func() {
+		defer func() {
+			e.mu.Lock()
+			delete(e.pending, mac.String())
+			e.mu.Unlock()
+		}()
+
+		for attempt := 1; attempt <= e.Attempts; attempt++ {
+			host, err := e.enroll(mac, ip)
+			if err == nil {
+				log.Infof("Enrolled BMC %s at %s as host %s", mac, ip, host.Name)
+				e.event("Success", fmt.Sprintf("Enrolled BMC %s as host %s", mac, host.Name), host.Name)
+				return
+			}
+
+			if errors.Is(err, ErrNoMapping) || attempt == e.Attempts {
+				log.Errorf("Failed to enroll BMC %s at %s: %s", mac, ip, err)
+				e.event("Error", fmt.Sprintf("Failed to enroll BMC %s at %s: %s", mac, ip, err))
+				return
+			}
+
+			log.Debugf("Enrolling BMC %s at %s failed, retrying in %s: %s", mac, ip, e.Interval, err)
+			time.Sleep(e.Interval)
+		}
+	}()
+}
+
+func (e *Enroller) enroll(mac net.HardwareAddr, ip netip.Addr) (*model.Host, error) {
+	// The BMC may have been enrolled by an earlier attempt or added by hand
+	if host, err := e.DB.LoadHostFromMAC(mac.String()); err == nil {
+		return host, nil
+	} else if !errors.Is(err, store.ErrNotFound) {
+		return nil, err
+	}
+
+	user, pass := viper.GetString("bmc.user"), viper.GetString("bmc.password")
+	creds, err := e.Vault.snapshot()
+	if err != nil {
+		log.Errorf("Failed to load BMC credentials, using bmc.user: %s", err)
+	}
+	if creds != nil {
+		if u, p, ok := creds.lookup(&model.Host{Tags: e.Tags}); ok {
+			user, pass = u, p
+		}
+	}
+
+	r, err := NewRedfishClient(ip.String(), user, pass, viper.GetBool("bmc.insecure"))
+	if err != nil {
+		return nil, err
+	}
+	defer r.client.Logout()
+
+	id, err := r.Identity()
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := e.Mapping.Lookup(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := e.DB.LoadHostFromName(entry.Name); err == nil {
+		return nil, fmt.Errorf("%w: host %s mapped to %s already exists", ErrNoMapping, entry.Name, entry.Key)
+	}
+
+	host, err := NewEnrolledHost(mac, id, entry, e.Domain)
+	if err != nil {
+		return nil, err
+	}
+	host.Tags = append(host.Tags, e.Tags...)
+	host.BootImage = e.Image
+	host.Provision = e.Image != ""
+
+	if err := e.DB.StoreHost(host); err != nil {
+		return nil, err
+	}
+
+	if err := e.DB.DeleteDHCPLease(mac.String()); err != nil && !errors.Is(err, store.ErrNotFound) {
+		log.Warnf("Failed to release dynamic lease of enrolled BMC %s: %s", mac, err)
+	}
+
+	// Restart the BMC so it requests its assigned address right away instead
+	// of when the temporary lease expires
+	if err := r.PowerCycleBmc(); err != nil {
+		log.Warnf("Failed to restart enrolled BMC %s, it moves to %s when its lease expires: %s", mac, entry.BmcIP.Addr(), err)
+	}
+
+	return host, nil
+}
+
+func (e *Enroller) event(severity, msg string, hosts ...string) {
+	event := model.Event{
+		Severity: severity,
+		Time:     time.Now().UTC(),
+		User:     "enroll",
+		Message:  msg,
+		Hosts:    hosts,
+	}
+
+	if err := e.DB.StoreEvent(&event); err != nil {
+		log.Errorf("failed to store event: %s", err)
+	}
+
+	stream.PublishEvent(event)
+}
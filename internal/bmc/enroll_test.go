@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnrollMapping(t *testing.T) {
+	assert := assert.New(t)
+
+	mapping, err := ParseEnrollMapping(strings.NewReader(`
+# serial        name        boot            bmc             bmc name
+7XK2PW3         cpn-d13-01  10.128.13.1/16  10.64.13.1/16   bmc-d13-01
+d13/2           cpn-d13-02  10.128.13.2/16  10.64.13.2/16
+`))
+	if !assert.NoError(err) {
+		return
+	}
+	assert.Len(mapping, 2)
+
+	entry, err := mapping.Lookup(&BmcIdentity{Serial: "CN7792151E00AB", SKU: "7xk2pw3"})
+	if assert.NoError(err) {
+		assert.Equal("cpn-d13-01", entry.Name)
+		assert.Equal("bmc-d13-01", entry.BmcName)
+	}
+
+	entry, err = mapping.Lookup(&BmcIdentity{Serial: "CN7792151E00AC", Location: "d13/2"})
+	if assert.NoError(err) {
+		assert.Equal("cpn-d13-02", entry.Name)
+		assert.Equal("10.64.13.2/16", entry.BmcIP.String())
+	}
+
+	_, err = mapping.Lookup(&BmcIdentity{Serial: "unknown"})
+	assert.ErrorIs(err, ErrNoMapping)
+
+	_, err = ParseEnrollMapping(strings.NewReader("7XK2PW3 cpn-d13-01 10.128.13.1 10.64.13.1/16"))
+	assert.Error(err)
+
+	_, err = ParseEnrollMapping(strings.NewReader("7XK2PW3 cpn-d13-01 10.128.13.1/16"))
+	assert.Error(err)
+
+	_, err = ParseEnrollMapping(strings.NewReader("a cpn-01 10.0.0.1/24 10.1.0.1/24\nA cpn-02 10.0.0.2/24 10.1.0.2/24"))
+	assert.Error(err)
+}
+
+func TestNewEnrolledHost(t *testing.T) {
+	assert := assert.New(t)
+
+	mapping, err := ParseEnrollMapping(strings.NewReader("7XK2PW3 cpn-d13-01 10.128.13.1/16 10.64.13.1/16 bmc-d13-01"))
+	if !assert.NoError(err) {
+		return
+	}
+
+	bmcMAC, _ := net.ParseMAC("d0:8e:79:00:00:01")
+	nic1, _ := net.ParseMAC("b0:26:28:00:00:01")
+	nic2, _ := net.ParseMAC("b0:26:28:00:00:02")
+
+	id := &BmcIdentity{SKU: "7XK2PW3"}
+	entry, err := mapping.Lookup(id)
+	if !assert.NoError(err) {
+		return
+	}
+
+	_, err = NewEnrolledHost(bmcMAC, id, entry, "example.com")
+	assert.Error(err)
+
+	id.NICs = []HostNIC{{ID: "NIC.Embedded.1-1-1", MAC: nic1}, {ID: "NIC.Slot.3-1-1", MAC: nic2}}
+	host, err := NewEnrolledHost(bmcMAC, id, entry, "example.com")
+	if !assert.NoError(err) {
+		return
+	}
+
+	assert.Equal("cpn-d13-01", host.Name)
+	if assert.Len(host.Interfaces, 3) {
+		assert.Equal(nic1.String(), host.Interfaces[0].MAC.String())
+		assert.Equal("cpn-d13-01.example.com", host.Interfaces[0].FQDN)
+		assert.False(host.Interfaces[0].BMC)
+		assert.Equal(nic2.String(), host.Interfaces[2].MAC.String())
+		assert.False(host.Interfaces[2].IP.IsValid())
+		assert.False(host.Interfaces[2].BMC)
+	}
+
+	bmc := host.InterfaceBMC()
+	if assert.NotNil(bmc) {
+		assert.Equal(bmcMAC.String(), bmc.MAC.String())
+		assert.Equal("10.64.13.1", bmc.AddrString())
+		assert.Equal("bmc-d13-01.example.com", bmc.FQDN)
+	}
+
+	// The mapping names the boot NIC by Redfish id or index
+	for _, nic := range []string{"NIC.Slot.3-1-1", "1"} {
+		entry.BootNIC = nic
+		host, err = NewEnrolledHost(bmcMAC, id, entry, "example.com")
+		if assert.NoError(err) {
+			assert.Equal(nic2.String(), host.BootInterface().MAC.String())
+			assert.Equal("10.128.13.1", host.BootInterface().AddrString())
+			assert.Equal(nic1.String(), host.Interfaces[2].MAC.String())
+		}
+	}
+
+	entry.BootNIC = "NIC.Slot.4-1-1"
+	_, err = NewEnrolledHost(bmcMAC, id, entry, "example.com")
+	assert.ErrorIs(err, ErrNoMapping)
+
+	mapping, err = ParseEnrollMapping(strings.NewReader("7XK2PW3 cpn-d13-01 10.128.13.1/16 10.64.13.1/16 - NIC.Slot.3-1-1"))
+	if assert.NoError(err) {
+		entry = mapping["7xk2pw3"]
+		assert.Equal("", entry.BmcName)
+		assert.Equal("NIC.Slot.3-1-1", entry.BootNIC)
+	}
+}
@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// CollectInventory collects the inventory of every server in the store from
+// its BMC
+func CollectInventory(db store.Store, vault *Vault) (model.JobMessageList, error) {
+	hostList, err := db.Hosts()
+	if err != nil {
+		return nil, err
+	}
+
+	servers := make(model.HostList, 0, len(hostList))
+	for _, host := range hostList {
+		if host.InterfaceBMC() != nil {
+			servers = append(servers, host)
+		}
+	}
+
+	job := NewJob()
+	job.Vault = vault
+
+	return job.CollectInventory(servers, db)
+}
+
+// GetInventory returns the DIMMs, CPUs, PCIe devices, NICs, drives and
+// firmware of the systems managed by the BMC
+func (r *Redfish) GetInventory() (model.InventoryComponentList, error) {
+	var inv model.InventoryComponentList
+
+	ss, err := r.service.Systems()
+	if err != nil {
+		return nil, err
+	}
+
+	bios := ""
+	for _, s := range ss {
+		if s.BiosVersion != "" {
+			bios = s.BiosVersion
+		}
+
+		mem, err := s.Memory()
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range mem {
+			if absent(m.Status) {
+				continue
+			}
+			c := &model.InventoryComponent{
+				Kind:         model.InventoryDIMM,
+				Slot:         firstOf(m.DeviceLocator, m.ID),
+				Name:         m.Name,
+				Manufacturer: m.Manufacturer,
+				Model:        m.Model,
+				Serial:       strings.TrimSpace(m.SerialNumber),
+				PartNumber:   strings.TrimSpace(m.PartNumber),
+			}
+			if m.CapacityMiB != nil {
+				c.Capacity = int64(*m.CapacityMiB) << 20
+			}
+			inv = append(inv, c)
+		}
+
+		cpus, err := s.Processors()
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range cpus {
+			if absent(p.Status) {
+				continue
+			}
+			inv = append(inv, &model.InventoryComponent{
+				Kind:         model.InventoryCPU,
+				Slot:         firstOf(p.Socket, p.ID),
+				Name:         p.Name,
+				Manufacturer: p.Manufacturer,
+				Model:        strings.TrimSpace(p.Model),
+				Serial:       p.SerialNumber,
+				PartNumber:   p.PartNumber,
+				Version:      p.FirmwareVersion,
+			})
+		}
+
+		pcie, err := s.PCIeDevices()
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range pcie {
+			if absent(p.Status) {
+				continue
+			}
+			inv = append(inv, &model.InventoryComponent{
+				Kind:         model.InventoryPCIe,
+				Slot:         p.ID,
+				Name:         p.Name,
+				Manufacturer: p.Manufacturer,
+				Model:        p.Model,
+				Serial:       p.SerialNumber,
+				PartNumber:   p.PartNumber,
+				Version:      p.FirmwareVersion,
+			})
+		}
+
+		nics, err := s.EthernetInterfaces()
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nics {
+			inv = append(inv, &model.InventoryComponent{
+				Kind:    model.InventoryNIC,
+				Slot:    n.ID,
+				Name:    n.Name,
+				Address: strings.ToLower(firstOf(n.PermanentMACAddress, n.MACAddress)),
+			})
+		}
+
+		storage, err := s.Storage()
+		if err != nil {
+			return nil, err
+		}
+		for _, st := range storage {
+			drives, err := st.Drives()
+			if err != nil {
+				return nil, err
+			}
+			for _, d := range drives {
+				if absent(d.Status) {
+					continue
+				}
+				c := &model.InventoryComponent{
+					Kind:         model.InventoryDrive,
+					Slot:         d.ID,
+					Name:         d.Name,
+					Manufacturer: d.Manufacturer,
+					Model:        strings.TrimSpace(d.Model),
+					Serial:       strings.TrimSpace(d.SerialNumber),
+					PartNumber:   d.PartNumber,
+					Version:      firstOf(d.Revision, d.FirmwareVersion),
+				}
+				if d.CapacityBytes != nil {
+					c.Capacity = int64(*d.CapacityBytes)
+				}
+				inv = append(inv, c)
+			}
+		}
+	}
+
+	firmware, err := r.firmwareInventory()
+	if err != nil {
+		return nil, err
+	}
+	inv = append(inv, firmware...)
+
+	hasBios := false
+	for _, c := range firmware {
+		if strings.EqualFold(c.Name, "BIOS") {
+			hasBios = true
+		}
+	}
+	if bios != "" && !hasBios {
+		inv = append(inv, &model.InventoryComponent{
+			Kind:    model.InventoryFirmware,
+			Slot:    "BIOS",
+			Name:    "BIOS",
+			Version: bios,
+		})
+	}
+
+	// Multi node chassis can list the same slot more than once
+	seen := make(map[string]bool, len(inv))
+	unique := inv[:0]
+	for _, c := range inv {
+		if c.Slot == "" || seen[c.Key()] {
+			continue
+		}
+		seen[c.Key()] = true
+		unique = append(unique, c)
+	}
+
+	return unique, nil
+}
+
+// firmwareInventory returns the installed firmware listed by the
+// UpdateService. Firmware ids often contain the version, like Dell's
+// Installed-159-7.00.00.171__iDRAC.Embedded.1-1, so only the part after the
+// last __ identifies the slot.
+func (r *Redfish) firmwareInventory() (model.InventoryComponentList, error) {
+	us, err := r.service.UpdateService()
+	if err != nil {
+		return nil, err
+	}
+
+	fw, err := us.FirmwareInventory()
+	if err != nil {
+		return nil, err
+	}
+
+	var inv model.InventoryComponentList
+	for _, f := range fw {
+		if strings.HasPrefix(f.ID, "Previous") || absent(f.Status) {
+			continue
+		}
+
+		slot := f.ID
+		if i := strings.LastIndex(slot, "__"); i >= 0 {
+			slot = slot[i+2:]
+		}
+		inv = append(inv, &model.InventoryComponent{
+			Kind:         model.InventoryFirmware,
+			Slot:         slot,
+			Name:         f.Name,
+			Manufacturer: f.Manufacturer,
+			Version:      f.Version,
+		})
+	}
+
+	return inv, nil
+}
+
+func absent(s schemas.Status) bool {
+	return s.State == schemas.AbsentState
+}
+
+func firstOf(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// inventorySummary describes the result of an inventory collection
+func inventorySummary(inv model.InventoryComponentList, changes model.InventoryChangeList) string {
+	msg := fmt.Sprintf("Collected %d components", len(inv))
+	if len(changes) > 0 {
+		msg += fmt.Sprintf(", %d changed", len(changes))
+	}
+
+	return msg
+}
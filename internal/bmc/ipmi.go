@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"fmt"
+
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/ipmi"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// IPMI is a BMC driver using IPMI over LAN for BMCs without Redfish
+type IPMI struct {
+	client *ipmi.Client
+}
+
+func NewIPMIClient(ip, user, pass string) (*IPMI, error) {
+	client, err := ipmi.Dial(ip, user, pass)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IPMI{client: client}, nil
+}
+
+func (i *IPMI) Logout() {
+	i.client.Close()
+}
+
+// PowerControl maps the Redfish reset types to chassis control actions
+func (i *IPMI) PowerControl(resetType schemas.ResetType, bootOverride schemas.BootSource) error {
+	if bootOverride != schemas.NoneBootSource {
+		device, efi, err := ipmiBootDevice(bootOverride)
+		if err != nil {
+			return err
+		}
+		if err := i.client.SetBootDevice(device, efi); err != nil {
+			return err
+		}
+	}
+
+	status, err := i.client.ChassisStatus()
+	if err != nil {
+		return err
+	}
+
+	var action uint8
+	switch resetType {
+	case schemas.OnResetType, schemas.ForceOnResetType:
+		if status.PowerOn {
+			return nil
+		}
+		action = ipmi.PowerUp
+	case schemas.ForceOffResetType:
+		action = ipmi.PowerDown
+	case schemas.GracefulShutdownResetType:
+		action = ipmi.SoftShutdown
+	case schemas.ForceRestartResetType, schemas.GracefulRestartResetType:
+		action = ipmi.HardReset
+		if !status.PowerOn {
+			action = ipmi.PowerUp
+		}
+	case schemas.PowerCycleResetType:
+		action = ipmi.PowerCycle
+		if !status.PowerOn {
+			action = ipmi.PowerUp
+		}
+	case schemas.NmiResetType:
+		action = ipmi.PulseDiag
+	default:
+		return fmt.Errorf("%w: reset type %s", ErrNotSupported, resetType)
+	}
+
+	return i.client.ChassisControl(action)
+}
+
+func ipmiBootDevice(bootOverride schemas.BootSource) (uint8, bool, error) {
+	switch bootOverride {
+	case schemas.PxeBootSource:
+		return ipmi.BootPXE, false, nil
+	case schemas.UefiHTTPBootSource:
+		return ipmi.BootPXE, true, nil
+	case schemas.HddBootSource:
+		return ipmi.BootDisk, false, nil
+	case schemas.UefiTargetBootSource:
+		return ipmi.BootDisk, true, nil
+	case schemas.DiagsBootSource:
+		return ipmi.BootDiag, false, nil
+	case schemas.BiosSetupBootSource:
+		return ipmi.BootBIOS, false, nil
+	}
+
+	return 0, false, fmt.Errorf("%w: boot override %s", ErrNotSupported, bootOverride)
+}
+
+// GetSystem returns the power state, health from the sensor thresholds and
+// the board and product information of the FRU
+func (i *IPMI) GetSystem() (*model.RedfishSystem, error) {
+	status, err := i.client.ChassisStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	system := &model.RedfishSystem{
+		PowerStatus: string(schemas.OffPowerState),
+	}
+	if status.PowerOn {
+		system.PowerStatus = string(schemas.OnPowerState)
+	}
+
+	sensors, err := i.client.Sensors()
+	if err != nil {
+		return nil, err
+	}
+	system.Health = ipmi.Health(sensors)
+
+	fru, err := i.client.FRU(0)
+	if err != nil {
+		log.Debugf("Failed to read FRU: %s", err)
+		return system, nil
+	}
+
+	system.Manufacturer = fru.ProductManufacturer
+	if system.Manufacturer == "" {
+		system.Manufacturer = fru.BoardManufacturer
+	}
+	system.Model = fru.ProductName
+	if system.Model == "" {
+		system.Model = fru.BoardProduct
+	}
+	system.SerialNumber = fru.ProductSerial
+	if system.SerialNumber == "" {
+		system.SerialNumber = fru.BoardSerial
+	}
+
+	return system, nil
+}
+
+func (i *IPMI) ClearSel() error {
+	return i.client.ClearSEL()
+}
+
+func (i *IPMI) PowerCycleBmc() error {
+	return i.client.ColdReset()
+}
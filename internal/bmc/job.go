@@ -5,29 +5,57 @@
 package bmc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"sort"
 	"time"
 
+	"github.com/segmentio/ksuid"
 	"github.com/spf13/viper"
 	"github.com/stmcginnis/gofish/oem/dell"
 	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
 type Job struct {
-	delay  time.Duration
-	fanout int
+	// ID identifies the results of this job on the event stream
+	ID string
+
+	// Vault holds the per host and per tag credentials, hosts without one
+	// use bmc.user and bmc.password
+	Vault *Vault
+
+	// ctx cancels the job on the hosts it has not started yet
+	ctx context.Context
+	// onResult is called with the result of each host as it finishes
+	onResult func(model.JobMessage)
+	delay    time.Duration
+	fanout   int
 }
 
 func NewJob() *Job {
 	return &Job{
+		ID:     ksuid.New().String(),
+		ctx:    context.Background(),
 		delay:  time.Duration(viper.GetInt("bmc.delay")) * time.Second,
 		fanout: viper.GetInt("bmc.fanout"),
 	}
 }
 
+// sleep waits for the delay between batches of hosts unless the job is
+// canceled
+func (j *Job) sleep() {
+	t := time.NewTimer(j.delay)
+	defer t.Stop()
+
+	select {
+	case <-j.ctx.Done():
+	case <-t.C:
+	}
+}
+
 func PrintStatusCli(output model.JobMessageList) {
 	for _, m := range output {
 		log.Warnf("Error during redfish query: %s\t %s\t %s", m.Status, m.Host, m.Msg)
@@ -58,7 +86,7 @@ func (j *Job) PowerControl(hostList model.HostList, bootOption schemas.BootSourc
 		runner.RunPowerControl(host, ch, bootOption, powerOption)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 			continue
 		}
 	}
@@ -81,7 +109,7 @@ func (j *Job) BmcStatus(hostList model.HostList) ([]model.RedfishSystem, error)
 		runner.RunBmcStatus(host, ch)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 			continue
 		}
 	}
@@ -118,7 +146,7 @@ func (j *Job) GetJobs(hostList model.HostList) (model.RedfishJobList, error) {
 		runner.RunGetJobs(host, ch)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 			continue
 		}
 	}
@@ -171,7 +199,7 @@ func (j *Job) ClearJobs(hostList model.HostList, ids []string) (model.JobMessage
 		runner.RunClearJobs(host, ch, ids)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 			continue
 		}
 	}
@@ -195,7 +223,7 @@ func (j *Job) ClearManyJobs(req map[*model.Host][]string) (model.JobMessageList,
 		runner.RunClearJobs(host, ch, jids)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 		}
 		i++
 	}
@@ -218,7 +246,7 @@ func (j *Job) PowerCycleBmc(hostList model.HostList) (model.JobMessageList, erro
 		runner.RunPowerCycleBmc(host, ch)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 			continue
 		}
 	}
@@ -241,7 +269,7 @@ func (j *Job) ClearSel(hostList model.HostList) (model.JobMessageList, error) {
 		runner.RunClearSel(host, ch)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 			continue
 		}
 	}
@@ -264,7 +292,7 @@ func (j *Job) BmcAutoConfigure(hostList model.HostList) (model.JobMessageList, e
 		runner.RunBmcAutoConfigure(host, ch)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 			continue
 		}
 	}
@@ -286,7 +314,7 @@ func (j *Job) BmcImportConfiguration(hostList model.HostList, shutdownType, file
 		runner.RunBmcImportConfiguration(host, ch, shutdownType, file)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 			continue
 		}
 	}
@@ -309,7 +337,7 @@ func (j *Job) BmcGetMetricReports(hostList model.HostList) (model.RedfishMetricR
 		runner.RunBmcGetMetricReports(host, ch)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 			continue
 		}
 	}
@@ -347,7 +375,7 @@ func (j *Job) DellInstallFromRepo(hostList model.HostList, installBody dell.Inst
 		runner.RunDellInstallFromRepo(host, ch, installBody)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 			continue
 		}
 	}
@@ -369,7 +397,7 @@ func (j *Job) DellGetRepoUpdateList(hostList model.HostList) (model.RedfishDellU
 		runner.RunDellGetRepoUpdateList(host, ch)
 
 		if (i+1)%j.fanout == 0 {
-			time.Sleep(j.delay)
+			j.sleep()
 			continue
 		}
 	}
@@ -410,3 +438,169 @@ func (j *Job) DellGetRepoUpdateList(hostList model.HostList) (model.RedfishDellU
 
 	return arr, nil
 }
+
+func (j *Job) UpdateFirmware(hostList model.HostList, update *UpdateRequest) (model.JobMessageList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunFirmwareUpdate(host, ch, update)
+
+		if (i+1)%j.fanout == 0 {
+			j.sleep()
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	return FormatOutput(ch)
+}
+
+// FirmwareTasks returns the state of the firmware update task of each host.
+// tasks maps host names to the task URIs returned by UpdateFirmware.
+func (j *Job) FirmwareTasks(hostList model.HostList, tasks map[string]string) (model.RedfishFirmwareTaskList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		taskID, ok := tasks[host.Name]
+		if !ok || host.HostType() != "server" {
+			continue
+		}
+		runner.RunFirmwareTask(host, ch, taskID)
+
+		if (i+1)%j.fanout == 0 {
+			j.sleep()
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	arr := model.RedfishFirmwareTaskList{}
+	for m := range ch {
+		d := model.RedfishFirmwareTask{TaskID: m.Data}
+		if m.Status == "success" {
+			if err := json.Unmarshal([]byte(m.Msg), &d); err != nil {
+				return nil, err
+			}
+		} else {
+			d.Message = m.Msg
+		}
+		d.Name = m.Host
+		d.Status = m.Status
+
+		arr = append(arr, d)
+	}
+
+	sort.Slice(arr, func(i, j int) bool {
+		return arr[i].Name < arr[j].Name
+	})
+
+	return arr, nil
+}
+
+// RotateCredentials changes the BMC account password of each host to a new
+// random password stored in the vault under the host
+func (j *Job) RotateCredentials(hostList model.HostList, req *RotateRequest) (model.JobMessageList, error) {
+	if !j.Vault.Enabled() {
+		return nil, ErrVaultDisabled
+	}
+
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunRotateCredentials(host, ch, req)
+
+		if (i+1)%j.fanout == 0 {
+			j.sleep()
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	return FormatOutput(ch)
+}
+
+// InsertMedia attaches the image URL to the virtual CD/DVD drive of each
+// host, and restarts the host from it when boot is set
+func (j *Job) InsertMedia(hostList model.HostList, image string, boot bool) (model.JobMessageList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunInsertMedia(host, ch, image, boot)
+
+		if (i+1)%j.fanout == 0 {
+			j.sleep()
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	return FormatOutput(ch)
+}
+
+// EjectMedia detaches the virtual CD/DVD of each host
+func (j *Job) EjectMedia(hostList model.HostList) (model.JobMessageList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunEjectMedia(host, ch)
+
+		if (i+1)%j.fanout == 0 {
+			j.sleep()
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	return FormatOutput(ch)
+}
+
+// CollectInventory collects the inventory of each host from its BMC and
+// stores it along with the changes since the previous collection
+func (j *Job) CollectInventory(hostList model.HostList, db store.Store) (model.JobMessageList, error) {
+	runner := newJobRunner(j)
+
+	ch := make(chan model.JobMessage, len(hostList))
+	for i, host := range hostList {
+		if host.HostType() != "server" {
+			continue
+		}
+		runner.RunCollectInventory(host, ch, db)
+
+		if (i+1)%j.fanout == 0 {
+			j.sleep()
+			continue
+		}
+	}
+
+	runner.Wait()
+	close(ch)
+
+	return FormatOutput(ch)
+}
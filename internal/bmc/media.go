@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+// NewMediaImage resolves an ISO in the provision repo dir to its URL on the
+// provision server. URLs are returned as is.
+func NewMediaImage(image string) (string, error) {
+	if image == "" {
+		return "", errors.New("missing media image")
+	}
+
+	if strings.Contains(image, "://") {
+		return image, nil
+	}
+
+	name, err := repoFile(image)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := os.Stat(filepath.Join(viper.GetString("provision.repo_dir"), name)); err != nil {
+		return "", err
+	}
+
+	return repoURL(name)
+}
+
+// InsertMedia attaches the image to the virtual CD/DVD drive of the BMC,
+// replacing any image already inserted
+func (r *Redfish) InsertMedia(image string) error {
+	vm, err := r.virtualMedia()
+	if err != nil {
+		return err
+	}
+
+	if vm.Inserted != nil && *vm.Inserted {
+		if _, err := vm.EjectMedia(); err != nil {
+			return err
+		}
+	}
+
+	inserted := true
+	writeProtected := true
+	_, err = vm.InsertMedia(&schemas.VirtualMediaInsertMediaParameters{
+		Image:          image,
+		Inserted:       &inserted,
+		WriteProtected: &writeProtected,
+		MediaType:      schemas.CDVirtualMediaType,
+	})
+
+	return err
+}
+
+// EjectMedia detaches the image from the virtual CD/DVD drive of the BMC
+func (r *Redfish) EjectMedia() error {
+	vm, err := r.virtualMedia()
+	if err != nil {
+		return err
+	}
+
+	if vm.Inserted != nil && !*vm.Inserted {
+		return nil
+	}
+
+	_, err = vm.EjectMedia()
+	return err
+}
+
+// virtualMedia returns the virtual CD/DVD drive of the BMC. Most BMCs list
+// virtual media under the manager, newer ones under the system.
+func (r *Redfish) virtualMedia() (*schemas.VirtualMedia, error) {
+	var media []*schemas.VirtualMedia
+
+	ms, err := r.service.Managers()
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range ms {
+		vm, err := m.VirtualMedia()
+		if err != nil {
+			return nil, err
+		}
+		media = append(media, vm...)
+	}
+
+	if len(media) == 0 {
+		ss, err := r.service.Systems()
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range ss {
+			vm, err := s.VirtualMedia()
+			if err != nil {
+				return nil, err
+			}
+			media = append(media, vm...)
+		}
+	}
+
+	for _, vm := range media {
+		if slices.Contains(vm.MediaTypes, schemas.CDVirtualMediaType) || slices.Contains(vm.MediaTypes, schemas.DVDVirtualMediaType) {
+			return vm, nil
+		}
+	}
+
+	return nil, errors.New("bmc has no virtual CD/DVD drive")
+}
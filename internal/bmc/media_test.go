@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMediaImage(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "rescue.iso"), []byte("iso"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	viper.Set("provision.repo_dir", dir)
+	viper.Set("provision.listen", "10.0.0.1:80")
+	defer viper.Set("provision.repo_dir", "")
+
+	image, err := NewMediaImage("rescue.iso")
+	if assert.NoError(err) {
+		assert.Equal("http://10.0.0.1:80/repo/rescue.iso", image)
+	}
+
+	image, err = NewMediaImage("https://example.com/rescue.iso")
+	if assert.NoError(err) {
+		assert.Equal("https://example.com/rescue.iso", image)
+	}
+
+	_, err = NewMediaImage("missing.iso")
+	assert.Error(err)
+
+	_, err = NewMediaImage("")
+	assert.Error(err)
+}
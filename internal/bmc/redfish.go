@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -166,7 +167,13 @@ func (r *Redfish) ClearJobs(ids []string) error {
 	return nil
 }
 
+// GetTaskInfo returns a task by ID or by the URI of the task or its task
+// monitor
 func (r *Redfish) GetTaskInfo(tid string) (*schemas.Task, error) {
+	if strings.HasPrefix(tid, "/redfish/") {
+		return schemas.GetTask(r.client, tid)
+	}
+
 	ts, err := r.service.Tasks()
 	if err != nil {
 		return nil, err
@@ -183,7 +190,6 @@ func (r *Redfish) GetTaskInfo(tid string) (*schemas.Task, error) {
 		}
 	}
 
-	fmt.Printf("%#v\n", tasks)
 	return nil, fmt.Errorf("unable to find task with ID: %s", tid)
 }
 
@@ -264,26 +270,11 @@ func (r *Redfish) BmcImportConfiguration(st, path, file string) (string, error)
 		shutdownType = dell.NoRebootISCShutdownType
 	}
 
-	rawip, err := util.GetFirstExternalIPFromInterfaces()
-	if err != nil {
-		return "", err
-	}
-
-	ip := rawip.String()
-	lip, port, err := net.SplitHostPort(viper.GetString("provision.listen"))
+	ip, port, err := provisionHost()
 	if err != nil {
 		return "", err
 	}
 
-	if lip != "0.0.0.0" {
-		ip = lip
-	}
-
-	cip := viper.GetString("bmc.config_share_ip")
-	if cip != "" {
-		ip = cip
-	}
-
 	icw := dell.DisabledISCIgnoreCertificateWarning
 	if viper.GetString("bmc.config_ignore_certificate_warning") == "Enabled" {
 		icw = dell.EnabledISCIgnoreCertificateWarning
@@ -442,3 +433,54 @@ func (r *Redfish) DellGetRepoUpdateList() (*dell.UpdateList, error) {
 	res, err := sis.GetRepoBasedUpdateList()
 	return res, err
 }
+
+// provisionHost returns the IP and port of the provision server reachable by
+// the BMCs
+func provisionHost() (string, string, error) {
+	rawip, err := util.GetFirstExternalIPFromInterfaces()
+	if err != nil {
+		return "", "", err
+	}
+
+	ip := rawip.String()
+	lip, port, err := net.SplitHostPort(viper.GetString("provision.listen"))
+	if err != nil {
+		return "", "", err
+	}
+
+	if lip != "0.0.0.0" {
+		ip = lip
+	}
+
+	cip := viper.GetString("bmc.config_share_ip")
+	if cip != "" {
+		ip = cip
+	}
+
+	return ip, port, nil
+}
+
+// SetAccountPassword changes the password of a BMC account through the
+// AccountService
+func (r *Redfish) SetAccountPassword(username, password string) error {
+	as, err := r.service.AccountService()
+	if err != nil {
+		return err
+	}
+
+	accounts, err := as.Accounts()
+	if err != nil {
+		return err
+	}
+
+	for _, a := range accounts {
+		if a.UserName != username {
+			continue
+		}
+
+		a.Password = password
+		return a.Update()
+	}
+
+	return fmt.Errorf("failed to find bmc account %s", username)
+}
@@ -5,21 +5,32 @@
 package bmc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/korovkin/limiter"
 	"github.com/spf13/viper"
+	"github.com/stmcginnis/gofish"
 	"github.com/stmcginnis/gofish/oem/dell"
 	"github.com/stmcginnis/gofish/schemas"
+	"github.com/ubccr/grendel/internal/metrics"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/stream"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
 type jobRunner struct {
+	jobID    string
+	ctx      context.Context
+	onResult func(model.JobMessage)
 	limit    *limiter.ConcurrencyLimiter
 	user     string
 	pass     string
 	insecure bool
+	vault    *Vault
+	creds    *credentialSet
 }
 
 func newJobRunner(j *Job) *jobRunner {
@@ -27,40 +38,81 @@ func newJobRunner(j *Job) *jobRunner {
 	pass := viper.GetString("bmc.password")
 	insecure := viper.GetBool("bmc.insecure")
 
+	creds, err := j.Vault.snapshot()
+	if err != nil {
+		log.Errorf("Failed to load BMC credentials, using bmc.user: %s", err)
+	}
+
 	return &jobRunner{
+		jobID:    j.ID,
+		ctx:      j.ctx,
+		onResult: j.onResult,
 		limit:    limiter.NewConcurrencyLimiter(j.fanout),
 		user:     user,
 		pass:     pass,
 		insecure: insecure,
+		vault:    j.Vault,
+		creds:    creds,
 	}
 }
 
+// credentials returns the BMC user name and password of host from the vault,
+// or the global bmc.user and bmc.password
+func (r *jobRunner) credentials(host *model.Host) (string, string) {
+	if r.creds != nil {
+		if user, pass, ok := r.creds.lookup(host); ok {
+			return user, pass
+		}
+	}
+
+	return r.user, r.pass
+}
+
 func (r *jobRunner) Wait() {
 	r.limit.Wait()
 }
 
+// send records the duration of a job on a single host, publishes its message
+// on the event stream and sends it to the job
+func (r *jobRunner) send(job string, start time.Time, m *model.JobMessage, ch chan model.JobMessage) {
+	metrics.Since(metrics.BMCJobDuration.WithLabelValues(job, m.Status), start)
+	stream.PublishJob(r.jobID, *m)
+	if r.onResult != nil {
+		r.onResult(*m)
+	}
+	ch <- *m
+}
+
+// canceled marks the message canceled if the job was canceled before it
+// started on the host
+func (r *jobRunner) canceled(m *model.JobMessage) bool {
+	if r.ctx.Err() == nil {
+		return false
+	}
+
+	m.Status = model.BmcTaskHostCanceled
+	m.Msg = "job canceled"
+	return true
+}
+
 func (r *jobRunner) RunPowerControl(host *model.Host, ch chan model.JobMessage, bootOverride schemas.BootSource, powerOption schemas.ResetType) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
-		defer func() { ch <- m }()
+		defer r.send("power_control", time.Now(), &m, ch)
 
-		bmc := host.InterfaceBMC()
-		ip := ""
-		if bmc != nil {
-			ip = bmc.AddrString()
-		} else {
-			m.Msg = "failed to find bmc interface to query"
+		if r.canceled(&m) {
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+
+		d, err := r.driver(host)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer d.Logout()
 
-		err = r.PowerControl(powerOption, bootOverride)
+		err = d.PowerControl(powerOption, bootOverride)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
@@ -74,26 +126,22 @@ func (r *jobRunner) RunPowerControl(host *model.Host, ch chan model.JobMessage,
 func (r *jobRunner) RunBmcStatus(host *model.Host, ch chan model.JobMessage) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
-		defer func() { ch <- m }()
+		defer r.send("bmc_status", time.Now(), &m, ch)
 
-		data := &model.RedfishSystem{}
-		bmc := host.InterfaceBMC()
-		ip := ""
-		if bmc != nil {
-			ip = bmc.AddrString()
-		} else {
-			m.Msg = "failed to find bmc interface to query"
+		if r.canceled(&m) {
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+
+		data := &model.RedfishSystem{}
+		d, err := r.driver(host)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer d.Logout()
 
-		data, err = r.GetSystem()
+		data, err = d.GetSystem()
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
@@ -114,7 +162,11 @@ func (r *jobRunner) RunBmcStatus(host *model.Host, ch chan model.JobMessage) {
 func (r *jobRunner) RunGetJobs(host *model.Host, ch chan model.JobMessage) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
-		defer func() { ch <- m }()
+		defer r.send("get_jobs", time.Now(), &m, ch)
+
+		if r.canceled(&m) {
+			return
+		}
 
 		bmc := host.InterfaceBMC()
 		ip := ""
@@ -124,7 +176,8 @@ func (r *jobRunner) RunGetJobs(host *model.Host, ch chan model.JobMessage) {
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		user, pass := r.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, r.insecure)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
@@ -155,7 +208,11 @@ func (r *jobRunner) RunGetJobs(host *model.Host, ch chan model.JobMessage) {
 func (r *jobRunner) RunClearJobs(host *model.Host, ch chan model.JobMessage, ids []string) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
-		defer func() { ch <- m }()
+		defer r.send("clear_jobs", time.Now(), &m, ch)
+
+		if r.canceled(&m) {
+			return
+		}
 
 		bmc := host.InterfaceBMC()
 		ip := ""
@@ -165,7 +222,8 @@ func (r *jobRunner) RunClearJobs(host *model.Host, ch chan model.JobMessage, ids
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		user, pass := r.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, r.insecure)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
@@ -187,25 +245,21 @@ func (r *jobRunner) RunClearJobs(host *model.Host, ch chan model.JobMessage, ids
 func (r *jobRunner) RunPowerCycleBmc(host *model.Host, ch chan model.JobMessage) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
-		defer func() { ch <- m }()
+		defer r.send("power_cycle_bmc", time.Now(), &m, ch)
 
-		bmc := host.InterfaceBMC()
-		ip := ""
-		if bmc != nil {
-			ip = bmc.AddrString()
-		} else {
-			m.Msg = "failed to find bmc interface to query"
+		if r.canceled(&m) {
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+
+		d, err := r.driver(host)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer d.Logout()
 
-		err = r.PowerCycleBmc()
+		err = d.PowerCycleBmc()
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
@@ -218,25 +272,21 @@ func (r *jobRunner) RunPowerCycleBmc(host *model.Host, ch chan model.JobMessage)
 func (r *jobRunner) RunClearSel(host *model.Host, ch chan model.JobMessage) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
-		defer func() { ch <- m }()
+		defer r.send("clear_sel", time.Now(), &m, ch)
 
-		bmc := host.InterfaceBMC()
-		ip := ""
-		if bmc != nil {
-			ip = bmc.AddrString()
-		} else {
-			m.Msg = "failed to find bmc interface to query"
+		if r.canceled(&m) {
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+
+		d, err := r.driver(host)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
 		}
 
-		defer r.client.Logout()
+		defer d.Logout()
 
-		err = r.ClearSel()
+		err = d.ClearSel()
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
@@ -249,7 +299,11 @@ func (r *jobRunner) RunClearSel(host *model.Host, ch chan model.JobMessage) {
 func (r *jobRunner) RunBmcAutoConfigure(host *model.Host, ch chan model.JobMessage) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
-		defer func() { ch <- m }()
+		defer r.send("bmc_auto_configure", time.Now(), &m, ch)
+
+		if r.canceled(&m) {
+			return
+		}
 
 		bmc := host.InterfaceBMC()
 		ip := ""
@@ -259,7 +313,8 @@ func (r *jobRunner) RunBmcAutoConfigure(host *model.Host, ch chan model.JobMessa
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		user, pass := r.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, r.insecure)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
@@ -280,7 +335,11 @@ func (r *jobRunner) RunBmcAutoConfigure(host *model.Host, ch chan model.JobMessa
 func (r *jobRunner) RunBmcImportConfiguration(host *model.Host, ch chan model.JobMessage, shutdownType, file string) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
-		defer func() { ch <- m }()
+		defer r.send("bmc_import_configuration", time.Now(), &m, ch)
+
+		if r.canceled(&m) {
+			return
+		}
 
 		bmc := host.InterfaceBMC()
 		mac := ""
@@ -298,7 +357,8 @@ func (r *jobRunner) RunBmcImportConfiguration(host *model.Host, ch chan model.Jo
 		}
 		path := fmt.Sprintf("/boot/%s/bmc", token)
 
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		user, pass := r.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, r.insecure)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
@@ -320,7 +380,11 @@ func (r *jobRunner) RunBmcImportConfiguration(host *model.Host, ch chan model.Jo
 func (r *jobRunner) RunBmcGetMetricReports(host *model.Host, ch chan model.JobMessage) {
 	r.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
-		defer func() { ch <- m }()
+		defer r.send("bmc_get_metric_reports", time.Now(), &m, ch)
+
+		if r.canceled(&m) {
+			return
+		}
 
 		bmc := host.InterfaceBMC()
 		ip := ""
@@ -331,7 +395,8 @@ func (r *jobRunner) RunBmcGetMetricReports(host *model.Host, ch chan model.JobMe
 			return
 		}
 
-		r, err := NewRedfishClient(ip, r.user, r.pass, r.insecure)
+		user, pass := r.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, r.insecure)
 		if err != nil {
 			m.Msg = err.Error()
 			return
@@ -359,7 +424,11 @@ func (r *jobRunner) RunBmcGetMetricReports(host *model.Host, ch chan model.JobMe
 func (jr *jobRunner) RunDellInstallFromRepo(host *model.Host, ch chan model.JobMessage, installBody dell.InstallFromRepoBody) {
 	jr.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
-		defer func() { ch <- m }()
+		defer jr.send("dell_install_from_repo", time.Now(), &m, ch)
+
+		if jr.canceled(&m) {
+			return
+		}
 
 		bmc := host.InterfaceBMC()
 		ip := ""
@@ -369,7 +438,8 @@ func (jr *jobRunner) RunDellInstallFromRepo(host *model.Host, ch chan model.JobM
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, jr.user, jr.pass, jr.insecure)
+		user, pass := jr.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, jr.insecure)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
@@ -397,7 +467,11 @@ func (jr *jobRunner) RunDellInstallFromRepo(host *model.Host, ch chan model.JobM
 func (jr *jobRunner) RunDellGetRepoUpdateList(host *model.Host, ch chan model.JobMessage) {
 	jr.limit.Execute(func() {
 		m := model.JobMessage{Status: "error", Host: host.Name}
-		defer func() { ch <- m }()
+		defer jr.send("dell_get_repo_update_list", time.Now(), &m, ch)
+
+		if jr.canceled(&m) {
+			return
+		}
 
 		bmc := host.InterfaceBMC()
 		ip := ""
@@ -407,7 +481,8 @@ func (jr *jobRunner) RunDellGetRepoUpdateList(host *model.Host, ch chan model.Jo
 			m.Msg = "failed to find bmc interface to query"
 			return
 		}
-		r, err := NewRedfishClient(ip, jr.user, jr.pass, jr.insecure)
+		user, pass := jr.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, jr.insecure)
 		if err != nil {
 			m.Msg = fmt.Sprintf("%s", err)
 			return
@@ -431,3 +506,304 @@ func (jr *jobRunner) RunDellGetRepoUpdateList(host *model.Host, ch chan model.Jo
 		m.Msg = string(output)
 	})
 }
+
+func (r *jobRunner) RunFirmwareUpdate(host *model.Host, ch chan model.JobMessage, update *UpdateRequest) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name}
+		defer r.send("firmware_update", time.Now(), &m, ch)
+
+		if r.canceled(&m) {
+			return
+		}
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+		user, pass := r.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, r.insecure)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		defer r.client.Logout()
+
+		task, err := r.UpdateFirmware(update)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		m.Status = "success"
+		m.Msg = "Started firmware update"
+		if task == "" {
+			m.Msg = "Firmware update accepted without a task"
+		}
+		m.Data = task
+	})
+}
+
+func (r *jobRunner) RunFirmwareTask(host *model.Host, ch chan model.JobMessage, taskID string) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name, Data: taskID}
+		defer r.send("firmware_task", time.Now(), &m, ch)
+
+		if r.canceled(&m) {
+			return
+		}
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+		user, pass := r.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, r.insecure)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		defer r.client.Logout()
+
+		task, err := r.GetTaskInfo(taskID)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		t := model.RedfishFirmwareTask{
+			TaskID:          taskID,
+			TaskState:       string(task.TaskState),
+			TaskStatus:      string(task.TaskStatus),
+			PercentComplete: int(gofish.Deref(task.PercentComplete)),
+		}
+		for _, msg := range task.Messages {
+			t.Messages = append(t.Messages, msg.Message)
+		}
+
+		output, err := json.Marshal(t)
+		if err != nil {
+			m.Msg = err.Error()
+			return
+		}
+
+		m.Status = "success"
+		m.Msg = string(output)
+	})
+}
+
+func (r *jobRunner) RunRotateCredentials(host *model.Host, ch chan model.JobMessage, req *RotateRequest) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name}
+		defer r.send("rotate_credentials", time.Now(), &m, ch)
+
+		if r.canceled(&m) {
+			return
+		}
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+
+		user, pass := r.credentials(host)
+		if req.Username != "" {
+			user, pass = req.Username, req.Password
+		}
+
+		newPass, err := GeneratePassword(req.Length)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		rf, err := NewRedfishClient(ip, user, pass, r.insecure)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		defer rf.client.Logout()
+
+		// Rotate the account that logged in, which is the factory default
+		// when the client fell back to it
+		user, pass = rf.config.Username, rf.config.Password
+
+		// Keep the new password as pending until the BMC is known to use it,
+		// so it's never lost when the change is applied but the request fails
+		if err := r.vault.SetPending(host.Name, user, newPass); err != nil {
+			m.Msg = fmt.Sprintf("failed to store new password: %s", err)
+			return
+		}
+
+		if err := rf.SetAccountPassword(user, newPass); err != nil {
+			if checkLogin(ip, user, newPass, r.insecure) != nil {
+				m.Msg = fmt.Sprintf("%s", err)
+				if checkLogin(ip, user, pass, r.insecure) != nil {
+					m.Msg += ", login with both the old and new password failed, the new password is kept as pending credential"
+					return
+				}
+				if err := r.vault.DiscardPending(host.Name); err != nil {
+					log.Errorf("Failed to delete pending BMC credential of %s: %s", host.Name, err)
+				}
+				return
+			}
+			log.Warnf("Setting BMC password of %s failed but the new password works: %s", host.Name, err)
+		} else if err := checkLogin(ip, user, newPass, r.insecure); err != nil {
+			m.Msg = fmt.Sprintf("password changed but login with the new password failed, the new password is kept as pending credential: %s", err)
+			return
+		}
+
+		if err := r.vault.ConfirmPending(host.Name); err != nil {
+			m.Msg = fmt.Sprintf("password changed but failed to store new password, the new password is kept as pending credential: %s", err)
+			return
+		}
+
+		m.Status = "success"
+		m.Msg = fmt.Sprintf("Rotated password of BMC account %s", user)
+	})
+}
+
+func (r *jobRunner) RunInsertMedia(host *model.Host, ch chan model.JobMessage, image string, boot bool) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name}
+		defer r.send("media_insert", time.Now(), &m, ch)
+
+		if r.canceled(&m) {
+			return
+		}
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+		user, pass := r.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, r.insecure)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		defer r.client.Logout()
+
+		if err := r.InsertMedia(image); err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		m.Status = "success"
+		m.Msg = "Inserted virtual media"
+
+		if !boot {
+			return
+		}
+
+		if err := r.PowerControl(schemas.ForceRestartResetType, schemas.CdBootSource); err != nil {
+			m.Status = "error"
+			m.Msg = fmt.Sprintf("inserted virtual media but failed to boot from it: %s", err)
+			return
+		}
+
+		m.Msg = "Inserted virtual media and booting from it"
+	})
+}
+
+func (r *jobRunner) RunEjectMedia(host *model.Host, ch chan model.JobMessage) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name}
+		defer r.send("media_eject", time.Now(), &m, ch)
+
+		if r.canceled(&m) {
+			return
+		}
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+		user, pass := r.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, r.insecure)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		defer r.client.Logout()
+
+		if err := r.EjectMedia(); err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		m.Status = "success"
+		m.Msg = "Ejected virtual media"
+	})
+}
+
+func (r *jobRunner) RunCollectInventory(host *model.Host, ch chan model.JobMessage, db store.Store) {
+	r.limit.Execute(func() {
+		m := model.JobMessage{Status: "error", Host: host.Name}
+		defer r.send("inventory", time.Now(), &m, ch)
+
+		if r.canceled(&m) {
+			return
+		}
+
+		bmc := host.InterfaceBMC()
+		ip := ""
+		if bmc != nil {
+			ip = bmc.AddrString()
+		} else {
+			m.Msg = "failed to find bmc interface to query"
+			return
+		}
+		user, pass := r.credentials(host)
+		r, err := NewRedfishClient(ip, user, pass, r.insecure)
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		defer r.client.Logout()
+
+		inv, err := r.GetInventory()
+		if err != nil {
+			m.Msg = fmt.Sprintf("%s", err)
+			return
+		}
+
+		changes, err := db.StoreInventory(host.Name, inv)
+		if err != nil {
+			m.Msg = fmt.Sprintf("failed to store inventory: %s", err)
+			return
+		}
+
+		for _, c := range changes {
+			log.Warnf("Inventory of %s changed: %s %s %s", host.Name, c.Kind, c.Slot, c.Change)
+		}
+
+		m.Status = "success"
+		m.Msg = inventorySummary(inv, changes)
+	})
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+
+	"github.com/segmentio/ksuid"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// ErrTaskNotRunning is returned when canceling a task that is not running on
+// this instance
+var ErrTaskNotRunning = errors.New("task is not running on this instance")
+
+// TaskFunc runs the BMC job of a task
+type TaskFunc func(job *Job) (model.JobMessageList, error)
+
+// TaskQueue runs BMC jobs in the background as tasks stored in the database
+// with the status of each host. At most concurrency tasks run at once, the
+// rest wait queued.
+type TaskQueue struct {
+	DB store.Store
+
+	// Owner names this instance on the tasks it runs
+	Owner string
+
+	// Vault holds the BMC credentials of the jobs, if enabled
+	Vault *Vault
+
+	sem     chan struct{}
+	mu      sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+// NewTaskQueue returns a new TaskQueue owned by the given instance id, or by
+// this host if the id is empty. Tasks left unfinished by a previous run of the
+// instance are marked failed, so instances sharing the database need unique
+// ids.
+func NewTaskQueue(db store.Store, owner string, concurrency int) (*TaskQueue, error) {
+	if owner == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		owner = hostname
+	}
+
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	n, err := db.InterruptBmcTasks(owner)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		log.Warnf("Marked %d unfinished BMC tasks failed", n)
+	}
+
+	return &TaskQueue{
+		DB:      db,
+		Owner:   owner,
+		sem:     make(chan struct{}, concurrency),
+		cancels: make(map[string]context.CancelFunc),
+	}, nil
+}
+
+// Submit stores a new task for the given hosts and runs fn in the background.
+// The results of the job are published on the event stream under the task id.
+func (q *TaskQueue) Submit(operation, user string, hosts []string, fn TaskFunc) (*model.BmcTask, error) {
+	task := &model.BmcTask{
+		ID:        ksuid.New().String(),
+		Operation: operation,
+		Status:    model.BmcTaskQueued,
+		User:      user,
+		Owner:     q.Owner,
+		Hosts:     make(model.JobMessageList, len(hosts)),
+	}
+	for i, host := range hosts {
+		task.Hosts[i] = model.JobMessage{Host: host}
+	}
+
+	if err := q.DB.StoreBmcTask(task); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	q.mu.Lock()
+	q.cancels[task.ID] = cancel
+	q.mu.Unlock()
+
+	go q.run(ctx, task, fn)
+
+	return task, nil
+}
+
+// Cancel cancels a task running on this instance. Hosts the job already
+// started on finish, the rest are canceled.
+func (q *TaskQueue) Cancel(id string) error {
+	q.mu.Lock()
+	cancel, ok := q.cancels[id]
+	q.mu.Unlock()
+
+	if !ok {
+		return ErrTaskNotRunning
+	}
+
+	cancel()
+	return nil
+}
+
+func (q *TaskQueue) run(ctx context.Context, task *model.BmcTask, fn TaskFunc) {
+	defer func() {
+		q.mu.Lock()
+		cancel := q.cancels[task.ID]
+		delete(q.cancels, task.ID)
+		q.mu.Unlock()
+		cancel()
+	}()
+
+	select {
+	case q.sem <- struct{}{}:
+		defer func() { <-q.sem }()
+	case <-ctx.Done():
+		for _, m := range task.Hosts {
+			m.Status = model.BmcTaskHostCanceled
+			q.storeResult(task.ID, m)
+		}
+		q.update(task.ID, model.BmcTaskCanceled, "")
+		return
+	}
+
+	q.update(task.ID, model.BmcTaskRunning, "")
+	log.Infof("Running BMC task %s: %s on %d hosts", task.ID, task.Operation, len(task.Hosts))
+
+	job := NewJob()
+	job.ID = task.ID
+	job.Vault = q.Vault
+	job.ctx = ctx
+	job.onResult = func(m model.JobMessage) {
+		q.storeResult(task.ID, m)
+	}
+
+	_, err := fn(job)
+	switch {
+	case err != nil:
+		log.Errorf("BMC task %s failed: %s", task.ID, err)
+		q.update(task.ID, model.BmcTaskFailed, err.Error())
+	case ctx.Err() != nil:
+		log.Infof("BMC task %s canceled", task.ID)
+		q.update(task.ID, model.BmcTaskCanceled, "")
+	default:
+		log.Infof("BMC task %s complete", task.ID)
+		q.update(task.ID, model.BmcTaskComplete, "")
+	}
+}
+
+func (q *TaskQueue) update(id, status, taskError string) {
+	if err := q.DB.UpdateBmcTask(id, status, taskError); err != nil {
+		log.Errorf("Failed to update BMC task %s: %s", id, err)
+	}
+}
+
+func (q *TaskQueue) storeResult(id string, m model.JobMessage) {
+	if err := q.DB.StoreBmcTaskResult(id, m); err != nil {
+		log.Errorf("Failed to store result of BMC task %s on %s: %s", id, m.Host, err)
+	}
+}
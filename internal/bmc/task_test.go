@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stmcginnis/gofish/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func waitTask(t *testing.T, q *TaskQueue, id string) *model.BmcTask {
+	for i := 0; i < 100; i++ {
+		task, err := q.DB.LoadBmcTask(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if task.Done() {
+			return task
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("task %s did not finish", id)
+	return nil
+}
+
+func TestTaskQueue(t *testing.T) {
+	assert := assert.New(t)
+
+	db, err := sqlstore.New(":memory:")
+	if err != nil {
+		assert.Fail(err.Error())
+	}
+
+	q, err := NewTaskQueue(db, "", 1)
+	if !assert.NoError(err) {
+		return
+	}
+
+	// Hosts without a BMC interface fail right away
+	hostList := model.HostList{{Name: "cpn-01"}, {Name: "cpn-02"}}
+	task, err := q.Submit("power_os", "admin", []string{"cpn-01", "cpn-02"}, func(job *Job) (model.JobMessageList, error) {
+		return job.PowerControl(hostList, schemas.NoneBootSource, schemas.OnResetType)
+	})
+	if assert.NoError(err) {
+		task = waitTask(t, q, task.ID)
+		assert.Equal(model.BmcTaskComplete, task.Status)
+		assert.Equal("admin", task.User)
+		if assert.Len(task.Hosts, 2) {
+			assert.Equal("error", task.Hosts[0].Status)
+			assert.Equal("failed to find bmc interface to query", task.Hosts[0].Msg)
+		}
+	}
+
+	// Canceled tasks skip the hosts not started yet
+	started := make(chan struct{})
+	task, err = q.Submit("power_os", "admin", []string{"cpn-01", "cpn-02"}, func(job *Job) (model.JobMessageList, error) {
+		close(started)
+		<-job.ctx.Done()
+		return job.PowerControl(hostList, schemas.NoneBootSource, schemas.OnResetType)
+	})
+	if assert.NoError(err) {
+		<-started
+		assert.NoError(q.Cancel(task.ID))
+		task = waitTask(t, q, task.ID)
+		assert.Equal(model.BmcTaskCanceled, task.Status)
+		if assert.Len(task.Hosts, 2) {
+			assert.Equal(model.BmcTaskHostCanceled, task.Hosts[1].Status)
+		}
+	}
+
+	assert.ErrorIs(q.Cancel(task.ID), ErrTaskNotRunning)
+
+	// Another instance on the same database leaves the running tasks alone
+	started = make(chan struct{})
+	release := make(chan struct{})
+	task, err = q.Submit("power_os", "admin", []string{"cpn-01"}, func(job *Job) (model.JobMessageList, error) {
+		close(started)
+		<-release
+		return model.JobMessageList{{Status: "success", Host: "cpn-01"}}, nil
+	})
+	if assert.NoError(err) {
+		<-started
+		_, err = NewTaskQueue(db, "grendel-b", 1)
+		assert.NoError(err)
+		close(release)
+		task = waitTask(t, q, task.ID)
+		assert.Equal(model.BmcTaskComplete, task.Status)
+	}
+}
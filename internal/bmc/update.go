@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+	"github.com/stmcginnis/gofish/schemas"
+)
+
+const (
+	// FirmwareUpdateSimple asks the BMC to download the image with the
+	// UpdateService SimpleUpdate action
+	FirmwareUpdateSimple = "simple"
+
+	// FirmwareUpdatePush uploads the image to the BMC with a multipart HTTP
+	// push, or a plain HTTP push on older BMCs
+	FirmwareUpdatePush = "push"
+)
+
+// UpdateRequest is a vendor neutral firmware update through the Redfish
+// UpdateService
+type UpdateRequest struct {
+	// Method is FirmwareUpdateSimple or FirmwareUpdatePush
+	Method string
+
+	// Image is a URL for simple updates or a file in the provision repo dir
+	Image string
+
+	// Targets are the URIs of the software inventory to update, all
+	// applicable components when empty
+	Targets []string
+
+	// ForceUpdate bypasses update policies such as downgrade protection
+	ForceUpdate bool
+
+	// ApplyTime is the Redfish operation apply time of pushed updates, such
+	// as Immediate or OnReset
+	ApplyTime string
+}
+
+// NewUpdateRequest checks the update method and resolves the image. For
+// simple updates a file name is turned into a URL of the provision server's
+// repo, for push updates into a path in the repo dir.
+func NewUpdateRequest(method, image string, targets []string, force bool, applyTime string) (*UpdateRequest, error) {
+	if method == "" {
+		method = FirmwareUpdateSimple
+	}
+	if image == "" {
+		return nil, errors.New("missing firmware image")
+	}
+
+	u := &UpdateRequest{
+		Method:      method,
+		Targets:     targets,
+		ForceUpdate: force,
+		ApplyTime:   applyTime,
+	}
+
+	remote := strings.Contains(image, "://")
+
+	switch method {
+	case FirmwareUpdateSimple:
+		if remote {
+			u.Image = image
+			return u, nil
+		}
+		name, err := repoFile(image)
+		if err != nil {
+			return nil, err
+		}
+		u.Image, err = repoURL(name)
+		if err != nil {
+			return nil, err
+		}
+	case FirmwareUpdatePush:
+		if remote {
+			return nil, errors.New("push updates need a file in the repo dir, not a URL")
+		}
+		name, err := repoFile(image)
+		if err != nil {
+			return nil, err
+		}
+		u.Image = filepath.Join(viper.GetString("provision.repo_dir"), name)
+		if _, err := os.Stat(u.Image); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid firmware update method: %s", method)
+	}
+
+	return u, nil
+}
+
+// repoFile returns the cleaned image name relative to the repo dir
+func repoFile(image string) (string, error) {
+	if viper.GetString("provision.repo_dir") == "" {
+		return "", errors.New("provision.repo_dir is not set")
+	}
+
+	name := filepath.Clean("/" + image)[1:]
+	if name == "" {
+		return "", fmt.Errorf("invalid firmware image: %s", image)
+	}
+
+	return filepath.ToSlash(name), nil
+}
+
+// repoURL returns the URL of a file in the repo dir on the provision server
+func repoURL(name string) (string, error) {
+	ip, port, err := provisionHost()
+	if err != nil {
+		return "", err
+	}
+
+	scheme := "http"
+	if viper.IsSet("provision.cert") {
+		scheme = "https"
+	}
+
+	u := url.URL{
+		Scheme: scheme,
+		Host:   net.JoinHostPort(ip, port),
+		Path:   "/repo/" + name,
+	}
+
+	return u.String(), nil
+}
+
+// UpdateFirmware starts a firmware update and returns the URI of the task
+// tracking it. An empty URI means the BMC did not start a task.
+func (r *Redfish) UpdateFirmware(update *UpdateRequest) (string, error) {
+	us, err := r.service.UpdateService()
+	if err != nil {
+		return "", err
+	}
+
+	if !us.ServiceEnabled {
+		return "", errors.New("update service is disabled")
+	}
+
+	var task *schemas.TaskMonitorInfo
+	switch update.Method {
+	case FirmwareUpdatePush:
+		task, err = r.pushUpdate(us, update)
+	default:
+		task, err = us.SimpleUpdate(&schemas.UpdateServiceSimpleUpdateParameters{
+			ImageURI:    update.Image,
+			Targets:     update.Targets,
+			ForceUpdate: update.ForceUpdate,
+		})
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if task == nil {
+		return "", nil
+	}
+	if task.Task != nil && task.Task.ODataID != "" {
+		return task.Task.ODataID, nil
+	}
+
+	return task.TaskMonitor, nil
+}
+
+func (r *Redfish) pushUpdate(us *schemas.UpdateService, update *UpdateRequest) (*schemas.TaskMonitorInfo, error) {
+	file, err := os.Open(update.Image)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if us.MultipartHTTPPushURI != "" {
+		params := map[string]any{
+			"Targets":     update.Targets,
+			"ForceUpdate": update.ForceUpdate,
+		}
+		if update.Targets == nil {
+			params["Targets"] = []string{}
+		}
+		if update.ApplyTime != "" {
+			params["@Redfish.OperationApplyTime"] = update.ApplyTime
+		}
+		body, err := json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, task, err := schemas.PostWithTask(r.client, us.MultipartHTTPPushURI, map[string]io.Reader{
+			"UpdateParameters": bytes.NewReader(body),
+			"UpdateFile":       file,
+		}, nil, true)
+		defer schemas.DeferredCleanupHTTPResponse(resp)
+
+		return task, err
+	}
+
+	if us.HTTPPushURI == "" {
+		return nil, errors.New("bmc does not support http push updates")
+	}
+
+	resp, err := r.client.RunRawRequestWithHeaders(http.MethodPost, us.HTTPPushURI, file, "application/octet-stream", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer schemas.DeferredCleanupHTTPResponse(resp)
+
+	if resp.StatusCode == http.StatusAccepted {
+		return schemas.ParseTaskMonitorInfo(r.client, resp), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package bmc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUpdateRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "bmc.bin"), []byte("firmware"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	viper.Set("provision.repo_dir", dir)
+	viper.Set("provision.listen", "10.0.0.1:80")
+	defer viper.Set("provision.repo_dir", "")
+
+	u, err := NewUpdateRequest("", "bmc.bin", nil, false, "")
+	if assert.NoError(err) {
+		assert.Equal(FirmwareUpdateSimple, u.Method)
+		assert.Equal("http://10.0.0.1:80/repo/bmc.bin", u.Image)
+	}
+
+	u, err = NewUpdateRequest(FirmwareUpdateSimple, "https://example.com/bmc.bin", nil, false, "")
+	if assert.NoError(err) {
+		assert.Equal("https://example.com/bmc.bin", u.Image)
+	}
+
+	// Push images never leave the repo dir
+	u, err = NewUpdateRequest(FirmwareUpdatePush, "../../bmc.bin", nil, false, "")
+	if assert.NoError(err) {
+		assert.Equal(filepath.Join(dir, "bmc.bin"), u.Image)
+	}
+
+	_, err = NewUpdateRequest(FirmwareUpdatePush, "missing.bin", nil, false, "")
+	assert.Error(err)
+
+	_, err = NewUpdateRequest(FirmwareUpdatePush, "https://example.com/bmc.bin", nil, false, "")
+	assert.Error(err)
+
+	_, err = NewUpdateRequest("ftp", "bmc.bin", nil, false, "")
+	assert.Error(err)
+}
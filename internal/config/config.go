@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/spf13/viper"
+	"go4.org/netipx"
 )
 
 var (
@@ -21,22 +22,57 @@ var (
 	DefaultDNS          []net.IP       = []net.IP{}
 	DefaultDomainSearch []string       = []string{}
 	DefaultMTU          uint16         = 1500
+	DiscoveryImage      string         = ""
 	DefaultGateway      netip.Addr
 )
 
 type Subnet struct {
-	Gateway      netip.Prefix
-	DNS          []net.IP
-	DomainSearch []string
-	MTU          uint16
+	Gateway        netip.Prefix
+	DNS            []net.IP
+	DomainSearch   []string
+	MTU            uint16
+	DynamicRange   netipx.IPRange
+	DiscoveryImage string
+	Enroll         bool
+}
+
+// Dynamic returns true if the subnet has a dynamic address pool for unknown
+// MAC addresses
+func (s *Subnet) Dynamic() bool {
+	return s.DynamicRange.IsValid()
+}
+
+// DynamicSubnet returns the subnet with a dynamic address pool that contains
+// the given address or nil if none is found
+func DynamicSubnet(addr netip.Addr) *Subnet {
+	for i := range Subnets {
+		if Subnets[i].Dynamic() && Subnets[i].Gateway.Masked().Contains(addr) {
+			return &Subnets[i]
+		}
+	}
+
+	return nil
+}
+
+// DiscoveryImageFor returns the name of the discovery boot image for an
+// address handed out from a dynamic pool
+func DiscoveryImageFor(addr netip.Addr) string {
+	if subnet := DynamicSubnet(addr); subnet != nil && subnet.DiscoveryImage != "" {
+		return subnet.DiscoveryImage
+	}
+
+	return DiscoveryImage
 }
 
 func ParseConfigs() error {
 	type SubnetConfig struct {
-		Gateway      string
-		DNS          string
-		DomainSearch string
-		MTU          uint16
+		Gateway        string
+		DNS            string
+		DomainSearch   string
+		MTU            uint16
+		DynamicRange   string `mapstructure:"dynamic_range"`
+		DiscoveryImage string `mapstructure:"discovery_image"`
+		Enroll         bool
 	}
 	var subnetConfigs []SubnetConfig
 
@@ -72,7 +108,28 @@ func ParseConfigs() error {
 			domainSearch = append(domainSearch, domain)
 		}
 
-		Subnets = append(Subnets, Subnet{Gateway: gw, DNS: dnsServers, DomainSearch: domainSearch, MTU: sc.MTU})
+		var dynamicRange netipx.IPRange
+		if sc.DynamicRange != "" {
+			dynamicRange, err = netipx.ParseIPRange(sc.DynamicRange)
+			if err != nil {
+				return fmt.Errorf("Failed parsing dhcp.subnets config. Invalid dynamic_range: %s", sc.DynamicRange)
+			}
+			if !gw.Masked().Contains(dynamicRange.From()) || !gw.Masked().Contains(dynamicRange.To()) {
+				return fmt.Errorf("Failed parsing dhcp.subnets config. dynamic_range %s is not inside subnet %s", sc.DynamicRange, gw.Masked())
+			}
+		} else if sc.Enroll {
+			return fmt.Errorf("Failed parsing dhcp.subnets config. Subnet %s enrolls BMCs without a dynamic_range", gw.Masked())
+		}
+
+		Subnets = append(Subnets, Subnet{
+			Gateway:        gw,
+			DNS:            dnsServers,
+			DomainSearch:   domainSearch,
+			MTU:            sc.MTU,
+			DynamicRange:   dynamicRange,
+			DiscoveryImage: sc.DiscoveryImage,
+			Enroll:         sc.Enroll,
+		})
 	}
 
 	DefaultDNS = make([]net.IP, 0)
@@ -86,6 +143,7 @@ func ParseConfigs() error {
 
 	DefaultDomainSearch = viper.GetStringSlice("dhcp.domain_search")
 	DefaultMTU = uint16(viper.GetInt("dhcp.mtu"))
+	DiscoveryImage = viper.GetString("dhcp.discovery_image")
 
 	addrPort, err := netip.ParseAddrPort(viper.GetString("provision.listen"))
 	if err != nil {
@@ -84,17 +84,28 @@ func (s *Server) bootingHandler4(host *model.Host, serverIP net.IP, req, resp *d
 		resp.UpdateOption(dhcpv4.OptBootFileName(endpoints.BootFileURL()))
 
 	case firmware.EFI386, firmware.EFI64, firmware.SNPONLYx86_64, firmware.SNPONLYarm64:
-		log.Printf("EFI boot PXE client")
 		if host.Firmware != 0 {
 			log.Infof("Overriding firmware for host: %s", req.ClientHWAddr.String())
 			fwtype = host.Firmware
 		}
-		resp.UpdateOption(dhcpv4.OptTFTPServerName(serverIP.String()))
 
 		token, err := model.NewFirmwareToken(req.ClientHWAddr.String(), fwtype)
 		if err != nil {
 			return fmt.Errorf("EFI failed to generated signed Firmware token")
 		}
+
+		if firmware.IsHTTPBoot(req.ClientArch(), req.ClassIdentifier()) {
+			// UEFI HTTP Boot clients only accept offers with the HTTPClient
+			// vendor class and fetch the boot file from a URL
+			log.Printf("EFI HTTP boot client")
+			endpoints := provision.NewEndpoints(serverIP.String(), token)
+			resp.UpdateOption(dhcpv4.OptClassIdentifier(firmware.HTTPClient))
+			resp.UpdateOption(dhcpv4.OptBootFileName(endpoints.FirmwareURL(fwtype.String())))
+			break
+		}
+
+		log.Printf("EFI boot PXE client")
+		resp.UpdateOption(dhcpv4.OptTFTPServerName(serverIP.String()))
 		resp.UpdateOption(dhcpv4.OptBootFileName(token))
 
 	case firmware.GRENDEL:
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/internal/provision"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// enterpriseIntel is the enterprise number EDK2 firmware sends in the vendor
+// class of UEFI HTTP Boot requests
+const enterpriseIntel = 343
+
+func (s *Server6) bootingHandler6(host *model.Host, mac net.HardwareAddr, req, resp *dhcpv6.Message) error {
+	if !host.Provision {
+		log.Infof("Host not set to provision: %s", mac.String())
+		return nil
+	}
+
+	archs := req.Options.ArchTypes()
+	if len(archs) == 0 {
+		log.Debugf("Ignoring packet - missing client system architecture type")
+		return nil
+	}
+
+	if !req.IsOptionRequested(dhcpv6.OptionBootfileURL) {
+		log.Debugf("Ignoring packet - boot file url not requested")
+		return nil
+	}
+
+	userClass := ""
+	if ucs := req.Options.UserClasses(); len(ucs) > 0 {
+		userClass = string(ucs[0])
+	}
+
+	fwtype, err := firmware.DetectBuild(archs, userClass)
+	log.Debugf("iPXE Firmware type detected: %s", fwtype.String())
+	if err != nil {
+		return fmt.Errorf("Failed to get PXE firmware from DHCPv6: %s", err)
+	}
+
+	log.WithFields(logrus.Fields{
+		"mac":      mac.String(),
+		"name":     host.Name,
+		"firmware": fwtype.String(),
+	}).Info("Got valid PXE boot request")
+
+	// Boot file urls must contain the literal IPv6 address in brackets
+	serverHost := fmt.Sprintf("[%s]", s.ServerAddress.String())
+
+	switch fwtype {
+	case firmware.EFI386, firmware.EFI64, firmware.SNPONLYx86_64, firmware.SNPONLYarm64:
+		log.Printf("EFI boot PXE client")
+		if host.Firmware != 0 {
+			log.Infof("Overriding firmware for host: %s", mac.String())
+			fwtype = host.Firmware
+		}
+
+		token, err := model.NewFirmwareToken(mac.String(), fwtype)
+		if err != nil {
+			return fmt.Errorf("EFI failed to generated signed Firmware token")
+		}
+		endpoints := provision.NewEndpoints(serverHost, token)
+
+		// UEFI HTTP Boot clients send the HTTPClient vendor class and expect
+		// it back with the same enterprise number
+		vendorClass := &dhcpv6.OptVendorClass{EnterpriseNumber: enterpriseIntel}
+		if vcs := req.Options.VendorClasses(); len(vcs) > 0 {
+			vendorClass = vcs[0]
+		}
+		class := ""
+		if len(vendorClass.Data) > 0 {
+			class = string(vendorClass.Data[0])
+		}
+		if firmware.IsHTTPBoot(archs, class) {
+			log.Printf("EFI HTTP boot client")
+			resp.UpdateOption(&dhcpv6.OptVendorClass{
+				EnterpriseNumber: vendorClass.EnterpriseNumber,
+				Data:             [][]byte{[]byte(firmware.HTTPClient)},
+			})
+			resp.UpdateOption(dhcpv6.OptBootFileURL(endpoints.FirmwareURL(fwtype.String())))
+			break
+		}
+
+		resp.UpdateOption(dhcpv6.OptBootFileURL(endpoints.BootFileURL()))
+
+	case firmware.GRENDEL:
+		// Chainload to HTTP
+		token, err := model.NewBootToken(host.UID.String(), mac.String())
+		if err != nil {
+			return fmt.Errorf("Failed to generate signed boot token: %s", err)
+		}
+
+		endpoints := provision.NewEndpoints(serverHost, token)
+		ipxeUrl := endpoints.IpxeURL()
+		log.Debugf("BootFile iPXE script: %s", ipxeUrl)
+		resp.UpdateOption(dhcpv6.OptBootFileURL(ipxeUrl))
+
+	default:
+		// Legacy BIOS PXE clients do not support DHCPv6
+		return fmt.Errorf("unsupported firmware type for DHCPv6 %d", fwtype)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestBootingHandler4HTTPBoot(t *testing.T) {
+	assert := assert.New(t)
+
+	host := &model.Host{Name: "cpn-01", UID: ksuid.New(), Provision: true}
+	mac := net.HardwareAddr{1, 2, 3, 4, 5, 6}
+	serverIP := net.ParseIP("10.0.0.1")
+
+	tests := []struct {
+		arch        iana.Arch
+		vendorClass string
+		http        bool
+		binary      string
+	}{
+		{iana.EFI_X86_64_HTTP, "HTTPClient:Arch:00016:UNDI:003001", true, "snponly-x86_64.efi"},
+		{iana.EFI_ARM64_HTTP, "", true, "snponly-arm64.efi"},
+		{iana.EFI_X86_64, "HTTPClient:Arch:00007:UNDI:003001", true, "snponly-x86_64.efi"},
+		{iana.EFI_X86_64, "PXEClient:Arch:00007:UNDI:003001", false, ""},
+	}
+
+	for _, test := range tests {
+		req, err := dhcpv4.NewDiscovery(mac,
+			dhcpv4.WithOption(dhcpv4.OptClientArch(test.arch)),
+			dhcpv4.WithOption(dhcpv4.OptClassIdentifier(test.vendorClass)))
+		if !assert.NoError(err) {
+			return
+		}
+		resp, err := dhcpv4.NewReplyFromRequest(req)
+		if !assert.NoError(err) {
+			return
+		}
+
+		s := &Server{}
+		if !assert.NoError(s.bootingHandler4(host, serverIP, req, resp)) {
+			continue
+		}
+
+		if !test.http {
+			assert.NotEqual(firmware.HTTPClient, resp.ClassIdentifier(), test.arch.String())
+			assert.Equal(serverIP.String(), resp.TFTPServerName())
+			assert.False(strings.Contains(resp.BootFileNameOption(), "://"))
+			continue
+		}
+
+		assert.Equal(firmware.HTTPClient, resp.ClassIdentifier(), test.arch.String())
+		assert.True(strings.HasPrefix(resp.BootFileNameOption(), "http://10.0.0.1"), resp.BootFileNameOption())
+		assert.Contains(resp.BootFileNameOption(), "/firmware/")
+		assert.True(strings.HasSuffix(resp.BootFileNameOption(), "/"+test.binary), resp.BootFileNameOption())
+	}
+}
+
+func TestBootingHandler6HTTPBoot(t *testing.T) {
+	assert := assert.New(t)
+
+	host := &model.Host{Name: "cpn-01", UID: ksuid.New(), Provision: true}
+	mac := net.HardwareAddr{1, 2, 3, 4, 5, 6}
+	s := &Server6{ServerAddress: net.ParseIP("2001:db8::1")}
+
+	req, err := dhcpv6.NewSolicit(mac,
+		dhcpv6.WithArchType(iana.EFI_X86_64_HTTP),
+		dhcpv6.WithRequestedOptions(dhcpv6.OptionBootfileURL))
+	if !assert.NoError(err) {
+		return
+	}
+	req.AddOption(&dhcpv6.OptVendorClass{EnterpriseNumber: enterpriseIntel, Data: [][]byte{[]byte("HTTPClient:Arch:00016:UNDI:003001")}})
+
+	resp, err := dhcpv6.NewAdvertiseFromSolicit(req)
+	if !assert.NoError(err) {
+		return
+	}
+
+	if !assert.NoError(s.bootingHandler6(host, mac, req, resp)) {
+		return
+	}
+
+	vcs := resp.Options.VendorClasses()
+	if assert.Len(vcs, 1) && assert.Len(vcs[0].Data, 1) {
+		assert.Equal(uint32(enterpriseIntel), vcs[0].EnterpriseNumber)
+		assert.Equal(firmware.HTTPClient, string(vcs[0].Data[0]))
+	}
+
+	url := resp.Options.BootFileURL()
+	assert.True(strings.HasPrefix(url, "http://[2001:db8::1]"), url)
+	assert.Contains(url, "/firmware/")
+	assert.True(strings.HasSuffix(url, "/snponly-x86_64.efi"), url)
+
+	// Without an HTTP Boot arch or vendor class the client gets a TFTP URL
+	req, err = dhcpv6.NewSolicit(mac,
+		dhcpv6.WithArchType(iana.EFI_X86_64),
+		dhcpv6.WithRequestedOptions(dhcpv6.OptionBootfileURL))
+	if !assert.NoError(err) {
+		return
+	}
+	resp, err = dhcpv6.NewAdvertiseFromSolicit(req)
+	if !assert.NoError(err) {
+		return
+	}
+
+	if assert.NoError(s.bootingHandler6(host, mac, req, resp)) {
+		assert.Empty(resp.Options.VendorClasses())
+		assert.True(strings.HasPrefix(resp.Options.BootFileURL(), "tftp://[2001:db8::1]/"))
+	}
+}
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/config"
+	"github.com/ubccr/grendel/internal/metrics"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+	"golang.org/x/net/ipv4"
+)
+
+// dynamicEnabled returns true if any subnet is configured with a dynamic
+// address pool
+func (s *Server) dynamicEnabled() bool {
+	for _, subnet := range config.Subnets {
+		if subnet.Dynamic() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dynamicLeaseTime returns the lease time for addresses handed out from a
+// dynamic pool
+func (s *Server) dynamicLeaseTime() time.Duration {
+	if s.DynamicLease > 0 {
+		return s.DynamicLease
+	}
+
+	return s.LeaseTime
+}
+
+// dynamicHandler4 handles requests from MAC addresses not assigned to any host
+// by handing out a temporary address from the dynamic pool of the subnet the
+// request came from. It returns the result used for metrics.
+func (s *Server) dynamicHandler4(serverIP net.IP, req *dhcpv4.DHCPv4, oob *ipv4.ControlMessage) string {
+	// Relayed requests are matched against the relay address, otherwise the
+	// address of the interface the request came in on
+	origin, _ := netip.AddrFromSlice(serverIP.To4())
+	if !req.GatewayIPAddr.IsUnspecified() {
+		origin, _ = netip.AddrFromSlice(req.GatewayIPAddr.To4())
+	}
+
+	subnet := config.DynamicSubnet(origin)
+	if subnet == nil {
+		log.Debugf("Ignoring unknown client mac address %s, no dynamic pool for %s", req.ClientHWAddr, origin)
+		return metrics.ResultUnknownMAC
+	}
+
+	resp, err := s.newReply4(serverIP, req)
+	if err != nil {
+		log.Printf("DHCP failed to build reply: %v", err)
+		return metrics.ResultError
+	}
+
+	name := model.DiscoveryHostName(req.ClientHWAddr)
+	s.recordEvent4(name, req, req)
+
+	switch mt := req.MessageType(); mt {
+	case dhcpv4.MessageTypeDiscover:
+		lease, err := s.allocateLease(subnet, req.ClientHWAddr, nil)
+		if err != nil {
+			log.Errorf("Failed to allocate dynamic lease for %s: %s", req.ClientHWAddr, err)
+			return metrics.ResultError
+		}
+
+		host := s.discoveryHost(subnet, req.ClientHWAddr, lease)
+		if host.Provision {
+			err := s.bootingHandler4(host, serverIP, req, resp)
+			if err != nil {
+				log.WithFields(logrus.Fields{
+					"mac": req.ClientHWAddr.String(),
+					"err": err,
+				}).Error("Failed to add discovery boot options to DHCP request")
+			}
+		}
+
+		s.setNetworkOptions4(host.Interfaces[0], s.dynamicLeaseTime(), req, resp)
+		log.WithFields(logrus.Fields{
+			"ip":         lease.IP.String(),
+			"mac":        req.ClientHWAddr.String(),
+			"boot_image": host.BootImage,
+		}).Info("Offering dynamic lease to unknown host")
+	case dhcpv4.MessageTypeRequest:
+		err := s.dynamicAckHandler4(subnet, serverIP, req, resp)
+		if err != nil {
+			log.Errorf("Failed to ack dynamic DHCP REQUEST: %s", err)
+			return metrics.ResultError
+		}
+	default:
+		log.Warnf("DHCP Unhandled message type for dynamic lease: %v", mt)
+		log.Debugln(resp.Summary())
+		return metrics.ResultIgnored
+	}
+
+	s.recordEvent4(name, req, resp)
+	return s.sendReply4(req, resp, oob)
+}
+
+func (s *Server) dynamicAckHandler4(subnet *config.Subnet, serverIP net.IP, req, resp *dhcpv4.DHCPv4) error {
+	if req.ServerIdentifier() != nil &&
+		!req.ServerIdentifier().Equal(net.IPv4zero) &&
+		!req.ServerIdentifier().Equal(serverIP) {
+		return fmt.Errorf("requested Server Identifier does not match. Got %v, want %v", req.ServerIdentifier(), serverIP)
+	}
+
+	requestedIP := req.RequestedIPAddress()
+	if requestedIP == nil || requestedIP.Equal(net.IPv4zero) {
+		requestedIP = req.ClientIPAddr
+	}
+
+	requested, _ := netip.AddrFromSlice(requestedIP.To4())
+	lease, err := s.allocateLease(subnet, req.ClientHWAddr, &requested)
+	if err != nil || lease.IP != requested {
+		msg := fmt.Sprintf("Requested IP address %v is not available in dynamic pool", requestedIP)
+		log.Info(msg)
+		resp.UpdateOption(dhcpv4.OptMessage(msg))
+		resp.UpdateOption(dhcpv4.OptMessageType(dhcpv4.MessageTypeNak))
+		return nil
+	}
+
+	if req.ClientIPAddr != nil && !req.ClientIPAddr.Equal(net.IPv4zero) {
+		resp.ClientIPAddr = req.ClientIPAddr
+	}
+
+	host := s.discoveryHost(subnet, req.ClientHWAddr, lease)
+	s.setNetworkOptions4(host.Interfaces[0], s.dynamicLeaseTime(), req, resp)
+	resp.UpdateOption(dhcpv4.OptMessageType(dhcpv4.MessageTypeAck))
+
+	log.WithFields(logrus.Fields{
+		"ip":  lease.IP.String(),
+		"mac": req.ClientHWAddr.String(),
+	}).Info("Acked dynamic lease for unknown host")
+
+	// Unknown MACs on enrollment subnets are BMCs of new servers
+	if subnet.Enroll && s.Enroll != nil {
+		s.Enroll(req.ClientHWAddr, lease.IP)
+	}
+
+	return nil
+}
+
+// allocateLease returns the lease for the given MAC address, renewing an
+// existing lease if it falls within the subnet pool or picking the requested
+// address, or the first free address, from the pool otherwise.
+func (s *Server) allocateLease(subnet *config.Subnet, mac net.HardwareAddr, requested *netip.Addr) (*model.DHCPLease, error) {
+	s.leaseMu.Lock()
+	defer s.leaseMu.Unlock()
+
+	now := time.Now()
+	lease, err := s.DB.LoadDHCPLease(mac.String())
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, err
+	}
+
+	if lease == nil || !subnet.DynamicRange.Contains(lease.IP) || (requested != nil && *requested != lease.IP) {
+		ip, err := s.freeAddress(subnet, mac, requested, now)
+		if err != nil {
+			return nil, err
+		}
+		lease = &model.DHCPLease{MAC: mac.String(), IP: ip}
+	}
+
+	lease.ExpiresAt = now.Add(s.dynamicLeaseTime())
+	err = s.DB.StoreDHCPLease(lease)
+	if err != nil {
+		return nil, err
+	}
+
+	return lease, nil
+}
+
+// freeAddress returns an address from the subnet pool that is neither held by
+// an active lease of another MAC address nor assigned to a host
+func (s *Server) freeAddress(subnet *config.Subnet, mac net.HardwareAddr, requested *netip.Addr, now time.Time) (netip.Addr, error) {
+	leases, err := s.DB.DHCPLeases()
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	used := make(map[netip.Addr]bool, len(leases))
+	for _, l := range leases {
+		if l.MAC != mac.String() && !l.Expired(now) {
+			used[l.IP] = true
+		}
+	}
+
+	available := func(ip netip.Addr) (bool, error) {
+		if used[ip] {
+			return false, nil
+		}
+		names, err := s.DB.ReverseResolve(ip.String())
+		if err != nil {
+			return false, err
+		}
+		return len(names) == 0, nil
+	}
+
+	if requested != nil {
+		if !subnet.DynamicRange.Contains(*requested) {
+			return netip.Addr{}, fmt.Errorf("requested address %s is outside dynamic pool %s", requested, subnet.DynamicRange)
+		}
+		ok, err := available(*requested)
+		if err != nil {
+			return netip.Addr{}, err
+		}
+		if !ok {
+			return netip.Addr{}, fmt.Errorf("requested address %s is already in use", requested)
+		}
+		return *requested, nil
+	}
+
+	for ip := subnet.DynamicRange.From(); subnet.DynamicRange.Contains(ip); ip = ip.Next() {
+		ok, err := available(ip)
+		if err != nil {
+			return netip.Addr{}, err
+		}
+		if ok {
+			return ip, nil
+		}
+	}
+
+	return netip.Addr{}, fmt.Errorf("dynamic pool %s exhausted", subnet.DynamicRange)
+}
+
+func (s *Server) discoveryHost(subnet *config.Subnet, mac net.HardwareAddr, lease *model.DHCPLease) *model.Host {
+	prefix := netip.PrefixFrom(lease.IP, subnet.Gateway.Bits())
+	return model.NewDiscoveryHost(mac, prefix, config.DiscoveryImageFor(lease.IP))
+}
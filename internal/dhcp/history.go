@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/ubccr/grendel/internal/firmware"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// recordEvent4 stores msg, either the client request or the reply sent to
+// it, in the DHCP boot history of the named host
+func (s *Server) recordEvent4(name string, req, msg *dhcpv4.DHCPv4) {
+	if s.History {
+		storeEvent4(s.DB, name, req, msg)
+	}
+}
+
+// recordEvent4 stores a proxy PXE request or the reply sent to it in the DHCP
+// boot history of the named host
+func (s *PXEServer) recordEvent4(name string, req, msg *dhcpv4.DHCPv4) {
+	if s.History {
+		storeEvent4(s.DB, name, req, msg)
+	}
+}
+
+// recordEvent6 stores msg, either the client request or the reply sent to
+// it, in the DHCP boot history of the named host. req is the message as
+// received, which may be wrapped in relay messages.
+func (s *Server6) recordEvent6(name string, mac net.HardwareAddr, req dhcpv6.DHCPv6, msg *dhcpv6.Message) {
+	if !s.History {
+		return
+	}
+
+	inner, err := req.GetInnerMessage()
+	if err != nil {
+		return
+	}
+
+	event := &model.DHCPEvent{
+		MessageType: msg.Type().String(),
+		MAC:         mac.String(),
+		Host:        name,
+		Time:        time.Now(),
+	}
+
+	if iana := msg.Options.OneIANA(); iana != nil {
+		if addr := iana.Options.OneAddress(); addr != nil {
+			event.IP = toAddr(addr.IPv6Addr)
+		}
+	}
+
+	if relay, ok := req.(*dhcpv6.RelayMessage); ok {
+		event.RelayIP = toAddr(relay.LinkAddr)
+	}
+
+	if archs := inner.Options.ArchTypes(); len(archs) > 0 {
+		userClass := ""
+		if ucs := inner.Options.UserClasses(); len(ucs) > 0 {
+			userClass = string(ucs[0])
+		}
+
+		fwtype, err := firmware.DetectBuild(archs, userClass)
+		if err == nil {
+			event.Firmware = fwtype.String()
+		}
+	}
+
+	err = s.DB.StoreDHCPEvent(event)
+	if err != nil {
+		log.Errorf("Failed to record DHCPv6 %s for %s: %s", event.MessageType, event.MAC, err)
+	}
+}
+
+// storeEvent4 stores a DHCPv4 message in the boot history of the named host
+func storeEvent4(db store.Store, name string, req, msg *dhcpv4.DHCPv4) {
+	ip := msg.YourIPAddr
+	if msg == req {
+		ip = req.RequestedIPAddress()
+		if ip == nil || ip.Equal(net.IPv4zero) {
+			ip = req.ClientIPAddr
+		}
+	}
+
+	event := &model.DHCPEvent{
+		MessageType: msg.MessageType().String(),
+		MAC:         req.ClientHWAddr.String(),
+		Host:        name,
+		IP:          toAddr(ip),
+		RelayIP:     toAddr(req.GatewayIPAddr),
+		Time:        time.Now(),
+	}
+
+	if req.Options.Has(dhcpv4.OptionClientSystemArchitectureType) {
+		userClass := ""
+		if req.Options.Has(dhcpv4.OptionUserClassInformation) {
+			userClass = string(req.Options.Get(dhcpv4.OptionUserClassInformation))
+		}
+
+		fwtype, err := firmware.DetectBuild(req.ClientArch(), userClass)
+		if err == nil {
+			event.Firmware = fwtype.String()
+		}
+	}
+
+	err := db.StoreDHCPEvent(event)
+	if err != nil {
+		log.Errorf("Failed to record DHCP %s for %s: %s", event.MessageType, event.MAC, err)
+	}
+}
+
+// toAddr converts ip to a netip.Addr, returning the zero Addr for nil or
+// unspecified addresses
+func toAddr(ip net.IP) netip.Addr {
+	if ip == nil || ip.IsUnspecified() {
+		return netip.Addr{}
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+
+	addr, _ := netip.AddrFromSlice(ip)
+	return addr
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv4"
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+)
+
+func newHistoryDB(t *testing.T) store.Store {
+	db, err := sqlstore.New(":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func TestRecordEventPXE(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &PXEServer{DB: newHistoryDB(t), History: true}
+	mac := net.HardwareAddr{1, 2, 3, 4, 5, 6}
+
+	req, err := dhcpv4.NewDiscovery(mac, dhcpv4.WithOption(dhcpv4.OptClientArch(iana.EFI_X86_64)))
+	assert.NoError(err)
+	req.UpdateOption(dhcpv4.OptMessageType(dhcpv4.MessageTypeRequest))
+
+	s.recordEvent4("cpn-01", req, req)
+
+	events, err := s.DB.DHCPEvents("cpn-01", time.Now().Add(-time.Hour))
+	if assert.NoError(err) && assert.Len(events, 1) {
+		assert.Equal("REQUEST", events[0].MessageType)
+		assert.Equal(mac.String(), events[0].MAC)
+		assert.Equal("snponly-x86_64.efi", events[0].Firmware)
+	}
+}
+
+func TestRecordEvent6(t *testing.T) {
+	assert := assert.New(t)
+
+	s := &Server6{DB: newHistoryDB(t), History: true}
+	mac := net.HardwareAddr{1, 2, 3, 4, 5, 6}
+
+	msg, err := dhcpv6.NewSolicit(mac, dhcpv6.WithArchType(iana.EFI_X86_64))
+	assert.NoError(err)
+
+	relay, err := dhcpv6.EncapsulateRelay(msg, dhcpv6.MessageTypeRelayForward, net.ParseIP("2001:db8::1"), net.ParseIP("fe80::1"))
+	assert.NoError(err)
+
+	resp, err := dhcpv6.NewAdvertiseFromSolicit(msg)
+	assert.NoError(err)
+	resp.AddOption(&dhcpv6.OptIANA{
+		Options: dhcpv6.IdentityOptions{Options: dhcpv6.Options{
+			&dhcpv6.OptIAAddress{IPv6Addr: net.ParseIP("2001:db8::10")},
+		}},
+	})
+
+	s.recordEvent6("cpn-01", mac, relay, msg)
+	s.recordEvent6("cpn-01", mac, relay, resp)
+
+	events, err := s.DB.DHCPEvents("cpn-01", time.Now().Add(-time.Hour))
+	if assert.NoError(err) && assert.Len(events, 2) {
+		assert.Equal("SOLICIT", events[0].MessageType)
+		assert.Equal("ADVERTISE", events[1].MessageType)
+		assert.Equal("2001:db8::10", events[1].IP.String())
+		assert.Equal("2001:db8::1", events[1].RelayIP.String())
+		assert.Equal("snponly-x86_64.efi", events[1].Firmware)
+	}
+}
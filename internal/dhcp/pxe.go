@@ -17,6 +17,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/ubccr/grendel/internal/firmware"
 	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/metrics"
 	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/internal/util"
 	"github.com/ubccr/grendel/pkg/model"
@@ -33,6 +34,7 @@ type PXEServer struct {
 	ServerAddress  net.IP
 	InterfaceIPMap map[int]net.IP
 	Port           int
+	History        bool
 	srv            *server4.Server
 	log            *logrus.Entry
 	conn           *ipv4.PacketConn
@@ -89,14 +91,25 @@ func NewPXEServer(db store.Store, address string) (*PXEServer, error) {
 }
 
 func (s *PXEServer) pxeHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.ControlMessage) {
+	result := metrics.ResultIgnored
+	defer func() {
+		metrics.DHCPRequests.WithLabelValues("pxe", req.MessageType().String(), result).Inc()
+	}()
+
 	host, err := s.DB.LoadHostFromMAC(req.ClientHWAddr.String())
 	if err != nil {
-		if !errors.Is(err, store.ErrNotFound) {
+		if errors.Is(err, store.ErrNotFound) {
+			metrics.DHCPUnknownMAC.WithLabelValues("pxe").Inc()
+			result = metrics.ResultUnknownMAC
+		} else {
 			s.log.Errorf("failed to find host: %s", err)
+			result = metrics.ResultError
 		}
 		return
 	}
 
+	s.recordEvent4(host.Name, req, req)
+
 	if !host.Provision {
 		s.log.Infof("Host %s not set to provision: %s", host.Name, req.ClientHWAddr.String())
 		return
@@ -118,6 +131,7 @@ func (s *PXEServer) pxeHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4
 	fwtype, err := firmware.DetectBuild(req.ClientArch(), "")
 	if err != nil {
 		s.log.Errorf("failed to get firmware: %s", err)
+		result = metrics.ResultError
 		return
 	}
 	if host.Firmware != 0 {
@@ -144,6 +158,7 @@ func (s *PXEServer) pxeHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4
 	)
 	if err != nil {
 		s.log.Errorf("failed to build reply: %v", err)
+		result = metrics.ResultError
 		return
 	}
 
@@ -154,6 +169,7 @@ func (s *PXEServer) pxeHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4
 	token, err := model.NewFirmwareToken(req.ClientHWAddr.String(), fwtype)
 	if err != nil {
 		s.log.Errorf("Failed to generated signed firmware token: %v", err)
+		result = metrics.ResultError
 		return
 	}
 	resp.BootFileName = token
@@ -168,12 +184,18 @@ func (s *PXEServer) pxeHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4
 		}
 	}
 
+	s.recordEvent4(host.Name, req, resp)
+
 	s.log.Debugf("Sending response")
 	s.log.Debugln(resp.Summary())
 
 	if _, err := s.conn.WriteTo(resp.ToBytes(), woob, peer); err != nil {
 		s.log.Errorf("UDP write to %v failed: %v", peer, err)
+		result = metrics.ResultError
+		return
 	}
+
+	result = metrics.ResultReply
 }
 
 func (s *PXEServer) Serve() error {
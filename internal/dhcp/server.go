@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"strconv"
 	"sync"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/insomniacslk/dhcp/dhcpv4/server4"
 	"github.com/sirupsen/logrus"
 	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/metrics"
 	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/internal/util"
 	"golang.org/x/net/ipv4"
@@ -32,7 +34,12 @@ type Server struct {
 	ProxyOnly      bool
 	DB             store.Store
 	LeaseTime      time.Duration
+	DynamicLease   time.Duration
+	History        bool
+	IsLeader       func() bool
+	Enroll         func(mac net.HardwareAddr, ip netip.Addr)
 	conn           *ipv4.PacketConn
+	leaseMu        sync.Mutex
 	quit           chan interface{}
 	wg             sync.WaitGroup
 }
@@ -87,45 +94,49 @@ func NewServer(db store.Store, address string) (*Server, error) {
 }
 
 func (s *Server) mainHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.ControlMessage) {
+	result := metrics.ResultIgnored
+	defer func() {
+		metrics.DHCPRequests.WithLabelValues("dhcp4", req.MessageType().String(), result).Inc()
+	}()
+
 	if req.OpCode != dhcpv4.OpcodeBootRequest {
 		log.Debugf("Ignoring not a BootRequest")
 		return
 	}
 
+	if !s.active() {
+		log.Debugf("Ignoring request from %s, not the HA leader", req.ClientHWAddr)
+		result = metrics.ResultStandby
+		return
+	}
+
+	serverIP := s.serverIP(oob)
+
 	host, err := s.DB.LoadHostFromMAC(req.ClientHWAddr.String())
 	if err != nil {
 		if errors.Is(err, store.ErrNotFound) {
+			metrics.DHCPUnknownMAC.WithLabelValues("dhcp4").Inc()
+			result = metrics.ResultUnknownMAC
+			if !s.ProxyOnly && s.dynamicEnabled() {
+				result = s.dynamicHandler4(serverIP, req, oob)
+				return
+			}
 			log.Debugf("Ignoring unknown client mac address: %s", req.ClientHWAddr)
 		} else {
 			log.Errorf("Failed to find host from database: %s", err)
+			result = metrics.ResultError
 		}
 		return
 	}
 
-	serverIP := s.ServerAddress
-	// Use the IP address of the interface the request came in on for the
-	// ServerIP if available.
-	if intfIP, ok := s.InterfaceIPMap[oob.IfIndex]; ok {
-		serverIP = intfIP
-	}
-
-	resp, err := dhcpv4.NewReplyFromRequest(req,
-		dhcpv4.WithServerIP(serverIP),
-		dhcpv4.WithMessageType(dhcpv4.MessageTypeOffer),
-		dhcpv4.WithOption(dhcpv4.OptClassIdentifier("PXEClient")),
-		dhcpv4.WithOption(dhcpv4.OptServerIdentifier(serverIP)),
-	)
+	resp, err := s.newReply4(serverIP, req)
 	if err != nil {
 		log.Printf("DHCP failed to build reply: %v", err)
+		result = metrics.ResultError
 		return
 	}
 
-	// Copy hop count? is this needed?
-	resp.HopCount = req.HopCount
-
-	if req.Options.Has(dhcpv4.OptionClientMachineIdentifier) {
-		resp.UpdateOption(dhcpv4.OptGeneric(dhcpv4.OptionClientMachineIdentifier, req.Options.Get(dhcpv4.OptionClientMachineIdentifier)))
-	}
+	s.recordEvent4(host.Name, req, req)
 
 	switch mt := req.MessageType(); mt {
 	case dhcpv4.MessageTypeDiscover:
@@ -137,6 +148,7 @@ func (s *Server) mainHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.C
 				"err":      err,
 			}).Error("Failed to add boot options to DHCP request")
 			if s.ProxyOnly {
+				result = metrics.ResultError
 				return
 			}
 		}
@@ -145,6 +157,7 @@ func (s *Server) mainHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.C
 			err := s.staticHandler4(host, serverIP, req, resp)
 			if err != nil {
 				log.Errorf("Failed to add client ip to DHCP DISCOVER: %s", err)
+				result = metrics.ResultError
 				return
 			}
 		}
@@ -156,6 +169,7 @@ func (s *Server) mainHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.C
 		err := s.staticAckHandler4(host, serverIP, req, resp)
 		if err != nil {
 			log.Errorf("Failed to ack DHCP REQUEST: %s", err)
+			result = metrics.ResultError
 			return
 		}
 	default:
@@ -164,7 +178,52 @@ func (s *Server) mainHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.C
 		return
 	}
 
-	peer = &net.UDPAddr{IP: net.IPv4bcast, Port: dhcpv4.ClientPort}
+	s.recordEvent4(host.Name, req, resp)
+	result = s.sendReply4(req, resp, oob)
+}
+
+// active returns true if the server answers requests, which is always the case
+// unless running as a follower of a high availability group
+func (s *Server) active() bool {
+	return s.IsLeader == nil || s.IsLeader()
+}
+
+// serverIP returns the IP address of the interface the request came in on if
+// available, otherwise the default ServerAddress
+func (s *Server) serverIP(oob *ipv4.ControlMessage) net.IP {
+	if oob != nil {
+		if intfIP, ok := s.InterfaceIPMap[oob.IfIndex]; ok {
+			return intfIP
+		}
+	}
+
+	return s.ServerAddress
+}
+
+func (s *Server) newReply4(serverIP net.IP, req *dhcpv4.DHCPv4) (*dhcpv4.DHCPv4, error) {
+	resp, err := dhcpv4.NewReplyFromRequest(req,
+		dhcpv4.WithServerIP(serverIP),
+		dhcpv4.WithMessageType(dhcpv4.MessageTypeOffer),
+		dhcpv4.WithOption(dhcpv4.OptClassIdentifier("PXEClient")),
+		dhcpv4.WithOption(dhcpv4.OptServerIdentifier(serverIP)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy hop count? is this needed?
+	resp.HopCount = req.HopCount
+
+	if req.Options.Has(dhcpv4.OptionClientMachineIdentifier) {
+		resp.UpdateOption(dhcpv4.OptGeneric(dhcpv4.OptionClientMachineIdentifier, req.Options.Get(dhcpv4.OptionClientMachineIdentifier)))
+	}
+
+	return resp, nil
+}
+
+// sendReply4 sends the response and returns the result used for metrics
+func (s *Server) sendReply4(req, resp *dhcpv4.DHCPv4, oob *ipv4.ControlMessage) string {
+	peer := &net.UDPAddr{IP: net.IPv4bcast, Port: dhcpv4.ClientPort}
 	if !req.GatewayIPAddr.IsUnspecified() {
 		peer = &net.UDPAddr{IP: req.GatewayIPAddr, Port: dhcpv4.ServerPort}
 		resp.SetBroadcast()
@@ -188,7 +247,14 @@ func (s *Server) mainHandler4(peer *net.UDPAddr, req *dhcpv4.DHCPv4, oob *ipv4.C
 
 	if _, err := s.conn.WriteTo(resp.ToBytes(), woob, peer); err != nil {
 		log.Printf("DHCP write to %v failed: %v", peer, err)
+		return metrics.ResultError
 	}
+
+	if resp.MessageType() == dhcpv4.MessageTypeNak {
+		return metrics.ResultNak
+	}
+
+	return metrics.ResultReply
 }
 
 func (s *Server) Serve() error {
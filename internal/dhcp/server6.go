@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/insomniacslk/dhcp/dhcpv6/server6"
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/insomniacslk/dhcp/interfaces"
+	"github.com/sirupsen/logrus"
+	"github.com/ubccr/grendel/internal/metrics"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/util"
+)
+
+type Server6 struct {
+	ListenAddress net.IP
+	ServerAddress net.IP
+	ServerID      dhcpv6.DUID
+	Port          int
+	DB            store.Store
+	LeaseTime     time.Duration
+	DNS           []net.IP
+	IsLeader      func() bool
+	History       bool
+	srv           *server6.Server
+	quit          chan interface{}
+	wg            sync.WaitGroup
+}
+
+func NewServer6(db store.Store, address string) (*Server6, error) {
+	s := &Server6{DB: db, quit: make(chan interface{})}
+
+	if address == "" {
+		address = fmt.Sprintf("[%s]:%d", net.IPv6unspecified.String(), dhcpv6.DefaultServerPort)
+	}
+
+	ipStr, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Port = port
+
+	ip := net.ParseIP(ipStr)
+	if ip == nil || ip.To4() != nil {
+		return nil, fmt.Errorf("Invalid IPv6 address: %s", ipStr)
+	}
+
+	s.ListenAddress = ip
+	s.ServerAddress = ip
+
+	if ip.IsUnspecified() {
+		ipaddr, err := util.GetFirstExternalIPv6FromInterfaces()
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Using default IPv6 ServerAddress: %s", ipaddr)
+		s.ServerAddress = ipaddr
+	}
+
+	duid, err := newServerDUID(s.ServerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	s.ServerID = duid
+
+	return s, nil
+}
+
+// newServerDUID returns a DUID-LL based on the hardware address of the
+// interface with the given ip address. If no interface is found the hardware
+// address of the first non-loopback interface is used.
+func newServerDUID(ip net.IP) (dhcpv6.DUID, error) {
+	if intf, err := util.GetInterfaceFromIPv6(ip); err == nil && len(intf.HardwareAddr) > 0 {
+		return &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: intf.HardwareAddr}, nil
+	}
+
+	intfs, err := interfaces.GetNonLoopbackInterfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, intf := range intfs {
+		if len(intf.HardwareAddr) > 0 {
+			return &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: intf.HardwareAddr}, nil
+		}
+	}
+
+	return nil, errors.New("Failed to find hardware address for DHCPv6 server DUID")
+}
+
+func (s *Server6) mainHandler6(conn net.PacketConn, peer net.Addr, req dhcpv6.DHCPv6) {
+	mtype := req.Type().String()
+	result := metrics.ResultIgnored
+	defer func() {
+		metrics.DHCPRequests.WithLabelValues("dhcp6", mtype, result).Inc()
+	}()
+
+	if s.IsLeader != nil && !s.IsLeader() {
+		log.Debugf("Ignoring DHCPv6 message from %s, not the HA leader", peer)
+		result = metrics.ResultStandby
+		return
+	}
+
+	msg, err := req.GetInnerMessage()
+	if err != nil {
+		log.Errorf("Failed to decode DHCPv6 message: %s", err)
+		result = metrics.ResultError
+		return
+	}
+	mtype = msg.Type().String()
+
+	mac, err := dhcpv6.ExtractMAC(req)
+	if err != nil {
+		log.Debugf("Ignoring DHCPv6 message - failed to find client mac address: %s", err)
+		return
+	}
+
+	host, err := s.DB.LoadHostFromMAC(mac.String())
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			log.Debugf("Ignoring unknown client mac address: %s", mac)
+			metrics.DHCPUnknownMAC.WithLabelValues("dhcp6").Inc()
+			result = metrics.ResultUnknownMAC
+		} else {
+			log.Errorf("Failed to find host from database: %s", err)
+			result = metrics.ResultError
+		}
+		return
+	}
+
+	s.recordEvent6(host.Name, mac, req, msg)
+
+	nic := host.Interface(mac)
+	if nic == nil || !nic.IPv6.IsValid() {
+		log.Debugf("Ignoring DHCPv6 message - no IPv6 address configured for mac: %s", mac)
+		return
+	}
+
+	if sid := msg.Options.ServerID(); sid != nil && !sid.Equal(s.ServerID) {
+		log.Debugf("Ignoring DHCPv6 message for another server: %s", sid)
+		return
+	}
+
+	var resp *dhcpv6.Message
+	switch mt := msg.Type(); mt {
+	case dhcpv6.MessageTypeSolicit:
+		if msg.GetOneOption(dhcpv6.OptionRapidCommit) != nil {
+			resp, err = dhcpv6.NewReplyFromMessage(msg)
+		} else {
+			resp, err = dhcpv6.NewAdvertiseFromSolicit(msg)
+		}
+	case dhcpv6.MessageTypeRequest, dhcpv6.MessageTypeConfirm, dhcpv6.MessageTypeRenew,
+		dhcpv6.MessageTypeRebind, dhcpv6.MessageTypeRelease, dhcpv6.MessageTypeInformationRequest:
+		resp, err = dhcpv6.NewReplyFromMessage(msg)
+	default:
+		log.Warnf("DHCPv6 Unhandled message type: %v", mt)
+		log.Debugln(msg.Summary())
+		return
+	}
+	if err != nil {
+		log.Errorf("DHCPv6 failed to build reply: %v", err)
+		result = metrics.ResultError
+		return
+	}
+
+	resp.AddOption(dhcpv6.OptServerID(s.ServerID))
+
+	log.WithFields(logrus.Fields{
+		"ip":           nic.Addr6String(),
+		"mac":          mac.String(),
+		"name":         host.Name,
+		"dhcp_message": msg.Type().String(),
+	}).Info("Found host")
+	log.Debugln(msg.Summary())
+
+	err = s.staticHandler6(host, nic, msg, resp)
+	if err != nil {
+		log.Errorf("Failed to add client ip to DHCPv6 %s: %s", msg.Type(), err)
+		result = metrics.ResultError
+		return
+	}
+
+	err = s.bootingHandler6(host, mac, msg, resp)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"mac":      mac.String(),
+			"host_uid": host.UID.String(),
+			"err":      err,
+		}).Error("Failed to add boot options to DHCPv6 request")
+	}
+
+	var out dhcpv6.DHCPv6 = resp
+	if req.IsRelay() {
+		relay, ok := req.(*dhcpv6.RelayMessage)
+		if !ok {
+			log.Errorf("DHCPv6 invalid relay message")
+			result = metrics.ResultError
+			return
+		}
+
+		out, err = dhcpv6.NewRelayReplFromRelayForw(relay, resp)
+		if err != nil {
+			log.Errorf("DHCPv6 failed to build relay reply: %v", err)
+			result = metrics.ResultError
+			return
+		}
+	}
+
+	s.recordEvent6(host.Name, mac, req, resp)
+
+	log.Debugf("Sending DHCPv6 packet response")
+	log.Debugln(out.Summary())
+
+	if _, err := conn.WriteTo(out.ToBytes(), peer); err != nil {
+		log.Printf("DHCPv6 write to %v failed: %v", peer, err)
+		result = metrics.ResultError
+		return
+	}
+
+	result = metrics.ResultReply
+}
+
+func (s *Server6) Serve() error {
+	listener := &net.UDPAddr{
+		IP:   s.ListenAddress,
+		Port: s.Port,
+	}
+
+	intf := ""
+	if !s.ListenAddress.IsUnspecified() {
+		iface, err := util.GetInterfaceFromIPv6(s.ListenAddress)
+		if err != nil {
+			return err
+		}
+		intf = iface.Name
+		listener = &net.UDPAddr{IP: net.IPv6unspecified, Port: s.Port}
+		log.Printf("Binding to interface: %s", intf)
+	}
+
+	handler := func(conn net.PacketConn, peer net.Addr, m dhcpv6.DHCPv6) {
+		s.wg.Add(1)
+		defer s.wg.Done()
+		s.mainHandler6(conn, peer, m)
+	}
+
+	srv, err := server6.NewServer(intf, listener, handler)
+	if err != nil {
+		return err
+	}
+
+	s.srv = srv
+
+	log.Infof("DHCPv6 server listening on: [%s]:%d", s.ListenAddress, s.Port)
+	err = s.srv.Serve()
+	select {
+	case <-s.quit:
+		return nil
+	default:
+		return err
+	}
+}
+
+func (s *Server6) Shutdown(ctx context.Context) error {
+	close(s.quit)
+	if s.srv == nil {
+		return nil
+	}
+
+	s.srv.Close()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-done:
+			return nil
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/insomniacslk/dhcp/dhcpv4"
 	"github.com/insomniacslk/dhcp/rfc1035label"
@@ -151,9 +152,30 @@ func (s *Server) staticHandler4(host *model.Host, serverIP net.IP, req, resp *dh
 	}).Info("Found host")
 	log.Debugln(req.Summary())
 
+	s.setNetworkOptions4(nic, s.LeaseTime, req, resp)
+	s.setZTD(host, nic, serverIP, req, resp)
+
+	if req.ClassIdentifier() == "iDRAC" && host.Provision {
+		token, _ := model.NewBootToken(host.UID.String(), nic.MAC.String())
+		scpFileLocation := fmt.Sprintf("-f idrac-config.json -i %s -s 5 -n boot/%s/provision", serverIP.String(), token)
+		log.Debugf("Dell iDRAC Auto Config SCP location: %s", scpFileLocation)
+		resp.UpdateOption(dhcpv4.Option{Code: dhcpv4.OptionVendorSpecificInformation, Value: dhcpv4.String(scpFileLocation)})
+
+		log.WithFields(logrus.Fields{
+			"ip":   nic.AddrString(),
+			"name": host.Name,
+		}).Info("Host iDRAC Auto Config requested. Sending VendorSpecificInfo SCP file location")
+	}
+
+	return nil
+}
+
+// setNetworkOptions4 sets the client address and network configuration
+// options for the given interface
+func (s *Server) setNetworkOptions4(nic *model.NetInterface, leaseTime time.Duration, req, resp *dhcpv4.DHCPv4) {
 	resp.YourIPAddr = nic.ToStdAddr()
 	resp.UpdateOption(dhcpv4.OptSubnetMask(nic.Netmask()))
-	resp.UpdateOption(dhcpv4.OptIPAddressLeaseTime(s.LeaseTime))
+	resp.UpdateOption(dhcpv4.OptIPAddressLeaseTime(leaseTime))
 
 	if req.IsOptionRequested(dhcpv4.OptionInterfaceMTU) {
 		resp.UpdateOption(dhcpv4.OptGeneric(dhcpv4.OptionInterfaceMTU, dhcpv4.Uint16(nic.InterfaceMTU()).ToBytes()))
@@ -180,22 +202,6 @@ func (s *Server) staticHandler4(host *model.Host, serverIP net.IP, req, resp *dh
 			Labels: domainSearch,
 		}))
 	}
-
-	s.setZTD(host, nic, serverIP, req, resp)
-
-	if req.ClassIdentifier() == "iDRAC" && host.Provision {
-		token, _ := model.NewBootToken(host.UID.String(), nic.MAC.String())
-		scpFileLocation := fmt.Sprintf("-f idrac-config.json -i %s -s 5 -n boot/%s/provision", serverIP.String(), token)
-		log.Debugf("Dell iDRAC Auto Config SCP location: %s", scpFileLocation)
-		resp.UpdateOption(dhcpv4.Option{Code: dhcpv4.OptionVendorSpecificInformation, Value: dhcpv4.String(scpFileLocation)})
-
-		log.WithFields(logrus.Fields{
-			"ip":   nic.AddrString(),
-			"name": host.Name,
-		}).Info("Host iDRAC Auto Config requested. Sending VendorSpecificInfo SCP file location")
-	}
-
-	return nil
 }
 
 func (s *Server) staticAckHandler4(host *model.Host, serverIP net.IP, req, resp *dhcpv4.DHCPv4) error {
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package dhcp
+
+import (
+	"fmt"
+
+	"github.com/insomniacslk/dhcp/dhcpv6"
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func (s *Server6) staticHandler6(host *model.Host, nic *model.NetInterface, req, resp *dhcpv6.Message) error {
+	switch req.Type() {
+	case dhcpv6.MessageTypeConfirm:
+		// Confirm only checks the client addresses are appropriate for the
+		// link. Reply NotOnLink if the client has a different address than
+		// what's configured in Grendel.
+		for _, ia := range req.Options.IANA() {
+			for _, addr := range ia.Options.Addresses() {
+				if !addr.IPv6Addr.Equal(nic.ToStdAddr6()) {
+					msg := fmt.Sprintf("Confirmed IPv6 address %v does not match address configured in Grendel: %v", addr.IPv6Addr, nic.Addr6String())
+					log.Info(msg)
+					resp.AddOption(&dhcpv6.OptStatusCode{StatusCode: iana.StatusNotOnLink, StatusMessage: msg})
+					return nil
+				}
+			}
+		}
+		resp.AddOption(&dhcpv6.OptStatusCode{StatusCode: iana.StatusSuccess})
+		return nil
+	case dhcpv6.MessageTypeRelease:
+		// Addresses are static so there's nothing to release
+		resp.AddOption(&dhcpv6.OptStatusCode{StatusCode: iana.StatusSuccess, StatusMessage: "Release received"})
+		return nil
+	case dhcpv6.MessageTypeInformationRequest:
+		// Information-Request is stateless and must not include addresses
+	default:
+		for _, ia := range req.Options.IANA() {
+			respIA := &dhcpv6.OptIANA{
+				IaId: ia.IaId,
+				T1:   s.LeaseTime / 2,
+				T2:   s.LeaseTime * 4 / 5,
+			}
+			respIA.Options.Add(&dhcpv6.OptIAAddress{
+				IPv6Addr:          nic.ToStdAddr6(),
+				PreferredLifetime: s.LeaseTime,
+				ValidLifetime:     s.LeaseTime,
+			})
+			resp.AddOption(respIA)
+		}
+	}
+
+	if len(s.DNS) > 0 && req.IsOptionRequested(dhcpv6.OptionDNSRecursiveNameServer) {
+		resp.UpdateOption(dhcpv6.OptDNS(s.DNS...))
+	}
+
+	domainSearch := nic.DomainSearch()
+	if len(domainSearch) > 0 && req.IsOptionRequested(dhcpv6.OptionDomainSearchList) {
+		dhcpv6.WithDomainSearchList(domainSearch...)(resp)
+	}
+
+	if nic.FQDN != "" && req.GetOneOption(dhcpv6.OptionFQDN) != nil {
+		// Let the client know the server will handle DNS updates
+		dhcpv6.WithFQDN(0x01, nic.HostName())(resp)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/miekg/dns"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/metrics"
 	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/internal/util"
 )
@@ -56,6 +57,15 @@ func (h *handler) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 			}).Error("Failed to resolve FQDN")
 		}
 		answers = a(qname, h.ttl, ips)
+	case dns.TypeAAAA:
+		ips, err := h.db.ResolveIPv6(qname)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"qname": qname,
+				"err":   err,
+			}).Error("Failed to resolve FQDN")
+		}
+		answers = aaaa(qname, h.ttl, ips)
 	}
 
 	fwAddr := viper.GetString("dns.forward")
@@ -79,6 +89,8 @@ func (h *handler) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
 		m.SetRcode(r, dns.RcodeNameError)
 	}
 
+	metrics.DNSQueries.WithLabelValues(dns.Type(h.QType(r)).String(), dns.RcodeToString[m.Rcode]).Inc()
+
 	w.WriteMsg(m)
 }
 
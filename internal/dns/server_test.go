@@ -20,6 +20,7 @@ var (
 	serverAddr = "127.0.0.1:8053"
 	clientFQDN = "test-01.example.local"
 	clientIP   = netip.MustParsePrefix("10.1.0.1/24")
+	clientIP6  = netip.MustParsePrefix("2001:db8::567:89ab/64")
 )
 
 func newDNS() (*Server, error) {
@@ -34,6 +35,7 @@ func newDNS() (*Server, error) {
 			{
 				FQDN: clientFQDN,
 				IP:   clientIP,
+				IPv6: clientIP6,
 			},
 		},
 	})
@@ -90,6 +92,34 @@ func TestDns(t *testing.T) {
 	}
 	assert.Equal(r2.Answer[0].String(), "1.0.1.10.in-addr.arpa.\t5\tIN\tPTR\ttest-01.example.local.")
 
+	// Check standard grendel IPv6 lookup
+	m6 := new(dns.Msg)
+	m6.SetQuestion(clientFQDN+".", dns.TypeAAAA)
+
+	r6, err := dns.Exchange(m6, serverAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(r6.Response)
+	if len(r6.Answer) == 0 {
+		t.Fatal(errors.New("r6 response is empty"))
+	}
+	assert.Equal(r6.Answer[0].String(), clientFQDN+".\t5\tIN\tAAAA\t2001:db8::567:89ab")
+
+	// Check reverse grendel IPv6 lookup
+	m7 := new(dns.Msg)
+	m7.SetQuestion("b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.", dns.TypePTR)
+
+	r7, err := dns.Exchange(m7, serverAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(r7.Response)
+	if len(r7.Answer) == 0 {
+		t.Fatal(errors.New("r7 response is empty"))
+	}
+	assert.Equal(r7.Answer[0].String(), "b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.\t5\tIN\tPTR\ttest-01.example.local.")
+
 	// Check non forwarded lookup
 	m3 := new(dns.Msg)
 	m3.SetQuestion("miekl.nl.", dns.TypeMX)
@@ -7,6 +7,7 @@ package firmware
 import (
 	_ "embed"
 	"fmt"
+	"strings"
 
 	"github.com/insomniacslk/dhcp/iana"
 )
@@ -23,6 +24,10 @@ const (
 	GRENDEL
 )
 
+// HTTPClient is the vendor class identifier of UEFI HTTP Boot clients, which
+// must also be set in replies to them
+const HTTPClient = "HTTPClient"
+
 //go:embed bin/ipxe.pxe
 var ipxeBin []byte
 
@@ -105,11 +110,11 @@ func DetectBuild(archs iana.Archs, userClass string) (Build, error) {
 	switch arch {
 	case iana.INTEL_X86PC: // BIOS Boot
 		build = UNDI
-	case iana.EFI_IA32: // unverified
+	case iana.EFI_IA32, iana.EFI_X86_HTTP: // unverified
 		build = EFI386
-	case iana.EFI_BC, iana.EFI_X86_64: // UEFI x86_64 Boot
+	case iana.EFI_BC, iana.EFI_X86_64, iana.EFI_BC_HTTP, iana.EFI_X86_64_HTTP: // UEFI x86_64 Boot
 		build = SNPONLYx86_64
-	case iana.EFI_ARM64: // UEFI ARM64
+	case iana.EFI_ARM64, iana.EFI_ARM64_HTTP: // UEFI ARM64
 		build = SNPONLYarm64
 	default:
 		return build, fmt.Errorf("unsupported client system architecture type: %d", arch)
@@ -126,3 +131,24 @@ func DetectBuild(archs iana.Archs, userClass string) (Build, error) {
 
 	return build, nil
 }
+
+// IsHTTPBoot returns true if a client with the given system architecture types
+// and vendor class identifier boots with UEFI HTTP Boot instead of TFTP
+func IsHTTPBoot(archs iana.Archs, vendorClass string) bool {
+	if strings.HasPrefix(vendorClass, HTTPClient) {
+		return true
+	}
+
+	if len(archs) == 0 {
+		return false
+	}
+
+	// Only the UEFI HTTP arches Grendel has a binary for. 0x1f is s390 Basic,
+	// not an HTTP Boot arch, and s390 doesn't boot iPXE at all.
+	switch archs[0] {
+	case iana.EFI_X86_HTTP, iana.EFI_X86_64_HTTP, iana.EFI_BC_HTTP, iana.EFI_ARM64_HTTP:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package firmware
+
+import (
+	"testing"
+
+	"github.com/insomniacslk/dhcp/iana"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectBuild(t *testing.T) {
+	tests := []struct {
+		arch      iana.Arch
+		userClass string
+		build     Build
+	}{
+		{iana.INTEL_X86PC, "", UNDI},
+		{iana.INTEL_X86PC, "iPXE", IPXE},
+		{iana.EFI_IA32, "", EFI386},
+		{iana.EFI_X86_64, "", SNPONLYx86_64},
+		{iana.EFI_BC, "", SNPONLYx86_64},
+		{iana.EFI_ARM64, "", SNPONLYarm64},
+		{iana.EFI_X86_HTTP, "", EFI386},
+		{iana.EFI_X86_64_HTTP, "", SNPONLYx86_64},
+		{iana.EFI_BC_HTTP, "", SNPONLYx86_64},
+		{iana.EFI_ARM64_HTTP, "", SNPONLYarm64},
+		{iana.EFI_X86_64_HTTP, "grendel", GRENDEL},
+		{iana.EFI_X86_64, "iPXE", SNPONLYx86_64},
+	}
+
+	for _, test := range tests {
+		build, err := DetectBuild(iana.Archs{test.arch}, test.userClass)
+		if assert.NoError(t, err, test.arch.String()) {
+			assert.Equal(t, test.build, build, test.arch.String())
+		}
+	}
+
+	for _, arch := range []iana.Arch{iana.EFI_ARM32_HTTP, iana.INTEL_X86PC_HTTP, iana.S390_BASIC} {
+		_, err := DetectBuild(iana.Archs{arch}, "")
+		assert.Error(t, err, arch.String())
+	}
+
+	_, err := DetectBuild(nil, "")
+	assert.Error(t, err)
+}
+
+func TestIsHTTPBoot(t *testing.T) {
+	tests := []struct {
+		archs       iana.Archs
+		vendorClass string
+		http        bool
+	}{
+		{iana.Archs{iana.EFI_X86_HTTP}, "", true},
+		{iana.Archs{iana.EFI_X86_64_HTTP}, "", true},
+		{iana.Archs{iana.EFI_BC_HTTP}, "", true},
+		{iana.Archs{iana.EFI_ARM64_HTTP}, "", true},
+		{iana.Archs{iana.EFI_X86_64}, "HTTPClient:Arch:00016:UNDI:003001", true},
+		{nil, "HTTPClient", true},
+		{iana.Archs{iana.EFI_X86_64}, "PXEClient:Arch:00007:UNDI:003001", false},
+		{iana.Archs{iana.INTEL_X86PC}, "", false},
+		{iana.Archs{iana.S390_BASIC}, "", false},
+		{nil, "", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.http, IsHTTPBoot(test.archs, test.vendorClass), "%v %q", test.archs, test.vendorClass)
+	}
+}
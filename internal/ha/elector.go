@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package ha implements active/standby leader election for Grendel instances
+// sharing the same database.
+package ha
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	// LeaseName is the name of the leader lease row in the database
+	LeaseName = "grendel"
+
+	// DefaultLeaseTTL is the default time a leader holds the lease without
+	// renewing it
+	DefaultLeaseTTL = 15 * time.Second
+
+	RoleStandalone = "standalone"
+	RoleLeader     = "leader"
+	RoleFollower   = "follower"
+)
+
+var log = logger.GetLogger("HA")
+
+// Elector competes for the leader lease with any other Grendel instance using
+// the same database. The lease is renewed every third of its TTL, an instance
+// stops being leader as soon as its lease expires even if it could not reach
+// the database to renew it.
+type Elector struct {
+	DB  store.Store
+	ID  string
+	TTL time.Duration
+
+	mu     sync.RWMutex
+	lease  *model.LeaderLease
+	leader bool
+}
+
+// NewElector returns a new Elector. If id is empty a default id is made from
+// the hostname and process id.
+func NewElector(db store.Store, id string, ttl time.Duration) (*Elector, error) {
+	if id == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		id = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultLeaseTTL
+	}
+
+	return &Elector{DB: db, ID: id, TTL: ttl}, nil
+}
+
+// Run takes part in the election until the context is done, when the lease is
+// released if held
+func (e *Elector) Run(ctx context.Context) error {
+	log.Infof("Starting leader election as %s with lease ttl %s", e.ID, e.TTL)
+
+	ticker := time.NewTicker(e.TTL / 3)
+	defer ticker.Stop()
+
+	for {
+		e.renew(time.Now())
+
+		select {
+		case <-ctx.Done():
+			e.release()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// IsLeader returns true if this instance holds an unexpired leader lease
+func (e *Elector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.lease != nil && e.lease.HeldBy(e.ID, time.Now())
+}
+
+// Lease returns a copy of the last known leader lease, or nil if none is
+// known
+func (e *Elector) Lease() *model.LeaderLease {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.lease == nil {
+		return nil
+	}
+
+	lease := *e.lease
+	return &lease
+}
+
+// Role returns the current role of this instance
+func (e *Elector) Role() string {
+	if e.IsLeader() {
+		return RoleLeader
+	}
+
+	return RoleFollower
+}
+
+func (e *Elector) renew(now time.Time) {
+	lease, err := e.DB.AcquireLeaderLease(LeaseName, e.ID, e.TTL)
+	if err != nil {
+		log.Errorf("Failed to renew leader lease: %s", err)
+	} else {
+		e.mu.Lock()
+		e.lease = lease
+		e.mu.Unlock()
+	}
+
+	e.transition(now)
+}
+
+// transition logs changes in leadership
+func (e *Elector) transition(now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	leader := e.lease != nil && e.lease.HeldBy(e.ID, now)
+	if leader == e.leader {
+		return
+	}
+
+	e.leader = leader
+	if leader {
+		log.Infof("Acquired leader lease, %s is now the leader", e.ID)
+	} else if e.lease != nil && !e.lease.Expired(now) {
+		log.Infof("Lost leader lease, following %s", e.lease.Holder)
+	} else {
+		log.Warn("Leader lease expired, no longer the leader")
+	}
+}
+
+func (e *Elector) release() {
+	if !e.IsLeader() {
+		return
+	}
+
+	err := e.DB.ReleaseLeaderLease(LeaseName, e.ID)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		log.Errorf("Failed to release leader lease: %s", err)
+		return
+	}
+
+	e.mu.Lock()
+	e.lease = nil
+	e.leader = false
+	e.mu.Unlock()
+
+	log.Info("Released leader lease")
+}
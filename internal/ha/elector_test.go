@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package ha
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/internal/store/sqlstore"
+)
+
+func TestElector(t *testing.T) {
+	assert := assert.New(t)
+
+	db, err := sqlstore.New(":memory:")
+	if err != nil {
+		assert.Fail(err.Error())
+	}
+
+	a, err := NewElector(db, "node-a", time.Minute)
+	assert.NoError(err)
+	b, err := NewElector(db, "node-b", time.Minute)
+	assert.NoError(err)
+
+	a.renew(time.Now())
+	b.renew(time.Now())
+	assert.True(a.IsLeader())
+	assert.Equal(RoleLeader, a.Role())
+	assert.False(b.IsLeader())
+	assert.Equal(RoleFollower, b.Role())
+	if assert.NotNil(b.Lease()) {
+		assert.Equal("node-a", b.Lease().Holder)
+	}
+
+	// Followers take over once the leader releases the lease
+	a.release()
+	assert.False(a.IsLeader())
+	b.renew(time.Now())
+	assert.True(b.IsLeader())
+	a.renew(time.Now())
+	assert.False(a.IsLeader())
+
+	// The lease is released when the elector stops
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NoError(b.Run(ctx))
+	assert.False(b.IsLeader())
+	a.renew(time.Now())
+	assert.True(a.IsLeader())
+}
+
+func TestElectorDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	e, err := NewElector(nil, "", 0)
+	if assert.NoError(err) {
+		assert.NotEmpty(e.ID)
+		assert.Equal(DefaultLeaseTTL, e.TTL)
+		assert.False(e.IsLeader())
+		assert.Nil(e.Lease())
+	}
+}
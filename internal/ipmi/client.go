@@ -0,0 +1,557 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package ipmi implements a minimal IPMI v2.0 over LAN (RMCP+) client for
+// BMCs without Redfish support. Sessions use cipher suite 3: RAKP-HMAC-SHA1
+// authentication, HMAC-SHA1-96 integrity and AES-CBC-128 confidentiality.
+package ipmi
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	// DefaultPort is the RMCP port of BMCs
+	DefaultPort = "623"
+
+	// DefaultTimeout is how long to wait for a response before retrying
+	DefaultTimeout = 2 * time.Second
+
+	// DefaultRetries is the number of times a request is retried
+	DefaultRetries = 2
+)
+
+const (
+	rmcpVersion   = 0x06
+	rmcpSeq       = 0xff
+	rmcpClassIPMI = 0x07
+
+	authTypeNone     = 0x00
+	authTypeRMCPPlus = 0x06
+
+	payloadIPMI           = 0x00
+	payloadOpenSessionReq = 0x10
+	payloadOpenSessionRsp = 0x11
+	payloadRAKP1          = 0x12
+	payloadRAKP2          = 0x13
+	payloadRAKP3          = 0x14
+	payloadRAKP4          = 0x15
+
+	payloadEncrypted     = 0x80
+	payloadAuthenticated = 0x40
+
+	authRAKPHMACSHA1   = 0x01
+	integrityHMACSHA1  = 0x01
+	confidentialityAES = 0x01
+
+	bmcAddr     = 0x20
+	consoleAddr = 0x81
+
+	privAdmin = 0x04
+	// privLookupName looks up the user by name only
+	privLookupName = 0x10
+
+	authCodeSize  = 20
+	integritySize = 12
+)
+
+// ErrAuth is returned when the BMC rejects the user name or password
+var ErrAuth = errors.New("ipmi: authentication failed")
+
+// CompletionError is a non-zero completion code returned by the BMC
+type CompletionError struct {
+	NetFn uint8
+	Cmd   uint8
+	Code  uint8
+}
+
+func (e *CompletionError) Error() string {
+	return fmt.Sprintf("ipmi: netfn 0x%02x command 0x%02x failed with completion code 0x%02x", e.NetFn, e.Cmd, e.Code)
+}
+
+// Client is an authenticated RMCP+ session with a BMC. A Client is not safe
+// for concurrent use.
+type Client struct {
+	Timeout time.Duration
+	Retries int
+
+	conn      net.Conn
+	user      []byte
+	pass      []byte
+	consoleID uint32
+	bmcID     uint32
+	seq       uint32
+	rqSeq     uint8
+	k1        []byte
+	k2        []byte
+	active    bool
+}
+
+// Dial connects to the BMC at addr, host or host:port, and opens an
+// administrator session
+func Dial(addr, user, pass string) (*Client, error) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, DefaultPort)
+	}
+
+	if len(user) > 16 || len(pass) > 20 {
+		return nil, errors.New("ipmi: user name is limited to 16 and password to 20 characters")
+	}
+
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		Timeout: DefaultTimeout,
+		Retries: DefaultRetries,
+		conn:    conn,
+		user:    []byte(user),
+		pass:    []byte(pass),
+	}
+
+	if err := c.open(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Close closes the session and the connection
+func (c *Client) Close() error {
+	if c.active {
+		id := make([]byte, 4)
+		binary.LittleEndian.PutUint32(id, c.bmcID)
+		// The BMC may not answer once the session is gone
+		c.Retries = 0
+		_, _ = c.Send(NetFnApp, cmdCloseSession, id)
+		c.active = false
+	}
+
+	return c.conn.Close()
+}
+
+func (c *Client) open() error {
+	if err := c.channelAuthCapabilities(); err != nil {
+		return err
+	}
+
+	var id [4]byte
+	for binary.LittleEndian.Uint32(id[:]) == 0 {
+		if _, err := rand.Read(id[:]); err != nil {
+			return err
+		}
+	}
+	c.consoleID = binary.LittleEndian.Uint32(id[:])
+
+	// Open Session Request
+	req := []byte{0, 0, 0, 0}
+	req = append(req, id[:]...)
+	req = append(req, algorithm(0x00, authRAKPHMACSHA1)...)
+	req = append(req, algorithm(0x01, integrityHMACSHA1)...)
+	req = append(req, algorithm(0x02, confidentialityAES)...)
+
+	rsp, err := c.exchange(payloadOpenSessionReq, payloadOpenSessionRsp, req)
+	if err != nil {
+		return err
+	}
+	if len(rsp) < 12 {
+		return errors.New("ipmi: short open session response")
+	}
+	if rsp[1] != 0 {
+		return fmt.Errorf("ipmi: open session failed with status 0x%02x, cipher suite 3 may not be supported", rsp[1])
+	}
+	if binary.LittleEndian.Uint32(rsp[4:8]) != c.consoleID {
+		return errors.New("ipmi: open session response for another session")
+	}
+	c.bmcID = binary.LittleEndian.Uint32(rsp[8:12])
+
+	// RAKP 1 and 2
+	rm := make([]byte, 16)
+	if _, err := rand.Read(rm); err != nil {
+		return err
+	}
+	role := byte(privAdmin | privLookupName)
+
+	req = []byte{0, 0, 0, 0}
+	req = binary.LittleEndian.AppendUint32(req, c.bmcID)
+	req = append(req, rm...)
+	req = append(req, role, 0, 0, byte(len(c.user)))
+	req = append(req, c.user...)
+
+	rsp, err = c.exchange(payloadRAKP1, payloadRAKP2, req)
+	if err != nil {
+		return err
+	}
+	if len(rsp) >= 2 && rsp[1] != 0 {
+		if rsp[1] == 0x0d || rsp[1] == 0x12 {
+			return ErrAuth
+		}
+		return fmt.Errorf("ipmi: rakp 2 failed with status 0x%02x", rsp[1])
+	}
+	if len(rsp) < 40+authCodeSize {
+		return errors.New("ipmi: short rakp 2 message")
+	}
+	rc := rsp[8:24]
+	guid := rsp[24:40]
+
+	var buf bytes.Buffer
+	buf.Write(id[:])
+	binary.Write(&buf, binary.LittleEndian, c.bmcID)
+	buf.Write(rm)
+	buf.Write(rc)
+	buf.Write(guid)
+	buf.Write([]byte{role, byte(len(c.user))})
+	buf.Write(c.user)
+	if !hmac.Equal(rsp[40:40+authCodeSize], hmacSHA1(c.pass, buf.Bytes())) {
+		return ErrAuth
+	}
+
+	sik, k1, k2 := SessionKeys(c.pass, c.user, rm, rc, role)
+	c.k1 = k1
+	c.k2 = k2
+
+	// RAKP 3 and 4
+	buf.Reset()
+	buf.Write(rc)
+	buf.Write(id[:])
+	buf.Write([]byte{role, byte(len(c.user))})
+	buf.Write(c.user)
+
+	req = []byte{0, 0, 0, 0}
+	req = binary.LittleEndian.AppendUint32(req, c.bmcID)
+	req = append(req, hmacSHA1(c.pass, buf.Bytes())...)
+
+	rsp, err = c.exchange(payloadRAKP3, payloadRAKP4, req)
+	if err != nil {
+		return err
+	}
+	if len(rsp) >= 2 && rsp[1] != 0 {
+		return fmt.Errorf("ipmi: rakp 4 failed with status 0x%02x", rsp[1])
+	}
+	if len(rsp) < 8+integritySize {
+		return errors.New("ipmi: short rakp 4 message")
+	}
+
+	buf.Reset()
+	buf.Write(rm)
+	binary.Write(&buf, binary.LittleEndian, c.bmcID)
+	buf.Write(guid)
+	if !hmac.Equal(rsp[8:8+integritySize], hmacSHA1(sik, buf.Bytes())[:integritySize]) {
+		return errors.New("ipmi: invalid rakp 4 integrity check value")
+	}
+
+	c.active = true
+
+	_, err = c.Send(NetFnApp, cmdSetSessionPrivilege, []byte{privAdmin})
+	return err
+}
+
+// channelAuthCapabilities checks the BMC supports IPMI v2.0 with an IPMI
+// v1.5 session-less request
+func (c *Client) channelAuthCapabilities() error {
+	// Current channel, IPMI v2.0 extended data, administrator
+	msg := c.request(NetFnApp, cmdGetChannelAuthCapabilities, []byte{0x8e, privAdmin})
+
+	pkt := []byte{rmcpVersion, 0, rmcpSeq, rmcpClassIPMI, authTypeNone, 0, 0, 0, 0, 0, 0, 0, 0, byte(len(msg))}
+	pkt = append(pkt, msg...)
+
+	rsp, err := c.roundTrip(pkt, func(b []byte) ([]byte, bool) {
+		if len(b) < 14 || b[3] != rmcpClassIPMI || b[4] != authTypeNone {
+			return nil, false
+		}
+		return c.parseResponse(NetFnApp, cmdGetChannelAuthCapabilities, b[14:])
+	})
+	if err != nil {
+		return fmt.Errorf("ipmi: no response from bmc: %w", err)
+	}
+
+	if len(rsp) < 3 || rsp[0] != completionCode || rsp[2]&0x80 == 0 {
+		return errors.New("ipmi: bmc does not support ipmi v2.0")
+	}
+
+	return nil
+}
+
+// exchange sends a session setup payload and returns the response payload
+func (c *Client) exchange(reqType, rspType byte, payload []byte) ([]byte, error) {
+	tag := c.nextRqSeq()
+	payload[0] = tag
+
+	pkt := []byte{rmcpVersion, 0, rmcpSeq, rmcpClassIPMI, authTypeRMCPPlus, reqType, 0, 0, 0, 0, 0, 0, 0, 0}
+	pkt = binary.LittleEndian.AppendUint16(pkt, uint16(len(payload)))
+	pkt = append(pkt, payload...)
+
+	return c.roundTrip(pkt, func(b []byte) ([]byte, bool) {
+		if len(b) < 16 || b[4] != authTypeRMCPPlus || b[5]&0x3f != rspType {
+			return nil, false
+		}
+		n := int(binary.LittleEndian.Uint16(b[14:16]))
+		if len(b) < 16+n || n < 1 || b[16] != tag {
+			return nil, false
+		}
+		return b[16 : 16+n], true
+	})
+}
+
+// Send sends a command in the session and returns the response data without
+// the completion code. Non-zero completion codes are returned as a
+// *CompletionError.
+func (c *Client) Send(netFn, cmd uint8, data []byte) ([]byte, error) {
+	if !c.active {
+		return nil, errors.New("ipmi: no active session")
+	}
+
+	msg := c.request(netFn, cmd, data)
+
+	return c.roundTripSession(func() ([]byte, error) {
+		return c.sealPacket(msg)
+	}, func(b []byte) ([]byte, bool) {
+		payload, ok := c.openPacket(b)
+		if !ok {
+			return nil, false
+		}
+		return c.parseResponse(netFn, cmd, payload)
+	})
+}
+
+// request builds an IPMI message to the BMC
+func (c *Client) request(netFn, cmd uint8, data []byte) []byte {
+	msg := []byte{bmcAddr, netFn << 2}
+	msg = append(msg, checksum(msg))
+	msg = append(msg, consoleAddr, c.nextRqSeq()<<2, cmd)
+	msg = append(msg, data...)
+	return append(msg, checksum(msg[3:]))
+}
+
+// parseResponse returns the data of the response to the last request. The
+// completion code is left as the first byte of the data and checked by the
+// caller.
+func (c *Client) parseResponse(netFn, cmd uint8, msg []byte) ([]byte, bool) {
+	if len(msg) < 8 {
+		return nil, false
+	}
+	if msg[1]>>2 != netFn+1 || msg[4]>>2 != c.rqSeq || msg[5] != cmd {
+		return nil, false
+	}
+	if checksum(msg[:2]) != msg[2] || checksum(msg[3:len(msg)-1]) != msg[len(msg)-1] {
+		return nil, false
+	}
+
+	return msg[6 : len(msg)-1], true
+}
+
+func (c *Client) nextRqSeq() uint8 {
+	c.rqSeq = (c.rqSeq + 1) & 0x3f
+	return c.rqSeq
+}
+
+// sealPacket encrypts and signs an IPMI message in the session
+func (c *Client) sealPacket(msg []byte) ([]byte, error) {
+	c.seq++
+	return SealPacket(c.k1, c.k2, c.bmcID, c.seq, msg)
+}
+
+// openPacket verifies and decrypts a session packet from the BMC
+func (c *Client) openPacket(b []byte) ([]byte, bool) {
+	return OpenPacket(c.k1, c.k2, c.consoleID, b)
+}
+
+func (c *Client) roundTrip(pkt []byte, parse func([]byte) ([]byte, bool)) ([]byte, error) {
+	return c.roundTripSession(func() ([]byte, error) { return pkt, nil }, parse)
+}
+
+// roundTripSession sends the packet built by next and waits for a packet
+// accepted by parse, retrying on timeout. Session packets are built again
+// for each retry with a new sequence number.
+func (c *Client) roundTripSession(next func() ([]byte, error), parse func([]byte) ([]byte, bool)) ([]byte, error) {
+	buf := make([]byte, 1024)
+	var lastErr error
+
+	for attempt := 0; attempt <= c.Retries; attempt++ {
+		pkt, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := c.conn.Write(pkt); err != nil {
+			return nil, err
+		}
+
+		deadline := time.Now().Add(c.Timeout)
+		for {
+			if err := c.conn.SetReadDeadline(deadline); err != nil {
+				return nil, err
+			}
+			n, err := c.conn.Read(buf)
+			if err != nil {
+				lastErr = err
+				break
+			}
+
+			data, ok := parse(buf[:n])
+			if !ok {
+				// Stale or unrelated packet
+				continue
+			}
+
+			return data, nil
+		}
+	}
+
+	return nil, lastErr
+}
+
+// SessionKeys derives the session integrity key and the K1 integrity and K2
+// confidentiality keys from the RAKP exchange
+func SessionKeys(pass, user, rm, rc []byte, role byte) (sik, k1, k2 []byte) {
+	var buf bytes.Buffer
+	buf.Write(rm)
+	buf.Write(rc)
+	buf.Write([]byte{role, byte(len(user))})
+	buf.Write(user)
+
+	sik = hmacSHA1(pass, buf.Bytes())
+	k1 = hmacSHA1(sik, bytes.Repeat([]byte{0x01}, 20))
+	k2 = hmacSHA1(sik, bytes.Repeat([]byte{0x02}, 20))
+
+	return sik, k1, k2
+}
+
+// OpenPacket verifies and decrypts an authenticated and encrypted IPMI
+// payload sent to the given session id. It returns false for packets of
+// other sessions or with an invalid signature.
+func OpenPacket(k1, k2 []byte, sessionID uint32, b []byte) ([]byte, bool) {
+	if len(b) < 16 || b[3] != rmcpClassIPMI || b[4] != authTypeRMCPPlus {
+		return nil, false
+	}
+	if b[5]&0x3f != payloadIPMI || b[5]&payloadAuthenticated == 0 || b[5]&payloadEncrypted == 0 {
+		return nil, false
+	}
+	if binary.LittleEndian.Uint32(b[6:10]) != sessionID {
+		return nil, false
+	}
+
+	n := int(binary.LittleEndian.Uint16(b[14:16]))
+	if len(b) < 16+n+2+integritySize {
+		return nil, false
+	}
+
+	signed := b[4 : len(b)-integritySize]
+	if !hmac.Equal(b[len(b)-integritySize:], hmacSHA1(k1, signed)[:integritySize]) {
+		return nil, false
+	}
+
+	payload, err := decrypt(k2[:16], b[16:16+n])
+	if err != nil {
+		return nil, false
+	}
+
+	return payload, true
+}
+
+// SealPacket encrypts and signs an IPMI message for the given session id and
+// sequence number
+func SealPacket(k1, k2 []byte, sessionID, seq uint32, msg []byte) ([]byte, error) {
+	payload, err := encrypt(k2[:16], msg)
+	if err != nil {
+		return nil, err
+	}
+
+	pkt := []byte{rmcpVersion, 0, rmcpSeq, rmcpClassIPMI, authTypeRMCPPlus, payloadIPMI | payloadEncrypted | payloadAuthenticated}
+	pkt = binary.LittleEndian.AppendUint32(pkt, sessionID)
+	pkt = binary.LittleEndian.AppendUint32(pkt, seq)
+	pkt = binary.LittleEndian.AppendUint16(pkt, uint16(len(payload)))
+	pkt = append(pkt, payload...)
+
+	return sign(k1, pkt), nil
+}
+
+// sign pads a session packet and appends its HMAC-SHA1-96 auth code
+func sign(k1, pkt []byte) []byte {
+	// Everything from the auth type through the next header byte is a
+	// multiple of 4 bytes
+	pad := (4 - (len(pkt)-4+2)%4) % 4
+	for i := 0; i < pad; i++ {
+		pkt = append(pkt, 0xff)
+	}
+	pkt = append(pkt, byte(pad), rmcpClassIPMI)
+
+	return append(pkt, hmacSHA1(k1, pkt[4:])[:integritySize]...)
+}
+
+// encrypt returns the IV followed by the AES-CBC-128 encrypted message with
+// its confidentiality trailer
+func encrypt(key, msg []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	pad := (aes.BlockSize - (len(msg)+1)%aes.BlockSize) % aes.BlockSize
+	plain := append([]byte{}, msg...)
+	for i := 1; i <= pad; i++ {
+		plain = append(plain, byte(i))
+	}
+	plain = append(plain, byte(pad))
+
+	out := make([]byte, aes.BlockSize+len(plain))
+	if _, err := rand.Read(out[:aes.BlockSize]); err != nil {
+		return nil, err
+	}
+	cipher.NewCBCEncrypter(block, out[:aes.BlockSize]).CryptBlocks(out[aes.BlockSize:], plain)
+
+	return out, nil
+}
+
+func decrypt(key, payload []byte) ([]byte, error) {
+	if len(payload) < 2*aes.BlockSize || len(payload)%aes.BlockSize != 0 {
+		return nil, errors.New("ipmi: invalid encrypted payload length")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	plain := make([]byte, len(payload)-aes.BlockSize)
+	cipher.NewCBCDecrypter(block, payload[:aes.BlockSize]).CryptBlocks(plain, payload[aes.BlockSize:])
+
+	pad := int(plain[len(plain)-1])
+	if pad >= len(plain) {
+		return nil, errors.New("ipmi: invalid confidentiality pad")
+	}
+
+	return plain[:len(plain)-1-pad], nil
+}
+
+func algorithm(payloadType, algo byte) []byte {
+	return []byte{payloadType, 0, 0, 0x08, algo, 0, 0, 0}
+}
+
+func hmacSHA1(key, data []byte) []byte {
+	h := hmac.New(sha1.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// checksum returns the 2's complement checksum of b
+func checksum(b []byte) byte {
+	var sum byte
+	for _, v := range b {
+		sum += v
+	}
+	return -sum
+}
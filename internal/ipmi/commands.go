@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package ipmi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// Network functions
+const (
+	NetFnChassis = 0x00
+	NetFnSensor  = 0x04
+	NetFnApp     = 0x06
+	NetFnStorage = 0x0a
+)
+
+const (
+	cmdGetDeviceID                = 0x01
+	cmdColdReset                  = 0x02
+	cmdGetChannelAuthCapabilities = 0x38
+	cmdSetSessionPrivilege        = 0x3b
+	cmdCloseSession               = 0x3c
+
+	cmdGetChassisStatus  = 0x01
+	cmdChassisControl    = 0x02
+	cmdSetSystemBootOpts = 0x08
+
+	cmdGetSensorReading = 0x2d
+
+	cmdGetFRUInventoryAreaInfo = 0x10
+	cmdReadFRUData             = 0x11
+	cmdReserveSDRRepository    = 0x22
+	cmdGetSDR                  = 0x23
+	cmdReserveSEL              = 0x42
+	cmdClearSEL                = 0x47
+
+	completionCode = 0x00
+)
+
+// ChassisControl actions
+const (
+	PowerDown    = 0x00
+	PowerUp      = 0x01
+	PowerCycle   = 0x02
+	HardReset    = 0x03
+	PulseDiag    = 0x04
+	SoftShutdown = 0x05
+)
+
+// Boot devices of the boot flags boot option
+const (
+	BootNone = 0x00
+	BootPXE  = 0x04
+	BootDisk = 0x08
+	BootDiag = 0x10
+	BootBIOS = 0x18
+)
+
+// DeviceID is the response to Get Device ID
+type DeviceID struct {
+	DeviceID        uint8
+	DeviceRevision  uint8
+	FirmwareVersion string
+	IPMIVersion     string
+	ManufacturerID  uint32
+	ProductID       uint16
+}
+
+// ChassisStatus is the response to Get Chassis Status
+type ChassisStatus struct {
+	PowerOn       bool
+	PowerOverload bool
+	PowerFault    bool
+}
+
+// FRU is the board and product information of a FRU device
+type FRU struct {
+	BoardManufacturer   string
+	BoardProduct        string
+	BoardSerial         string
+	BoardPartNumber     string
+	ProductManufacturer string
+	ProductName         string
+	ProductPartNumber   string
+	ProductVersion      string
+	ProductSerial       string
+	ProductAssetTag     string
+}
+
+// do sends a command and checks its completion code
+func (c *Client) do(netFn, cmd uint8, data []byte) ([]byte, error) {
+	rsp, err := c.Send(netFn, cmd, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp) < 1 {
+		return nil, fmt.Errorf("ipmi: empty response to netfn 0x%02x command 0x%02x", netFn, cmd)
+	}
+	if rsp[0] != completionCode {
+		return nil, &CompletionError{NetFn: netFn, Cmd: cmd, Code: rsp[0]}
+	}
+
+	return rsp[1:], nil
+}
+
+// DeviceID returns the device id of the BMC
+func (c *Client) DeviceID() (*DeviceID, error) {
+	rsp, err := c.do(NetFnApp, cmdGetDeviceID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp) < 11 {
+		return nil, fmt.Errorf("ipmi: short get device id response")
+	}
+
+	return &DeviceID{
+		DeviceID:        rsp[0],
+		DeviceRevision:  rsp[1] & 0x0f,
+		FirmwareVersion: fmt.Sprintf("%d.%02x", rsp[2]&0x7f, rsp[3]),
+		IPMIVersion:     fmt.Sprintf("%d.%d", rsp[4]&0x0f, rsp[4]>>4),
+		ManufacturerID:  uint32(rsp[6]) | uint32(rsp[7])<<8 | uint32(rsp[8]&0x0f)<<16,
+		ProductID:       binary.LittleEndian.Uint16(rsp[9:11]),
+	}, nil
+}
+
+// ColdReset reboots the BMC
+func (c *Client) ColdReset() error {
+	_, err := c.do(NetFnApp, cmdColdReset, nil)
+	return err
+}
+
+// ChassisStatus returns the power status of the chassis
+func (c *Client) ChassisStatus() (*ChassisStatus, error) {
+	rsp, err := c.do(NetFnChassis, cmdGetChassisStatus, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp) < 1 {
+		return nil, fmt.Errorf("ipmi: short get chassis status response")
+	}
+
+	return &ChassisStatus{
+		PowerOn:       rsp[0]&0x01 != 0,
+		PowerOverload: rsp[0]&0x02 != 0,
+		PowerFault:    rsp[0]&0x08 != 0,
+	}, nil
+}
+
+// ChassisControl powers the chassis up, down, cycles or resets it
+func (c *Client) ChassisControl(action uint8) error {
+	_, err := c.do(NetFnChassis, cmdChassisControl, []byte{action})
+	return err
+}
+
+// SetBootDevice overrides the boot device of the next boot. EFI selects the
+// UEFI boot of the device.
+func (c *Client) SetBootDevice(device uint8, efi bool) error {
+	// Boot flags valid, next boot only
+	flags := byte(0x80)
+	if efi {
+		flags |= 0x20
+	}
+
+	_, err := c.do(NetFnChassis, cmdSetSystemBootOpts, []byte{0x05, flags, device, 0, 0})
+	return err
+}
+
+// ClearSEL erases the system event log
+func (c *Client) ClearSEL() error {
+	rsp, err := c.do(NetFnStorage, cmdReserveSEL, nil)
+	if err != nil {
+		return err
+	}
+	if len(rsp) < 2 {
+		return fmt.Errorf("ipmi: short reserve sel response")
+	}
+
+	_, err = c.do(NetFnStorage, cmdClearSEL, []byte{rsp[0], rsp[1], 'C', 'L', 'R', 0xaa})
+	return err
+}
+
+// FRU returns the board and product information of FRU device id
+func (c *Client) FRU(id uint8) (*FRU, error) {
+	rsp, err := c.do(NetFnStorage, cmdGetFRUInventoryAreaInfo, []byte{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp) < 3 {
+		return nil, fmt.Errorf("ipmi: short get fru inventory area info response")
+	}
+	size := int(binary.LittleEndian.Uint16(rsp[0:2]))
+	if rsp[2]&0x01 != 0 {
+		return nil, fmt.Errorf("ipmi: fru device %d is only accessible by words", id)
+	}
+
+	data := make([]byte, 0, size)
+	for len(data) < size {
+		n := size - len(data)
+		if n > 16 {
+			n = 16
+		}
+		rsp, err := c.do(NetFnStorage, cmdReadFRUData, []byte{id, byte(len(data)), byte(len(data) >> 8), byte(n)})
+		if err != nil {
+			return nil, err
+		}
+		if len(rsp) < 1 || rsp[0] == 0 || int(rsp[0]) > len(rsp)-1 {
+			return nil, fmt.Errorf("ipmi: invalid read fru data response")
+		}
+		data = append(data, rsp[1:1+rsp[0]]...)
+	}
+
+	return ParseFRU(data)
+}
+
+// ParseFRU parses the board and product areas of FRU data
+func ParseFRU(data []byte) (*FRU, error) {
+	if len(data) < 8 || data[0]&0x0f != 0x01 || checksum(data[:7]) != data[7] {
+		return nil, fmt.Errorf("ipmi: invalid fru common header")
+	}
+
+	fru := &FRU{}
+
+	area := func(offset byte, skip int) []string {
+		start := int(offset) * 8
+		if start == 0 || start+2 > len(data) {
+			return nil
+		}
+		end := start + int(data[start+1])*8
+		if end > len(data) {
+			end = len(data)
+		}
+		return fruFields(data[start+skip : end])
+	}
+
+	// Board area has a language code and manufacturing date
+	if f := area(data[3], 6); f != nil {
+		fields := []*string{&fru.BoardManufacturer, &fru.BoardProduct, &fru.BoardSerial, &fru.BoardPartNumber}
+		for i := range fields {
+			if i < len(f) {
+				*fields[i] = f[i]
+			}
+		}
+	}
+
+	// Product area has a language code
+	if f := area(data[4], 3); f != nil {
+		fields := []*string{&fru.ProductManufacturer, &fru.ProductName, &fru.ProductPartNumber, &fru.ProductVersion, &fru.ProductSerial, &fru.ProductAssetTag}
+		for i := range fields {
+			if i < len(f) {
+				*fields[i] = f[i]
+			}
+		}
+	}
+
+	return fru, nil
+}
+
+// fruFields decodes type/length encoded fields up to the end marker
+func fruFields(b []byte) []string {
+	fields := make([]string, 0)
+	for len(b) > 0 && b[0] != 0xc1 {
+		n := int(b[0] & 0x3f)
+		if 1+n > len(b) {
+			break
+		}
+		fields = append(fields, decodeString(b[0]>>6, b[1:1+n]))
+		b = b[1+n:]
+	}
+
+	return fields
+}
+
+// decodeString decodes a FRU or SDR string of the given type code
+func decodeString(typ byte, b []byte) string {
+	switch typ {
+	case 0x00:
+		return fmt.Sprintf("%x", b)
+	case 0x01:
+		// BCD plus
+		const digits = "0123456789 -.:,_"
+		var s strings.Builder
+		for _, v := range b {
+			s.WriteByte(digits[v>>4])
+			s.WriteByte(digits[v&0x0f])
+		}
+		return strings.TrimSpace(s.String())
+	case 0x02:
+		// 6-bit ASCII packed
+		var s strings.Builder
+		var bits uint32
+		var n uint
+		for _, v := range b {
+			bits |= uint32(v) << n
+			n += 8
+			for n >= 6 {
+				s.WriteByte(byte(bits&0x3f) + 0x20)
+				bits >>= 6
+				n -= 6
+			}
+		}
+		return strings.TrimSpace(s.String())
+	default:
+		return strings.TrimRight(string(b), " \x00")
+	}
+}
@@ -0,0 +1,282 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package ipmi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testUser = "admin"
+	testPass = "secret"
+)
+
+// fakeBMC answers RMCP+ session setup and a few chassis and sensor commands
+type fakeBMC struct {
+	conn      net.PacketConn
+	powerOn   bool
+	bootDev   byte
+	consoleID uint32
+	bmcID     uint32
+	rm, rc    []byte
+	guid      []byte
+	role      byte
+	sik       []byte
+	k1, k2    []byte
+}
+
+func newFakeBMC(t *testing.T) *fakeBMC {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	f := &fakeBMC{
+		conn:  conn,
+		bmcID: 0x0a0b0c0d,
+		rc:    bytes.Repeat([]byte{0x11}, 16),
+		guid:  bytes.Repeat([]byte{0x22}, 16),
+	}
+	go f.serve()
+
+	return f
+}
+
+func (f *fakeBMC) serve() {
+	buf := make([]byte, 1024)
+	for {
+		n, addr, err := f.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		if rsp := f.handle(buf[:n]); rsp != nil {
+			f.conn.WriteTo(rsp, addr)
+		}
+	}
+}
+
+func (f *fakeBMC) handle(b []byte) []byte {
+	if b[4] == authTypeNone {
+		msg := b[14:]
+		data := []byte{0, 0x01, 0x80, 0x14, 0x02, 0, 0, 0, 0}
+		rsp := []byte{rmcpVersion, 0, rmcpSeq, rmcpClassIPMI, authTypeNone, 0, 0, 0, 0, 0, 0, 0, 0}
+		out := response(msg, data)
+		rsp = append(rsp, byte(len(out)))
+		return append(rsp, out...)
+	}
+
+	payloadType := b[5] & 0x3f
+	if payloadType == payloadIPMI {
+		msg, ok := OpenPacket(f.k1, f.k2, f.bmcID, b)
+		if !ok {
+			return nil
+		}
+		rsp, err := SealPacket(f.k1, f.k2, f.consoleID, 1, response(msg, f.command(msg[1]>>2, msg[5], msg[6:len(msg)-1])))
+		if err != nil {
+			return nil
+		}
+		return rsp
+	}
+
+	payload := b[16:]
+	var out []byte
+	var rspType byte
+	switch payloadType {
+	case payloadOpenSessionReq:
+		f.consoleID = binary.LittleEndian.Uint32(payload[4:8])
+		rspType = payloadOpenSessionRsp
+		out = []byte{payload[0], 0, privAdmin, 0}
+		out = binary.LittleEndian.AppendUint32(out, f.consoleID)
+		out = binary.LittleEndian.AppendUint32(out, f.bmcID)
+		out = append(out, payload[8:32]...)
+	case payloadRAKP1:
+		f.rm = append([]byte{}, payload[8:24]...)
+		f.role = payload[24]
+		user := payload[28 : 28+payload[27]]
+		rspType = payloadRAKP2
+
+		var buf bytes.Buffer
+		binary.Write(&buf, binary.LittleEndian, f.consoleID)
+		binary.Write(&buf, binary.LittleEndian, f.bmcID)
+		buf.Write(f.rm)
+		buf.Write(f.rc)
+		buf.Write(f.guid)
+		buf.Write([]byte{f.role, byte(len(user))})
+		buf.Write(user)
+
+		out = []byte{payload[0], 0, 0, 0}
+		out = binary.LittleEndian.AppendUint32(out, f.consoleID)
+		out = append(out, f.rc...)
+		out = append(out, f.guid...)
+		out = append(out, hmacSHA1([]byte(testPass), buf.Bytes())...)
+		f.sik, f.k1, f.k2 = SessionKeys([]byte(testPass), user, f.rm, f.rc, f.role)
+	case payloadRAKP3:
+		var buf bytes.Buffer
+		buf.Write(f.rc)
+		binary.Write(&buf, binary.LittleEndian, f.consoleID)
+		buf.Write([]byte{f.role, byte(len(testUser))})
+		buf.WriteString(testUser)
+		rspType = payloadRAKP4
+		if !bytes.Equal(payload[8:28], hmacSHA1([]byte(testPass), buf.Bytes())) {
+			out = []byte{payload[0], 0x0f, 0, 0}
+			out = binary.LittleEndian.AppendUint32(out, f.consoleID)
+			break
+		}
+
+		buf.Reset()
+		buf.Write(f.rm)
+		binary.Write(&buf, binary.LittleEndian, f.bmcID)
+		buf.Write(f.guid)
+		out = []byte{payload[0], 0, 0, 0}
+		out = binary.LittleEndian.AppendUint32(out, f.consoleID)
+		out = append(out, hmacSHA1(f.sik, buf.Bytes())[:integritySize]...)
+	default:
+		return nil
+	}
+
+	rsp := []byte{rmcpVersion, 0, rmcpSeq, rmcpClassIPMI, authTypeRMCPPlus, rspType, 0, 0, 0, 0, 0, 0, 0, 0}
+	rsp = binary.LittleEndian.AppendUint16(rsp, uint16(len(out)))
+	return append(rsp, out...)
+}
+
+// command returns the completion code and response data of a command
+func (f *fakeBMC) command(netFn, cmd byte, data []byte) []byte {
+	switch {
+	case netFn == NetFnApp && cmd == cmdSetSessionPrivilege:
+		return []byte{0, data[0]}
+	case netFn == NetFnApp && cmd == cmdCloseSession:
+		return []byte{0}
+	case netFn == NetFnChassis && cmd == cmdGetChassisStatus:
+		status := byte(0)
+		if f.powerOn {
+			status = 0x01
+		}
+		return []byte{0, status, 0, 0}
+	case netFn == NetFnChassis && cmd == cmdChassisControl:
+		f.powerOn = data[0] != PowerDown
+		return []byte{0}
+	case netFn == NetFnChassis && cmd == cmdSetSystemBootOpts:
+		f.bootDev = data[2]
+		return []byte{0}
+	case netFn == NetFnStorage && cmd == cmdReserveSDRRepository:
+		return []byte{0, 0x01, 0x00}
+	case netFn == NetFnStorage && cmd == cmdGetSDR:
+		offset, n := int(data[4]), int(data[5])
+		record := testSDR()
+		return append([]byte{0, 0xff, 0xff}, record[offset:offset+n]...)
+	case netFn == NetFnSensor && cmd == cmdGetSensorReading:
+		// Reading 0x2d, scanning enabled, upper non-critical exceeded
+		return []byte{0, 0x2d, 0x40, 0x08}
+	}
+
+	return []byte{0xc1}
+}
+
+// testSDR returns a full sensor record for a temperature sensor reading
+// x/2 degrees
+func testSDR() []byte {
+	name := "Inlet Temp"
+	record := make([]byte, 48)
+	record[0] = 0x01
+	record[2] = 0x51
+	record[3] = sdrFullSensor
+	record[5] = bmcAddr
+	record[7] = 0x04
+	record[12] = 0x01
+	record[13] = readingTypeThreshold
+	record[24] = 5
+	record[29] = 0xf0
+	record[47] = 0xc0 | byte(len(name))
+	record = append(record, name...)
+	record[4] = byte(len(record) - sdrHeaderSize)
+
+	return record
+}
+
+// response builds the response message to a request message
+func response(req, data []byte) []byte {
+	msg := []byte{consoleAddr, (req[1]>>2 + 1) << 2}
+	msg = append(msg, checksum(msg))
+	msg = append(msg, bmcAddr, req[4], req[5])
+	msg = append(msg, data...)
+	return append(msg, checksum(msg[3:]))
+}
+
+func TestSession(t *testing.T) {
+	assert := assert.New(t)
+
+	bmc := newFakeBMC(t)
+	addr := bmc.conn.LocalAddr().String()
+
+	c, err := Dial(addr, testUser, testPass)
+	if !assert.NoError(err) {
+		return
+	}
+	defer c.Close()
+
+	status, err := c.ChassisStatus()
+	if assert.NoError(err) {
+		assert.False(status.PowerOn)
+	}
+
+	assert.NoError(c.SetBootDevice(BootPXE, false))
+	assert.Equal(byte(BootPXE), bmc.bootDev)
+
+	assert.NoError(c.ChassisControl(PowerUp))
+	status, err = c.ChassisStatus()
+	if assert.NoError(err) {
+		assert.True(status.PowerOn)
+	}
+
+	_, err = c.DeviceID()
+	var cerr *CompletionError
+	if assert.ErrorAs(err, &cerr) {
+		assert.Equal(uint8(0xc1), cerr.Code)
+	}
+
+	sensors, err := c.Sensors()
+	if assert.NoError(err) && assert.Len(sensors, 1) {
+		assert.Equal("Inlet Temp", sensors[0].Name)
+		assert.True(sensors[0].HasValue)
+		assert.Equal(22.5, sensors[0].Value)
+		assert.Equal(SensorWarning, sensors[0].State)
+		assert.Equal(SensorWarning, Health(sensors))
+	}
+}
+
+func TestSessionBadPassword(t *testing.T) {
+	bmc := newFakeBMC(t)
+
+	_, err := Dial(bmc.conn.LocalAddr().String(), testUser, "wrong")
+	assert.ErrorIs(t, err, ErrAuth)
+}
+
+func TestParseFRU(t *testing.T) {
+	assert := assert.New(t)
+
+	board := []byte{0x01, 0x00, 0x19, 0, 0, 0, 0xc4, 'D', 'e', 'l', 'l', 0xc3, 'R', '6', '4', 0xc5, 'A', 'B', 'C', '1', '2', 0xc1}
+	for len(board)%8 != 7 {
+		board = append(board, 0)
+	}
+	board[1] = byte((len(board) + 1) / 8)
+	board = append(board, checksum(board))
+
+	header := []byte{0x01, 0, 0, 0x01, 0, 0, 0}
+	header = append(header, checksum(header))
+
+	fru, err := ParseFRU(append(header, board...))
+	if assert.NoError(err) {
+		assert.Equal("Dell", fru.BoardManufacturer)
+		assert.Equal("R64", fru.BoardProduct)
+		assert.Equal("ABC12", fru.BoardSerial)
+		assert.Equal("", fru.ProductSerial)
+	}
+}
@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package ipmi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	sdrFullSensor    = 0x01
+	sdrCompactSensor = 0x02
+
+	// readingTypeThreshold is the event/reading type code of threshold
+	// sensors
+	readingTypeThreshold = 0x01
+
+	sdrHeaderSize = 5
+	sdrChunkSize  = 16
+	sdrLastRecord = 0xffff
+
+	ccReservationCanceled = 0xc5
+	ccNotPresent          = 0xcb
+)
+
+// Sensor health states
+const (
+	SensorOK       = "OK"
+	SensorWarning  = "Warning"
+	SensorCritical = "Critical"
+)
+
+// Sensor is the reading of a sensor from the SDR repository
+type Sensor struct {
+	Number uint8
+	Name   string
+	Type   uint8
+
+	// Value is the converted reading of threshold sensors with an analog
+	// reading
+	Value    float64
+	HasValue bool
+
+	// State is OK, Warning or Critical for threshold sensors. Discrete
+	// sensors are always OK.
+	State string
+}
+
+type sdrRecord struct {
+	number      uint8
+	name        string
+	sensorType  uint8
+	readingType uint8
+	analog      byte
+	m, b        int32
+	rExp, bExp  int32
+}
+
+// Sensors reads all sensors owned by the BMC listed in the SDR repository.
+// Sensors with no reading available are skipped.
+func (c *Client) Sensors() ([]Sensor, error) {
+	records, err := c.sdrRecords()
+	if err != nil {
+		return nil, err
+	}
+
+	sensors := make([]Sensor, 0, len(records))
+	for _, r := range records {
+		rsp, err := c.do(NetFnSensor, cmdGetSensorReading, []byte{r.number})
+		var cerr *CompletionError
+		if errors.As(err, &cerr) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		// Scanning disabled or reading unavailable
+		if len(rsp) < 2 || rsp[1]&0x40 == 0 || rsp[1]&0x20 != 0 {
+			continue
+		}
+
+		s := Sensor{
+			Number: r.number,
+			Name:   r.name,
+			Type:   r.sensorType,
+			State:  SensorOK,
+		}
+
+		if r.readingType == readingTypeThreshold {
+			if r.analog != 0x03 {
+				s.Value = r.convert(rsp[0])
+				s.HasValue = true
+			}
+			if len(rsp) >= 3 {
+				s.State = thresholdState(rsp[2])
+			}
+		}
+
+		sensors = append(sensors, s)
+	}
+
+	return sensors, nil
+}
+
+// Health returns the worst state of the sensors
+func Health(sensors []Sensor) string {
+	health := SensorOK
+	for _, s := range sensors {
+		switch s.State {
+		case SensorCritical:
+			return SensorCritical
+		case SensorWarning:
+			health = SensorWarning
+		}
+	}
+
+	return health
+}
+
+// thresholdState returns the state of the threshold comparison status bits
+func thresholdState(status byte) string {
+	switch {
+	// Lower/upper critical and non-recoverable
+	case status&0x36 != 0:
+		return SensorCritical
+	// Lower/upper non-critical
+	case status&0x09 != 0:
+		return SensorWarning
+	}
+
+	return SensorOK
+}
+
+// convert returns the reading converted with the sensor's linear formula
+// y = (M*x + B*10^Bexp) * 10^Rexp
+func (r *sdrRecord) convert(raw byte) float64 {
+	var x float64
+	switch r.analog {
+	case 0x01:
+		// 1's complement
+		if raw&0x80 != 0 {
+			x = -float64(^raw & 0x7f)
+		} else {
+			x = float64(raw)
+		}
+	case 0x02:
+		x = float64(int8(raw))
+	default:
+		x = float64(raw)
+	}
+
+	y := (float64(r.m)*x + float64(r.b)*math.Pow10(int(r.bExp))) * math.Pow10(int(r.rExp))
+
+	return math.Round(y*1000) / 1000
+}
+
+// sdrRecords returns the full and compact sensor records of sensors owned by
+// the BMC
+func (c *Client) sdrRecords() ([]*sdrRecord, error) {
+	records := make([]*sdrRecord, 0)
+
+	reservation, err := c.reserveSDR()
+	if err != nil {
+		return nil, err
+	}
+
+	id := uint16(0)
+	for i := 0; id != sdrLastRecord && i < 1024; i++ {
+		next, data, err := c.readSDR(reservation, id)
+		var cerr *CompletionError
+		if errors.As(err, &cerr) && cerr.Code == ccReservationCanceled {
+			if reservation, err = c.reserveSDR(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if errors.As(err, &cerr) && cerr.Code == ccNotPresent && id == 0 {
+			// Empty repository
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if r := parseSDR(data); r != nil {
+			records = append(records, r)
+		}
+
+		id = next
+	}
+
+	return records, nil
+}
+
+func (c *Client) reserveSDR() ([]byte, error) {
+	rsp, err := c.do(NetFnStorage, cmdReserveSDRRepository, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(rsp) < 2 {
+		return nil, fmt.Errorf("ipmi: short reserve sdr repository response")
+	}
+
+	return rsp[:2], nil
+}
+
+// readSDR reads a record in chunks, as many BMCs cannot return a whole
+// record in one response
+func (c *Client) readSDR(reservation []byte, id uint16) (uint16, []byte, error) {
+	read := func(offset, n int) (uint16, []byte, error) {
+		rsp, err := c.do(NetFnStorage, cmdGetSDR, []byte{reservation[0], reservation[1], byte(id), byte(id >> 8), byte(offset), byte(n)})
+		if err != nil {
+			return 0, nil, err
+		}
+		if len(rsp) < 2+n {
+			return 0, nil, fmt.Errorf("ipmi: short get sdr response")
+		}
+		return uint16(rsp[0]) | uint16(rsp[1])<<8, rsp[2 : 2+n], nil
+	}
+
+	next, data, err := read(0, sdrHeaderSize)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	size := sdrHeaderSize + int(data[4])
+	for len(data) < size {
+		n := size - len(data)
+		if n > sdrChunkSize {
+			n = sdrChunkSize
+		}
+		_, chunk, err := read(len(data), n)
+		if err != nil {
+			return 0, nil, err
+		}
+		data = append(data, chunk...)
+	}
+
+	return next, data, nil
+}
+
+// parseSDR parses full and compact sensor records owned by the BMC and
+// returns nil for all other records
+func parseSDR(data []byte) *sdrRecord {
+	if len(data) < 14 || data[5] != bmcAddr || data[6]&0x03 != 0 {
+		return nil
+	}
+
+	r := &sdrRecord{
+		number:      data[7],
+		sensorType:  data[12],
+		readingType: data[13],
+		analog:      0x03,
+		m:           1,
+	}
+
+	var nameAt int
+	switch data[3] {
+	case sdrFullSensor:
+		if len(data) < 48 {
+			return nil
+		}
+		r.analog = data[20] >> 6
+		r.m = signExtend(int32(data[24])|int32(data[25]&0xc0)<<2, 10)
+		r.b = signExtend(int32(data[26])|int32(data[27]&0xc0)<<2, 10)
+		r.rExp = signExtend(int32(data[29]>>4), 4)
+		r.bExp = signExtend(int32(data[29]&0x0f), 4)
+		nameAt = 47
+	case sdrCompactSensor:
+		if len(data) < 32 {
+			return nil
+		}
+		nameAt = 31
+	default:
+		return nil
+	}
+
+	n := int(data[nameAt] & 0x1f)
+	if nameAt+1+n <= len(data) {
+		r.name = decodeString(data[nameAt]>>6, data[nameAt+1:nameAt+1+n])
+	}
+
+	return r
+}
+
+func signExtend(v int32, bits uint) int32 {
+	shift := 32 - bits
+	return v << shift >> shift
+}
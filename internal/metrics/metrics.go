@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package metrics defines the Prometheus collectors exported by the Grendel
+// services on the /metrics endpoint.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "grendel"
+
+// Results of a DHCP request
+const (
+	ResultReply      = "reply"
+	ResultNak        = "nak"
+	ResultIgnored    = "ignored"
+	ResultUnknownMAC = "unknown_mac"
+	ResultStandby    = "standby"
+	ResultError      = "error"
+)
+
+var (
+	// DHCPRequests counts DHCP requests by server (dhcp4, dhcp6 or pxe),
+	// message type and result
+	DHCPRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "dhcp",
+		Name:      "requests_total",
+		Help:      "Number of DHCP requests handled by message type and result.",
+	}, []string{"server", "message_type", "result"})
+
+	// DHCPUnknownMAC counts DHCP requests from MAC addresses not assigned to
+	// any host
+	DHCPUnknownMAC = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "dhcp",
+		Name:      "unknown_mac_total",
+		Help:      "Number of DHCP requests from unknown MAC addresses.",
+	}, []string{"server"})
+
+	// DNSQueries counts DNS queries by query type and response code
+	DNSQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "dns",
+		Name:      "queries_total",
+		Help:      "Number of DNS queries by query type and response code.",
+	}, []string{"qtype", "rcode"})
+
+	// TFTPBytesSent counts bytes sent over TFTP by firmware build. Kernel
+	// and initrd transfers are counted under "image".
+	TFTPBytesSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "tftp",
+		Name:      "bytes_sent_total",
+		Help:      "Number of bytes sent over TFTP by firmware build.",
+	}, []string{"firmware"})
+
+	// ProvisionDuration observes the latency of provision endpoints by route
+	ProvisionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "provision",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of provision requests by route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "code"})
+
+	// APIDuration observes the latency of API endpoints by route
+	APIDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of API requests by route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "code"})
+
+	// BMCJobDuration observes the duration of BMC jobs by job and status
+	BMCJobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "bmc",
+		Name:      "job_duration_seconds",
+		Help:      "Duration of BMC jobs across all hosts by job and status.",
+		Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600},
+	}, []string{"job", "status"})
+
+	// DBQueryDuration observes the latency of database queries by query name
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Latency of database queries by query name.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"query"})
+)
+
+// Handler returns the http handler for the /metrics endpoint
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Since observes the seconds elapsed since start on the given observer
+func Since(o prometheus.Observer, start time.Time) {
+	o.Observe(time.Since(start).Seconds())
+}
+
+// Code returns the label value for an HTTP status code
+func Code(code int) string {
+	return strconv.Itoa(code)
+}
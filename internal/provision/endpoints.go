@@ -12,8 +12,12 @@ import (
 
 const (
 	endpointPrefix             string = "boot"
+	endpointFirmware                  = "firmware"
 	endpointRepo                      = "repo"
 	endpointComplete                  = "complete"
+	endpointFail                      = "fail"
+	endpointProgress                  = "progress"
+	endpointLog                       = "log/"
 	endpointIPXE                      = "ipxe"
 	endpointKickstart                 = "kickstart"
 	endpointKernel                    = "file/kernel"
@@ -43,6 +47,12 @@ func (e *Endpoints) BootFileURL() string {
 	return fmt.Sprintf("tftp://%s/%s", e.host, e.token)
 }
 
+// FirmwareURL returns the HTTP URL of the iPXE binary for a firmware token,
+// used by UEFI HTTP Boot clients
+func (e *Endpoints) FirmwareURL(name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", e.BaseURL(), endpointFirmware, e.token, name)
+}
+
 func (e *Endpoints) RepoURL() string {
 	return fmt.Sprintf("%s/%s", e.BaseURL(), endpointRepo)
 }
@@ -69,6 +79,18 @@ func (e *Endpoints) CompleteURL() string {
 	return e.provisionURL(endpointComplete)
 }
 
+func (e *Endpoints) FailURL() string {
+	return e.provisionURL(endpointFail)
+}
+
+func (e *Endpoints) ProgressURL() string {
+	return e.provisionURL(endpointProgress)
+}
+
+func (e *Endpoints) LogURL(name string) string {
+	return e.provisionURL(endpointLog + name)
+}
+
 func (e *Endpoints) IpxeURL() string {
 	return e.provisionURL(endpointIPXE)
 }
@@ -8,32 +8,55 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
+	"net/netip"
 	"path"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
 	"time"
+	"unicode/utf8"
 
 	"github.com/labstack/echo/v4"
+	"github.com/segmentio/ksuid"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/artifact"
+	"github.com/ubccr/grendel/internal/bmc"
+	"github.com/ubccr/grendel/internal/config"
 	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/stream"
 	"github.com/ubccr/grendel/pkg/model"
 	"github.com/ubccr/grendel/pkg/netbox"
 )
 
 var netBoxClient *netbox.Client
 
+// maxMessage is the longest failure or progress message stored for a host
+const maxMessage = 1024
+
+// logNameRegexp matches the names of log files uploaded by hosts
+var logNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,127}$`)
+
 type Handler struct {
 	DB               store.Store
 	DefaultImageName string
+	Vault            *bmc.Vault
+
+	// EjectMedia ejects the virtual media of a host that completed its
+	// install, over Redfish if nil
+	EjectMedia func(host *model.Host)
 }
 
 func init() {
 	viper.SetDefault("provision.enable_prometheus_sd", false)
 	viper.SetDefault("provision.prometheus_sd_refresh_interval", "3600")
+	viper.SetDefault("provision.max_log_size", 10*1024*1024)
+	viper.SetDefault("provision.max_host_log_size", 100*1024*1024)
 	netBoxClient = netbox.NewClient()
 }
 
@@ -51,6 +74,12 @@ func NewHandler(db store.Store, defaultImageName string) (*Handler, error) {
 		}
 	}
 
+	vault, err := bmc.NewVault(db, viper.GetString("bmc.credential_key"))
+	if err != nil {
+		return nil, err
+	}
+	h.Vault = vault
+
 	return h, nil
 }
 
@@ -73,9 +102,14 @@ func (h *Handler) SetupRoutes(e *echo.Echo) {
 		e.GET("/pdu-service-discovery/:tag/:port", h.PDUServiceDiscovery).Name = "psd"
 	}
 
+	e.GET("/firmware/:token/:name", h.Firmware).Name = "firmware"
+
 	boot := e.Group("/boot/:token/")
 	boot.Use(TokenRequired)
 	boot.POST("complete", h.Complete)
+	boot.POST("fail", h.Fail)
+	boot.POST("progress", h.Progress)
+	boot.POST("log/:name", h.Log)
 	boot.GET("ipxe", h.Ipxe)
 	boot.GET("kickstart", h.Kickstart)
 	boot.GET("file/kernel*", h.File)
@@ -102,12 +136,58 @@ func (h *Handler) Index(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+// Firmware sends the iPXE binary for a firmware token to UEFI HTTP Boot
+// clients. The name only gives the URL the .efi extension some firmware
+// expects.
+func (h *Handler) Firmware(c echo.Context) error {
+	fwtype, err := model.ParseFirmwareToken(c.Param("token"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid token").SetInternal(err)
+	}
+
+	bs := fwtype.ToBytes()
+	if bs == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "unknown firmware")
+	}
+
+	log.Infof("Sending firmware %s via http to %s", fwtype, c.RealIP())
+
+	return c.Blob(http.StatusOK, "application/efi", bs)
+}
+
+// loadHost returns the host for the given boot claims. Claims without a host
+// ID belong to unknown machines booting from a dynamic DHCP pool and are
+// resolved to a discovery host using the lease for the MAC address.
+func (h *Handler) loadHost(claims *model.BootClaims) (*model.Host, error) {
+	if id, err := ksuid.Parse(claims.ID); err != nil || !id.IsNil() {
+		return h.DB.LoadHostFromID(claims.ID)
+	}
+
+	mac, err := net.ParseMAC(claims.MAC)
+	if err != nil {
+		return nil, err
+	}
+
+	lease, err := h.DB.LoadDHCPLease(mac.String())
+	if err != nil {
+		return nil, err
+	}
+
+	subnet := config.DynamicSubnet(lease.IP)
+	if subnet == nil {
+		return nil, fmt.Errorf("no dynamic subnet for lease address %s: %w", lease.IP, store.ErrNotFound)
+	}
+
+	prefix := netip.PrefixFrom(lease.IP, subnet.Gateway.Bits())
+	return model.NewDiscoveryHost(mac, prefix, config.DiscoveryImageFor(lease.IP)), nil
+}
+
 func (h *Handler) verifyClaims(c echo.Context) (*model.BootImage, *model.Host, *model.NetInterface, map[string]interface{}, error) {
 	claims := c.Get(ContextKeyToken).(*model.BootClaims)
 
 	log.Debugf("Got valid boot claims: %v", claims)
 
-	host, err := h.DB.LoadHostFromID(claims.ID)
+	host, err := h.loadHost(claims)
 	if err != nil {
 		log.WithFields(logrus.Fields{
 			"host_id": claims.ID,
@@ -181,6 +261,7 @@ func (h *Handler) Ipxe(c echo.Context) error {
 	}
 
 	log.Infof("Sending iPXE script to boot host %s with image %s", host.Name, bootImage.Name)
+	h.setProvisionState(host, model.ProvisionStateBooting, "")
 
 	commandLine := bootImage.CommandLine
 
@@ -215,11 +296,18 @@ func (h *Handler) File(c echo.Context) error {
 
 	switch {
 	case fileType == "kernel":
+		h.setProvisionState(host, model.ProvisionStateKernel, "")
+		if err := artifact.VerifyPath(bootImage.KernelPath, bootImage.KernelSHA256); err != nil {
+			return checksumError(err)
+		}
 		return c.File(bootImage.KernelPath)
 	case fileType == "kernel.sig":
 		return c.File(bootImage.KernelPath + ".sig")
 
 	case fileType == "liveimg":
+		if err := artifact.VerifyPath(bootImage.LiveImage, bootImage.LiveImageSHA256); err != nil {
+			return checksumError(err)
+		}
 		return c.File(bootImage.LiveImage)
 
 	case strings.HasPrefix(fileType, "initrd-"):
@@ -230,7 +318,12 @@ func (h *Handler) File(c echo.Context) error {
 		}
 		initrd := bootImage.InitrdPaths[i]
 		if strings.HasSuffix(fileType, ".sig") {
-			initrd += ".sig"
+			return c.File(initrd + ".sig")
+		}
+		if i < len(bootImage.InitrdSHA256) {
+			if err := artifact.VerifyPath(initrd, bootImage.InitrdSHA256[i]); err != nil {
+				return checksumError(err)
+			}
 		}
 		return c.File(initrd)
 	}
@@ -238,17 +331,23 @@ func (h *Handler) File(c echo.Context) error {
 	return echo.NewHTTPError(http.StatusNotFound, "")
 }
 
+func checksumError(err error) error {
+	return echo.NewHTTPError(http.StatusInternalServerError, "file failed checksum verification").SetInternal(err)
+}
+
 func (h *Handler) serveBlob(c echo.Context, name string, data []byte) error {
 	http.ServeContent(c.Response(), c.Request(), name, time.Time{}, bytes.NewReader(data))
 	return nil
 }
 
 func (h *Handler) Kickstart(c echo.Context) error {
-	bootImage, _, _, data, err := h.verifyClaims(c)
+	bootImage, host, _, data, err := h.verifyClaims(c)
 	if err != nil {
 		return err
 	}
 
+	h.setProvisionState(host, model.ProvisionStateInstalling, "kickstart")
+
 	tmplName, ok := bootImage.ProvisionTemplates["kickstart"]
 	if !ok {
 		tmplName = "kickstart.tmpl"
@@ -276,12 +375,272 @@ func (h *Handler) Complete(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to unprovision host").SetInternal(err)
 	}
 
+	h.setProvisionState(host, model.ProvisionStateComplete, "")
+
+	if _, err := h.DB.LoadBmcMedia(host.Name); err == nil {
+		eject := h.EjectMedia
+		if eject == nil {
+			eject = h.ejectMedia
+		}
+		go eject(host)
+	}
+
 	resp := map[string]interface{}{
 		"status": "ok",
 	}
 	return c.JSON(http.StatusOK, resp)
 }
 
+// Fail records that provisioning the host failed. The host is left set to
+// provision so the install can be retried. An optional message describing the
+// failure can be sent in the message form value.
+func (h *Handler) Fail(c echo.Context) error {
+	_, host, _, _, err := h.verifyClaims(c)
+	if err != nil {
+		return err
+	}
+
+	message := sanitizeMessage(c.FormValue("message"))
+
+	log.WithFields(logrus.Fields{
+		"name":    host.Name,
+		"message": message,
+	}).Warn("Provisioning host failed")
+
+	h.setProvisionState(host, model.ProvisionStateFailed, message)
+
+	resp := map[string]interface{}{
+		"status": "ok",
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// claimsHost returns the stored host of the boot claims. Unlike verifyClaims
+// the host does not have to be set to provision, so hosts can still send
+// progress and logs after calling complete.
+func (h *Handler) claimsHost(c echo.Context) (*model.Host, error) {
+	claims := c.Get(ContextKeyToken).(*model.BootClaims)
+
+	host, err := h.loadHost(claims)
+	if err != nil || host.IsDiscovery() {
+		log.WithFields(logrus.Fields{
+			"host_id": claims.ID,
+			"mac":     claims.MAC,
+		}).Error("failed to find host")
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid host").SetInternal(err)
+	}
+
+	return host, nil
+}
+
+// Progress stores a progress message sent by a host while it installs. The
+// message is sent in the message form value. Other form values, such as those
+// posted by cloud-init phone_home, are stored as key=value pairs.
+func (h *Handler) Progress(c echo.Context) error {
+	host, err := h.claimsHost(c)
+	if err != nil {
+		return err
+	}
+
+	message := c.FormValue("message")
+	if message == "" {
+		params, err := c.FormParams()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid form").SetInternal(err)
+		}
+
+		keys := make([]string, 0, len(params))
+		for k := range params {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		pairs := make([]string, 0, len(keys))
+		for _, k := range keys {
+			pairs = append(pairs, k+"="+strings.Join(params[k], ","))
+		}
+		message = strings.Join(pairs, " ")
+	}
+
+	if message == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "missing message")
+	}
+	message = sanitizeMessage(message)
+
+	if err := h.checkLogQuota(host, len(message)); err != nil {
+		return err
+	}
+
+	log.Infof("Host %s install progress: %s", host.Name, message)
+
+	err = h.DB.StoreProvisionLog(&model.ProvisionLog{
+		Host:    host.Name,
+		Kind:    model.ProvisionLogProgress,
+		Content: message,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to store progress").SetInternal(err)
+	}
+
+	resp := map[string]interface{}{
+		"status": "ok",
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Log stores a log file uploaded by a host while it installs. The file is sent
+// as the request body or as the file field of a multipart form, and is limited
+// to provision.max_log_size bytes. It replaces an earlier upload of the same
+// name.
+func (h *Handler) Log(c echo.Context) error {
+	host, err := h.claimsHost(c)
+	if err != nil {
+		return err
+	}
+
+	name := c.Param("name")
+	if !logNameRegexp.MatchString(name) {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid log name")
+	}
+
+	maxSize := viper.GetInt64("provision.max_log_size")
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, maxSize)
+
+	var body io.Reader = c.Request().Body
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		fh, err := c.FormFile("file")
+		if err != nil {
+			return logUploadError(err)
+		}
+		f, err := fh.Open()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "invalid log file").SetInternal(err)
+		}
+		defer f.Close()
+		body = f
+	}
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return logUploadError(err)
+	}
+
+	text := sanitizeLog(string(content))
+	if err := h.checkLogQuota(host, len(text)); err != nil {
+		return err
+	}
+
+	log.Infof("Host %s uploaded log %s (%d bytes)", host.Name, name, len(content))
+
+	err = h.DB.StoreProvisionLog(&model.ProvisionLog{
+		Host:    host.Name,
+		Kind:    model.ProvisionLogFile,
+		Name:    name,
+		Content: text,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to store log").SetInternal(err)
+	}
+
+	resp := map[string]interface{}{
+		"status": "ok",
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// checkLogQuota refuses progress messages and log files that would take the
+// logs stored for a host over provision.max_host_log_size
+func (h *Handler) checkLogQuota(host *model.Host, size int) error {
+	used, err := h.DB.ProvisionLogSize(host.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get log size").SetInternal(err)
+	}
+
+	if used+int64(size) > viper.GetInt64("provision.max_host_log_size") {
+		log.Warnf("Host %s exceeded its install log quota", host.Name)
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "install log quota exceeded")
+	}
+
+	return nil
+}
+
+// sanitizeLog removes NUL bytes and invalid UTF-8 from content sent by a host,
+// which the text columns of the data store cannot hold
+func sanitizeLog(s string) string {
+	return strings.ToValidUTF8(strings.ReplaceAll(s, "\x00", ""), "\uFFFD")
+}
+
+// sanitizeMessage sanitizes a failure or progress message and cuts it to
+// maxMessage bytes without splitting a rune
+func sanitizeMessage(s string) string {
+	s = sanitizeLog(s)
+	if len(s) <= maxMessage {
+		return s
+	}
+
+	n := maxMessage
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n]
+}
+
+func logUploadError(err error) error {
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "log too large").SetInternal(err)
+	}
+
+	return echo.NewHTTPError(http.StatusBadRequest, "invalid log file").SetInternal(err)
+}
+
+// setProvisionState records the provision state of a host and sends it on the
+// event stream. Discovery hosts are not stored so only their state is sent.
+func (h *Handler) setProvisionState(host *model.Host, state, message string) {
+	stream.PublishProvision(host.Name, state)
+
+	if host.IsDiscovery() {
+		return
+	}
+
+	err := h.DB.StoreProvisionEvent(&model.ProvisionEvent{
+		Host:    host.Name,
+		State:   state,
+		Message: message,
+	})
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"name":  host.Name,
+			"state": state,
+		}).Errorf("failed to record provision state: %s", err)
+	}
+}
+
+// ejectMedia ejects the virtual media inserted by grendel bmc media insert
+// once the host has installed from it
+func (h *Handler) ejectMedia(host *model.Host) {
+	job := bmc.NewJob()
+	job.Vault = h.Vault
+
+	output, err := job.EjectMedia(model.HostList{host})
+	if err != nil {
+		log.Errorf("Failed to eject virtual media of %s: %s", host.Name, err)
+		return
+	}
+
+	for _, m := range output {
+		if m.Status != "success" {
+			log.Errorf("Failed to eject virtual media of %s: %s", m.Host, m.Msg)
+			continue
+		}
+		log.Infof("Ejected virtual media of %s", m.Host)
+		if err := h.DB.DeleteBmcMedia(m.Host); err != nil && !errors.Is(err, store.ErrNotFound) {
+			log.Errorf("Failed to delete the virtual media record of %s: %s", m.Host, err)
+		}
+	}
+}
+
 func (h *Handler) UserData(c echo.Context) error {
 	bootImage, host, _, data, err := h.verifyClaims(c)
 	if err != nil {
@@ -294,6 +653,7 @@ func (h *Handler) UserData(c echo.Context) error {
 	}
 
 	log.Infof("Sending cloud-init user-data to host %s", host.Name)
+	h.setProvisionState(host, model.ProvisionStateInstalling, "cloud-init")
 	c.Response().Header().Set(echo.HeaderContentType, "application/yaml; charset=utf-8")
 	return c.Render(http.StatusOK, tmplName, data)
 }
@@ -326,6 +686,7 @@ func (h *Handler) Ignition(c echo.Context) error {
 	}
 
 	log.Infof("Sending ignition config to host %s", host.Name)
+	h.setProvisionState(host, model.ProvisionStateInstalling, "ignition")
 	renderer := c.Echo().Renderer.(*TemplateRenderer)
 	return renderer.RenderIgnition(http.StatusOK, tmplName, data, c)
 }
@@ -8,11 +8,16 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/tidwall/gjson"
+	"github.com/ubccr/grendel/internal/firmware"
 	"github.com/ubccr/grendel/internal/store"
 	"github.com/ubccr/grendel/internal/store/sqlstore"
 	"github.com/ubccr/grendel/internal/tests"
@@ -246,7 +251,13 @@ func TestKickstart(t *testing.T) {
 func TestComplete(t *testing.T) {
 	assert := assert.New(t)
 
-	h := &Handler{DB: newTestDB(t)}
+	ejected := make(chan string, 1)
+	h := &Handler{
+		DB: newTestDB(t),
+		EjectMedia: func(host *model.Host) {
+			ejected <- host.Name
+		},
+	}
 
 	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
 	err := h.DB.StoreBootImage(image)
@@ -258,6 +269,10 @@ func TestComplete(t *testing.T) {
 	err = h.DB.StoreHost(host)
 	assert.NoError(err)
 
+	// Media inserted by grendel bmc media insert is ejected on complete
+	err = h.DB.StoreBmcMedia(&model.BmcMedia{Host: host.Name, Image: "http://10.0.0.1/repo/rescue.iso"})
+	assert.NoError(err)
+
 	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
 	assert.NoError(err)
 
@@ -278,6 +293,73 @@ func TestComplete(t *testing.T) {
 	if assert.NoError(err) {
 		assert.False(hostTest.Provision)
 	}
+
+	select {
+	case name := <-ejected:
+		assert.Equal(host.Name, name)
+	case <-time.After(5 * time.Second):
+		assert.Fail("virtual media not ejected")
+	}
+
+	events, err := h.DB.ProvisionEvents(host.Name)
+	if assert.NoError(err) && assert.Len(events, 1) {
+		assert.Equal(model.ProvisionStateComplete, events[0].State)
+	}
+}
+
+func TestFail(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	err := h.DB.StoreBootImage(image)
+	assert.NoError(err)
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = image.Name
+	host.Provision = true
+	err = h.DB.StoreHost(host)
+	assert.NoError(err)
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("message=no+disks+found"))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/boot/:token/fail")
+	c.SetParamNames("token")
+	c.SetParamValues(token)
+
+	if assert.NoError(TokenRequired(h.Fail)(c)) {
+		assert.Equal(http.StatusOK, rec.Code)
+		assert.Equal("ok", gjson.Get(rec.Body.String(), "status").String())
+	}
+
+	hostTest, err := h.DB.LoadHostFromID(host.UID.String())
+	if assert.NoError(err) {
+		assert.True(hostTest.Provision)
+	}
+
+	events, err := h.DB.ProvisionEvents(host.Name)
+	if assert.NoError(err) && assert.Len(events, 1) {
+		assert.Equal(model.ProvisionStateFailed, events[0].State)
+		assert.Equal("no disks found", events[0].Message)
+	}
+}
+
+func TestSanitizeMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("no disks\uFFFD found", sanitizeMessage("no\x00 disks\xff found"))
+
+	// Long messages are cut without splitting a rune
+	long := sanitizeMessage("x" + strings.Repeat("é", maxMessage))
+	assert.True(utf8.ValidString(long))
+	assert.Len(long, maxMessage-1)
 }
 
 func TestUserData(t *testing.T) {
@@ -345,3 +427,130 @@ func TestMetaData(t *testing.T) {
 		assert.Contains(rec.Body.String(), host.UID.String())
 	}
 }
+
+func TestProgress(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Provision = false
+	err := h.DB.StoreHost(host)
+	assert.NoError(err)
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	for _, body := range []string{"message=partitioning+disks", "instance_id=i-123&hostname=tux01"} {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/boot/:token/progress")
+		c.SetParamNames("token")
+		c.SetParamValues(token)
+
+		if assert.NoError(TokenRequired(h.Progress)(c)) {
+			assert.Equal(http.StatusOK, rec.Code)
+		}
+	}
+
+	logs, err := h.DB.ProvisionLogs(host.Name)
+	if assert.NoError(err) && assert.Len(logs, 2) {
+		assert.Equal(model.ProvisionLogProgress, logs[0].Kind)
+		assert.Equal("partitioning disks", logs[0].Content)
+		assert.Equal("hostname=tux01 instance_id=i-123", logs[1].Content)
+	}
+}
+
+func TestLog(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	err := h.DB.StoreHost(host)
+	assert.NoError(err)
+
+	token, err := model.NewBootToken(host.UID.String(), host.Interfaces[0].MAC.String())
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	upload := func(name, body string) error {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/boot/:token/log/:name")
+		c.SetParamNames("token", "name")
+		c.SetParamValues(token, name)
+
+		return TokenRequired(h.Log)(c)
+	}
+
+	assert.NoError(upload("anaconda.log", "INFO anaconda: partial"))
+	assert.NoError(upload("anaconda.log", "INFO anaconda: starting"))
+	assert.NoError(upload("dmesg.log", "boot\x00ok\xff"))
+
+	err = upload("../etc/passwd", "x")
+	if assert.Error(err) {
+		assert.Equal(http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	}
+
+	viper.Set("provision.max_host_log_size", 40)
+	err = upload("storage.log", "exceeds the host quota")
+	viper.Set("provision.max_host_log_size", 100*1024*1024)
+	if assert.Error(err) {
+		assert.Equal(http.StatusRequestEntityTooLarge, err.(*echo.HTTPError).Code)
+	}
+
+	viper.Set("provision.max_log_size", 4)
+	defer viper.Set("provision.max_log_size", 10*1024*1024)
+
+	err = upload("storage.log", "too large")
+	if assert.Error(err) {
+		assert.Equal(http.StatusRequestEntityTooLarge, err.(*echo.HTTPError).Code)
+	}
+
+	logs, err := h.DB.ProvisionLogs(host.Name)
+	if assert.NoError(err) && assert.Len(logs, 2) {
+		assert.Equal(model.ProvisionLogFile, logs[0].Kind)
+		assert.Equal("anaconda.log", logs[0].Name)
+		assert.Equal("INFO anaconda: starting", logs[0].Content)
+		assert.Equal("bootok\uFFFD", logs[1].Content)
+	}
+}
+
+func TestFirmware(t *testing.T) {
+	assert := assert.New(t)
+
+	h := &Handler{DB: newTestDB(t)}
+
+	token, err := model.NewFirmwareToken("00:11:22:33:44:55", firmware.SNPONLYx86_64)
+	assert.NoError(err)
+
+	e := newTestEcho(t)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/firmware/:token/:name")
+	c.SetParamNames("token", "name")
+	c.SetParamValues(token, firmware.SNPONLYx86_64.String())
+
+	if assert.NoError(h.Firmware(c)) {
+		assert.Equal(http.StatusOK, rec.Code)
+		assert.Equal(firmware.SNPONLYx86_64.ToBytes(), rec.Body.Bytes())
+	}
+
+	c = e.NewContext(req, httptest.NewRecorder())
+	c.SetParamNames("token", "name")
+	c.SetParamValues("invalid", firmware.SNPONLYx86_64.String())
+
+	err = h.Firmware(c)
+	if assert.Error(err) {
+		he, ok := err.(*echo.HTTPError)
+		if assert.True(ok) {
+			assert.Equal(http.StatusBadRequest, he.Code)
+		}
+	}
+}
@@ -6,8 +6,10 @@ package provision
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/ubccr/grendel/internal/metrics"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
@@ -28,3 +30,27 @@ func TokenRequired(next echo.HandlerFunc) echo.HandlerFunc {
 		return next(c)
 	}
 }
+
+// Metrics observes request latency by route
+func Metrics(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+		err := next(c)
+
+		code := c.Response().Status
+		if he, ok := err.(*echo.HTTPError); ok {
+			code = he.Code
+		} else if err != nil {
+			code = http.StatusInternalServerError
+		}
+
+		route := c.Path()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		metrics.Since(metrics.ProvisionDuration.WithLabelValues(route, c.Request().Method, metrics.Code(code)), start)
+
+		return err
+	}
+}
@@ -90,6 +90,7 @@ func newEcho() (*echo.Echo, error) {
 	e.HTTPErrorHandler = HTTPErrorHandler
 	e.HideBanner = true
 	e.Use(middleware.Recover())
+	e.Use(Metrics)
 	e.Logger = EchoLogger()
 
 	renderer, err := NewTemplateRenderer()
@@ -10,6 +10,8 @@ import (
 	"fmt"
 
 	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/pgx/v5"
 	"github.com/golang-migrate/migrate/v4/database/sqlite"
 	"github.com/golang-migrate/migrate/v4/source"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
@@ -18,23 +20,42 @@ import (
 //go:embed sql/*.sql
 var sqlMigrations embed.FS
 
+// PostgreSQL migrations use the same version numbers as the sqlite migrations
+//
+//go:embed postgres/*.sql
+var postgresMigrations embed.FS
+
 type Migrator struct {
 	mg     *migrate.Migrate
 	source source.Driver
 }
 
 func New(db *sql.DB) (*Migrator, error) {
-	source, err := iofs.New(sqlMigrations, "sql")
+	dbi, err := sqlite.WithInstance(db, new(sqlite.Config))
 	if err != nil {
-		return nil, fmt.Errorf("Failed to find sql migration sources: %w", err)
+		return nil, fmt.Errorf("Invalid sqlite db instance: %w", err)
 	}
 
-	dbi, err := sqlite.WithInstance(db, new(sqlite.Config))
+	return newMigrator(sqlMigrations, "sql", "sqlite", dbi)
+}
+
+// NewPostgres returns a Migrator for a PostgreSQL database
+func NewPostgres(db *sql.DB) (*Migrator, error) {
+	dbi, err := pgx.WithInstance(db, new(pgx.Config))
 	if err != nil {
-		return nil, fmt.Errorf("Invalid sqlite db instance: %w", err)
+		return nil, fmt.Errorf("Invalid postgres db instance: %w", err)
+	}
+
+	return newMigrator(postgresMigrations, "postgres", "postgres", dbi)
+}
+
+func newMigrator(fsys embed.FS, path, name string, dbi database.Driver) (*Migrator, error) {
+	source, err := iofs.New(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to find sql migration sources: %w", err)
 	}
 
-	mg, err := migrate.NewWithInstance("iofs", source, "sqlite", dbi)
+	mg, err := migrate.NewWithInstance("iofs", source, name, dbi)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to create migrate instance: %w", err)
 	}
@@ -0,0 +1,27 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+drop view if exists node_view;
+drop view if exists kernel_view;
+drop view if exists user_view;
+drop view if exists role_view;
+drop table if exists dhcp_event;
+drop table if exists dhcp_lease;
+drop table if exists "user";
+drop table if exists role_permission;
+drop table if exists permission;
+drop table if exists role;
+drop table if exists nic;
+drop table if exists node_tag;
+drop table if exists node;
+drop table if exists kernel_template;
+drop table if exists template;
+drop table if exists initrd;
+drop table if exists kernel;
+drop table if exists tag;
+drop table if exists arch;
+drop table if exists template_type;
+drop table if exists node_type;
+drop function if exists instr(text, text);
+drop function if exists set_id();
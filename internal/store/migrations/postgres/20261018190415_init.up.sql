@@ -0,0 +1,480 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+-- Initial PostgreSQL schema. This matches the sqlite schema as of the
+-- add-dhcp-event migration, later migrations are kept in sync with the sqlite
+-- migrations using the same version numbers.
+
+-- Upserts pass an explicit null id for new rows, assign one from the sequence
+-- and keep the sequence ahead of any explicitly given id
+create function set_id() returns trigger as $$
+declare
+  seq regclass := pg_get_serial_sequence(quote_ident(tg_table_schema) || '.' || quote_ident(tg_table_name), 'id')::regclass;
+begin
+  if new.id is null then
+    new.id := nextval(seq);
+  elsif new.id > coalesce(pg_sequence_last_value(seq), 0) then
+    perform setval(seq, new.id);
+  end if;
+  return new;
+end;
+$$ language plpgsql;
+
+-- sqlite compatible instr used by the shared queries
+create function instr(str text, substr text) returns integer as $$
+  select strpos(str, substr);
+$$ language sql immutable;
+
+create table node_type (
+  id         bigserial primary key,
+  name       text    not null
+);
+
+create table template_type (
+  id         bigserial primary key,
+  name       text    not null unique,
+  uri_name   text    not null unique
+);
+
+create table arch (
+  id         bigserial primary key,
+  name       text    not null
+);
+
+create table tag (
+  id         bigserial primary key,
+  key        text    not null unique
+);
+
+create table kernel (
+  id            bigserial primary key,
+  uid           text not null unique,
+  name          text not null unique,
+  version       text not null,
+  path          text not null,
+  arch_id       bigint,
+  command_line  text,
+  verify        boolean default false not null,
+  created_at    timestamptz default current_timestamp not null,
+  updated_at    timestamptz default current_timestamp not null
+);
+
+create trigger kernel_set_id before insert on kernel
+  for each row execute function set_id();
+
+create table initrd (
+  id            bigserial primary key,
+  kernel_id     bigint not null,
+  path          text not null,
+  created_at    timestamptz default current_timestamp not null,
+  updated_at    timestamptz default current_timestamp not null,
+  foreign key (kernel_id) references kernel(id) on delete cascade,
+  unique(kernel_id, path)
+);
+
+create index initrd_kernel_id_idx on initrd(kernel_id);
+
+create table template (
+  id                bigserial primary key,
+  template_type_id  bigint not null,
+  name              text not null unique,
+  created_at        timestamptz default current_timestamp not null,
+  updated_at        timestamptz default current_timestamp not null,
+  foreign key (template_type_id) references template_type(id)
+);
+
+create table kernel_template (
+  kernel_id     bigint not null,
+  template_id   bigint not null,
+  foreign key (kernel_id)     references kernel(id) on delete cascade,
+  foreign key (template_id)  references template(id) on delete cascade,
+  primary key (kernel_id, template_id)
+);
+
+create table node (
+  id            bigserial primary key,
+  uid           text    not null unique,
+  name          text    not null unique,
+  provision     boolean default false not null,
+  arch_id       bigint,
+  kernel_id     bigint,
+  node_type_id  bigint,
+  firmware      text,
+  created_at    timestamptz default current_timestamp not null,
+  updated_at    timestamptz default current_timestamp not null,
+  foreign key (node_type_id) references node_type(id),
+  foreign key (arch_id)      references arch(id),
+  foreign key (kernel_id)    references kernel(id)
+);
+
+create trigger node_set_id before insert on node
+  for each row execute function set_id();
+
+create index node_kernel_id_idx on node(kernel_id);
+
+create table node_tag (
+  id         bigserial primary key,
+  tag_id     bigint  not null,
+  node_id    bigint  not null,
+  value      text    not null,
+  foreign key (tag_id)  references tag(id) on delete cascade,
+  foreign key (node_id) references node(id) on delete cascade,
+  unique (tag_id, node_id, value)
+);
+
+create index node_tag_node_id_idx on node_tag(node_id);
+
+create table nic (
+  id            bigserial primary key,
+  node_id       bigint  not null,
+  nic_type      text    not null,
+  name          text,
+  vlan          text,
+  fqdn          text,
+  mac           text,
+  ip            text,
+  peers         text,
+  mtu           bigint,
+  ip6           text,
+  foreign key (node_id)     references node(id) on delete cascade
+);
+
+create trigger nic_set_id before insert on nic
+  for each row execute function set_id();
+
+create index nic_node_id_idx on nic(node_id);
+
+create table role (
+  id            bigserial primary key,
+  name          text    not null unique
+);
+
+create table permission (
+  id      bigserial primary key,
+  method  text    not null,
+  path    text    not null
+);
+
+create table role_permission (
+  role_id       bigint not null,
+  permission_id bigint not null,
+  foreign key (role_id) references role(id) on delete cascade,
+  foreign key (permission_id) references permission(id) on delete cascade,
+  unique (role_id, permission_id)
+);
+
+create table "user" (
+  id            bigserial primary key,
+  username      text    not null unique,
+  role_id       bigint  not null,
+  password_hash text    not null,
+  enabled       boolean default false not null,
+  created_at    timestamptz default current_timestamp not null,
+  updated_at    timestamptz default current_timestamp not null,
+  foreign key (role_id) references role(id) on delete restrict
+);
+
+create table dhcp_lease (
+  id            bigserial primary key,
+  mac           text not null unique,
+  ip            text not null unique,
+  expires_at    timestamptz not null,
+  created_at    timestamptz default current_timestamp not null,
+  updated_at    timestamptz default current_timestamp not null
+);
+
+create table dhcp_event (
+  id            bigserial primary key,
+  message_type  text not null,
+  mac           text not null,
+  host          text not null default '',
+  ip            text not null default '',
+  relay_ip      text not null default '',
+  firmware      text not null default '',
+  created_at    timestamptz default current_timestamp not null
+);
+
+create index dhcp_event_host_idx on dhcp_event (host, created_at);
+
+-- The json columns of the views are returned as text so they scan the same as
+-- the sqlite json functions
+create view role_view as
+  select
+  role.name,
+  json_build_object(
+    'name', role.name,
+    'permission_list', (
+      select coalesce(json_agg(
+        json_build_object(
+        'method', permission.method,
+        'path', permission.path
+        ) order by permission.id
+      ), '[]'::json)
+      from role_permission
+      inner join permission
+      on role_permission.permission_id = permission.id
+      where role_permission.role_id = role.id
+    ),
+    'unassigned_permission_list', (
+      select coalesce(json_agg(
+        json_build_object(
+        'method', permission.method,
+        'path', permission.path
+        ) order by permission.id
+      ), '[]'::json)
+      from permission
+      where not exists
+      (
+        select * from role_permission
+        where role_permission.role_id = role.id
+        and role_permission.permission_id = permission.id
+      )
+    )
+  )::text as permission_json
+  from
+    role;
+
+create view user_view as
+select
+"user".username,
+"user".id,
+"user".password_hash,
+role.name as role,
+"user".enabled,
+"user".created_at,
+"user".updated_at
+from "user"
+inner join role
+on role.id = "user".role_id;
+
+create view kernel_view as
+select
+  k.id,
+  k.name,
+  json_build_object(
+    'id', k.id,
+    'uid', k.uid,
+    'name', k.name,
+    'kernel', k.path,
+    'cmdline', k.command_line,
+    'verify', k.verify,
+    'initrd', (
+      select coalesce(json_agg(
+         rd.path order by rd.id
+       ), '[]'::json)
+       from initrd as rd
+       where rd.kernel_id = k.id
+    ),
+    'provision_templates', (
+      select coalesce(json_object_agg(tt.uri_name, t.name), '{}'::json)
+      from kernel_template as kt
+      join template t
+         on kt.template_id = t.id
+      join template_type tt
+         on t.template_type_id = tt.id
+      where kt.kernel_id = k.id
+    )
+  )::text as image_json
+from
+    kernel as k;
+
+create view node_view as
+select
+  n.id,
+  n.name,
+  n.uid,
+  json_build_object(
+    'id', n.id,
+    'uid', n.uid,
+    'name', n.name,
+    'provision', n.provision,
+    'boot_image', k.name,
+    'firmware', n.firmware,
+    'tags',
+      (select coalesce(json_agg(concat_ws(':',t.key,nt.value) order by nt.id), '[]'::json)
+       from node_tag as nt
+       join tag as t
+         on nt.tag_id = t.id
+       where nt.node_id = n.id
+      ),
+    'interfaces', (
+      select coalesce(json_agg(
+         json_build_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'mtu', nc.mtu,
+           'bmc', nc.nic_type = 'bmc',
+           'ip', nc.ip,
+           'ip6', nc.ip6
+         ) order by nc.id), '[]'::json)
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type != 'bond'
+    ),
+    'bonds', (
+      select coalesce(json_agg(
+         json_build_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'peers', nc.peers::json,
+           'mtu', nc.mtu,
+           'bmc', nc.nic_type = 'bmc',
+           'ip', nc.ip,
+           'ip6', nc.ip6
+         ) order by nc.id), '[]'::json)
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type = 'bond'
+    )
+  )::text as host_json
+from
+    node as n
+left join kernel as k
+on n.kernel_id = k.id;
+
+insert into arch(name) values
+  ('x86_64'),
+  ('aarch64');
+
+insert into role(name) values
+  ('admin'),
+  ('user'),
+  ('read-only');
+
+insert into permission(method, path) values
+  ('GET', '/v1/db/dump'),
+  ('GET', '/v1/users'),
+  ('GET', '/v1/grendel/events'),
+  ('GET', '/v1/bmc'),
+  ('GET', '/v1/nodes/token/%'),
+  ('GET', '/v1/nodes/find'),
+  ('GET', '/v1/bmc/jobs'),
+  ('GET', '/v1/bmc/metrics'),
+  ('GET', '/v1/nodes'),
+  ('GET', '/v1/images'),
+  ('GET', '/v1/images/find'),
+  ('GET', '/v1/roles'),
+  ('PATCH', '/v1/nodes/provision'),
+  ('PATCH', '/v1/nodes/tags/%'),
+  ('PATCH', '/v1/nodes/image'),
+  ('PATCH', '/v1/users/%/role'),
+  ('PATCH', '/v1/users/%/enable'),
+  ('PATCH', '/v1/roles'),
+  ('PATCH', '/v1/auth/reset'),
+  ('POST', '/v1/users'),
+  ('POST', '/v1/bmc/power/os'),
+  ('POST', '/v1/bmc/power/bmc'),
+  ('POST', '/v1/bmc/configure/auto'),
+  ('POST', '/v1/db/restore'),
+  ('POST', '/v1/nodes'),
+  ('POST', '/v1/images'),
+  ('POST', '/v1/auth/token'),
+  ('POST', '/v1/bmc/configure/import'),
+  ('POST', '/v1/roles'),
+  ('DELETE', '/v1/bmc/jobs/%'),
+  ('DELETE', '/v1/users/%'),
+  ('DELETE', '/v1/bmc/sel'),
+  ('DELETE', '/v1/nodes'),
+  ('DELETE', '/v1/images'),
+  ('DELETE', '/v1/roles/%'),
+  ('GET', '/v1/switch/%/hosttree'),
+  ('GET', '/v1/switch/%/lldp'),
+  ('GET', '/v1/bmc/upgrade/dell/repo'),
+  ('POST', '/v1/bmc/upgrade/dell/installfromrepo'),
+  ('DELETE', '/v1/bmc/jobs'),
+  ('GET', '/v1/dhcp/leases'),
+  ('GET', '/v1/nodes/history/%');
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'user'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/grendel/events'),
+        ('GET', '/v1/bmc'),
+        ('GET', '/v1/nodes/find'),
+        ('GET', '/v1/bmc/jobs'),
+        ('GET', '/v1/bmc/metrics'),
+        ('GET', '/v1/nodes'),
+        ('GET', '/v1/images'),
+        ('GET', '/v1/images/find'),
+        ('PATCH', '/v1/nodes/provision'),
+        ('PATCH', '/v1/nodes/image'),
+        ('PATCH', '/v1/auth/reset'),
+        ('POST', '/v1/users'),
+        ('POST', '/v1/bmc/power/os'),
+        ('POST', '/v1/bmc/power/bmc'),
+        ('POST', '/v1/bmc/configure/auto'),
+        ('POST', '/v1/nodes'),
+        ('POST', '/v1/images'),
+        ('POST', '/v1/auth/token'),
+        ('POST', '/v1/bmc/configure/import'),
+        ('DELETE', '/v1/bmc/sel'),
+        ('DELETE', '/v1/nodes'),
+        ('DELETE', '/v1/images'),
+        ('GET', '/v1/switch/%/hosttree'),
+        ('GET', '/v1/switch/%/lldp'),
+        ('GET', '/v1/bmc/upgrade/dell/repo'),
+        ('POST', '/v1/bmc/upgrade/dell/installfromrepo'),
+        ('DELETE', '/v1/bmc/jobs'),
+        ('GET', '/v1/dhcp/leases'),
+        ('GET', '/v1/nodes/history/%')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'read-only'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/grendel/events'),
+        ('GET', '/v1/bmc'),
+        ('GET', '/v1/nodes/find'),
+        ('GET', '/v1/bmc/jobs'),
+        ('GET', '/v1/bmc/metrics'),
+        ('GET', '/v1/nodes'),
+        ('GET', '/v1/images'),
+        ('GET', '/v1/images/find'),
+        ('PATCH', '/v1/auth/reset'),
+        ('POST', '/v1/auth/token'),
+        ('GET', '/v1/dhcp/leases'),
+        ('GET', '/v1/nodes/history/%')
+      )
+  ) permission
+;
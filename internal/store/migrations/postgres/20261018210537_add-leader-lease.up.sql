@@ -0,0 +1,32 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table leader_lease (
+  name          text primary key,
+  holder        text not null,
+  acquired_at   timestamptz not null,
+  expires_at    timestamptz not null,
+  updated_at    timestamptz not null
+);
+
+insert into permission(method, path) values
+  ('GET', '/v1/grendel/status');
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/grendel/status')
+      )
+  ) permission
+;
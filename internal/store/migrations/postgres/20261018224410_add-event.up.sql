@@ -0,0 +1,24 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table event (
+  id            bigserial primary key,
+  severity      text not null,
+  user_name     text not null default '',
+  message       text not null,
+  job_messages  text not null default '[]',
+  created_at    timestamptz default current_timestamp not null
+);
+
+create index event_created_at_idx on event (created_at);
+create index event_user_name_idx on event (user_name, created_at);
+
+create table event_host (
+  event_id  bigint not null,
+  host      text not null,
+  primary key (event_id, host),
+  foreign key (event_id) references event (id) on delete cascade
+);
+
+create index event_host_host_idx on event_host (host);
@@ -0,0 +1,33 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from role_permission where permission_id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/bmc/credentials'),
+    ('POST', '/v1/bmc/credentials'),
+    ('DELETE', '/v1/bmc/credentials/%/%'),
+    ('POST', '/v1/bmc/credentials/rotate')
+  )
+)
+;
+
+delete from permission where id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/bmc/credentials'),
+    ('POST', '/v1/bmc/credentials'),
+    ('DELETE', '/v1/bmc/credentials/%/%'),
+    ('POST', '/v1/bmc/credentials/rotate')
+  )
+)
+;
+
+drop table if exists bmc_credential;
@@ -0,0 +1,39 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table bmc_credential (
+  scope       text not null,
+  name        text not null,
+  username    text not null,
+  password    text not null,
+  updated_at  timestamptz default current_timestamp not null,
+  primary key (scope, name)
+);
+
+insert into permission(method, path) values
+  ('GET', '/v1/bmc/credentials'),
+  ('POST', '/v1/bmc/credentials'),
+  ('DELETE', '/v1/bmc/credentials/%/%'), -- :scope/:name
+  ('POST', '/v1/bmc/credentials/rotate');
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/bmc/credentials'),
+        ('POST', '/v1/bmc/credentials'),
+        ('DELETE', '/v1/bmc/credentials/%/%'),
+        ('POST', '/v1/bmc/credentials/rotate')
+      )
+  ) permission
+;
@@ -0,0 +1,27 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from role_permission where permission_id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('POST', '/v1/bmc/media'),
+    ('DELETE', '/v1/bmc/media')
+  )
+)
+;
+
+delete from permission where id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('POST', '/v1/bmc/media'),
+    ('DELETE', '/v1/bmc/media')
+  )
+)
+;
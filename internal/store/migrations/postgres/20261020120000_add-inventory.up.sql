@@ -0,0 +1,74 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table inventory (
+  host          text not null,
+  kind          text not null,
+  slot          text not null,
+  name          text not null default '',
+  manufacturer  text not null default '',
+  model         text not null default '',
+  serial        text not null default '',
+  part_number   text not null default '',
+  version       text not null default '',
+  capacity      bigint not null default 0,
+  address       text not null default '',
+  collected_at  timestamptz default current_timestamp not null,
+  primary key (host, kind, slot)
+);
+
+create table inventory_change (
+  id            bigserial primary key,
+  host          text not null,
+  kind          text not null,
+  slot          text not null,
+  change        text not null,
+  old           text not null default '',
+  new           text not null default '',
+  detected_at   timestamptz default current_timestamp not null
+);
+
+create index inventory_change_host_idx on inventory_change (host, detected_at);
+
+insert into permission(method, path) values
+  ('GET', '/v1/nodes/inventory'),
+  ('GET', '/v1/nodes/inventory/changes'),
+  ('POST', '/v1/bmc/inventory');
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/nodes/inventory'),
+        ('GET', '/v1/nodes/inventory/changes')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('POST', '/v1/bmc/inventory')
+      )
+  ) permission
+;
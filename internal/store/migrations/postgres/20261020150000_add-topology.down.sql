@@ -0,0 +1,34 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from role_permission where permission_id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/topology'),
+    ('GET', '/v1/topology/dot'),
+    ('POST', '/v1/topology/walk'),
+    ('POST', '/v1/topology/accept')
+  )
+)
+;
+
+delete from permission where id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/topology'),
+    ('GET', '/v1/topology/dot'),
+    ('POST', '/v1/topology/walk'),
+    ('POST', '/v1/topology/accept')
+  )
+)
+;
+
+drop table if exists topology_expected;
+drop table if exists topology_link;
@@ -0,0 +1,68 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table topology_link (
+  switch         text not null,
+  port           text not null,
+  mac            text not null default '',
+  vlan           text not null default '',
+  host           text not null default '',
+  neighbor       text not null default '',
+  neighbor_port  text not null default '',
+  last_seen      timestamptz default current_timestamp not null,
+  primary key (switch, port, mac)
+);
+
+create index topology_link_mac_idx on topology_link (mac);
+
+create table topology_expected (
+  mac         text primary key,
+  switch      text not null,
+  port        text not null,
+  first_seen  timestamptz default current_timestamp not null
+);
+
+insert into permission(method, path) values
+  ('GET', '/v1/topology'),
+  ('GET', '/v1/topology/dot'),
+  ('POST', '/v1/topology/walk'),
+  ('POST', '/v1/topology/accept');
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/topology'),
+        ('GET', '/v1/topology/dot')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('POST', '/v1/topology/walk'),
+        ('POST', '/v1/topology/accept')
+      )
+  ) permission
+;
@@ -0,0 +1,88 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+alter table nic add column switch text;
+alter table nic add column switch_port text;
+
+drop view node_view;
+
+create view node_view as
+select
+  n.id,
+  n.name,
+  n.uid,
+  json_build_object(
+    'id', n.id,
+    'uid', n.uid,
+    'name', n.name,
+    'provision', n.provision,
+    'boot_image', k.name,
+    'firmware', n.firmware,
+    'tags',
+      (select coalesce(json_agg(concat_ws(':',t.key,nt.value) order by nt.id), '[]'::json)
+       from node_tag as nt
+       join tag as t
+         on nt.tag_id = t.id
+       where nt.node_id = n.id
+      ),
+    'interfaces', (
+      select coalesce(json_agg(
+         json_build_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'mtu', nc.mtu,
+           'bmc', nc.nic_type = 'bmc',
+           'ip', nc.ip,
+           'ip6', nc.ip6,
+           'switch', nc.switch,
+           'switch_port', nc.switch_port
+         ) order by nc.id), '[]'::json)
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type != 'bond'
+    ),
+    'bonds', (
+      select coalesce(json_agg(
+         json_build_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'peers', nc.peers::json,
+           'mtu', nc.mtu,
+           'bmc', nc.nic_type = 'bmc',
+           'ip', nc.ip,
+           'ip6', nc.ip6,
+           'switch', nc.switch,
+           'switch_port', nc.switch_port
+         ) order by nc.id), '[]'::json)
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type = 'bond'
+    )
+  )::text as host_json
+from
+    node as n
+left join kernel as k
+on n.kernel_id = k.id;
+
+insert into permission(method, path) values
+  ('GET', '/v1/topology/cabling');
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'GET' and path = '/v1/topology/cabling'
+  ) permission
+;
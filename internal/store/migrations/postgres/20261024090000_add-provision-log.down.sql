@@ -0,0 +1,16 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from role_permission where permission_id in
+(
+  select id
+  from permission
+  where method = 'GET' and path = '/v1/nodes/logs/%'
+)
+;
+
+delete from permission where method = 'GET' and path = '/v1/nodes/logs/%';
+
+drop index provision_log_host_idx;
+drop table provision_log;
@@ -0,0 +1,69 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from role_permission where permission_id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/images/artifacts'),
+    ('POST', '/v1/images/artifacts'),
+    ('POST', '/v1/images/artifacts/gc')
+  )
+)
+;
+
+delete from permission where id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/images/artifacts'),
+    ('POST', '/v1/images/artifacts'),
+    ('POST', '/v1/images/artifacts/gc')
+  )
+)
+;
+
+drop view kernel_view;
+
+create view kernel_view as
+select
+  k.id,
+  k.name,
+  json_build_object(
+    'id', k.id,
+    'uid', k.uid,
+    'name', k.name,
+    'kernel', k.path,
+    'cmdline', k.command_line,
+    'verify', k.verify,
+    'initrd', (
+      select coalesce(json_agg(
+         rd.path order by rd.id
+       ), '[]'::json)
+       from initrd as rd
+       where rd.kernel_id = k.id
+    ),
+    'provision_templates', (
+      select coalesce(json_object_agg(tt.uri_name, t.name), '{}'::json)
+      from kernel_template as kt
+      join template t
+         on kt.template_id = t.id
+      join template_type tt
+         on t.template_type_id = tt.id
+      where kt.kernel_id = k.id
+    )
+  )::text as image_json
+from
+    kernel as k;
+
+alter table initrd drop column sha256;
+alter table kernel drop column liveimg_sha256;
+alter table kernel drop column kernel_sha256;
+alter table kernel drop column liveimg;
+
+drop table artifact;
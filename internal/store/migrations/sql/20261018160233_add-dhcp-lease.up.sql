@@ -0,0 +1,12 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table dhcp_lease (
+  id            integer primary key,
+  mac           text not null unique,
+  ip            text not null unique,
+  expires_at    timestamp not null,
+  created_at    timestamp default current_timestamp not null,
+  updated_at    timestamp default current_timestamp not null
+);
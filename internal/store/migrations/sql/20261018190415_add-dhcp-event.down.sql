@@ -0,0 +1,30 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from role_permission where permission_id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/dhcp/leases'),
+    ('GET', '/v1/nodes/history/%')
+  )
+)
+;
+
+delete from permission where id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/dhcp/leases'),
+    ('GET', '/v1/nodes/history/%')
+  )
+)
+;
+
+drop index dhcp_event_host_idx;
+drop table dhcp_event;
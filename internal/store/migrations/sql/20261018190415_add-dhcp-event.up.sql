@@ -0,0 +1,40 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table dhcp_event (
+  id            integer primary key,
+  message_type  text not null,
+  mac           text not null,
+  host          text not null default '',
+  ip            text not null default '',
+  relay_ip      text not null default '',
+  firmware      text not null default '',
+  created_at    timestamp default current_timestamp not null
+);
+
+create index dhcp_event_host_idx on dhcp_event (host, created_at);
+
+insert into permission(method, path) values
+  ('GET', '/v1/dhcp/leases'),
+  ('GET', '/v1/nodes/history/%') -- :name
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/dhcp/leases'),
+        ('GET', '/v1/nodes/history/%')
+      )
+  ) permission
+;
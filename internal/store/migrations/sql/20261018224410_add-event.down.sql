@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+drop index event_host_host_idx;
+drop table event_host;
+drop index event_user_name_idx;
+drop index event_created_at_idx;
+drop table event;
@@ -0,0 +1,34 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from role_permission where permission_id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/bmc/tasks'),
+    ('GET', '/v1/bmc/tasks/%'),
+    ('DELETE', '/v1/bmc/tasks/%')
+  )
+)
+;
+
+delete from permission where id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/bmc/tasks'),
+    ('GET', '/v1/bmc/tasks/%'),
+    ('DELETE', '/v1/bmc/tasks/%')
+  )
+)
+;
+
+drop table bmc_task_host;
+drop index bmc_task_owner_idx;
+drop index bmc_task_created_at_idx;
+drop table bmc_task;
@@ -0,0 +1,71 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table bmc_task (
+  id          text primary key,
+  operation   text not null,
+  status      text not null,
+  user_name   text not null default '',
+  owner       text not null default '',
+  error       text not null default '',
+  created_at  timestamp default current_timestamp not null,
+  updated_at  timestamp default current_timestamp not null
+);
+
+create index bmc_task_created_at_idx on bmc_task (created_at);
+create index bmc_task_owner_idx on bmc_task (owner, status);
+
+create table bmc_task_host (
+  task_id     text not null,
+  host        text not null,
+  status      text not null,
+  msg         text not null default '',
+  data        text not null default '',
+  updated_at  timestamp default current_timestamp not null,
+  primary key (task_id, host),
+  foreign key (task_id) references bmc_task (id) on delete cascade
+);
+
+insert into permission(method, path) values
+  ('GET', '/v1/bmc/tasks'),
+  ('GET', '/v1/bmc/tasks/%'), -- :id
+  ('DELETE', '/v1/bmc/tasks/%') -- :id
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/bmc/tasks'),
+        ('GET', '/v1/bmc/tasks/%')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('DELETE', '/v1/bmc/tasks/%')
+      )
+  ) permission
+;
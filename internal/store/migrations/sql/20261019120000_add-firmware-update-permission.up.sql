@@ -0,0 +1,26 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+insert into permission(method, path) values
+  ('POST', '/v1/bmc/upgrade/redfish'),
+  ('POST', '/v1/bmc/upgrade/redfish/tasks');
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('POST', '/v1/bmc/upgrade/redfish'),
+        ('POST', '/v1/bmc/upgrade/redfish/tasks')
+      )
+  ) permission
+;
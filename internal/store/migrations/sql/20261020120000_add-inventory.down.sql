@@ -0,0 +1,32 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from role_permission where permission_id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/nodes/inventory'),
+    ('GET', '/v1/nodes/inventory/changes'),
+    ('POST', '/v1/bmc/inventory')
+  )
+)
+;
+
+delete from permission where id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/nodes/inventory'),
+    ('GET', '/v1/nodes/inventory/changes'),
+    ('POST', '/v1/bmc/inventory')
+  )
+)
+;
+
+drop table if exists inventory_change;
+drop table if exists inventory;
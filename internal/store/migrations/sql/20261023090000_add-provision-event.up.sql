@@ -0,0 +1,37 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table provision_event (
+  id          integer primary key,
+  host        text not null,
+  state       text not null,
+  message     text not null default '',
+  created_at  timestamp default current_timestamp not null
+);
+
+create index provision_event_host_idx on provision_event (host, id);
+
+insert into permission(method, path) values
+  ('GET', '/v1/nodes/provision'),
+  ('GET', '/v1/nodes/provision/%') -- :name
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/nodes/provision'),
+        ('GET', '/v1/nodes/provision/%')
+      )
+  ) permission
+;
@@ -0,0 +1,32 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table provision_log (
+  id          integer primary key,
+  host        text not null,
+  kind        text not null,
+  name        text not null default '',
+  content     text not null default '',
+  created_at  timestamp default current_timestamp not null
+);
+
+create index provision_log_host_idx on provision_log (host, id);
+
+insert into permission(method, path) values
+  ('GET', '/v1/nodes/logs/%'); -- :name
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user')
+  ) role,
+  (
+    select id
+    from permission
+    where method = 'GET' and path = '/v1/nodes/logs/%'
+  ) permission
+;
@@ -0,0 +1,81 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table artifact (
+  sha256      text primary key,
+  size        integer not null,
+  created_at  timestamp default current_timestamp not null
+);
+
+alter table kernel add column liveimg text;
+alter table kernel add column kernel_sha256 text;
+alter table kernel add column liveimg_sha256 text;
+alter table initrd add column sha256 text;
+
+drop view kernel_view;
+
+create view kernel_view as
+select
+  k.id,
+  k.name,
+  json_object(
+    'id', k.id,
+    'uid', k.uid,
+    'name', k.name,
+    'kernel', k.path,
+    'liveimg', coalesce(k.liveimg, ''),
+    'cmdline', k.command_line,
+    'verify', iif(k.verify == 0, json('false'), json('true')),
+    'kernel_sha256', coalesce(k.kernel_sha256, ''),
+    'liveimg_sha256', coalesce(k.liveimg_sha256, ''),
+    'initrd', (
+      select json_group_array(
+         rd.path
+       )
+       from (select * from initrd where kernel_id = k.id order by id) as rd
+    ),
+    'initrd_sha256', (
+      select json_group_array(
+         rd.sha256
+       )
+       from (select * from initrd where kernel_id = k.id and sha256 is not null order by id) as rd
+    ),
+    'provision_templates', (
+      select json_group_object(tt.uri_name, t.name)
+      from kernel_template as kt
+      join template t
+         on kt.template_id = t.id
+      join template_type tt
+         on t.template_type_id = tt.id
+      where kt.kernel_id = k.id
+    )
+  ) as image_json
+from
+    kernel as k
+;
+
+insert into permission(method, path) values
+  ('GET', '/v1/images/artifacts'),
+  ('POST', '/v1/images/artifacts'),
+  ('POST', '/v1/images/artifacts/gc');
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name = 'admin'
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/images/artifacts'),
+        ('POST', '/v1/images/artifacts'),
+        ('POST', '/v1/images/artifacts/gc')
+      )
+  ) permission
+;
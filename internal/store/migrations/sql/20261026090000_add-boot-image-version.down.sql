@@ -0,0 +1,149 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+delete from role_permission where permission_id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/images/versions/%'),
+    ('PATCH', '/v1/images/current'),
+    ('GET', '/v1/images/rollouts'),
+    ('POST', '/v1/images/rollouts'),
+    ('POST', '/v1/images/rollouts/%/promote'),
+    ('POST', '/v1/images/rollouts/%/rollback')
+  )
+)
+;
+
+delete from permission where id in
+(
+  select id
+  from permission
+  where (method, path) in
+  (
+    ('GET', '/v1/images/versions/%'),
+    ('PATCH', '/v1/images/current'),
+    ('GET', '/v1/images/rollouts'),
+    ('POST', '/v1/images/rollouts'),
+    ('POST', '/v1/images/rollouts/%/promote'),
+    ('POST', '/v1/images/rollouts/%/rollback')
+  )
+)
+;
+
+drop view node_view;
+
+create view node_view as
+select
+  n.id,
+  n.name,
+  n.uid,
+  json_object(
+    'id', n.id,
+    'uid', n.uid,
+    'name', n.name,
+    'provision', n.provision,
+    'boot_image', k.name,
+    'firmware', n.firmware,
+    'tags',
+      (select json_group_array(concat_ws(':',t.key,nt.value))
+       from node_tag as nt
+       join tag as t
+         on nt.tag_id = t.id
+       where nt.node_id = n.id
+      ),
+    'interfaces', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip,
+           'ip6', nc.ip6,
+           'switch', nc.switch,
+           'switch_port', nc.switch_port
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type != 'bond'
+    ),
+    'bonds', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'peers', json_extract(nc.peers, '$'),
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip,
+           'ip6', nc.ip6,
+           'switch', nc.switch,
+           'switch_port', nc.switch_port
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type = 'bond'
+    )
+  ) as host_json
+from
+    node as n
+left join kernel as k
+on n.kernel_id = k.id
+;
+
+drop view kernel_view;
+
+create view kernel_view as
+select
+  k.id,
+  k.name,
+  json_object(
+    'id', k.id,
+    'uid', k.uid,
+    'name', k.name,
+    'kernel', k.path,
+    'liveimg', coalesce(k.liveimg, ''),
+    'cmdline', k.command_line,
+    'verify', iif(k.verify == 0, json('false'), json('true')),
+    'kernel_sha256', coalesce(k.kernel_sha256, ''),
+    'liveimg_sha256', coalesce(k.liveimg_sha256, ''),
+    'initrd', (
+      select json_group_array(
+         rd.path
+       )
+       from (select * from initrd where kernel_id = k.id order by id) as rd
+    ),
+    'initrd_sha256', (
+      select json_group_array(
+         rd.sha256
+       )
+       from (select * from initrd where kernel_id = k.id and sha256 is not null order by id) as rd
+    ),
+    'provision_templates', (
+      select json_group_object(tt.uri_name, t.name)
+      from kernel_template as kt
+      join template t
+         on kt.template_id = t.id
+      join template_type tt
+         on t.template_type_id = tt.id
+      where kt.kernel_id = k.id
+    )
+  ) as image_json
+from
+    kernel as k
+;
+
+alter table node drop column kernel_version;
+
+update kernel set version = '';
+
+drop table rollout;
+drop table boot_image_version;
@@ -0,0 +1,194 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table boot_image_version (
+  id          integer primary key,
+  kernel_id   integer not null,
+  version     integer not null,
+  image_json  text not null,
+  created_at  timestamp default current_timestamp not null,
+  foreign key (kernel_id) references kernel(id) on delete cascade,
+  unique(kernel_id, version)
+);
+
+create table rollout (
+  id            integer primary key,
+  image         text not null,
+  version       integer not null,
+  from_version  integer not null,
+  nodes         text not null,
+  canary        text not null,
+  percent       integer not null,
+  state         text not null,
+  previous      text default '{}' not null,
+  created_at    timestamp default current_timestamp not null,
+  updated_at    timestamp default current_timestamp not null
+);
+
+create index rollout_image_idx on rollout (image, id);
+
+alter table node add column kernel_version integer;
+
+drop view node_view;
+
+create view node_view as
+select
+  n.id,
+  n.name,
+  n.uid,
+  json_object(
+    'id', n.id,
+    'uid', n.uid,
+    'name', n.name,
+    'provision', n.provision,
+    'boot_image', iif(n.kernel_version is null, k.name, k.name || '@' || n.kernel_version),
+    'firmware', n.firmware,
+    'tags',
+      (select json_group_array(concat_ws(':',t.key,nt.value))
+       from node_tag as nt
+       join tag as t
+         on nt.tag_id = t.id
+       where nt.node_id = n.id
+      ),
+    'interfaces', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip,
+           'ip6', nc.ip6,
+           'switch', nc.switch,
+           'switch_port', nc.switch_port
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type != 'bond'
+    ),
+    'bonds', (
+      select json_group_array(
+         json_object(
+           'id', nc.id,
+           'ifname', nc.name,
+           'fqdn', nc.fqdn,
+           'vlan', nc.vlan,
+           'mac', nc.mac,
+           'peers', json_extract(nc.peers, '$'),
+           'mtu', nc.mtu,
+           'bmc', iif(nc.nic_type == 'bmc', true, false),
+           'ip', nc.ip,
+           'ip6', nc.ip6,
+           'switch', nc.switch,
+           'switch_port', nc.switch_port
+         ))
+       from nic as nc
+       where nc.node_id = n.id and nc.nic_type = 'bond'
+    )
+  ) as host_json
+from
+    node as n
+left join kernel as k
+on n.kernel_id = k.id
+;
+
+drop view kernel_view;
+
+create view kernel_view as
+select
+  k.id,
+  k.name,
+  json_object(
+    'id', k.id,
+    'uid', k.uid,
+    'name', k.name,
+    'version', cast(k.version as integer),
+    'kernel', k.path,
+    'liveimg', coalesce(k.liveimg, ''),
+    'cmdline', k.command_line,
+    'verify', iif(k.verify == 0, json('false'), json('true')),
+    'kernel_sha256', coalesce(k.kernel_sha256, ''),
+    'liveimg_sha256', coalesce(k.liveimg_sha256, ''),
+    'initrd', (
+      select json_group_array(
+         rd.path
+       )
+       from (select * from initrd where kernel_id = k.id order by id) as rd
+    ),
+    'initrd_sha256', (
+      select json_group_array(
+         rd.sha256
+       )
+       from (select * from initrd where kernel_id = k.id and sha256 is not null order by id) as rd
+    ),
+    'provision_templates', (
+      select json_group_object(tt.uri_name, t.name)
+      from kernel_template as kt
+      join template t
+         on kt.template_id = t.id
+      join template_type tt
+         on t.template_type_id = tt.id
+      where kt.kernel_id = k.id
+    )
+  ) as image_json
+from
+    kernel as k
+;
+
+-- Existing images become version 1
+insert into boot_image_version (kernel_id, version, image_json)
+select id, 1, image_json from kernel_view;
+
+update kernel set version = '1';
+
+insert into permission(method, path) values
+  ('GET', '/v1/images/versions/%'),
+  ('PATCH', '/v1/images/current'),
+  ('GET', '/v1/images/rollouts'),
+  ('POST', '/v1/images/rollouts'),
+  ('POST', '/v1/images/rollouts/%/promote'),
+  ('POST', '/v1/images/rollouts/%/rollback')
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin', 'user', 'read-only')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('GET', '/v1/images/versions/%'),
+        ('GET', '/v1/images/rollouts')
+      )
+  ) permission
+;
+
+insert into role_permission(role_id, permission_id)
+select role.id, permission.id
+from
+  (
+    select id
+    from role
+    where name in ('admin')
+  ) role,
+  (
+    select id
+    from permission
+    where (method, path) in
+      (
+        ('PATCH', '/v1/images/current'),
+        ('POST', '/v1/images/rollouts'),
+        ('POST', '/v1/images/rollouts/%/promote'),
+        ('POST', '/v1/images/rollouts/%/rollback')
+      )
+  ) permission
+;
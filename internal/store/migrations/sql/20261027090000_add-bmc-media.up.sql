@@ -0,0 +1,22 @@
+-- SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+--
+-- SPDX-License-Identifier: GPL-3.0-or-later
+
+create table bmc_media (
+  host         text primary key,
+  image        text not null,
+  inserted_at  timestamp default current_timestamp not null
+);
+
+-- Virtual media used to be tracked with the grendel:media tag
+insert into bmc_media (host, image)
+select n.name, ''
+from node as n
+join node_tag as nt
+  on nt.node_id = n.id
+join tag as t
+  on nt.tag_id = t.id
+where t.key = 'grendel:media';
+
+delete from node_tag where tag_id in (select id from tag where key = 'grendel:media');
+delete from tag where key = 'grendel:media';
@@ -12,11 +12,30 @@ var ConfigDefault = Config{
 	Driver: "sqlite3",
 }
 
+// ConfigPostgres is the config for a PostgreSQL database. The filename given
+// to New is a PostgreSQL connection string.
+var ConfigPostgres = Config{
+	Driver: postgresDriver,
+}
+
 func configDefault(config ...Config) Config {
+	if len(config) > 0 {
+		return config[0]
+	}
+
 	return ConfigDefault
 }
 
+// Postgres returns true if the config is for a PostgreSQL database
+func (c Config) Postgres() bool {
+	return c.Driver == postgresDriver
+}
+
 func (c Config) DataSourceName(filename string, rw bool) string {
+	if c.Postgres() {
+		return filename
+	}
+
 	p := c.connectionParams()
 	if rw {
 		p.Set("mode", "rwc")
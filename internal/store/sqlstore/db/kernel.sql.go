@@ -14,20 +14,21 @@ import (
 )
 
 const initrdUpsert = `-- name: InitrdUpsert :one
-insert into initrd (kernel_id, path)
-values (?1, ?2)
+insert into initrd (kernel_id, path, sha256)
+values (?1, ?2, ?3)
 on conflict (path, kernel_id)
-do update set path = ?2
-returning id, kernel_id, path, created_at, updated_at
+do update set path = ?2, sha256 = ?3
+returning id, kernel_id, path, created_at, updated_at, sha256
 `
 
 type InitrdUpsertParams struct {
-	KernelID int64  `json:"kernel_id"`
-	Path     string `json:"path"`
+	KernelID int64       `json:"kernel_id"`
+	Path     string      `json:"path"`
+	Sha256   null.String `json:"sha256"`
 }
 
 func (q *Queries) InitrdUpsert(ctx context.Context, db DBTX, arg InitrdUpsertParams) (Initrd, error) {
-	row := db.QueryRowContext(ctx, initrdUpsert, arg.KernelID, arg.Path)
+	row := db.QueryRowContext(ctx, initrdUpsert, arg.KernelID, arg.Path, arg.Sha256)
 	var i Initrd
 	err := row.Scan(
 		&i.ID,
@@ -35,6 +36,7 @@ func (q *Queries) InitrdUpsert(ctx context.Context, db DBTX, arg InitrdUpsertPar
 		&i.Path,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Sha256,
 	)
 	return i, err
 }
@@ -127,6 +129,21 @@ func (q *Queries) KernelFetch(ctx context.Context, db DBTX, name string) (Kernel
 	return i, err
 }
 
+const kernelSetVersion = `-- name: KernelSetVersion :exec
+update kernel set version = ?1
+where id = ?2
+`
+
+type KernelSetVersionParams struct {
+	Version string `json:"version"`
+	ID      int64  `json:"id"`
+}
+
+func (q *Queries) KernelSetVersion(ctx context.Context, db DBTX, arg KernelSetVersionParams) error {
+	_, err := db.ExecContext(ctx, kernelSetVersion, arg.Version, arg.ID)
+	return err
+}
+
 const kernelTemplateUpsert = `-- name: KernelTemplateUpsert :exec
 insert into kernel_template (kernel_id, template_id)
 values (?1, ?2)
@@ -170,22 +187,25 @@ func (q *Queries) KernelTemplateUpsertDelete(ctx context.Context, db DBTX, arg K
 }
 
 const kernelUpsert = `-- name: KernelUpsert :one
-insert into kernel (id, uid, name, version, path, arch_id, command_line, verify)
-values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)
+insert into kernel (id, uid, name, version, path, arch_id, command_line, verify, liveimg, kernel_sha256, liveimg_sha256)
+values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11)
 on conflict (id)
-do update set uid = ?2, name = ?3, version = ?4, path = ?5, arch_id = ?6, command_line = ?7, verify = ?8
-returning id, uid, name, version, path, arch_id, command_line, verify, created_at, updated_at
+do update set uid = ?2, name = ?3, version = ?4, path = ?5, arch_id = ?6, command_line = ?7, verify = ?8, liveimg = ?9, kernel_sha256 = ?10, liveimg_sha256 = ?11
+returning id, uid, name, version, path, arch_id, command_line, verify, created_at, updated_at, liveimg, kernel_sha256, liveimg_sha256
 `
 
 type KernelUpsertParams struct {
-	ID          null.Int64  `json:"id"`
-	UID         ksuid.KSUID `json:"uid"`
-	Name        string      `json:"name"`
-	Version     string      `json:"version"`
-	Path        string      `json:"path"`
-	ArchID      null.Int64  `json:"arch_id"`
-	CommandLine null.String `json:"command_line"`
-	Verify      bool        `json:"verify"`
+	ID            null.Int64  `json:"id"`
+	UID           ksuid.KSUID `json:"uid"`
+	Name          string      `json:"name"`
+	Version       string      `json:"version"`
+	Path          string      `json:"path"`
+	ArchID        null.Int64  `json:"arch_id"`
+	CommandLine   null.String `json:"command_line"`
+	Verify        bool        `json:"verify"`
+	Liveimg       null.String `json:"liveimg"`
+	KernelSha256  null.String `json:"kernel_sha256"`
+	LiveimgSha256 null.String `json:"liveimg_sha256"`
 }
 
 func (q *Queries) KernelUpsert(ctx context.Context, db DBTX, arg KernelUpsertParams) (Kernel, error) {
@@ -198,6 +218,9 @@ func (q *Queries) KernelUpsert(ctx context.Context, db DBTX, arg KernelUpsertPar
 		arg.ArchID,
 		arg.CommandLine,
 		arg.Verify,
+		arg.Liveimg,
+		arg.KernelSha256,
+		arg.LiveimgSha256,
 	)
 	var i Kernel
 	err := row.Scan(
@@ -211,6 +234,9 @@ func (q *Queries) KernelUpsert(ctx context.Context, db DBTX, arg KernelUpsertPar
 		&i.Verify,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Liveimg,
+		&i.KernelSha256,
+		&i.LiveimgSha256,
 	)
 	return i, err
 }
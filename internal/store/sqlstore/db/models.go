@@ -17,25 +17,137 @@ type Arch struct {
 	Name string `json:"name"`
 }
 
-type Initrd struct {
+type Artifact struct {
+	Sha256    string    `json:"sha256"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BmcCredential struct {
+	Scope     string    `json:"scope"`
+	Name      string    `json:"name"`
+	Username  string    `json:"username"`
+	Password  string    `json:"password"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type BmcMedium struct {
+	Host       string    `json:"host"`
+	Image      string    `json:"image"`
+	InsertedAt time.Time `json:"inserted_at"`
+}
+
+type BmcTask struct {
+	ID        string    `json:"id"`
+	Operation string    `json:"operation"`
+	Status    string    `json:"status"`
+	UserName  string    `json:"user_name"`
+	Owner     string    `json:"owner"`
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type BmcTaskHost struct {
+	TaskID    string    `json:"task_id"`
+	Host      string    `json:"host"`
+	Status    string    `json:"status"`
+	Msg       string    `json:"msg"`
+	Data      string    `json:"data"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type BootImageVersion struct {
 	ID        int64     `json:"id"`
 	KernelID  int64     `json:"kernel_id"`
-	Path      string    `json:"path"`
+	Version   int64     `json:"version"`
+	Image     string    `json:"image_json"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type DHCPEvent struct {
+	ID          int64     `json:"id"`
+	MessageType string    `json:"message_type"`
+	MAC         string    `json:"mac"`
+	Host        string    `json:"host"`
+	IP          string    `json:"ip"`
+	RelayIP     string    `json:"relay_ip"`
+	Firmware    string    `json:"firmware"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type DHCPLease struct {
+	ID        int64     `json:"id"`
+	MAC       string    `json:"mac"`
+	IP        string    `json:"ip"`
+	ExpiresAt time.Time `json:"expires_at"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+type Event struct {
+	ID          int64     `json:"id"`
+	Severity    string    `json:"severity"`
+	UserName    string    `json:"user_name"`
+	Message     string    `json:"message"`
+	JobMessages string    `json:"job_messages"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type EventHost struct {
+	EventID int64  `json:"event_id"`
+	Host    string `json:"host"`
+}
+
+type Initrd struct {
+	ID        int64       `json:"id"`
+	KernelID  int64       `json:"kernel_id"`
+	Path      string      `json:"path"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+	Sha256    null.String `json:"sha256"`
+}
+
+type Inventory struct {
+	Host         string    `json:"host"`
+	Kind         string    `json:"kind"`
+	Slot         string    `json:"slot"`
+	Name         string    `json:"name"`
+	Manufacturer string    `json:"manufacturer"`
+	Model        string    `json:"model"`
+	Serial       string    `json:"serial"`
+	PartNumber   string    `json:"part_number"`
+	Version      string    `json:"version"`
+	Capacity     int64     `json:"capacity"`
+	Address      string    `json:"address"`
+	CollectedAt  time.Time `json:"collected_at"`
+}
+
+type InventoryChange struct {
+	ID         int64     `json:"id"`
+	Host       string    `json:"host"`
+	Kind       string    `json:"kind"`
+	Slot       string    `json:"slot"`
+	Change     string    `json:"change"`
+	Old        string    `json:"old"`
+	New        string    `json:"new"`
+	DetectedAt time.Time `json:"detected_at"`
+}
+
 type Kernel struct {
-	ID          int64       `json:"id"`
-	UID         ksuid.KSUID `json:"uid"`
-	Name        string      `json:"name"`
-	Version     string      `json:"version"`
-	Path        string      `json:"path"`
-	ArchID      null.Int64  `json:"arch_id"`
-	CommandLine null.String `json:"command_line"`
-	Verify      bool        `json:"verify"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	ID            int64       `json:"id"`
+	UID           ksuid.KSUID `json:"uid"`
+	Name          string      `json:"name"`
+	Version       string      `json:"version"`
+	Path          string      `json:"path"`
+	ArchID        null.Int64  `json:"arch_id"`
+	CommandLine   null.String `json:"command_line"`
+	Verify        bool        `json:"verify"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+	Liveimg       null.String `json:"liveimg"`
+	KernelSha256  null.String `json:"kernel_sha256"`
+	LiveimgSha256 null.String `json:"liveimg_sha256"`
 }
 
 type KernelTemplate struct {
@@ -49,30 +161,42 @@ type KernelView struct {
 	Image model.BootImage `json:"image_json"`
 }
 
+type LeaderLease struct {
+	Name       string    `json:"name"`
+	Holder     string    `json:"holder"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
 type Nic struct {
-	ID      int64       `json:"id"`
-	NodeID  int64       `json:"node_id"`
-	NicType string      `json:"nic_type"`
-	Name    null.String `json:"name"`
-	VLAN    null.String `json:"vlan"`
-	FQDN    null.String `json:"fqdn"`
-	MAC     null.String `json:"mac"`
-	IP      null.String `json:"ip"`
-	Peers   null.String `json:"peers"`
-	MTU     null.Int64  `json:"mtu"`
+	ID         int64       `json:"id"`
+	NodeID     int64       `json:"node_id"`
+	NicType    string      `json:"nic_type"`
+	Name       null.String `json:"name"`
+	VLAN       null.String `json:"vlan"`
+	FQDN       null.String `json:"fqdn"`
+	MAC        null.String `json:"mac"`
+	IP         null.String `json:"ip"`
+	Peers      null.String `json:"peers"`
+	MTU        null.Int64  `json:"mtu"`
+	IPv6       null.String `json:"ip6"`
+	Switch     null.String `json:"switch"`
+	SwitchPort null.String `json:"switch_port"`
 }
 
 type Node struct {
-	ID         int64       `json:"id"`
-	UID        ksuid.KSUID `json:"uid"`
-	Name       string      `json:"name"`
-	Provision  bool        `json:"provision"`
-	ArchID     null.Int64  `json:"arch_id"`
-	KernelID   null.Int64  `json:"kernel_id"`
-	NodeTypeID null.Int64  `json:"node_type_id"`
-	Firmware   null.String `json:"firmware"`
-	CreatedAt  time.Time   `json:"created_at"`
-	UpdatedAt  time.Time   `json:"updated_at"`
+	ID            int64       `json:"id"`
+	UID           ksuid.KSUID `json:"uid"`
+	Name          string      `json:"name"`
+	Provision     bool        `json:"provision"`
+	ArchID        null.Int64  `json:"arch_id"`
+	KernelID      null.Int64  `json:"kernel_id"`
+	NodeTypeID    null.Int64  `json:"node_type_id"`
+	Firmware      null.String `json:"firmware"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+	KernelVersion null.Int64  `json:"kernel_version"`
 }
 
 type NodeTag struct {
@@ -100,6 +224,23 @@ type Permission struct {
 	Path   string `json:"path"`
 }
 
+type ProvisionEvent struct {
+	ID        int64     `json:"id"`
+	Host      string    `json:"host"`
+	State     string    `json:"state"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ProvisionLog struct {
+	ID        int64     `json:"id"`
+	Host      string    `json:"host"`
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type Role struct {
 	ID   int64  `json:"id"`
 	Name string `json:"name"`
@@ -115,6 +256,20 @@ type RoleView struct {
 	PermissionJson model.RoleView `json:"permission_json"`
 }
 
+type Rollout struct {
+	ID          int64     `json:"id"`
+	Image       string    `json:"image"`
+	Version     int64     `json:"version"`
+	FromVersion int64     `json:"from_version"`
+	Nodes       string    `json:"nodes"`
+	Canary      string    `json:"canary"`
+	Percent     int64     `json:"percent"`
+	State       string    `json:"state"`
+	Previous    string    `json:"previous"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
 type Tag struct {
 	ID  int64  `json:"id"`
 	Key string `json:"key"`
@@ -134,6 +289,24 @@ type TemplateType struct {
 	UriName string `json:"uri_name"`
 }
 
+type TopologyExpected struct {
+	Mac       string    `json:"mac"`
+	Switch    string    `json:"switch"`
+	Port      string    `json:"port"`
+	FirstSeen time.Time `json:"first_seen"`
+}
+
+type TopologyLink struct {
+	Switch       string    `json:"switch"`
+	Port         string    `json:"port"`
+	Mac          string    `json:"mac"`
+	Vlan         string    `json:"vlan"`
+	Host         string    `json:"host"`
+	Neighbor     string    `json:"neighbor"`
+	NeighborPort string    `json:"neighbor_port"`
+	LastSeen     time.Time `json:"last_seen"`
+}
+
 type User struct {
 	ID           int64     `json:"id"`
 	Username     string    `json:"username"`
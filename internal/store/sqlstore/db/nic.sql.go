@@ -19,25 +19,28 @@ const nicUpsert = `-- name: NicUpsert :one
  * SPDX-License-Identifier: GPL-3.0-or-later
  */
 
-insert into nic (id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu)
-values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10)
+insert into nic (id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu, ip6, switch, switch_port)
+values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11, ?12, ?13)
 on conflict (id)
 do update set nic_type = ?3, name = ?4, vlan = ?5, fqdn = ?6, mac = ?7, ip = ?8,
-              peers = ?9, mtu = ?10
-returning id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu
+              peers = ?9, mtu = ?10, ip6 = ?11, switch = ?12, switch_port = ?13
+returning id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu, ip6, switch, switch_port
 `
 
 type NicUpsertParams struct {
-	ID      null.Int64  `json:"id"`
-	NodeID  int64       `json:"node_id"`
-	NicType string      `json:"nic_type"`
-	Name    null.String `json:"name"`
-	VLAN    null.String `json:"vlan"`
-	FQDN    null.String `json:"fqdn"`
-	MAC     null.String `json:"mac"`
-	IP      null.String `json:"ip"`
-	Peers   null.String `json:"peers"`
-	MTU     null.Int64  `json:"mtu"`
+	ID         null.Int64  `json:"id"`
+	NodeID     int64       `json:"node_id"`
+	NicType    string      `json:"nic_type"`
+	Name       null.String `json:"name"`
+	VLAN       null.String `json:"vlan"`
+	FQDN       null.String `json:"fqdn"`
+	MAC        null.String `json:"mac"`
+	IP         null.String `json:"ip"`
+	Peers      null.String `json:"peers"`
+	MTU        null.Int64  `json:"mtu"`
+	IPv6       null.String `json:"ip6"`
+	Switch     null.String `json:"switch"`
+	SwitchPort null.String `json:"switch_port"`
 }
 
 func (q *Queries) NicUpsert(ctx context.Context, db DBTX, arg NicUpsertParams) (Nic, error) {
@@ -52,6 +55,9 @@ func (q *Queries) NicUpsert(ctx context.Context, db DBTX, arg NicUpsertParams) (
 		arg.IP,
 		arg.Peers,
 		arg.MTU,
+		arg.IPv6,
+		arg.Switch,
+		arg.SwitchPort,
 	)
 	var i Nic
 	err := row.Scan(
@@ -65,6 +71,9 @@ func (q *Queries) NicUpsert(ctx context.Context, db DBTX, arg NicUpsertParams) (
 		&i.IP,
 		&i.Peers,
 		&i.MTU,
+		&i.IPv6,
+		&i.Switch,
+		&i.SwitchPort,
 	)
 	return i, err
 }
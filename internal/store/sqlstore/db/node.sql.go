@@ -46,19 +46,21 @@ func (q *Queries) NodeAll(ctx context.Context, db DBTX) ([]NodeView, error) {
 }
 
 const nodeBootKernel = `-- name: NodeBootKernel :exec
-update node set kernel_id = ?1
+update node set kernel_id = ?1, kernel_version = ?2
 where id in (/*SLICE:nodes*/?)
 `
 
 type NodeBootKernelParams struct {
-	KernelID null.Int64 `json:"kernel_id"`
-	Nodes    []int64    `json:"nodes"`
+	KernelID      null.Int64 `json:"kernel_id"`
+	KernelVersion null.Int64 `json:"kernel_version"`
+	Nodes         []int64    `json:"nodes"`
 }
 
 func (q *Queries) NodeBootKernel(ctx context.Context, db DBTX, arg NodeBootKernelParams) error {
 	query := nodeBootKernel
 	var queryParams []interface{}
 	queryParams = append(queryParams, arg.KernelID)
+	queryParams = append(queryParams, arg.KernelVersion)
 	if len(arg.Nodes) > 0 {
 		for _, v := range arg.Nodes {
 			queryParams = append(queryParams, v)
@@ -162,9 +164,9 @@ join nic as nc
 on nc.node_id = n.id
 where 
   case 
-    when cast(?1 as integer) then nc.mac = ?2
-    when cast(?3 as integer) then nc.ip = ?4
-    else 0
+    when cast(?1 as integer) = 1 then nc.mac = ?2
+    when cast(?3 as integer) = 1 then nc.ip = ?4
+    else false
   end
 limit 1
 `
@@ -354,13 +356,14 @@ func (q *Queries) NodeProvision(ctx context.Context, db DBTX, arg NodeProvisionP
 }
 
 const nodeResolve = `-- name: NodeResolve :many
-select nc.fqdn, nc.ip 
+select nc.fqdn, nc.ip, nc.ip6
 from nic as nc
 where 
   case 
-    when cast(?1 as integer) then lower(nc.fqdn) like concat('%', cast(?2 as text), '%')
-    when cast(?3 as integer) then substring(nc.ip, 0, instr(nc.ip, '/')) = cast(?4 as text)
-    else 0
+    when cast(?1 as integer) = 1 then lower(nc.fqdn) like concat('%', cast(?2 as text), '%')
+    when cast(?3 as integer) = 1 then substring(nc.ip, 0, instr(nc.ip, '/')) = cast(?4 as text)
+    when cast(?5 as integer) = 1 then substring(nc.ip6, 0, instr(nc.ip6, '/')) = cast(?6 as text)
+    else false
   end
 `
 
@@ -369,11 +372,14 @@ type NodeResolveParams struct {
 	FQDN       string `json:"fqdn"`
 	FilterIP   int64  `json:"filter_ip"`
 	IP         string `json:"ip"`
+	FilterIPv6 int64  `json:"filter_ip6"`
+	IPv6       string `json:"ip6"`
 }
 
 type NodeResolveRow struct {
 	FQDN null.String `json:"fqdn"`
 	IP   null.String `json:"ip"`
+	IPv6 null.String `json:"ip6"`
 }
 
 func (q *Queries) NodeResolve(ctx context.Context, db DBTX, arg NodeResolveParams) ([]NodeResolveRow, error) {
@@ -382,6 +388,8 @@ func (q *Queries) NodeResolve(ctx context.Context, db DBTX, arg NodeResolveParam
 		arg.FQDN,
 		arg.FilterIP,
 		arg.IP,
+		arg.FilterIPv6,
+		arg.IPv6,
 	)
 	if err != nil {
 		return nil, err
@@ -390,7 +398,7 @@ func (q *Queries) NodeResolve(ctx context.Context, db DBTX, arg NodeResolveParam
 	var items []NodeResolveRow
 	for rows.Next() {
 		var i NodeResolveRow
-		if err := rows.Scan(&i.FQDN, &i.IP); err != nil {
+		if err := rows.Scan(&i.FQDN, &i.IP, &i.IPv6); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -487,22 +495,23 @@ func (q *Queries) NodeTagUpsertDelete(ctx context.Context, db DBTX, arg NodeTagU
 }
 
 const nodeUpsert = `-- name: NodeUpsert :one
-insert into node (id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware)
-values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)
+insert into node (id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware, kernel_version)
+values (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9)
 on conflict (id)
-do update set name = ?3, provision = ?4, arch_id = ?5, kernel_id = ?6, node_type_id = ?7, firmware = ?8
-returning id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware, created_at, updated_at
+do update set name = ?3, provision = ?4, arch_id = ?5, kernel_id = ?6, node_type_id = ?7, firmware = ?8, kernel_version = ?9
+returning id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware, created_at, updated_at, kernel_version
 `
 
 type NodeUpsertParams struct {
-	ID         null.Int64  `json:"id"`
-	UID        ksuid.KSUID `json:"uid"`
-	Name       string      `json:"name"`
-	Provision  bool        `json:"provision"`
-	ArchID     null.Int64  `json:"arch_id"`
-	KernelID   null.Int64  `json:"kernel_id"`
-	NodeTypeID null.Int64  `json:"node_type_id"`
-	Firmware   null.String `json:"firmware"`
+	ID            null.Int64  `json:"id"`
+	UID           ksuid.KSUID `json:"uid"`
+	Name          string      `json:"name"`
+	Provision     bool        `json:"provision"`
+	ArchID        null.Int64  `json:"arch_id"`
+	KernelID      null.Int64  `json:"kernel_id"`
+	NodeTypeID    null.Int64  `json:"node_type_id"`
+	Firmware      null.String `json:"firmware"`
+	KernelVersion null.Int64  `json:"kernel_version"`
 }
 
 func (q *Queries) NodeUpsert(ctx context.Context, db DBTX, arg NodeUpsertParams) (Node, error) {
@@ -515,6 +524,7 @@ func (q *Queries) NodeUpsert(ctx context.Context, db DBTX, arg NodeUpsertParams)
 		arg.KernelID,
 		arg.NodeTypeID,
 		arg.Firmware,
+		arg.KernelVersion,
 	)
 	var i Node
 	err := row.Scan(
@@ -528,6 +538,7 @@ func (q *Queries) NodeUpsert(ctx context.Context, db DBTX, arg NodeUpsertParams)
 		&i.Firmware,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.KernelVersion,
 	)
 	return i, err
 }
@@ -43,8 +43,8 @@ const roleFetchByMethodAndPath = `-- name: RoleFetchByMethodAndPath :many
 
 select role.name
 from permission
-inner join role on role_permission.role_id = role.id
 inner join role_permission on role_permission.permission_id = permission.id
+inner join role on role_permission.role_id = role.id
 where permission.method = ?1 and ?2 like permission.path
 `
 
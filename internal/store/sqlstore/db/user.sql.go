@@ -16,7 +16,7 @@ const userCount = `-- name: UserCount :one
  * SPDX-License-Identifier: GPL-3.0-or-later
  */
 
-select count(*) from user
+select count(*) from "user"
 `
 
 func (q *Queries) UserCount(ctx context.Context, db DBTX) (int64, error) {
@@ -27,8 +27,8 @@ func (q *Queries) UserCount(ctx context.Context, db DBTX) (int64, error) {
 }
 
 const userCreate = `-- name: UserCreate :one
-insert into user (username, password_hash, role_id, enabled) 
-select ?1, ?2, role.id, ?3
+insert into "user" (username, password_hash, role_id, enabled) 
+select ?1, ?2, role.id, cast(?3 as boolean)
 from role
 where role.name = ?4
 on conflict (username)
@@ -64,7 +64,7 @@ func (q *Queries) UserCreate(ctx context.Context, db DBTX, arg UserCreateParams)
 }
 
 const userDelete = `-- name: UserDelete :exec
-delete from user where username = ?1
+delete from "user" where username = ?1
 `
 
 func (q *Queries) UserDelete(ctx context.Context, db DBTX, username string) error {
@@ -127,7 +127,7 @@ func (q *Queries) UserList(ctx context.Context, db DBTX) ([]UserView, error) {
 }
 
 const userUpdateEnable = `-- name: UserUpdateEnable :exec
-update user set enabled = ?1 where username = ?2
+update "user" set enabled = ?1 where username = ?2
 returning id, username, role_id, password_hash, enabled, created_at, updated_at
 `
 
@@ -142,12 +142,12 @@ func (q *Queries) UserUpdateEnable(ctx context.Context, db DBTX, arg UserUpdateE
 }
 
 const userUpdateRole = `-- name: UserUpdateRole :exec
-update user set role_id = (
+update "user" set role_id = (
   select role.id
   from role
   where role.name = ?1
 )
-where user.username = ?2
+where "user".username = ?2
 returning id, username, role_id, password_hash, enabled, created_at, updated_at
 `
 
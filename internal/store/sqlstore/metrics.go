@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/ubccr/grendel/internal/metrics"
+)
+
+// sqlDB wraps a database handle to observe the latency of the sqlc queries
+// run on it
+type sqlDB struct {
+	*sql.DB
+}
+
+func (d *sqlDB) Begin() (*sqlTx, error) {
+	tx, err := d.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlTx{tx}, nil
+}
+
+func (d *sqlDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer observe(query, time.Now())
+	return d.DB.ExecContext(ctx, query, args...)
+}
+
+func (d *sqlDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	defer observe(query, time.Now())
+	return d.DB.QueryContext(ctx, query, args...)
+}
+
+func (d *sqlDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer observe(query, time.Now())
+	return d.DB.QueryRowContext(ctx, query, args...)
+}
+
+// sqlTx wraps a transaction to observe the latency of the sqlc queries run
+// on it
+type sqlTx struct {
+	*sql.Tx
+}
+
+func (t *sqlTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer observe(query, time.Now())
+	return t.Tx.ExecContext(ctx, query, args...)
+}
+
+func (t *sqlTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	defer observe(query, time.Now())
+	return t.Tx.QueryContext(ctx, query, args...)
+}
+
+func (t *sqlTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer observe(query, time.Now())
+	return t.Tx.QueryRowContext(ctx, query, args...)
+}
+
+func observe(query string, start time.Time) {
+	metrics.Since(metrics.DBQueryDuration.WithLabelValues(queryName(query)), start)
+}
+
+// queryName returns the name sqlc gives a query in its leading
+// "-- name: NodeFind :many" comment, or "other" for queries without one
+func queryName(query string) string {
+	_, after, ok := strings.Cut(query, "-- name: ")
+	if !ok {
+		return "other"
+	}
+
+	name, _, _ := strings.Cut(after, " ")
+	return name
+}
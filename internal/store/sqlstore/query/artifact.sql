@@ -0,0 +1,27 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: ArtifactUpsert :exec
+insert into artifact (sha256, size, created_at)
+values (@sha256, @size, @created_at)
+on conflict (sha256)
+do update set size = ?2, created_at = ?3;
+
+-- name: ArtifactAll :many
+select * from artifact
+order by created_at, sha256;
+
+-- name: ArtifactFetchUnreferenced :many
+select * from artifact
+where created_at < @before
+  and sha256 not in (select kernel_sha256 from kernel where kernel_sha256 is not null)
+  and sha256 not in (select liveimg_sha256 from kernel where liveimg_sha256 is not null)
+  and sha256 not in (select sha256 from initrd where sha256 is not null)
+  and not exists (select 1 from boot_image_version as v where v.image_json like '%' || artifact.sha256 || '%')
+order by sha256;
+
+-- name: ArtifactDelete :exec
+delete from artifact where sha256 = @sha256;
@@ -0,0 +1,22 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: BmcCredentialUpsert :exec
+insert into bmc_credential (scope, name, username, password, updated_at)
+values (@scope, @name, @username, @password, @updated_at)
+on conflict (scope, name) do update set
+  username = excluded.username,
+  password = excluded.password,
+  updated_at = excluded.updated_at;
+
+-- name: BmcCredentialFetch :one
+select * from bmc_credential where scope = @scope and name = @name;
+
+-- name: BmcCredentialFind :many
+select * from bmc_credential order by scope, name;
+
+-- name: BmcCredentialDelete :execrows
+delete from bmc_credential where scope = @scope and name = @name;
@@ -0,0 +1,18 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: BmcMediaUpsert :exec
+insert into bmc_media (host, image, inserted_at)
+values (@host, @image, @inserted_at)
+on conflict (host) do update set
+  image = excluded.image,
+  inserted_at = excluded.inserted_at;
+
+-- name: BmcMediaFetch :one
+select * from bmc_media where host = @host;
+
+-- name: BmcMediaDelete :execrows
+delete from bmc_media where host = @host;
@@ -0,0 +1,49 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: BmcTaskCreate :exec
+insert into bmc_task (id, operation, status, user_name, owner, created_at, updated_at)
+values (@id, @operation, @status, @user_name, @owner, @created_at, @created_at);
+
+-- name: BmcTaskHostCreate :exec
+insert into bmc_task_host (task_id, host, status, updated_at)
+values (@task_id, @host, @status, @updated_at)
+on conflict do nothing;
+
+-- name: BmcTaskFetch :one
+select * from bmc_task where id = @id;
+
+-- name: BmcTaskFind :many
+select * from bmc_task
+where (status = @status or @status = '')
+order by created_at desc, id desc
+limit @limit;
+
+-- name: BmcTaskHostFetch :many
+select * from bmc_task_host
+where task_id = @task_id
+order by host;
+
+-- name: BmcTaskUpdate :exec
+update bmc_task set status = @status, error = @error, updated_at = @updated_at
+where id = @id;
+
+-- name: BmcTaskHostUpdate :exec
+update bmc_task_host set status = @status, msg = @msg, data = @data, updated_at = @updated_at
+where task_id = @task_id and host = @host;
+
+-- name: BmcTaskHostInterrupt :exec
+update bmc_task_host set status = @status, updated_at = @updated_at
+where status = 'pending'
+  and task_id in (select id from bmc_task where owner = @owner and bmc_task.status in ('queued', 'running'));
+
+-- name: BmcTaskInterrupt :execrows
+update bmc_task set status = @status, error = @error, updated_at = @updated_at
+where owner = @owner and status in ('queued', 'running');
+
+-- name: BmcTaskPrune :execrows
+delete from bmc_task
+where updated_at < @before and status not in ('queued', 'running');
@@ -0,0 +1,18 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: BootImageVersionCreate :exec
+insert into boot_image_version (kernel_id, version, image_json)
+values (@kernel_id, @version, @image_json);
+
+-- name: BootImageVersionFetch :one
+select * from boot_image_version
+where kernel_id = @kernel_id and version = @version;
+
+-- name: BootImageVersionFetchByKernel :many
+select * from boot_image_version
+where kernel_id = @kernel_id
+order by version;
@@ -0,0 +1,17 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: DHCPEventCreate :exec
+insert into dhcp_event (message_type, mac, host, ip, relay_ip, firmware, created_at)
+values (@message_type, @mac, @host, @ip, @relay_ip, @firmware, @created_at);
+
+-- name: DHCPEventFetchByHost :many
+select * from dhcp_event
+where host = @host and created_at >= @since
+order by created_at, id;
+
+-- name: DHCPEventPrune :exec
+delete from dhcp_event where created_at < @before;
@@ -0,0 +1,32 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: EventCreate :one
+insert into event (severity, user_name, message, job_messages, created_at)
+values (@severity, @user_name, @message, @job_messages, @created_at)
+returning id;
+
+-- name: EventHostCreate :exec
+insert into event_host (event_id, host)
+values (@event_id, @host)
+on conflict do nothing;
+
+-- name: EventFind :many
+select * from event
+where (user_name = @user_name or @user_name = '')
+  and (severity = @severity or @severity = '')
+  and (id in (select event_id from event_host where event_host.host = @host) or @host = '')
+  and created_at >= @since and created_at <= @until
+order by created_at desc, id desc
+limit @limit offset @offset;
+
+-- name: EventHostFetch :many
+select * from event_host
+where event_id in (sqlc.slice(ids))
+order by event_id, host;
+
+-- name: EventPrune :execrows
+delete from event where created_at < @before;
@@ -0,0 +1,26 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: InventoryCreate :exec
+insert into inventory (host, kind, slot, name, manufacturer, model, serial, part_number, version, capacity, address, collected_at)
+values (@host, @kind, @slot, @name, @manufacturer, @model, @serial, @part_number, @version, @capacity, @address, @collected_at);
+
+-- name: InventoryDeleteByHost :exec
+delete from inventory where host = @host;
+
+-- name: InventoryFind :many
+select * from inventory
+where (host = @host or @host = '') and (kind = @kind or @kind = '')
+order by host, kind, slot;
+
+-- name: InventoryChangeCreate :exec
+insert into inventory_change (host, kind, slot, change, old, new, detected_at)
+values (@host, @kind, @slot, @change, @old, @new, @detected_at);
+
+-- name: InventoryChangeFind :many
+select * from inventory_change
+where (host = @host or @host = '') and detected_at >= @since
+order by detected_at, id;
@@ -11,17 +11,21 @@ select * from kernel_view where name = @name;
 select * from kernel_view;
 
 -- name: KernelUpsert :one
-insert into kernel (id, uid, name, version, path, arch_id, command_line, verify)
-values (sqlc.narg(id), @uid, @name, @version, @path, @arch_id, @command_line, @verify)
+insert into kernel (id, uid, name, version, path, arch_id, command_line, verify, liveimg, kernel_sha256, liveimg_sha256)
+values (sqlc.narg(id), @uid, @name, @version, @path, @arch_id, @command_line, @verify, @liveimg, @kernel_sha256, @liveimg_sha256)
 on conflict (id)
-do update set uid = ?2, name = ?3, version = ?4, path = ?5, arch_id = ?6, command_line = ?7, verify = ?8
+do update set uid = ?2, name = ?3, version = ?4, path = ?5, arch_id = ?6, command_line = ?7, verify = ?8, liveimg = ?9, kernel_sha256 = ?10, liveimg_sha256 = ?11
 returning *;
 
+-- name: KernelSetVersion :exec
+update kernel set version = @version
+where id = @id;
+
 -- name: InitrdUpsert :one
-insert into initrd (kernel_id, path)
-values (@kernel_id, @path)
+insert into initrd (kernel_id, path, sha256)
+values (@kernel_id, @path, @sha256)
 on conflict (path, kernel_id)
-do update set path = ?2
+do update set path = ?2, sha256 = ?3
 returning *;
 
 -- name: InitrdUpsertDelete :exec
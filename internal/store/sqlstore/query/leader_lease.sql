@@ -0,0 +1,20 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: LeaderLeaseFetch :one
+select * from leader_lease where name = @name;
+
+-- name: LeaderLeaseAcquire :one
+insert into leader_lease (name, holder, acquired_at, expires_at, updated_at)
+values (@name, @holder, @now, @expires_at, @now)
+on conflict (name)
+do update set holder = ?2, expires_at = ?4, updated_at = ?3,
+              acquired_at = case when leader_lease.holder = ?2 then leader_lease.acquired_at else ?3 end
+where leader_lease.holder = ?2 or leader_lease.expires_at < ?3
+returning *;
+
+-- name: LeaderLeaseRelease :exec
+delete from leader_lease where name = @name and holder = @holder;
@@ -0,0 +1,24 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: LeaseFetch :one
+select * from dhcp_lease where mac = @mac;
+
+-- name: LeaseList :many
+select * from dhcp_lease order by id;
+
+-- name: LeaseUpsert :one
+insert into dhcp_lease (mac, ip, expires_at)
+values (@mac, @ip, @expires_at)
+on conflict (mac)
+do update set ip = ?2, expires_at = ?3, updated_at = current_timestamp
+returning *;
+
+-- name: LeaseDeleteIP :exec
+delete from dhcp_lease where ip = @ip and mac != @mac;
+
+-- name: LeaseDelete :exec
+delete from dhcp_lease where mac = @mac;
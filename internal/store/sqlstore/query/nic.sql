@@ -5,11 +5,11 @@
  */
 
 -- name: NicUpsert :one
-insert into nic (id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu)
-values (sqlc.narg(id), @node_id, @nic_type, @name, @vlan, @fqdn, @mac, @ip, @peers, @mtu)
+insert into nic (id, node_id, nic_type, name, vlan, fqdn, mac, ip, peers, mtu, ip6, switch, switch_port)
+values (sqlc.narg(id), @node_id, @nic_type, @name, @vlan, @fqdn, @mac, @ip, @peers, @mtu, @ip6, @switch, @switch_port)
 on conflict (id)
 do update set nic_type = ?3, name = ?4, vlan = ?5, fqdn = ?6, mac = ?7, ip = ?8,
-              peers = ?9, mtu = ?10
+              peers = ?9, mtu = ?10, ip6 = ?11, switch = ?12, switch_port = ?13
 returning *;
 
 -- name: NicUpsertDelete :exec
@@ -23,20 +23,21 @@ join nic as nc
 on nc.node_id = n.id
 where 
   case 
-    when cast(@filter_mac as integer) then nc.mac = @mac
-    when cast(@filter_ip as integer) then nc.ip = @ip
-    else 0
+    when cast(@filter_mac as integer) = 1 then nc.mac = @mac
+    when cast(@filter_ip as integer) = 1 then nc.ip = @ip
+    else false
   end
 limit 1;
 
 -- name: NodeResolve :many
-select nc.fqdn, nc.ip 
+select nc.fqdn, nc.ip, nc.ip6
 from nic as nc
 where 
   case 
-    when cast(@filter_fqdn as integer) then lower(nc.fqdn) like concat('%', cast(@fqdn as text), '%')
-    when cast(@filter_ip as integer) then substring(nc.ip, 0, instr(nc.ip, '/')) = cast(@ip as text)
-    else 0
+    when cast(@filter_fqdn as integer) = 1 then lower(nc.fqdn) like concat('%', cast(@fqdn as text), '%')
+    when cast(@filter_ip as integer) = 1 then substring(nc.ip, 0, instr(nc.ip, '/')) = cast(@ip as text)
+    when cast(@filter_ip6 as integer) = 1 then substring(nc.ip6, 0, instr(nc.ip6, '/')) = cast(@ip6 as text)
+    else false
   end;
 
 -- name: NodeAll :many
@@ -73,14 +74,14 @@ update node set provision = @provision
 where id in (sqlc.slice(nodes));
 
 -- name: NodeBootKernel :exec
-update node set kernel_id = @kernel_id
+update node set kernel_id = @kernel_id, kernel_version = @kernel_version
 where id in (sqlc.slice(nodes));
 
 -- name: NodeUpsert :one
-insert into node (id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware)
-values (sqlc.narg('id'), @uid, @name, @provision, @arch_id, @kernel_id, @node_type_id, @firmware)
+insert into node (id, uid, name, provision, arch_id, kernel_id, node_type_id, firmware, kernel_version)
+values (sqlc.narg('id'), @uid, @name, @provision, @arch_id, @kernel_id, @node_type_id, @firmware, @kernel_version)
 on conflict (id)
-do update set name = ?3, provision = ?4, arch_id = ?5, kernel_id = ?6, node_type_id = ?7, firmware = ?8
+do update set name = ?3, provision = ?4, arch_id = ?5, kernel_id = ?6, node_type_id = ?7, firmware = ?8, kernel_version = ?9
 returning *;
 
 -- name: NodeDelete :exec
@@ -0,0 +1,28 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: ProvisionEventCreate :exec
+insert into provision_event (host, state, message, created_at)
+values (@host, @state, @message, @created_at);
+
+-- name: ProvisionEventLatestByHost :one
+select * from provision_event
+where host = @host
+order by id desc
+limit 1;
+
+-- name: ProvisionEventFetchByHost :many
+select * from provision_event
+where host = @host
+order by id;
+
+-- name: ProvisionEventFetchLatest :many
+select * from provision_event as pe
+where pe.id = (select max(id) from provision_event where host = pe.host)
+order by pe.host;
+
+-- name: ProvisionEventDeleteByHost :exec
+delete from provision_event where host = @host;
@@ -0,0 +1,27 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: ProvisionLogCreate :exec
+insert into provision_log (host, kind, name, content, created_at)
+values (@host, @kind, @name, @content, @created_at);
+
+-- name: ProvisionLogFetchByHost :many
+select * from provision_log
+where host = @host
+order by id;
+
+-- name: ProvisionLogDeleteByHost :exec
+delete from provision_log where host = @host;
+
+-- name: ProvisionLogDeleteByName :exec
+delete from provision_log where host = @host and kind = @kind and name = @name;
+
+-- name: ProvisionLogSize :one
+select cast(coalesce(sum(length(content)), 0) as integer) as size from provision_log
+where host = @host;
+
+-- name: ProvisionLogPrune :execrows
+delete from provision_log where created_at < @before;
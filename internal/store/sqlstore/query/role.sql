@@ -7,8 +7,8 @@
 -- name: RoleFetchByMethodAndPath :many
 select role.name
 from permission
-inner join role on role_permission.role_id = role.id
 inner join role_permission on role_permission.permission_id = permission.id
+inner join role on role_permission.role_id = role.id
 where permission.method = @method and @path like permission.path;
 
 -- name: RoleFetchView :many
@@ -0,0 +1,24 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: RolloutCreate :one
+insert into rollout (image, version, from_version, nodes, canary, percent, state, previous, created_at, updated_at)
+values (@image, @version, @from_version, @nodes, @canary, @percent, @state, @previous, @created_at, @created_at)
+returning *;
+
+-- name: RolloutUpdateState :exec
+update rollout set state = @state, updated_at = @updated_at
+where id = @id;
+
+-- name: RolloutAll :many
+select * from rollout
+order by id;
+
+-- name: RolloutFetchLatest :one
+select * from rollout
+where image = @image
+order by id desc
+limit 1;
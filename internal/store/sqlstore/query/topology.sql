@@ -0,0 +1,30 @@
+/*
+ * SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+ *
+ * SPDX-License-Identifier: GPL-3.0-or-later
+ */
+
+-- name: TopologyLinkCreate :exec
+insert into topology_link (switch, port, mac, vlan, host, neighbor, neighbor_port, last_seen)
+values (@switch, @port, @mac, @vlan, @host, @neighbor, @neighbor_port, @last_seen);
+
+-- name: TopologyLinkDeleteBySwitch :exec
+delete from topology_link where switch = @switch;
+
+-- name: TopologyLinkFind :many
+select l.switch, l.port, l.mac, l.vlan, l.host, l.neighbor, l.neighbor_port, l.last_seen,
+  coalesce(e.switch, '') as expected_switch, coalesce(e.port, '') as expected_port
+from topology_link l
+left join topology_expected e on e.mac = l.mac and l.mac != ''
+where (l.switch = @switch or @switch = '')
+order by l.switch, l.port, l.mac;
+
+-- name: TopologyExpectedCreate :exec
+insert into topology_expected (mac, switch, port, first_seen)
+values (@mac, @switch, @port, @first_seen)
+on conflict (mac) do nothing;
+
+-- name: TopologyExpectedUpsert :exec
+insert into topology_expected (mac, switch, port, first_seen)
+values (@mac, @switch, @port, @first_seen)
+on conflict (mac) do update set switch = excluded.switch, port = excluded.port, first_seen = excluded.first_seen;
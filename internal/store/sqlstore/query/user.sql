@@ -5,7 +5,7 @@
  */
 
 -- name: UserCount :one
-select count(*) from user;
+select count(*) from "user";
 
 -- name: UserFetch :one
 select * from user_view where username = @username;
@@ -14,8 +14,8 @@ select * from user_view where username = @username;
 select * from user_view;
 
 -- name: UserCreate :one
-insert into user (username, password_hash, role_id, enabled) 
-select @username, @password_hash, role.id, @enabled
+insert into "user" (username, password_hash, role_id, enabled) 
+select @username, @password_hash, role.id, cast(@enabled as boolean)
 from role
 where role.name = @role
 on conflict (username)
@@ -23,17 +23,17 @@ do update set password_hash = ?2
 returning *;
 
 -- name: UserUpdateRole :exec
-update user set role_id = (
+update "user" set role_id = (
   select role.id
   from role
   where role.name = @role
 )
-where user.username = @username
+where "user".username = @username
 returning *;
 
 -- name: UserUpdateEnable :exec
-update user set enabled = @enabled where username = @username
+update "user" set enabled = @enabled where username = @username
 returning *;
 
 -- name: UserDelete :exec
-delete from user where username = @username;
+delete from "user" where username = @username;
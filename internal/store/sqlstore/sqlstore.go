@@ -10,9 +10,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"net/netip"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	null "github.com/guregu/null/v5"
 	_ "github.com/mattn/go-sqlite3"
@@ -30,11 +34,12 @@ import (
 // SqlStore implements a Grendel Store using sqlc
 type SqlStore struct {
 	q  *db.Queries
-	rw *sql.DB
-	ro *sql.DB
+	rw *sqlDB
+	ro *sqlDB
 }
 
-// New returns a new SqlStore using the given database filename. For memory only you can provide `:memory:`
+// New returns a new SqlStore using the given database filename. For memory only you can provide `:memory:`.
+// When using ConfigPostgres the filename is a PostgreSQL connection string.
 func New(filename string, config ...Config) (*SqlStore, error) {
 	cfg := configDefault(config...)
 
@@ -43,7 +48,12 @@ func New(filename string, config ...Config) (*SqlStore, error) {
 		return nil, err
 	}
 
-	migrator, err := migrations.New(rw)
+	var migrator *migrations.Migrator
+	if cfg.Postgres() {
+		migrator, err = migrations.NewPostgres(rw)
+	} else {
+		migrator, err = migrations.New(rw)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -71,6 +81,12 @@ func New(filename string, config ...Config) (*SqlStore, error) {
 		}).Info("Database migrated")
 	}
 
+	// PostgreSQL handles concurrent writers itself, sqlite needs a single
+	// writer connection and a separate read-only pool
+	if cfg.Postgres() {
+		return &SqlStore{rw: &sqlDB{rw}, ro: &sqlDB{rw}, q: db.New()}, nil
+	}
+
 	var ro *sql.DB
 	if filename != ":memory:" {
 		var err error
@@ -84,7 +100,7 @@ func New(filename string, config ...Config) (*SqlStore, error) {
 
 	rw.SetMaxOpenConns(1)
 
-	return &SqlStore{rw: rw, ro: ro, q: db.New()}, nil
+	return &SqlStore{rw: &sqlDB{rw}, ro: &sqlDB{ro}, q: db.New()}, nil
 }
 
 // StoreUser stores the User in the data store
@@ -243,14 +259,16 @@ func (s *SqlStore) StoreHosts(hosts model.HostList) error {
 
 		// Link kernel if exists
 		kernelID := null.NewInt(0, false)
+		kernelVersion := null.NewInt(0, false)
 		if h.BootImage != "" {
-			kernel, err := s.q.KernelFetch(ctx, tx, h.BootImage)
-			if errors.Is(err, sql.ErrNoRows) {
+			kid, version, err := s.bootImageID(tx, h.BootImage)
+			if errors.Is(err, store.ErrNotFound) {
 				return fmt.Errorf("boot image does not exist. image=%s node=%s", h.BootImage, h.Name)
 			} else if err != nil {
 				return err
 			}
-			kernelID.SetValid(kernel.ID)
+			kernelID.SetValid(kid)
+			kernelVersion = null.NewInt(int64(version), version != 0)
 		}
 
 		if h.UID.IsNil() {
@@ -262,12 +280,13 @@ func (s *SqlStore) StoreHosts(hosts model.HostList) error {
 
 		// Upsert node
 		node, err := s.q.NodeUpsert(ctx, tx, db.NodeUpsertParams{
-			ID:        null.NewInt(h.ID, h.ID != 0),
-			UID:       h.UID,
-			KernelID:  kernelID,
-			Name:      h.Name,
-			Provision: h.Provision,
-			Firmware:  null.NewString(h.Firmware.String(), !h.Firmware.IsNil()),
+			ID:            null.NewInt(h.ID, h.ID != 0),
+			UID:           h.UID,
+			KernelID:      kernelID,
+			KernelVersion: kernelVersion,
+			Name:          h.Name,
+			Provision:     h.Provision,
+			Firmware:      null.NewString(h.Firmware.String(), !h.Firmware.IsNil()),
 		})
 		if err != nil {
 			return err
@@ -313,15 +332,18 @@ func (s *SqlStore) StoreHosts(hosts model.HostList) error {
 				nt = model.NicTypeBMC
 			}
 			nc, err := s.q.NicUpsert(ctx, tx, db.NicUpsertParams{
-				ID:      null.NewInt(n.ID, n.ID != 0),
-				NodeID:  node.ID,
-				NicType: nt.String(),
-				Name:    null.NewString(n.Name, len(n.Name) != 0),
-				IP:      null.NewString(n.IP.String(), n.IP.IsValid()),
-				MAC:     null.NewString(n.MAC.String(), n.MAC != nil),
-				FQDN:    null.NewString(n.FQDN, len(n.FQDN) != 0),
-				VLAN:    null.NewString(n.VLAN, len(n.VLAN) != 0),
-				MTU:     null.NewInt(int64(n.MTU), n.MTU != 0),
+				ID:         null.NewInt(n.ID, n.ID != 0),
+				NodeID:     node.ID,
+				NicType:    nt.String(),
+				Name:       null.NewString(n.Name, len(n.Name) != 0),
+				IP:         null.NewString(n.IP.String(), n.IP.IsValid()),
+				IPv6:       null.NewString(n.IPv6.String(), n.IPv6.IsValid()),
+				Switch:     null.NewString(n.Switch, len(n.Switch) != 0),
+				SwitchPort: null.NewString(n.SwitchPort, len(n.SwitchPort) != 0),
+				MAC:        null.NewString(n.MAC.String(), n.MAC != nil),
+				FQDN:       null.NewString(n.FQDN, len(n.FQDN) != 0),
+				VLAN:       null.NewString(n.VLAN, len(n.VLAN) != 0),
+				MTU:        null.NewInt(int64(n.MTU), n.MTU != 0),
 			})
 			if err != nil {
 				return err
@@ -340,16 +362,19 @@ func (s *SqlStore) StoreHosts(hosts model.HostList) error {
 				peers.SetValid(string(pj))
 			}
 			bi, err := s.q.NicUpsert(ctx, tx, db.NicUpsertParams{
-				ID:      null.NewInt(n.ID, n.ID != 0),
-				NodeID:  node.ID,
-				NicType: model.NicTypeBond.String(),
-				Name:    null.NewString(n.Name, len(n.Name) != 0),
-				IP:      null.NewString(n.IP.String(), n.IP.IsValid()),
-				FQDN:    null.NewString(n.FQDN, len(n.FQDN) != 0),
-				VLAN:    null.NewString(n.VLAN, len(n.VLAN) != 0),
-				MTU:     null.NewInt(int64(n.MTU), n.MTU != 0),
-				MAC:     null.NewString(n.MAC.String(), n.MAC != nil),
-				Peers:   peers,
+				ID:         null.NewInt(n.ID, n.ID != 0),
+				NodeID:     node.ID,
+				NicType:    model.NicTypeBond.String(),
+				Name:       null.NewString(n.Name, len(n.Name) != 0),
+				IP:         null.NewString(n.IP.String(), n.IP.IsValid()),
+				IPv6:       null.NewString(n.IPv6.String(), n.IPv6.IsValid()),
+				Switch:     null.NewString(n.Switch, len(n.Switch) != 0),
+				SwitchPort: null.NewString(n.SwitchPort, len(n.SwitchPort) != 0),
+				FQDN:       null.NewString(n.FQDN, len(n.FQDN) != 0),
+				VLAN:       null.NewString(n.VLAN, len(n.VLAN) != 0),
+				MTU:        null.NewInt(int64(n.MTU), n.MTU != 0),
+				MAC:        null.NewString(n.MAC.String(), n.MAC != nil),
+				Peers:      peers,
 			})
 			if err != nil {
 				return err
@@ -416,39 +441,66 @@ func (s *SqlStore) LoadHostFromID(uid string) (*model.Host, error) {
 
 // ResolveIPv4 returns the list of IPv4 addresses with the given FQDN
 func (s *SqlStore) ResolveIPv4(fqdn string) ([]net.IP, error) {
+	rows, err := s.resolve(fqdn)
+	if err != nil {
+		return nil, err
+	}
+
+	ips := make([]net.IP, 0)
+	for _, row := range rows {
+		ip, _ := netip.ParsePrefix(row.IP.String)
+		if ip.IsValid() {
+			ips = append(ips, net.IP(ip.Addr().AsSlice()))
+		}
+	}
+
+	return ips, nil
+}
+
+// ResolveIPv6 returns the list of IPv6 addresses with the given FQDN
+func (s *SqlStore) ResolveIPv6(fqdn string) ([]net.IP, error) {
+	rows, err := s.resolve(fqdn)
+	if err != nil {
+		return nil, err
+	}
+
+	ips := make([]net.IP, 0)
+	for _, row := range rows {
+		ip, _ := netip.ParsePrefix(row.IPv6.String)
+		if ip.IsValid() {
+			ips = append(ips, net.IP(ip.Addr().AsSlice()))
+		}
+	}
+
+	return ips, nil
+}
+
+// resolve returns the nic rows with an exact match for the given FQDN
+func (s *SqlStore) resolve(fqdn string) ([]db.NodeResolveRow, error) {
 	if len(fqdn) == 0 {
 		return nil, errors.New("invalid fqdn")
 	}
 	fqdnString := strings.TrimSuffix(util.Normalize(fqdn), ".")
-	ips := make([]net.IP, 0)
 
 	rows, err := s.q.NodeResolve(context.Background(), s.ro, db.NodeResolveParams{FilterFQDN: 1, FQDN: fqdnString})
 	if err != nil {
 		return nil, err
 	}
 
+	matches := make([]db.NodeResolveRow, 0, len(rows))
 	for _, row := range rows {
 		// TODO: consider refactor data model to store fqdn as an array? for
 		// now this code ensures only exact fqdn exact matches are returned, as
 		// the sql fetches rows with like %% because of the comma separated string
-		exatcMatch := false
 		for _, name := range strings.Split(row.FQDN.String, ",") {
 			if strings.ToLower(name) == fqdnString {
-				exatcMatch = true
+				matches = append(matches, row)
 				break
 			}
 		}
-		if !exatcMatch {
-			continue
-		}
-
-		ip, _ := netip.ParsePrefix(row.IP.String)
-		if ip.IsValid() {
-			ips = append(ips, net.IP(ip.Addr().AsSlice()))
-		}
 	}
 
-	return ips, nil
+	return matches, nil
 }
 
 // ReverseResolve returns the list of FQDNs for the given IP
@@ -458,7 +510,13 @@ func (s *SqlStore) ReverseResolve(ip string) ([]string, error) {
 	}
 	fqdn := make([]string, 0)
 
-	rows, err := s.q.NodeResolve(context.Background(), s.ro, db.NodeResolveParams{FilterIP: 1, IP: ip})
+	params := db.NodeResolveParams{FilterIP: 1, IP: ip}
+	if addr, err := netip.ParseAddr(ip); err == nil && addr.Is6() && !addr.Is4In6() {
+		// IPv6 addresses are stored in canonical form
+		params = db.NodeResolveParams{FilterIPv6: 1, IPv6: addr.String()}
+	}
+
+	rows, err := s.q.NodeResolve(context.Background(), s.ro, params)
 	if err != nil {
 		return nil, err
 	}
@@ -639,20 +697,21 @@ func (s *SqlStore) UntagHosts(ns *nodeset.NodeSet, tags []string) error {
 	})
 }
 
-// SetBootImage sets all hosts to use the BootImage with the given name
+// SetBootImage sets all hosts to use the BootImage with the given name. A name
+// of the form name@version pins the hosts to that version of the BootImage
 func (s *SqlStore) SetBootImage(ns *nodeset.NodeSet, name string) error {
-	ctx := context.Background()
-
 	kernelID := null.NewInt(0, false)
+	kernelVersion := null.NewInt(0, false)
 	if name != "" {
-		kernel, err := s.q.KernelFetch(ctx, s.ro, name)
+		kid, version, err := s.bootImageID(s.ro, name)
 		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
+			if errors.Is(err, store.ErrNotFound) {
 				return fmt.Errorf("no boot kernel found %s:  %w", name, store.ErrNotFound)
 			}
 			return err
 		}
-		kernelID.SetValid(kernel.ID)
+		kernelID.SetValid(kid)
+		kernelVersion = null.NewInt(int64(version), version != 0)
 	}
 
 	nodeID, err := s.q.NodeID(context.Background(), s.ro, ns.Iterator().StringSlice())
@@ -664,11 +723,45 @@ func (s *SqlStore) SetBootImage(ns *nodeset.NodeSet, name string) error {
 	}
 
 	return s.q.NodeBootKernel(context.Background(), s.rw, db.NodeBootKernelParams{
-		Nodes:    nodeID,
-		KernelID: kernelID,
+		Nodes:         nodeID,
+		KernelID:      kernelID,
+		KernelVersion: kernelVersion,
 	})
 }
 
+// bootImageID returns the kernel ID and version of a boot image name of the
+// form name or name@version. The version is 0 for the current version.
+func (s *SqlStore) bootImageID(dbtx db.DBTX, name string) (int64, int, error) {
+	ctx := context.Background()
+	name, version, err := model.ParseBootImageName(name)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+	}
+
+	kernel, err := s.q.KernelFetch(ctx, dbtx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, 0, store.ErrNotFound
+		}
+		return 0, 0, err
+	}
+
+	if version != 0 {
+		_, err := s.q.BootImageVersionFetch(ctx, dbtx, db.BootImageVersionFetchParams{
+			KernelID: kernel.ID,
+			Version:  int64(version),
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return 0, 0, store.ErrNotFound
+			}
+			return 0, 0, err
+		}
+	}
+
+	return kernel.ID, version, nil
+}
+
 // StoreBootImage stores a boot image in the data store. If the boot image exists it is overwritten
 func (s *SqlStore) StoreBootImage(image *model.BootImage) error {
 	return s.StoreBootImages(model.BootImageList{image})
@@ -695,13 +788,20 @@ func (s *SqlStore) StoreBootImages(images model.BootImageList) error {
 			}
 		}
 		// Upsert kernel
+		if len(image.InitrdSHA256) != 0 && len(image.InitrdSHA256) != len(image.InitrdPaths) {
+			return fmt.Errorf("got %d initrd checksums for %d initrds in kernel %s: %w", len(image.InitrdSHA256), len(image.InitrdPaths), image.Name, store.ErrInvalidData)
+		}
+
 		kernel, err := s.q.KernelUpsert(ctx, tx, db.KernelUpsertParams{
-			ID:          null.NewInt(image.ID, image.ID != 0),
-			UID:         image.UID,
-			Name:        image.Name,
-			Path:        image.KernelPath,
-			CommandLine: null.NewString(image.CommandLine, len(image.CommandLine) != 0),
-			Verify:      image.Verify,
+			ID:            null.NewInt(image.ID, image.ID != 0),
+			UID:           image.UID,
+			Name:          image.Name,
+			Path:          image.KernelPath,
+			CommandLine:   null.NewString(image.CommandLine, len(image.CommandLine) != 0),
+			Verify:        image.Verify,
+			Liveimg:       null.NewString(image.LiveImage, image.LiveImage != ""),
+			KernelSha256:  null.NewString(image.KernelSHA256, image.KernelSHA256 != ""),
+			LiveimgSha256: null.NewString(image.LiveImageSHA256, image.LiveImageSHA256 != ""),
 		})
 		if err != nil {
 			return err
@@ -709,10 +809,15 @@ func (s *SqlStore) StoreBootImages(images model.BootImageList) error {
 
 		// Upsert initrd
 		initrdIDs := make([]int64, 0)
-		for _, rd := range image.InitrdPaths {
+		for i, rd := range image.InitrdPaths {
+			sum := ""
+			if len(image.InitrdSHA256) != 0 {
+				sum = image.InitrdSHA256[i]
+			}
 			ird, err := s.q.InitrdUpsert(ctx, tx, db.InitrdUpsertParams{
 				KernelID: kernel.ID,
 				Path:     rd,
+				Sha256:   null.NewString(sum, sum != ""),
 			})
 			if err != nil {
 				return err
@@ -756,11 +861,159 @@ func (s *SqlStore) StoreBootImages(images model.BootImageList) error {
 		}
 
 		image.ID = kernel.ID
+
+		version, err := s.storeBootImageVersion(tx, image.Name)
+		if err != nil {
+			return err
+		}
+		image.Version = version
 	}
 	return tx.Commit()
 }
 
-func (s *SqlStore) storeTemplate(tx *sql.Tx, kid int64, ttype, name string) (int64, error) {
+// storeBootImageVersion sets the current version of a boot image to the
+// version matching its stored contents, recording a new version if none match
+func (s *SqlStore) storeBootImageVersion(tx *sqlTx, name string) (int, error) {
+	ctx := context.Background()
+	kernel, err := s.q.KernelFetch(ctx, tx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	current, err := bootImageVersionJSON(&kernel.Image)
+	if err != nil {
+		return 0, err
+	}
+
+	versions, err := s.q.BootImageVersionFetchByKernel(ctx, tx, kernel.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	version := int64(0)
+	for _, v := range versions {
+		var image model.BootImage
+		if err := image.Scan(v.Image); err != nil {
+			return 0, err
+		}
+		data, err := bootImageVersionJSON(&image)
+		if err != nil {
+			return 0, err
+		}
+		if data == current {
+			version = v.Version
+			break
+		}
+	}
+
+	if version == 0 {
+		version = 1
+		if len(versions) > 0 {
+			version = versions[len(versions)-1].Version + 1
+		}
+		err = s.q.BootImageVersionCreate(ctx, tx, db.BootImageVersionCreateParams{
+			KernelID: kernel.ID,
+			Version:  version,
+			Image:    current,
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = s.q.KernelSetVersion(ctx, tx, db.KernelSetVersionParams{
+		ID:      kernel.ID,
+		Version: strconv.FormatInt(version, 10),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(version), nil
+}
+
+// bootImageVersionJSON returns the contents of a boot image that are versioned
+func bootImageVersionJSON(image *model.BootImage) (string, error) {
+	v := *image
+	v.ID = 0
+	v.UID = ksuid.Nil
+	v.Version = 0
+	if len(v.InitrdPaths) == 0 {
+		v.InitrdPaths = nil
+	}
+	if len(v.InitrdSHA256) == 0 {
+		v.InitrdSHA256 = nil
+	}
+	if len(v.ProvisionTemplates) == 0 {
+		v.ProvisionTemplates = nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// StoreArtifact records a boot image file stored under its checksum
+func (s *SqlStore) StoreArtifact(artifact *model.Artifact) error {
+	if artifact.SHA256 == "" {
+		return fmt.Errorf("sha256 required for artifact: %w", store.ErrInvalidData)
+	}
+
+	if artifact.Time.IsZero() {
+		artifact.Time = time.Now()
+	}
+	artifact.Time = artifact.Time.UTC()
+
+	return s.q.ArtifactUpsert(context.Background(), s.rw, db.ArtifactUpsertParams{
+		Sha256:    artifact.SHA256,
+		Size:      artifact.Size,
+		CreatedAt: artifact.Time,
+	})
+}
+
+// Artifacts returns all boot image files stored under their checksum
+func (s *SqlStore) Artifacts() (model.ArtifactList, error) {
+	artifacts, err := s.q.ArtifactAll(context.Background(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	return newArtifactList(artifacts), nil
+}
+
+// UnreferencedArtifacts returns the artifacts stored before the given time that
+// no boot image references
+func (s *SqlStore) UnreferencedArtifacts(before time.Time) (model.ArtifactList, error) {
+	artifacts, err := s.q.ArtifactFetchUnreferenced(context.Background(), s.ro, before.UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	return newArtifactList(artifacts), nil
+}
+
+// DeleteArtifact deletes the artifact with the given checksum
+func (s *SqlStore) DeleteArtifact(sha256 string) error {
+	return s.q.ArtifactDelete(context.Background(), s.rw, sha256)
+}
+
+func newArtifactList(artifacts []db.Artifact) model.ArtifactList {
+	artifactList := make(model.ArtifactList, len(artifacts))
+	for i, a := range artifacts {
+		artifactList[i] = &model.Artifact{
+			SHA256: a.Sha256,
+			Size:   a.Size,
+			Time:   a.CreatedAt,
+		}
+	}
+
+	return artifactList
+}
+
+func (s *SqlStore) storeTemplate(tx *sqlTx, kid int64, ttype, name string) (int64, error) {
 	ctx := context.Background()
 	tt, err := s.q.TemplateTypeUpsert(ctx, tx, db.TemplateTypeUpsertParams{
 		Name:    ttype,
@@ -794,9 +1047,145 @@ func (s *SqlStore) DeleteBootImages(names []string) error {
 	return s.q.KernelDelete(context.Background(), s.rw, names)
 }
 
-// LoadBootImage returns a BootImage with the given name
+// LoadBootImage returns a BootImage with the given name. A name of the form
+// name@version returns that version of the BootImage
 func (s *SqlStore) LoadBootImage(name string) (*model.BootImage, error) {
-	kernel, err := s.q.KernelFetch(context.Background(), s.ro, name)
+	ctx := context.Background()
+	name, version, err := model.ParseBootImageName(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", store.ErrInvalidData, err)
+	}
+
+	kernel, err := s.q.KernelFetch(ctx, s.ro, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	if version == 0 || version == kernel.Image.Version {
+		return &kernel.Image, nil
+	}
+
+	v, err := s.q.BootImageVersionFetch(ctx, s.ro, db.BootImageVersionFetchParams{
+		KernelID: kernel.ID,
+		Version:  int64(version),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	image, err := newBootImageVersion(kernel, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return image.Image, nil
+}
+
+// BootImageVersions returns all versions of the BootImage with the given name
+func (s *SqlStore) BootImageVersions(name string) (model.BootImageVersionList, error) {
+	ctx := context.Background()
+	kernel, err := s.q.KernelFetch(ctx, s.ro, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	versions, err := s.q.BootImageVersionFetchByKernel(ctx, s.ro, kernel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	versionList := make(model.BootImageVersionList, 0, len(versions))
+	for _, v := range versions {
+		version, err := newBootImageVersion(kernel, v)
+		if err != nil {
+			return nil, err
+		}
+		versionList = append(versionList, version)
+	}
+
+	return versionList, nil
+}
+
+// SetBootImageVersion makes a version of the BootImage with the given name the
+// current version
+func (s *SqlStore) SetBootImageVersion(name string, version int) error {
+	image, err := s.LoadBootImage(fmt.Sprintf("%s@%d", name, version))
+	if err != nil {
+		return err
+	}
+
+	return s.StoreBootImage(image)
+}
+
+func newBootImageVersion(kernel db.KernelView, v db.BootImageVersion) (*model.BootImageVersion, error) {
+	image := &model.BootImage{}
+	if err := image.Scan(v.Image); err != nil {
+		return nil, err
+	}
+	image.ID = kernel.ID
+	image.UID = kernel.Image.UID
+	image.Name = kernel.Name
+	image.Version = int(v.Version)
+
+	return &model.BootImageVersion{
+		Version: int(v.Version),
+		Current: v.Version == int64(kernel.Image.Version),
+		Image:   image,
+		Time:    v.CreatedAt,
+	}, nil
+}
+
+// StoreRollout stores a new boot image rollout
+func (s *SqlStore) StoreRollout(rollout *model.Rollout) error {
+	if rollout.Image == "" || rollout.Version == 0 {
+		return fmt.Errorf("image and version required for rollout: %w", store.ErrInvalidData)
+	}
+
+	if rollout.Time.IsZero() {
+		rollout.Time = time.Now()
+	}
+
+	previous, err := json.Marshal(rollout.Previous)
+	if err != nil {
+		return err
+	}
+
+	r, err := s.q.RolloutCreate(context.Background(), s.rw, db.RolloutCreateParams{
+		Image:       rollout.Image,
+		Version:     int64(rollout.Version),
+		FromVersion: int64(rollout.FromVersion),
+		Nodes:       rollout.Nodes,
+		Canary:      rollout.Canary,
+		Percent:     int64(rollout.Percent),
+		State:       rollout.State,
+		Previous:    string(previous),
+		CreatedAt:   rollout.Time.UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	newR, err := newRollout(r)
+	if err != nil {
+		return err
+	}
+
+	*rollout = *newR
+	return nil
+}
+
+// LoadRollout returns the latest rollout of the BootImage with the given name
+func (s *SqlStore) LoadRollout(image string) (*model.Rollout, error) {
+	r, err := s.q.RolloutFetchLatest(context.Background(), s.ro, image)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, store.ErrNotFound
@@ -804,7 +1193,56 @@ func (s *SqlStore) LoadBootImage(name string) (*model.BootImage, error) {
 		return nil, err
 	}
 
-	return &kernel.Image, nil
+	return newRollout(r)
+}
+
+// Rollouts returns all boot image rollouts
+func (s *SqlStore) Rollouts() (model.RolloutList, error) {
+	rollouts, err := s.q.RolloutAll(context.Background(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	rolloutList := make(model.RolloutList, len(rollouts))
+	for i, r := range rollouts {
+		rolloutList[i], err = newRollout(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rolloutList, nil
+}
+
+// SetRolloutState sets the state of the rollout with the given ID
+func (s *SqlStore) SetRolloutState(id int64, state string) error {
+	return s.q.RolloutUpdateState(context.Background(), s.rw, db.RolloutUpdateStateParams{
+		ID:        id,
+		State:     state,
+		UpdatedAt: time.Now().UTC(),
+	})
+}
+
+func newRollout(r db.Rollout) (*model.Rollout, error) {
+	rollout := &model.Rollout{
+		ID:          r.ID,
+		Image:       r.Image,
+		Version:     int(r.Version),
+		FromVersion: int(r.FromVersion),
+		Nodes:       r.Nodes,
+		Canary:      r.Canary,
+		Percent:     int(r.Percent),
+		State:       r.State,
+		Time:        r.CreatedAt,
+		Updated:     r.UpdatedAt,
+	}
+
+	err := json.Unmarshal([]byte(r.Previous), &rollout.Previous)
+	if err != nil {
+		return nil, err
+	}
+
+	return rollout, nil
 }
 
 // BootImages returns a list of all boot images
@@ -821,6 +1259,1077 @@ func (s *SqlStore) BootImages() (model.BootImageList, error) {
 	return imageList, nil
 }
 
+// LoadDHCPLease returns the dynamic DHCP lease for the given MAC address
+func (s *SqlStore) LoadDHCPLease(mac string) (*model.DHCPLease, error) {
+	lease, err := s.q.LeaseFetch(context.Background(), s.ro, mac)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return newDHCPLease(lease), nil
+}
+
+// StoreDHCPLease stores the dynamic DHCP lease, replacing any other lease
+// holding the same IP address
+func (s *SqlStore) StoreDHCPLease(lease *model.DHCPLease) error {
+	if lease.MAC == "" || !lease.IP.IsValid() {
+		return fmt.Errorf("mac and ip required for lease: %w", store.ErrInvalidData)
+	}
+
+	ctx := context.Background()
+	tx, err := s.rw.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = s.q.LeaseDeleteIP(ctx, tx, db.LeaseDeleteIPParams{
+		IP:  lease.IP.String(),
+		MAC: lease.MAC,
+	})
+	if err != nil {
+		return err
+	}
+
+	l, err := s.q.LeaseUpsert(ctx, tx, db.LeaseUpsertParams{
+		MAC:       lease.MAC,
+		IP:        lease.IP.String(),
+		ExpiresAt: lease.ExpiresAt.UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	*lease = *newDHCPLease(l)
+
+	return tx.Commit()
+}
+
+// DHCPLeases returns all dynamic DHCP leases
+func (s *SqlStore) DHCPLeases() (model.DHCPLeaseList, error) {
+	leases, err := s.q.LeaseList(context.Background(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	leaseList := make(model.DHCPLeaseList, len(leases))
+	for i, l := range leases {
+		leaseList[i] = newDHCPLease(l)
+	}
+
+	return leaseList, nil
+}
+
+// DeleteDHCPLease deletes the dynamic DHCP lease for the given MAC address
+func (s *SqlStore) DeleteDHCPLease(mac string) error {
+	return s.q.LeaseDelete(context.Background(), s.rw, mac)
+}
+
+func newDHCPLease(l db.DHCPLease) *model.DHCPLease {
+	ip, _ := netip.ParseAddr(l.IP)
+	return &model.DHCPLease{
+		ID:        l.ID,
+		MAC:       l.MAC,
+		IP:        ip,
+		ExpiresAt: l.ExpiresAt,
+		CreatedAt: l.CreatedAt,
+		UpdatedAt: l.UpdatedAt,
+	}
+}
+
+// StoreDHCPEvent records a DHCP message in the boot history
+func (s *SqlStore) StoreDHCPEvent(event *model.DHCPEvent) error {
+	if event.MAC == "" || event.MessageType == "" {
+		return fmt.Errorf("mac and message type required for dhcp event: %w", store.ErrInvalidData)
+	}
+
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	event.Time = event.Time.UTC()
+
+	ip, relayIP := "", ""
+	if event.IP.IsValid() {
+		ip = event.IP.String()
+	}
+	if event.RelayIP.IsValid() {
+		relayIP = event.RelayIP.String()
+	}
+
+	return s.q.DHCPEventCreate(context.Background(), s.rw, db.DHCPEventCreateParams{
+		MessageType: event.MessageType,
+		MAC:         event.MAC,
+		Host:        event.Host,
+		IP:          ip,
+		RelayIP:     relayIP,
+		Firmware:    event.Firmware,
+		CreatedAt:   event.Time,
+	})
+}
+
+// DHCPEvents returns the DHCP boot history for the given host name since the
+// given time
+func (s *SqlStore) DHCPEvents(host string, since time.Time) (model.DHCPEventList, error) {
+	events, err := s.q.DHCPEventFetchByHost(context.Background(), s.ro, db.DHCPEventFetchByHostParams{
+		Host:  host,
+		Since: since.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eventList := make(model.DHCPEventList, len(events))
+	for i, e := range events {
+		ip, _ := netip.ParseAddr(e.IP)
+		relayIP, _ := netip.ParseAddr(e.RelayIP)
+		eventList[i] = &model.DHCPEvent{
+			ID:          e.ID,
+			MessageType: e.MessageType,
+			MAC:         e.MAC,
+			Host:        e.Host,
+			IP:          ip,
+			RelayIP:     relayIP,
+			Firmware:    e.Firmware,
+			Time:        e.CreatedAt,
+		}
+	}
+
+	return eventList, nil
+}
+
+// PruneDHCPEvents deletes DHCP boot history older than the given time
+func (s *SqlStore) PruneDHCPEvents(before time.Time) error {
+	return s.q.DHCPEventPrune(context.Background(), s.rw, before.UTC())
+}
+
+// StoreProvisionEvent records a host entering a provision state unless it is
+// already in that state. A requested state starts a new provision and clears
+// the previous events of the host.
+func (s *SqlStore) StoreProvisionEvent(event *model.ProvisionEvent) error {
+	if event.Host == "" || event.State == "" {
+		return fmt.Errorf("host and state required for provision event: %w", store.ErrInvalidData)
+	}
+
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	event.Time = event.Time.UTC()
+
+	ctx := context.Background()
+	tx, err := s.rw.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if event.State == model.ProvisionStateRequested {
+		err = s.q.ProvisionEventDeleteByHost(ctx, tx, event.Host)
+		if err != nil {
+			return err
+		}
+	} else {
+		latest, err := s.q.ProvisionEventLatestByHost(ctx, tx, event.Host)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil && latest.State == event.State && latest.Message == event.Message {
+			return nil
+		}
+	}
+
+	err = s.q.ProvisionEventCreate(ctx, tx, db.ProvisionEventCreateParams{
+		Host:      event.Host,
+		State:     event.State,
+		Message:   event.Message,
+		CreatedAt: event.Time,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ProvisionEvents returns the provision states of the given host name since it
+// was last requested to provision
+func (s *SqlStore) ProvisionEvents(host string) (model.ProvisionEventList, error) {
+	events, err := s.q.ProvisionEventFetchByHost(context.Background(), s.ro, host)
+	if err != nil {
+		return nil, err
+	}
+
+	return newProvisionEventList(events), nil
+}
+
+// ProvisionStates returns the current provision state of all hosts
+func (s *SqlStore) ProvisionStates() (model.ProvisionEventList, error) {
+	events, err := s.q.ProvisionEventFetchLatest(context.Background(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	return newProvisionEventList(events), nil
+}
+
+// StoreProvisionLog stores a progress message or log file sent by a host
+func (s *SqlStore) StoreProvisionLog(log *model.ProvisionLog) error {
+	if log.Host == "" || log.Kind == "" {
+		return fmt.Errorf("host and kind required for provision log: %w", store.ErrInvalidData)
+	}
+
+	if log.Time.IsZero() {
+		log.Time = time.Now()
+	}
+	log.Time = log.Time.UTC()
+
+	tx, err := s.rw.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// A log file replaces the earlier upload of the same name
+	if log.Kind == model.ProvisionLogFile {
+		err = s.q.ProvisionLogDeleteByName(context.Background(), tx, db.ProvisionLogDeleteByNameParams{
+			Host: log.Host,
+			Kind: log.Kind,
+			Name: log.Name,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.q.ProvisionLogCreate(context.Background(), tx, db.ProvisionLogCreateParams{
+		Host:      log.Host,
+		Kind:      log.Kind,
+		Name:      log.Name,
+		Content:   log.Content,
+		CreatedAt: log.Time,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ProvisionLogs returns the progress messages and log files sent by the given
+// host name
+func (s *SqlStore) ProvisionLogs(host string) (model.ProvisionLogList, error) {
+	logs, err := s.q.ProvisionLogFetchByHost(context.Background(), s.ro, host)
+	if err != nil {
+		return nil, err
+	}
+
+	logList := make(model.ProvisionLogList, len(logs))
+	for i, l := range logs {
+		logList[i] = &model.ProvisionLog{
+			ID:      l.ID,
+			Host:    l.Host,
+			Kind:    l.Kind,
+			Name:    l.Name,
+			Content: l.Content,
+			Time:    l.CreatedAt,
+		}
+	}
+
+	return logList, nil
+}
+
+// ProvisionLogSize returns the total size of the progress messages and log
+// files sent by the given host name
+func (s *SqlStore) ProvisionLogSize(host string) (int64, error) {
+	return s.q.ProvisionLogSize(context.Background(), s.ro, host)
+}
+
+// PruneProvisionLogs deletes progress messages and log files older than the
+// given time and returns the number deleted
+func (s *SqlStore) PruneProvisionLogs(before time.Time) (int64, error) {
+	return s.q.ProvisionLogPrune(context.Background(), s.rw, before.UTC())
+}
+
+// DeleteProvisionLogs deletes the progress messages and log files sent by the
+// given host name
+func (s *SqlStore) DeleteProvisionLogs(host string) error {
+	return s.q.ProvisionLogDeleteByHost(context.Background(), s.rw, host)
+}
+
+func newProvisionEventList(events []db.ProvisionEvent) model.ProvisionEventList {
+	eventList := make(model.ProvisionEventList, len(events))
+	for i, e := range events {
+		eventList[i] = &model.ProvisionEvent{
+			ID:      e.ID,
+			Host:    e.Host,
+			State:   e.State,
+			Message: e.Message,
+			Time:    e.CreatedAt,
+		}
+	}
+
+	return eventList
+}
+
+// StoreEvent records an audit event along with the hosts it refers to
+func (s *SqlStore) StoreEvent(event *model.Event) error {
+	if event.Severity == "" || event.Message == "" {
+		return fmt.Errorf("severity and message required for event: %w", store.ErrInvalidData)
+	}
+
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	event.Time = event.Time.UTC()
+
+	jobMessages := event.JobMessages
+	if jobMessages == nil {
+		jobMessages = model.JobMessageList{}
+	}
+	jobJSON, err := json.Marshal(jobMessages)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	tx, err := s.rw.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	id, err := s.q.EventCreate(ctx, tx, db.EventCreateParams{
+		Severity:    event.Severity,
+		UserName:    event.User,
+		Message:     event.Message,
+		JobMessages: string(jobJSON),
+		CreatedAt:   event.Time,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, host := range event.Hosts {
+		err := s.q.EventHostCreate(ctx, tx, db.EventHostCreateParams{
+			EventID: id,
+			Host:    host,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	event.ID = id
+	return nil
+}
+
+// eventHostBatch is the most event ids bound in a single query
+const eventHostBatch = 1000
+
+// Events returns the audit events matching the filter, newest first
+func (s *SqlStore) Events(filter model.EventFilter) (model.EventList, error) {
+	until := filter.Until
+	if until.IsZero() {
+		until = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	}
+
+	limit := int64(filter.Limit)
+	if limit <= 0 {
+		limit = math.MaxInt32
+	}
+
+	ctx := context.Background()
+	events, err := s.q.EventFind(ctx, s.ro, db.EventFindParams{
+		UserName: filter.User,
+		Severity: filter.Severity,
+		Host:     filter.Host,
+		Since:    filter.Since.UTC(),
+		Until:    until.UTC(),
+		Limit:    limit,
+		Offset:   int64(max(filter.Offset, 0)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(events))
+	for i, e := range events {
+		ids[i] = e.ID
+	}
+
+	// Fetch the hosts in batches to stay below the SQLite variable limit
+	hosts := make(map[int64][]string)
+	for batch := range slices.Chunk(ids, eventHostBatch) {
+		eventHosts, err := s.q.EventHostFetch(ctx, s.ro, batch)
+		if err != nil {
+			return nil, err
+		}
+		for _, eh := range eventHosts {
+			hosts[eh.EventID] = append(hosts[eh.EventID], eh.Host)
+		}
+	}
+
+	eventList := make(model.EventList, len(events))
+	for i, e := range events {
+		jobMessages := model.JobMessageList{}
+		if err := json.Unmarshal([]byte(e.JobMessages), &jobMessages); err != nil {
+			return nil, err
+		}
+
+		eventHosts := hosts[e.ID]
+		if eventHosts == nil {
+			eventHosts = []string{}
+		}
+
+		eventList[i] = model.Event{
+			ID:          e.ID,
+			Severity:    e.Severity,
+			Time:        e.CreatedAt,
+			User:        e.UserName,
+			Message:     e.Message,
+			Hosts:       eventHosts,
+			JobMessages: jobMessages,
+		}
+	}
+
+	return eventList, nil
+}
+
+// PruneEvents deletes audit events older than the given time
+func (s *SqlStore) PruneEvents(before time.Time) (int64, error) {
+	return s.q.EventPrune(context.Background(), s.rw, before.UTC())
+}
+
+// StoreBmcTask creates a BMC task with every host pending
+func (s *SqlStore) StoreBmcTask(task *model.BmcTask) error {
+	if task.ID == "" || task.Operation == "" {
+		return fmt.Errorf("id and operation required for bmc task: %w", store.ErrInvalidData)
+	}
+
+	if task.Status == "" {
+		task.Status = model.BmcTaskQueued
+	}
+	if task.CreatedAt.IsZero() {
+		task.CreatedAt = time.Now()
+	}
+	task.CreatedAt = task.CreatedAt.UTC()
+	task.UpdatedAt = task.CreatedAt
+
+	ctx := context.Background()
+	tx, err := s.rw.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = s.q.BmcTaskCreate(ctx, tx, db.BmcTaskCreateParams{
+		ID:        task.ID,
+		Operation: task.Operation,
+		Status:    task.Status,
+		UserName:  task.User,
+		Owner:     task.Owner,
+		CreatedAt: task.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, m := range task.Hosts {
+		if m.Status == "" {
+			task.Hosts[i].Status = model.BmcTaskHostPending
+		}
+		err := s.q.BmcTaskHostCreate(ctx, tx, db.BmcTaskHostCreateParams{
+			TaskID:    task.ID,
+			Host:      m.Host,
+			Status:    task.Hosts[i].Status,
+			UpdatedAt: task.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// UpdateBmcTask sets the status and error of a BMC task
+func (s *SqlStore) UpdateBmcTask(id, status, taskError string) error {
+	return s.q.BmcTaskUpdate(context.Background(), s.rw, db.BmcTaskUpdateParams{
+		Status:    status,
+		Error:     taskError,
+		UpdatedAt: time.Now().UTC(),
+		ID:        id,
+	})
+}
+
+// StoreBmcTaskResult records the result of a BMC task on a single host
+func (s *SqlStore) StoreBmcTaskResult(id string, m model.JobMessage) error {
+	return s.q.BmcTaskHostUpdate(context.Background(), s.rw, db.BmcTaskHostUpdateParams{
+		Status:    m.Status,
+		Msg:       m.Msg,
+		Data:      m.Data,
+		UpdatedAt: time.Now().UTC(),
+		TaskID:    id,
+		Host:      m.Host,
+	})
+}
+
+// LoadBmcTask returns the BMC task with the given id along with the status of
+// each host
+func (s *SqlStore) LoadBmcTask(id string) (*model.BmcTask, error) {
+	ctx := context.Background()
+	t, err := s.q.BmcTaskFetch(ctx, s.ro, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	hosts, err := s.q.BmcTaskHostFetch(ctx, s.ro, id)
+	if err != nil {
+		return nil, err
+	}
+
+	task := newBmcTask(t)
+	task.Hosts = make(model.JobMessageList, len(hosts))
+	for i, h := range hosts {
+		task.Hosts[i] = model.JobMessage{
+			Status: h.Status,
+			Host:   h.Host,
+			Msg:    h.Msg,
+			Data:   h.Data,
+		}
+	}
+
+	return task, nil
+}
+
+// BmcTasks returns up to limit BMC tasks with the given status, or any status
+// if empty, newest first
+func (s *SqlStore) BmcTasks(status string, limit int) (model.BmcTaskList, error) {
+	if limit <= 0 {
+		limit = math.MaxInt32
+	}
+
+	tasks, err := s.q.BmcTaskFind(context.Background(), s.ro, db.BmcTaskFindParams{
+		Status: status,
+		Limit:  int64(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	taskList := make(model.BmcTaskList, len(tasks))
+	for i, t := range tasks {
+		taskList[i] = newBmcTask(t)
+	}
+
+	return taskList, nil
+}
+
+// InterruptBmcTasks fails the unfinished BMC tasks run by the given owner
+func (s *SqlStore) InterruptBmcTasks(owner string) (int64, error) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	tx, err := s.rw.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	err = s.q.BmcTaskHostInterrupt(ctx, tx, db.BmcTaskHostInterruptParams{
+		Status:    model.BmcTaskHostCanceled,
+		UpdatedAt: now,
+		Owner:     owner,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := s.q.BmcTaskInterrupt(ctx, tx, db.BmcTaskInterruptParams{
+		Status:    model.BmcTaskFailed,
+		Error:     "task interrupted by restart",
+		UpdatedAt: now,
+		Owner:     owner,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, tx.Commit()
+}
+
+// PruneBmcTasks deletes finished BMC tasks last updated before the given time
+func (s *SqlStore) PruneBmcTasks(before time.Time) (int64, error) {
+	return s.q.BmcTaskPrune(context.Background(), s.rw, before.UTC())
+}
+
+func newBmcTask(t db.BmcTask) *model.BmcTask {
+	return &model.BmcTask{
+		ID:        t.ID,
+		Operation: t.Operation,
+		Status:    t.Status,
+		User:      t.UserName,
+		Owner:     t.Owner,
+		Error:     t.Error,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// StoreBmcCredential creates or replaces the BMC credential of a host or tag
+func (s *SqlStore) StoreBmcCredential(cred *model.BmcCredential) error {
+	switch cred.Scope {
+	case model.BmcCredentialHost, model.BmcCredentialTag, model.BmcCredentialPending:
+	default:
+		return fmt.Errorf("invalid bmc credential scope %q: %w", cred.Scope, store.ErrInvalidData)
+	}
+
+	if cred.Name == "" || cred.Username == "" || cred.Password == "" {
+		return fmt.Errorf("name, username and password required for bmc credential: %w", store.ErrInvalidData)
+	}
+
+	cred.UpdatedAt = time.Now().UTC()
+
+	return s.q.BmcCredentialUpsert(context.Background(), s.rw, db.BmcCredentialUpsertParams{
+		Scope:     cred.Scope,
+		Name:      cred.Name,
+		Username:  cred.Username,
+		Password:  cred.Password,
+		UpdatedAt: cred.UpdatedAt,
+	})
+}
+
+// LoadBmcCredential returns the BMC credential of the given scope and name
+func (s *SqlStore) LoadBmcCredential(scope, name string) (*model.BmcCredential, error) {
+	c, err := s.q.BmcCredentialFetch(context.Background(), s.ro, db.BmcCredentialFetchParams{
+		Scope: scope,
+		Name:  name,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return newBmcCredential(c), nil
+}
+
+// BmcCredentials returns all BMC credentials ordered by scope and name
+func (s *SqlStore) BmcCredentials() (model.BmcCredentialList, error) {
+	creds, err := s.q.BmcCredentialFind(context.Background(), s.ro)
+	if err != nil {
+		return nil, err
+	}
+
+	credList := make(model.BmcCredentialList, len(creds))
+	for i, c := range creds {
+		credList[i] = newBmcCredential(c)
+	}
+
+	return credList, nil
+}
+
+// DeleteBmcCredential deletes the BMC credential of the given scope and name
+func (s *SqlStore) DeleteBmcCredential(scope, name string) error {
+	n, err := s.q.BmcCredentialDelete(context.Background(), s.rw, db.BmcCredentialDeleteParams{
+		Scope: scope,
+		Name:  name,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return store.ErrNotFound
+	}
+
+	return nil
+}
+
+func newBmcCredential(c db.BmcCredential) *model.BmcCredential {
+	return &model.BmcCredential{
+		Scope:     c.Scope,
+		Name:      c.Name,
+		Username:  c.Username,
+		Password:  c.Password,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// StoreBmcMedia records the virtual media inserted on the BMC of a host
+func (s *SqlStore) StoreBmcMedia(media *model.BmcMedia) error {
+	if media.Host == "" {
+		return fmt.Errorf("host required for bmc media: %w", store.ErrInvalidData)
+	}
+
+	media.InsertedAt = time.Now().UTC()
+
+	return s.q.BmcMediaUpsert(context.Background(), s.rw, db.BmcMediaUpsertParams{
+		Host:       media.Host,
+		Image:      media.Image,
+		InsertedAt: media.InsertedAt,
+	})
+}
+
+// LoadBmcMedia returns the virtual media inserted on the BMC of a host
+func (s *SqlStore) LoadBmcMedia(host string) (*model.BmcMedia, error) {
+	m, err := s.q.BmcMediaFetch(context.Background(), s.ro, host)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &model.BmcMedia{
+		Host:       m.Host,
+		Image:      m.Image,
+		InsertedAt: m.InsertedAt,
+	}, nil
+}
+
+// DeleteBmcMedia deletes the virtual media recorded for a host
+func (s *SqlStore) DeleteBmcMedia(host string) error {
+	n, err := s.q.BmcMediaDelete(context.Background(), s.rw, host)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return store.ErrNotFound
+	}
+
+	return nil
+}
+
+// StoreInventory replaces the inventory of the given host and returns the
+// changes from its previous inventory
+func (s *SqlStore) StoreInventory(host string, components model.InventoryComponentList) (model.InventoryChangeList, error) {
+	if host == "" {
+		return nil, fmt.Errorf("host required for inventory: %w", store.ErrInvalidData)
+	}
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	tx, err := s.rw.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := s.q.InventoryFind(ctx, tx, db.InventoryFindParams{Host: host})
+	if err != nil {
+		return nil, err
+	}
+
+	old := make(model.InventoryComponentList, len(rows))
+	for i, r := range rows {
+		old[i] = newInventoryComponent(r)
+	}
+
+	err = s.q.InventoryDeleteByHost(ctx, tx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range components {
+		if c.Kind == "" || c.Slot == "" {
+			return nil, fmt.Errorf("kind and slot required for inventory component: %w", store.ErrInvalidData)
+		}
+		c.Host = host
+		c.CollectedAt = now
+		err := s.q.InventoryCreate(ctx, tx, db.InventoryCreateParams{
+			Host:         c.Host,
+			Kind:         c.Kind,
+			Slot:         c.Slot,
+			Name:         c.Name,
+			Manufacturer: c.Manufacturer,
+			Model:        c.Model,
+			Serial:       c.Serial,
+			PartNumber:   c.PartNumber,
+			Version:      c.Version,
+			Capacity:     c.Capacity,
+			Address:      c.Address,
+			CollectedAt:  c.CollectedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes := make(model.InventoryChangeList, 0)
+	if len(old) > 0 {
+		changes = model.DiffInventory(host, old, components, now)
+	}
+
+	for _, c := range changes {
+		err := s.q.InventoryChangeCreate(ctx, tx, db.InventoryChangeCreateParams{
+			Host:       c.Host,
+			Kind:       c.Kind,
+			Slot:       c.Slot,
+			Change:     c.Change,
+			Old:        c.Old,
+			New:        c.New,
+			DetectedAt: c.DetectedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, tx.Commit()
+}
+
+// Inventory returns the inventory components of the given host and kind, or
+// of all hosts or kinds if empty
+func (s *SqlStore) Inventory(host, kind string) (model.InventoryComponentList, error) {
+	rows, err := s.q.InventoryFind(context.Background(), s.ro, db.InventoryFindParams{
+		Host: host,
+		Kind: kind,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	components := make(model.InventoryComponentList, len(rows))
+	for i, r := range rows {
+		components[i] = newInventoryComponent(r)
+	}
+
+	return components, nil
+}
+
+// InventoryChanges returns the inventory changes of the given host, or of all
+// hosts if empty, since the given time
+func (s *SqlStore) InventoryChanges(host string, since time.Time) (model.InventoryChangeList, error) {
+	rows, err := s.q.InventoryChangeFind(context.Background(), s.ro, db.InventoryChangeFindParams{
+		Host:  host,
+		Since: since.UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(model.InventoryChangeList, len(rows))
+	for i, r := range rows {
+		changes[i] = &model.InventoryChange{
+			ID:         r.ID,
+			Host:       r.Host,
+			Kind:       r.Kind,
+			Slot:       r.Slot,
+			Change:     r.Change,
+			Old:        r.Old,
+			New:        r.New,
+			DetectedAt: r.DetectedAt,
+		}
+	}
+
+	return changes, nil
+}
+
+func newInventoryComponent(r db.Inventory) *model.InventoryComponent {
+	return &model.InventoryComponent{
+		Host:         r.Host,
+		Kind:         r.Kind,
+		Slot:         r.Slot,
+		Name:         r.Name,
+		Manufacturer: r.Manufacturer,
+		Model:        r.Model,
+		Serial:       r.Serial,
+		PartNumber:   r.PartNumber,
+		Version:      r.Version,
+		Capacity:     r.Capacity,
+		Address:      r.Address,
+		CollectedAt:  r.CollectedAt,
+	}
+}
+
+// StoreTopology replaces the links seen on the ports of the given switch and
+// records the expected port of host MAC addresses seen for the first time
+func (s *SqlStore) StoreTopology(switchName string, links model.TopologyLinkList) error {
+	if switchName == "" {
+		return fmt.Errorf("switch required for topology: %w", store.ErrInvalidData)
+	}
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	tx, err := s.rw.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = s.q.TopologyLinkDeleteBySwitch(ctx, tx, switchName)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range links {
+		if l.Port == "" {
+			return fmt.Errorf("port required for topology link: %w", store.ErrInvalidData)
+		}
+		l.Switch = switchName
+		l.LastSeen = now
+		err := s.q.TopologyLinkCreate(ctx, tx, db.TopologyLinkCreateParams{
+			Switch:       l.Switch,
+			Port:         l.Port,
+			Mac:          l.MAC,
+			Vlan:         l.VLAN,
+			Host:         l.Host,
+			Neighbor:     l.Neighbor,
+			NeighborPort: l.NeighborPort,
+			LastSeen:     l.LastSeen,
+		})
+		if err != nil {
+			return err
+		}
+
+		if l.MAC == "" || l.Host == "" {
+			continue
+		}
+		err = s.q.TopologyExpectedCreate(ctx, tx, db.TopologyExpectedCreateParams{
+			Mac:       l.MAC,
+			Switch:    l.Switch,
+			Port:      l.Port,
+			FirstSeen: now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Topology returns the links seen on the ports of the given switch, or of all
+// switches if empty
+func (s *SqlStore) Topology(switchName string) (model.TopologyLinkList, error) {
+	rows, err := s.q.TopologyLinkFind(context.Background(), s.ro, switchName)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make(model.TopologyLinkList, len(rows))
+	for i, r := range rows {
+		links[i] = &model.TopologyLink{
+			Switch:         r.Switch,
+			Port:           r.Port,
+			MAC:            r.Mac,
+			VLAN:           r.Vlan,
+			Host:           r.Host,
+			Neighbor:       r.Neighbor,
+			NeighborPort:   r.NeighborPort,
+			ExpectedSwitch: r.ExpectedSwitch,
+			ExpectedPort:   r.ExpectedPort,
+			LastSeen:       r.LastSeen,
+		}
+		links[i].Moved = r.ExpectedSwitch != "" && (r.ExpectedSwitch != r.Switch || r.ExpectedPort != r.Port)
+	}
+
+	return links, nil
+}
+
+// AcceptTopology makes the ports the MAC addresses of the given hosts, or all
+// hosts if nil, are seen on their expected ports
+func (s *SqlStore) AcceptTopology(hosts []string) (int, error) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	tx, err := s.rw.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := s.q.TopologyLinkFind(ctx, tx, "")
+	if err != nil {
+		return 0, err
+	}
+
+	accepted := 0
+	for _, r := range rows {
+		if r.Mac == "" || r.Host == "" || (hosts != nil && !slices.Contains(hosts, r.Host)) {
+			continue
+		}
+		err := s.q.TopologyExpectedUpsert(ctx, tx, db.TopologyExpectedUpsertParams{
+			Mac:       r.Mac,
+			Switch:    r.Switch,
+			Port:      r.Port,
+			FirstSeen: now,
+		})
+		if err != nil {
+			return 0, err
+		}
+		accepted++
+	}
+
+	return accepted, tx.Commit()
+}
+
+// AcquireLeaderLease acquires or renews the named leader lease for the given
+// holder if it is free, expired or already held by the holder and returns the
+// current lease
+func (s *SqlStore) AcquireLeaderLease(name, holder string, ttl time.Duration) (*model.LeaderLease, error) {
+	if name == "" || holder == "" {
+		return nil, fmt.Errorf("name and holder required for leader lease: %w", store.ErrInvalidData)
+	}
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	lease, err := s.q.LeaderLeaseAcquire(ctx, s.rw, db.LeaderLeaseAcquireParams{
+		Name:      name,
+		Holder:    holder,
+		Now:       now,
+		ExpiresAt: now.Add(ttl),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// Held by another instance
+		lease, err = s.q.LeaderLeaseFetch(ctx, s.rw, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newLeaderLease(lease), nil
+}
+
+// LoadLeaderLease returns the named leader lease
+func (s *SqlStore) LoadLeaderLease(name string) (*model.LeaderLease, error) {
+	lease, err := s.q.LeaderLeaseFetch(context.Background(), s.ro, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, store.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return newLeaderLease(lease), nil
+}
+
+// ReleaseLeaderLease releases the named leader lease if it is held by the
+// given holder
+func (s *SqlStore) ReleaseLeaderLease(name, holder string) error {
+	return s.q.LeaderLeaseRelease(context.Background(), s.rw, db.LeaderLeaseReleaseParams{
+		Name:   name,
+		Holder: holder,
+	})
+}
+
+func newLeaderLease(l db.LeaderLease) *model.LeaderLease {
+	return &model.LeaderLease{
+		Name:       l.Name,
+		Holder:     l.Holder,
+		AcquiredAt: l.AcquiredAt,
+		ExpiresAt:  l.ExpiresAt,
+	}
+}
+
 // RestoreFrom restores the database using the provided data dump
 func (s *SqlStore) RestoreFrom(data model.DataDump) error {
 	ctx := context.Background()
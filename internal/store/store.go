@@ -7,6 +7,7 @@ package store
 
 import (
 	"net"
+	"time"
 
 	"github.com/ubccr/grendel/internal/logger"
 	"github.com/ubccr/grendel/pkg/model"
@@ -43,9 +44,17 @@ type Store interface {
 	// BootImages returns a list of all boot images
 	BootImages() (model.BootImageList, error)
 
-	// LoadBootImage returns a BootImage with the given name
+	// LoadBootImage returns a BootImage with the given name. A name of the form
+	// name@version returns that version of the BootImage
 	LoadBootImage(name string) (*model.BootImage, error)
 
+	// BootImageVersions returns all versions of the BootImage with the given name
+	BootImageVersions(name string) (model.BootImageVersionList, error)
+
+	// SetBootImageVersion makes a version of the BootImage with the given name
+	// the current version
+	SetBootImageVersion(name string, version int) error
+
 	// StoreBootImage stores the BootImage in the data store
 	StoreBootImage(image *model.BootImage) error
 
@@ -55,9 +64,35 @@ type Store interface {
 	// DeleteBootImages delete BootImages from the data store
 	DeleteBootImages(names []string) error
 
-	// SetBootImage sets all hosts to use the BootImage with the given name
+	// StoreArtifact records a boot image file stored under its checksum
+	StoreArtifact(artifact *model.Artifact) error
+
+	// Artifacts returns all boot image files stored under their checksum
+	Artifacts() (model.ArtifactList, error)
+
+	// UnreferencedArtifacts returns the artifacts stored before the given time
+	// that no boot image references
+	UnreferencedArtifacts(before time.Time) (model.ArtifactList, error)
+
+	// DeleteArtifact deletes the artifact with the given checksum
+	DeleteArtifact(sha256 string) error
+
+	// SetBootImage sets all hosts to use the BootImage with the given name. A
+	// name of the form name@version pins the hosts to that version
 	SetBootImage(ns *nodeset.NodeSet, name string) error
 
+	// StoreRollout stores a new boot image rollout
+	StoreRollout(rollout *model.Rollout) error
+
+	// LoadRollout returns the latest rollout of the BootImage with the given name
+	LoadRollout(image string) (*model.Rollout, error)
+
+	// Rollouts returns all boot image rollouts
+	Rollouts() (model.RolloutList, error)
+
+	// SetRolloutState sets the state of the rollout with the given ID
+	SetRolloutState(id int64, state string) error
+
 	// Hosts returns a list of all the hosts
 	Hosts() (model.HostList, error)
 
@@ -100,9 +135,167 @@ type Store interface {
 	// ResolveIPv4 returns the list of IPv4 addresses with the given FQDN
 	ResolveIPv4(fqdn string) ([]net.IP, error)
 
+	// ResolveIPv6 returns the list of IPv6 addresses with the given FQDN
+	ResolveIPv6(fqdn string) ([]net.IP, error)
+
 	// ReverseResolve returns the list of FQDNs for the given IP
 	ReverseResolve(ip string) ([]string, error)
 
+	// LoadDHCPLease returns the dynamic DHCP lease for the given MAC address
+	LoadDHCPLease(mac string) (*model.DHCPLease, error)
+
+	// StoreDHCPLease stores the dynamic DHCP lease, replacing any other lease
+	// holding the same IP address
+	StoreDHCPLease(lease *model.DHCPLease) error
+
+	// DHCPLeases returns all dynamic DHCP leases
+	DHCPLeases() (model.DHCPLeaseList, error)
+
+	// DeleteDHCPLease deletes the dynamic DHCP lease for the given MAC address
+	DeleteDHCPLease(mac string) error
+
+	// StoreDHCPEvent records a DHCP message in the boot history
+	StoreDHCPEvent(event *model.DHCPEvent) error
+
+	// DHCPEvents returns the DHCP boot history for the given host name since
+	// the given time
+	DHCPEvents(host string, since time.Time) (model.DHCPEventList, error)
+
+	// PruneDHCPEvents deletes DHCP boot history older than the given time
+	PruneDHCPEvents(before time.Time) error
+
+	// StoreProvisionEvent records a host entering a provision state unless it
+	// is already in that state. A requested state starts a new provision and
+	// clears the previous events of the host.
+	StoreProvisionEvent(event *model.ProvisionEvent) error
+
+	// ProvisionEvents returns the provision states of the given host name since
+	// it was last requested to provision
+	ProvisionEvents(host string) (model.ProvisionEventList, error)
+
+	// ProvisionStates returns the current provision state of all hosts
+	ProvisionStates() (model.ProvisionEventList, error)
+
+	// StoreProvisionLog stores a progress message or log file sent by a host
+	StoreProvisionLog(log *model.ProvisionLog) error
+
+	// ProvisionLogs returns the progress messages and log files sent by the
+	// given host name
+	ProvisionLogs(host string) (model.ProvisionLogList, error)
+
+	// DeleteProvisionLogs deletes the progress messages and log files sent by
+	// the given host name
+	DeleteProvisionLogs(host string) error
+
+	// ProvisionLogSize returns the total size of the progress messages and log
+	// files sent by the given host name
+	ProvisionLogSize(host string) (int64, error)
+
+	// PruneProvisionLogs deletes progress messages and log files older than
+	// the given time and returns the number deleted
+	PruneProvisionLogs(before time.Time) (int64, error)
+
+	// StoreEvent records an audit event
+	StoreEvent(event *model.Event) error
+
+	// Events returns the audit events matching the filter, newest first
+	Events(filter model.EventFilter) (model.EventList, error)
+
+	// PruneEvents deletes audit events older than the given time and returns
+	// the number of events deleted
+	PruneEvents(before time.Time) (int64, error)
+
+	// StoreBmcTask creates a BMC task with every host pending
+	StoreBmcTask(task *model.BmcTask) error
+
+	// UpdateBmcTask sets the status and error of a BMC task
+	UpdateBmcTask(id, status, taskError string) error
+
+	// StoreBmcTaskResult records the result of a BMC task on a single host
+	StoreBmcTaskResult(id string, m model.JobMessage) error
+
+	// LoadBmcTask returns the BMC task with the given id along with the
+	// status of each host
+	LoadBmcTask(id string) (*model.BmcTask, error)
+
+	// BmcTasks returns up to limit BMC tasks with the given status, or any
+	// status if empty, newest first. Hosts are not included.
+	BmcTasks(status string, limit int) (model.BmcTaskList, error)
+
+	// InterruptBmcTasks fails the unfinished BMC tasks run by the given owner,
+	// for tasks lost when the owner restarted, and returns the number of
+	// tasks failed
+	InterruptBmcTasks(owner string) (int64, error)
+
+	// PruneBmcTasks deletes finished BMC tasks last updated before the given
+	// time and returns the number of tasks deleted
+	PruneBmcTasks(before time.Time) (int64, error)
+
+	// StoreBmcCredential creates or replaces the BMC credential of a host or
+	// tag. The password is stored as given and should already be encrypted.
+	StoreBmcCredential(cred *model.BmcCredential) error
+
+	// LoadBmcCredential returns the BMC credential of the given scope and name
+	LoadBmcCredential(scope, name string) (*model.BmcCredential, error)
+
+	// BmcCredentials returns all BMC credentials ordered by scope and name
+	BmcCredentials() (model.BmcCredentialList, error)
+
+	// DeleteBmcCredential deletes the BMC credential of the given scope and
+	// name
+	DeleteBmcCredential(scope, name string) error
+
+	// StoreBmcMedia records the virtual media inserted on the BMC of a host,
+	// replacing any recorded before
+	StoreBmcMedia(media *model.BmcMedia) error
+
+	// LoadBmcMedia returns the virtual media inserted on the BMC of a host
+	LoadBmcMedia(host string) (*model.BmcMedia, error)
+
+	// DeleteBmcMedia deletes the virtual media recorded for a host once it
+	// is ejected
+	DeleteBmcMedia(host string) error
+
+	// StoreInventory replaces the inventory of the given host, records the
+	// changes from its previous inventory and returns them. The first
+	// inventory of a host records no changes.
+	StoreInventory(host string, components model.InventoryComponentList) (model.InventoryChangeList, error)
+
+	// Inventory returns the inventory components of the given host and kind,
+	// or of all hosts or kinds if empty
+	Inventory(host, kind string) (model.InventoryComponentList, error)
+
+	// InventoryChanges returns the inventory changes of the given host, or of
+	// all hosts if empty, since the given time
+	InventoryChanges(host string, since time.Time) (model.InventoryChangeList, error)
+
+	// StoreTopology replaces the links seen on the ports of the given switch.
+	// The first port the MAC address of a host is seen on becomes its expected
+	// port.
+	StoreTopology(switchName string, links model.TopologyLinkList) error
+
+	// Topology returns the links seen on the ports of the given switch, or of
+	// all switches if empty, with their expected ports
+	Topology(switchName string) (model.TopologyLinkList, error)
+
+	// AcceptTopology makes the ports the MAC addresses of the given hosts, or
+	// all hosts if nil, are seen on their expected ports and returns the
+	// number of links accepted
+	AcceptTopology(hosts []string) (int, error)
+
+	// AcquireLeaderLease acquires or renews the named leader lease for the
+	// given holder if it is free, expired or already held by the holder. The
+	// current lease is returned, which is held by another instance if the
+	// lease could not be acquired.
+	AcquireLeaderLease(name, holder string, ttl time.Duration) (*model.LeaderLease, error)
+
+	// LoadLeaderLease returns the named leader lease
+	LoadLeaderLease(name string) (*model.LeaderLease, error)
+
+	// ReleaseLeaderLease releases the named leader lease if it is held by the
+	// given holder
+	ReleaseLeaderLease(name, holder string) error
+
 	// RestoreFrom restores the database using the provided data dump
 	RestoreFrom(data model.DataDump) error
 
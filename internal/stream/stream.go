@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+// Package stream broadcasts audit events, BMC job results and provision state
+// changes to the subscribers of the API event stream.
+package stream
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// bufferSize is the number of messages queued for a subscriber before new
+// messages are dropped
+const bufferSize = 1024
+
+var log = logger.GetLogger("STREAM")
+
+// Default is the broker used by the Grendel services running in this process
+var Default = NewBroker()
+
+// Filter selects the messages sent to a subscriber. Empty fields match any
+// message.
+type Filter struct {
+	Types []string
+	Host  string
+	JobID string
+}
+
+// Match returns true if the message is selected by the filter
+func (f Filter) Match(m model.StreamMessage) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, m.Type) {
+		return false
+	}
+
+	if f.JobID != "" && f.JobID != m.JobID {
+		return false
+	}
+
+	if f.Host != "" {
+		if m.Event != nil {
+			return slices.Contains(m.Event.Hosts, f.Host)
+		}
+		return f.Host == m.Host
+	}
+
+	return true
+}
+
+type subscriber struct {
+	ch     chan model.StreamMessage
+	filter Filter
+}
+
+// Broker sends every published message to all subscribers with a matching
+// filter. Publishing never blocks, messages for subscribers that can't keep
+// up are dropped.
+type Broker struct {
+	mu   sync.RWMutex
+	subs map[*subscriber]struct{}
+}
+
+// NewBroker returns a new Broker
+func NewBroker() *Broker {
+	return &Broker{subs: make(map[*subscriber]struct{})}
+}
+
+// Subscribe returns a channel receiving the messages matching the filter and
+// a function to cancel the subscription
+func (b *Broker) Subscribe(filter Filter) (<-chan model.StreamMessage, func()) {
+	sub := &subscriber{
+		ch:     make(chan model.StreamMessage, bufferSize),
+		filter: filter,
+	}
+
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs, sub)
+			b.mu.Unlock()
+			close(sub.ch)
+		})
+	}
+}
+
+// Publish sends the message to all matching subscribers
+func (b *Broker) Publish(m model.StreamMessage) {
+	if m.Time.IsZero() {
+		m.Time = time.Now().UTC()
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subs {
+		if !sub.filter.Match(m) {
+			continue
+		}
+
+		select {
+		case sub.ch <- m:
+		default:
+			log.Warnf("Dropping %s message, subscriber is too slow", m.Type)
+		}
+	}
+}
+
+// PublishEvent publishes an audit event on the default broker
+func PublishEvent(event model.Event) {
+	Default.Publish(model.StreamMessage{
+		Type:  model.StreamTypeEvent,
+		Time:  event.Time,
+		Event: &event,
+	})
+}
+
+// PublishJob publishes the result of a BMC job on a single host on the
+// default broker
+func PublishJob(jobID string, m model.JobMessage) {
+	Default.Publish(model.StreamMessage{
+		Type:  model.StreamTypeJob,
+		Host:  m.Host,
+		JobID: jobID,
+		Job:   &m,
+	})
+}
+
+// PublishProvision publishes a change of the provision state of a host on the
+// default broker
+func PublishProvision(host, state string) {
+	Default.Publish(model.StreamMessage{
+		Type:  model.StreamTypeProvision,
+		Host:  host,
+		State: state,
+	})
+}
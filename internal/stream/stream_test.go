@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package stream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestBroker(t *testing.T) {
+	assert := assert.New(t)
+
+	b := NewBroker()
+	all, cancelAll := b.Subscribe(Filter{})
+	jobs, cancelJobs := b.Subscribe(Filter{Types: []string{model.StreamTypeJob}, JobID: "job1"})
+	host, cancelHost := b.Subscribe(Filter{Host: "cpn-01"})
+	defer cancelAll()
+	defer cancelJobs()
+
+	b.Publish(model.StreamMessage{Type: model.StreamTypeJob, JobID: "job1", Host: "cpn-01", Job: &model.JobMessage{Host: "cpn-01"}})
+	b.Publish(model.StreamMessage{Type: model.StreamTypeJob, JobID: "job2", Host: "cpn-02", Job: &model.JobMessage{Host: "cpn-02"}})
+	b.Publish(model.StreamMessage{Type: model.StreamTypeEvent, Event: &model.Event{Hosts: []string{"cpn-02", "cpn-01"}}})
+	b.Publish(model.StreamMessage{Type: model.StreamTypeProvision, Host: "cpn-02", State: model.ProvisionStateComplete})
+
+	assert.Len(all, 4)
+	assert.Len(jobs, 1)
+	assert.Len(host, 2)
+
+	m := <-jobs
+	assert.Equal("cpn-01", m.Host)
+	assert.False(m.Time.IsZero())
+
+	m = <-host
+	assert.Equal(model.StreamTypeJob, m.Type)
+	m = <-host
+	assert.Equal(model.StreamTypeEvent, m.Type)
+
+	// Canceled subscriptions are closed and no longer receive messages
+	cancelHost()
+	cancelHost()
+	_, ok := <-host
+	assert.False(ok)
+	b.Publish(model.StreamMessage{Type: model.StreamTypeProvision, Host: "cpn-01"})
+	assert.Len(all, 5)
+}
+
+func TestBrokerSlowSubscriber(t *testing.T) {
+	assert := assert.New(t)
+
+	b := NewBroker()
+	ch, cancel := b.Subscribe(Filter{})
+	defer cancel()
+
+	for i := 0; i < bufferSize+10; i++ {
+		b.Publish(model.StreamMessage{Type: model.StreamTypeEvent})
+	}
+
+	assert.Len(ch, bufferSize)
+}
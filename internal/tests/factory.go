@@ -35,6 +35,8 @@ var NetInterfaceFactory = factory.NewFactory(
 	return net.ParseMAC(randomdata.MacAddress())
 }).Attr("IP", func(args factory.Args) (interface{}, error) {
 	return netip.MustParsePrefix(randomdata.IpV4Address() + "/24"), nil
+}).Attr("IPv6", func(args factory.Args) (interface{}, error) {
+	return netip.MustParsePrefix(randomdata.IpV6Address() + "/64"), nil
 })
 
 var BondFactory = factory.NewFactory(
@@ -14,6 +14,8 @@ import (
 	"strings"
 
 	"github.com/pin/tftp/v3"
+	"github.com/ubccr/grendel/internal/artifact"
+	"github.com/ubccr/grendel/internal/metrics"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
@@ -24,6 +26,7 @@ func (s *Server) sendFile(fileName string, rf io.ReaderFrom) error {
 		return err
 	}
 	n, err := rf.ReadFrom(file)
+	metrics.TFTPBytesSent.WithLabelValues("image").Add(float64(n))
 	if err != nil {
 		log.Errorf("Failed to send %s via tftp: %s", fileName, err)
 		return err
@@ -43,6 +46,9 @@ func (s *Server) imageFileHandler(filePath string, rf io.ReaderFrom) error {
 
 	switch {
 	case fileType == "kernel":
+		if err := artifact.VerifyPath(bootImage.KernelPath, bootImage.KernelSHA256); err != nil {
+			return err
+		}
 		return s.sendFile(bootImage.KernelPath, rf)
 	case strings.HasPrefix(fileType, "initrd-"):
 		i, err := strconv.Atoi(fileType[7:])
@@ -50,6 +56,11 @@ func (s *Server) imageFileHandler(filePath string, rf io.ReaderFrom) error {
 			return fmt.Errorf("no initrd with ID %q", i)
 		}
 		initrd := bootImage.InitrdPaths[i]
+		if i < len(bootImage.InitrdSHA256) {
+			if err := artifact.VerifyPath(initrd, bootImage.InitrdSHA256[i]); err != nil {
+				return err
+			}
+		}
 		return s.sendFile(initrd, rf)
 	}
 
@@ -72,6 +83,7 @@ func (s *Server) ReadHandler(token string, rf io.ReaderFrom) error {
 
 	rf.(tftp.OutgoingTransfer).SetSize(int64(len(bs)))
 	n, err := rf.ReadFrom(bytes.NewBuffer(bs))
+	metrics.TFTPBytesSent.WithLabelValues(fwtype.String()).Add(float64(n))
 	if err != nil && !strings.Contains(err.Error(), "User aborted") {
 		log.Errorf("Failed to send firmware via tftp: %s", err)
 		return err
@@ -0,0 +1,291 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package topology
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// ErrNoCabling is returned when none of the hosts validated have an interface
+// with an intended switch port
+var ErrNoCabling = errors.New("no interfaces with an intended switch port")
+
+// cabling is a host interface with an intended switch port
+type cabling struct {
+	host string
+	nic  *model.NetInterface
+}
+
+// ValidateCabling compares the intended switch ports of the interfaces of the
+// given hosts with the MAC address tables and LLDP neighbors of their switches.
+// Interfaces seen on another port are miswired, interfaces not seen at all
+// missing. Links seen on a port no host interface is intended to be cabled to
+// are unexpected.
+func ValidateCabling(db store.Store, hostList model.HostList) (*model.CablingReport, error) {
+	tag := viper.GetString("topology.switch_tag")
+	if tag == "" {
+		tag = DefaultSwitchTag
+	}
+	maxMACs := viper.GetInt("topology.max_port_macs")
+	if maxMACs <= 0 {
+		maxMACs = DefaultMaxPortMACs
+	}
+
+	allHosts, err := db.Hosts()
+	if err != nil {
+		return nil, err
+	}
+
+	intended := intendedCabling(hostList)
+	if len(intended) == 0 {
+		return nil, ErrNoCabling
+	}
+
+	switches := make(map[string]bool)
+	for _, c := range intended {
+		switches[c.nic.Switch] = true
+	}
+
+	byName := make(map[string]*model.Host, len(allHosts))
+	for _, host := range allHosts {
+		byName[host.Name] = host
+	}
+
+	names := newHostNames(allHosts, tag)
+	report := &model.CablingReport{Failed: make([]string, 0)}
+
+	switchNames := make([]string, 0, len(switches))
+	for name := range switches {
+		switchNames = append(switchNames, name)
+	}
+	sort.Strings(switchNames)
+
+	live := make(map[string]model.TopologyLinkList)
+	for _, name := range switchNames {
+		host, ok := byName[name]
+		if !ok {
+			log.Errorf("Failed validating cabling of switch %s: switch not found", name)
+			report.Failed = append(report.Failed, name)
+			continue
+		}
+
+		links, err := walkSwitch(host, names, maxMACs)
+		if err != nil {
+			log.Errorf("Failed validating cabling of switch %s: %s", name, err)
+			report.Failed = append(report.Failed, name)
+			continue
+		}
+		for _, l := range links {
+			l.Switch = name
+		}
+		live[name] = links
+	}
+
+	report.Links = compareCabling(intended, allHosts, live, names)
+
+	return report, nil
+}
+
+// intendedCabling returns the interfaces of the given hosts with an intended
+// switch port, sorted by host and interface name
+func intendedCabling(hostList model.HostList) []*cabling {
+	intended := make([]*cabling, 0)
+	for _, host := range hostList {
+		for _, nic := range host.Interfaces {
+			if nic.Switch == "" || nic.SwitchPort == "" {
+				continue
+			}
+			intended = append(intended, &cabling{host: host.Name, nic: nic})
+		}
+	}
+
+	sort.SliceStable(intended, func(i, j int) bool {
+		if intended[i].host != intended[j].host {
+			return intended[i].host < intended[j].host
+		}
+		return intended[i].nic.Name < intended[j].nic.Name
+	})
+
+	return intended
+}
+
+// compareCabling compares the intended cabling with the links seen live on
+// each switch. Interfaces on switches that weren't walked are left out.
+func compareCabling(intended []*cabling, allHosts model.HostList, live map[string]model.TopologyLinkList, names *hostNames) model.CablingLinkList {
+	cablingLinks := make(model.CablingLinkList, 0)
+	matched := make(map[*model.TopologyLink]bool)
+	for _, c := range intended {
+		if _, ok := live[c.nic.Switch]; !ok {
+			continue
+		}
+
+		cl := &model.CablingLink{
+			Host:      c.host,
+			Interface: c.nic.Name,
+			Switch:    c.nic.Switch,
+			Port:      c.nic.SwitchPort,
+			Status:    model.CablingMissing,
+		}
+		if len(c.nic.MAC) > 0 {
+			cl.MAC = c.nic.MAC.String()
+		}
+
+		if l := findCabling(live, c, cl.MAC); l != nil {
+			matched[l] = true
+			cl.ActualSwitch = l.Switch
+			cl.ActualPort = l.Port
+			cl.Status = model.CablingMiswired
+			if l.Switch == c.nic.Switch && samePort(l.Port, c.nic.SwitchPort) {
+				cl.Status = model.CablingOK
+			}
+		}
+
+		cablingLinks = append(cablingLinks, cl)
+	}
+
+	// Ports intended for any host, not only the ones validated
+	intendedPorts := make(map[string][]string)
+	for _, c := range intendedCabling(allHosts) {
+		intendedPorts[c.nic.Switch] = append(intendedPorts[c.nic.Switch], c.nic.SwitchPort)
+	}
+
+	switchNames := make([]string, 0, len(live))
+	for name := range live {
+		switchNames = append(switchNames, name)
+	}
+	sort.Strings(switchNames)
+
+	for _, name := range switchNames {
+		for _, l := range live[name] {
+			if matched[l] || names.switches[l.Host] || (l.MAC == "" && l.Host == "") {
+				continue
+			}
+			if l.MAC != "" && linkedElsewhere(matched, l.MAC) {
+				continue
+			}
+			// Ports intended for hosts not validated are left to them
+			if containsPort(intendedPorts[name], l.Port) && !intendedForValidated(intended, name, l.Port) {
+				continue
+			}
+
+			cablingLinks = append(cablingLinks, &model.CablingLink{
+				Host:         l.Host,
+				MAC:          l.MAC,
+				ActualSwitch: l.Switch,
+				ActualPort:   l.Port,
+				Status:       model.CablingUnexpected,
+			})
+		}
+	}
+
+	return cablingLinks
+}
+
+// findCabling returns where an interface is seen: the link with its MAC
+// address on the intended port, else anywhere, else for interfaces without a
+// MAC address the link to its host found by LLDP
+func findCabling(live map[string]model.TopologyLinkList, c *cabling, mac string) *model.TopologyLink {
+	var found *model.TopologyLink
+	for _, l := range live[c.nic.Switch] {
+		if !samePort(l.Port, c.nic.SwitchPort) {
+			continue
+		}
+		if (mac != "" && l.MAC == mac) || (mac == "" && l.Host == c.host) {
+			return l
+		}
+	}
+
+	for _, links := range live {
+		for _, l := range links {
+			if mac != "" && l.MAC == mac {
+				return l
+			}
+			if mac == "" && l.Host == c.host && l.Neighbor != "" && found == nil {
+				found = l
+			}
+		}
+	}
+
+	return found
+}
+
+// linkedElsewhere returns true if a MAC address was matched to an interface
+// on another port, such as a miswired interface also seen through a second
+// switch
+func linkedElsewhere(matched map[*model.TopologyLink]bool, mac string) bool {
+	for l := range matched {
+		if l.MAC == mac {
+			return true
+		}
+	}
+
+	return false
+}
+
+func intendedForValidated(intended []*cabling, switchName, port string) bool {
+	for _, c := range intended {
+		if c.nic.Switch == switchName && samePort(c.nic.SwitchPort, port) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsPort(ports []string, port string) bool {
+	for _, p := range ports {
+		if samePort(p, port) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// samePort returns true if a and b name the same switch port. A port number
+// matches the last number of an interface name, so port 5 is ethernet1/1/5
+// or swp5.
+func samePort(a, b string) bool {
+	if strings.EqualFold(a, b) {
+		return true
+	}
+	if isNumber(a) {
+		return strings.TrimLeft(a, "0") == portNumber(b)
+	}
+	if isNumber(b) {
+		return strings.TrimLeft(b, "0") == portNumber(a)
+	}
+
+	return false
+}
+
+// portNumber returns the trailing number of an interface name
+func portNumber(port string) string {
+	start := len(port)
+	for start > 0 && port[start-1] >= '0' && port[start-1] <= '9' {
+		start--
+	}
+
+	return strings.TrimLeft(port[start:], "0")
+}
+
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package topology
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/tors"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+// ErrInvalidAdminState is returned when the admin state to set on switch ports
+// is not up or down
+var ErrInvalidAdminState = errors.New("invalid admin state")
+
+// ConfigurePorts pushes the access VLAN, MTU and description of the interfaces
+// of the given hosts to their intended switch ports, and adminState if set.
+// Only the settings that differ from the switch are changed. With dryRun the
+// changes are returned without configuring the switches.
+func ConfigurePorts(db store.Store, hostList model.HostList, adminState string, dryRun bool) (*model.SwitchPortReport, error) {
+	if adminState != "" && adminState != model.PortAdminUp && adminState != model.PortAdminDown {
+		return nil, fmt.Errorf("%w %s: must be %s or %s", ErrInvalidAdminState, adminState, model.PortAdminUp, model.PortAdminDown)
+	}
+
+	intended := intendedCabling(hostList)
+	if len(intended) == 0 {
+		return nil, ErrNoCabling
+	}
+
+	allHosts, err := db.Hosts()
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*model.Host, len(allHosts))
+	for _, host := range allHosts {
+		byName[host.Name] = host
+	}
+
+	bySwitch := make(map[string][]*cabling)
+	for _, c := range intended {
+		bySwitch[c.nic.Switch] = append(bySwitch[c.nic.Switch], c)
+	}
+
+	switchNames := make([]string, 0, len(bySwitch))
+	for name := range bySwitch {
+		switchNames = append(switchNames, name)
+	}
+	sort.Strings(switchNames)
+
+	report := &model.SwitchPortReport{
+		Ports:  make(model.SwitchPortChangeList, 0),
+		DryRun: dryRun,
+		Failed: make([]string, 0),
+	}
+	for _, name := range switchNames {
+		pc, err := portConfigurator(byName[name])
+		if err != nil {
+			log.Errorf("Failed configuring ports of switch %s: %s", name, err)
+			report.Failed = append(report.Failed, name)
+			continue
+		}
+
+		report.Ports = append(report.Ports, configureSwitchPorts(pc, bySwitch[name], adminState, dryRun)...)
+	}
+
+	return report, nil
+}
+
+func portConfigurator(host *model.Host) (tors.PortConfigurator, error) {
+	if host == nil {
+		return nil, errors.New("switch not found")
+	}
+
+	sw, err := tors.NewNetworkSwitch(host)
+	if err != nil {
+		return nil, err
+	}
+
+	pc, ok := sw.(tors.PortConfigurator)
+	if !ok {
+		return nil, errors.New("port configuration not supported by the switch driver")
+	}
+
+	return pc, nil
+}
+
+// configureSwitchPorts pushes the intended configuration of the ports of one
+// switch
+func configureSwitchPorts(pc tors.PortConfigurator, intended []*cabling, adminState string, dryRun bool) model.SwitchPortChangeList {
+	changes := make(model.SwitchPortChangeList, 0, len(intended))
+	for _, c := range intended {
+		change := &model.SwitchPortChange{
+			Host:      c.host,
+			Interface: c.nic.Name,
+			Switch:    c.nic.Switch,
+			Port:      c.nic.SwitchPort,
+			Changes:   make([]string, 0),
+		}
+		changes = append(changes, change)
+
+		current, err := pc.GetPortConfig(c.nic.SwitchPort)
+		if err != nil {
+			change.Status = model.PortFailed
+			change.Error = err.Error()
+			continue
+		}
+
+		changed, diff := intendedPortConfig(c, adminState).Changes(current)
+		change.Changes = diff
+		switch {
+		case len(diff) == 0:
+			change.Status = model.PortUnchanged
+		case dryRun:
+			change.Status = model.PortPending
+		default:
+			if err := pc.SetPortConfig(c.nic.SwitchPort, changed); err != nil {
+				log.Errorf("Failed configuring port %s %s of host %s: %s", c.nic.Switch, c.nic.SwitchPort, c.host, err)
+				change.Status = model.PortFailed
+				change.Error = err.Error()
+				continue
+			}
+			log.Infof("Configured port %s %s of host %s: %s", c.nic.Switch, c.nic.SwitchPort, c.host, strings.Join(diff, ", "))
+			change.Status = model.PortChanged
+		}
+	}
+
+	return changes
+}
+
+// intendedPortConfig returns the configuration of the switch port of a host
+// interface: its VLAN, MTU and FQDN as the description, or the host name if
+// it has no FQDN
+func intendedPortConfig(c *cabling, adminState string) *model.SwitchPortConfig {
+	description := c.nic.HostName()
+	if description == "" {
+		description = c.host
+	}
+
+	return &model.SwitchPortConfig{
+		Description: description,
+		VLAN:        c.nic.VLAN,
+		MTU:         int(c.nic.MTU),
+		AdminState:  adminState,
+	}
+}
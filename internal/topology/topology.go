@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package topology
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+	"github.com/ubccr/grendel/internal/logger"
+	"github.com/ubccr/grendel/internal/store"
+	"github.com/ubccr/grendel/internal/tors"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+var log = logger.GetLogger("TOPOLOGY")
+
+// ErrNoSwitches is returned when no hosts are tagged as switches
+var ErrNoSwitches = errors.New("no hosts tagged as switches")
+
+// DefaultSwitchTag is the tag of the hosts walked when topology.switch_tag is
+// not set
+const DefaultSwitchTag = "switch"
+
+// DefaultMaxPortMACs is the number of MAC addresses above which a switch port
+// is taken to be an uplink when topology.max_port_macs is not set
+const DefaultMaxPortMACs = 16
+
+// Result is the outcome of walking the switches
+type Result struct {
+	Switches int
+	Failed   []string
+	Links    int
+	Moved    model.TopologyLinkList
+}
+
+// Walk queries the MAC address table and LLDP neighbors of every host tagged
+// with topology.switch_tag and stores the links found on their ports. A switch
+// that can't be queried keeps the links of its last walk.
+func Walk(db store.Store) (*Result, error) {
+	tag := viper.GetString("topology.switch_tag")
+	if tag == "" {
+		tag = DefaultSwitchTag
+	}
+	maxMACs := viper.GetInt("topology.max_port_macs")
+	if maxMACs <= 0 {
+		maxMACs = DefaultMaxPortMACs
+	}
+
+	hostList, err := db.Hosts()
+	if err != nil {
+		return nil, err
+	}
+
+	names := newHostNames(hostList, tag)
+	res := &Result{}
+	for _, host := range hostList {
+		if !host.HasTags(tag) {
+			continue
+		}
+
+		res.Switches++
+		links, err := walkSwitch(host, names, maxMACs)
+		if err == nil {
+			err = db.StoreTopology(host.Name, links)
+		}
+		if err != nil {
+			log.Errorf("Failed walking switch %s: %s", host.Name, err)
+			res.Failed = append(res.Failed, host.Name)
+			continue
+		}
+		res.Links += len(links)
+	}
+
+	if res.Switches == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoSwitches, tag)
+	}
+
+	links, err := db.Topology("")
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range links {
+		if l.Moved {
+			log.Warnf("Host %s MAC %s seen on %s %s, expected on %s %s", l.Host, l.MAC, l.Switch, l.Port, l.ExpectedSwitch, l.ExpectedPort)
+			res.Moved = append(res.Moved, l)
+		}
+	}
+
+	return res, nil
+}
+
+func walkSwitch(host *model.Host, names *hostNames, maxMACs int) (model.TopologyLinkList, error) {
+	sw, err := tors.NewNetworkSwitch(host)
+	if err != nil {
+		return nil, err
+	}
+
+	macTable, err := sw.GetMACTable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get MAC table: %w", err)
+	}
+
+	// Not every switch driver supports LLDP
+	neighbors, err := sw.GetLLDPNeighbors()
+	if err != nil {
+		log.Debugf("Failed to get LLDP neighbors of switch %s: %s", host.Name, err)
+	}
+	tors.LearnNOS(neighbors)
+
+	return switchLinks(macTable, neighbors, names, maxMACs), nil
+}
+
+// switchLinks returns the links on the ports of a switch. Ports with an LLDP
+// neighbor that is a switch, or with more than maxMACs MAC addresses, are
+// uplinks and only linked to the neighbor, the MAC addresses learned on them
+// are on other switches.
+func switchLinks(macTable model.MACTable, neighbors model.LLDPNeighbors, names *hostNames, maxMACs int) model.TopologyLinkList {
+	ports := make(map[string][]*model.MACTableEntry)
+	for _, entry := range macTable {
+		port := entry.Ifname
+		if port == "" {
+			port = strconv.Itoa(entry.Port)
+		}
+		ports[port] = append(ports[port], entry)
+	}
+
+	links := make(model.TopologyLinkList, 0)
+	for port, entries := range ports {
+		lldp := neighbors[port]
+		neighbor := ""
+		if lldp != nil {
+			neighbor = names.lookup(lldp.SystemName)
+		}
+
+		if (neighbor != "" && names.switches[neighbor]) || len(entries) > maxMACs {
+			continue
+		}
+
+		for _, entry := range entries {
+			l := &model.TopologyLink{
+				Port: port,
+				MAC:  entry.MAC.String(),
+				VLAN: strings.TrimPrefix(entry.VLAN, "vlan"),
+				Host: names.macs[entry.MAC.String()],
+			}
+			if lldp != nil {
+				l.Neighbor = lldp.SystemName
+				l.NeighborPort = lldp.PortId
+				if l.Host == "" {
+					l.Host = neighbor
+				}
+			}
+			links = append(links, l)
+		}
+	}
+
+	// LLDP neighbors of ports without MAC addresses of their own, such as
+	// uplinks
+	for port, lldp := range neighbors {
+		if lldp == nil || lldp.SystemName == "" {
+			continue
+		}
+		if entries := ports[port]; len(entries) > 0 && len(entries) <= maxMACs {
+			if neighbor := names.lookup(lldp.SystemName); neighbor == "" || !names.switches[neighbor] {
+				continue
+			}
+		}
+
+		links = append(links, &model.TopologyLink{
+			Port:         port,
+			Host:         names.lookup(lldp.SystemName),
+			Neighbor:     lldp.SystemName,
+			NeighborPort: lldp.PortId,
+		})
+	}
+
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].Port != links[j].Port {
+			return links[i].Port < links[j].Port
+		}
+		return links[i].MAC < links[j].MAC
+	})
+
+	return links
+}
+
+// hostNames resolves MAC addresses and LLDP system names to host names
+type hostNames struct {
+	macs     map[string]string
+	names    map[string]string
+	switches map[string]bool
+}
+
+func newHostNames(hostList model.HostList, switchTag string) *hostNames {
+	n := &hostNames{
+		macs:     make(map[string]string),
+		names:    make(map[string]string),
+		switches: make(map[string]bool),
+	}
+
+	for _, host := range hostList {
+		n.names[strings.ToLower(host.Name)] = host.Name
+		if host.HasTags(switchTag) {
+			n.switches[host.Name] = true
+		}
+		for _, nic := range host.Interfaces {
+			if len(nic.MAC) > 0 {
+				n.macs[nic.MAC.String()] = host.Name
+			}
+			for _, fqdn := range strings.Split(nic.FQDN, ",") {
+				if fqdn != "" {
+					n.names[strings.ToLower(fqdn)] = host.Name
+				}
+			}
+		}
+	}
+
+	return n
+}
+
+// lookup returns the host with the given name or FQDN, or with the short name
+// of the given FQDN
+func (n *hostNames) lookup(name string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == "" {
+		return ""
+	}
+	if host, ok := n.names[name]; ok {
+		return host
+	}
+
+	short, _, _ := strings.Cut(name, ".")
+	return n.names[short]
+}
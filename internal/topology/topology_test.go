@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package topology
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestSwitchLinks(t *testing.T) {
+	assert := assert.New(t)
+
+	mac := func(s string) net.HardwareAddr {
+		m, _ := net.ParseMAC(s)
+		return m
+	}
+
+	hostList := model.HostList{
+		{Name: "tux01", Interfaces: []*model.NetInterface{{MAC: mac("aa:bb:cc:00:00:01"), FQDN: "tux01.example.com"}}},
+		{Name: "tux02", Interfaces: []*model.NetInterface{{MAC: mac("aa:bb:cc:00:00:02"), FQDN: "tux02.example.com"}}},
+		{Name: "swe-01", Tags: []string{"switch"}},
+		{Name: "swe-02", Tags: []string{"switch"}, Interfaces: []*model.NetInterface{{FQDN: "swe-02.example.com"}}},
+	}
+	names := newHostNames(hostList, "switch")
+
+	macTable := model.MACTable{
+		"aa:bb:cc:00:00:01": {Ifname: "ethernet1/1/1", VLAN: "vlan1001", MAC: mac("aa:bb:cc:00:00:01")},
+		"aa:bb:cc:00:00:02": {Ifname: "ethernet1/1/2", VLAN: "1001", MAC: mac("aa:bb:cc:00:00:02")},
+		"aa:bb:cc:00:00:03": {Ifname: "ethernet1/1/3", VLAN: "1001", MAC: mac("aa:bb:cc:00:00:03")},
+		// learned on the uplink to swe-02
+		"aa:bb:cc:00:01:01": {Ifname: "ethernet1/1/54", VLAN: "1001", MAC: mac("aa:bb:cc:00:01:01")},
+	}
+	// learned on an uplink without LLDP
+	for i := 0; i < 20; i++ {
+		m := fmt.Sprintf("aa:bb:cc:00:02:%02x", i)
+		macTable[m] = &model.MACTableEntry{Ifname: "ethernet1/1/53", VLAN: "1001", MAC: mac(m)}
+	}
+
+	neighbors := model.LLDPNeighbors{
+		"ethernet1/1/2":  {PortName: "ethernet1/1/2", SystemName: "tux02.example.com", PortId: "eno1"},
+		"ethernet1/1/54": {PortName: "ethernet1/1/54", SystemName: "swe-02.example.com", PortId: "ethernet1/1/54"},
+	}
+
+	links := switchLinks(macTable, neighbors, names, DefaultMaxPortMACs)
+	if !assert.Len(links, 4) {
+		return
+	}
+
+	assert.Equal("ethernet1/1/1", links[0].Port)
+	assert.Equal("tux01", links[0].Host)
+	assert.Equal("1001", links[0].VLAN)
+
+	assert.Equal("tux02", links[1].Host)
+	assert.Equal("eno1", links[1].NeighborPort)
+
+	assert.Equal("aa:bb:cc:00:00:03", links[2].MAC)
+	assert.Empty(links[2].Host)
+
+	assert.Equal("ethernet1/1/54", links[3].Port)
+	assert.Empty(links[3].MAC)
+	assert.Equal("swe-02", links[3].Host)
+
+	for _, l := range links {
+		l.Switch = "swe-01"
+	}
+	links[0].Moved = true
+	links[0].ExpectedSwitch = "swe-01"
+	links[0].ExpectedPort = "ethernet1/1/9"
+
+	// the other end of the uplink, walked on swe-02
+	links = append(links, &model.TopologyLink{Switch: "swe-02", Port: "ethernet1/1/54", Host: "swe-01", Neighbor: "swe-01", NeighborPort: "ethernet1/1/54"})
+
+	g := model.NewTopologyGraph(links)
+	if assert.Len(g.Nodes, 5) {
+		assert.Equal(model.TopologyUnknown, g.Nodes[0].Kind)
+		assert.Equal("swe-01", g.Nodes[1].Name)
+		assert.Equal(model.TopologySwitch, g.Nodes[1].Kind)
+		assert.Equal(model.TopologySwitch, g.Nodes[2].Kind)
+		assert.Equal(model.TopologyHost, g.Nodes[3].Kind)
+	}
+
+	dot := g.DOT()
+	assert.True(strings.HasPrefix(dot, "graph topology {"))
+	assert.Contains(dot, `"swe-01" [shape=box];`)
+	assert.Contains(dot, `"swe-01" -- "tux01" [label="ethernet1/1/1 (expected swe-01 ethernet1/1/9)", color=red];`)
+	assert.Contains(dot, `"swe-01" -- "swe-02" [label="ethernet1/1/54 - ethernet1/1/54"];`)
+	assert.NotContains(dot, `"swe-02" -- "swe-01"`)
+}
+
+func TestCompareCabling(t *testing.T) {
+	assert := assert.New(t)
+
+	mac := func(s string) net.HardwareAddr {
+		m, _ := net.ParseMAC(s)
+		return m
+	}
+
+	hostList := model.HostList{
+		{Name: "tux01", Interfaces: []*model.NetInterface{{Name: "eno1", MAC: mac("aa:bb:cc:00:00:01"), Switch: "swe-01", SwitchPort: "1"}}},
+		{Name: "tux02", Interfaces: []*model.NetInterface{{Name: "eno1", MAC: mac("aa:bb:cc:00:00:02"), Switch: "swe-01", SwitchPort: "ethernet1/1/2"}}},
+		{Name: "tux03", Interfaces: []*model.NetInterface{{Name: "eno1", MAC: mac("aa:bb:cc:00:00:03"), Switch: "swe-01", SwitchPort: "ethernet1/1/3"}}},
+		{Name: "tux04", Interfaces: []*model.NetInterface{{Name: "eno1", Switch: "swe-01", SwitchPort: "ethernet1/1/4"}}},
+		{Name: "tux05", Interfaces: []*model.NetInterface{{Name: "eno1", MAC: mac("aa:bb:cc:00:00:05"), Switch: "swe-02", SwitchPort: "ethernet1/1/5"}}},
+		{Name: "swe-01", Tags: []string{"switch"}},
+		{Name: "swe-02", Tags: []string{"switch"}},
+	}
+	names := newHostNames(hostList, "switch")
+
+	live := map[string]model.TopologyLinkList{
+		"swe-01": {
+			{Switch: "swe-01", Port: "ethernet1/1/1", MAC: "aa:bb:cc:00:00:01", Host: "tux01"},
+			// tux02 and tux03 swapped
+			{Switch: "swe-01", Port: "ethernet1/1/2", MAC: "aa:bb:cc:00:00:03", Host: "tux03"},
+			{Switch: "swe-01", Port: "ethernet1/1/3", MAC: "aa:bb:cc:00:00:02", Host: "tux02"},
+			{Switch: "swe-01", Port: "ethernet1/1/4", Host: "tux04", Neighbor: "tux04.example.com", NeighborPort: "eno1"},
+			{Switch: "swe-01", Port: "ethernet1/1/9", MAC: "aa:bb:cc:00:00:09"},
+			{Switch: "swe-01", Port: "ethernet1/1/54", Host: "swe-02", Neighbor: "swe-02", NeighborPort: "ethernet1/1/54"},
+		},
+	}
+
+	links := compareCabling(intendedCabling(hostList), hostList, live, names)
+	if !assert.Len(links, 5) {
+		return
+	}
+
+	assert.Equal("tux01", links[0].Host)
+	assert.Equal(model.CablingOK, links[0].Status)
+	assert.Equal("ethernet1/1/1", links[0].ActualPort)
+
+	assert.Equal("tux02", links[1].Host)
+	assert.Equal(model.CablingMiswired, links[1].Status)
+	assert.Equal("ethernet1/1/3", links[1].ActualPort)
+
+	assert.Equal("tux03", links[2].Host)
+	assert.Equal(model.CablingMiswired, links[2].Status)
+
+	assert.Equal("tux04", links[3].Host)
+	assert.Equal(model.CablingOK, links[3].Status)
+
+	// tux05 is left out, swe-02 wasn't walked
+	assert.Equal(model.CablingUnexpected, links[4].Status)
+	assert.Equal("aa:bb:cc:00:00:09", links[4].MAC)
+
+	live["swe-02"] = model.TopologyLinkList{}
+	links = compareCabling(intendedCabling(hostList), hostList, live, names)
+	if assert.Len(links, 6) {
+		assert.Equal("tux05", links[4].Host)
+		assert.Equal(model.CablingMissing, links[4].Status)
+	}
+
+	assert.True(samePort("5", "ethernet1/1/5"))
+	assert.True(samePort("swp05", "5"))
+	assert.False(samePort("5", "ethernet1/1/15"))
+	assert.False(samePort("ethernet1/1/5", "ethernet1/2/5"))
+}
+
+type fakePorts struct {
+	ports map[string]*model.SwitchPortConfig
+	set   map[string]*model.SwitchPortConfig
+}
+
+func (f *fakePorts) GetPortConfig(port string) (*model.SwitchPortConfig, error) {
+	config, ok := f.ports[port]
+	if !ok {
+		return nil, fmt.Errorf("interface %s not found", port)
+	}
+	return config, nil
+}
+
+func (f *fakePorts) SetPortConfig(port string, config *model.SwitchPortConfig) error {
+	f.set[port] = config
+	return nil
+}
+
+func TestConfigureSwitchPorts(t *testing.T) {
+	assert := assert.New(t)
+
+	hostList := model.HostList{
+		{Name: "tux01", Interfaces: []*model.NetInterface{{Name: "eno1", FQDN: "tux01.example.com", VLAN: "1001", MTU: 9000, Switch: "swe-01", SwitchPort: "1"}}},
+		{Name: "tux02", Interfaces: []*model.NetInterface{{Name: "eno1", VLAN: "vlan1001", Switch: "swe-01", SwitchPort: "2"}}},
+		{Name: "tux03", Interfaces: []*model.NetInterface{{Name: "eno1", Switch: "swe-01", SwitchPort: "3"}}},
+	}
+
+	pc := &fakePorts{
+		ports: map[string]*model.SwitchPortConfig{
+			"1": {Description: "old", VLAN: "1", MTU: 1500, AdminState: model.PortAdminUp},
+			"2": {Description: "tux02", VLAN: "1001", MTU: 9216, AdminState: model.PortAdminUp},
+		},
+		set: make(map[string]*model.SwitchPortConfig),
+	}
+
+	changes := configureSwitchPorts(pc, intendedCabling(hostList), "", true)
+	if !assert.Len(changes, 3) {
+		return
+	}
+	assert.Equal(model.PortPending, changes[0].Status)
+	assert.Len(changes[0].Changes, 3)
+	assert.Equal(model.PortUnchanged, changes[1].Status)
+	assert.Equal(model.PortFailed, changes[2].Status)
+	assert.Empty(pc.set)
+
+	changes = configureSwitchPorts(pc, intendedCabling(hostList), model.PortAdminUp, false)
+	assert.Equal(model.PortChanged, changes[0].Status)
+	assert.Equal(model.PortUnchanged, changes[1].Status)
+	if assert.Contains(pc.set, "1") {
+		assert.Equal(&model.SwitchPortConfig{Description: "tux01.example.com", VLAN: "1001", MTU: 9000}, pc.set["1"])
+	}
+}
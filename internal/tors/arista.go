@@ -5,6 +5,7 @@
 package tors
 
 import (
+	"fmt"
 	"net"
 	"strconv"
 	"strings"
@@ -191,3 +192,58 @@ func (a *Arista) GetLLDPNeighbors() (model.LLDPNeighbors, error) {
 	}
 	return lldp, nil
 }
+
+// aristaPortName returns the interface name of a port: 5 is Ethernet5
+func aristaPortName(port string) string {
+	if isPortNumber(port) {
+		return "Ethernet" + port
+	}
+
+	return port
+}
+
+func (a *Arista) GetPortConfig(port string) (*model.SwitchPortConfig, error) {
+	name := aristaPortName(port)
+	show := module.Show(a.client)
+
+	iface, ok := show.ShowInterfaces().Interfaces[name]
+	if !ok {
+		return nil, fmt.Errorf("interface %s not found", name)
+	}
+
+	config := &model.SwitchPortConfig{
+		Description: iface.Description,
+		MTU:         iface.Mtu,
+		AdminState:  model.PortAdminUp,
+	}
+	if iface.InterfaceStatus == "disabled" {
+		config.AdminState = model.PortAdminDown
+	}
+
+	if sp, ok := show.ShowInterfacesSwitchport().Switchports[name]; ok && sp.SwitchportInfo.Mode == "access" {
+		config.VLAN = strconv.Itoa(sp.SwitchportInfo.AccessVlanID)
+	}
+
+	return config, nil
+}
+
+func (a *Arista) SetPortConfig(port string, config *model.SwitchPortConfig) error {
+	cmds := []string{"interface " + aristaPortName(port)}
+	if config.Description != "" {
+		cmds = append(cmds, "description "+config.Description)
+	}
+	if config.MTU != 0 {
+		cmds = append(cmds, fmt.Sprintf("mtu %d", config.MTU))
+	}
+	if config.VLAN != "" {
+		cmds = append(cmds, "switchport mode access", "switchport access vlan "+config.VLAN)
+	}
+	switch config.AdminState {
+	case model.PortAdminUp:
+		cmds = append(cmds, "no shutdown")
+	case model.PortAdminDown:
+		cmds = append(cmds, "shutdown")
+	}
+
+	return a.client.ConfigWithErr(cmds...)
+}
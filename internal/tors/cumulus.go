@@ -0,0 +1,273 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package tors
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	CUMULUS_NVUE_INTERFACES = "/nvue_v1/interface?rev=operational"
+	CUMULUS_NVUE_MACTABLE   = "/nvue_v1/bridge/domain/br_default/mac-table?rev=operational"
+)
+
+// Cumulus queries NVIDIA Cumulus Linux switches with the NVUE REST API
+type Cumulus struct {
+	endpoint string
+	user     string
+	password string
+	client   *http.Client
+}
+
+type cumulusInterface struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Link        struct {
+		AdminStatus string `json:"admin-status"`
+		OperStatus  string `json:"oper-status"`
+		MAC         string `json:"mac"`
+		MTU         int    `json:"mtu"`
+		Speed       string `json:"speed"`
+	} `json:"link"`
+	LLDP struct {
+		Neighbor map[string]struct {
+			Chassis struct {
+				ChassisId         string `json:"chassis-id"`
+				SystemName        string `json:"system-name"`
+				SystemDescription string `json:"system-description"`
+				ManagementAddress string `json:"management-address-ipv4"`
+			} `json:"chassis"`
+			Port struct {
+				Name        string `json:"name"`
+				Description string `json:"description"`
+				Type        string `json:"type"`
+			} `json:"port"`
+		} `json:"neighbor"`
+	} `json:"lldp"`
+}
+
+type cumulusMacTableEntry struct {
+	Interface string `json:"interface"`
+	MAC       string `json:"mac"`
+	VLAN      int    `json:"vlan"`
+	EntryType string `json:"entry-type"`
+}
+
+func NewCumulus(endpoint, user, password, cacert string, insecure bool) (*Cumulus, error) {
+	tr := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure}}
+
+	pem, err := os.ReadFile(cacert)
+	if err == nil {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("Failed to read cacert: %s", cacert)
+		}
+
+		tr = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: certPool, InsecureSkipVerify: false}}
+	}
+
+	c := &Cumulus{
+		user:     user,
+		password: password,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		client:   &http.Client{Timeout: time.Second * 20, Transport: tr},
+	}
+
+	return c, nil
+}
+
+func (c *Cumulus) URL(resource string) string {
+	return fmt.Sprintf("%s%s", c.endpoint, resource)
+}
+
+func (c *Cumulus) get(resource string) ([]byte, error) {
+	url := c.URL(resource)
+	log.Infof("Requesting %s", url)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if c.user != "" && c.password != "" {
+		req.SetBasicAuth(c.user, c.password)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s with HTTP status code: %d", resource, res.StatusCode)
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+func (c *Cumulus) interfaces() (map[string]*cumulusInterface, error) {
+	rawJson, err := c.get(CUMULUS_NVUE_INTERFACES)
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaces map[string]*cumulusInterface
+	err = json.Unmarshal(rawJson, &ifaces)
+	if err != nil {
+		return nil, err
+	}
+
+	return ifaces, nil
+}
+
+func (c *Cumulus) GetMACTable() (model.MACTable, error) {
+	rawJson, err := c.get(CUMULUS_NVUE_MACTABLE)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Cumulus json response: %s", rawJson)
+
+	var entries map[string]*cumulusMacTableEntry
+	err = json.Unmarshal(rawJson, &entries)
+	if err != nil {
+		return nil, err
+	}
+
+	macTable := parseCumulusMACTable(entries)
+
+	log.Infof("Received %d entries", len(macTable))
+	return macTable, nil
+}
+
+func (c *Cumulus) GetLLDPNeighbors() (model.LLDPNeighbors, error) {
+	ifaces, err := c.interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCumulusLLDPNeighbors(ifaces), nil
+}
+
+func (c *Cumulus) GetInterfaceStatus() (model.InterfaceTable, error) {
+	ifaces, err := c.interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCumulusInterfaceStatus(ifaces), nil
+}
+
+// cumulusPort returns the port number of a switch port named swpN or swpNsM
+// for breakout ports
+func cumulusPort(iface string) (int, bool) {
+	if !strings.HasPrefix(iface, "swp") {
+		return 0, false
+	}
+	portStr, _, _ := strings.Cut(strings.TrimPrefix(iface, "swp"), "s")
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return 0, false
+	}
+
+	return port, true
+}
+
+func parseCumulusMACTable(entries map[string]*cumulusMacTableEntry) model.MACTable {
+	macTable := make(model.MACTable, 0)
+
+	for _, entry := range entries {
+		port, ok := cumulusPort(entry.Interface)
+		if !ok {
+			continue
+		}
+
+		mac, err := net.ParseMAC(entry.MAC)
+		if err != nil {
+			log.Errorf("Invalid mac address entry %s: %v", entry.MAC, err)
+			continue
+		}
+
+		macTable[entry.MAC] = &model.MACTableEntry{
+			Ifname: entry.Interface,
+			Port:   port,
+			VLAN:   strconv.Itoa(entry.VLAN),
+			Type:   entry.EntryType,
+			MAC:    mac,
+		}
+	}
+
+	return macTable
+}
+
+func parseCumulusLLDPNeighbors(ifaces map[string]*cumulusInterface) model.LLDPNeighbors {
+	lldp := make(model.LLDPNeighbors, 0)
+
+	for name, iface := range ifaces {
+		for _, n := range iface.LLDP.Neighbor {
+			chassisIdType := ""
+			chassisId := n.Chassis.ChassisId
+			if mac, err := net.ParseMAC(chassisId); err == nil {
+				chassisId = mac.String()
+				chassisIdType = "MAC_ADDRESS"
+			}
+
+			lldp[name] = &model.LLDP{
+				PortName:          name,
+				ChassisId:         chassisId,
+				ChassisIdType:     chassisIdType,
+				SystemName:        n.Chassis.SystemName,
+				SystemDescription: n.Chassis.SystemDescription,
+				ManagementAddress: n.Chassis.ManagementAddress,
+				PortDescription:   n.Port.Description,
+				PortId:            n.Port.Name,
+				PortIdType:        n.Port.Type,
+			}
+		}
+	}
+
+	return lldp
+}
+
+// parseCumulusInterfaceStatus returns the status of the swpN ports. Breakout
+// ports share the port number of their parent and are skipped.
+func parseCumulusInterfaceStatus(ifaces map[string]*cumulusInterface) model.InterfaceTable {
+	interfaceTable := make(model.InterfaceTable, 0)
+
+	for name, iface := range ifaces {
+		port, ok := cumulusPort(name)
+		if !ok || strings.Contains(strings.TrimPrefix(name, "swp"), "s") {
+			continue
+		}
+
+		mac, _ := net.ParseMAC(iface.Link.MAC)
+		interfaceTable[port] = &model.InterfaceStatus{
+			Name:               name,
+			LineProtocolStatus: iface.Link.OperStatus,
+			InterfaceStatus:    iface.Link.AdminStatus,
+			PhysicalAddress:    mac,
+			Description:        iface.Description,
+			Hardware:           iface.Type,
+			Bandwidth:          parseSpeed(iface.Link.Speed),
+			MTU:                iface.Link.MTU,
+		}
+	}
+
+	return interfaceTable
+}
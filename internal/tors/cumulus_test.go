@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package tors
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCumulus(t *testing.T) {
+	endpoint := os.Getenv("GRENDEL_CUMULUS_ENDPOINT")
+	user := os.Getenv("GRENDEL_CUMULUS_USER")
+	pass := os.Getenv("GRENDEL_CUMULUS_PASS")
+
+	if endpoint == "" || user == "" || pass == "" {
+		t.Skip("Skipping Cumulus test. Missing env vars")
+	}
+
+	client, err := NewCumulus(endpoint, user, pass, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	macTable, err := client.GetMACTable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(macTable) == 0 {
+		t.Errorf("No mac table entries returned from api")
+	}
+
+	for _, entry := range macTable {
+		fmt.Printf("%s - %d\n", entry.MAC, entry.Port)
+	}
+}
+
+func TestCumulusParse(t *testing.T) {
+	assert := assert.New(t)
+
+	var entries map[string]*cumulusMacTableEntry
+	err := json.Unmarshal([]byte(`{
+		"0": {"interface": "swp12", "mac": "AA:BB:CC:00:00:01", "vlan": 10, "entry-type": "permanent"},
+		"1": {"interface": "swp3s1", "mac": "aa:bb:cc:00:00:02", "vlan": 10},
+		"2": {"interface": "bond0", "mac": "aa:bb:cc:00:00:03", "vlan": 10}
+	}`), &entries)
+	if !assert.NoError(err) {
+		return
+	}
+
+	macTable := parseCumulusMACTable(entries)
+	if assert.Len(macTable, 2) && assert.NotNil(macTable["AA:BB:CC:00:00:01"]) {
+		assert.Equal(12, macTable["AA:BB:CC:00:00:01"].Port)
+		assert.Equal("10", macTable["AA:BB:CC:00:00:01"].VLAN)
+		assert.Equal(3, macTable["aa:bb:cc:00:00:02"].Port)
+	}
+
+	var ifaces map[string]*cumulusInterface
+	err = json.Unmarshal([]byte(`{
+		"swp1": {
+			"type": "swp",
+			"description": "cpn-d13-01",
+			"link": {"admin-status": "up", "oper-status": "up", "mac": "48:b0:2d:00:00:01", "mtu": 9216, "speed": "100G"},
+			"lldp": {"neighbor": {"cpn-d13-01": {
+				"chassis": {"chassis-id": "aa:bb:cc:00:00:01", "system-name": "cpn-d13-01", "system-description": "Rocky Linux 9", "management-address-ipv4": "10.64.13.1"},
+				"port": {"name": "eno1", "description": "eno1", "type": "ifname"}
+			}}}
+		},
+		"swp2s0": {"type": "swp", "link": {"admin-status": "down"}},
+		"eth0": {"type": "eth"}
+	}`), &ifaces)
+	if !assert.NoError(err) {
+		return
+	}
+
+	neighbors := parseCumulusLLDPNeighbors(ifaces)
+	if assert.Len(neighbors, 1) && assert.NotNil(neighbors["swp1"]) {
+		assert.Equal("cpn-d13-01", neighbors["swp1"].SystemName)
+		assert.Equal("eno1", neighbors["swp1"].PortId)
+		assert.Equal("MAC_ADDRESS", neighbors["swp1"].ChassisIdType)
+		assert.Equal("10.64.13.1", neighbors["swp1"].ManagementAddress)
+	}
+
+	status := parseCumulusInterfaceStatus(ifaces)
+	if assert.Len(status, 1) && assert.NotNil(status[1]) {
+		assert.Equal("swp1", status[1].Name)
+		assert.Equal("up", status[1].LineProtocolStatus)
+		assert.Equal(9216, status[1].MTU)
+		assert.Equal(100000000000, status[1].Bandwidth)
+		assert.Equal("cpn-d13-01", status[1].Description)
+	}
+}
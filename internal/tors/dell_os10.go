@@ -5,6 +5,7 @@
 package tors
 
 import (
+	"bytes"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
@@ -15,6 +16,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -25,6 +27,7 @@ import (
 const (
 	DELLOS10_RESTCONF_MACTABLE   = "/restconf/data/dell-l2-mac:oper-params"
 	DELLOS10_RESTCONF_Interfaces = "/restconf/data/interfaces-state/interface"
+	DELLOS10_RESTCONF_Config     = "/restconf/data/interfaces"
 )
 
 type DellOS10 struct {
@@ -35,8 +38,8 @@ type DellOS10 struct {
 }
 
 type dellMacTable struct {
-	DynamicCount int                  `json:"dynamic-mac-count`
-	StaticCount  int                  `json:"static-mac-count`
+	DynamicCount int                  `json:"dynamic-mac-count"`
+	StaticCount  int                  `json:"static-mac-count"`
 	Entries      []*dellMacTableEntry `json:"fwd-table"`
 }
 
@@ -316,3 +319,140 @@ func (d *DellOS10) GetLLDPNeighbors() (model.LLDPNeighbors, error) {
 func (d *DellOS10) GetInterfaceStatus() (model.InterfaceTable, error) {
 	return nil, errors.New("Interface Status not supported on Dell OS10")
 }
+
+type dellPortConfig struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type,omitempty"`
+	Description   string   `json:"description,omitempty"`
+	MTU           int      `json:"mtu,omitempty"`
+	Enabled       *bool    `json:"enabled,omitempty"`
+	UntaggedPorts []string `json:"dell-interface:untagged-ports,omitempty"`
+}
+
+type dellPortConfigs struct {
+	Interfaces struct {
+		Interface []*dellPortConfig `json:"interface"`
+	} `json:"ietf-interfaces:interfaces"`
+}
+
+// dellPortName returns the interface name of a port: 5 is ethernet1/1/5
+func dellPortName(port string) string {
+	if isPortNumber(port) {
+		return "ethernet1/1/" + port
+	}
+
+	return port
+}
+
+func (d *DellOS10) GetPortConfig(port string) (*model.SwitchPortConfig, error) {
+	name := dellPortName(port)
+
+	req, err := d.getRequest(d.URL(DELLOS10_RESTCONF_Config))
+	if err != nil {
+		return nil, err
+	}
+	res, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Failed to fetch interfaces with HTTP status code: %d", res.StatusCode)
+	}
+
+	var configs dellPortConfigs
+	err = json.NewDecoder(res.Body).Decode(&configs)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseDellPortConfig(&configs, name)
+}
+
+// parseDellPortConfig returns the configuration of a port from the
+// configuration of all interfaces. The access VLAN is the VLAN interface with
+// the port untagged.
+func parseDellPortConfig(configs *dellPortConfigs, name string) (*model.SwitchPortConfig, error) {
+	var config *model.SwitchPortConfig
+	vlan := ""
+	for _, iface := range configs.Interfaces.Interface {
+		if iface.Name == name {
+			config = &model.SwitchPortConfig{
+				Description: iface.Description,
+				MTU:         iface.MTU,
+				AdminState:  model.PortAdminUp,
+			}
+			if iface.Enabled != nil && !*iface.Enabled {
+				config.AdminState = model.PortAdminDown
+			}
+		}
+		if strings.HasPrefix(iface.Name, "vlan") && slices.Contains(iface.UntaggedPorts, name) {
+			vlan = strings.TrimPrefix(iface.Name, "vlan")
+		}
+	}
+
+	if config == nil {
+		return nil, fmt.Errorf("interface %s not found", name)
+	}
+	config.VLAN = vlan
+
+	return config, nil
+}
+
+func (d *DellOS10) SetPortConfig(port string, config *model.SwitchPortConfig) error {
+	name := dellPortName(port)
+
+	var configs dellPortConfigs
+	portConfig := &dellPortConfig{
+		Name:        name,
+		Description: config.Description,
+		MTU:         config.MTU,
+	}
+	if config.AdminState != "" {
+		enabled := config.AdminState == model.PortAdminUp
+		portConfig.Enabled = &enabled
+	}
+	configs.Interfaces.Interface = append(configs.Interfaces.Interface, portConfig)
+
+	if config.VLAN != "" {
+		configs.Interfaces.Interface = append(configs.Interfaces.Interface, &dellPortConfig{
+			Name:          "vlan" + config.VLAN,
+			Type:          "iana-if-type:l2vlan",
+			UntaggedPorts: []string{name},
+		})
+	}
+
+	body, err := json.Marshal(&configs)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, d.URL(DELLOS10_RESTCONF_Config), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if d.user != "" && d.password != "" {
+		req.SetBasicAuth(d.user, d.password)
+	}
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 300 {
+		return nil
+	}
+
+	var derr map[string]map[string][]*dellRestconfError
+	if err := json.NewDecoder(res.Body).Decode(&derr); err == nil {
+		if rec := derr["ietf-restconf:errors"]["error"]; len(rec) > 0 {
+			return fmt.Errorf("Failed to configure %s: %s - %s", name, rec[0].Tag, rec[0].Message)
+		}
+	}
+
+	return fmt.Errorf("Failed to configure %s with HTTP status code: %d", name, res.StatusCode)
+}
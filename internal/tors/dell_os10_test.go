@@ -6,8 +6,12 @@ package tors
 
 import (
 	//"fmt"
+	"encoding/json"
 	"os"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/model"
 )
 
 func TestDellOS10(t *testing.T) {
@@ -37,3 +41,27 @@ func TestDellOS10(t *testing.T) {
 	//	fmt.Printf("%s - %d\n", entry.MAC, entry.Port)
 	//	}
 }
+
+func TestDellOS10PortConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	var configs dellPortConfigs
+	err := json.Unmarshal([]byte(`{"ietf-interfaces:interfaces": {"interface": [
+		{"name": "ethernet1/1/5", "description": "tux05", "mtu": 9216, "enabled": false},
+		{"name": "vlan1001", "dell-interface:untagged-ports": ["ethernet1/1/4", "ethernet1/1/5"]}
+	]}}`), &configs)
+	if !assert.NoError(err) {
+		return
+	}
+
+	config, err := parseDellPortConfig(&configs, dellPortName("5"))
+	if assert.NoError(err) {
+		assert.Equal("tux05", config.Description)
+		assert.Equal(9216, config.MTU)
+		assert.Equal("1001", config.VLAN)
+		assert.Equal(model.PortAdminDown, config.AdminState)
+	}
+
+	_, err = parseDellPortConfig(&configs, "ethernet1/1/6")
+	assert.Error(err)
+}
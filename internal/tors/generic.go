@@ -5,47 +5,299 @@
 package tors
 
 import (
-	"errors"
 	"fmt"
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/alouca/gosnmp"
+	"github.com/gosnmp/gosnmp"
+	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/pkg/model"
 )
 
 const (
-	dot1qTpFdbAddress = ".1.3.6.1.2.1.17.7.1.2.2.1.2."
+	sysDescr              = ".1.3.6.1.2.1.1.1.0"
+	dot1qTpFdbPort        = ".1.3.6.1.2.1.17.7.1.2.2.1.2"
+	dot1dBasePortIfIndex  = ".1.3.6.1.2.1.17.1.4.1.2"
+	ifDescr               = ".1.3.6.1.2.1.2.2.1.2"
+	ifType                = ".1.3.6.1.2.1.2.2.1.3"
+	ifMtu                 = ".1.3.6.1.2.1.2.2.1.4"
+	ifPhysAddress         = ".1.3.6.1.2.1.2.2.1.6"
+	ifAdminStatus         = ".1.3.6.1.2.1.2.2.1.7"
+	ifOperStatus          = ".1.3.6.1.2.1.2.2.1.8"
+	ifName                = ".1.3.6.1.2.1.31.1.1.1.1"
+	ifHighSpeed           = ".1.3.6.1.2.1.31.1.1.1.15"
+	ifAlias               = ".1.3.6.1.2.1.31.1.1.1.18"
+	lldpLocPortIdSubtype  = ".1.0.8802.1.1.2.1.3.7.1.2"
+	lldpLocPortId         = ".1.0.8802.1.1.2.1.3.7.1.3"
+	lldpRemTable          = ".1.0.8802.1.1.2.1.4.1.1"
+	lldpRemManAddrIfIndex = ".1.0.8802.1.1.2.1.4.2.1.4"
 )
 
+// Columns of the LLDP-MIB lldpRemTable
+const (
+	lldpRemChassisIdSubtype = 4
+	lldpRemChassisId        = 5
+	lldpRemPortIdSubtype    = 6
+	lldpRemPortId           = 7
+	lldpRemPortDesc         = 8
+	lldpRemSysName          = 9
+	lldpRemSysDesc          = 10
+)
+
+func init() {
+	viper.SetDefault("snmp.version", "2c")
+	viper.SetDefault("snmp.community", "public")
+	viper.SetDefault("snmp.port", 161)
+	viper.SetDefault("snmp.timeout", "15s")
+	viper.SetDefault("snmp.retries", 1)
+	viper.SetDefault("snmp.auth_protocol", "SHA")
+	viper.SetDefault("snmp.priv_protocol", "AES")
+}
+
+// SNMPConfig are the SNMP settings used to query switches. Version 3 uses the
+// user security model with authentication if AuthPassword is set and privacy
+// if PrivPassword is set too.
+type SNMPConfig struct {
+	Version      string
+	Community    string
+	Port         uint16
+	Timeout      time.Duration
+	Retries      int
+	User         string
+	AuthProtocol string
+	AuthPassword string
+	PrivProtocol string
+	PrivPassword string
+	Context      string
+}
+
+// NewSNMPConfig returns the SNMP settings from the snmp section of the config
+// file
+func NewSNMPConfig() *SNMPConfig {
+	return &SNMPConfig{
+		Version:      viper.GetString("snmp.version"),
+		Community:    viper.GetString("snmp.community"),
+		Port:         uint16(viper.GetUint("snmp.port")),
+		Timeout:      viper.GetDuration("snmp.timeout"),
+		Retries:      viper.GetInt("snmp.retries"),
+		User:         viper.GetString("snmp.user"),
+		AuthProtocol: viper.GetString("snmp.auth_protocol"),
+		AuthPassword: viper.GetString("snmp.auth_password"),
+		PrivProtocol: viper.GetString("snmp.priv_protocol"),
+		PrivPassword: viper.GetString("snmp.priv_password"),
+		Context:      viper.GetString("snmp.context"),
+	}
+}
+
+// Generic queries switches of any vendor with the standard Q-BRIDGE-MIB,
+// IF-MIB and LLDP-MIB over SNMP
 type Generic struct {
-	endpoint  string
-	community string
+	endpoint string
+	config   *SNMPConfig
 }
 
-func NewGeneric(endpoint, community string) (*Generic, error) {
-	g := &Generic{endpoint: endpoint, community: community}
+func NewGeneric(endpoint string, config *SNMPConfig) (*Generic, error) {
+	if config == nil {
+		config = NewSNMPConfig()
+	}
+
+	g := &Generic{endpoint: endpoint, config: config}
+	if _, err := g.client(); err != nil {
+		return nil, err
+	}
 
 	return g, nil
 }
 
+// client returns an unconnected SNMP client for the switch
+func (g *Generic) client() (*gosnmp.GoSNMP, error) {
+	client := &gosnmp.GoSNMP{
+		Target:             g.endpoint,
+		Port:               g.config.Port,
+		Community:          g.config.Community,
+		Timeout:            g.config.Timeout,
+		Retries:            g.config.Retries,
+		ExponentialTimeout: true,
+		MaxOids:            gosnmp.MaxOids,
+		ContextName:        g.config.Context,
+	}
+
+	if host, port, err := net.SplitHostPort(g.endpoint); err == nil {
+		p, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SNMP port %s: %w", port, err)
+		}
+		client.Target = host
+		client.Port = uint16(p)
+	}
+	if client.Port == 0 {
+		client.Port = 161
+	}
+	if client.Timeout == 0 {
+		client.Timeout = 15 * time.Second
+	}
+
+	switch g.config.Version {
+	case "1":
+		client.Version = gosnmp.Version1
+	case "2c", "":
+		client.Version = gosnmp.Version2c
+	case "3":
+		client.Version = gosnmp.Version3
+		client.SecurityModel = gosnmp.UserSecurityModel
+
+		usm := &gosnmp.UsmSecurityParameters{
+			UserName:                 g.config.User,
+			AuthenticationProtocol:   gosnmp.NoAuth,
+			PrivacyProtocol:          gosnmp.NoPriv,
+			AuthenticationPassphrase: g.config.AuthPassword,
+			PrivacyPassphrase:        g.config.PrivPassword,
+		}
+		client.MsgFlags = gosnmp.NoAuthNoPriv
+		if g.config.AuthPassword != "" {
+			auth, err := snmpAuthProtocol(g.config.AuthProtocol)
+			if err != nil {
+				return nil, err
+			}
+			usm.AuthenticationProtocol = auth
+			client.MsgFlags = gosnmp.AuthNoPriv
+		}
+		if g.config.AuthPassword != "" && g.config.PrivPassword != "" {
+			priv, err := snmpPrivProtocol(g.config.PrivProtocol)
+			if err != nil {
+				return nil, err
+			}
+			usm.PrivacyProtocol = priv
+			client.MsgFlags = gosnmp.AuthPriv
+		}
+		client.SecurityParameters = usm
+	default:
+		return nil, fmt.Errorf("invalid SNMP version %s: must be 1, 2c or 3", g.config.Version)
+	}
+
+	return client, nil
+}
+
+func snmpAuthProtocol(name string) (gosnmp.SnmpV3AuthProtocol, error) {
+	for _, p := range []gosnmp.SnmpV3AuthProtocol{gosnmp.MD5, gosnmp.SHA, gosnmp.SHA224, gosnmp.SHA256, gosnmp.SHA384, gosnmp.SHA512} {
+		if strings.EqualFold(p.String(), name) {
+			return p, nil
+		}
+	}
+
+	return gosnmp.NoAuth, fmt.Errorf("invalid SNMPv3 auth protocol %s", name)
+}
+
+func snmpPrivProtocol(name string) (gosnmp.SnmpV3PrivProtocol, error) {
+	for _, p := range []gosnmp.SnmpV3PrivProtocol{gosnmp.DES, gosnmp.AES, gosnmp.AES192, gosnmp.AES256, gosnmp.AES192C, gosnmp.AES256C} {
+		if strings.EqualFold(p.String(), name) {
+			return p, nil
+		}
+	}
+
+	return gosnmp.NoPriv, fmt.Errorf("invalid SNMPv3 privacy protocol %s", name)
+}
+
+// walk returns the results of walking each of the given OIDs
+func (g *Generic) walk(oids ...string) (map[string][]gosnmp.SnmpPDU, error) {
+	client, err := g.client()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.Connect(); err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", g.endpoint, err)
+	}
+	defer client.Conn.Close()
+
+	results := make(map[string][]gosnmp.SnmpPDU, len(oids))
+	for _, oid := range oids {
+		var pdus []gosnmp.SnmpPDU
+		if client.Version == gosnmp.Version1 {
+			pdus, err = client.WalkAll(oid)
+		} else {
+			pdus, err = client.BulkWalkAll(oid)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to walk %s: %w", oid, err)
+		}
+		results[oid] = pdus
+	}
+
+	return results, nil
+}
+
+// SystemDescription returns the sysDescr of the switch, used to detect its NOS
+func (g *Generic) SystemDescription() (string, error) {
+	client, err := g.client()
+	if err != nil {
+		return "", err
+	}
+
+	if err := client.Connect(); err != nil {
+		return "", fmt.Errorf("failed to connect to %s: %w", g.endpoint, err)
+	}
+	defer client.Conn.Close()
+
+	res, err := client.Get([]string{sysDescr})
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s: %w", sysDescr, err)
+	}
+	if len(res.Variables) == 0 || res.Variables[0].Type != gosnmp.OctetString {
+		return "", fmt.Errorf("no sysDescr returned from %s", g.endpoint)
+	}
+
+	return pduString(res.Variables[0]), nil
+}
+
 func (g *Generic) GetMACTable() (model.MACTable, error) {
-	macTable := make(model.MACTable, 0)
+	results, err := g.walk(dot1qTpFdbPort, dot1dBasePortIfIndex, ifName)
+	if err != nil {
+		return nil, err
+	}
+
+	macTable := parseMACTable(results[dot1qTpFdbPort], results[dot1dBasePortIfIndex], results[ifName])
+
+	log.Infof("Received %d entries", len(macTable))
+	return macTable, nil
+}
 
-	client, err := gosnmp.NewGoSNMP(g.endpoint, g.community, gosnmp.Version2c, 15)
+func (g *Generic) GetLLDPNeighbors() (model.LLDPNeighbors, error) {
+	results, err := g.walk(lldpRemTable, lldpRemManAddrIfIndex, lldpLocPortIdSubtype, lldpLocPortId, ifName)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseLLDPNeighbors(results[lldpRemTable], results[lldpRemManAddrIfIndex], results[lldpLocPortIdSubtype], results[lldpLocPortId], results[ifName]), nil
+}
+
+func (g *Generic) GetInterfaceStatus() (model.InterfaceTable, error) {
+	oids := []string{ifDescr, ifType, ifMtu, ifPhysAddress, ifAdminStatus, ifOperStatus, ifName, ifHighSpeed, ifAlias}
+	results, err := g.walk(oids...)
 	if err != nil {
 		return nil, err
 	}
 
-	results, err := client.Walk(dot1qTpFdbAddress)
-	for _, rec := range results {
+	return parseInterfaceStatus(results), nil
+}
+
+// parseMACTable returns the MAC address table from dot1qTpFdbPort, indexed by
+// VLAN and MAC address, with the bridge ports mapped to interface names by
+// dot1dBasePortIfIndex and ifName
+func parseMACTable(fdb, basePorts, ifNames []gosnmp.SnmpPDU) model.MACTable {
+	macTable := make(model.MACTable, 0)
+	names := pduStrings(ifName, ifNames)
+	ifIndexes := pduInts(dot1dBasePortIfIndex, basePorts)
+
+	for _, rec := range fdb {
 		if rec.Type != gosnmp.Integer {
-			log.Warnf("Invalid result type. Expecting Integer got: %d", rec.Type)
+			log.Warnf("Invalid result type. Expecting Integer got: %s", rec.Type)
 			continue
 		}
 
-		key := strings.Split(strings.TrimPrefix(rec.Name, dot1qTpFdbAddress), ".")
+		key := strings.Split(strings.TrimPrefix(rec.Name, dot1qTpFdbPort+"."), ".")
 		idx := 1
 		switch len(key) {
 		case 7:
@@ -70,20 +322,325 @@ func (g *Generic) GetMACTable() (model.MACTable, error) {
 			continue
 		}
 
-		macTable[macStr] = &model.MACTableEntry{
-			Port: rec.Value.(int) - 1,
+		bridgePort := int(gosnmp.ToBigInt(rec.Value).Int64())
+		entry := &model.MACTableEntry{
+			Port: bridgePort - 1,
 			VLAN: key[0],
 			MAC:  mac,
 		}
+		if ifIndex, ok := ifIndexes[strconv.Itoa(bridgePort)]; ok {
+			entry.Ifname = names[strconv.Itoa(ifIndex)]
+		}
+
+		macTable[macStr] = entry
 	}
 
-	log.Infof("Received %d entries", len(macTable))
-	return macTable, nil
+	return macTable
 }
 
-func (g *Generic) GetLLDPNeighbors() (model.LLDPNeighbors, error) {
-	return nil, errors.New("LLDP not supported with Generic switch type")
+// parseLLDPNeighbors returns the LLDP neighbors from lldpRemTable keyed by the
+// local port name. The local port number is mapped to the ifName of the
+// interface with that index, else the lldpLocPortId if it is a name.
+func parseLLDPNeighbors(remTable, manAddrs, locPortIdSubtypes, locPortIds, ifNames []gosnmp.SnmpPDU) model.LLDPNeighbors {
+	names := pduStrings(ifName, ifNames)
+	locSubtypes := pduInts(lldpLocPortIdSubtype, locPortIdSubtypes)
+	locIds := pduStrings(lldpLocPortId, locPortIds)
+
+	portName := func(localPort string) string {
+		if name, ok := names[localPort]; ok && name != "" {
+			return name
+		}
+		// interfaceAlias(1), interfaceName(5) and local(7) are printable
+		if id, ok := locIds[localPort]; ok && id != "" {
+			switch locSubtypes[localPort] {
+			case 1, 5, 7:
+				return id
+			}
+		}
+		return localPort
+	}
+
+	// Remote entries are indexed by lldpRemTimeMark.lldpRemLocalPortNum.lldpRemIndex
+	type remKey struct {
+		localPort string
+		index     string
+	}
+	neighbors := make(map[remKey]*model.LLDP)
+	order := make([]remKey, 0)
+	for _, rec := range remTable {
+		parts := strings.Split(strings.TrimPrefix(rec.Name, lldpRemTable+"."), ".")
+		if len(parts) != 4 {
+			continue
+		}
+		column, _ := strconv.Atoi(parts[0])
+		key := remKey{localPort: parts[2], index: parts[3]}
+
+		n, ok := neighbors[key]
+		if !ok {
+			n = &model.LLDP{PortName: portName(key.localPort)}
+			neighbors[key] = n
+			order = append(order, key)
+		}
+
+		switch column {
+		case lldpRemChassisIdSubtype:
+			n.ChassisIdType = chassisIdSubtype(pduInt(rec))
+		case lldpRemChassisId:
+			n.ChassisId = pduBytes(rec)
+		case lldpRemPortIdSubtype:
+			n.PortIdType = portIdSubtype(pduInt(rec))
+		case lldpRemPortId:
+			n.PortId = pduBytes(rec)
+		case lldpRemPortDesc:
+			n.PortDescription = pduString(rec)
+		case lldpRemSysName:
+			n.SystemName = pduString(rec)
+		case lldpRemSysDesc:
+			n.SystemDescription = pduString(rec)
+		}
+	}
+
+	// Management addresses are indexed by
+	// lldpRemTimeMark.lldpRemLocalPortNum.lldpRemIndex.subtype.length.address
+	for _, rec := range manAddrs {
+		parts := strings.Split(strings.TrimPrefix(rec.Name, lldpRemManAddrIfIndex+"."), ".")
+		if len(parts) < 5 {
+			continue
+		}
+		n, ok := neighbors[remKey{localPort: parts[1], index: parts[2]}]
+		if !ok || n.ManagementAddress != "" {
+			continue
+		}
+		// ipV4(1) and ipV6(2) address families
+		addr := make(net.IP, 0, 16)
+		for _, b := range parts[5:] {
+			v, _ := strconv.Atoi(b)
+			addr = append(addr, byte(v))
+		}
+		if (parts[3] == "1" && len(addr) == net.IPv4len) || (parts[3] == "2" && len(addr) == net.IPv6len) {
+			n.ManagementAddress = addr.String()
+		}
+	}
+
+	lldpNeighbors := make(model.LLDPNeighbors, len(neighbors))
+	for _, key := range order {
+		n := neighbors[key]
+		if _, ok := lldpNeighbors[n.PortName]; ok {
+			continue
+		}
+		lldpNeighbors[n.PortName] = n
+	}
+
+	return lldpNeighbors
 }
-func (g *Generic) GetInterfaceStatus() (model.InterfaceTable, error) {
-	return nil, errors.New("Interface status not supported with Generic switch type")
+
+// parseInterfaceStatus returns the status of the interfaces in IF-MIB keyed
+// by ifIndex
+func parseInterfaceStatus(results map[string][]gosnmp.SnmpPDU) model.InterfaceTable {
+	interfaceTable := make(model.InterfaceTable, 0)
+
+	get := func(ifIndex string) *model.InterfaceStatus {
+		idx, err := strconv.Atoi(ifIndex)
+		if err != nil {
+			return nil
+		}
+		status, ok := interfaceTable[idx]
+		if !ok {
+			status = &model.InterfaceStatus{}
+			interfaceTable[idx] = status
+		}
+		return status
+	}
+
+	for oid, pdus := range results {
+		for _, rec := range pdus {
+			status := get(strings.TrimPrefix(rec.Name, oid+"."))
+			if status == nil {
+				continue
+			}
+
+			switch oid {
+			case ifDescr:
+				if status.Name == "" {
+					status.Name = pduString(rec)
+				}
+			case ifName:
+				if name := pduString(rec); name != "" {
+					status.Name = name
+				}
+			case ifType:
+				status.Hardware = ifTypeName(pduInt(rec))
+			case ifMtu:
+				status.MTU = pduInt(rec)
+			case ifPhysAddress:
+				if b, ok := rec.Value.([]byte); ok && len(b) == 6 {
+					status.PhysicalAddress = net.HardwareAddr(b)
+				}
+			case ifAdminStatus:
+				status.InterfaceStatus = ifStatusName(pduInt(rec))
+			case ifOperStatus:
+				status.LineProtocolStatus = ifStatusName(pduInt(rec))
+			case ifHighSpeed:
+				status.Bandwidth = pduInt(rec) * 1000000
+			case ifAlias:
+				status.Description = pduString(rec)
+			}
+		}
+	}
+
+	return interfaceTable
+}
+
+func ifStatusName(status int) string {
+	switch status {
+	case 1:
+		return "up"
+	case 2:
+		return "down"
+	case 3:
+		return "testing"
+	case 4:
+		return "unknown"
+	case 5:
+		return "dormant"
+	case 6:
+		return "notPresent"
+	case 7:
+		return "lowerLayerDown"
+	default:
+		return ""
+	}
+}
+
+func ifTypeName(t int) string {
+	switch t {
+	case 6:
+		return "ethernetCsmacd"
+	case 24:
+		return "softwareLoopback"
+	case 53:
+		return "propVirtual"
+	case 131:
+		return "tunnel"
+	case 135:
+		return "l2vlan"
+	case 136:
+		return "l3ipvlan"
+	case 161:
+		return "ieee8023adLag"
+	default:
+		return strconv.Itoa(t)
+	}
+}
+
+func chassisIdSubtype(subtype int) string {
+	switch subtype {
+	case 1:
+		return "chassis-component"
+	case 2:
+		return "interface-alias"
+	case 3:
+		return "port-component"
+	case 4:
+		return "mac-address"
+	case 5:
+		return "network-address"
+	case 6:
+		return "interface-name"
+	case 7:
+		return "local"
+	default:
+		return ""
+	}
+}
+
+func portIdSubtype(subtype int) string {
+	switch subtype {
+	case 1:
+		return "interface-alias"
+	case 2:
+		return "port-component"
+	case 3:
+		return "mac-address"
+	case 4:
+		return "network-address"
+	case 5:
+		return "interface-name"
+	case 6:
+		return "agent-circuit-id"
+	case 7:
+		return "local"
+	default:
+		return ""
+	}
+}
+
+// pduBytes returns an LLDP chassis or port ID: MAC addresses formatted as
+// such, printable IDs as strings and others in hex
+func pduBytes(rec gosnmp.SnmpPDU) string {
+	b, ok := rec.Value.([]byte)
+	if !ok {
+		return pduString(rec)
+	}
+	if len(b) == 6 && !printable(b) {
+		return net.HardwareAddr(b).String()
+	}
+	if printable(b) {
+		return string(b)
+	}
+
+	return fmt.Sprintf("%x", b)
+}
+
+func printable(b []byte) bool {
+	for _, c := range b {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+
+	return len(b) > 0
+}
+
+func pduString(rec gosnmp.SnmpPDU) string {
+	switch v := rec.Value.(type) {
+	case []byte:
+		return strings.TrimRight(string(v), "\x00")
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func pduInt(rec gosnmp.SnmpPDU) int {
+	if rec.Value == nil {
+		return 0
+	}
+
+	return int(gosnmp.ToBigInt(rec.Value).Int64())
+}
+
+// pduStrings returns the string values of a column keyed by the index after
+// the column OID
+func pduStrings(oid string, pdus []gosnmp.SnmpPDU) map[string]string {
+	values := make(map[string]string, len(pdus))
+	for _, rec := range pdus {
+		values[strings.TrimPrefix(rec.Name, oid+".")] = pduString(rec)
+	}
+
+	return values
+}
+
+// pduInts returns the integer values of a column keyed by the index after the
+// column OID
+func pduInts(oid string, pdus []gosnmp.SnmpPDU) map[string]int {
+	values := make(map[string]int, len(pdus))
+	for _, rec := range pdus {
+		values[strings.TrimPrefix(rec.Name, oid+".")] = pduInt(rec)
+	}
+
+	return values
 }
@@ -8,8 +8,13 @@ import (
 	"fmt"
 	"os"
 	"testing"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/stretchr/testify/assert"
 )
 
+// TestGeneric queries a switch or a local snmpd stand-in, for example
+// GRENDEL_SNMP_ENDPOINT=127.0.0.1:1161
 func TestGeneric(t *testing.T) {
 	endpoint := os.Getenv("GRENDEL_SNMP_ENDPOINT")
 
@@ -17,7 +22,7 @@ func TestGeneric(t *testing.T) {
 		t.Skip("Skipping generic snmp test. Missing env vars")
 	}
 
-	client, err := NewGeneric(endpoint, "public")
+	client, err := NewGeneric(endpoint, NewSNMPConfig())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -31,6 +36,138 @@ func TestGeneric(t *testing.T) {
 		t.Errorf("No mac table entries returned from api")
 	}
 	for _, entry := range macTable {
-		fmt.Printf("%s - %d\n", entry.MAC, entry.Port)
+		fmt.Printf("%s - %d %s\n", entry.MAC, entry.Port, entry.Ifname)
+	}
+
+	lldpNeighbors, err := client.GetLLDPNeighbors()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for port, n := range lldpNeighbors {
+		fmt.Printf("%s - %s %s\n", port, n.SystemName, n.PortId)
+	}
+
+	interfaceTable, err := client.GetInterfaceStatus()
+	if err != nil {
+		t.Fatal(err)
 	}
+	if len(interfaceTable) == 0 {
+		t.Errorf("No interfaces returned from api")
+	}
+}
+
+func TestGenericParse(t *testing.T) {
+	assert := assert.New(t)
+
+	ifNames := []gosnmp.SnmpPDU{
+		{Name: ifName + ".1001", Type: gosnmp.OctetString, Value: []byte("ethernet1/1/1")},
+		{Name: ifName + ".1002", Type: gosnmp.OctetString, Value: []byte("ethernet1/1/2")},
+	}
+
+	// aa:bb:cc:00:00:01 on vlan 1001 bridge port 2
+	fdb := []gosnmp.SnmpPDU{
+		{Name: dot1qTpFdbPort + ".1001.170.187.204.0.0.1", Type: gosnmp.Integer, Value: 2},
+		{Name: dot1qTpFdbPort + ".1001.170.187.204.0.0.2", Type: gosnmp.Integer, Value: 9},
+	}
+	basePorts := []gosnmp.SnmpPDU{
+		{Name: dot1dBasePortIfIndex + ".2", Type: gosnmp.Integer, Value: 1001},
+	}
+
+	macTable := parseMACTable(fdb, basePorts, ifNames)
+	if assert.Len(macTable, 2) {
+		entry := macTable["aa:bb:cc:00:00:01"]
+		if assert.NotNil(entry) {
+			assert.Equal(1, entry.Port)
+			assert.Equal("1001", entry.VLAN)
+			assert.Equal("ethernet1/1/1", entry.Ifname)
+		}
+		assert.Empty(macTable["aa:bb:cc:00:00:02"].Ifname)
+	}
+
+	rem := lldpRemTable + ".%d.0.1002.1"
+	remTable := []gosnmp.SnmpPDU{
+		{Name: fmt.Sprintf(rem, lldpRemChassisIdSubtype), Type: gosnmp.Integer, Value: 4},
+		{Name: fmt.Sprintf(rem, lldpRemChassisId), Type: gosnmp.OctetString, Value: []byte{0xaa, 0xbb, 0xcc, 0x00, 0x01, 0x00}},
+		{Name: fmt.Sprintf(rem, lldpRemPortIdSubtype), Type: gosnmp.Integer, Value: 5},
+		{Name: fmt.Sprintf(rem, lldpRemPortId), Type: gosnmp.OctetString, Value: []byte("ethernet1/1/54")},
+		{Name: fmt.Sprintf(rem, lldpRemSysName), Type: gosnmp.OctetString, Value: []byte("swe-02.example.com")},
+		{Name: fmt.Sprintf(rem, lldpRemSysDesc), Type: gosnmp.OctetString, Value: []byte("Dell SmartFabric OS10 Enterprise")},
+		// neighbor on a port without an ifName
+		{Name: lldpRemTable + ".9.0.7.1", Type: gosnmp.OctetString, Value: []byte("tux01")},
+	}
+	manAddrs := []gosnmp.SnmpPDU{
+		{Name: lldpRemManAddrIfIndex + ".0.1002.1.1.4.10.64.0.2", Type: gosnmp.Integer, Value: 1},
+	}
+	locSubtypes := []gosnmp.SnmpPDU{
+		{Name: lldpLocPortIdSubtype + ".7", Type: gosnmp.Integer, Value: 5},
+	}
+	locIds := []gosnmp.SnmpPDU{
+		{Name: lldpLocPortId + ".7", Type: gosnmp.OctetString, Value: []byte("mgmt1/1/1")},
+	}
+
+	neighbors := parseLLDPNeighbors(remTable, manAddrs, locSubtypes, locIds, ifNames)
+	if assert.Len(neighbors, 2) {
+		n := neighbors["ethernet1/1/2"]
+		if assert.NotNil(n) {
+			assert.Equal("swe-02.example.com", n.SystemName)
+			assert.Equal("ethernet1/1/54", n.PortId)
+			assert.Equal("interface-name", n.PortIdType)
+			assert.Equal("aa:bb:cc:00:01:00", n.ChassisId)
+			assert.Equal("mac-address", n.ChassisIdType)
+			assert.Equal("10.64.0.2", n.ManagementAddress)
+		}
+		if assert.NotNil(neighbors["mgmt1/1/1"]) {
+			assert.Equal("tux01", neighbors["mgmt1/1/1"].SystemName)
+		}
+	}
+
+	status := parseInterfaceStatus(map[string][]gosnmp.SnmpPDU{
+		ifName:        ifNames,
+		ifDescr:       {{Name: ifDescr + ".1001", Type: gosnmp.OctetString, Value: []byte("eth1")}},
+		ifMtu:         {{Name: ifMtu + ".1001", Type: gosnmp.Integer, Value: 9216}},
+		ifAdminStatus: {{Name: ifAdminStatus + ".1001", Type: gosnmp.Integer, Value: 1}},
+		ifOperStatus:  {{Name: ifOperStatus + ".1001", Type: gosnmp.Integer, Value: 2}},
+		ifHighSpeed:   {{Name: ifHighSpeed + ".1001", Type: gosnmp.Gauge32, Value: uint(25000)}},
+		ifAlias:       {{Name: ifAlias + ".1001", Type: gosnmp.OctetString, Value: []byte("tux01")}},
+	})
+	if assert.Len(status, 2) && assert.NotNil(status[1001]) {
+		assert.Equal("ethernet1/1/1", status[1001].Name)
+		assert.Equal(9216, status[1001].MTU)
+		assert.Equal("up", status[1001].InterfaceStatus)
+		assert.Equal("down", status[1001].LineProtocolStatus)
+		assert.Equal(25000000000, status[1001].Bandwidth)
+		assert.Equal("tux01", status[1001].Description)
+	}
+}
+
+func TestGenericSNMPv3(t *testing.T) {
+	assert := assert.New(t)
+
+	g, err := NewGeneric("127.0.0.1:1161", &SNMPConfig{
+		Version:      "3",
+		User:         "grendel",
+		AuthProtocol: "sha256",
+		AuthPassword: "authpassword",
+		PrivProtocol: "AES",
+		PrivPassword: "privpassword",
+	})
+	if !assert.NoError(err) {
+		return
+	}
+
+	client, err := g.client()
+	if assert.NoError(err) {
+		assert.Equal("127.0.0.1", client.Target)
+		assert.Equal(uint16(1161), client.Port)
+		assert.Equal(gosnmp.AuthPriv, client.MsgFlags)
+		usm := client.SecurityParameters.(*gosnmp.UsmSecurityParameters)
+		assert.Equal(gosnmp.SHA256, usm.AuthenticationProtocol)
+		assert.Equal(gosnmp.AES, usm.PrivacyProtocol)
+	}
+
+	_, err = NewGeneric("127.0.0.1", &SNMPConfig{Version: "3", AuthProtocol: "sha3", AuthPassword: "authpassword"})
+	assert.Error(err)
+
+	_, err = NewGeneric("127.0.0.1", &SNMPConfig{Version: "4"})
+	assert.Error(err)
 }
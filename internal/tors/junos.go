@@ -0,0 +1,315 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package tors
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+const (
+	JUNOS_REST_MACTABLE   = "/rpc/get-ethernet-switching-table-information"
+	JUNOS_REST_LLDP       = "/rpc/get-lldp-neighbors-information"
+	JUNOS_REST_INTERFACES = "/rpc/get-interface-information"
+)
+
+// Junos queries Juniper switches running Junos ELS with the REST API, which
+// returns RPC replies with every value wrapped as [{"data": "..."}]
+type Junos struct {
+	endpoint string
+	user     string
+	password string
+	client   *http.Client
+}
+
+type junosValue []struct {
+	Data string `json:"data"`
+}
+
+func (v junosValue) String() string {
+	if len(v) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(v[0].Data)
+}
+
+type junosMacTable struct {
+	Info []struct {
+		VLANs []struct {
+			Entries []struct {
+				MAC       junosValue `json:"l2ng-l2-mac-address"`
+				VLAN      junosValue `json:"l2ng-l2-mac-vlan-name"`
+				Flags     junosValue `json:"l2ng-l2-mac-flags"`
+				Interface junosValue `json:"l2ng-l2-mac-logical-interface"`
+			} `json:"l2ng-mac-entry"`
+		} `json:"l2ng-l2ald-mac-entry-vlan"`
+	} `json:"l2ng-l2ald-rtb-macdb"`
+}
+
+type junosLLDP struct {
+	Info []struct {
+		Neighbors []struct {
+			LocalPortId       junosValue `json:"lldp-local-port-id"`
+			ChassisIdSubtype  junosValue `json:"lldp-remote-chassis-id-subtype"`
+			ChassisId         junosValue `json:"lldp-remote-chassis-id"`
+			PortIdSubtype     junosValue `json:"lldp-remote-port-id-subtype"`
+			PortId            junosValue `json:"lldp-remote-port-id"`
+			PortDescription   junosValue `json:"lldp-remote-port-description"`
+			SystemName        junosValue `json:"lldp-remote-system-name"`
+			SystemDescription junosValue `json:"lldp-remote-system-description"`
+			ManagementAddress junosValue `json:"lldp-remote-management-address"`
+		} `json:"lldp-neighbor-information"`
+	} `json:"lldp-neighbors-information"`
+}
+
+type junosInterfaces struct {
+	Info []struct {
+		Interfaces []struct {
+			Name        junosValue `json:"name"`
+			AdminStatus junosValue `json:"admin-status"`
+			OperStatus  junosValue `json:"oper-status"`
+			Description junosValue `json:"description"`
+			Type        junosValue `json:"if-type"`
+			MTU         junosValue `json:"mtu"`
+			Speed       junosValue `json:"speed"`
+			MAC         junosValue `json:"current-physical-address"`
+		} `json:"physical-interface"`
+	} `json:"interface-information"`
+}
+
+func NewJunos(endpoint, user, password, cacert string, insecure bool) (*Junos, error) {
+	tr := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure}}
+
+	pem, err := os.ReadFile(cacert)
+	if err == nil {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("Failed to read cacert: %s", cacert)
+		}
+
+		tr = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: certPool, InsecureSkipVerify: false}}
+	}
+
+	j := &Junos{
+		user:     user,
+		password: password,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		client:   &http.Client{Timeout: time.Second * 20, Transport: tr},
+	}
+
+	return j, nil
+}
+
+func (j *Junos) URL(resource string) string {
+	return fmt.Sprintf("%s%s", j.endpoint, resource)
+}
+
+func (j *Junos) get(resource string) ([]byte, error) {
+	url := j.URL(resource)
+	log.Infof("Requesting %s", url)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if j.user != "" && j.password != "" {
+		req.SetBasicAuth(j.user, j.password)
+	}
+
+	res, err := j.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s with HTTP status code: %d", resource, res.StatusCode)
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+func (j *Junos) GetMACTable() (model.MACTable, error) {
+	rawJson, err := j.get(JUNOS_REST_MACTABLE)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Junos json response: %s", rawJson)
+
+	var table junosMacTable
+	err = json.Unmarshal(rawJson, &table)
+	if err != nil {
+		return nil, err
+	}
+
+	macTable := parseJunosMACTable(&table)
+
+	log.Infof("Received %d entries", len(macTable))
+	return macTable, nil
+}
+
+func (j *Junos) GetLLDPNeighbors() (model.LLDPNeighbors, error) {
+	rawJson, err := j.get(JUNOS_REST_LLDP)
+	if err != nil {
+		return nil, err
+	}
+
+	var lldp junosLLDP
+	err = json.Unmarshal(rawJson, &lldp)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseJunosLLDPNeighbors(&lldp), nil
+}
+
+func (j *Junos) GetInterfaceStatus() (model.InterfaceTable, error) {
+	rawJson, err := j.get(JUNOS_REST_INTERFACES)
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaces junosInterfaces
+	err = json.Unmarshal(rawJson, &ifaces)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseJunosInterfaceStatus(&ifaces), nil
+}
+
+// junosPort returns the port number of an interface named type-fpc/pic/port,
+// with an optional :channel and .unit
+func junosPort(iface string) (int, bool) {
+	name, _, _ := strings.Cut(iface, ".")
+	name, _, _ = strings.Cut(name, ":")
+	parts := strings.Split(name, "/")
+	if len(parts) != 3 || !strings.Contains(parts[0], "-") {
+		return 0, false
+	}
+
+	port, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return 0, false
+	}
+
+	return port, true
+}
+
+func parseJunosMACTable(table *junosMacTable) model.MACTable {
+	macTable := make(model.MACTable, 0)
+
+	for _, info := range table.Info {
+		for _, vlan := range info.VLANs {
+			for _, entry := range vlan.Entries {
+				// Entries are learned on the logical interface, ge-0/0/1.0
+				ifname, _, _ := strings.Cut(entry.Interface.String(), ".")
+				port, ok := junosPort(ifname)
+				if !ok {
+					log.Debugf("failed to parse mac address table port on interface: %s", entry.Interface.String())
+					continue
+				}
+
+				mac, err := net.ParseMAC(entry.MAC.String())
+				if err != nil {
+					log.Errorf("Invalid mac address entry %s: %v", entry.MAC.String(), err)
+					continue
+				}
+
+				macTable[mac.String()] = &model.MACTableEntry{
+					Ifname: ifname,
+					Port:   port,
+					VLAN:   entry.VLAN.String(),
+					Type:   entry.Flags.String(),
+					MAC:    mac,
+				}
+			}
+		}
+	}
+
+	return macTable
+}
+
+func parseJunosLLDPNeighbors(lldp *junosLLDP) model.LLDPNeighbors {
+	o := make(model.LLDPNeighbors, 0)
+
+	for _, info := range lldp.Info {
+		for _, n := range info.Neighbors {
+			name := n.LocalPortId.String()
+			if name == "" {
+				continue
+			}
+
+			chassisIdType := n.ChassisIdSubtype.String()
+			chassisId := n.ChassisId.String()
+			if chassisIdType == "Mac address" {
+				chassisIdType = "MAC_ADDRESS"
+				if mac, err := net.ParseMAC(chassisId); err == nil {
+					chassisId = mac.String()
+				}
+			}
+
+			o[name] = &model.LLDP{
+				PortName:          name,
+				ChassisId:         chassisId,
+				ChassisIdType:     chassisIdType,
+				SystemName:        n.SystemName.String(),
+				SystemDescription: n.SystemDescription.String(),
+				ManagementAddress: n.ManagementAddress.String(),
+				PortDescription:   n.PortDescription.String(),
+				PortId:            n.PortId.String(),
+				PortIdType:        n.PortIdSubtype.String(),
+			}
+		}
+	}
+
+	return o
+}
+
+// parseJunosInterfaceStatus returns the status of the physical ports.
+// Channelized ports share the port number of their parent and are skipped.
+func parseJunosInterfaceStatus(ifaces *junosInterfaces) model.InterfaceTable {
+	interfaceTable := make(model.InterfaceTable, 0)
+
+	for _, info := range ifaces.Info {
+		for _, iface := range info.Interfaces {
+			name := iface.Name.String()
+			port, ok := junosPort(name)
+			if !ok || strings.Contains(name, ":") {
+				continue
+			}
+
+			mtu, _ := strconv.Atoi(iface.MTU.String())
+			mac, _ := net.ParseMAC(iface.MAC.String())
+			interfaceTable[port] = &model.InterfaceStatus{
+				Name:               name,
+				LineProtocolStatus: iface.OperStatus.String(),
+				InterfaceStatus:    iface.AdminStatus.String(),
+				PhysicalAddress:    mac,
+				Description:        iface.Description.String(),
+				Hardware:           iface.Type.String(),
+				Bandwidth:          parseSpeed(iface.Speed.String()),
+				MTU:                mtu,
+			}
+		}
+	}
+
+	return interfaceTable
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package tors
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJunos(t *testing.T) {
+	endpoint := os.Getenv("GRENDEL_JUNOS_ENDPOINT")
+	user := os.Getenv("GRENDEL_JUNOS_USER")
+	pass := os.Getenv("GRENDEL_JUNOS_PASS")
+
+	if endpoint == "" || user == "" || pass == "" {
+		t.Skip("Skipping Junos test. Missing env vars")
+	}
+
+	client, err := NewJunos(endpoint, user, pass, "", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	macTable, err := client.GetMACTable()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(macTable) == 0 {
+		t.Errorf("No mac table entries returned from api")
+	}
+
+	for _, entry := range macTable {
+		fmt.Printf("%s - %d\n", entry.MAC, entry.Port)
+	}
+}
+
+func TestJunosParse(t *testing.T) {
+	assert := assert.New(t)
+
+	var table junosMacTable
+	err := json.Unmarshal([]byte(`{"l2ng-l2ald-rtb-macdb": [{"l2ng-l2ald-mac-entry-vlan": [{"l2ng-mac-entry": [
+		{"l2ng-l2-mac-address": [{"data": "aa:bb:cc:00:00:01"}], "l2ng-l2-mac-vlan-name": [{"data": "compute"}], "l2ng-l2-mac-flags": [{"data": "D"}], "l2ng-l2-mac-logical-interface": [{"data": "ge-0/0/12.0"}]},
+		{"l2ng-l2-mac-address": [{"data": "aa:bb:cc:00:00:02"}], "l2ng-l2-mac-logical-interface": [{"data": "ae0.0"}]}
+	]}]}]}`), &table)
+	if !assert.NoError(err) {
+		return
+	}
+
+	macTable := parseJunosMACTable(&table)
+	if assert.Len(macTable, 1) && assert.NotNil(macTable["aa:bb:cc:00:00:01"]) {
+		assert.Equal(12, macTable["aa:bb:cc:00:00:01"].Port)
+		assert.Equal("ge-0/0/12", macTable["aa:bb:cc:00:00:01"].Ifname)
+		assert.Equal("compute", macTable["aa:bb:cc:00:00:01"].VLAN)
+	}
+
+	var lldp junosLLDP
+	err = json.Unmarshal([]byte(`{"lldp-neighbors-information": [{"lldp-neighbor-information": [{
+		"lldp-local-port-id": [{"data": "xe-0/0/48"}],
+		"lldp-remote-chassis-id-subtype": [{"data": "Mac address"}],
+		"lldp-remote-chassis-id": [{"data": "aa:bb:cc:00:01:00"}],
+		"lldp-remote-port-id-subtype": [{"data": "Interface name"}],
+		"lldp-remote-port-id": [{"data": "swp1"}],
+		"lldp-remote-system-name": [{"data": "spine-01"}]
+	}]}]}`), &lldp)
+	if !assert.NoError(err) {
+		return
+	}
+
+	neighbors := parseJunosLLDPNeighbors(&lldp)
+	if assert.Len(neighbors, 1) && assert.NotNil(neighbors["xe-0/0/48"]) {
+		assert.Equal("spine-01", neighbors["xe-0/0/48"].SystemName)
+		assert.Equal("swp1", neighbors["xe-0/0/48"].PortId)
+		assert.Equal("MAC_ADDRESS", neighbors["xe-0/0/48"].ChassisIdType)
+	}
+
+	var ifaces junosInterfaces
+	err = json.Unmarshal([]byte(`{"interface-information": [{"physical-interface": [
+		{"name": [{"data": "\nge-0/0/12\n"}], "admin-status": [{"data": "up"}], "oper-status": [{"data": "down"}], "mtu": [{"data": "1514"}], "speed": [{"data": "1000mbps"}], "current-physical-address": [{"data": "2c:6b:f5:00:00:0c"}]},
+		{"name": [{"data": "et-0/0/50:1"}]},
+		{"name": [{"data": "lo0"}]}
+	]}]}`), &ifaces)
+	if !assert.NoError(err) {
+		return
+	}
+
+	status := parseJunosInterfaceStatus(&ifaces)
+	if assert.Len(status, 1) && assert.NotNil(status[12]) {
+		assert.Equal("ge-0/0/12", status[12].Name)
+		assert.Equal("up", status[12].InterfaceStatus)
+		assert.Equal("down", status[12].LineProtocolStatus)
+		assert.Equal(1514, status[12].MTU)
+		assert.Equal(1000000000, status[12].Bandwidth)
+	}
+}
@@ -5,6 +5,7 @@
 package tors
 
 import (
+	"bytes"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
@@ -13,6 +14,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -24,6 +26,8 @@ import (
 const (
 	SONIC_RESTCONF_MACTABLE = "/restconf/data/openconfig-network-instance:network-instances/network-instance=default/fdb/mac-table/entries"
 	SONIC_RESTCONF_LLDP     = "/restconf/data/openconfig-lldp:lldp/interfaces"
+	SONIC_RESTCONF_PORT     = "/restconf/data/openconfig-interfaces:interfaces/interface=%s/config"
+	SONIC_RESTCONF_PORTVLAN = "/restconf/data/openconfig-interfaces:interfaces/interface=%s/openconfig-if-ethernet:ethernet/openconfig-vlan:switched-vlan/config"
 )
 
 type Sonic struct {
@@ -244,3 +248,138 @@ func (d *Sonic) GetLLDPNeighbors() (model.LLDPNeighbors, error) {
 	return o, nil
 	// return nil, errors.New("LLDPNeighbors not supported on SONiC")
 }
+
+type sonicPortConfig struct {
+	Config struct {
+		Description string `json:"description,omitempty"`
+		MTU         int    `json:"mtu,omitempty"`
+		Enabled     *bool  `json:"enabled,omitempty"`
+	} `json:"openconfig-interfaces:config"`
+}
+
+type sonicPortVLAN struct {
+	Config struct {
+		InterfaceMode string `json:"interface-mode,omitempty"`
+		AccessVLAN    int    `json:"access-vlan,omitempty"`
+	} `json:"openconfig-vlan:config"`
+}
+
+// sonicPortName returns the interface name of a port in standard naming mode:
+// 5 is Eth1/5
+func sonicPortName(port string) string {
+	if isPortNumber(port) {
+		return "Eth1/" + port
+	}
+
+	return port
+}
+
+func (d *Sonic) getJSON(resource string, v interface{}) error {
+	req, err := d.getRequest(d.URL(resource))
+	if err != nil {
+		return err
+	}
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch %s with HTTP status code: %d", resource, res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+func (d *Sonic) patchJSON(resource string, v interface{}) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, d.URL(resource), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/yang-data+json")
+	if d.username != "" && d.password != "" {
+		req.SetBasicAuth(d.username, d.password)
+	}
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		msg, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("failed to patch %s with HTTP status code: %d %s", resource, res.StatusCode, msg)
+	}
+
+	return nil
+}
+
+func (d *Sonic) GetPortConfig(port string) (*model.SwitchPortConfig, error) {
+	name := url.PathEscape(sonicPortName(port))
+
+	var portConfig sonicPortConfig
+	if err := d.getJSON(fmt.Sprintf(SONIC_RESTCONF_PORT, name), &portConfig); err != nil {
+		return nil, err
+	}
+	var portVLAN sonicPortVLAN
+	if err := d.getJSON(fmt.Sprintf(SONIC_RESTCONF_PORTVLAN, name), &portVLAN); err != nil {
+		return nil, err
+	}
+
+	config := &model.SwitchPortConfig{
+		Description: portConfig.Config.Description,
+		MTU:         portConfig.Config.MTU,
+		AdminState:  model.PortAdminUp,
+	}
+	if portConfig.Config.Enabled != nil && !*portConfig.Config.Enabled {
+		config.AdminState = model.PortAdminDown
+	}
+	if portVLAN.Config.AccessVLAN != 0 {
+		config.VLAN = strconv.Itoa(portVLAN.Config.AccessVLAN)
+	}
+
+	return config, nil
+}
+
+func (d *Sonic) SetPortConfig(port string, config *model.SwitchPortConfig) error {
+	name := url.PathEscape(sonicPortName(port))
+
+	var portConfig sonicPortConfig
+	portConfig.Config.Description = config.Description
+	portConfig.Config.MTU = config.MTU
+	if config.AdminState != "" {
+		enabled := config.AdminState == model.PortAdminUp
+		portConfig.Config.Enabled = &enabled
+	}
+	if config.Description != "" || config.MTU != 0 || config.AdminState != "" {
+		if err := d.patchJSON(fmt.Sprintf(SONIC_RESTCONF_PORT, name), &portConfig); err != nil {
+			return err
+		}
+	}
+
+	if config.VLAN != "" {
+		vlan, err := strconv.Atoi(config.VLAN)
+		if err != nil {
+			return fmt.Errorf("invalid vlan %s: %w", config.VLAN, err)
+		}
+
+		var portVLAN sonicPortVLAN
+		portVLAN.Config.InterfaceMode = "ACCESS"
+		portVLAN.Config.AccessVLAN = vlan
+		if err := d.patchJSON(fmt.Sprintf(SONIC_RESTCONF_PORTVLAN, name), &portVLAN); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,10 @@ package tors
 
 import (
 	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/ubccr/grendel/internal/logger"
@@ -14,13 +18,172 @@ import (
 
 var log = logger.GetLogger("SWITCH")
 
+// NOS tags of the switch drivers
+const (
+	NOSArista   = "arista"
+	NOSSonic    = "sonic"
+	NOSDellOS10 = "dellos10"
+	NOSCumulus  = "cumulus"
+	NOSJunos    = "junos"
+)
+
+// nosTags are the NOS tags in the order they are checked on a host
+var nosTags = []string{NOSArista, NOSSonic, NOSDellOS10, NOSCumulus, NOSJunos}
+
+// nosDescriptions map substrings of LLDP system descriptions and SNMP sysDescr
+// to the NOS, checked in order
+var nosDescriptions = []struct {
+	match string
+	nos   string
+}{
+	{"arista", NOSArista},
+	{"cumulus", NOSCumulus},
+	{"junos", NOSJunos},
+	{"juniper", NOSJunos},
+	{"sonic", NOSSonic},
+	{"os10", NOSDellOS10},
+	{"smartfabric", NOSDellOS10},
+}
+
+// nosProbeRetry is how long a switch whose NOS couldn't be detected with
+// SNMP isn't probed again
+const nosProbeRetry = 15 * time.Minute
+
+var (
+	detectedNOS   = make(map[string]string)
+	failedProbes  = make(map[string]time.Time)
+	detectedNOSMu sync.Mutex
+)
+
+func init() {
+	viper.SetDefault("bmc.switch_detect_nos", true)
+	viper.SetDefault("bmc.switch_force_detect_nos", false)
+}
+
 type NetworkSwitch interface {
 	GetInterfaceStatus() (model.InterfaceTable, error)
 	GetMACTable() (model.MACTable, error)
 	GetLLDPNeighbors() (model.LLDPNeighbors, error)
 }
 
+// PortConfigurator is implemented by the switch drivers that can configure
+// ports. Ports are named as on the switch or by their number.
+type PortConfigurator interface {
+	GetPortConfig(port string) (*model.SwitchPortConfig, error)
+	SetPortConfig(port string, config *model.SwitchPortConfig) error
+}
+
+// DetectNOS returns the NOS tag of a switch from its LLDP system description
+// or SNMP sysDescr, or an empty string if it isn't recognized
+func DetectNOS(description string) string {
+	description = strings.ToLower(description)
+	for _, d := range nosDescriptions {
+		if strings.Contains(description, d.match) {
+			return d.nos
+		}
+	}
+
+	return ""
+}
+
+// LearnNOS records the NOS of the switches seen as LLDP neighbors, keyed by
+// their system name, so they are known before they are queried themselves
+func LearnNOS(neighbors model.LLDPNeighbors) {
+	detectedNOSMu.Lock()
+	defer detectedNOSMu.Unlock()
+
+	for _, n := range neighbors {
+		if n == nil || n.SystemName == "" {
+			continue
+		}
+		nos := DetectNOS(n.SystemDescription)
+		if nos == "" {
+			continue
+		}
+		detectedNOS[n.SystemName] = nos
+		if short, _, ok := strings.Cut(n.SystemName, "."); ok {
+			detectedNOS[short] = nos
+		}
+	}
+}
+
+// hostNOS returns the NOS of a switch: learned from LLDP, detected from its
+// SNMP sysDescr or else from its tags. Switches tagged with a NOS are only
+// probed with SNMP if bmc.switch_force_detect_nos is set. An empty string
+// means none is known and the switch is queried with SNMP.
+func hostNOS(host *model.Host, ip string) string {
+	tagged := ""
+	for _, tag := range nosTags {
+		if host.HasTags(tag) {
+			tagged = tag
+			break
+		}
+	}
+
+	if !viper.GetBool("bmc.switch_detect_nos") {
+		return tagged
+	}
+
+	detectedNOSMu.Lock()
+	nos, ok := detectedNOS[host.Name]
+	failed, probed := failedProbes[host.Name]
+	detectedNOSMu.Unlock()
+
+	probe := tagged == "" || viper.GetBool("bmc.switch_force_detect_nos")
+	if probed && time.Since(failed) < nosProbeRetry {
+		probe = false
+	}
+
+	if !ok && ip != "" && probe {
+		// Probe quickly as switches may not answer SNMP at all
+		config := NewSNMPConfig()
+		config.Timeout = 2 * time.Second
+		config.Retries = 0
+		if g, err := NewGeneric(ip, config); err == nil {
+			desc, err := g.SystemDescription()
+			if err != nil {
+				log.Debugf("Failed to detect NOS of switch %s: %s", host.Name, err)
+			}
+			nos = DetectNOS(desc)
+		}
+
+		detectedNOSMu.Lock()
+		if nos != "" {
+			detectedNOS[host.Name] = nos
+			delete(failedProbes, host.Name)
+		} else {
+			failedProbes[host.Name] = time.Now()
+		}
+		detectedNOSMu.Unlock()
+	}
+
+	if nos == "" {
+		return tagged
+	}
+	if tagged != "" && tagged != nos {
+		log.Warnf("Switch %s is tagged %s but runs %s, using %s", host.Name, tagged, nos, nos)
+	}
+
+	return nos
+}
+
+// NewNetworkSwitch returns the driver for a switch from its NOS: arista,
+// sonic, dellos10, cumulus or junos use their APIs, others are queried with
+// SNMP. The NOS is detected from LLDP or the SNMP sysDescr if
+// bmc.switch_detect_nos is set and taken from the host tags otherwise, see
+// hostNOS.
 func NewNetworkSwitch(host *model.Host) (NetworkSwitch, error) {
+	bmc := host.InterfaceBMC()
+	ip := ""
+	if bmc != nil {
+		ip = bmc.AddrString()
+	}
+
+	nos := hostNOS(host, ip)
+	if nos == "" {
+		return NewGeneric(ip, NewSNMPConfig())
+	}
+
 	username := viper.GetString("bmc.switch_admin_username")
 	password := viper.GetString("bmc.switch_admin_password")
 
@@ -31,22 +194,48 @@ func NewNetworkSwitch(host *model.Host) (NetworkSwitch, error) {
 
 	var sw NetworkSwitch
 	var err error
-
-	bmc := host.InterfaceBMC()
-	ip := ""
-	if bmc != nil {
-		ip = bmc.AddrString()
-	}
-	// TODO: automatically determine NOS
-	if host.HasTags("arista") {
+	switch nos {
+	case NOSArista:
 		sw, err = NewArista(ip, username, password)
-	} else if host.HasTags("sonic") {
+	case NOSSonic:
 		sw, err = NewSonic(ip, username, password, "", true)
-	} else if host.HasTags("dellos10") {
+	case NOSCumulus:
+		sw, err = NewCumulus("https://"+ip+":8765", username, password, "", true)
+	case NOSJunos:
+		sw, err = NewJunos("https://"+ip+":3443", username, password, "", true)
+	default:
 		sw, err = NewDellOS10("https://"+ip, username, password, "", true)
-	} else {
-		return nil, errors.New("failed to determine switch NOS")
 	}
 
 	return sw, err
 }
+
+// parseSpeed returns the bandwidth in bits per second of a port speed such as
+// 100G, 25Gbps or 1000mbps, or 0 if it isn't known
+func parseSpeed(speed string) int {
+	speed = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(speed)), "bps")
+
+	unit := 1
+	switch {
+	case strings.HasSuffix(speed, "g"):
+		unit = 1000000000
+	case strings.HasSuffix(speed, "m"):
+		unit = 1000000
+	case strings.HasSuffix(speed, "k"):
+		unit = 1000
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimRight(speed, "gmk"), 64)
+	if err != nil {
+		return 0
+	}
+
+	return int(n * float64(unit))
+}
+
+// isPortNumber returns true if a port is given by its number instead of its
+// interface name
+func isPortNumber(port string) bool {
+	_, err := strconv.Atoi(port)
+	return err == nil
+}
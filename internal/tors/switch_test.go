@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package tors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestDetectNOS(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(NOSArista, DetectNOS("Arista Networks EOS version 4.28.3M running on an Arista Networks DCS-7050SX3-48YC8"))
+	assert.Equal(NOSCumulus, DetectNOS("Cumulus Linux version 5.4.0 running on Mellanox Technologies Ltd. MSN2010"))
+	assert.Equal(NOSJunos, DetectNOS("Juniper Networks, Inc. qfx5120-48y-8c Ethernet Switch, kernel JUNOS 21.4R3"))
+	assert.Equal(NOSSonic, DetectNOS("SONiC Software Version: SONiC.4.1.0-Enterprise_Base"))
+	assert.Equal(NOSDellOS10, DetectNOS("Dell SmartFabric OS10 Enterprise."))
+	assert.Equal("", DetectNOS("Rocky Linux 9"))
+
+	LearnNOS(model.LLDPNeighbors{
+		"xe-0/0/48": {SystemName: "spine-01.example.com", SystemDescription: "Cumulus Linux version 5.4.0"},
+		"xe-0/0/1":  {SystemName: "cpn-d13-01", SystemDescription: "Rocky Linux 9"},
+	})
+	assert.Equal(NOSCumulus, detectedNOS["spine-01"])
+	assert.Equal(NOSCumulus, detectedNOS["spine-01.example.com"])
+	assert.NotContains(detectedNOS, "cpn-d13-01")
+
+	host := &model.Host{Name: "spine-01", Tags: []string{"arista"}}
+	assert.Equal(NOSCumulus, hostNOS(host, ""))
+
+	// Tagged switches aren't probed
+	host = &model.Host{Name: "leaf-01", Tags: []string{"arista"}}
+	assert.Equal(NOSArista, hostNOS(host, "127.0.0.1"))
+	assert.NotContains(failedProbes, "leaf-01")
+
+	// Failed probes aren't retried right away
+	failed := time.Now().Add(-time.Minute)
+	failedProbes["leaf-02"] = failed
+	assert.Equal("", hostNOS(&model.Host{Name: "leaf-02"}, "127.0.0.1"))
+	assert.Equal(failed, failedProbes["leaf-02"])
+
+	assert.Equal(100000000000, parseSpeed("100G"))
+	assert.Equal(10000000000, parseSpeed("10Gbps"))
+	assert.Equal(1000000000, parseSpeed("1000mbps"))
+	assert.Equal(0, parseSpeed("Auto"))
+}
@@ -48,6 +48,58 @@ func GetFirstExternalIPFromInterfaces() (net.IP, error) {
 	return serverIps[0], nil
 }
 
+func GetFirstExternalIPv6FromInterfaces() (net.IP, error) {
+	intfs, err := interfaces.GetNonLoopbackInterfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, intf := range intfs {
+		addrs, err := intf.Addrs()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, addr := range addrs {
+			ipnet, ok := addr.(*net.IPNet)
+			if !ok || ipnet.IP.To4() != nil {
+				continue
+			}
+
+			// Skip link-local addresses as they are not routable and can't
+			// be used in boot file URLs
+			if ipnet.IP.IsGlobalUnicast() {
+				return ipnet.IP, nil
+			}
+		}
+	}
+
+	return nil, errors.New("Failed to find server ipv6 address from configured interfaces")
+}
+
+func GetInterfaceFromIPv6(ip net.IP) (*net.Interface, error) {
+	intfs, err := interfaces.GetNonLoopbackInterfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, intf := range intfs {
+		addrs, err := intf.Addrs()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, addr := range addrs {
+			ipnet, ok := addr.(*net.IPNet)
+			if ok && ipnet.IP.Equal(ip) {
+				return &intf, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("Interface not found with ip: %s", ip)
+}
+
 func GetInterfaceIPMap() (map[int]net.IP, error) {
 	intfs, err := interfaces.GetNonLoopbackInterfaces()
 	if err != nil {
@@ -33,6 +33,18 @@ type Invoker interface {
 	//
 	// DELETE /v1/auth/signout
 	DELETEV1AuthSignout(ctx context.Context, params DELETEV1AuthSignoutParams) (*GenericResponse, error)
+	// DELETEV1BmcCredentialsScopeName invokes DELETE_/v1/bmc/credentials/:scope/:name operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcCredentialDelete`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Delete a BMC credential.
+	//
+	// DELETE /v1/bmc/credentials/{scope}/{name}
+	DELETEV1BmcCredentialsScopeName(ctx context.Context, params DELETEV1BmcCredentialsScopeNameParams) (*GenericResponse, error)
 	// DELETEV1BmcJobs invokes DELETE_/v1/bmc/jobs operation.
 	//
 	// #### Controller:
@@ -57,6 +69,18 @@ type Invoker interface {
 	//
 	// DELETE /v1/bmc/jobs/{jids}
 	DELETEV1BmcJobsJids(ctx context.Context, params DELETEV1BmcJobsJidsParams) ([]JobMessage, error)
+	// DELETEV1BmcMedia invokes DELETE_/v1/bmc/media operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcMediaEject`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Eject the virtual CD/DVD of nodes.
+	//
+	// DELETE /v1/bmc/media
+	DELETEV1BmcMedia(ctx context.Context, params DELETEV1BmcMediaParams) ([]JobMessage, error)
 	// DELETEV1BmcSel invokes DELETE_/v1/bmc/sel operation.
 	//
 	// #### Controller:
@@ -69,6 +93,18 @@ type Invoker interface {
 	//
 	// DELETE /v1/bmc/sel
 	DELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelParams) ([]JobMessage, error)
+	// DELETEV1BmcTasksID invokes DELETE_/v1/bmc/tasks/:id operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcTaskCancel`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Cancel a BMC task. Nodes the task has not started on are skipped.
+	//
+	// DELETE /v1/bmc/tasks/{id}
+	DELETEV1BmcTasksID(ctx context.Context, params DELETEV1BmcTasksIDParams) (*GenericResponse, error)
 	// DELETEV1Images invokes DELETE_/v1/images operation.
 	//
 	// #### Controller:
@@ -129,6 +165,18 @@ type Invoker interface {
 	//
 	// GET /v1/bmc
 	GETV1Bmc(ctx context.Context, params GETV1BmcParams) ([]RedfishSystem, error)
+	// GETV1BmcCredentials invokes GET_/v1/bmc/credentials operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcCredentialList`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get stored BMC credentials, without passwords.
+	//
+	// GET /v1/bmc/credentials
+	GETV1BmcCredentials(ctx context.Context, params GETV1BmcCredentialsParams) ([]BmcCredential, error)
 	// GETV1BmcJobs invokes GET_/v1/bmc/jobs operation.
 	//
 	// #### Controller:
@@ -153,6 +201,30 @@ type Invoker interface {
 	//
 	// GET /v1/bmc/metrics
 	GETV1BmcMetrics(ctx context.Context, params GETV1BmcMetricsParams) ([]RedfishMetricReport, error)
+	// GETV1BmcTasks invokes GET_/v1/bmc/tasks operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcTaskList`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get BMC tasks, newest first.
+	//
+	// GET /v1/bmc/tasks
+	GETV1BmcTasks(ctx context.Context, params GETV1BmcTasksParams) ([]BmcTask, error)
+	// GETV1BmcTasksID invokes GET_/v1/bmc/tasks/:id operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcTaskGet`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get a BMC task with the status of each node.
+	//
+	// GET /v1/bmc/tasks/{id}
+	GETV1BmcTasksID(ctx context.Context, params GETV1BmcTasksIDParams) (*BmcTask, error)
 	// GETV1BmcUpgradeDellRepo invokes GET_/v1/bmc/upgrade/dell/repo operation.
 	//
 	// #### Controller:
@@ -177,6 +249,18 @@ type Invoker interface {
 	//
 	// GET /v1/db/dump
 	GETV1DbDump(ctx context.Context, params GETV1DbDumpParams) (*DataDump, error)
+	// GETV1DhcpLeases invokes GET_/v1/dhcp/leases operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).DHCPLeaseList`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// List dynamic DHCP leases.
+	//
+	// GET /v1/dhcp/leases
+	GETV1DhcpLeases(ctx context.Context, params GETV1DhcpLeasesParams) ([]DHCPLease, error)
 	// GETV1GrendelEvents invokes GET_/v1/grendel/events operation.
 	//
 	// #### Controller:
@@ -184,10 +268,23 @@ type Invoker interface {
 	// #### Middlewares:
 	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
 	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
-	// ---.
+	// ---
+	// Get audit events, newest first.
 	//
 	// GET /v1/grendel/events
 	GETV1GrendelEvents(ctx context.Context, params GETV1GrendelEventsParams) ([]Event, error)
+	// GETV1GrendelStatus invokes GET_/v1/grendel/status operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelStatus`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get the status of this Grendel instance and the current HA leader.
+	//
+	// GET /v1/grendel/status
+	GETV1GrendelStatus(ctx context.Context, params GETV1GrendelStatusParams) (*GrendelStatus, error)
 	// GETV1Images invokes GET_/v1/images operation.
 	//
 	// #### Controller:
@@ -200,6 +297,18 @@ type Invoker interface {
 	//
 	// GET /v1/images
 	GETV1Images(ctx context.Context, params GETV1ImagesParams) ([]BootImage, error)
+	// GETV1ImagesArtifacts invokes GET_/v1/images/artifacts operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).ArtifactList`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// List the stored image artifacts.
+	//
+	// GET /v1/images/artifacts
+	GETV1ImagesArtifacts(ctx context.Context, params GETV1ImagesArtifactsParams) ([]Artifact, error)
 	// GETV1ImagesFind invokes GET_/v1/images/find operation.
 	//
 	// #### Controller:
@@ -212,6 +321,30 @@ type Invoker interface {
 	//
 	// GET /v1/images/find
 	GETV1ImagesFind(ctx context.Context, params GETV1ImagesFindParams) ([]BootImage, error)
+	// GETV1ImagesRollouts invokes GET_/v1/images/rollouts operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).RolloutList`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// List image rollouts and the provision states of their canary nodes.
+	//
+	// GET /v1/images/rollouts
+	GETV1ImagesRollouts(ctx context.Context, params GETV1ImagesRolloutsParams) ([]Rollout, error)
+	// GETV1ImagesVersionsName invokes GET_/v1/images/versions/:name operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageVersions`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// List the versions of an image.
+	//
+	// GET /v1/images/versions/{name}
+	GETV1ImagesVersionsName(ctx context.Context, params GETV1ImagesVersionsNameParams) ([]BootImageVersion, error)
 	// GETV1Nodes invokes GET_/v1/nodes operation.
 	//
 	// #### Controller:
@@ -236,6 +369,78 @@ type Invoker interface {
 	//
 	// GET /v1/nodes/find
 	GETV1NodesFind(ctx context.Context, params GETV1NodesFindParams) ([]Host, error)
+	// GETV1NodesHistoryName invokes GET_/v1/nodes/history/:name operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeHistory`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get the DHCP, DHCPv6 and proxy PXE messages sent to and received from a node, oldest first.
+	//
+	// GET /v1/nodes/history/{name}
+	GETV1NodesHistoryName(ctx context.Context, params GETV1NodesHistoryNameParams) ([]DHCPEvent, error)
+	// GETV1NodesInventory invokes GET_/v1/nodes/inventory operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeInventory`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get the hardware inventory of nodes collected from their BMCs.
+	//
+	// GET /v1/nodes/inventory
+	GETV1NodesInventory(ctx context.Context, params GETV1NodesInventoryParams) ([]InventoryComponent, error)
+	// GETV1NodesInventoryChanges invokes GET_/v1/nodes/inventory/changes operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeInventoryChanges`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get hardware inventory changes found between collections, such as replaced parts.
+	//
+	// GET /v1/nodes/inventory/changes
+	GETV1NodesInventoryChanges(ctx context.Context, params GETV1NodesInventoryChangesParams) ([]InventoryChange, error)
+	// GETV1NodesLogsName invokes GET_/v1/nodes/logs/:name operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeLogs`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get the install progress messages and logs sent by a node since it was last set to provision.
+	//
+	// GET /v1/nodes/logs/{name}
+	GETV1NodesLogsName(ctx context.Context, params GETV1NodesLogsNameParams) ([]ProvisionLog, error)
+	// GETV1NodesProvision invokes GET_/v1/nodes/provision operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeProvisionStates`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get the current provision state of nodes by nodeset and/or tags.
+	//
+	// GET /v1/nodes/provision
+	GETV1NodesProvision(ctx context.Context, params GETV1NodesProvisionParams) ([]ProvisionEvent, error)
+	// GETV1NodesProvisionName invokes GET_/v1/nodes/provision/:name operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).NodeProvisionHistory`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get the provision states of a node since it was last set to provision.
+	//
+	// GET /v1/nodes/provision/{name}
+	GETV1NodesProvisionName(ctx context.Context, params GETV1NodesProvisionNameParams) ([]ProvisionEvent, error)
 	// GETV1NodesTokenInterface invokes GET_/v1/nodes/token/:interface operation.
 	//
 	// #### Controller:
@@ -272,6 +477,43 @@ type Invoker interface {
 	//
 	// GET /v1/switch/{nodeset}/lldp
 	GETV1SwitchNodesetLldp(ctx context.Context, params GETV1SwitchNodesetLldpParams) ([]LLDP, error)
+	// GETV1Topology invokes GET_/v1/topology operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).Topology`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get the network topology graph of the links seen on switch ports.
+	//
+	// GET /v1/topology
+	GETV1Topology(ctx context.Context, params GETV1TopologyParams) (*TopologyGraph, error)
+	// GETV1TopologyCabling invokes GET_/v1/topology/cabling operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).TopologyCabling`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Validate the intended switch ports of node interfaces against the MAC address tables and LLDP
+	// neighbors of their switches.
+	//
+	// GET /v1/topology/cabling
+	GETV1TopologyCabling(ctx context.Context, params GETV1TopologyCablingParams) (*CablingReport, error)
+	// GETV1TopologyDot invokes GET_/v1/topology/dot operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).TopologyDot`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get the network topology graph in the Graphviz DOT language.
+	//
+	// GET /v1/topology/dot
+	GETV1TopologyDot(ctx context.Context, params GETV1TopologyDotParams) (GETV1TopologyDotOK, error)
 	// GETV1Users invokes GET_/v1/users operation.
 	//
 	// #### Controller:
@@ -296,6 +538,18 @@ type Invoker interface {
 	//
 	// PATCH /v1/auth/reset
 	PATCHV1AuthReset(ctx context.Context, request *AuthResetRequest, params PATCHV1AuthResetParams) (*GenericResponse, error)
+	// PATCHV1ImagesCurrent invokes PATCH_/v1/images/current operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageCurrent`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Set the current version of an image.
+	//
+	// PATCH /v1/images/current
+	PATCHV1ImagesCurrent(ctx context.Context, request *BootImageCurrentRequest, params PATCHV1ImagesCurrentParams) (*GenericResponse, error)
 	// PATCHV1NodesImage invokes PATCH_/v1/nodes/image operation.
 	//
 	// #### Controller:
@@ -426,6 +680,55 @@ type Invoker interface {
 	//
 	// POST /v1/bmc/configure/import
 	POSTV1BmcConfigureImport(ctx context.Context, request *BmcImportConfigurationRequest, params POSTV1BmcConfigureImportParams) ([]JobMessage, error)
+	// POSTV1BmcCredentials invokes POST_/v1/bmc/credentials operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcCredentialStore`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Store the BMC credential of a node or of all nodes with a tag.
+	//
+	// POST /v1/bmc/credentials
+	POSTV1BmcCredentials(ctx context.Context, request *BmcCredentialRequest, params POSTV1BmcCredentialsParams) (*GenericResponse, error)
+	// POSTV1BmcCredentialsRotate invokes POST_/v1/bmc/credentials/rotate operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcCredentialRotate`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Change the BMC password of nodes to new random passwords stored with the node.
+	//
+	// POST /v1/bmc/credentials/rotate
+	POSTV1BmcCredentialsRotate(ctx context.Context, request *BmcCredentialRotateRequest, params POSTV1BmcCredentialsRotateParams) ([]JobMessage, error)
+	// POSTV1BmcInventory invokes POST_/v1/bmc/inventory operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcCollectInventory`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Collect the hardware inventory of nodes from their BMCs now.
+	//
+	// POST /v1/bmc/inventory
+	POSTV1BmcInventory(ctx context.Context, params POSTV1BmcInventoryParams) ([]JobMessage, error)
+	// POSTV1BmcMedia invokes POST_/v1/bmc/media operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcMediaInsert`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Insert an ISO into the virtual CD/DVD drive of nodes. The media is ejected when the node completes
+	// provisioning.
+	//
+	// POST /v1/bmc/media
+	POSTV1BmcMedia(ctx context.Context, request *BmcMediaInsertRequest, params POSTV1BmcMediaParams) ([]JobMessage, error)
 	// POSTV1BmcPowerBmc invokes POST_/v1/bmc/power/bmc operation.
 	//
 	// #### Controller:
@@ -462,6 +765,31 @@ type Invoker interface {
 	//
 	// POST /v1/bmc/upgrade/dell/installfromrepo
 	POSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, request *BmcDellInstallFromRepoRequest, params POSTV1BmcUpgradeDellInstallfromrepoParams) ([]JobMessage, error)
+	// POSTV1BmcUpgradeRedfish invokes POST_/v1/bmc/upgrade/redfish operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcFirmwareUpdate`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Update firmware through the Redfish UpdateService. The data of each node's result is the URI of
+	// its update task.
+	//
+	// POST /v1/bmc/upgrade/redfish
+	POSTV1BmcUpgradeRedfish(ctx context.Context, request *BmcFirmwareUpdateRequest, params POSTV1BmcUpgradeRedfishParams) ([]JobMessage, error)
+	// POSTV1BmcUpgradeRedfishTasks invokes POST_/v1/bmc/upgrade/redfish/tasks operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).BmcFirmwareTasks`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Get the state of Redfish firmware update tasks.
+	//
+	// POST /v1/bmc/upgrade/redfish/tasks
+	POSTV1BmcUpgradeRedfishTasks(ctx context.Context, request *BmcFirmwareTasksRequest, params POSTV1BmcUpgradeRedfishTasksParams) ([]RedfishFirmwareTask, error)
 	// POSTV1DbRestore invokes POST_/v1/db/restore operation.
 	//
 	// #### Controller:
@@ -486,6 +814,67 @@ type Invoker interface {
 	//
 	// POST /v1/images
 	POSTV1Images(ctx context.Context, request *BootImageAddRequest, params POSTV1ImagesParams) (*GenericResponse, error)
+	// POSTV1ImagesArtifacts invokes POST_/v1/images/artifacts operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).ArtifactUpload`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Upload a kernel, initrd or live image file. The file is stored under its SHA-256 checksum, which
+	// images reference in kernel_sha256, initrd_sha256 and liveimg_sha256.
+	//
+	// POST /v1/images/artifacts
+	POSTV1ImagesArtifacts(ctx context.Context, request POSTV1ImagesArtifactsReq) (*Artifact, error)
+	// POSTV1ImagesArtifactsGc invokes POST_/v1/images/artifacts/gc operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).ArtifactGC`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Delete the image artifacts no image references.
+	//
+	// POST /v1/images/artifacts/gc
+	POSTV1ImagesArtifactsGc(ctx context.Context, params POSTV1ImagesArtifactsGcParams) ([]Artifact, error)
+	// POSTV1ImagesRollouts invokes POST_/v1/images/rollouts operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).RolloutStart`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Start a rollout of an image version to a percentage of nodes by nodeset and/or tags.
+	//
+	// POST /v1/images/rollouts
+	POSTV1ImagesRollouts(ctx context.Context, request *RolloutStartRequest, params POSTV1ImagesRolloutsParams) (*Rollout, error)
+	// POSTV1ImagesRolloutsImagePromote invokes POST_/v1/images/rollouts/:image/promote operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).RolloutPromote`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Make the version of a running rollout current and move all nodes of the rollout to it.
+	//
+	// POST /v1/images/rollouts/{image}/promote
+	POSTV1ImagesRolloutsImagePromote(ctx context.Context, params POSTV1ImagesRolloutsImagePromoteParams) (*GenericResponse, error)
+	// POSTV1ImagesRolloutsImageRollback invokes POST_/v1/images/rollouts/:image/rollback operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).RolloutRollback`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Move the canary nodes of a running rollout back to the current version of the image.
+	//
+	// POST /v1/images/rollouts/{image}/rollback
+	POSTV1ImagesRolloutsImageRollback(ctx context.Context, params POSTV1ImagesRolloutsImageRollbackParams) (*GenericResponse, error)
 	// POSTV1Nodes invokes POST_/v1/nodes operation.
 	//
 	// #### Controller:
@@ -510,6 +899,43 @@ type Invoker interface {
 	//
 	// POST /v1/roles
 	POSTV1Roles(ctx context.Context, request *PostRolesRequest, params POSTV1RolesParams) (*GenericResponse, error)
+	// POSTV1SwitchPorts invokes POST_/v1/switch/ports operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchConfigurePorts`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Set the access VLAN, MTU and description of the intended switch ports of node interfaces from
+	// their VLAN, MTU and FQDN.
+	//
+	// POST /v1/switch/ports
+	POSTV1SwitchPorts(ctx context.Context, params POSTV1SwitchPortsParams) (*SwitchPortReport, error)
+	// POSTV1TopologyAccept invokes POST_/v1/topology/accept operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).TopologyAccept`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Accept the switch ports nodes are seen on as their expected ports.
+	//
+	// POST /v1/topology/accept
+	POSTV1TopologyAccept(ctx context.Context, params POSTV1TopologyAcceptParams) (*GenericResponse, error)
+	// POSTV1TopologyWalk invokes POST_/v1/topology/walk operation.
+	//
+	// #### Controller:
+	// `github.com/ubccr/grendel/internal/api.(*Handler).TopologyWalk`
+	// #### Middlewares:
+	// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+	// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+	// ---
+	// Walk the MAC address tables and LLDP neighbors of all switches now.
+	//
+	// POST /v1/topology/walk
+	POSTV1TopologyWalk(ctx context.Context, params POSTV1TopologyWalkParams) (*GenericResponse, error)
 	// POSTV1Users invokes POST_/v1/users operation.
 	//
 	// #### Controller:
@@ -668,26 +1094,166 @@ func (c *Client) sendDELETEV1AuthSignout(ctx context.Context, params DELETEV1Aut
 	return result, nil
 }
 
-// DELETEV1BmcJobs invokes DELETE_/v1/bmc/jobs operation.
+// DELETEV1BmcCredentialsScopeName invokes DELETE_/v1/bmc/credentials/:scope/:name operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcJobDeleteMany`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcCredentialDelete`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Delete redfish jobs from many node(s).
+// Delete a BMC credential.
 //
-// DELETE /v1/bmc/jobs
-func (c *Client) DELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteRequest, params DELETEV1BmcJobsParams) ([]JobMessage, error) {
-	res, err := c.sendDELETEV1BmcJobs(ctx, request, params)
+// DELETE /v1/bmc/credentials/{scope}/{name}
+func (c *Client) DELETEV1BmcCredentialsScopeName(ctx context.Context, params DELETEV1BmcCredentialsScopeNameParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1BmcCredentialsScopeName(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteRequest, params DELETEV1BmcJobsParams) (res []JobMessage, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
+func (c *Client) sendDELETEV1BmcCredentialsScopeName(ctx context.Context, params DELETEV1BmcCredentialsScopeNameParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/v1/bmc/credentials/"
+	{
+		// Encode "scope" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "scope",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Scope))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcCredentialsScopeNameOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1BmcCredentialsScopeNameOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1BmcCredentialsScopeNameResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1BmcJobs invokes DELETE_/v1/bmc/jobs operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcJobDeleteMany`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Delete redfish jobs from many node(s).
+//
+// DELETE /v1/bmc/jobs
+func (c *Client) DELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteRequest, params DELETEV1BmcJobsParams) ([]JobMessage, error) {
+	res, err := c.sendDELETEV1BmcJobs(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteRequest, params DELETEV1BmcJobsParams) (res []JobMessage, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
 			return err
 		}
 		return nil
@@ -735,6 +1301,23 @@ func (c *Client) sendDELETEV1BmcJobs(ctx context.Context, request *BmcJobDeleteR
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	u.RawQuery = q.Values().Encode()
 
 	r, err := ht.NewRequest(ctx, "DELETE", u)
@@ -896,6 +1479,23 @@ func (c *Client) sendDELETEV1BmcJobsJids(ctx context.Context, params DELETEV1Bmc
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	u.RawQuery = q.Values().Encode()
 
 	r, err := ht.NewRequest(ctx, "DELETE", u)
@@ -978,27 +1578,27 @@ func (c *Client) sendDELETEV1BmcJobsJids(ctx context.Context, params DELETEV1Bmc
 	return result, nil
 }
 
-// DELETEV1BmcSel invokes DELETE_/v1/bmc/sel operation.
+// DELETEV1BmcMedia invokes DELETE_/v1/bmc/media operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcSelClear`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcMediaEject`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Clear system event log on node(s).
+// Eject the virtual CD/DVD of nodes.
 //
-// DELETE /v1/bmc/sel
-func (c *Client) DELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelParams) ([]JobMessage, error) {
-	res, err := c.sendDELETEV1BmcSel(ctx, params)
+// DELETE /v1/bmc/media
+func (c *Client) DELETEV1BmcMedia(ctx context.Context, params DELETEV1BmcMediaParams) ([]JobMessage, error) {
+	res, err := c.sendDELETEV1BmcMedia(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelParams) (res []JobMessage, err error) {
+func (c *Client) sendDELETEV1BmcMedia(ctx context.Context, params DELETEV1BmcMediaParams) (res []JobMessage, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/sel"
+	pathParts[0] = "/v1/bmc/media"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
@@ -1036,6 +1636,23 @@ func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelPa
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	u.RawQuery = q.Values().Encode()
 
 	r, err := ht.NewRequest(ctx, "DELETE", u)
@@ -1064,7 +1681,7 @@ func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelPa
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcSelOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcMediaOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1075,7 +1692,7 @@ func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelPa
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, DELETEV1BmcSelOperation, r); {
+			switch err := c.securityCookieAuth(ctx, DELETEV1BmcMediaOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1110,7 +1727,7 @@ func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelPa
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDELETEV1BmcSelResponse(resp)
+	result, err := decodeDELETEV1BmcMediaResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1118,40 +1735,57 @@ func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelPa
 	return result, nil
 }
 
-// DELETEV1Images invokes DELETE_/v1/images operation.
+// DELETEV1BmcSel invokes DELETE_/v1/bmc/sel operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageDelete`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcSelClear`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Delete images by name.
+// Clear system event log on node(s).
 //
-// DELETE /v1/images
-func (c *Client) DELETEV1Images(ctx context.Context, params DELETEV1ImagesParams) (*GenericResponse, error) {
-	res, err := c.sendDELETEV1Images(ctx, params)
+// DELETE /v1/bmc/sel
+func (c *Client) DELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelParams) ([]JobMessage, error) {
+	res, err := c.sendDELETEV1BmcSel(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesParams) (res *GenericResponse, err error) {
+func (c *Client) sendDELETEV1BmcSel(ctx context.Context, params DELETEV1BmcSelParams) (res []JobMessage, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/images"
+	pathParts[0] = "/v1/bmc/sel"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "names" parameter.
+		// Encode "nodeset" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "names",
+			Name:    "nodeset",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Names.Get(); ok {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -1159,6 +1793,23 @@ func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesPa
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	u.RawQuery = q.Values().Encode()
 
 	r, err := ht.NewRequest(ctx, "DELETE", u)
@@ -1187,7 +1838,7 @@ func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesPa
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, DELETEV1ImagesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcSelOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1198,7 +1849,7 @@ func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesPa
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, DELETEV1ImagesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, DELETEV1BmcSelOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1233,7 +1884,7 @@ func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesPa
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeDELETEV1ImagesResponse(resp)
+	result, err := decodeDELETEV1BmcSelResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1241,35 +1892,279 @@ func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesPa
 	return result, nil
 }
 
-// DELETEV1Nodes invokes DELETE_/v1/nodes operation.
+// DELETEV1BmcTasksID invokes DELETE_/v1/bmc/tasks/:id operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeDelete`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcTaskCancel`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Delete nodes by nodeset and/or tags.
+// Cancel a BMC task. Nodes the task has not started on are skipped.
 //
-// DELETE /v1/nodes
-func (c *Client) DELETEV1Nodes(ctx context.Context, params DELETEV1NodesParams) (*GenericResponse, error) {
-	res, err := c.sendDELETEV1Nodes(ctx, params)
+// DELETE /v1/bmc/tasks/{id}
+func (c *Client) DELETEV1BmcTasksID(ctx context.Context, params DELETEV1BmcTasksIDParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1BmcTasksID(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDELETEV1Nodes(ctx context.Context, params DELETEV1NodesParams) (res *GenericResponse, err error) {
+func (c *Client) sendDELETEV1BmcTasksID(ctx context.Context, params DELETEV1BmcTasksIDParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/nodes"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	q := uri.NewQueryEncoder()
+	var pathParts [2]string
+	pathParts[0] = "/v1/bmc/tasks/"
 	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1BmcTasksIDOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1BmcTasksIDOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1BmcTasksIDResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1Images invokes DELETE_/v1/images operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageDelete`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Delete images by name.
+//
+// DELETE /v1/images
+func (c *Client) DELETEV1Images(ctx context.Context, params DELETEV1ImagesParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1Images(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1Images(ctx context.Context, params DELETEV1ImagesParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/images"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "names" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "names",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Names.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, DELETEV1ImagesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, DELETEV1ImagesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeDELETEV1ImagesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DELETEV1Nodes invokes DELETE_/v1/nodes operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeDelete`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Delete nodes by nodeset and/or tags.
+//
+// DELETE /v1/nodes
+func (c *Client) DELETEV1Nodes(ctx context.Context, params DELETEV1NodesParams) (*GenericResponse, error) {
+	res, err := c.sendDELETEV1Nodes(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDELETEV1Nodes(ctx context.Context, params DELETEV1NodesParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
@@ -1763,66 +2658,29 @@ func (c *Client) sendGETV1Bmc(ctx context.Context, params GETV1BmcParams) (res [
 	return result, nil
 }
 
-// GETV1BmcJobs invokes GET_/v1/bmc/jobs operation.
+// GETV1BmcCredentials invokes GET_/v1/bmc/credentials operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcJobList`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcCredentialList`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Get redfish jobs from node(s).
+// Get stored BMC credentials, without passwords.
 //
-// GET /v1/bmc/jobs
-func (c *Client) GETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams) ([]RedfishJob, error) {
-	res, err := c.sendGETV1BmcJobs(ctx, params)
+// GET /v1/bmc/credentials
+func (c *Client) GETV1BmcCredentials(ctx context.Context, params GETV1BmcCredentialsParams) ([]BmcCredential, error) {
+	res, err := c.sendGETV1BmcCredentials(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams) (res []RedfishJob, err error) {
+func (c *Client) sendGETV1BmcCredentials(ctx context.Context, params GETV1BmcCredentialsParams) (res []BmcCredential, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/jobs"
+	pathParts[0] = "/v1/bmc/credentials"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -1849,7 +2707,7 @@ func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1BmcJobsOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcCredentialsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1860,7 +2718,7 @@ func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1BmcJobsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1BmcCredentialsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1895,7 +2753,7 @@ func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1BmcJobsResponse(resp)
+	result, err := decodeGETV1BmcCredentialsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1903,27 +2761,27 @@ func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams
 	return result, nil
 }
 
-// GETV1BmcMetrics invokes GET_/v1/bmc/metrics operation.
+// GETV1BmcJobs invokes GET_/v1/bmc/jobs operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcMetricReports`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcJobList`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Get metric reports by nodeset.
+// Get redfish jobs from node(s).
 //
-// GET /v1/bmc/metrics
-func (c *Client) GETV1BmcMetrics(ctx context.Context, params GETV1BmcMetricsParams) ([]RedfishMetricReport, error) {
-	res, err := c.sendGETV1BmcMetrics(ctx, params)
+// GET /v1/bmc/jobs
+func (c *Client) GETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams) ([]RedfishJob, error) {
+	res, err := c.sendGETV1BmcJobs(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetricsParams) (res []RedfishMetricReport, err error) {
+func (c *Client) sendGETV1BmcJobs(ctx context.Context, params GETV1BmcJobsParams) (res []RedfishJob, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/metrics"
+	pathParts[0] = "/v1/bmc/jobs"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
@@ -1989,7 +2847,7 @@ func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetrics
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1BmcMetricsOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcJobsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2000,7 +2858,7 @@ func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetrics
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1BmcMetricsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1BmcJobsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2035,7 +2893,7 @@ func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetrics
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1BmcMetricsResponse(resp)
+	result, err := decodeGETV1BmcJobsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2043,27 +2901,27 @@ func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetrics
 	return result, nil
 }
 
-// GETV1BmcUpgradeDellRepo invokes GET_/v1/bmc/upgrade/dell/repo operation.
+// GETV1BmcMetrics invokes GET_/v1/bmc/metrics operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellGetRepoUpdateList`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcMetricReports`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Fetch which packages can be upgraded.
+// Get metric reports by nodeset.
 //
-// GET /v1/bmc/upgrade/dell/repo
-func (c *Client) GETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1BmcUpgradeDellRepoParams) ([]RedfishDellUpgradeFirmware, error) {
-	res, err := c.sendGETV1BmcUpgradeDellRepo(ctx, params)
+// GET /v1/bmc/metrics
+func (c *Client) GETV1BmcMetrics(ctx context.Context, params GETV1BmcMetricsParams) ([]RedfishMetricReport, error) {
+	res, err := c.sendGETV1BmcMetrics(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1BmcUpgradeDellRepoParams) (res []RedfishDellUpgradeFirmware, err error) {
+func (c *Client) sendGETV1BmcMetrics(ctx context.Context, params GETV1BmcMetricsParams) (res []RedfishMetricReport, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/upgrade/dell/repo"
+	pathParts[0] = "/v1/bmc/metrics"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
@@ -2129,7 +2987,7 @@ func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1Bm
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1BmcUpgradeDellRepoOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcMetricsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2140,7 +2998,7 @@ func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1Bm
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1BmcUpgradeDellRepoOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1BmcMetricsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2175,7 +3033,7 @@ func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1Bm
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1BmcUpgradeDellRepoResponse(resp)
+	result, err := decodeGETV1BmcMetricsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2183,29 +3041,66 @@ func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1Bm
 	return result, nil
 }
 
-// GETV1DbDump invokes GET_/v1/db/dump operation.
+// GETV1BmcTasks invokes GET_/v1/bmc/tasks operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).Dump`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcTaskList`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Get a backup of the DB.
+// Get BMC tasks, newest first.
 //
-// GET /v1/db/dump
-func (c *Client) GETV1DbDump(ctx context.Context, params GETV1DbDumpParams) (*DataDump, error) {
-	res, err := c.sendGETV1DbDump(ctx, params)
+// GET /v1/bmc/tasks
+func (c *Client) GETV1BmcTasks(ctx context.Context, params GETV1BmcTasksParams) ([]BmcTask, error) {
+	res, err := c.sendGETV1BmcTasks(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams) (res *DataDump, err error) {
+func (c *Client) sendGETV1BmcTasks(ctx context.Context, params GETV1BmcTasksParams) (res []BmcTask, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/db/dump"
+	pathParts[0] = "/v1/bmc/tasks"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "status" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Status.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -2232,7 +3127,7 @@ func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams)
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1DbDumpOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcTasksOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2243,7 +3138,7 @@ func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams)
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1DbDumpOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1BmcTasksOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2278,7 +3173,7 @@ func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams)
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1DbDumpResponse(resp)
+	result, err := decodeGETV1BmcTasksResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2286,26 +3181,45 @@ func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams)
 	return result, nil
 }
 
-// GETV1GrendelEvents invokes GET_/v1/grendel/events operation.
+// GETV1BmcTasksID invokes GET_/v1/bmc/tasks/:id operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).GetEvents`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcTaskGet`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
-// ---.
+// ---
+// Get a BMC task with the status of each node.
 //
-// GET /v1/grendel/events
-func (c *Client) GETV1GrendelEvents(ctx context.Context, params GETV1GrendelEventsParams) ([]Event, error) {
-	res, err := c.sendGETV1GrendelEvents(ctx, params)
+// GET /v1/bmc/tasks/{id}
+func (c *Client) GETV1BmcTasksID(ctx context.Context, params GETV1BmcTasksIDParams) (*BmcTask, error) {
+	res, err := c.sendGETV1BmcTasksID(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1GrendelEventsParams) (res []Event, err error) {
+func (c *Client) sendGETV1BmcTasksID(ctx context.Context, params GETV1BmcTasksIDParams) (res *BmcTask, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/grendel/events"
+	var pathParts [2]string
+	pathParts[0] = "/v1/bmc/tasks/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -2334,7 +3248,7 @@ func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1Grendel
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1GrendelEventsOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcTasksIDOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2345,7 +3259,7 @@ func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1Grendel
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1GrendelEventsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1BmcTasksIDOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2380,7 +3294,7 @@ func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1Grendel
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1GrendelEventsResponse(resp)
+	result, err := decodeGETV1BmcTasksIDResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2388,29 +3302,66 @@ func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1Grendel
 	return result, nil
 }
 
-// GETV1Images invokes GET_/v1/images operation.
+// GETV1BmcUpgradeDellRepo invokes GET_/v1/bmc/upgrade/dell/repo operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageList`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellGetRepoUpdateList`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// List all images.
+// Fetch which packages can be upgraded.
 //
-// GET /v1/images
-func (c *Client) GETV1Images(ctx context.Context, params GETV1ImagesParams) ([]BootImage, error) {
-	res, err := c.sendGETV1Images(ctx, params)
+// GET /v1/bmc/upgrade/dell/repo
+func (c *Client) GETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1BmcUpgradeDellRepoParams) ([]RedfishDellUpgradeFirmware, error) {
+	res, err := c.sendGETV1BmcUpgradeDellRepo(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams) (res []BootImage, err error) {
+func (c *Client) sendGETV1BmcUpgradeDellRepo(ctx context.Context, params GETV1BmcUpgradeDellRepoParams) (res []RedfishDellUpgradeFirmware, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/images"
+	pathParts[0] = "/v1/bmc/upgrade/dell/repo"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -2437,7 +3388,7 @@ func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams)
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1ImagesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1BmcUpgradeDellRepoOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2448,7 +3399,7 @@ func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams)
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1ImagesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1BmcUpgradeDellRepoOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2483,7 +3434,7 @@ func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams)
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1ImagesResponse(resp)
+	result, err := decodeGETV1BmcUpgradeDellRepoResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2491,49 +3442,29 @@ func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams)
 	return result, nil
 }
 
-// GETV1ImagesFind invokes GET_/v1/images/find operation.
+// GETV1DbDump invokes GET_/v1/db/dump operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageFind`
+// `github.com/ubccr/grendel/internal/api.(*Handler).Dump`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Find images by name.
+// Get a backup of the DB.
 //
-// GET /v1/images/find
-func (c *Client) GETV1ImagesFind(ctx context.Context, params GETV1ImagesFindParams) ([]BootImage, error) {
-	res, err := c.sendGETV1ImagesFind(ctx, params)
+// GET /v1/db/dump
+func (c *Client) GETV1DbDump(ctx context.Context, params GETV1DbDumpParams) (*DataDump, error) {
+	res, err := c.sendGETV1DbDump(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFindParams) (res []BootImage, err error) {
+func (c *Client) sendGETV1DbDump(ctx context.Context, params GETV1DbDumpParams) (res *DataDump, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/images/find"
+	pathParts[0] = "/v1/db/dump"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "names" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "names",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Names.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -2560,7 +3491,7 @@ func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFind
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1ImagesFindOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1DbDumpOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2571,7 +3502,7 @@ func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFind
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1ImagesFindOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1DbDumpOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2606,7 +3537,7 @@ func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFind
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1ImagesFindResponse(resp)
+	result, err := decodeGETV1DbDumpResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2614,27 +3545,27 @@ func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFind
 	return result, nil
 }
 
-// GETV1Nodes invokes GET_/v1/nodes operation.
+// GETV1DhcpLeases invokes GET_/v1/dhcp/leases operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeList`
+// `github.com/ubccr/grendel/internal/api.(*Handler).DHCPLeaseList`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// List all nodes.
+// List dynamic DHCP leases.
 //
-// GET /v1/nodes
-func (c *Client) GETV1Nodes(ctx context.Context, params GETV1NodesParams) ([]Host, error) {
-	res, err := c.sendGETV1Nodes(ctx, params)
+// GET /v1/dhcp/leases
+func (c *Client) GETV1DhcpLeases(ctx context.Context, params GETV1DhcpLeasesParams) ([]DHCPLease, error) {
+	res, err := c.sendGETV1DhcpLeases(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (res []Host, err error) {
+func (c *Client) sendGETV1DhcpLeases(ctx context.Context, params GETV1DhcpLeasesParams) (res []DHCPLease, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/nodes"
+	pathParts[0] = "/v1/dhcp/leases"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -2663,7 +3594,7 @@ func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (r
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1NodesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1DhcpLeasesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2674,7 +3605,7 @@ func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (r
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1NodesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1DhcpLeasesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2709,7 +3640,7 @@ func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (r
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1NodesResponse(resp)
+	result, err := decodeGETV1DhcpLeasesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2717,40 +3648,40 @@ func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (r
 	return result, nil
 }
 
-// GETV1NodesFind invokes GET_/v1/nodes/find operation.
+// GETV1GrendelEvents invokes GET_/v1/grendel/events operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFind`
+// `github.com/ubccr/grendel/internal/api.(*Handler).GetEvents`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Find nodes by nodeset and/or tags.
+// Get audit events, newest first.
 //
-// GET /v1/nodes/find
-func (c *Client) GETV1NodesFind(ctx context.Context, params GETV1NodesFindParams) ([]Host, error) {
-	res, err := c.sendGETV1NodesFind(ctx, params)
+// GET /v1/grendel/events
+func (c *Client) GETV1GrendelEvents(ctx context.Context, params GETV1GrendelEventsParams) ([]Event, error) {
+	res, err := c.sendGETV1GrendelEvents(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindParams) (res []Host, err error) {
+func (c *Client) sendGETV1GrendelEvents(ctx context.Context, params GETV1GrendelEventsParams) (res []Event, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/nodes/find"
+	pathParts[0] = "/v1/grendel/events"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "nodeset" parameter.
+		// Encode "user" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
+			Name:    "user",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
+			if val, ok := params.User.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -2759,15 +3690,66 @@ func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindPa
 		}
 	}
 	{
-		// Encode "tags" parameter.
+		// Encode "severity" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
+			Name:    "severity",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
+			if val, ok := params.Severity.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "host" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "host",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Host.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "until" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Until.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -2775,6 +3757,40 @@ func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindPa
 			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "offset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Offset.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
 	u.RawQuery = q.Values().Encode()
 
 	r, err := ht.NewRequest(ctx, "GET", u)
@@ -2803,7 +3819,7 @@ func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindPa
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1NodesFindOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1GrendelEventsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2814,7 +3830,7 @@ func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindPa
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1NodesFindOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1GrendelEventsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2849,7 +3865,7 @@ func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindPa
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1NodesFindResponse(resp)
+	result, err := decodeGETV1GrendelEventsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2857,84 +3873,29 @@ func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindPa
 	return result, nil
 }
 
-// GETV1NodesTokenInterface invokes GET_/v1/nodes/token/:interface operation.
+// GETV1GrendelStatus invokes GET_/v1/grendel/status operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootToken`
+// `github.com/ubccr/grendel/internal/api.(*Handler).GrendelStatus`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Create a boot token for the provision server. Used for debugging requests made by images.
+// Get the status of this Grendel instance and the current HA leader.
 //
-// GET /v1/nodes/token/{interface}
-func (c *Client) GETV1NodesTokenInterface(ctx context.Context, params GETV1NodesTokenInterfaceParams) (*NodeBootTokenResponse, error) {
-	res, err := c.sendGETV1NodesTokenInterface(ctx, params)
+// GET /v1/grendel/status
+func (c *Client) GETV1GrendelStatus(ctx context.Context, params GETV1GrendelStatusParams) (*GrendelStatus, error) {
+	res, err := c.sendGETV1GrendelStatus(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1NodesTokenInterfaceParams) (res *NodeBootTokenResponse, err error) {
+func (c *Client) sendGETV1GrendelStatus(ctx context.Context, params GETV1GrendelStatusParams) (res *GrendelStatus, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/v1/nodes/token/"
-	{
-		// Encode "interface" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "interface",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Interface))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/v1/grendel/status"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -2961,7 +3922,7 @@ func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1N
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1NodesTokenInterfaceOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1GrendelStatusOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2972,7 +3933,7 @@ func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1N
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1NodesTokenInterfaceOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1GrendelStatusOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3007,7 +3968,7 @@ func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1N
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1NodesTokenInterfaceResponse(resp)
+	result, err := decodeGETV1GrendelStatusResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3015,49 +3976,29 @@ func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1N
 	return result, nil
 }
 
-// GETV1Roles invokes GET_/v1/roles operation.
+// GETV1Images invokes GET_/v1/images operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).GetRoles`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageList`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Get roles and permissions.
+// List all images.
 //
-// GET /v1/roles
-func (c *Client) GETV1Roles(ctx context.Context, params GETV1RolesParams) (*GetRolesResponse, error) {
-	res, err := c.sendGETV1Roles(ctx, params)
+// GET /v1/images
+func (c *Client) GETV1Images(ctx context.Context, params GETV1ImagesParams) ([]BootImage, error) {
+	res, err := c.sendGETV1Images(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (res *GetRolesResponse, err error) {
+func (c *Client) sendGETV1Images(ctx context.Context, params GETV1ImagesParams) (res []BootImage, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/roles"
+	pathParts[0] = "/v1/images"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "name" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "name",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Name.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -3084,7 +4025,7 @@ func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (r
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1RolesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1ImagesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3095,7 +4036,7 @@ func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (r
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1RolesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1ImagesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3130,7 +4071,7 @@ func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (r
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1RolesResponse(resp)
+	result, err := decodeGETV1ImagesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3138,59 +4079,143 @@ func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (r
 	return result, nil
 }
 
-// GETV1SwitchNodesetLldp invokes GET_/v1/switch/:nodeset/lldp operation.
+// GETV1ImagesArtifacts invokes GET_/v1/images/artifacts operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchGetLLDP`
+// `github.com/ubccr/grendel/internal/api.(*Handler).ArtifactList`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Get switch LLDP info.
+// List the stored image artifacts.
 //
-// GET /v1/switch/{nodeset}/lldp
-func (c *Client) GETV1SwitchNodesetLldp(ctx context.Context, params GETV1SwitchNodesetLldpParams) ([]LLDP, error) {
-	res, err := c.sendGETV1SwitchNodesetLldp(ctx, params)
+// GET /v1/images/artifacts
+func (c *Client) GETV1ImagesArtifacts(ctx context.Context, params GETV1ImagesArtifactsParams) ([]Artifact, error) {
+	res, err := c.sendGETV1ImagesArtifacts(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1SwitchNodesetLldpParams) (res []LLDP, err error) {
+func (c *Client) sendGETV1ImagesArtifacts(ctx context.Context, params GETV1ImagesArtifactsParams) (res []Artifact, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/v1/switch/"
+	var pathParts [1]string
+	pathParts[0] = "/v1/images/artifacts"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
 	{
-		// Encode "nodeset" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "nodeset",
-			Style:   uri.PathStyleSimple,
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
 			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Nodeset))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
 		}
-		pathParts[1] = encoded
 	}
-	pathParts[2] = "/lldp"
-	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
 	{
-		// Encode "ports" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "ports",
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1ImagesArtifactsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1ImagesArtifactsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1ImagesArtifactsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1ImagesFind invokes GET_/v1/images/find operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageFind`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Find images by name.
+//
+// GET /v1/images/find
+func (c *Client) GETV1ImagesFind(ctx context.Context, params GETV1ImagesFindParams) ([]BootImage, error) {
+	res, err := c.sendGETV1ImagesFind(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1ImagesFind(ctx context.Context, params GETV1ImagesFindParams) (res []BootImage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/images/find"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "names" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "names",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Ports.Get(); ok {
+			if val, ok := params.Names.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -3226,7 +4251,7 @@ func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1Swi
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1SwitchNodesetLldpOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1ImagesFindOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3237,7 +4262,7 @@ func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1Swi
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1SwitchNodesetLldpOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1ImagesFindOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3272,7 +4297,7 @@ func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1Swi
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1SwitchNodesetLldpResponse(resp)
+	result, err := decodeGETV1ImagesFindResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3280,49 +4305,29 @@ func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1Swi
 	return result, nil
 }
 
-// GETV1Users invokes GET_/v1/users operation.
+// GETV1ImagesRollouts invokes GET_/v1/images/rollouts operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).UserList`
+// `github.com/ubccr/grendel/internal/api.(*Handler).RolloutList`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// List all users.
+// List image rollouts and the provision states of their canary nodes.
 //
-// GET /v1/users
-func (c *Client) GETV1Users(ctx context.Context, params GETV1UsersParams) ([]User, error) {
-	res, err := c.sendGETV1Users(ctx, params)
+// GET /v1/images/rollouts
+func (c *Client) GETV1ImagesRollouts(ctx context.Context, params GETV1ImagesRolloutsParams) ([]Rollout, error) {
+	res, err := c.sendGETV1ImagesRollouts(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (res []User, err error) {
+func (c *Client) sendGETV1ImagesRollouts(ctx context.Context, params GETV1ImagesRolloutsParams) (res []Rollout, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/users"
+	pathParts[0] = "/v1/images/rollouts"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "usernames" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "usernames",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Usernames.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -3349,7 +4354,7 @@ func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (r
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, GETV1UsersOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1ImagesRolloutsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3360,7 +4365,7 @@ func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (r
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, GETV1UsersOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1ImagesRolloutsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3395,7 +4400,7 @@ func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (r
 	}
 	defer resp.Body.Close()
 
-	result, err := decodeGETV1UsersResponse(resp)
+	result, err := decodeGETV1ImagesRolloutsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3403,45 +4408,51 @@ func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (r
 	return result, nil
 }
 
-// PATCHV1AuthReset invokes PATCH_/v1/auth/reset operation.
+// GETV1ImagesVersionsName invokes GET_/v1/images/versions/:name operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).AuthReset`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageVersions`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Change password.
+// List the versions of an image.
 //
-// PATCH /v1/auth/reset
-func (c *Client) PATCHV1AuthReset(ctx context.Context, request *AuthResetRequest, params PATCHV1AuthResetParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1AuthReset(ctx, request, params)
+// GET /v1/images/versions/{name}
+func (c *Client) GETV1ImagesVersionsName(ctx context.Context, params GETV1ImagesVersionsNameParams) ([]BootImageVersion, error) {
+	res, err := c.sendGETV1ImagesVersionsName(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetRequest, params PATCHV1AuthResetParams) (res *GenericResponse, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendGETV1ImagesVersionsName(ctx context.Context, params GETV1ImagesVersionsNameParams) (res []BootImageVersion, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/auth/reset"
+	var pathParts [2]string
+	pathParts[0] = "/v1/images/versions/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1AuthResetRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -3464,7 +4475,7 @@ func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetReq
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1AuthResetOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1ImagesVersionsNameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3475,7 +4486,7 @@ func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetReq
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1AuthResetOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1ImagesVersionsNameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3510,7 +4521,7 @@ func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetReq
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1AuthResetResponse(resp)
+	result, err := decodeGETV1ImagesVersionsNameResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3518,73 +4529,33 @@ func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetReq
 	return result, nil
 }
 
-// PATCHV1NodesImage invokes PATCH_/v1/nodes/image operation.
+// GETV1Nodes invokes GET_/v1/nodes operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootImage`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeList`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Update nodes boot image by nodeset and/or tags.
+// List all nodes.
 //
-// PATCH /v1/nodes/image
-func (c *Client) PATCHV1NodesImage(ctx context.Context, request *NodeBootImageRequest, params PATCHV1NodesImageParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1NodesImage(ctx, request, params)
+// GET /v1/nodes
+func (c *Client) GETV1Nodes(ctx context.Context, params GETV1NodesParams) ([]Host, error) {
+	res, err := c.sendGETV1Nodes(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootImageRequest, params PATCHV1NodesImageParams) (res *GenericResponse, err error) {
+func (c *Client) sendGETV1Nodes(ctx context.Context, params GETV1NodesParams) (res []Host, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/nodes/image"
+	pathParts[0] = "/v1/nodes"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1NodesImageRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -3607,7 +4578,7 @@ func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootIma
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesImageOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3618,7 +4589,7 @@ func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootIma
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1NodesImageOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1NodesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3653,7 +4624,7 @@ func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootIma
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1NodesImageResponse(resp)
+	result, err := decodeGETV1NodesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3661,27 +4632,27 @@ func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootIma
 	return result, nil
 }
 
-// PATCHV1NodesProvision invokes PATCH_/v1/nodes/provision operation.
+// GETV1NodesFind invokes GET_/v1/nodes/find operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeProvision`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeFind`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Provision / Unprovision nodes by nodeset and/or tags.
+// Find nodes by nodeset and/or tags.
 //
-// PATCH /v1/nodes/provision
-func (c *Client) PATCHV1NodesProvision(ctx context.Context, request *NodeProvisionRequest, params PATCHV1NodesProvisionParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1NodesProvision(ctx, request, params)
+// GET /v1/nodes/find
+func (c *Client) GETV1NodesFind(ctx context.Context, params GETV1NodesFindParams) ([]Host, error) {
+	res, err := c.sendGETV1NodesFind(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodeProvisionRequest, params PATCHV1NodesProvisionParams) (res *GenericResponse, err error) {
+func (c *Client) sendGETV1NodesFind(ctx context.Context, params GETV1NodesFindParams) (res []Host, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/nodes/provision"
+	pathParts[0] = "/v1/nodes/find"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
@@ -3721,13 +4692,10 @@ func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodePro
 	}
 	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1NodesProvisionRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -3750,7 +4718,7 @@ func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodePro
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesProvisionOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesFindOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3761,7 +4729,7 @@ func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodePro
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1NodesProvisionOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1NodesFindOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3796,7 +4764,7 @@ func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodePro
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1NodesProvisionResponse(resp)
+	result, err := decodeGETV1NodesFindResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3804,36 +4772,36 @@ func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodePro
 	return result, nil
 }
 
-// PATCHV1NodesTagsAction invokes PATCH_/v1/nodes/tags/:action operation.
+// GETV1NodesHistoryName invokes GET_/v1/nodes/history/:name operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTags`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeHistory`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Update nodes tags by nodeset and/or tags.
+// Get the DHCP, DHCPv6 and proxy PXE messages sent to and received from a node, oldest first.
 //
-// PATCH /v1/nodes/tags/{action}
-func (c *Client) PATCHV1NodesTagsAction(ctx context.Context, request *NodeTagsRequest, params PATCHV1NodesTagsActionParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1NodesTagsAction(ctx, request, params)
+// GET /v1/nodes/history/{name}
+func (c *Client) GETV1NodesHistoryName(ctx context.Context, params GETV1NodesHistoryNameParams) ([]DHCPEvent, error) {
+	res, err := c.sendGETV1NodesHistoryName(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTagsRequest, params PATCHV1NodesTagsActionParams) (res *GenericResponse, err error) {
+func (c *Client) sendGETV1NodesHistoryName(ctx context.Context, params GETV1NodesHistoryNameParams) (res []DHCPEvent, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [2]string
-	pathParts[0] = "/v1/nodes/tags/"
+	pathParts[0] = "/v1/nodes/history/"
 	{
-		// Encode "action" parameter.
+		// Encode "name" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "action",
+			Param:   "name",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Action))
+			return e.EncodeValue(conv.StringToString(params.Name))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3847,32 +4815,15 @@ func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTa
 
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
+		// Encode "since" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
+			Name:    "since",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
+			if val, ok := params.Since.Get(); ok {
 				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
@@ -3882,13 +4833,10 @@ func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTa
 	}
 	u.RawQuery = q.Values().Encode()
 
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1NodesTagsActionRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -3911,7 +4859,7 @@ func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTa
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesTagsActionOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesHistoryNameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3922,7 +4870,7 @@ func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTa
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1NodesTagsActionOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1NodesHistoryNameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3957,7 +4905,7 @@ func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTa
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1NodesTagsActionResponse(resp)
+	result, err := decodeGETV1NodesHistoryNameResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3965,36 +4913,121 @@ func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTa
 	return result, nil
 }
 
-// PATCHV1Roles invokes PATCH_/v1/roles operation.
+// GETV1NodesInventory invokes GET_/v1/nodes/inventory operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).PatchRoles`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeInventory`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Edit role permissions.
+// Get the hardware inventory of nodes collected from their BMCs.
 //
-// PATCH /v1/roles
-func (c *Client) PATCHV1Roles(ctx context.Context, request *PatchRolesRequest, params PATCHV1RolesParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1Roles(ctx, request, params)
+// GET /v1/nodes/inventory
+func (c *Client) GETV1NodesInventory(ctx context.Context, params GETV1NodesInventoryParams) ([]InventoryComponent, error) {
+	res, err := c.sendGETV1NodesInventory(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesRequest, params PATCHV1RolesParams) (res *GenericResponse, err error) {
+func (c *Client) sendGETV1NodesInventory(ctx context.Context, params GETV1NodesInventoryParams) (res []InventoryComponent, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/roles"
+	pathParts[0] = "/v1/nodes/inventory"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "kind" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "kind",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Kind.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Name.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "version_lt" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "version_lt",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.VersionLt.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1RolesRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -4017,7 +5050,7 @@ func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesReques
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1RolesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesInventoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4028,7 +5061,7 @@ func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesReques
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1RolesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1NodesInventoryOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4063,7 +5096,7 @@ func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesReques
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1RolesResponse(resp)
+	result, err := decodeGETV1NodesInventoryResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4071,55 +5104,87 @@ func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesReques
 	return result, nil
 }
 
-// PATCHV1UsersUsernamesEnable invokes PATCH_/v1/users/:usernames/enable operation.
+// GETV1NodesInventoryChanges invokes GET_/v1/nodes/inventory/changes operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).UserEnable`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeInventoryChanges`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Update users enable.
+// Get hardware inventory changes found between collections, such as replaced parts.
 //
-// PATCH /v1/users/{usernames}/enable
-func (c *Client) PATCHV1UsersUsernamesEnable(ctx context.Context, request *UserEnableRequest, params PATCHV1UsersUsernamesEnableParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1UsersUsernamesEnable(ctx, request, params)
+// GET /v1/nodes/inventory/changes
+func (c *Client) GETV1NodesInventoryChanges(ctx context.Context, params GETV1NodesInventoryChangesParams) ([]InventoryChange, error) {
+	res, err := c.sendGETV1NodesInventoryChanges(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *UserEnableRequest, params PATCHV1UsersUsernamesEnableParams) (res *GenericResponse, err error) {
+func (c *Client) sendGETV1NodesInventoryChanges(ctx context.Context, params GETV1NodesInventoryChangesParams) (res []InventoryChange, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/v1/users/"
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/inventory/changes"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
 	{
-		// Encode "usernames" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "usernames",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Usernames))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
-		pathParts[1] = encoded
 	}
-	pathParts[2] = "/enable"
-	uri.AddPathParts(u, pathParts[:]...)
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "since" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Since.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1UsersUsernamesEnableRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -4142,7 +5207,7 @@ func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *U
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1UsersUsernamesEnableOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesInventoryChangesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4153,7 +5218,7 @@ func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *U
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1UsersUsernamesEnableOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1NodesInventoryChangesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4188,7 +5253,7 @@ func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *U
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1UsersUsernamesEnableResponse(resp)
+	result, err := decodeGETV1NodesInventoryChangesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4196,36 +5261,36 @@ func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *U
 	return result, nil
 }
 
-// PATCHV1UsersUsernamesRole invokes PATCH_/v1/users/:usernames/role operation.
+// GETV1NodesLogsName invokes GET_/v1/nodes/logs/:name operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).UserRole`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeLogs`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Update users role.
+// Get the install progress messages and logs sent by a node since it was last set to provision.
 //
-// PATCH /v1/users/{usernames}/role
-func (c *Client) PATCHV1UsersUsernamesRole(ctx context.Context, request *UserRoleRequest, params PATCHV1UsersUsernamesRoleParams) (*GenericResponse, error) {
-	res, err := c.sendPATCHV1UsersUsernamesRole(ctx, request, params)
+// GET /v1/nodes/logs/{name}
+func (c *Client) GETV1NodesLogsName(ctx context.Context, params GETV1NodesLogsNameParams) ([]ProvisionLog, error) {
+	res, err := c.sendGETV1NodesLogsName(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *UserRoleRequest, params PATCHV1UsersUsernamesRoleParams) (res *GenericResponse, err error) {
+func (c *Client) sendGETV1NodesLogsName(ctx context.Context, params GETV1NodesLogsNameParams) (res []ProvisionLog, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/v1/users/"
+	var pathParts [2]string
+	pathParts[0] = "/v1/nodes/logs/"
 	{
-		// Encode "usernames" parameter.
+		// Encode "name" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "usernames",
+			Param:   "name",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Usernames))
+			return e.EncodeValue(conv.StringToString(params.Name))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -4235,16 +5300,12 @@ func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *Use
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/role"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	r, err := ht.NewRequest(ctx, "PATCH", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePATCHV1UsersUsernamesRoleRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -4267,7 +5328,7 @@ func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *Use
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, PATCHV1UsersUsernamesRoleOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesLogsNameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4278,7 +5339,7 @@ func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *Use
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, PATCHV1UsersUsernamesRoleOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GETV1NodesLogsNameOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4313,7 +5374,7 @@ func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *Use
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePATCHV1UsersUsernamesRoleResponse(resp)
+	result, err := decodeGETV1NodesLogsNameResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4321,33 +5382,4251 @@ func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *Use
 	return result, nil
 }
 
-// POSTV1AuthSignin invokes POST_/v1/auth/signin operation.
+// GETV1NodesProvision invokes GET_/v1/nodes/provision operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignin`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeProvisionStates`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// signin user.
+// Get the current provision state of nodes by nodeset and/or tags.
 //
-// POST /v1/auth/signin
-func (c *Client) POSTV1AuthSignin(ctx context.Context, request *AuthRequest, params POSTV1AuthSigninParams) (*AuthResponse, error) {
-	res, err := c.sendPOSTV1AuthSignin(ctx, request, params)
+// GET /v1/nodes/provision
+func (c *Client) GETV1NodesProvision(ctx context.Context, params GETV1NodesProvisionParams) ([]ProvisionEvent, error) {
+	res, err := c.sendGETV1NodesProvision(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest, params POSTV1AuthSigninParams) (res *AuthResponse, err error) {
+func (c *Client) sendGETV1NodesProvision(ctx context.Context, params GETV1NodesProvisionParams) (res []ProvisionEvent, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/provision"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesProvisionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesProvisionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesProvisionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1NodesProvisionName invokes GET_/v1/nodes/provision/:name operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeProvisionHistory`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Get the provision states of a node since it was last set to provision.
+//
+// GET /v1/nodes/provision/{name}
+func (c *Client) GETV1NodesProvisionName(ctx context.Context, params GETV1NodesProvisionNameParams) ([]ProvisionEvent, error) {
+	res, err := c.sendGETV1NodesProvisionName(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1NodesProvisionName(ctx context.Context, params GETV1NodesProvisionNameParams) (res []ProvisionEvent, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/nodes/provision/"
+	{
+		// Encode "name" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "name",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Name))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesProvisionNameOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesProvisionNameOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesProvisionNameResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1NodesTokenInterface invokes GET_/v1/nodes/token/:interface operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootToken`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Create a boot token for the provision server. Used for debugging requests made by images.
+//
+// GET /v1/nodes/token/{interface}
+func (c *Client) GETV1NodesTokenInterface(ctx context.Context, params GETV1NodesTokenInterfaceParams) (*NodeBootTokenResponse, error) {
+	res, err := c.sendGETV1NodesTokenInterface(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1NodesTokenInterface(ctx context.Context, params GETV1NodesTokenInterfaceParams) (res *NodeBootTokenResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/nodes/token/"
+	{
+		// Encode "interface" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "interface",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Interface))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1NodesTokenInterfaceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1NodesTokenInterfaceOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1NodesTokenInterfaceResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Roles invokes GET_/v1/roles operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).GetRoles`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Get roles and permissions.
+//
+// GET /v1/roles
+func (c *Client) GETV1Roles(ctx context.Context, params GETV1RolesParams) (*GetRolesResponse, error) {
+	res, err := c.sendGETV1Roles(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Roles(ctx context.Context, params GETV1RolesParams) (res *GetRolesResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/roles"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Name.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1RolesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1RolesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1RolesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1SwitchNodesetLldp invokes GET_/v1/switch/:nodeset/lldp operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchGetLLDP`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Get switch LLDP info.
+//
+// GET /v1/switch/{nodeset}/lldp
+func (c *Client) GETV1SwitchNodesetLldp(ctx context.Context, params GETV1SwitchNodesetLldpParams) ([]LLDP, error) {
+	res, err := c.sendGETV1SwitchNodesetLldp(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1SwitchNodesetLldp(ctx context.Context, params GETV1SwitchNodesetLldpParams) (res []LLDP, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/switch/"
+	{
+		// Encode "nodeset" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "nodeset",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Nodeset))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/lldp"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "ports" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "ports",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Ports.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1SwitchNodesetLldpOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1SwitchNodesetLldpOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1SwitchNodesetLldpResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Topology invokes GET_/v1/topology operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).Topology`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Get the network topology graph of the links seen on switch ports.
+//
+// GET /v1/topology
+func (c *Client) GETV1Topology(ctx context.Context, params GETV1TopologyParams) (*TopologyGraph, error) {
+	res, err := c.sendGETV1Topology(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Topology(ctx context.Context, params GETV1TopologyParams) (res *TopologyGraph, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/topology"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "switch" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "switch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Switch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "moved" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "moved",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Moved.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1TopologyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1TopologyOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1TopologyResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1TopologyCabling invokes GET_/v1/topology/cabling operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).TopologyCabling`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Validate the intended switch ports of node interfaces against the MAC address tables and LLDP
+// neighbors of their switches.
+//
+// GET /v1/topology/cabling
+func (c *Client) GETV1TopologyCabling(ctx context.Context, params GETV1TopologyCablingParams) (*CablingReport, error) {
+	res, err := c.sendGETV1TopologyCabling(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1TopologyCabling(ctx context.Context, params GETV1TopologyCablingParams) (res *CablingReport, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/topology/cabling"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1TopologyCablingOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1TopologyCablingOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1TopologyCablingResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1TopologyDot invokes GET_/v1/topology/dot operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).TopologyDot`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Get the network topology graph in the Graphviz DOT language.
+//
+// GET /v1/topology/dot
+func (c *Client) GETV1TopologyDot(ctx context.Context, params GETV1TopologyDotParams) (GETV1TopologyDotOK, error) {
+	res, err := c.sendGETV1TopologyDot(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1TopologyDot(ctx context.Context, params GETV1TopologyDotParams) (res GETV1TopologyDotOK, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/topology/dot"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "switch" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "switch",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Switch.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "moved" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "moved",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Moved.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1TopologyDotOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1TopologyDotOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1TopologyDotResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GETV1Users invokes GET_/v1/users operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).UserList`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// List all users.
+//
+// GET /v1/users
+func (c *Client) GETV1Users(ctx context.Context, params GETV1UsersParams) ([]User, error) {
+	res, err := c.sendGETV1Users(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGETV1Users(ctx context.Context, params GETV1UsersParams) (res []User, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "usernames" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "usernames",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Usernames.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, GETV1UsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, GETV1UsersOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodeGETV1UsersResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1AuthReset invokes PATCH_/v1/auth/reset operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).AuthReset`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Change password.
+//
+// PATCH /v1/auth/reset
+func (c *Client) PATCHV1AuthReset(ctx context.Context, request *AuthResetRequest, params PATCHV1AuthResetParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1AuthReset(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1AuthReset(ctx context.Context, request *AuthResetRequest, params PATCHV1AuthResetParams) (res *GenericResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/reset"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1AuthResetRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1AuthResetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1AuthResetOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1AuthResetResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1ImagesCurrent invokes PATCH_/v1/images/current operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageCurrent`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Set the current version of an image.
+//
+// PATCH /v1/images/current
+func (c *Client) PATCHV1ImagesCurrent(ctx context.Context, request *BootImageCurrentRequest, params PATCHV1ImagesCurrentParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1ImagesCurrent(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1ImagesCurrent(ctx context.Context, request *BootImageCurrentRequest, params PATCHV1ImagesCurrentParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/images/current"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1ImagesCurrentRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1ImagesCurrentOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1ImagesCurrentOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1ImagesCurrentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1NodesImage invokes PATCH_/v1/nodes/image operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeBootImage`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Update nodes boot image by nodeset and/or tags.
+//
+// PATCH /v1/nodes/image
+func (c *Client) PATCHV1NodesImage(ctx context.Context, request *NodeBootImageRequest, params PATCHV1NodesImageParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1NodesImage(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1NodesImage(ctx context.Context, request *NodeBootImageRequest, params PATCHV1NodesImageParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/image"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1NodesImageRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesImageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1NodesImageOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1NodesImageResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1NodesProvision invokes PATCH_/v1/nodes/provision operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeProvision`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Provision / Unprovision nodes by nodeset and/or tags.
+//
+// PATCH /v1/nodes/provision
+func (c *Client) PATCHV1NodesProvision(ctx context.Context, request *NodeProvisionRequest, params PATCHV1NodesProvisionParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1NodesProvision(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1NodesProvision(ctx context.Context, request *NodeProvisionRequest, params PATCHV1NodesProvisionParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/nodes/provision"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1NodesProvisionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesProvisionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1NodesProvisionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1NodesProvisionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1NodesTagsAction invokes PATCH_/v1/nodes/tags/:action operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeTags`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Update nodes tags by nodeset and/or tags.
+//
+// PATCH /v1/nodes/tags/{action}
+func (c *Client) PATCHV1NodesTagsAction(ctx context.Context, request *NodeTagsRequest, params PATCHV1NodesTagsActionParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1NodesTagsAction(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1NodesTagsAction(ctx context.Context, request *NodeTagsRequest, params PATCHV1NodesTagsActionParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/v1/nodes/tags/"
+	{
+		// Encode "action" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "action",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Action))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1NodesTagsActionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1NodesTagsActionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1NodesTagsActionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1NodesTagsActionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1Roles invokes PATCH_/v1/roles operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).PatchRoles`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Edit role permissions.
+//
+// PATCH /v1/roles
+func (c *Client) PATCHV1Roles(ctx context.Context, request *PatchRolesRequest, params PATCHV1RolesParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1Roles(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1Roles(ctx context.Context, request *PatchRolesRequest, params PATCHV1RolesParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/roles"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1RolesRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1RolesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1RolesOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1RolesResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1UsersUsernamesEnable invokes PATCH_/v1/users/:usernames/enable operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).UserEnable`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Update users enable.
+//
+// PATCH /v1/users/{usernames}/enable
+func (c *Client) PATCHV1UsersUsernamesEnable(ctx context.Context, request *UserEnableRequest, params PATCHV1UsersUsernamesEnableParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1UsersUsernamesEnable(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1UsersUsernamesEnable(ctx context.Context, request *UserEnableRequest, params PATCHV1UsersUsernamesEnableParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/users/"
+	{
+		// Encode "usernames" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "usernames",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Usernames))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/enable"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1UsersUsernamesEnableRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1UsersUsernamesEnableOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1UsersUsernamesEnableOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1UsersUsernamesEnableResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// PATCHV1UsersUsernamesRole invokes PATCH_/v1/users/:usernames/role operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).UserRole`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Update users role.
+//
+// PATCH /v1/users/{usernames}/role
+func (c *Client) PATCHV1UsersUsernamesRole(ctx context.Context, request *UserRoleRequest, params PATCHV1UsersUsernamesRoleParams) (*GenericResponse, error) {
+	res, err := c.sendPATCHV1UsersUsernamesRole(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPATCHV1UsersUsernamesRole(ctx context.Context, request *UserRoleRequest, params PATCHV1UsersUsernamesRoleParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/v1/users/"
+	{
+		// Encode "usernames" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "usernames",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Usernames))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/role"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "PATCH", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePATCHV1UsersUsernamesRoleRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, PATCHV1UsersUsernamesRoleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, PATCHV1UsersUsernamesRoleOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePATCHV1UsersUsernamesRoleResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1AuthSignin invokes POST_/v1/auth/signin operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignin`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// ---
+// signin user.
+//
+// POST /v1/auth/signin
+func (c *Client) POSTV1AuthSignin(ctx context.Context, request *AuthRequest, params POSTV1AuthSigninParams) (*AuthResponse, error) {
+	res, err := c.sendPOSTV1AuthSignin(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest, params POSTV1AuthSigninParams) (res *AuthResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/signin"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1AuthSigninRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1AuthSigninOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1AuthSigninOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1AuthSigninResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1AuthSignup invokes POST_/v1/auth/signup operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignup`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// ---
+// Signup user.
+//
+// POST /v1/auth/signup
+func (c *Client) POSTV1AuthSignup(ctx context.Context, request *AuthSignupRequest, params POSTV1AuthSignupParams) (*AuthResponse, error) {
+	res, err := c.sendPOSTV1AuthSignup(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRequest, params POSTV1AuthSignupParams) (res *AuthResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/signup"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1AuthSignupRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1AuthSignupOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1AuthSignupOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1AuthSignupResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1AuthToken invokes POST_/v1/auth/token operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).AuthToken`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Create API token.
+//
+// POST /v1/auth/token
+func (c *Client) POSTV1AuthToken(ctx context.Context, request *AuthTokenRequest, params POSTV1AuthTokenParams) (*AuthTokenReponse, error) {
+	res, err := c.sendPOSTV1AuthToken(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1AuthToken(ctx context.Context, request *AuthTokenRequest, params POSTV1AuthTokenParams) (res *AuthTokenReponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/auth/token"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1AuthTokenRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1AuthTokenOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1AuthTokenOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1AuthTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1BmcConfigureAuto invokes POST_/v1/bmc/configure/auto operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcAutoConfigure`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Set BMC to autoconfigure.
+//
+// POST /v1/bmc/configure/auto
+func (c *Client) POSTV1BmcConfigureAuto(ctx context.Context, params POSTV1BmcConfigureAutoParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcConfigureAuto(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1BmcConfigureAutoParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/configure/auto"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcConfigureAutoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcConfigureAutoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1BmcConfigureAutoResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1BmcConfigureImport invokes POST_/v1/bmc/configure/import operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcImportConfiguration`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Manually import system configuration to BMC.
+//
+// POST /v1/bmc/configure/import
+func (c *Client) POSTV1BmcConfigureImport(ctx context.Context, request *BmcImportConfigurationRequest, params POSTV1BmcConfigureImportParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcConfigureImport(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcImportConfigurationRequest, params POSTV1BmcConfigureImportParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/configure/import"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1BmcConfigureImportRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcConfigureImportOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcConfigureImportOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1BmcConfigureImportResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1BmcCredentials invokes POST_/v1/bmc/credentials operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcCredentialStore`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Store the BMC credential of a node or of all nodes with a tag.
+//
+// POST /v1/bmc/credentials
+func (c *Client) POSTV1BmcCredentials(ctx context.Context, request *BmcCredentialRequest, params POSTV1BmcCredentialsParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1BmcCredentials(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1BmcCredentials(ctx context.Context, request *BmcCredentialRequest, params POSTV1BmcCredentialsParams) (res *GenericResponse, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/credentials"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1BmcCredentialsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcCredentialsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcCredentialsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1BmcCredentialsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1BmcCredentialsRotate invokes POST_/v1/bmc/credentials/rotate operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcCredentialRotate`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Change the BMC password of nodes to new random passwords stored with the node.
+//
+// POST /v1/bmc/credentials/rotate
+func (c *Client) POSTV1BmcCredentialsRotate(ctx context.Context, request *BmcCredentialRotateRequest, params POSTV1BmcCredentialsRotateParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcCredentialsRotate(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1BmcCredentialsRotate(ctx context.Context, request *BmcCredentialRotateRequest, params POSTV1BmcCredentialsRotateParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/credentials/rotate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1BmcCredentialsRotateRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcCredentialsRotateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcCredentialsRotateOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1BmcCredentialsRotateResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1BmcInventory invokes POST_/v1/bmc/inventory operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcCollectInventory`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Collect the hardware inventory of nodes from their BMCs now.
+//
+// POST /v1/bmc/inventory
+func (c *Client) POSTV1BmcInventory(ctx context.Context, params POSTV1BmcInventoryParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcInventory(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1BmcInventory(ctx context.Context, params POSTV1BmcInventoryParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/inventory"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcInventoryOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcInventoryOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1BmcInventoryResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1BmcMedia invokes POST_/v1/bmc/media operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcMediaInsert`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Insert an ISO into the virtual CD/DVD drive of nodes. The media is ejected when the node completes
+// provisioning.
+//
+// POST /v1/bmc/media
+func (c *Client) POSTV1BmcMedia(ctx context.Context, request *BmcMediaInsertRequest, params POSTV1BmcMediaParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcMedia(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1BmcMedia(ctx context.Context, request *BmcMediaInsertRequest, params POSTV1BmcMediaParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/media"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1BmcMediaRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcMediaOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcMediaOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1BmcMediaResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1BmcPowerBmc invokes POST_/v1/bmc/power/bmc operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcPower`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Reboot node(s) BMC.
+//
+// POST /v1/bmc/power/bmc
+func (c *Client) POSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowerBmcParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcPowerBmc(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowerBmcParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/power/bmc"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcPowerBmcOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcPowerBmcOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1BmcPowerBmcResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1BmcPowerOs invokes POST_/v1/bmc/power/os operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcOsPower`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Change power status of node(s).
+//
+// POST /v1/bmc/power/os
+func (c *Client) POSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBody, params POSTV1BmcPowerOsParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcPowerOs(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBody, params POSTV1BmcPowerOsParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/power/os"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1BmcPowerOsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcPowerOsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcPowerOsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1BmcPowerOsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1BmcUpgradeDellInstallfromrepo invokes POST_/v1/bmc/upgrade/dell/installfromrepo operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellInstallFromRepo`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Request iDRAC to download the latest firmware catalog and compare firmware versions.
+//
+// POST /v1/bmc/upgrade/dell/installfromrepo
+func (c *Client) POSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, request *BmcDellInstallFromRepoRequest, params POSTV1BmcUpgradeDellInstallfromrepoParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, request *BmcDellInstallFromRepoRequest, params POSTV1BmcUpgradeDellInstallfromrepoParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/upgrade/dell/installfromrepo"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1BmcUpgradeDellInstallfromrepoRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcUpgradeDellInstallfromrepoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcUpgradeDellInstallfromrepoOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1BmcUpgradeDellInstallfromrepoResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1BmcUpgradeRedfish invokes POST_/v1/bmc/upgrade/redfish operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcFirmwareUpdate`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Update firmware through the Redfish UpdateService. The data of each node's result is the URI of
+// its update task.
+//
+// POST /v1/bmc/upgrade/redfish
+func (c *Client) POSTV1BmcUpgradeRedfish(ctx context.Context, request *BmcFirmwareUpdateRequest, params POSTV1BmcUpgradeRedfishParams) ([]JobMessage, error) {
+	res, err := c.sendPOSTV1BmcUpgradeRedfish(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1BmcUpgradeRedfish(ctx context.Context, request *BmcFirmwareUpdateRequest, params POSTV1BmcUpgradeRedfishParams) (res []JobMessage, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/upgrade/redfish"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "async" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "async",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Async.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1BmcUpgradeRedfishRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcUpgradeRedfishOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcUpgradeRedfishOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1BmcUpgradeRedfishResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1BmcUpgradeRedfishTasks invokes POST_/v1/bmc/upgrade/redfish/tasks operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).BmcFirmwareTasks`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Get the state of Redfish firmware update tasks.
+//
+// POST /v1/bmc/upgrade/redfish/tasks
+func (c *Client) POSTV1BmcUpgradeRedfishTasks(ctx context.Context, request *BmcFirmwareTasksRequest, params POSTV1BmcUpgradeRedfishTasksParams) ([]RedfishFirmwareTask, error) {
+	res, err := c.sendPOSTV1BmcUpgradeRedfishTasks(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1BmcUpgradeRedfishTasks(ctx context.Context, request *BmcFirmwareTasksRequest, params POSTV1BmcUpgradeRedfishTasksParams) (res []RedfishFirmwareTask, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/v1/bmc/upgrade/redfish/tasks"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodePOSTV1BmcUpgradeRedfishTasksRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	h := uri.NewHeaderEncoder(r.Header)
+	{
+		cfg := uri.HeaderParameterEncodingConfig{
+			Name:    "Accept",
+			Explode: false,
+		}
+		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Accept.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode header")
+		}
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityHeaderAuth(ctx, POSTV1BmcUpgradeRedfishTasksOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"HeaderAuth\"")
+			}
+		}
+		{
+
+			switch err := c.securityCookieAuth(ctx, POSTV1BmcUpgradeRedfishTasksOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 1
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	result, err := decodePOSTV1BmcUpgradeRedfishTasksResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// POSTV1DbRestore invokes POST_/v1/db/restore operation.
+//
+// #### Controller:
+// `github.com/ubccr/grendel/internal/api.(*Handler).Restore`
+// #### Middlewares:
+// - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
+// ---
+// Restore a backup of the DB.
+//
+// POST /v1/db/restore
+func (c *Client) POSTV1DbRestore(ctx context.Context, request *DataDump, params POSTV1DbRestoreParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1DbRestore(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, params POSTV1DbRestoreParams) (res *GenericResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/auth/signin"
+	pathParts[0] = "/v1/db/restore"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1AuthSigninRequest(request, r); err != nil {
+	if err := encodePOSTV1DbRestoreRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -4372,7 +9651,7 @@ func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest,
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1AuthSigninOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1DbRestoreOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4383,7 +9662,7 @@ func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest,
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1AuthSigninOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1DbRestoreOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4398,7 +9677,6 @@ func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest,
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
-				{},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -4419,7 +9697,7 @@ func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest,
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1AuthSigninResponse(resp)
+	result, err := decodePOSTV1DbRestoreResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4427,42 +9705,34 @@ func (c *Client) sendPOSTV1AuthSignin(ctx context.Context, request *AuthRequest,
 	return result, nil
 }
 
-// POSTV1AuthSignup invokes POST_/v1/auth/signup operation.
+// POSTV1Images invokes POST_/v1/images operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).AuthSignup`
+// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageAdd`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
+// - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Signup user.
+// Add images.
 //
-// POST /v1/auth/signup
-func (c *Client) POSTV1AuthSignup(ctx context.Context, request *AuthSignupRequest, params POSTV1AuthSignupParams) (*AuthResponse, error) {
-	res, err := c.sendPOSTV1AuthSignup(ctx, request, params)
+// POST /v1/images
+func (c *Client) POSTV1Images(ctx context.Context, request *BootImageAddRequest, params POSTV1ImagesParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1Images(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRequest, params POSTV1AuthSignupParams) (res *AuthResponse, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequest, params POSTV1ImagesParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/auth/signup"
+	pathParts[0] = "/v1/images"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1AuthSignupRequest(request, r); err != nil {
+	if err := encodePOSTV1ImagesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -4487,7 +9757,7 @@ func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRe
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1AuthSignupOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1ImagesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4498,7 +9768,7 @@ func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRe
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1AuthSignupOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1ImagesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4513,7 +9783,6 @@ func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRe
 			for _, requirement := range []bitset{
 				{0b00000001},
 				{0b00000010},
-				{},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -4534,7 +9803,7 @@ func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRe
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1AuthSignupResponse(resp)
+	result, err := decodePOSTV1ImagesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4542,59 +9811,44 @@ func (c *Client) sendPOSTV1AuthSignup(ctx context.Context, request *AuthSignupRe
 	return result, nil
 }
 
-// POSTV1AuthToken invokes POST_/v1/auth/token operation.
+// POSTV1ImagesArtifacts invokes POST_/v1/images/artifacts operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).AuthToken`
+// `github.com/ubccr/grendel/internal/api.(*Handler).ArtifactUpload`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Create API token.
+// Upload a kernel, initrd or live image file. The file is stored under its SHA-256 checksum, which
+// images reference in kernel_sha256, initrd_sha256 and liveimg_sha256.
 //
-// POST /v1/auth/token
-func (c *Client) POSTV1AuthToken(ctx context.Context, request *AuthTokenRequest, params POSTV1AuthTokenParams) (*AuthTokenReponse, error) {
-	res, err := c.sendPOSTV1AuthToken(ctx, request, params)
+// POST /v1/images/artifacts
+func (c *Client) POSTV1ImagesArtifacts(ctx context.Context, request POSTV1ImagesArtifactsReq) (*Artifact, error) {
+	res, err := c.sendPOSTV1ImagesArtifacts(ctx, request)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1AuthToken(ctx context.Context, request *AuthTokenRequest, params POSTV1AuthTokenParams) (res *AuthTokenReponse, err error) {
+func (c *Client) sendPOSTV1ImagesArtifacts(ctx context.Context, request POSTV1ImagesArtifactsReq) (res *Artifact, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/auth/token"
+	pathParts[0] = "/v1/images/artifacts"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1AuthTokenRequest(request, r); err != nil {
+	if err := encodePOSTV1ImagesArtifactsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
-	h := uri.NewHeaderEncoder(r.Header)
-	{
-		cfg := uri.HeaderParameterEncodingConfig{
-			Name:    "Accept",
-			Explode: false,
-		}
-		if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Accept.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode header")
-		}
-	}
-
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1AuthTokenOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1ImagesArtifactsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4605,7 +9859,7 @@ func (c *Client) sendPOSTV1AuthToken(ctx context.Context, request *AuthTokenRequ
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1AuthTokenOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1ImagesArtifactsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4640,7 +9894,7 @@ func (c *Client) sendPOSTV1AuthToken(ctx context.Context, request *AuthTokenRequ
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1AuthTokenResponse(resp)
+	result, err := decodePOSTV1ImagesArtifactsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4648,66 +9902,29 @@ func (c *Client) sendPOSTV1AuthToken(ctx context.Context, request *AuthTokenRequ
 	return result, nil
 }
 
-// POSTV1BmcConfigureAuto invokes POST_/v1/bmc/configure/auto operation.
+// POSTV1ImagesArtifactsGc invokes POST_/v1/images/artifacts/gc operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcAutoConfigure`
+// `github.com/ubccr/grendel/internal/api.(*Handler).ArtifactGC`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Set BMC to autoconfigure.
+// Delete the image artifacts no image references.
 //
-// POST /v1/bmc/configure/auto
-func (c *Client) POSTV1BmcConfigureAuto(ctx context.Context, params POSTV1BmcConfigureAutoParams) ([]JobMessage, error) {
-	res, err := c.sendPOSTV1BmcConfigureAuto(ctx, params)
+// POST /v1/images/artifacts/gc
+func (c *Client) POSTV1ImagesArtifactsGc(ctx context.Context, params POSTV1ImagesArtifactsGcParams) ([]Artifact, error) {
+	res, err := c.sendPOSTV1ImagesArtifactsGc(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1BmcConfigureAutoParams) (res []JobMessage, err error) {
+func (c *Client) sendPOSTV1ImagesArtifactsGc(ctx context.Context, params POSTV1ImagesArtifactsGcParams) (res []Artifact, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/configure/auto"
+	pathParts[0] = "/v1/images/artifacts/gc"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
@@ -4734,7 +9951,7 @@ func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1Bm
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1BmcConfigureAutoOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1ImagesArtifactsGcOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4745,7 +9962,7 @@ func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1Bm
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1BmcConfigureAutoOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1ImagesArtifactsGcOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4780,7 +9997,7 @@ func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1Bm
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1BmcConfigureAutoResponse(resp)
+	result, err := decodePOSTV1ImagesArtifactsGcResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4788,27 +10005,27 @@ func (c *Client) sendPOSTV1BmcConfigureAuto(ctx context.Context, params POSTV1Bm
 	return result, nil
 }
 
-// POSTV1BmcConfigureImport invokes POST_/v1/bmc/configure/import operation.
+// POSTV1ImagesRollouts invokes POST_/v1/images/rollouts operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcImportConfiguration`
+// `github.com/ubccr/grendel/internal/api.(*Handler).RolloutStart`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Manually import system configuration to BMC.
+// Start a rollout of an image version to a percentage of nodes by nodeset and/or tags.
 //
-// POST /v1/bmc/configure/import
-func (c *Client) POSTV1BmcConfigureImport(ctx context.Context, request *BmcImportConfigurationRequest, params POSTV1BmcConfigureImportParams) ([]JobMessage, error) {
-	res, err := c.sendPOSTV1BmcConfigureImport(ctx, request, params)
+// POST /v1/images/rollouts
+func (c *Client) POSTV1ImagesRollouts(ctx context.Context, request *RolloutStartRequest, params POSTV1ImagesRolloutsParams) (*Rollout, error) {
+	res, err := c.sendPOSTV1ImagesRollouts(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcImportConfigurationRequest, params POSTV1BmcConfigureImportParams) (res []JobMessage, err error) {
+func (c *Client) sendPOSTV1ImagesRollouts(ctx context.Context, request *RolloutStartRequest, params POSTV1ImagesRolloutsParams) (res *Rollout, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/configure/import"
+	pathParts[0] = "/v1/images/rollouts"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	q := uri.NewQueryEncoder()
@@ -4852,7 +10069,7 @@ func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcI
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1BmcConfigureImportRequest(request, r); err != nil {
+	if err := encodePOSTV1ImagesRolloutsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -4877,7 +10094,7 @@ func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcI
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1BmcConfigureImportOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1ImagesRolloutsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4888,7 +10105,7 @@ func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcI
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1BmcConfigureImportOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1ImagesRolloutsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -4923,7 +10140,7 @@ func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcI
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1BmcConfigureImportResponse(resp)
+	result, err := decodePOSTV1ImagesRolloutsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4931,65 +10148,47 @@ func (c *Client) sendPOSTV1BmcConfigureImport(ctx context.Context, request *BmcI
 	return result, nil
 }
 
-// POSTV1BmcPowerBmc invokes POST_/v1/bmc/power/bmc operation.
+// POSTV1ImagesRolloutsImagePromote invokes POST_/v1/images/rollouts/:image/promote operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcPower`
+// `github.com/ubccr/grendel/internal/api.(*Handler).RolloutPromote`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Reboot node(s) BMC.
+// Make the version of a running rollout current and move all nodes of the rollout to it.
 //
-// POST /v1/bmc/power/bmc
-func (c *Client) POSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowerBmcParams) ([]JobMessage, error) {
-	res, err := c.sendPOSTV1BmcPowerBmc(ctx, params)
+// POST /v1/images/rollouts/{image}/promote
+func (c *Client) POSTV1ImagesRolloutsImagePromote(ctx context.Context, params POSTV1ImagesRolloutsImagePromoteParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1ImagesRolloutsImagePromote(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowerBmcParams) (res []JobMessage, err error) {
+func (c *Client) sendPOSTV1ImagesRolloutsImagePromote(ctx context.Context, params POSTV1ImagesRolloutsImagePromoteParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/power/bmc"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [3]string
+	pathParts[0] = "/v1/images/rollouts/"
 	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "image" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "image",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Image))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/promote"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
@@ -5017,7 +10216,7 @@ func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowe
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1BmcPowerBmcOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1ImagesRolloutsImagePromoteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5028,7 +10227,7 @@ func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowe
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1BmcPowerBmcOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1ImagesRolloutsImagePromoteOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5063,7 +10262,7 @@ func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowe
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1BmcPowerBmcResponse(resp)
+	result, err := decodePOSTV1ImagesRolloutsImagePromoteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5071,73 +10270,52 @@ func (c *Client) sendPOSTV1BmcPowerBmc(ctx context.Context, params POSTV1BmcPowe
 	return result, nil
 }
 
-// POSTV1BmcPowerOs invokes POST_/v1/bmc/power/os operation.
+// POSTV1ImagesRolloutsImageRollback invokes POST_/v1/images/rollouts/:image/rollback operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcOsPower`
+// `github.com/ubccr/grendel/internal/api.(*Handler).RolloutRollback`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Change power status of node(s).
+// Move the canary nodes of a running rollout back to the current version of the image.
 //
-// POST /v1/bmc/power/os
-func (c *Client) POSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBody, params POSTV1BmcPowerOsParams) ([]JobMessage, error) {
-	res, err := c.sendPOSTV1BmcPowerOs(ctx, request, params)
+// POST /v1/images/rollouts/{image}/rollback
+func (c *Client) POSTV1ImagesRolloutsImageRollback(ctx context.Context, params POSTV1ImagesRolloutsImageRollbackParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1ImagesRolloutsImageRollback(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBody, params POSTV1BmcPowerOsParams) (res []JobMessage, err error) {
+func (c *Client) sendPOSTV1ImagesRolloutsImageRollback(ctx context.Context, params POSTV1ImagesRolloutsImageRollbackParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/power/os"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
+	var pathParts [3]string
+	pathParts[0] = "/v1/images/rollouts/"
 	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "image" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "image",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Image))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
 	}
-	u.RawQuery = q.Values().Encode()
+	pathParts[2] = "/rollback"
+	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1BmcPowerOsRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -5160,7 +10338,7 @@ func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBo
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1BmcPowerOsOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1ImagesRolloutsImageRollbackOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5171,7 +10349,7 @@ func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBo
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1BmcPowerOsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1ImagesRolloutsImageRollbackOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5206,7 +10384,7 @@ func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBo
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1BmcPowerOsResponse(resp)
+	result, err := decodePOSTV1ImagesRolloutsImageRollbackResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5214,71 +10392,43 @@ func (c *Client) sendPOSTV1BmcPowerOs(ctx context.Context, request *BmcOsPowerBo
 	return result, nil
 }
 
-// POSTV1BmcUpgradeDellInstallfromrepo invokes POST_/v1/bmc/upgrade/dell/installfromrepo operation.
+// POSTV1Nodes invokes POST_/v1/nodes operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BmcDellInstallFromRepo`
+// `github.com/ubccr/grendel/internal/api.(*Handler).NodeAdd`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Request iDRAC to download the latest firmware catalog and compare firmware versions.
+// Add nodes.
 //
-// POST /v1/bmc/upgrade/dell/installfromrepo
-func (c *Client) POSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, request *BmcDellInstallFromRepoRequest, params POSTV1BmcUpgradeDellInstallfromrepoParams) ([]JobMessage, error) {
-	res, err := c.sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx, request, params)
+// POST /v1/nodes
+func (c *Client) POSTV1Nodes(ctx context.Context, request *NodeAddRequest, params POSTV1NodesParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1Nodes(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, request *BmcDellInstallFromRepoRequest, params POSTV1BmcUpgradeDellInstallfromrepoParams) (res []JobMessage, err error) {
+func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, params POSTV1NodesParams) (res *GenericResponse, err error) {
+	// Validate request before sending.
+	if err := func() error {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return res, errors.Wrap(err, "validate")
+	}
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/bmc/upgrade/dell/installfromrepo"
+	pathParts[0] = "/v1/nodes"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	q := uri.NewQueryEncoder()
-	{
-		// Encode "nodeset" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "nodeset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Nodeset.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "tags" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "tags",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Tags.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	u.RawQuery = q.Values().Encode()
-
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1BmcUpgradeDellInstallfromrepoRequest(request, r); err != nil {
+	if err := encodePOSTV1NodesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -5303,7 +10453,7 @@ func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, re
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1BmcUpgradeDellInstallfromrepoOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1NodesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5314,7 +10464,7 @@ func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, re
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1BmcUpgradeDellInstallfromrepoOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1NodesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5349,7 +10499,7 @@ func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, re
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1BmcUpgradeDellInstallfromrepoResponse(resp)
+	result, err := decodePOSTV1NodesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5357,43 +10507,34 @@ func (c *Client) sendPOSTV1BmcUpgradeDellInstallfromrepo(ctx context.Context, re
 	return result, nil
 }
 
-// POSTV1DbRestore invokes POST_/v1/db/restore operation.
+// POSTV1Roles invokes POST_/v1/roles operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).Restore`
+// `github.com/ubccr/grendel/internal/api.(*Handler).PostRoles`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Restore a backup of the DB.
+// Add roles.
 //
-// POST /v1/db/restore
-func (c *Client) POSTV1DbRestore(ctx context.Context, request *DataDump, params POSTV1DbRestoreParams) (*GenericResponse, error) {
-	res, err := c.sendPOSTV1DbRestore(ctx, request, params)
+// POST /v1/roles
+func (c *Client) POSTV1Roles(ctx context.Context, request *PostRolesRequest, params POSTV1RolesParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1Roles(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, params POSTV1DbRestoreParams) (res *GenericResponse, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendPOSTV1Roles(ctx context.Context, request *PostRolesRequest, params POSTV1RolesParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/db/restore"
+	pathParts[0] = "/v1/roles"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1DbRestoreRequest(request, r); err != nil {
+	if err := encodePOSTV1RolesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -5418,7 +10559,7 @@ func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, par
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1DbRestoreOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1RolesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5429,7 +10570,7 @@ func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, par
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1DbRestoreOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1RolesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5464,7 +10605,7 @@ func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, par
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1DbRestoreResponse(resp)
+	result, err := decodePOSTV1RolesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5472,36 +10613,105 @@ func (c *Client) sendPOSTV1DbRestore(ctx context.Context, request *DataDump, par
 	return result, nil
 }
 
-// POSTV1Images invokes POST_/v1/images operation.
+// POSTV1SwitchPorts invokes POST_/v1/switch/ports operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).BootImageAdd`
+// `github.com/ubccr/grendel/internal/api.(*Handler).SwitchConfigurePorts`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Add images.
+// Set the access VLAN, MTU and description of the intended switch ports of node interfaces from
+// their VLAN, MTU and FQDN.
 //
-// POST /v1/images
-func (c *Client) POSTV1Images(ctx context.Context, request *BootImageAddRequest, params POSTV1ImagesParams) (*GenericResponse, error) {
-	res, err := c.sendPOSTV1Images(ctx, request, params)
+// POST /v1/switch/ports
+func (c *Client) POSTV1SwitchPorts(ctx context.Context, params POSTV1SwitchPortsParams) (*SwitchPortReport, error) {
+	res, err := c.sendPOSTV1SwitchPorts(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequest, params POSTV1ImagesParams) (res *GenericResponse, err error) {
+func (c *Client) sendPOSTV1SwitchPorts(ctx context.Context, params POSTV1SwitchPortsParams) (res *SwitchPortReport, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/images"
+	pathParts[0] = "/v1/switch/ports"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "dry_run" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "dry_run",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.DryRun.Get(); ok {
+				return e.EncodeValue(conv.BoolToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "admin_state" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "admin_state",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.AdminState.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1ImagesRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -5524,7 +10734,7 @@ func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequ
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1ImagesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1SwitchPortsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5535,7 +10745,7 @@ func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequ
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1ImagesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1SwitchPortsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5570,7 +10780,7 @@ func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequ
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1ImagesResponse(resp)
+	result, err := decodePOSTV1SwitchPortsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5578,45 +10788,70 @@ func (c *Client) sendPOSTV1Images(ctx context.Context, request *BootImageAddRequ
 	return result, nil
 }
 
-// POSTV1Nodes invokes POST_/v1/nodes operation.
+// POSTV1TopologyAccept invokes POST_/v1/topology/accept operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).NodeAdd`
+// `github.com/ubccr/grendel/internal/api.(*Handler).TopologyAccept`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Add nodes.
+// Accept the switch ports nodes are seen on as their expected ports.
 //
-// POST /v1/nodes
-func (c *Client) POSTV1Nodes(ctx context.Context, request *NodeAddRequest, params POSTV1NodesParams) (*GenericResponse, error) {
-	res, err := c.sendPOSTV1Nodes(ctx, request, params)
+// POST /v1/topology/accept
+func (c *Client) POSTV1TopologyAccept(ctx context.Context, params POSTV1TopologyAcceptParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1TopologyAccept(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, params POSTV1NodesParams) (res *GenericResponse, err error) {
-	// Validate request before sending.
-	if err := func() error {
-		if err := request.Validate(); err != nil {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return res, errors.Wrap(err, "validate")
-	}
+func (c *Client) sendPOSTV1TopologyAccept(ctx context.Context, params POSTV1TopologyAcceptParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/nodes"
+	pathParts[0] = "/v1/topology/accept"
 	uri.AddPathParts(u, pathParts[:]...)
 
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "nodeset" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "nodeset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Nodeset.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "tags" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "tags",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Tags.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1NodesRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -5639,7 +10874,7 @@ func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, p
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1NodesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1TopologyAcceptOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5650,7 +10885,7 @@ func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, p
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1NodesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1TopologyAcceptOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5685,7 +10920,7 @@ func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, p
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1NodesResponse(resp)
+	result, err := decodePOSTV1TopologyAcceptResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -5693,36 +10928,33 @@ func (c *Client) sendPOSTV1Nodes(ctx context.Context, request *NodeAddRequest, p
 	return result, nil
 }
 
-// POSTV1Roles invokes POST_/v1/roles operation.
+// POSTV1TopologyWalk invokes POST_/v1/topology/walk operation.
 //
 // #### Controller:
-// `github.com/ubccr/grendel/internal/api.(*Handler).PostRoles`
+// `github.com/ubccr/grendel/internal/api.(*Handler).TopologyWalk`
 // #### Middlewares:
 // - `github.com/go-fuego/fuego.defaultLogger.middleware`
 // - `github.com/ubccr/grendel/internal/api.(*Handler).authMiddleware`
 // ---
-// Add roles.
+// Walk the MAC address tables and LLDP neighbors of all switches now.
 //
-// POST /v1/roles
-func (c *Client) POSTV1Roles(ctx context.Context, request *PostRolesRequest, params POSTV1RolesParams) (*GenericResponse, error) {
-	res, err := c.sendPOSTV1Roles(ctx, request, params)
+// POST /v1/topology/walk
+func (c *Client) POSTV1TopologyWalk(ctx context.Context, params POSTV1TopologyWalkParams) (*GenericResponse, error) {
+	res, err := c.sendPOSTV1TopologyWalk(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendPOSTV1Roles(ctx context.Context, request *PostRolesRequest, params POSTV1RolesParams) (res *GenericResponse, err error) {
+func (c *Client) sendPOSTV1TopologyWalk(ctx context.Context, params POSTV1TopologyWalkParams) (res *GenericResponse, err error) {
 
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/v1/roles"
+	pathParts[0] = "/v1/topology/walk"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePOSTV1RolesRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	h := uri.NewHeaderEncoder(r.Header)
 	{
@@ -5745,7 +10977,7 @@ func (c *Client) sendPOSTV1Roles(ctx context.Context, request *PostRolesRequest,
 		var satisfied bitset
 		{
 
-			switch err := c.securityHeaderAuth(ctx, POSTV1RolesOperation, r); {
+			switch err := c.securityHeaderAuth(ctx, POSTV1TopologyWalkOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5756,7 +10988,7 @@ func (c *Client) sendPOSTV1Roles(ctx context.Context, request *PostRolesRequest,
 		}
 		{
 
-			switch err := c.securityCookieAuth(ctx, POSTV1RolesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, POSTV1TopologyWalkOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 1
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -5791,7 +11023,7 @@ func (c *Client) sendPOSTV1Roles(ctx context.Context, request *PostRolesRequest,
 	}
 	defer resp.Body.Close()
 
-	result, err := decodePOSTV1RolesResponse(resp)
+	result, err := decodePOSTV1TopologyWalkResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
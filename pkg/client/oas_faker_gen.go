@@ -9,6 +9,30 @@ import (
 	"github.com/go-faster/jx"
 )
 
+// SetFake set fake values.
+func (s *Artifact) SetFake() {
+	{
+		{
+			s.Path.SetFake()
+		}
+	}
+	{
+		{
+			s.SHA256.SetFake()
+		}
+	}
+	{
+		{
+			s.Size.SetFake()
+		}
+	}
+	{
+		{
+			s.Time.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *AuthRequest) SetFake() {
 	{
@@ -103,6 +127,83 @@ func (s *AuthTokenRequest) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *BmcCredential) SetFake() {
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Password.SetFake()
+		}
+	}
+	{
+		{
+			s.Scope.SetFake()
+		}
+	}
+	{
+		{
+			s.UpdatedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Username.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BmcCredentialRequest) SetFake() {
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Password.SetFake()
+		}
+	}
+	{
+		{
+			s.Scope.SetFake()
+		}
+	}
+	{
+		{
+			s.Username.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BmcCredentialRotateRequest) SetFake() {
+	{
+		{
+			s.CurrentPassword.SetFake()
+		}
+	}
+	{
+		{
+			s.CurrentUsername.SetFake()
+		}
+	}
+	{
+		{
+			s.Factory.SetFake()
+		}
+	}
+	{
+		{
+			s.Length.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *BmcDellInstallFromRepoRequest) SetFake() {
 	{
@@ -147,6 +248,62 @@ func (s *BmcDellInstallFromRepoRequest) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *BmcFirmwareTasksRequest) SetFake() {
+	{
+		{
+			s.Tasks.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BmcFirmwareTasksRequestTasks) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *BmcFirmwareUpdateRequest) SetFake() {
+	{
+		{
+			s.ApplyTime.SetFake()
+		}
+	}
+	{
+		{
+			s.ForceUpdate.SetFake()
+		}
+	}
+	{
+		{
+			s.Image.SetFake()
+		}
+	}
+	{
+		{
+			s.Method.SetFake()
+		}
+	}
+	{
+		{
+			s.Targets = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Targets = append(s.Targets, elem)
+			}
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *BmcImportConfigurationRequest) SetFake() {
 	{
@@ -181,6 +338,20 @@ func (s *BmcJobDeleteRequestNodeJobList) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *BmcMediaInsertRequest) SetFake() {
+	{
+		{
+			s.Boot.SetFake()
+		}
+	}
+	{
+		{
+			s.Image.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *BmcOsPowerBody) SetFake() {
 	{
@@ -196,152 +367,647 @@ func (s *BmcOsPowerBody) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *BootImage) SetFake() {
+func (s *BmcTask) SetFake() {
 	{
 		{
-			s.Cmdline.SetFake()
+			s.CreatedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Error.SetFake()
 		}
 	}
 	{
 		{
-			s.Initrd = nil
+			s.Hosts = nil
 			for i := 0; i < 0; i++ {
-				var elem string
+				var elem BmcTaskHostsItem
 				{
-					elem = "string"
+					elem.SetFake()
 				}
-				s.Initrd = append(s.Initrd, elem)
+				s.Hosts = append(s.Hosts, elem)
 			}
 		}
 	}
 	{
 		{
-			s.Kernel = "string"
+			s.ID.SetFake()
 		}
 	}
 	{
 		{
-			s.Liveimg.SetFake()
+			s.Operation.SetFake()
 		}
 	}
 	{
 		{
-			s.Name = "string"
+			s.Owner.SetFake()
 		}
 	}
 	{
 		{
-			s.ProvisionTemplates.SetFake()
+			s.Status.SetFake()
 		}
 	}
 	{
 		{
-			s.UID.SetFake()
+			s.UpdatedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.Verify.SetFake()
+			s.User.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *BootImageAddRequest) SetFake() {
+func (s *BmcTaskHostsItem) SetFake() {
 	{
 		{
-			s.BootImages = nil
-			for i := 0; i < 0; i++ {
-				var elem NilBootImageAddRequestBootImagesItem
-				{
-					elem.SetFake()
-				}
-				s.BootImages = append(s.BootImages, elem)
-			}
+			s.Data.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.Msg.SetFake()
+		}
+	}
+	{
+		{
+			s.RedfishError.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *BootImageAddRequestBootImagesItem) SetFake() {
+func (s *BmcTaskHostsItemRedfishError) SetFake() {
 	{
 		{
-			s.Cmdline.SetFake()
+			s.Code.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Error.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *BmcTaskHostsItemRedfishErrorError) SetFake() {
 	{
 		{
-			s.Initrd = nil
+			s.MessageDotExtendedInfo = nil
 			for i := 0; i < 0; i++ {
-				var elem string
+				var elem BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem
 				{
-					elem = "string"
+					elem.SetFake()
 				}
-				s.Initrd = append(s.Initrd, elem)
+				s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
 			}
 		}
 	}
 	{
 		{
-			s.Kernel.SetFake()
+			s.Code.SetFake()
 		}
 	}
 	{
 		{
-			s.Liveimg.SetFake()
+			s.Message.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetFake() {
 	{
 		{
-			s.Name.SetFake()
+			s.Message.SetFake()
 		}
 	}
 	{
 		{
-			s.ProvisionTemplates.SetFake()
+			s.MessageArgsDotOdataDotCount.SetFake()
 		}
 	}
 	{
 		{
-			s.UID.SetFake()
+			s.MessageId.SetFake()
 		}
 	}
 	{
 		{
-			s.Verify.SetFake()
+			s.RelatedPropertiesDotOdataDotCount.SetFake()
+		}
+	}
+	{
+		{
+			s.Resolution.SetFake()
+		}
+	}
+	{
+		{
+			s.Severity.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *BootImageAddRequestBootImagesItemProvisionTemplates) SetFake() {
-	var (
-		elem NilString
-		m    map[string]NilString = s.init()
-	)
-	for i := 0; i < 0; i++ {
-		m[fmt.Sprintf("fake%d", i)] = elem
+func (s *BootImage) SetFake() {
+	{
+		{
+			s.Cmdline.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Initrd = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Initrd = append(s.Initrd, elem)
+			}
+		}
+	}
+	{
+		{
+			s.InitrdSHA256 = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.InitrdSHA256 = append(s.InitrdSHA256, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Kernel = "string"
+		}
+	}
+	{
+		{
+			s.KernelSHA256.SetFake()
+		}
+	}
+	{
+		{
+			s.Liveimg.SetFake()
+		}
+	}
+	{
+		{
+			s.LiveimgSHA256.SetFake()
+		}
+	}
+	{
+		{
+			s.Name = "string"
+		}
+	}
+	{
+		{
+			s.ProvisionTemplates.SetFake()
+		}
+	}
+	{
+		{
+			s.UID.SetFake()
+		}
+	}
+	{
+		{
+			s.Verify.SetFake()
+		}
+	}
+	{
+		{
+			s.Version.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BootImageAddRequest) SetFake() {
+	{
+		{
+			s.BootImages = nil
+			for i := 0; i < 0; i++ {
+				var elem NilBootImageAddRequestBootImagesItem
+				{
+					elem.SetFake()
+				}
+				s.BootImages = append(s.BootImages, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BootImageAddRequestBootImagesItem) SetFake() {
+	{
+		{
+			s.Cmdline.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Initrd = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Initrd = append(s.Initrd, elem)
+			}
+		}
+	}
+	{
+		{
+			s.InitrdSHA256 = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.InitrdSHA256 = append(s.InitrdSHA256, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Kernel.SetFake()
+		}
+	}
+	{
+		{
+			s.KernelSHA256.SetFake()
+		}
+	}
+	{
+		{
+			s.Liveimg.SetFake()
+		}
+	}
+	{
+		{
+			s.LiveimgSHA256.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.ProvisionTemplates.SetFake()
+		}
+	}
+	{
+		{
+			s.UID.SetFake()
+		}
+	}
+	{
+		{
+			s.Verify.SetFake()
+		}
+	}
+	{
+		{
+			s.Version.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BootImageAddRequestBootImagesItemProvisionTemplates) SetFake() {
+	var (
+		elem NilString
+		m    map[string]NilString = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *BootImageCurrentRequest) SetFake() {
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Version.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BootImageProvisionTemplates) SetFake() {
+	var (
+		elem NilString
+		m    map[string]NilString = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *BootImageVersion) SetFake() {
+	{
+		{
+			s.Current.SetFake()
+		}
+	}
+	{
+		{
+			s.Image.SetFake()
+		}
+	}
+	{
+		{
+			s.Time.SetFake()
+		}
+	}
+	{
+		{
+			s.Version.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BootImageVersionImage) SetFake() {
+	{
+		{
+			s.Cmdline.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Initrd = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Initrd = append(s.Initrd, elem)
+			}
+		}
+	}
+	{
+		{
+			s.InitrdSHA256 = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.InitrdSHA256 = append(s.InitrdSHA256, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Kernel.SetFake()
+		}
+	}
+	{
+		{
+			s.KernelSHA256.SetFake()
+		}
+	}
+	{
+		{
+			s.Liveimg.SetFake()
+		}
+	}
+	{
+		{
+			s.LiveimgSHA256.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.ProvisionTemplates.SetFake()
+		}
+	}
+	{
+		{
+			s.UID.SetFake()
+		}
+	}
+	{
+		{
+			s.Verify.SetFake()
+		}
+	}
+	{
+		{
+			s.Version.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *BootImageVersionImageProvisionTemplates) SetFake() {
+	var (
+		elem NilString
+		m    map[string]NilString = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *CablingReport) SetFake() {
+	{
+		{
+			s.Failed = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Failed = append(s.Failed, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Links = nil
+			for i := 0; i < 0; i++ {
+				var elem NilCablingReportLinksItem
+				{
+					elem.SetFake()
+				}
+				s.Links = append(s.Links, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *CablingReportLinksItem) SetFake() {
+	{
+		{
+			s.ActualPort.SetFake()
+		}
+	}
+	{
+		{
+			s.ActualSwitch.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.Ifname.SetFake()
+		}
+	}
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Port.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DHCPEvent) SetFake() {
+	{
+		{
+			s.Firmware.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.MessageType.SetFake()
+		}
+	}
+	{
+		{
+			s.RelayIP.SetFake()
+		}
+	}
+	{
+		{
+			s.Time.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *DHCPLease) SetFake() {
+	{
+		{
+			s.CreatedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.ExpiresAt.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
 	}
-}
-
-// SetFake set fake values.
-func (s *BootImageProvisionTemplates) SetFake() {
-	var (
-		elem NilString
-		m    map[string]NilString = s.init()
-	)
-	for i := 0; i < 0; i++ {
-		m[fmt.Sprintf("fake%d", i)] = elem
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.UpdatedAt.SetFake()
+		}
 	}
 }
 
@@ -475,6 +1141,11 @@ func (s *DataDumpHostsItemBondsItem) SetFake() {
 			s.IP.SetFake()
 		}
 	}
+	{
+		{
+			s.Ip6.SetFake()
+		}
+	}
 	{
 		{
 			s.MAC.SetFake()
@@ -497,6 +1168,16 @@ func (s *DataDumpHostsItemBondsItem) SetFake() {
 			}
 		}
 	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.SwitchPort.SetFake()
+		}
+	}
 	{
 		{
 			s.Vlan.SetFake()
@@ -531,6 +1212,11 @@ func (s *DataDumpHostsItemInterfacesItem) SetFake() {
 			s.IP.SetFake()
 		}
 	}
+	{
+		{
+			s.Ip6.SetFake()
+		}
+	}
 	{
 		{
 			s.MAC.SetFake()
@@ -541,6 +1227,16 @@ func (s *DataDumpHostsItemInterfacesItem) SetFake() {
 			s.Mtu.SetFake()
 		}
 	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.SwitchPort.SetFake()
+		}
+	}
 	{
 		{
 			s.Vlan.SetFake()
@@ -572,16 +1268,38 @@ func (s *DataDumpImagesItem) SetFake() {
 			}
 		}
 	}
+	{
+		{
+			s.InitrdSHA256 = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.InitrdSHA256 = append(s.InitrdSHA256, elem)
+			}
+		}
+	}
 	{
 		{
 			s.Kernel.SetFake()
 		}
 	}
+	{
+		{
+			s.KernelSHA256.SetFake()
+		}
+	}
 	{
 		{
 			s.Liveimg.SetFake()
 		}
 	}
+	{
+		{
+			s.LiveimgSHA256.SetFake()
+		}
+	}
 	{
 		{
 			s.Name.SetFake()
@@ -602,6 +1320,11 @@ func (s *DataDumpImagesItem) SetFake() {
 			s.Verify.SetFake()
 		}
 	}
+	{
+		{
+			s.Version.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
@@ -656,6 +1379,23 @@ func (s *DataDumpUsersItem) SetFake() {
 
 // SetFake set fake values.
 func (s *Event) SetFake() {
+	{
+		{
+			s.Hosts = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Hosts = append(s.Hosts, elem)
+			}
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
 	{
 		{
 			s.JobMessages = nil
@@ -889,6 +1629,55 @@ func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *GrendelStatus) SetFake() {
+	{
+		{
+			s.Active.SetFake()
+		}
+	}
+	{
+		{
+			s.Ha.SetFake()
+		}
+	}
+	{
+		{
+			s.Hostname.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Leader.SetFake()
+		}
+	}
+	{
+		{
+			s.LeaderSince.SetFake()
+		}
+	}
+	{
+		{
+			s.LeaseExpiresAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Role.SetFake()
+		}
+	}
+	{
+		{
+			s.Version.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *HTTPError) SetFake() {
 	{
@@ -969,63 +1758,198 @@ func (s *Host) SetFake() {
 	}
 	{
 		{
-			s.BootImage.SetFake()
+			s.BootImage.SetFake()
+		}
+	}
+	{
+		{
+			s.Firmware.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Interfaces = nil
+			for i := 0; i < 0; i++ {
+				var elem NilHostInterfacesItem
+				{
+					elem.SetFake()
+				}
+				s.Interfaces = append(s.Interfaces, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Provision.SetFake()
+		}
+	}
+	{
+		{
+			s.Tags.SetFake()
+		}
+	}
+	{
+		{
+			s.UID.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostBondsItem) SetFake() {
+	{
+		{
+			s.Bmc.SetFake()
+		}
+	}
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Ifname.SetFake()
+		}
+	}
+	{
+		{
+			s.IP.SetFake()
+		}
+	}
+	{
+		{
+			s.Ip6.SetFake()
+		}
+	}
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Mtu.SetFake()
+		}
+	}
+	{
+		{
+			s.Peers = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Peers = append(s.Peers, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.SwitchPort.SetFake()
+		}
+	}
+	{
+		{
+			s.Vlan.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *HostInterfacesItem) SetFake() {
+	{
+		{
+			s.Bmc.SetFake()
+		}
+	}
+	{
+		{
+			s.Fqdn.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Ifname.SetFake()
 		}
 	}
 	{
 		{
-			s.Firmware.SetFake()
+			s.IP.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Ip6.SetFake()
 		}
 	}
 	{
 		{
-			s.Interfaces = nil
-			for i := 0; i < 0; i++ {
-				var elem NilHostInterfacesItem
-				{
-					elem.SetFake()
-				}
-				s.Interfaces = append(s.Interfaces, elem)
-			}
+			s.MAC.SetFake()
 		}
 	}
 	{
 		{
-			s.Name.SetFake()
+			s.Mtu.SetFake()
 		}
 	}
 	{
 		{
-			s.Provision.SetFake()
+			s.Switch.SetFake()
 		}
 	}
 	{
 		{
-			s.Tags.SetFake()
+			s.SwitchPort.SetFake()
 		}
 	}
 	{
 		{
-			s.UID.SetFake()
+			s.Vlan.SetFake()
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *HostBondsItem) SetFake() {
+func (s *InventoryChange) SetFake() {
 	{
 		{
-			s.Bmc.SetFake()
+			s.Change.SetFake()
 		}
 	}
 	{
 		{
-			s.Fqdn.SetFake()
+			s.DetectedAt.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
 		}
 	}
 	{
@@ -1035,83 +1959,86 @@ func (s *HostBondsItem) SetFake() {
 	}
 	{
 		{
-			s.Ifname.SetFake()
+			s.Kind.SetFake()
 		}
 	}
 	{
 		{
-			s.IP.SetFake()
+			s.New.SetFake()
 		}
 	}
 	{
 		{
-			s.MAC.SetFake()
+			s.Old.SetFake()
 		}
 	}
 	{
 		{
-			s.Mtu.SetFake()
+			s.Slot.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *InventoryComponent) SetFake() {
 	{
 		{
-			s.Peers = nil
-			for i := 0; i < 0; i++ {
-				var elem string
-				{
-					elem = "string"
-				}
-				s.Peers = append(s.Peers, elem)
-			}
+			s.Address.SetFake()
 		}
 	}
 	{
 		{
-			s.Vlan.SetFake()
+			s.Capacity.SetFake()
 		}
 	}
-}
-
-// SetFake set fake values.
-func (s *HostInterfacesItem) SetFake() {
 	{
 		{
-			s.Bmc.SetFake()
+			s.CollectedAt.SetFake()
 		}
 	}
 	{
 		{
-			s.Fqdn.SetFake()
+			s.Host.SetFake()
 		}
 	}
 	{
 		{
-			s.ID.SetFake()
+			s.Kind.SetFake()
 		}
 	}
 	{
 		{
-			s.Ifname.SetFake()
+			s.Manufacturer.SetFake()
 		}
 	}
 	{
 		{
-			s.IP.SetFake()
+			s.Model.SetFake()
 		}
 	}
 	{
 		{
-			s.MAC.SetFake()
+			s.Name.SetFake()
 		}
 	}
 	{
 		{
-			s.Mtu.SetFake()
+			s.PartNumber.SetFake()
 		}
 	}
 	{
 		{
-			s.Vlan.SetFake()
+			s.Serial.SetFake()
+		}
+	}
+	{
+		{
+			s.Slot.SetFake()
+		}
+	}
+	{
+		{
+			s.Version.SetFake()
 		}
 	}
 }
@@ -1266,6 +2193,11 @@ func (s *NilBootImageAddRequestBootImagesItem) SetFake() {
 	s.Null = true
 }
 
+// SetFake set fake values.
+func (s *NilCablingReportLinksItem) SetFake() {
+	s.Null = true
+}
+
 // SetFake set fake values.
 func (s *NilDataDumpHostsItem) SetFake() {
 	s.Null = true
@@ -1331,6 +2263,21 @@ func (s *NilString) SetFake() {
 	s.Null = true
 }
 
+// SetFake set fake values.
+func (s *NilSwitchPortReportPortsItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilTopologyGraphLinksItem) SetFake() {
+	s.Null = true
+}
+
+// SetFake set fake values.
+func (s *NilTopologyGraphNodesItem) SetFake() {
+	s.Null = true
+}
+
 // SetFake set fake values.
 func (s *NodeAddRequest) SetFake() {
 	{
@@ -1437,6 +2384,11 @@ func (s *NodeAddRequestNodeListItemBondsItem) SetFake() {
 			s.IP.SetFake()
 		}
 	}
+	{
+		{
+			s.Ip6.SetFake()
+		}
+	}
 	{
 		{
 			s.MAC.SetFake()
@@ -1459,6 +2411,16 @@ func (s *NodeAddRequestNodeListItemBondsItem) SetFake() {
 			}
 		}
 	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.SwitchPort.SetFake()
+		}
+	}
 	{
 		{
 			s.Vlan.SetFake()
@@ -1493,6 +2455,11 @@ func (s *NodeAddRequestNodeListItemInterfacesItem) SetFake() {
 			s.IP.SetFake()
 		}
 	}
+	{
+		{
+			s.Ip6.SetFake()
+		}
+	}
 	{
 		{
 			s.MAC.SetFake()
@@ -1503,6 +2470,16 @@ func (s *NodeAddRequestNodeListItemInterfacesItem) SetFake() {
 			s.Mtu.SetFake()
 		}
 	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.SwitchPort.SetFake()
+		}
+	}
 	{
 		{
 			s.Vlan.SetFake()
@@ -1567,6 +2544,15 @@ func (s *NodeTagsRequest) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *OptBmcFirmwareTasksRequestTasks) SetFake() {
+	var elem BmcFirmwareTasksRequestTasks
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
 // SetFake set fake values.
 func (s *OptBmcJobDeleteRequestNodeJobList) SetFake() {
 	var elem BmcJobDeleteRequestNodeJobList
@@ -1576,6 +2562,24 @@ func (s *OptBmcJobDeleteRequestNodeJobList) SetFake() {
 	s.SetTo(elem)
 }
 
+// SetFake set fake values.
+func (s *OptBmcTaskHostsItemRedfishError) SetFake() {
+	var elem BmcTaskHostsItemRedfishError
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptBmcTaskHostsItemRedfishErrorError) SetFake() {
+	var elem BmcTaskHostsItemRedfishErrorError
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
 // SetFake set fake values.
 func (s *OptBool) SetFake() {
 	var elem bool
@@ -1621,6 +2625,15 @@ func (s *OptFloat32) SetFake() {
 	s.SetTo(elem)
 }
 
+// SetFake set fake values.
+func (s *OptFloat64) SetFake() {
+	var elem float64
+	{
+		elem = float64(0)
+	}
+	s.SetTo(elem)
+}
+
 // SetFake set fake values.
 func (s *OptHTTPErrorErrorsItemMore) SetFake() {
 	var elem HTTPErrorErrorsItemMore
@@ -1678,6 +2691,18 @@ func (s *OptNilBootImageProvisionTemplates) SetFake() {
 	s.Set = true
 }
 
+// SetFake set fake values.
+func (s *OptNilBootImageVersionImage) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
+// SetFake set fake values.
+func (s *OptNilBootImageVersionImageProvisionTemplates) SetFake() {
+	s.Null = true
+	s.Set = true
+}
+
 // SetFake set fake values.
 func (s *OptNilDataDumpImagesItemProvisionTemplates) SetFake() {
 	s.Null = true
@@ -1783,6 +2808,15 @@ func (s *OptRedfishJobJobsItemSchedule) SetFake() {
 	s.SetTo(elem)
 }
 
+// SetFake set fake values.
+func (s *OptRolloutPrevious) SetFake() {
+	var elem RolloutPrevious
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
 // SetFake set fake values.
 func (s *OptString) SetFake() {
 	var elem string
@@ -1841,6 +2875,69 @@ func (s *PostRolesRequest) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *ProvisionEvent) SetFake() {
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.State.SetFake()
+		}
+	}
+	{
+		{
+			s.Time.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ProvisionLog) SetFake() {
+	{
+		{
+			s.Content.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Kind.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.Time.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *RedfishDellUpgradeFirmware) SetFake() {
 	{
@@ -1926,7 +3023,51 @@ func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetFake() {
 	}
 	{
 		{
-			s.JobID.SetFake()
+			s.JobID.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+	{
+		{
+			s.PackageName.SetFake()
+		}
+	}
+	{
+		{
+			s.PackagePath.SetFake()
+		}
+	}
+	{
+		{
+			s.PackageVersion.SetFake()
+		}
+	}
+	{
+		{
+			s.RebootType.SetFake()
+		}
+	}
+	{
+		{
+			s.Target.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RedfishFirmwareTask) SetFake() {
+	{
+		{
+			s.Message.SetFake()
+		}
+	}
+	{
+		{
+			s.Messages.SetFake()
 		}
 	}
 	{
@@ -1936,27 +3077,27 @@ func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetFake() {
 	}
 	{
 		{
-			s.PackageName.SetFake()
+			s.PercentComplete.SetFake()
 		}
 	}
 	{
 		{
-			s.PackagePath.SetFake()
+			s.Status.SetFake()
 		}
 	}
 	{
 		{
-			s.PackageVersion.SetFake()
+			s.TaskID.SetFake()
 		}
 	}
 	{
 		{
-			s.RebootType.SetFake()
+			s.TaskState.SetFake()
 		}
 	}
 	{
 		{
-			s.Target.SetFake()
+			s.TaskStatus.SetFake()
 		}
 	}
 }
@@ -3584,6 +4725,300 @@ func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemAction
 	}
 }
 
+// SetFake set fake values.
+func (s *Rollout) SetFake() {
+	{
+		{
+			s.Canary.SetFake()
+		}
+	}
+	{
+		{
+			s.Complete.SetFake()
+		}
+	}
+	{
+		{
+			s.Failed.SetFake()
+		}
+	}
+	{
+		{
+			s.FromVersion.SetFake()
+		}
+	}
+	{
+		{
+			s.ID.SetFake()
+		}
+	}
+	{
+		{
+			s.Image.SetFake()
+		}
+	}
+	{
+		{
+			s.Nodes.SetFake()
+		}
+	}
+	{
+		{
+			s.Pending.SetFake()
+		}
+	}
+	{
+		{
+			s.Percent.SetFake()
+		}
+	}
+	{
+		{
+			s.Previous.SetFake()
+		}
+	}
+	{
+		{
+			s.State.SetFake()
+		}
+	}
+	{
+		{
+			s.SuccessRate.SetFake()
+		}
+	}
+	{
+		{
+			s.Time.SetFake()
+		}
+	}
+	{
+		{
+			s.Updated.SetFake()
+		}
+	}
+	{
+		{
+			s.Version.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RolloutPrevious) SetFake() {
+	var (
+		elem string
+		m    map[string]string = s.init()
+	)
+	for i := 0; i < 0; i++ {
+		m[fmt.Sprintf("fake%d", i)] = elem
+	}
+}
+
+// SetFake set fake values.
+func (s *RolloutStartRequest) SetFake() {
+	{
+		{
+			s.Image.SetFake()
+		}
+	}
+	{
+		{
+			s.Percent.SetFake()
+		}
+	}
+	{
+		{
+			s.Provision.SetFake()
+		}
+	}
+	{
+		{
+			s.Version.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *SwitchPortReport) SetFake() {
+	{
+		{
+			s.DryRun.SetFake()
+		}
+	}
+	{
+		{
+			s.Failed = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Failed = append(s.Failed, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Ports = nil
+			for i := 0; i < 0; i++ {
+				var elem NilSwitchPortReportPortsItem
+				{
+					elem.SetFake()
+				}
+				s.Ports = append(s.Ports, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *SwitchPortReportPortsItem) SetFake() {
+	{
+		{
+			s.Changes = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.Changes = append(s.Changes, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Error.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.Ifname.SetFake()
+		}
+	}
+	{
+		{
+			s.Port.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *TopologyGraph) SetFake() {
+	{
+		{
+			s.Links = nil
+			for i := 0; i < 0; i++ {
+				var elem NilTopologyGraphLinksItem
+				{
+					elem.SetFake()
+				}
+				s.Links = append(s.Links, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Nodes = nil
+			for i := 0; i < 0; i++ {
+				var elem NilTopologyGraphNodesItem
+				{
+					elem.SetFake()
+				}
+				s.Nodes = append(s.Nodes, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *TopologyGraphLinksItem) SetFake() {
+	{
+		{
+			s.ExpectedPort.SetFake()
+		}
+	}
+	{
+		{
+			s.ExpectedSwitch.SetFake()
+		}
+	}
+	{
+		{
+			s.Host.SetFake()
+		}
+	}
+	{
+		{
+			s.LastSeen.SetFake()
+		}
+	}
+	{
+		{
+			s.MAC.SetFake()
+		}
+	}
+	{
+		{
+			s.Moved.SetFake()
+		}
+	}
+	{
+		{
+			s.Neighbor.SetFake()
+		}
+	}
+	{
+		{
+			s.NeighborPort.SetFake()
+		}
+	}
+	{
+		{
+			s.Port.SetFake()
+		}
+	}
+	{
+		{
+			s.Switch.SetFake()
+		}
+	}
+	{
+		{
+			s.Vlan.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *TopologyGraphNodesItem) SetFake() {
+	{
+		{
+			s.Kind.SetFake()
+		}
+	}
+	{
+		{
+			s.Name.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *User) SetFake() {
 	{
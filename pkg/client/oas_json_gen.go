@@ -14,6 +14,120 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// Encode implements json.Marshaler.
+func (s *Artifact) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Artifact) encodeFields(e *jx.Encoder) {
+	{
+		if s.Path.Set {
+			e.FieldStart("path")
+			s.Path.Encode(e)
+		}
+	}
+	{
+		if s.SHA256.Set {
+			e.FieldStart("sha256")
+			s.SHA256.Encode(e)
+		}
+	}
+	{
+		if s.Size.Set {
+			e.FieldStart("size")
+			s.Size.Encode(e)
+		}
+	}
+	{
+		if s.Time.Set {
+			e.FieldStart("time")
+			s.Time.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfArtifact = [4]string{
+	0: "path",
+	1: "sha256",
+	2: "size",
+	3: "time",
+}
+
+// Decode decodes Artifact from json.
+func (s *Artifact) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Artifact to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "path":
+			if err := func() error {
+				s.Path.Reset()
+				if err := s.Path.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"path\"")
+			}
+		case "sha256":
+			if err := func() error {
+				s.SHA256.Reset()
+				if err := s.SHA256.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"sha256\"")
+			}
+		case "size":
+			if err := func() error {
+				s.Size.Reset()
+				if err := s.Size.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"size\"")
+			}
+		case "time":
+			if err := func() error {
+				s.Time.Reset()
+				if err := s.Time.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"time\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Artifact")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Artifact) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Artifact) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *AuthRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -595,1090 +709,992 @@ func (s *AuthTokenRequest) UnmarshalJSON(data []byte) error {
 }
 
 // Encode implements json.Marshaler.
-func (s *BmcDellInstallFromRepoRequest) Encode(e *jx.Encoder) {
+func (s *BmcCredential) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *BmcDellInstallFromRepoRequest) encodeFields(e *jx.Encoder) {
-	{
-		if s.ApplyUpdate.Set {
-			e.FieldStart("ApplyUpdate")
-			s.ApplyUpdate.Encode(e)
-		}
-	}
-	{
-		if s.CatalogFile.Set {
-			e.FieldStart("CatalogFile")
-			s.CatalogFile.Encode(e)
-		}
-	}
-	{
-		if s.ClearJobQueue.Set {
-			e.FieldStart("ClearJobQueue")
-			s.ClearJobQueue.Encode(e)
-		}
-	}
+func (s *BmcCredential) encodeFields(e *jx.Encoder) {
 	{
-		if s.IPAddress.Set {
-			e.FieldStart("IPAddress")
-			s.IPAddress.Encode(e)
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
 		}
 	}
 	{
-		if s.IgnoreCertWarning.Set {
-			e.FieldStart("IgnoreCertWarning")
-			s.IgnoreCertWarning.Encode(e)
+		if s.Password.Set {
+			e.FieldStart("password")
+			s.Password.Encode(e)
 		}
 	}
 	{
-		if s.RebootNeeded.Set {
-			e.FieldStart("RebootNeeded")
-			s.RebootNeeded.Encode(e)
+		if s.Scope.Set {
+			e.FieldStart("scope")
+			s.Scope.Encode(e)
 		}
 	}
 	{
-		if s.ShareName.Set {
-			e.FieldStart("ShareName")
-			s.ShareName.Encode(e)
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.ShareType.Set {
-			e.FieldStart("ShareType")
-			s.ShareType.Encode(e)
+		if s.Username.Set {
+			e.FieldStart("username")
+			s.Username.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfBmcDellInstallFromRepoRequest = [8]string{
-	0: "ApplyUpdate",
-	1: "CatalogFile",
-	2: "ClearJobQueue",
-	3: "IPAddress",
-	4: "IgnoreCertWarning",
-	5: "RebootNeeded",
-	6: "ShareName",
-	7: "ShareType",
+var jsonFieldsNameOfBmcCredential = [5]string{
+	0: "name",
+	1: "password",
+	2: "scope",
+	3: "updated_at",
+	4: "username",
 }
 
-// Decode decodes BmcDellInstallFromRepoRequest from json.
-func (s *BmcDellInstallFromRepoRequest) Decode(d *jx.Decoder) error {
+// Decode decodes BmcCredential from json.
+func (s *BmcCredential) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BmcDellInstallFromRepoRequest to nil")
+		return errors.New("invalid: unable to decode BmcCredential to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ApplyUpdate":
-			if err := func() error {
-				s.ApplyUpdate.Reset()
-				if err := s.ApplyUpdate.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ApplyUpdate\"")
-			}
-		case "CatalogFile":
-			if err := func() error {
-				s.CatalogFile.Reset()
-				if err := s.CatalogFile.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"CatalogFile\"")
-			}
-		case "ClearJobQueue":
-			if err := func() error {
-				s.ClearJobQueue.Reset()
-				if err := s.ClearJobQueue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ClearJobQueue\"")
-			}
-		case "IPAddress":
+		case "name":
 			if err := func() error {
-				s.IPAddress.Reset()
-				if err := s.IPAddress.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"IPAddress\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "IgnoreCertWarning":
+		case "password":
 			if err := func() error {
-				s.IgnoreCertWarning.Reset()
-				if err := s.IgnoreCertWarning.Decode(d); err != nil {
+				s.Password.Reset()
+				if err := s.Password.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"IgnoreCertWarning\"")
+				return errors.Wrap(err, "decode field \"password\"")
 			}
-		case "RebootNeeded":
+		case "scope":
 			if err := func() error {
-				s.RebootNeeded.Reset()
-				if err := s.RebootNeeded.Decode(d); err != nil {
+				s.Scope.Reset()
+				if err := s.Scope.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RebootNeeded\"")
+				return errors.Wrap(err, "decode field \"scope\"")
 			}
-		case "ShareName":
+		case "updated_at":
 			if err := func() error {
-				s.ShareName.Reset()
-				if err := s.ShareName.Decode(d); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ShareName\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
-		case "ShareType":
+		case "username":
 			if err := func() error {
-				s.ShareType.Reset()
-				if err := s.ShareType.Decode(d); err != nil {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ShareType\"")
+				return errors.Wrap(err, "decode field \"username\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BmcDellInstallFromRepoRequest")
+		return errors.Wrap(err, "decode BmcCredential")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *BmcDellInstallFromRepoRequest) MarshalJSON() ([]byte, error) {
+func (s *BmcCredential) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BmcDellInstallFromRepoRequest) UnmarshalJSON(data []byte) error {
+func (s *BmcCredential) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *BmcImportConfigurationRequest) Encode(e *jx.Encoder) {
+func (s *BmcCredentialRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *BmcImportConfigurationRequest) encodeFields(e *jx.Encoder) {
+func (s *BmcCredentialRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.File.Set {
-			e.FieldStart("file")
-			s.File.Encode(e)
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
 		}
 	}
 	{
-		if s.ShutdownType.Set {
-			e.FieldStart("shutdown_type")
-			s.ShutdownType.Encode(e)
+		if s.Password.Set {
+			e.FieldStart("password")
+			s.Password.Encode(e)
+		}
+	}
+	{
+		if s.Scope.Set {
+			e.FieldStart("scope")
+			s.Scope.Encode(e)
+		}
+	}
+	{
+		if s.Username.Set {
+			e.FieldStart("username")
+			s.Username.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfBmcImportConfigurationRequest = [2]string{
-	0: "file",
-	1: "shutdown_type",
+var jsonFieldsNameOfBmcCredentialRequest = [4]string{
+	0: "name",
+	1: "password",
+	2: "scope",
+	3: "username",
 }
 
-// Decode decodes BmcImportConfigurationRequest from json.
-func (s *BmcImportConfigurationRequest) Decode(d *jx.Decoder) error {
+// Decode decodes BmcCredentialRequest from json.
+func (s *BmcCredentialRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BmcImportConfigurationRequest to nil")
+		return errors.New("invalid: unable to decode BmcCredentialRequest to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "file":
+		case "name":
 			if err := func() error {
-				s.File.Reset()
-				if err := s.File.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"file\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "shutdown_type":
+		case "password":
 			if err := func() error {
-				s.ShutdownType.Reset()
-				if err := s.ShutdownType.Decode(d); err != nil {
+				s.Password.Reset()
+				if err := s.Password.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"shutdown_type\"")
+				return errors.Wrap(err, "decode field \"password\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode BmcImportConfigurationRequest")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *BmcImportConfigurationRequest) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BmcImportConfigurationRequest) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *BmcJobDeleteRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *BmcJobDeleteRequest) encodeFields(e *jx.Encoder) {
-	{
-		if s.NodeJobList.Set {
-			e.FieldStart("node_job_list")
-			s.NodeJobList.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfBmcJobDeleteRequest = [1]string{
-	0: "node_job_list",
-}
-
-// Decode decodes BmcJobDeleteRequest from json.
-func (s *BmcJobDeleteRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode BmcJobDeleteRequest to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "node_job_list":
+		case "scope":
 			if err := func() error {
-				s.NodeJobList.Reset()
-				if err := s.NodeJobList.Decode(d); err != nil {
+				s.Scope.Reset()
+				if err := s.Scope.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"node_job_list\"")
+				return errors.Wrap(err, "decode field \"scope\"")
+			}
+		case "username":
+			if err := func() error {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BmcJobDeleteRequest")
+		return errors.Wrap(err, "decode BmcCredentialRequest")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *BmcJobDeleteRequest) MarshalJSON() ([]byte, error) {
+func (s *BmcCredentialRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BmcJobDeleteRequest) UnmarshalJSON(data []byte) error {
+func (s *BmcCredentialRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s BmcJobDeleteRequestNodeJobList) Encode(e *jx.Encoder) {
+func (s *BmcCredentialRotateRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s BmcJobDeleteRequestNodeJobList) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.ArrStart()
-		for _, elem := range elem {
-			e.Str(elem)
+// encodeFields encodes fields.
+func (s *BmcCredentialRotateRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.CurrentPassword.Set {
+			e.FieldStart("current_password")
+			s.CurrentPassword.Encode(e)
 		}
-		e.ArrEnd()
-	}
-}
-
-// Decode decodes BmcJobDeleteRequestNodeJobList from json.
-func (s *BmcJobDeleteRequestNodeJobList) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode BmcJobDeleteRequestNodeJobList to nil")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem []string
-		if err := func() error {
-			elem = make([]string, 0)
-			if err := d.Arr(func(d *jx.Decoder) error {
-				var elemElem string
-				v, err := d.Str()
-				elemElem = string(v)
-				if err != nil {
-					return err
-				}
-				elem = append(elem, elemElem)
-				return nil
-			}); err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+	{
+		if s.CurrentUsername.Set {
+			e.FieldStart("current_username")
+			s.CurrentUsername.Encode(e)
 		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode BmcJobDeleteRequestNodeJobList")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s BmcJobDeleteRequestNodeJobList) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BmcJobDeleteRequestNodeJobList) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *BmcOsPowerBody) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *BmcOsPowerBody) encodeFields(e *jx.Encoder) {
 	{
-		if s.BootOption.Set {
-			e.FieldStart("boot_option")
-			s.BootOption.Encode(e)
+		if s.Factory.Set {
+			e.FieldStart("factory")
+			s.Factory.Encode(e)
 		}
 	}
 	{
-		if s.PowerOption.Set {
-			e.FieldStart("power_option")
-			s.PowerOption.Encode(e)
+		if s.Length.Set {
+			e.FieldStart("length")
+			s.Length.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfBmcOsPowerBody = [2]string{
-	0: "boot_option",
-	1: "power_option",
+var jsonFieldsNameOfBmcCredentialRotateRequest = [4]string{
+	0: "current_password",
+	1: "current_username",
+	2: "factory",
+	3: "length",
 }
 
-// Decode decodes BmcOsPowerBody from json.
-func (s *BmcOsPowerBody) Decode(d *jx.Decoder) error {
+// Decode decodes BmcCredentialRotateRequest from json.
+func (s *BmcCredentialRotateRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BmcOsPowerBody to nil")
+		return errors.New("invalid: unable to decode BmcCredentialRotateRequest to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "boot_option":
+		case "current_password":
 			if err := func() error {
-				s.BootOption.Reset()
-				if err := s.BootOption.Decode(d); err != nil {
+				s.CurrentPassword.Reset()
+				if err := s.CurrentPassword.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"boot_option\"")
+				return errors.Wrap(err, "decode field \"current_password\"")
 			}
-		case "power_option":
+		case "current_username":
 			if err := func() error {
-				s.PowerOption.Reset()
-				if err := s.PowerOption.Decode(d); err != nil {
+				s.CurrentUsername.Reset()
+				if err := s.CurrentUsername.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"power_option\"")
+				return errors.Wrap(err, "decode field \"current_username\"")
+			}
+		case "factory":
+			if err := func() error {
+				s.Factory.Reset()
+				if err := s.Factory.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"factory\"")
+			}
+		case "length":
+			if err := func() error {
+				s.Length.Reset()
+				if err := s.Length.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"length\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BmcOsPowerBody")
+		return errors.Wrap(err, "decode BmcCredentialRotateRequest")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *BmcOsPowerBody) MarshalJSON() ([]byte, error) {
+func (s *BmcCredentialRotateRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BmcOsPowerBody) UnmarshalJSON(data []byte) error {
+func (s *BmcCredentialRotateRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *BootImage) Encode(e *jx.Encoder) {
+func (s *BmcDellInstallFromRepoRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *BootImage) encodeFields(e *jx.Encoder) {
+func (s *BmcDellInstallFromRepoRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.Cmdline.Set {
-			e.FieldStart("cmdline")
-			s.Cmdline.Encode(e)
+		if s.ApplyUpdate.Set {
+			e.FieldStart("ApplyUpdate")
+			s.ApplyUpdate.Encode(e)
 		}
 	}
 	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
+		if s.CatalogFile.Set {
+			e.FieldStart("CatalogFile")
+			s.CatalogFile.Encode(e)
 		}
 	}
 	{
-		if s.Initrd != nil {
-			e.FieldStart("initrd")
-			e.ArrStart()
-			for _, elem := range s.Initrd {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.ClearJobQueue.Set {
+			e.FieldStart("ClearJobQueue")
+			s.ClearJobQueue.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("kernel")
-		e.Str(s.Kernel)
-	}
-	{
-		if s.Liveimg.Set {
-			e.FieldStart("liveimg")
-			s.Liveimg.Encode(e)
+		if s.IPAddress.Set {
+			e.FieldStart("IPAddress")
+			s.IPAddress.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		if s.IgnoreCertWarning.Set {
+			e.FieldStart("IgnoreCertWarning")
+			s.IgnoreCertWarning.Encode(e)
+		}
 	}
 	{
-		if s.ProvisionTemplates.Set {
-			e.FieldStart("provision_templates")
-			s.ProvisionTemplates.Encode(e)
+		if s.RebootNeeded.Set {
+			e.FieldStart("RebootNeeded")
+			s.RebootNeeded.Encode(e)
 		}
 	}
 	{
-		if s.UID.Set {
-			e.FieldStart("uid")
-			s.UID.Encode(e)
+		if s.ShareName.Set {
+			e.FieldStart("ShareName")
+			s.ShareName.Encode(e)
 		}
 	}
 	{
-		if s.Verify.Set {
-			e.FieldStart("verify")
-			s.Verify.Encode(e)
+		if s.ShareType.Set {
+			e.FieldStart("ShareType")
+			s.ShareType.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfBootImage = [9]string{
-	0: "cmdline",
-	1: "id",
-	2: "initrd",
-	3: "kernel",
-	4: "liveimg",
-	5: "name",
-	6: "provision_templates",
-	7: "uid",
-	8: "verify",
+var jsonFieldsNameOfBmcDellInstallFromRepoRequest = [8]string{
+	0: "ApplyUpdate",
+	1: "CatalogFile",
+	2: "ClearJobQueue",
+	3: "IPAddress",
+	4: "IgnoreCertWarning",
+	5: "RebootNeeded",
+	6: "ShareName",
+	7: "ShareType",
 }
 
-// Decode decodes BootImage from json.
-func (s *BootImage) Decode(d *jx.Decoder) error {
+// Decode decodes BmcDellInstallFromRepoRequest from json.
+func (s *BmcDellInstallFromRepoRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BootImage to nil")
+		return errors.New("invalid: unable to decode BmcDellInstallFromRepoRequest to nil")
 	}
-	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "cmdline":
-			if err := func() error {
-				s.Cmdline.Reset()
-				if err := s.Cmdline.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cmdline\"")
-			}
-		case "id":
+		case "ApplyUpdate":
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				s.ApplyUpdate.Reset()
+				if err := s.ApplyUpdate.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"ApplyUpdate\"")
 			}
-		case "initrd":
+		case "CatalogFile":
 			if err := func() error {
-				s.Initrd = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Initrd = append(s.Initrd, elem)
-					return nil
-				}); err != nil {
+				s.CatalogFile.Reset()
+				if err := s.CatalogFile.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"initrd\"")
+				return errors.Wrap(err, "decode field \"CatalogFile\"")
 			}
-		case "kernel":
-			requiredBitSet[0] |= 1 << 3
+		case "ClearJobQueue":
 			if err := func() error {
-				v, err := d.Str()
-				s.Kernel = string(v)
-				if err != nil {
+				s.ClearJobQueue.Reset()
+				if err := s.ClearJobQueue.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"kernel\"")
+				return errors.Wrap(err, "decode field \"ClearJobQueue\"")
 			}
-		case "liveimg":
+		case "IPAddress":
 			if err := func() error {
-				s.Liveimg.Reset()
-				if err := s.Liveimg.Decode(d); err != nil {
+				s.IPAddress.Reset()
+				if err := s.IPAddress.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"liveimg\"")
+				return errors.Wrap(err, "decode field \"IPAddress\"")
 			}
-		case "name":
-			requiredBitSet[0] |= 1 << 5
+		case "IgnoreCertWarning":
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				s.IgnoreCertWarning.Reset()
+				if err := s.IgnoreCertWarning.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"IgnoreCertWarning\"")
 			}
-		case "provision_templates":
+		case "RebootNeeded":
 			if err := func() error {
-				s.ProvisionTemplates.Reset()
-				if err := s.ProvisionTemplates.Decode(d); err != nil {
+				s.RebootNeeded.Reset()
+				if err := s.RebootNeeded.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provision_templates\"")
+				return errors.Wrap(err, "decode field \"RebootNeeded\"")
 			}
-		case "uid":
+		case "ShareName":
 			if err := func() error {
-				s.UID.Reset()
-				if err := s.UID.Decode(d); err != nil {
+				s.ShareName.Reset()
+				if err := s.ShareName.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uid\"")
+				return errors.Wrap(err, "decode field \"ShareName\"")
 			}
-		case "verify":
+		case "ShareType":
 			if err := func() error {
-				s.Verify.Reset()
-				if err := s.Verify.Decode(d); err != nil {
+				s.ShareType.Reset()
+				if err := s.ShareType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"verify\"")
+				return errors.Wrap(err, "decode field \"ShareType\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BootImage")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00101000,
-		0b00000000,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfBootImage) {
-					name = jsonFieldsNameOfBootImage[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode BmcDellInstallFromRepoRequest")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *BootImage) MarshalJSON() ([]byte, error) {
+func (s *BmcDellInstallFromRepoRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BootImage) UnmarshalJSON(data []byte) error {
+func (s *BmcDellInstallFromRepoRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *BootImageAddRequest) Encode(e *jx.Encoder) {
+func (s *BmcFirmwareTasksRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *BootImageAddRequest) encodeFields(e *jx.Encoder) {
+func (s *BmcFirmwareTasksRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.BootImages != nil {
-			e.FieldStart("boot_images")
-			e.ArrStart()
-			for _, elem := range s.BootImages {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Tasks.Set {
+			e.FieldStart("tasks")
+			s.Tasks.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfBootImageAddRequest = [1]string{
-	0: "boot_images",
+var jsonFieldsNameOfBmcFirmwareTasksRequest = [1]string{
+	0: "tasks",
 }
 
-// Decode decodes BootImageAddRequest from json.
-func (s *BootImageAddRequest) Decode(d *jx.Decoder) error {
+// Decode decodes BmcFirmwareTasksRequest from json.
+func (s *BmcFirmwareTasksRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BootImageAddRequest to nil")
+		return errors.New("invalid: unable to decode BmcFirmwareTasksRequest to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "boot_images":
+		case "tasks":
 			if err := func() error {
-				s.BootImages = make([]NilBootImageAddRequestBootImagesItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NilBootImageAddRequestBootImagesItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.BootImages = append(s.BootImages, elem)
-					return nil
-				}); err != nil {
+				s.Tasks.Reset()
+				if err := s.Tasks.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"boot_images\"")
+				return errors.Wrap(err, "decode field \"tasks\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BootImageAddRequest")
+		return errors.Wrap(err, "decode BmcFirmwareTasksRequest")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *BootImageAddRequest) MarshalJSON() ([]byte, error) {
+func (s *BmcFirmwareTasksRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BootImageAddRequest) UnmarshalJSON(data []byte) error {
+func (s *BmcFirmwareTasksRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *BootImageAddRequestBootImagesItem) Encode(e *jx.Encoder) {
+func (s BmcFirmwareTasksRequestTasks) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *BootImageAddRequestBootImagesItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Cmdline.Set {
-			e.FieldStart("cmdline")
-			s.Cmdline.Encode(e)
-		}
+// encodeFields implements json.Marshaler.
+func (s BmcFirmwareTasksRequestTasks) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
 	}
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
+}
+
+// Decode decodes BmcFirmwareTasksRequestTasks from json.
+func (s *BmcFirmwareTasksRequestTasks) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode BmcFirmwareTasksRequestTasks to nil")
 	}
-	{
-		if s.Initrd != nil {
-			e.FieldStart("initrd")
-			e.ArrStart()
-			for _, elem := range s.Initrd {
-				e.Str(elem)
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
 			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.Kernel.Set {
-			e.FieldStart("kernel")
-			s.Kernel.Encode(e)
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode BmcFirmwareTasksRequestTasks")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s BmcFirmwareTasksRequestTasks) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *BmcFirmwareTasksRequestTasks) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *BmcFirmwareUpdateRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *BmcFirmwareUpdateRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.Liveimg.Set {
-			e.FieldStart("liveimg")
-			s.Liveimg.Encode(e)
+		if s.ApplyTime.Set {
+			e.FieldStart("apply_time")
+			s.ApplyTime.Encode(e)
 		}
 	}
 	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
+		if s.ForceUpdate.Set {
+			e.FieldStart("force_update")
+			s.ForceUpdate.Encode(e)
 		}
 	}
 	{
-		if s.ProvisionTemplates.Set {
-			e.FieldStart("provision_templates")
-			s.ProvisionTemplates.Encode(e)
+		if s.Image.Set {
+			e.FieldStart("image")
+			s.Image.Encode(e)
 		}
 	}
 	{
-		if s.UID.Set {
-			e.FieldStart("uid")
-			s.UID.Encode(e)
+		if s.Method.Set {
+			e.FieldStart("method")
+			s.Method.Encode(e)
 		}
 	}
 	{
-		if s.Verify.Set {
-			e.FieldStart("verify")
-			s.Verify.Encode(e)
+		if s.Targets != nil {
+			e.FieldStart("targets")
+			e.ArrStart()
+			for _, elem := range s.Targets {
+				e.Str(elem)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfBootImageAddRequestBootImagesItem = [9]string{
-	0: "cmdline",
-	1: "id",
-	2: "initrd",
-	3: "kernel",
-	4: "liveimg",
-	5: "name",
-	6: "provision_templates",
-	7: "uid",
-	8: "verify",
+var jsonFieldsNameOfBmcFirmwareUpdateRequest = [5]string{
+	0: "apply_time",
+	1: "force_update",
+	2: "image",
+	3: "method",
+	4: "targets",
 }
 
-// Decode decodes BootImageAddRequestBootImagesItem from json.
-func (s *BootImageAddRequestBootImagesItem) Decode(d *jx.Decoder) error {
+// Decode decodes BmcFirmwareUpdateRequest from json.
+func (s *BmcFirmwareUpdateRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BootImageAddRequestBootImagesItem to nil")
+		return errors.New("invalid: unable to decode BmcFirmwareUpdateRequest to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "cmdline":
+		case "apply_time":
 			if err := func() error {
-				s.Cmdline.Reset()
-				if err := s.Cmdline.Decode(d); err != nil {
+				s.ApplyTime.Reset()
+				if err := s.ApplyTime.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cmdline\"")
+				return errors.Wrap(err, "decode field \"apply_time\"")
 			}
-		case "id":
+		case "force_update":
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "initrd":
-			if err := func() error {
-				s.Initrd = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Initrd = append(s.Initrd, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"initrd\"")
-			}
-		case "kernel":
-			if err := func() error {
-				s.Kernel.Reset()
-				if err := s.Kernel.Decode(d); err != nil {
+				s.ForceUpdate.Reset()
+				if err := s.ForceUpdate.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"kernel\"")
+				return errors.Wrap(err, "decode field \"force_update\"")
 			}
-		case "liveimg":
+		case "image":
 			if err := func() error {
-				s.Liveimg.Reset()
-				if err := s.Liveimg.Decode(d); err != nil {
+				s.Image.Reset()
+				if err := s.Image.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"liveimg\"")
+				return errors.Wrap(err, "decode field \"image\"")
 			}
-		case "name":
+		case "method":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Method.Reset()
+				if err := s.Method.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"method\"")
 			}
-		case "provision_templates":
+		case "targets":
 			if err := func() error {
-				s.ProvisionTemplates.Reset()
-				if err := s.ProvisionTemplates.Decode(d); err != nil {
+				s.Targets = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Targets = append(s.Targets, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provision_templates\"")
+				return errors.Wrap(err, "decode field \"targets\"")
 			}
-		case "uid":
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode BmcFirmwareUpdateRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *BmcFirmwareUpdateRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *BmcFirmwareUpdateRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *BmcImportConfigurationRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *BmcImportConfigurationRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.File.Set {
+			e.FieldStart("file")
+			s.File.Encode(e)
+		}
+	}
+	{
+		if s.ShutdownType.Set {
+			e.FieldStart("shutdown_type")
+			s.ShutdownType.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfBmcImportConfigurationRequest = [2]string{
+	0: "file",
+	1: "shutdown_type",
+}
+
+// Decode decodes BmcImportConfigurationRequest from json.
+func (s *BmcImportConfigurationRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode BmcImportConfigurationRequest to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "file":
 			if err := func() error {
-				s.UID.Reset()
-				if err := s.UID.Decode(d); err != nil {
+				s.File.Reset()
+				if err := s.File.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uid\"")
+				return errors.Wrap(err, "decode field \"file\"")
 			}
-		case "verify":
+		case "shutdown_type":
 			if err := func() error {
-				s.Verify.Reset()
-				if err := s.Verify.Decode(d); err != nil {
+				s.ShutdownType.Reset()
+				if err := s.ShutdownType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"verify\"")
+				return errors.Wrap(err, "decode field \"shutdown_type\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BootImageAddRequestBootImagesItem")
+		return errors.Wrap(err, "decode BmcImportConfigurationRequest")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *BootImageAddRequestBootImagesItem) MarshalJSON() ([]byte, error) {
+func (s *BmcImportConfigurationRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BootImageAddRequestBootImagesItem) UnmarshalJSON(data []byte) error {
+func (s *BmcImportConfigurationRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s BootImageAddRequestBootImagesItemProvisionTemplates) Encode(e *jx.Encoder) {
+func (s *BmcJobDeleteRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s BootImageAddRequestBootImagesItemProvisionTemplates) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		elem.Encode(e)
+// encodeFields encodes fields.
+func (s *BmcJobDeleteRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.NodeJobList.Set {
+			e.FieldStart("node_job_list")
+			s.NodeJobList.Encode(e)
+		}
 	}
 }
 
-// Decode decodes BootImageAddRequestBootImagesItemProvisionTemplates from json.
-func (s *BootImageAddRequestBootImagesItemProvisionTemplates) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfBmcJobDeleteRequest = [1]string{
+	0: "node_job_list",
+}
+
+// Decode decodes BmcJobDeleteRequest from json.
+func (s *BmcJobDeleteRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BootImageAddRequestBootImagesItemProvisionTemplates to nil")
+		return errors.New("invalid: unable to decode BmcJobDeleteRequest to nil")
 	}
-	m := s.init()
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem NilString
-		if err := func() error {
-			if err := elem.Decode(d); err != nil {
-				return err
+		switch string(k) {
+		case "node_job_list":
+			if err := func() error {
+				s.NodeJobList.Reset()
+				if err := s.NodeJobList.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"node_job_list\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BootImageAddRequestBootImagesItemProvisionTemplates")
+		return errors.Wrap(err, "decode BmcJobDeleteRequest")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s BootImageAddRequestBootImagesItemProvisionTemplates) MarshalJSON() ([]byte, error) {
+func (s *BmcJobDeleteRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BootImageAddRequestBootImagesItemProvisionTemplates) UnmarshalJSON(data []byte) error {
+func (s *BmcJobDeleteRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s BootImageProvisionTemplates) Encode(e *jx.Encoder) {
+func (s BmcJobDeleteRequestNodeJobList) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields implements json.Marshaler.
-func (s BootImageProvisionTemplates) encodeFields(e *jx.Encoder) {
+func (s BmcJobDeleteRequestNodeJobList) encodeFields(e *jx.Encoder) {
 	for k, elem := range s {
 		e.FieldStart(k)
 
-		elem.Encode(e)
+		e.ArrStart()
+		for _, elem := range elem {
+			e.Str(elem)
+		}
+		e.ArrEnd()
 	}
 }
 
-// Decode decodes BootImageProvisionTemplates from json.
-func (s *BootImageProvisionTemplates) Decode(d *jx.Decoder) error {
+// Decode decodes BmcJobDeleteRequestNodeJobList from json.
+func (s *BmcJobDeleteRequestNodeJobList) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BootImageProvisionTemplates to nil")
+		return errors.New("invalid: unable to decode BmcJobDeleteRequestNodeJobList to nil")
 	}
 	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem NilString
+		var elem []string
 		if err := func() error {
-			if err := elem.Decode(d); err != nil {
+			elem = make([]string, 0)
+			if err := d.Arr(func(d *jx.Decoder) error {
+				var elemElem string
+				v, err := d.Str()
+				elemElem = string(v)
+				if err != nil {
+					return err
+				}
+				elem = append(elem, elemElem)
+				return nil
+			}); err != nil {
 				return err
 			}
 			return nil
@@ -1688,184 +1704,214 @@ func (s *BootImageProvisionTemplates) Decode(d *jx.Decoder) error {
 		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BootImageProvisionTemplates")
+		return errors.Wrap(err, "decode BmcJobDeleteRequestNodeJobList")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s BootImageProvisionTemplates) MarshalJSON() ([]byte, error) {
+func (s BmcJobDeleteRequestNodeJobList) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BootImageProvisionTemplates) UnmarshalJSON(data []byte) error {
+func (s *BmcJobDeleteRequestNodeJobList) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DataDump) Encode(e *jx.Encoder) {
+func (s *BmcMediaInsertRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DataDump) encodeFields(e *jx.Encoder) {
+func (s *BmcMediaInsertRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.Hosts != nil {
-			e.FieldStart("Hosts")
-			e.ArrStart()
-			for _, elem := range s.Hosts {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Boot.Set {
+			e.FieldStart("boot")
+			s.Boot.Encode(e)
 		}
 	}
 	{
-		if s.Images != nil {
-			e.FieldStart("Images")
-			e.ArrStart()
-			for _, elem := range s.Images {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.Users != nil {
-			e.FieldStart("Users")
-			e.ArrStart()
-			for _, elem := range s.Users {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Image.Set {
+			e.FieldStart("image")
+			s.Image.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfDataDump = [3]string{
-	0: "Hosts",
-	1: "Images",
-	2: "Users",
+var jsonFieldsNameOfBmcMediaInsertRequest = [2]string{
+	0: "boot",
+	1: "image",
 }
 
-// Decode decodes DataDump from json.
-func (s *DataDump) Decode(d *jx.Decoder) error {
+// Decode decodes BmcMediaInsertRequest from json.
+func (s *BmcMediaInsertRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DataDump to nil")
+		return errors.New("invalid: unable to decode BmcMediaInsertRequest to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Hosts":
+		case "boot":
 			if err := func() error {
-				s.Hosts = make([]NilDataDumpHostsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NilDataDumpHostsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Hosts = append(s.Hosts, elem)
-					return nil
-				}); err != nil {
+				s.Boot.Reset()
+				if err := s.Boot.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Hosts\"")
+				return errors.Wrap(err, "decode field \"boot\"")
 			}
-		case "Images":
+		case "image":
 			if err := func() error {
-				s.Images = make([]NilDataDumpImagesItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NilDataDumpImagesItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Images = append(s.Images, elem)
-					return nil
-				}); err != nil {
+				s.Image.Reset()
+				if err := s.Image.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Images\"")
+				return errors.Wrap(err, "decode field \"image\"")
 			}
-		case "Users":
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode BmcMediaInsertRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *BmcMediaInsertRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *BmcMediaInsertRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *BmcOsPowerBody) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *BmcOsPowerBody) encodeFields(e *jx.Encoder) {
+	{
+		if s.BootOption.Set {
+			e.FieldStart("boot_option")
+			s.BootOption.Encode(e)
+		}
+	}
+	{
+		if s.PowerOption.Set {
+			e.FieldStart("power_option")
+			s.PowerOption.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfBmcOsPowerBody = [2]string{
+	0: "boot_option",
+	1: "power_option",
+}
+
+// Decode decodes BmcOsPowerBody from json.
+func (s *BmcOsPowerBody) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode BmcOsPowerBody to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "boot_option":
 			if err := func() error {
-				s.Users = make([]DataDumpUsersItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem DataDumpUsersItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Users = append(s.Users, elem)
-					return nil
-				}); err != nil {
+				s.BootOption.Reset()
+				if err := s.BootOption.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Users\"")
+				return errors.Wrap(err, "decode field \"boot_option\"")
+			}
+		case "power_option":
+			if err := func() error {
+				s.PowerOption.Reset()
+				if err := s.PowerOption.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"power_option\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DataDump")
+		return errors.Wrap(err, "decode BmcOsPowerBody")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DataDump) MarshalJSON() ([]byte, error) {
+func (s *BmcOsPowerBody) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DataDump) UnmarshalJSON(data []byte) error {
+func (s *BmcOsPowerBody) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DataDumpHostsItem) Encode(e *jx.Encoder) {
+func (s *BmcTask) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DataDumpHostsItem) encodeFields(e *jx.Encoder) {
+func (s *BmcTask) encodeFields(e *jx.Encoder) {
 	{
-		if s.Bonds != nil {
-			e.FieldStart("bonds")
-			e.ArrStart()
-			for _, elem := range s.Bonds {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.BootImage.Set {
-			e.FieldStart("boot_image")
-			s.BootImage.Encode(e)
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
 		}
 	}
 	{
-		if s.Firmware.Set {
-			e.FieldStart("firmware")
-			s.Firmware.Encode(e)
+		if s.Hosts != nil {
+			e.FieldStart("hosts")
+			e.ArrStart()
+			for _, elem := range s.Hosts {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
@@ -1875,97 +1921,93 @@ func (s *DataDumpHostsItem) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.Interfaces != nil {
-			e.FieldStart("interfaces")
-			e.ArrStart()
-			for _, elem := range s.Interfaces {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Operation.Set {
+			e.FieldStart("operation")
+			s.Operation.Encode(e)
 		}
 	}
 	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
+		if s.Owner.Set {
+			e.FieldStart("owner")
+			s.Owner.Encode(e)
 		}
 	}
 	{
-		if s.Provision.Set {
-			e.FieldStart("provision")
-			s.Provision.Encode(e)
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
 		}
 	}
 	{
-		if s.Tags.Set {
-			e.FieldStart("tags")
-			s.Tags.Encode(e)
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.UID.Set {
-			e.FieldStart("uid")
-			s.UID.Encode(e)
+		if s.User.Set {
+			e.FieldStart("user")
+			s.User.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfDataDumpHostsItem = [9]string{
-	0: "bonds",
-	1: "boot_image",
-	2: "firmware",
+var jsonFieldsNameOfBmcTask = [9]string{
+	0: "created_at",
+	1: "error",
+	2: "hosts",
 	3: "id",
-	4: "interfaces",
-	5: "name",
-	6: "provision",
-	7: "tags",
-	8: "uid",
+	4: "operation",
+	5: "owner",
+	6: "status",
+	7: "updated_at",
+	8: "user",
 }
 
-// Decode decodes DataDumpHostsItem from json.
-func (s *DataDumpHostsItem) Decode(d *jx.Decoder) error {
+// Decode decodes BmcTask from json.
+func (s *BmcTask) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DataDumpHostsItem to nil")
+		return errors.New("invalid: unable to decode BmcTask to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "bonds":
+		case "created_at":
 			if err := func() error {
-				s.Bonds = make([]NilDataDumpHostsItemBondsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NilDataDumpHostsItemBondsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Bonds = append(s.Bonds, elem)
-					return nil
-				}); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bonds\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "boot_image":
+		case "error":
 			if err := func() error {
-				s.BootImage.Reset()
-				if err := s.BootImage.Decode(d); err != nil {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"boot_image\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
-		case "firmware":
+		case "hosts":
 			if err := func() error {
-				s.Firmware.Reset()
-				if err := s.Firmware.Decode(d); err != nil {
+				s.Hosts = make([]BmcTaskHostsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem BmcTaskHostsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Hosts = append(s.Hosts, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"firmware\"")
+				return errors.Wrap(err, "decode field \"hosts\"")
 			}
 		case "id":
 			if err := func() error {
@@ -1977,2246 +2019,1862 @@ func (s *DataDumpHostsItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "interfaces":
+		case "operation":
 			if err := func() error {
-				s.Interfaces = make([]NilDataDumpHostsItemInterfacesItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NilDataDumpHostsItemInterfacesItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Interfaces = append(s.Interfaces, elem)
-					return nil
-				}); err != nil {
+				s.Operation.Reset()
+				if err := s.Operation.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"interfaces\"")
+				return errors.Wrap(err, "decode field \"operation\"")
 			}
-		case "name":
+		case "owner":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Owner.Reset()
+				if err := s.Owner.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"owner\"")
 			}
-		case "provision":
+		case "status":
 			if err := func() error {
-				s.Provision.Reset()
-				if err := s.Provision.Decode(d); err != nil {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provision\"")
+				return errors.Wrap(err, "decode field \"status\"")
 			}
-		case "tags":
+		case "updated_at":
 			if err := func() error {
-				s.Tags.Reset()
-				if err := s.Tags.Decode(d); err != nil {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tags\"")
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
-		case "uid":
+		case "user":
 			if err := func() error {
-				s.UID.Reset()
-				if err := s.UID.Decode(d); err != nil {
+				s.User.Reset()
+				if err := s.User.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uid\"")
+				return errors.Wrap(err, "decode field \"user\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DataDumpHostsItem")
+		return errors.Wrap(err, "decode BmcTask")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DataDumpHostsItem) MarshalJSON() ([]byte, error) {
+func (s *BmcTask) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DataDumpHostsItem) UnmarshalJSON(data []byte) error {
+func (s *BmcTask) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DataDumpHostsItemBondsItem) Encode(e *jx.Encoder) {
+func (s *BmcTaskHostsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DataDumpHostsItemBondsItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Bmc.Set {
-			e.FieldStart("bmc")
-			s.Bmc.Encode(e)
-		}
-	}
-	{
-		if s.Fqdn.Set {
-			e.FieldStart("fqdn")
-			s.Fqdn.Encode(e)
-		}
-	}
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.Ifname.Set {
-			e.FieldStart("ifname")
-			s.Ifname.Encode(e)
-		}
-	}
+func (s *BmcTaskHostsItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.IP.Set {
-			e.FieldStart("ip")
-			s.IP.Encode(e)
+		if s.Data.Set {
+			e.FieldStart("data")
+			s.Data.Encode(e)
 		}
 	}
 	{
-		if s.MAC.Set {
-			e.FieldStart("mac")
-			s.MAC.Encode(e)
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
 		}
 	}
 	{
-		if s.Mtu.Set {
-			e.FieldStart("mtu")
-			s.Mtu.Encode(e)
+		if s.Msg.Set {
+			e.FieldStart("msg")
+			s.Msg.Encode(e)
 		}
 	}
 	{
-		if s.Peers != nil {
-			e.FieldStart("peers")
-			e.ArrStart()
-			for _, elem := range s.Peers {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.RedfishError.Set {
+			e.FieldStart("redfish_error")
+			s.RedfishError.Encode(e)
 		}
 	}
 	{
-		if s.Vlan.Set {
-			e.FieldStart("vlan")
-			s.Vlan.Encode(e)
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfDataDumpHostsItemBondsItem = [9]string{
-	0: "bmc",
-	1: "fqdn",
-	2: "id",
-	3: "ifname",
-	4: "ip",
-	5: "mac",
-	6: "mtu",
-	7: "peers",
-	8: "vlan",
+var jsonFieldsNameOfBmcTaskHostsItem = [5]string{
+	0: "data",
+	1: "host",
+	2: "msg",
+	3: "redfish_error",
+	4: "status",
 }
 
-// Decode decodes DataDumpHostsItemBondsItem from json.
-func (s *DataDumpHostsItemBondsItem) Decode(d *jx.Decoder) error {
+// Decode decodes BmcTaskHostsItem from json.
+func (s *BmcTaskHostsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DataDumpHostsItemBondsItem to nil")
+		return errors.New("invalid: unable to decode BmcTaskHostsItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "bmc":
-			if err := func() error {
-				s.Bmc.Reset()
-				if err := s.Bmc.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bmc\"")
-			}
-		case "fqdn":
-			if err := func() error {
-				s.Fqdn.Reset()
-				if err := s.Fqdn.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fqdn\"")
-			}
-		case "id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "ifname":
-			if err := func() error {
-				s.Ifname.Reset()
-				if err := s.Ifname.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ifname\"")
-			}
-		case "ip":
+		case "data":
 			if err := func() error {
-				s.IP.Reset()
-				if err := s.IP.Decode(d); err != nil {
+				s.Data.Reset()
+				if err := s.Data.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ip\"")
+				return errors.Wrap(err, "decode field \"data\"")
 			}
-		case "mac":
+		case "host":
 			if err := func() error {
-				s.MAC.Reset()
-				if err := s.MAC.Decode(d); err != nil {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mac\"")
+				return errors.Wrap(err, "decode field \"host\"")
 			}
-		case "mtu":
+		case "msg":
 			if err := func() error {
-				s.Mtu.Reset()
-				if err := s.Mtu.Decode(d); err != nil {
+				s.Msg.Reset()
+				if err := s.Msg.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mtu\"")
+				return errors.Wrap(err, "decode field \"msg\"")
 			}
-		case "peers":
+		case "redfish_error":
 			if err := func() error {
-				s.Peers = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Peers = append(s.Peers, elem)
-					return nil
-				}); err != nil {
+				s.RedfishError.Reset()
+				if err := s.RedfishError.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"peers\"")
+				return errors.Wrap(err, "decode field \"redfish_error\"")
 			}
-		case "vlan":
+		case "status":
 			if err := func() error {
-				s.Vlan.Reset()
-				if err := s.Vlan.Decode(d); err != nil {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vlan\"")
+				return errors.Wrap(err, "decode field \"status\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DataDumpHostsItemBondsItem")
+		return errors.Wrap(err, "decode BmcTaskHostsItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DataDumpHostsItemBondsItem) MarshalJSON() ([]byte, error) {
+func (s *BmcTaskHostsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DataDumpHostsItemBondsItem) UnmarshalJSON(data []byte) error {
+func (s *BmcTaskHostsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DataDumpHostsItemInterfacesItem) Encode(e *jx.Encoder) {
+func (s *BmcTaskHostsItemRedfishError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DataDumpHostsItemInterfacesItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Bmc.Set {
-			e.FieldStart("bmc")
-			s.Bmc.Encode(e)
-		}
-	}
-	{
-		if s.Fqdn.Set {
-			e.FieldStart("fqdn")
-			s.Fqdn.Encode(e)
-		}
-	}
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.Ifname.Set {
-			e.FieldStart("ifname")
-			s.Ifname.Encode(e)
-		}
-	}
-	{
-		if s.IP.Set {
-			e.FieldStart("ip")
-			s.IP.Encode(e)
-		}
-	}
-	{
-		if s.MAC.Set {
-			e.FieldStart("mac")
-			s.MAC.Encode(e)
-		}
-	}
+func (s *BmcTaskHostsItemRedfishError) encodeFields(e *jx.Encoder) {
 	{
-		if s.Mtu.Set {
-			e.FieldStart("mtu")
-			s.Mtu.Encode(e)
+		if s.Code.Set {
+			e.FieldStart("code")
+			s.Code.Encode(e)
 		}
 	}
 	{
-		if s.Vlan.Set {
-			e.FieldStart("vlan")
-			s.Vlan.Encode(e)
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfDataDumpHostsItemInterfacesItem = [8]string{
-	0: "bmc",
-	1: "fqdn",
-	2: "id",
-	3: "ifname",
-	4: "ip",
-	5: "mac",
-	6: "mtu",
-	7: "vlan",
+var jsonFieldsNameOfBmcTaskHostsItemRedfishError = [2]string{
+	0: "code",
+	1: "error",
 }
 
-// Decode decodes DataDumpHostsItemInterfacesItem from json.
-func (s *DataDumpHostsItemInterfacesItem) Decode(d *jx.Decoder) error {
+// Decode decodes BmcTaskHostsItemRedfishError from json.
+func (s *BmcTaskHostsItemRedfishError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DataDumpHostsItemInterfacesItem to nil")
+		return errors.New("invalid: unable to decode BmcTaskHostsItemRedfishError to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "bmc":
-			if err := func() error {
-				s.Bmc.Reset()
-				if err := s.Bmc.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bmc\"")
-			}
-		case "fqdn":
-			if err := func() error {
-				s.Fqdn.Reset()
-				if err := s.Fqdn.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fqdn\"")
-			}
-		case "id":
+		case "code":
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				s.Code.Reset()
+				if err := s.Code.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"code\"")
 			}
-		case "ifname":
+		case "error":
 			if err := func() error {
-				s.Ifname.Reset()
-				if err := s.Ifname.Decode(d); err != nil {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ifname\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
-		case "ip":
-			if err := func() error {
-				s.IP.Reset()
-				if err := s.IP.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ip\"")
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode BmcTaskHostsItemRedfishError")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *BmcTaskHostsItemRedfishError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *BmcTaskHostsItemRedfishError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *BmcTaskHostsItemRedfishErrorError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *BmcTaskHostsItemRedfishErrorError) encodeFields(e *jx.Encoder) {
+	{
+		if s.MessageDotExtendedInfo != nil {
+			e.FieldStart("@Message.ExtendedInfo")
+			e.ArrStart()
+			for _, elem := range s.MessageDotExtendedInfo {
+				elem.Encode(e)
 			}
-		case "mac":
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Code.Set {
+			e.FieldStart("code")
+			s.Code.Encode(e)
+		}
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfBmcTaskHostsItemRedfishErrorError = [3]string{
+	0: "@Message.ExtendedInfo",
+	1: "code",
+	2: "message",
+}
+
+// Decode decodes BmcTaskHostsItemRedfishErrorError from json.
+func (s *BmcTaskHostsItemRedfishErrorError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode BmcTaskHostsItemRedfishErrorError to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "@Message.ExtendedInfo":
 			if err := func() error {
-				s.MAC.Reset()
-				if err := s.MAC.Decode(d); err != nil {
+				s.MessageDotExtendedInfo = make([]BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mac\"")
+				return errors.Wrap(err, "decode field \"@Message.ExtendedInfo\"")
 			}
-		case "mtu":
+		case "code":
 			if err := func() error {
-				s.Mtu.Reset()
-				if err := s.Mtu.Decode(d); err != nil {
+				s.Code.Reset()
+				if err := s.Code.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mtu\"")
+				return errors.Wrap(err, "decode field \"code\"")
 			}
-		case "vlan":
+		case "message":
 			if err := func() error {
-				s.Vlan.Reset()
-				if err := s.Vlan.Decode(d); err != nil {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vlan\"")
+				return errors.Wrap(err, "decode field \"message\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DataDumpHostsItemInterfacesItem")
+		return errors.Wrap(err, "decode BmcTaskHostsItemRedfishErrorError")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DataDumpHostsItemInterfacesItem) MarshalJSON() ([]byte, error) {
+func (s *BmcTaskHostsItemRedfishErrorError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DataDumpHostsItemInterfacesItem) UnmarshalJSON(data []byte) error {
+func (s *BmcTaskHostsItemRedfishErrorError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DataDumpImagesItem) Encode(e *jx.Encoder) {
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DataDumpImagesItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Cmdline.Set {
-			e.FieldStart("cmdline")
-			s.Cmdline.Encode(e)
-		}
-	}
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.Initrd != nil {
-			e.FieldStart("initrd")
-			e.ArrStart()
-			for _, elem := range s.Initrd {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
-	}
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.Kernel.Set {
-			e.FieldStart("kernel")
-			s.Kernel.Encode(e)
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
 		}
 	}
 	{
-		if s.Liveimg.Set {
-			e.FieldStart("liveimg")
-			s.Liveimg.Encode(e)
+		if s.MessageArgsDotOdataDotCount.Set {
+			e.FieldStart("MessageArgs.@odata.count")
+			s.MessageArgsDotOdataDotCount.Encode(e)
 		}
 	}
 	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
+		if s.MessageId.Set {
+			e.FieldStart("MessageId")
+			s.MessageId.Encode(e)
 		}
 	}
 	{
-		if s.ProvisionTemplates.Set {
-			e.FieldStart("provision_templates")
-			s.ProvisionTemplates.Encode(e)
+		if s.RelatedPropertiesDotOdataDotCount.Set {
+			e.FieldStart("RelatedProperties.@odata.count")
+			s.RelatedPropertiesDotOdataDotCount.Encode(e)
 		}
 	}
 	{
-		if s.UID.Set {
-			e.FieldStart("uid")
-			s.UID.Encode(e)
+		if s.Resolution.Set {
+			e.FieldStart("Resolution")
+			s.Resolution.Encode(e)
 		}
 	}
 	{
-		if s.Verify.Set {
-			e.FieldStart("verify")
-			s.Verify.Encode(e)
+		if s.Severity.Set {
+			e.FieldStart("Severity")
+			s.Severity.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfDataDumpImagesItem = [9]string{
-	0: "cmdline",
-	1: "id",
-	2: "initrd",
-	3: "kernel",
-	4: "liveimg",
-	5: "name",
-	6: "provision_templates",
-	7: "uid",
-	8: "verify",
+var jsonFieldsNameOfBmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem = [6]string{
+	0: "Message",
+	1: "MessageArgs.@odata.count",
+	2: "MessageId",
+	3: "RelatedProperties.@odata.count",
+	4: "Resolution",
+	5: "Severity",
 }
 
-// Decode decodes DataDumpImagesItem from json.
-func (s *DataDumpImagesItem) Decode(d *jx.Decoder) error {
+// Decode decodes BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem from json.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DataDumpImagesItem to nil")
+		return errors.New("invalid: unable to decode BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "cmdline":
-			if err := func() error {
-				s.Cmdline.Reset()
-				if err := s.Cmdline.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"cmdline\"")
-			}
-		case "id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "initrd":
+		case "Message":
 			if err := func() error {
-				s.Initrd = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Initrd = append(s.Initrd, elem)
-					return nil
-				}); err != nil {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"initrd\"")
+				return errors.Wrap(err, "decode field \"Message\"")
 			}
-		case "kernel":
+		case "MessageArgs.@odata.count":
 			if err := func() error {
-				s.Kernel.Reset()
-				if err := s.Kernel.Decode(d); err != nil {
+				s.MessageArgsDotOdataDotCount.Reset()
+				if err := s.MessageArgsDotOdataDotCount.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"kernel\"")
+				return errors.Wrap(err, "decode field \"MessageArgs.@odata.count\"")
 			}
-		case "liveimg":
+		case "MessageId":
 			if err := func() error {
-				s.Liveimg.Reset()
-				if err := s.Liveimg.Decode(d); err != nil {
+				s.MessageId.Reset()
+				if err := s.MessageId.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"liveimg\"")
+				return errors.Wrap(err, "decode field \"MessageId\"")
 			}
-		case "name":
+		case "RelatedProperties.@odata.count":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.RelatedPropertiesDotOdataDotCount.Reset()
+				if err := s.RelatedPropertiesDotOdataDotCount.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"RelatedProperties.@odata.count\"")
 			}
-		case "provision_templates":
+		case "Resolution":
 			if err := func() error {
-				s.ProvisionTemplates.Reset()
-				if err := s.ProvisionTemplates.Decode(d); err != nil {
+				s.Resolution.Reset()
+				if err := s.Resolution.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provision_templates\"")
+				return errors.Wrap(err, "decode field \"Resolution\"")
 			}
-		case "uid":
+		case "Severity":
 			if err := func() error {
-				s.UID.Reset()
-				if err := s.UID.Decode(d); err != nil {
+				s.Severity.Reset()
+				if err := s.Severity.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uid\"")
-			}
-		case "verify":
-			if err := func() error {
-				s.Verify.Reset()
-				if err := s.Verify.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"verify\"")
+				return errors.Wrap(err, "decode field \"Severity\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DataDumpImagesItem")
+		return errors.Wrap(err, "decode BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DataDumpImagesItem) MarshalJSON() ([]byte, error) {
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DataDumpImagesItem) UnmarshalJSON(data []byte) error {
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s DataDumpImagesItemProvisionTemplates) Encode(e *jx.Encoder) {
+func (s *BootImage) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s DataDumpImagesItemProvisionTemplates) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		elem.Encode(e)
+// encodeFields encodes fields.
+func (s *BootImage) encodeFields(e *jx.Encoder) {
+	{
+		if s.Cmdline.Set {
+			e.FieldStart("cmdline")
+			s.Cmdline.Encode(e)
+		}
 	}
-}
-
-// Decode decodes DataDumpImagesItemProvisionTemplates from json.
-func (s *DataDumpImagesItemProvisionTemplates) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode DataDumpImagesItemProvisionTemplates to nil")
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem NilString
-		if err := func() error {
-			if err := elem.Decode(d); err != nil {
-				return err
+	{
+		if s.Initrd != nil {
+			e.FieldStart("initrd")
+			e.ArrStart()
+			for _, elem := range s.Initrd {
+				e.Str(elem)
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+			e.ArrEnd()
 		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode DataDumpImagesItemProvisionTemplates")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s DataDumpImagesItemProvisionTemplates) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DataDumpImagesItemProvisionTemplates) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *DataDumpUsersItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *DataDumpUsersItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		if s.InitrdSHA256 != nil {
+			e.FieldStart("initrd_sha256")
+			e.ArrStart()
+			for _, elem := range s.InitrdSHA256 {
+				e.Str(elem)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.Enabled.Set {
-			e.FieldStart("enabled")
-			s.Enabled.Encode(e)
+		e.FieldStart("kernel")
+		e.Str(s.Kernel)
+	}
+	{
+		if s.KernelSHA256.Set {
+			e.FieldStart("kernel_sha256")
+			s.KernelSHA256.Encode(e)
 		}
 	}
 	{
-		if s.Hash.Set {
-			e.FieldStart("hash")
-			s.Hash.Encode(e)
+		if s.Liveimg.Set {
+			e.FieldStart("liveimg")
+			s.Liveimg.Encode(e)
 		}
 	}
 	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
+		if s.LiveimgSHA256.Set {
+			e.FieldStart("liveimg_sha256")
+			s.LiveimgSHA256.Encode(e)
 		}
 	}
 	{
-		if s.ModifiedAt.Set {
-			e.FieldStart("modified_at")
-			s.ModifiedAt.Encode(e, json.EncodeDateTime)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.ProvisionTemplates.Set {
+			e.FieldStart("provision_templates")
+			s.ProvisionTemplates.Encode(e)
 		}
 	}
 	{
-		if s.Role.Set {
-			e.FieldStart("role")
-			s.Role.Encode(e)
+		if s.UID.Set {
+			e.FieldStart("uid")
+			s.UID.Encode(e)
 		}
 	}
 	{
-		if s.Username.Set {
-			e.FieldStart("username")
-			s.Username.Encode(e)
+		if s.Verify.Set {
+			e.FieldStart("verify")
+			s.Verify.Encode(e)
+		}
+	}
+	{
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfDataDumpUsersItem = [7]string{
-	0: "created_at",
-	1: "enabled",
-	2: "hash",
-	3: "id",
-	4: "modified_at",
-	5: "role",
-	6: "username",
+var jsonFieldsNameOfBootImage = [13]string{
+	0:  "cmdline",
+	1:  "id",
+	2:  "initrd",
+	3:  "initrd_sha256",
+	4:  "kernel",
+	5:  "kernel_sha256",
+	6:  "liveimg",
+	7:  "liveimg_sha256",
+	8:  "name",
+	9:  "provision_templates",
+	10: "uid",
+	11: "verify",
+	12: "version",
 }
 
-// Decode decodes DataDumpUsersItem from json.
-func (s *DataDumpUsersItem) Decode(d *jx.Decoder) error {
+// Decode decodes BootImage from json.
+func (s *BootImage) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DataDumpUsersItem to nil")
+		return errors.New("invalid: unable to decode BootImage to nil")
 	}
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "created_at":
+		case "cmdline":
 			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				s.Cmdline.Reset()
+				if err := s.Cmdline.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"cmdline\"")
 			}
-		case "enabled":
+		case "id":
 			if err := func() error {
-				s.Enabled.Reset()
-				if err := s.Enabled.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"enabled\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "hash":
+		case "initrd":
 			if err := func() error {
-				s.Hash.Reset()
-				if err := s.Hash.Decode(d); err != nil {
+				s.Initrd = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Initrd = append(s.Initrd, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"hash\"")
+				return errors.Wrap(err, "decode field \"initrd\"")
 			}
-		case "id":
+		case "initrd_sha256":
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				s.InitrdSHA256 = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.InitrdSHA256 = append(s.InitrdSHA256, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"initrd_sha256\"")
 			}
-		case "modified_at":
+		case "kernel":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.ModifiedAt.Reset()
-				if err := s.ModifiedAt.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := d.Str()
+				s.Kernel = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"modified_at\"")
+				return errors.Wrap(err, "decode field \"kernel\"")
 			}
-		case "role":
+		case "kernel_sha256":
 			if err := func() error {
-				s.Role.Reset()
-				if err := s.Role.Decode(d); err != nil {
+				s.KernelSHA256.Reset()
+				if err := s.KernelSHA256.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"role\"")
+				return errors.Wrap(err, "decode field \"kernel_sha256\"")
 			}
-		case "username":
+		case "liveimg":
 			if err := func() error {
-				s.Username.Reset()
-				if err := s.Username.Decode(d); err != nil {
+				s.Liveimg.Reset()
+				if err := s.Liveimg.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
+				return errors.Wrap(err, "decode field \"liveimg\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode DataDumpUsersItem")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *DataDumpUsersItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DataDumpUsersItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *Event) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *Event) encodeFields(e *jx.Encoder) {
-	{
-		if s.JobMessages != nil {
-			e.FieldStart("JobMessages")
-			e.ArrStart()
-			for _, elem := range s.JobMessages {
-				elem.Encode(e)
+		case "liveimg_sha256":
+			if err := func() error {
+				s.LiveimgSHA256.Reset()
+				if err := s.LiveimgSHA256.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"liveimg_sha256\"")
 			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.Message.Set {
-			e.FieldStart("Message")
-			s.Message.Encode(e)
-		}
-	}
-	{
-		if s.Severity.Set {
-			e.FieldStart("Severity")
-			s.Severity.Encode(e)
-		}
-	}
-	{
-		if s.Time.Set {
-			e.FieldStart("Time")
-			s.Time.Encode(e, json.EncodeDateTime)
-		}
-	}
-	{
-		if s.User.Set {
-			e.FieldStart("User")
-			s.User.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfEvent = [5]string{
-	0: "JobMessages",
-	1: "Message",
-	2: "Severity",
-	3: "Time",
-	4: "User",
-}
-
-// Decode decodes Event from json.
-func (s *Event) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode Event to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "JobMessages":
+		case "name":
+			requiredBitSet[1] |= 1 << 0
 			if err := func() error {
-				s.JobMessages = make([]EventJobMessagesItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem EventJobMessagesItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.JobMessages = append(s.JobMessages, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"JobMessages\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "Message":
+		case "provision_templates":
 			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
+				s.ProvisionTemplates.Reset()
+				if err := s.ProvisionTemplates.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Message\"")
+				return errors.Wrap(err, "decode field \"provision_templates\"")
 			}
-		case "Severity":
+		case "uid":
 			if err := func() error {
-				s.Severity.Reset()
-				if err := s.Severity.Decode(d); err != nil {
+				s.UID.Reset()
+				if err := s.UID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Severity\"")
+				return errors.Wrap(err, "decode field \"uid\"")
 			}
-		case "Time":
+		case "verify":
 			if err := func() error {
-				s.Time.Reset()
-				if err := s.Time.Decode(d, json.DecodeDateTime); err != nil {
+				s.Verify.Reset()
+				if err := s.Verify.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Time\"")
+				return errors.Wrap(err, "decode field \"verify\"")
 			}
-		case "User":
+		case "version":
 			if err := func() error {
-				s.User.Reset()
-				if err := s.User.Decode(d); err != nil {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"User\"")
+				return errors.Wrap(err, "decode field \"version\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Event")
+		return errors.Wrap(err, "decode BootImage")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00010000,
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfBootImage) {
+					name = jsonFieldsNameOfBootImage[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Event) MarshalJSON() ([]byte, error) {
+func (s *BootImage) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Event) UnmarshalJSON(data []byte) error {
+func (s *BootImage) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *EventJobMessagesItem) Encode(e *jx.Encoder) {
+func (s *BootImageAddRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *EventJobMessagesItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Data.Set {
-			e.FieldStart("data")
-			s.Data.Encode(e)
-		}
-	}
-	{
-		if s.Host.Set {
-			e.FieldStart("host")
-			s.Host.Encode(e)
-		}
-	}
-	{
-		if s.Msg.Set {
-			e.FieldStart("msg")
-			s.Msg.Encode(e)
-		}
-	}
-	{
-		if s.RedfishError.Set {
-			e.FieldStart("redfish_error")
-			s.RedfishError.Encode(e)
-		}
-	}
+func (s *BootImageAddRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.Status.Set {
-			e.FieldStart("status")
-			s.Status.Encode(e)
+		if s.BootImages != nil {
+			e.FieldStart("boot_images")
+			e.ArrStart()
+			for _, elem := range s.BootImages {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfEventJobMessagesItem = [5]string{
-	0: "data",
-	1: "host",
-	2: "msg",
-	3: "redfish_error",
-	4: "status",
+var jsonFieldsNameOfBootImageAddRequest = [1]string{
+	0: "boot_images",
 }
 
-// Decode decodes EventJobMessagesItem from json.
-func (s *EventJobMessagesItem) Decode(d *jx.Decoder) error {
+// Decode decodes BootImageAddRequest from json.
+func (s *BootImageAddRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EventJobMessagesItem to nil")
+		return errors.New("invalid: unable to decode BootImageAddRequest to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "data":
-			if err := func() error {
-				s.Data.Reset()
-				if err := s.Data.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
-			}
-		case "host":
-			if err := func() error {
-				s.Host.Reset()
-				if err := s.Host.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"host\"")
-			}
-		case "msg":
-			if err := func() error {
-				s.Msg.Reset()
-				if err := s.Msg.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"msg\"")
-			}
-		case "redfish_error":
-			if err := func() error {
-				s.RedfishError.Reset()
-				if err := s.RedfishError.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"redfish_error\"")
-			}
-		case "status":
+		case "boot_images":
 			if err := func() error {
-				s.Status.Reset()
-				if err := s.Status.Decode(d); err != nil {
+				s.BootImages = make([]NilBootImageAddRequestBootImagesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilBootImageAddRequestBootImagesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.BootImages = append(s.BootImages, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
+				return errors.Wrap(err, "decode field \"boot_images\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EventJobMessagesItem")
+		return errors.Wrap(err, "decode BootImageAddRequest")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EventJobMessagesItem) MarshalJSON() ([]byte, error) {
+func (s *BootImageAddRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventJobMessagesItem) UnmarshalJSON(data []byte) error {
+func (s *BootImageAddRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *EventJobMessagesItemRedfishError) Encode(e *jx.Encoder) {
+func (s *BootImageAddRequestBootImagesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *EventJobMessagesItemRedfishError) encodeFields(e *jx.Encoder) {
+func (s *BootImageAddRequestBootImagesItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.Code.Set {
-			e.FieldStart("code")
-			s.Code.Encode(e)
+		if s.Cmdline.Set {
+			e.FieldStart("cmdline")
+			s.Cmdline.Encode(e)
 		}
 	}
 	{
-		if s.Error.Set {
-			e.FieldStart("error")
-			s.Error.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
-}
-
-var jsonFieldsNameOfEventJobMessagesItemRedfishError = [2]string{
-	0: "code",
-	1: "error",
-}
-
-// Decode decodes EventJobMessagesItemRedfishError from json.
-func (s *EventJobMessagesItemRedfishError) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode EventJobMessagesItemRedfishError to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "code":
-			if err := func() error {
-				s.Code.Reset()
-				if err := s.Code.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
-			}
-		case "error":
-			if err := func() error {
-				s.Error.Reset()
-				if err := s.Error.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+	{
+		if s.Initrd != nil {
+			e.FieldStart("initrd")
+			e.ArrStart()
+			for _, elem := range s.Initrd {
+				e.Str(elem)
 			}
-		default:
-			return d.Skip()
+			e.ArrEnd()
 		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode EventJobMessagesItemRedfishError")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *EventJobMessagesItemRedfishError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventJobMessagesItemRedfishError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *EventJobMessagesItemRedfishErrorError) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *EventJobMessagesItemRedfishErrorError) encodeFields(e *jx.Encoder) {
 	{
-		if s.MessageDotExtendedInfo != nil {
-			e.FieldStart("@Message.ExtendedInfo")
+		if s.InitrdSHA256 != nil {
+			e.FieldStart("initrd_sha256")
 			e.ArrStart()
-			for _, elem := range s.MessageDotExtendedInfo {
-				elem.Encode(e)
+			for _, elem := range s.InitrdSHA256 {
+				e.Str(elem)
 			}
 			e.ArrEnd()
 		}
 	}
 	{
-		if s.Code.Set {
-			e.FieldStart("code")
-			s.Code.Encode(e)
+		if s.Kernel.Set {
+			e.FieldStart("kernel")
+			s.Kernel.Encode(e)
 		}
 	}
 	{
-		if s.Message.Set {
-			e.FieldStart("message")
-			s.Message.Encode(e)
+		if s.KernelSHA256.Set {
+			e.FieldStart("kernel_sha256")
+			s.KernelSHA256.Encode(e)
+		}
+	}
+	{
+		if s.Liveimg.Set {
+			e.FieldStart("liveimg")
+			s.Liveimg.Encode(e)
+		}
+	}
+	{
+		if s.LiveimgSHA256.Set {
+			e.FieldStart("liveimg_sha256")
+			s.LiveimgSHA256.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ProvisionTemplates.Set {
+			e.FieldStart("provision_templates")
+			s.ProvisionTemplates.Encode(e)
+		}
+	}
+	{
+		if s.UID.Set {
+			e.FieldStart("uid")
+			s.UID.Encode(e)
+		}
+	}
+	{
+		if s.Verify.Set {
+			e.FieldStart("verify")
+			s.Verify.Encode(e)
+		}
+	}
+	{
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfEventJobMessagesItemRedfishErrorError = [3]string{
-	0: "@Message.ExtendedInfo",
-	1: "code",
-	2: "message",
+var jsonFieldsNameOfBootImageAddRequestBootImagesItem = [13]string{
+	0:  "cmdline",
+	1:  "id",
+	2:  "initrd",
+	3:  "initrd_sha256",
+	4:  "kernel",
+	5:  "kernel_sha256",
+	6:  "liveimg",
+	7:  "liveimg_sha256",
+	8:  "name",
+	9:  "provision_templates",
+	10: "uid",
+	11: "verify",
+	12: "version",
 }
 
-// Decode decodes EventJobMessagesItemRedfishErrorError from json.
-func (s *EventJobMessagesItemRedfishErrorError) Decode(d *jx.Decoder) error {
+// Decode decodes BootImageAddRequestBootImagesItem from json.
+func (s *BootImageAddRequestBootImagesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EventJobMessagesItemRedfishErrorError to nil")
+		return errors.New("invalid: unable to decode BootImageAddRequestBootImagesItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "@Message.ExtendedInfo":
+		case "cmdline":
 			if err := func() error {
-				s.MessageDotExtendedInfo = make([]EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem, 0)
+				s.Cmdline.Reset()
+				if err := s.Cmdline.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cmdline\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "initrd":
+			if err := func() error {
+				s.Initrd = make([]string, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem
-					if err := elem.Decode(d); err != nil {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
 						return err
 					}
-					s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
+					s.Initrd = append(s.Initrd, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@Message.ExtendedInfo\"")
+				return errors.Wrap(err, "decode field \"initrd\"")
 			}
-		case "code":
+		case "initrd_sha256":
 			if err := func() error {
-				s.Code.Reset()
-				if err := s.Code.Decode(d); err != nil {
+				s.InitrdSHA256 = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.InitrdSHA256 = append(s.InitrdSHA256, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"initrd_sha256\"")
 			}
-		case "message":
+		case "kernel":
 			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
+				s.Kernel.Reset()
+				if err := s.Kernel.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"kernel\"")
+			}
+		case "kernel_sha256":
+			if err := func() error {
+				s.KernelSHA256.Reset()
+				if err := s.KernelSHA256.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kernel_sha256\"")
+			}
+		case "liveimg":
+			if err := func() error {
+				s.Liveimg.Reset()
+				if err := s.Liveimg.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"liveimg\"")
+			}
+		case "liveimg_sha256":
+			if err := func() error {
+				s.LiveimgSHA256.Reset()
+				if err := s.LiveimgSHA256.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"liveimg_sha256\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "provision_templates":
+			if err := func() error {
+				s.ProvisionTemplates.Reset()
+				if err := s.ProvisionTemplates.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provision_templates\"")
+			}
+		case "uid":
+			if err := func() error {
+				s.UID.Reset()
+				if err := s.UID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uid\"")
+			}
+		case "verify":
+			if err := func() error {
+				s.Verify.Reset()
+				if err := s.Verify.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"verify\"")
+			}
+		case "version":
+			if err := func() error {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EventJobMessagesItemRedfishErrorError")
+		return errors.Wrap(err, "decode BootImageAddRequestBootImagesItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EventJobMessagesItemRedfishErrorError) MarshalJSON() ([]byte, error) {
+func (s *BootImageAddRequestBootImagesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventJobMessagesItemRedfishErrorError) UnmarshalJSON(data []byte) error {
+func (s *BootImageAddRequestBootImagesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) Encode(e *jx.Encoder) {
+func (s BootImageAddRequestBootImagesItemProvisionTemplates) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Message.Set {
-			e.FieldStart("Message")
-			s.Message.Encode(e)
-		}
-	}
-	{
-		if s.MessageArgsDotOdataDotCount.Set {
-			e.FieldStart("MessageArgs.@odata.count")
-			s.MessageArgsDotOdataDotCount.Encode(e)
-		}
-	}
-	{
-		if s.MessageId.Set {
-			e.FieldStart("MessageId")
-			s.MessageId.Encode(e)
-		}
-	}
-	{
-		if s.RelatedPropertiesDotOdataDotCount.Set {
-			e.FieldStart("RelatedProperties.@odata.count")
-			s.RelatedPropertiesDotOdataDotCount.Encode(e)
-		}
-	}
-	{
-		if s.Resolution.Set {
-			e.FieldStart("Resolution")
-			s.Resolution.Encode(e)
-		}
-	}
-	{
-		if s.Severity.Set {
-			e.FieldStart("Severity")
-			s.Severity.Encode(e)
-		}
-	}
-}
+// encodeFields implements json.Marshaler.
+func (s BootImageAddRequestBootImagesItemProvisionTemplates) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
 
-var jsonFieldsNameOfEventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem = [6]string{
-	0: "Message",
-	1: "MessageArgs.@odata.count",
-	2: "MessageId",
-	3: "RelatedProperties.@odata.count",
-	4: "Resolution",
-	5: "Severity",
+		elem.Encode(e)
+	}
 }
 
-// Decode decodes EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem from json.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) Decode(d *jx.Decoder) error {
+// Decode decodes BootImageAddRequestBootImagesItemProvisionTemplates from json.
+func (s *BootImageAddRequestBootImagesItemProvisionTemplates) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem to nil")
+		return errors.New("invalid: unable to decode BootImageAddRequestBootImagesItemProvisionTemplates to nil")
 	}
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "Message":
-			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Message\"")
-			}
-		case "MessageArgs.@odata.count":
-			if err := func() error {
-				s.MessageArgsDotOdataDotCount.Reset()
-				if err := s.MessageArgsDotOdataDotCount.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageArgs.@odata.count\"")
-			}
-		case "MessageId":
-			if err := func() error {
-				s.MessageId.Reset()
-				if err := s.MessageId.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageId\"")
-			}
-		case "RelatedProperties.@odata.count":
-			if err := func() error {
-				s.RelatedPropertiesDotOdataDotCount.Reset()
-				if err := s.RelatedPropertiesDotOdataDotCount.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RelatedProperties.@odata.count\"")
-			}
-		case "Resolution":
-			if err := func() error {
-				s.Resolution.Reset()
-				if err := s.Resolution.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Resolution\"")
-			}
-		case "Severity":
-			if err := func() error {
-				s.Severity.Reset()
-				if err := s.Severity.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Severity\"")
+		var elem NilString
+		if err := func() error {
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem")
+		return errors.Wrap(err, "decode BootImageAddRequestBootImagesItemProvisionTemplates")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) MarshalJSON() ([]byte, error) {
+func (s BootImageAddRequestBootImagesItemProvisionTemplates) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) UnmarshalJSON(data []byte) error {
+func (s *BootImageAddRequestBootImagesItemProvisionTemplates) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GenericResponse) Encode(e *jx.Encoder) {
+func (s *BootImageCurrentRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *GenericResponse) encodeFields(e *jx.Encoder) {
-	{
-		if s.Changed.Set {
-			e.FieldStart("changed")
-			s.Changed.Encode(e)
-		}
-	}
+func (s *BootImageCurrentRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.Detail.Set {
-			e.FieldStart("detail")
-			s.Detail.Encode(e)
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
 		}
 	}
 	{
-		if s.Title.Set {
-			e.FieldStart("title")
-			s.Title.Encode(e)
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfGenericResponse = [3]string{
-	0: "changed",
-	1: "detail",
-	2: "title",
+var jsonFieldsNameOfBootImageCurrentRequest = [2]string{
+	0: "name",
+	1: "version",
 }
 
-// Decode decodes GenericResponse from json.
-func (s *GenericResponse) Decode(d *jx.Decoder) error {
+// Decode decodes BootImageCurrentRequest from json.
+func (s *BootImageCurrentRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GenericResponse to nil")
+		return errors.New("invalid: unable to decode BootImageCurrentRequest to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "changed":
-			if err := func() error {
-				s.Changed.Reset()
-				if err := s.Changed.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"changed\"")
-			}
-		case "detail":
+		case "name":
 			if err := func() error {
-				s.Detail.Reset()
-				if err := s.Detail.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"detail\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "title":
+		case "version":
 			if err := func() error {
-				s.Title.Reset()
-				if err := s.Title.Decode(d); err != nil {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
+				return errors.Wrap(err, "decode field \"version\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode GenericResponse")
+		return errors.Wrap(err, "decode BootImageCurrentRequest")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GenericResponse) MarshalJSON() ([]byte, error) {
+func (s *BootImageCurrentRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GenericResponse) UnmarshalJSON(data []byte) error {
+func (s *BootImageCurrentRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GetRolesResponse) Encode(e *jx.Encoder) {
+func (s BootImageProvisionTemplates) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *GetRolesResponse) encodeFields(e *jx.Encoder) {
-	{
-		if s.Roles != nil {
-			e.FieldStart("roles")
-			e.ArrStart()
-			for _, elem := range s.Roles {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-}
+// encodeFields implements json.Marshaler.
+func (s BootImageProvisionTemplates) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
 
-var jsonFieldsNameOfGetRolesResponse = [1]string{
-	0: "roles",
+		elem.Encode(e)
+	}
 }
 
-// Decode decodes GetRolesResponse from json.
-func (s *GetRolesResponse) Decode(d *jx.Decoder) error {
+// Decode decodes BootImageProvisionTemplates from json.
+func (s *BootImageProvisionTemplates) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetRolesResponse to nil")
+		return errors.New("invalid: unable to decode BootImageProvisionTemplates to nil")
 	}
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "roles":
-			if err := func() error {
-				s.Roles = make([]GetRolesResponseRolesItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem GetRolesResponseRolesItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Roles = append(s.Roles, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"roles\"")
+		var elem NilString
+		if err := func() error {
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode GetRolesResponse")
+		return errors.Wrap(err, "decode BootImageProvisionTemplates")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetRolesResponse) MarshalJSON() ([]byte, error) {
+func (s BootImageProvisionTemplates) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetRolesResponse) UnmarshalJSON(data []byte) error {
+func (s *BootImageProvisionTemplates) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GetRolesResponseRolesItem) Encode(e *jx.Encoder) {
+func (s *BootImageVersion) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *GetRolesResponseRolesItem) encodeFields(e *jx.Encoder) {
+func (s *BootImageVersion) encodeFields(e *jx.Encoder) {
 	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
+		if s.Current.Set {
+			e.FieldStart("current")
+			s.Current.Encode(e)
 		}
 	}
 	{
-		if s.PermissionList != nil {
-			e.FieldStart("permission_list")
-			e.ArrStart()
-			for _, elem := range s.PermissionList {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Image.Set {
+			e.FieldStart("image")
+			s.Image.Encode(e)
 		}
 	}
 	{
-		if s.UnassignedPermissionList != nil {
-			e.FieldStart("unassigned_permission_list")
-			e.ArrStart()
-			for _, elem := range s.UnassignedPermissionList {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Time.Set {
+			e.FieldStart("time")
+			s.Time.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfGetRolesResponseRolesItem = [3]string{
-	0: "name",
-	1: "permission_list",
-	2: "unassigned_permission_list",
+var jsonFieldsNameOfBootImageVersion = [4]string{
+	0: "current",
+	1: "image",
+	2: "time",
+	3: "version",
 }
 
-// Decode decodes GetRolesResponseRolesItem from json.
-func (s *GetRolesResponseRolesItem) Decode(d *jx.Decoder) error {
+// Decode decodes BootImageVersion from json.
+func (s *BootImageVersion) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetRolesResponseRolesItem to nil")
+		return errors.New("invalid: unable to decode BootImageVersion to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "current":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Current.Reset()
+				if err := s.Current.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"current\"")
 			}
-		case "permission_list":
+		case "image":
 			if err := func() error {
-				s.PermissionList = make([]GetRolesResponseRolesItemPermissionListItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem GetRolesResponseRolesItemPermissionListItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.PermissionList = append(s.PermissionList, elem)
-					return nil
-				}); err != nil {
+				s.Image.Reset()
+				if err := s.Image.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"permission_list\"")
+				return errors.Wrap(err, "decode field \"image\"")
 			}
-		case "unassigned_permission_list":
+		case "time":
 			if err := func() error {
-				s.UnassignedPermissionList = make([]GetRolesResponseRolesItemUnassignedPermissionListItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem GetRolesResponseRolesItemUnassignedPermissionListItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.UnassignedPermissionList = append(s.UnassignedPermissionList, elem)
-					return nil
-				}); err != nil {
+				s.Time.Reset()
+				if err := s.Time.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"unassigned_permission_list\"")
+				return errors.Wrap(err, "decode field \"time\"")
+			}
+		case "version":
+			if err := func() error {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode GetRolesResponseRolesItem")
+		return errors.Wrap(err, "decode BootImageVersion")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetRolesResponseRolesItem) MarshalJSON() ([]byte, error) {
+func (s *BootImageVersion) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetRolesResponseRolesItem) UnmarshalJSON(data []byte) error {
+func (s *BootImageVersion) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GetRolesResponseRolesItemPermissionListItem) Encode(e *jx.Encoder) {
+func (s *BootImageVersionImage) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *GetRolesResponseRolesItemPermissionListItem) encodeFields(e *jx.Encoder) {
+func (s *BootImageVersionImage) encodeFields(e *jx.Encoder) {
 	{
-		if s.Method.Set {
-			e.FieldStart("method")
-			s.Method.Encode(e)
+		if s.Cmdline.Set {
+			e.FieldStart("cmdline")
+			s.Cmdline.Encode(e)
 		}
 	}
 	{
-		if s.Path.Set {
-			e.FieldStart("path")
-			s.Path.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Initrd != nil {
+			e.FieldStart("initrd")
+			e.ArrStart()
+			for _, elem := range s.Initrd {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.InitrdSHA256 != nil {
+			e.FieldStart("initrd_sha256")
+			e.ArrStart()
+			for _, elem := range s.InitrdSHA256 {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Kernel.Set {
+			e.FieldStart("kernel")
+			s.Kernel.Encode(e)
+		}
+	}
+	{
+		if s.KernelSHA256.Set {
+			e.FieldStart("kernel_sha256")
+			s.KernelSHA256.Encode(e)
+		}
+	}
+	{
+		if s.Liveimg.Set {
+			e.FieldStart("liveimg")
+			s.Liveimg.Encode(e)
+		}
+	}
+	{
+		if s.LiveimgSHA256.Set {
+			e.FieldStart("liveimg_sha256")
+			s.LiveimgSHA256.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.ProvisionTemplates.Set {
+			e.FieldStart("provision_templates")
+			s.ProvisionTemplates.Encode(e)
+		}
+	}
+	{
+		if s.UID.Set {
+			e.FieldStart("uid")
+			s.UID.Encode(e)
+		}
+	}
+	{
+		if s.Verify.Set {
+			e.FieldStart("verify")
+			s.Verify.Encode(e)
+		}
+	}
+	{
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfGetRolesResponseRolesItemPermissionListItem = [2]string{
-	0: "method",
-	1: "path",
+var jsonFieldsNameOfBootImageVersionImage = [13]string{
+	0:  "cmdline",
+	1:  "id",
+	2:  "initrd",
+	3:  "initrd_sha256",
+	4:  "kernel",
+	5:  "kernel_sha256",
+	6:  "liveimg",
+	7:  "liveimg_sha256",
+	8:  "name",
+	9:  "provision_templates",
+	10: "uid",
+	11: "verify",
+	12: "version",
 }
 
-// Decode decodes GetRolesResponseRolesItemPermissionListItem from json.
-func (s *GetRolesResponseRolesItemPermissionListItem) Decode(d *jx.Decoder) error {
+// Decode decodes BootImageVersionImage from json.
+func (s *BootImageVersionImage) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetRolesResponseRolesItemPermissionListItem to nil")
+		return errors.New("invalid: unable to decode BootImageVersionImage to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "method":
+		case "cmdline":
 			if err := func() error {
-				s.Method.Reset()
-				if err := s.Method.Decode(d); err != nil {
+				s.Cmdline.Reset()
+				if err := s.Cmdline.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"method\"")
+				return errors.Wrap(err, "decode field \"cmdline\"")
 			}
-		case "path":
+		case "id":
 			if err := func() error {
-				s.Path.Reset()
-				if err := s.Path.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"path\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode GetRolesResponseRolesItemPermissionListItem")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *GetRolesResponseRolesItemPermissionListItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetRolesResponseRolesItemPermissionListItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Method.Set {
-			e.FieldStart("method")
-			s.Method.Encode(e)
-		}
-	}
-	{
-		if s.Path.Set {
-			e.FieldStart("path")
-			s.Path.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfGetRolesResponseRolesItemUnassignedPermissionListItem = [2]string{
-	0: "method",
-	1: "path",
-}
-
-// Decode decodes GetRolesResponseRolesItemUnassignedPermissionListItem from json.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode GetRolesResponseRolesItemUnassignedPermissionListItem to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "method":
+		case "initrd":
 			if err := func() error {
-				s.Method.Reset()
-				if err := s.Method.Decode(d); err != nil {
+				s.Initrd = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Initrd = append(s.Initrd, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"method\"")
+				return errors.Wrap(err, "decode field \"initrd\"")
 			}
-		case "path":
+		case "initrd_sha256":
 			if err := func() error {
-				s.Path.Reset()
-				if err := s.Path.Decode(d); err != nil {
+				s.InitrdSHA256 = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.InitrdSHA256 = append(s.InitrdSHA256, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"path\"")
+				return errors.Wrap(err, "decode field \"initrd_sha256\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode GetRolesResponseRolesItemUnassignedPermissionListItem")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *HTTPError) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *HTTPError) encodeFields(e *jx.Encoder) {
-	{
-		if s.Detail.Set {
-			e.FieldStart("detail")
-			s.Detail.Encode(e)
-		}
-	}
-	{
-		if s.Errors.Set {
-			e.FieldStart("errors")
-			s.Errors.Encode(e)
-		}
-	}
-	{
-		if s.Instance.Set {
-			e.FieldStart("instance")
-			s.Instance.Encode(e)
-		}
-	}
-	{
-		if s.Status.Set {
-			e.FieldStart("status")
-			s.Status.Encode(e)
-		}
-	}
-	{
-		if s.Title.Set {
-			e.FieldStart("title")
-			s.Title.Encode(e)
-		}
-	}
-	{
-		if s.Type.Set {
-			e.FieldStart("type")
-			s.Type.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfHTTPError = [6]string{
-	0: "detail",
-	1: "errors",
-	2: "instance",
-	3: "status",
-	4: "title",
-	5: "type",
-}
-
-// Decode decodes HTTPError from json.
-func (s *HTTPError) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode HTTPError to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "detail":
+		case "kernel":
 			if err := func() error {
-				s.Detail.Reset()
-				if err := s.Detail.Decode(d); err != nil {
+				s.Kernel.Reset()
+				if err := s.Kernel.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"detail\"")
+				return errors.Wrap(err, "decode field \"kernel\"")
 			}
-		case "errors":
+		case "kernel_sha256":
 			if err := func() error {
-				s.Errors.Reset()
-				if err := s.Errors.Decode(d); err != nil {
+				s.KernelSHA256.Reset()
+				if err := s.KernelSHA256.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"errors\"")
+				return errors.Wrap(err, "decode field \"kernel_sha256\"")
 			}
-		case "instance":
+		case "liveimg":
 			if err := func() error {
-				s.Instance.Reset()
-				if err := s.Instance.Decode(d); err != nil {
+				s.Liveimg.Reset()
+				if err := s.Liveimg.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"instance\"")
+				return errors.Wrap(err, "decode field \"liveimg\"")
 			}
-		case "status":
+		case "liveimg_sha256":
 			if err := func() error {
-				s.Status.Reset()
-				if err := s.Status.Decode(d); err != nil {
+				s.LiveimgSHA256.Reset()
+				if err := s.LiveimgSHA256.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
+				return errors.Wrap(err, "decode field \"liveimg_sha256\"")
 			}
-		case "title":
+		case "name":
 			if err := func() error {
-				s.Title.Reset()
-				if err := s.Title.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "type":
+		case "provision_templates":
 			if err := func() error {
-				s.Type.Reset()
-				if err := s.Type.Decode(d); err != nil {
+				s.ProvisionTemplates.Reset()
+				if err := s.ProvisionTemplates.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
+				return errors.Wrap(err, "decode field \"provision_templates\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode HTTPError")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *HTTPError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HTTPError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *HTTPErrorErrorsItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *HTTPErrorErrorsItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.More.Set {
-			e.FieldStart("more")
-			s.More.Encode(e)
-		}
-	}
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
-		}
-	}
-	{
-		if s.Reason.Set {
-			e.FieldStart("reason")
-			s.Reason.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfHTTPErrorErrorsItem = [3]string{
-	0: "more",
-	1: "name",
-	2: "reason",
-}
-
-// Decode decodes HTTPErrorErrorsItem from json.
-func (s *HTTPErrorErrorsItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode HTTPErrorErrorsItem to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "more":
+		case "uid":
 			if err := func() error {
-				s.More.Reset()
-				if err := s.More.Decode(d); err != nil {
+				s.UID.Reset()
+				if err := s.UID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"more\"")
+				return errors.Wrap(err, "decode field \"uid\"")
 			}
-		case "name":
+		case "verify":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Verify.Reset()
+				if err := s.Verify.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"verify\"")
 			}
-		case "reason":
+		case "version":
 			if err := func() error {
-				s.Reason.Reset()
-				if err := s.Reason.Decode(d); err != nil {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"reason\"")
+				return errors.Wrap(err, "decode field \"version\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode HTTPErrorErrorsItem")
+		return errors.Wrap(err, "decode BootImageVersionImage")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HTTPErrorErrorsItem) MarshalJSON() ([]byte, error) {
+func (s *BootImageVersionImage) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HTTPErrorErrorsItem) UnmarshalJSON(data []byte) error {
+func (s *BootImageVersionImage) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s HTTPErrorErrorsItemMore) Encode(e *jx.Encoder) {
+func (s BootImageVersionImageProvisionTemplates) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields implements json.Marshaler.
-func (s HTTPErrorErrorsItemMore) encodeFields(e *jx.Encoder) {
+func (s BootImageVersionImageProvisionTemplates) encodeFields(e *jx.Encoder) {
 	for k, elem := range s {
 		e.FieldStart(k)
 
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
+		elem.Encode(e)
 	}
 }
 
-// Decode decodes HTTPErrorErrorsItemMore from json.
-func (s *HTTPErrorErrorsItemMore) Decode(d *jx.Decoder) error {
+// Decode decodes BootImageVersionImageProvisionTemplates from json.
+func (s *BootImageVersionImageProvisionTemplates) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HTTPErrorErrorsItemMore to nil")
+		return errors.New("invalid: unable to decode BootImageVersionImageProvisionTemplates to nil")
 	}
 	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
+		var elem NilString
 		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
+			if err := elem.Decode(d); err != nil {
 				return err
 			}
 			return nil
@@ -4226,271 +3884,154 @@ func (s *HTTPErrorErrorsItemMore) Decode(d *jx.Decoder) error {
 		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode HTTPErrorErrorsItemMore")
+		return errors.Wrap(err, "decode BootImageVersionImageProvisionTemplates")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s HTTPErrorErrorsItemMore) MarshalJSON() ([]byte, error) {
+func (s BootImageVersionImageProvisionTemplates) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HTTPErrorErrorsItemMore) UnmarshalJSON(data []byte) error {
+func (s *BootImageVersionImageProvisionTemplates) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Host) Encode(e *jx.Encoder) {
+func (s *CablingReport) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Host) encodeFields(e *jx.Encoder) {
+func (s *CablingReport) encodeFields(e *jx.Encoder) {
 	{
-		if s.Bonds != nil {
-			e.FieldStart("bonds")
+		if s.Failed != nil {
+			e.FieldStart("failed")
 			e.ArrStart()
-			for _, elem := range s.Bonds {
-				elem.Encode(e)
+			for _, elem := range s.Failed {
+				e.Str(elem)
 			}
 			e.ArrEnd()
 		}
 	}
 	{
-		if s.BootImage.Set {
-			e.FieldStart("boot_image")
-			s.BootImage.Encode(e)
-		}
-	}
-	{
-		if s.Firmware.Set {
-			e.FieldStart("firmware")
-			s.Firmware.Encode(e)
-		}
-	}
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.Interfaces != nil {
-			e.FieldStart("interfaces")
-			e.ArrStart()
-			for _, elem := range s.Interfaces {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
-		}
-	}
-	{
-		if s.Provision.Set {
-			e.FieldStart("provision")
-			s.Provision.Encode(e)
-		}
-	}
-	{
-		if s.Tags.Set {
-			e.FieldStart("tags")
-			s.Tags.Encode(e)
-		}
-	}
-	{
-		if s.UID.Set {
-			e.FieldStart("uid")
-			s.UID.Encode(e)
+		if s.Links != nil {
+			e.FieldStart("links")
+			e.ArrStart()
+			for _, elem := range s.Links {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfHost = [9]string{
-	0: "bonds",
-	1: "boot_image",
-	2: "firmware",
-	3: "id",
-	4: "interfaces",
-	5: "name",
-	6: "provision",
-	7: "tags",
-	8: "uid",
+var jsonFieldsNameOfCablingReport = [2]string{
+	0: "failed",
+	1: "links",
 }
 
-// Decode decodes Host from json.
-func (s *Host) Decode(d *jx.Decoder) error {
+// Decode decodes CablingReport from json.
+func (s *CablingReport) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Host to nil")
+		return errors.New("invalid: unable to decode CablingReport to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "bonds":
+		case "failed":
 			if err := func() error {
-				s.Bonds = make([]NilHostBondsItem, 0)
+				s.Failed = make([]string, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NilHostBondsItem
-					if err := elem.Decode(d); err != nil {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
 						return err
 					}
-					s.Bonds = append(s.Bonds, elem)
+					s.Failed = append(s.Failed, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bonds\"")
-			}
-		case "boot_image":
-			if err := func() error {
-				s.BootImage.Reset()
-				if err := s.BootImage.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"boot_image\"")
-			}
-		case "firmware":
-			if err := func() error {
-				s.Firmware.Reset()
-				if err := s.Firmware.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"firmware\"")
-			}
-		case "id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"failed\"")
 			}
-		case "interfaces":
+		case "links":
 			if err := func() error {
-				s.Interfaces = make([]NilHostInterfacesItem, 0)
+				s.Links = make([]NilCablingReportLinksItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NilHostInterfacesItem
+					var elem NilCablingReportLinksItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Interfaces = append(s.Interfaces, elem)
+					s.Links = append(s.Links, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"interfaces\"")
-			}
-		case "name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "provision":
-			if err := func() error {
-				s.Provision.Reset()
-				if err := s.Provision.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provision\"")
-			}
-		case "tags":
-			if err := func() error {
-				s.Tags.Reset()
-				if err := s.Tags.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tags\"")
-			}
-		case "uid":
-			if err := func() error {
-				s.UID.Reset()
-				if err := s.UID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uid\"")
+				return errors.Wrap(err, "decode field \"links\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Host")
+		return errors.Wrap(err, "decode CablingReport")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Host) MarshalJSON() ([]byte, error) {
+func (s *CablingReport) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Host) UnmarshalJSON(data []byte) error {
+func (s *CablingReport) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *HostBondsItem) Encode(e *jx.Encoder) {
+func (s *CablingReportLinksItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *HostBondsItem) encodeFields(e *jx.Encoder) {
+func (s *CablingReportLinksItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.Bmc.Set {
-			e.FieldStart("bmc")
-			s.Bmc.Encode(e)
+		if s.ActualPort.Set {
+			e.FieldStart("actual_port")
+			s.ActualPort.Encode(e)
 		}
 	}
 	{
-		if s.Fqdn.Set {
-			e.FieldStart("fqdn")
-			s.Fqdn.Encode(e)
+		if s.ActualSwitch.Set {
+			e.FieldStart("actual_switch")
+			s.ActualSwitch.Encode(e)
 		}
 	}
 	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
 		}
 	}
 	{
@@ -4499,12 +4040,6 @@ func (s *HostBondsItem) encodeFields(e *jx.Encoder) {
 			s.Ifname.Encode(e)
 		}
 	}
-	{
-		if s.IP.Set {
-			e.FieldStart("ip")
-			s.IP.Encode(e)
-		}
-	}
 	{
 		if s.MAC.Set {
 			e.FieldStart("mac")
@@ -4512,78 +4047,73 @@ func (s *HostBondsItem) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.Mtu.Set {
-			e.FieldStart("mtu")
-			s.Mtu.Encode(e)
+		if s.Port.Set {
+			e.FieldStart("port")
+			s.Port.Encode(e)
 		}
 	}
 	{
-		if s.Peers != nil {
-			e.FieldStart("peers")
-			e.ArrStart()
-			for _, elem := range s.Peers {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
 		}
 	}
 	{
-		if s.Vlan.Set {
-			e.FieldStart("vlan")
-			s.Vlan.Encode(e)
+		if s.Switch.Set {
+			e.FieldStart("switch")
+			s.Switch.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfHostBondsItem = [9]string{
-	0: "bmc",
-	1: "fqdn",
-	2: "id",
+var jsonFieldsNameOfCablingReportLinksItem = [8]string{
+	0: "actual_port",
+	1: "actual_switch",
+	2: "host",
 	3: "ifname",
-	4: "ip",
-	5: "mac",
-	6: "mtu",
-	7: "peers",
-	8: "vlan",
+	4: "mac",
+	5: "port",
+	6: "status",
+	7: "switch",
 }
 
-// Decode decodes HostBondsItem from json.
-func (s *HostBondsItem) Decode(d *jx.Decoder) error {
+// Decode decodes CablingReportLinksItem from json.
+func (s *CablingReportLinksItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HostBondsItem to nil")
+		return errors.New("invalid: unable to decode CablingReportLinksItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "bmc":
+		case "actual_port":
 			if err := func() error {
-				s.Bmc.Reset()
-				if err := s.Bmc.Decode(d); err != nil {
+				s.ActualPort.Reset()
+				if err := s.ActualPort.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bmc\"")
+				return errors.Wrap(err, "decode field \"actual_port\"")
 			}
-		case "fqdn":
+		case "actual_switch":
 			if err := func() error {
-				s.Fqdn.Reset()
-				if err := s.Fqdn.Decode(d); err != nil {
+				s.ActualSwitch.Reset()
+				if err := s.ActualSwitch.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fqdn\"")
+				return errors.Wrap(err, "decode field \"actual_switch\"")
 			}
-		case "id":
+		case "host":
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"host\"")
 			}
 		case "ifname":
 			if err := func() error {
@@ -4595,16 +4125,6 @@ func (s *HostBondsItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"ifname\"")
 			}
-		case "ip":
-			if err := func() error {
-				s.IP.Reset()
-				if err := s.IP.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ip\"")
-			}
 		case "mac":
 			if err := func() error {
 				s.MAC.Reset()
@@ -4615,88 +4135,79 @@ func (s *HostBondsItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"mac\"")
 			}
-		case "mtu":
+		case "port":
 			if err := func() error {
-				s.Mtu.Reset()
-				if err := s.Mtu.Decode(d); err != nil {
+				s.Port.Reset()
+				if err := s.Port.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mtu\"")
+				return errors.Wrap(err, "decode field \"port\"")
 			}
-		case "peers":
+		case "status":
 			if err := func() error {
-				s.Peers = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Peers = append(s.Peers, elem)
-					return nil
-				}); err != nil {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"peers\"")
+				return errors.Wrap(err, "decode field \"status\"")
 			}
-		case "vlan":
+		case "switch":
 			if err := func() error {
-				s.Vlan.Reset()
-				if err := s.Vlan.Decode(d); err != nil {
+				s.Switch.Reset()
+				if err := s.Switch.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vlan\"")
+				return errors.Wrap(err, "decode field \"switch\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode HostBondsItem")
+		return errors.Wrap(err, "decode CablingReportLinksItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HostBondsItem) MarshalJSON() ([]byte, error) {
+func (s *CablingReportLinksItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HostBondsItem) UnmarshalJSON(data []byte) error {
+func (s *CablingReportLinksItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *HostInterfacesItem) Encode(e *jx.Encoder) {
+func (s *DHCPEvent) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *HostInterfacesItem) encodeFields(e *jx.Encoder) {
+func (s *DHCPEvent) encodeFields(e *jx.Encoder) {
 	{
-		if s.Bmc.Set {
-			e.FieldStart("bmc")
-			s.Bmc.Encode(e)
+		if s.Firmware.Set {
+			e.FieldStart("firmware")
+			s.Firmware.Encode(e)
 		}
 	}
 	{
-		if s.Fqdn.Set {
-			e.FieldStart("fqdn")
-			s.Fqdn.Encode(e)
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
 		}
 	}
 	{
@@ -4705,12 +4216,6 @@ func (s *HostInterfacesItem) encodeFields(e *jx.Encoder) {
 			s.ID.Encode(e)
 		}
 	}
-	{
-		if s.Ifname.Set {
-			e.FieldStart("ifname")
-			s.Ifname.Encode(e)
-		}
-	}
 	{
 		if s.IP.Set {
 			e.FieldStart("ip")
@@ -4724,57 +4229,63 @@ func (s *HostInterfacesItem) encodeFields(e *jx.Encoder) {
 		}
 	}
 	{
-		if s.Mtu.Set {
-			e.FieldStart("mtu")
-			s.Mtu.Encode(e)
+		if s.MessageType.Set {
+			e.FieldStart("message_type")
+			s.MessageType.Encode(e)
 		}
 	}
 	{
-		if s.Vlan.Set {
-			e.FieldStart("vlan")
-			s.Vlan.Encode(e)
+		if s.RelayIP.Set {
+			e.FieldStart("relay_ip")
+			s.RelayIP.Encode(e)
+		}
+	}
+	{
+		if s.Time.Set {
+			e.FieldStart("time")
+			s.Time.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfHostInterfacesItem = [8]string{
-	0: "bmc",
-	1: "fqdn",
+var jsonFieldsNameOfDHCPEvent = [8]string{
+	0: "firmware",
+	1: "host",
 	2: "id",
-	3: "ifname",
-	4: "ip",
-	5: "mac",
-	6: "mtu",
-	7: "vlan",
+	3: "ip",
+	4: "mac",
+	5: "message_type",
+	6: "relay_ip",
+	7: "time",
 }
 
-// Decode decodes HostInterfacesItem from json.
-func (s *HostInterfacesItem) Decode(d *jx.Decoder) error {
+// Decode decodes DHCPEvent from json.
+func (s *DHCPEvent) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode HostInterfacesItem to nil")
+		return errors.New("invalid: unable to decode DHCPEvent to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "bmc":
+		case "firmware":
 			if err := func() error {
-				s.Bmc.Reset()
-				if err := s.Bmc.Decode(d); err != nil {
+				s.Firmware.Reset()
+				if err := s.Firmware.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bmc\"")
+				return errors.Wrap(err, "decode field \"firmware\"")
 			}
-		case "fqdn":
+		case "host":
 			if err := func() error {
-				s.Fqdn.Reset()
-				if err := s.Fqdn.Decode(d); err != nil {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fqdn\"")
+				return errors.Wrap(err, "decode field \"host\"")
 			}
 		case "id":
 			if err := func() error {
@@ -4786,16 +4297,6 @@ func (s *HostInterfacesItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "ifname":
-			if err := func() error {
-				s.Ifname.Reset()
-				if err := s.Ifname.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ifname\"")
-			}
 		case "ip":
 			if err := func() error {
 				s.IP.Reset()
@@ -4816,1530 +4317,1666 @@ func (s *HostInterfacesItem) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"mac\"")
 			}
-		case "mtu":
+		case "message_type":
 			if err := func() error {
-				s.Mtu.Reset()
-				if err := s.Mtu.Decode(d); err != nil {
+				s.MessageType.Reset()
+				if err := s.MessageType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mtu\"")
+				return errors.Wrap(err, "decode field \"message_type\"")
 			}
-		case "vlan":
+		case "relay_ip":
 			if err := func() error {
-				s.Vlan.Reset()
-				if err := s.Vlan.Decode(d); err != nil {
+				s.RelayIP.Reset()
+				if err := s.RelayIP.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vlan\"")
+				return errors.Wrap(err, "decode field \"relay_ip\"")
+			}
+		case "time":
+			if err := func() error {
+				s.Time.Reset()
+				if err := s.Time.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"time\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode HostInterfacesItem")
+		return errors.Wrap(err, "decode DHCPEvent")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *HostInterfacesItem) MarshalJSON() ([]byte, error) {
+func (s *DHCPEvent) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *HostInterfacesItem) UnmarshalJSON(data []byte) error {
+func (s *DHCPEvent) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobMessage) Encode(e *jx.Encoder) {
+func (s *DHCPLease) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobMessage) encodeFields(e *jx.Encoder) {
+func (s *DHCPLease) encodeFields(e *jx.Encoder) {
 	{
-		if s.Data.Set {
-			e.FieldStart("data")
-			s.Data.Encode(e)
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.Host.Set {
-			e.FieldStart("host")
-			s.Host.Encode(e)
+		if s.ExpiresAt.Set {
+			e.FieldStart("expires_at")
+			s.ExpiresAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.Msg.Set {
-			e.FieldStart("msg")
-			s.Msg.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
 	{
-		if s.RedfishError.Set {
-			e.FieldStart("redfish_error")
-			s.RedfishError.Encode(e)
+		if s.IP.Set {
+			e.FieldStart("ip")
+			s.IP.Encode(e)
 		}
 	}
 	{
-		if s.Status.Set {
-			e.FieldStart("status")
-			s.Status.Encode(e)
+		if s.MAC.Set {
+			e.FieldStart("mac")
+			s.MAC.Encode(e)
+		}
+	}
+	{
+		if s.UpdatedAt.Set {
+			e.FieldStart("updated_at")
+			s.UpdatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfJobMessage = [5]string{
-	0: "data",
-	1: "host",
-	2: "msg",
-	3: "redfish_error",
-	4: "status",
+var jsonFieldsNameOfDHCPLease = [6]string{
+	0: "created_at",
+	1: "expires_at",
+	2: "id",
+	3: "ip",
+	4: "mac",
+	5: "updated_at",
 }
 
-// Decode decodes JobMessage from json.
-func (s *JobMessage) Decode(d *jx.Decoder) error {
+// Decode decodes DHCPLease from json.
+func (s *DHCPLease) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobMessage to nil")
+		return errors.New("invalid: unable to decode DHCPLease to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "data":
+		case "created_at":
 			if err := func() error {
-				s.Data.Reset()
-				if err := s.Data.Decode(d); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"data\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
-		case "host":
+		case "expires_at":
 			if err := func() error {
-				s.Host.Reset()
-				if err := s.Host.Decode(d); err != nil {
+				s.ExpiresAt.Reset()
+				if err := s.ExpiresAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"host\"")
+				return errors.Wrap(err, "decode field \"expires_at\"")
 			}
-		case "msg":
+		case "id":
 			if err := func() error {
-				s.Msg.Reset()
-				if err := s.Msg.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"msg\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "redfish_error":
+		case "ip":
 			if err := func() error {
-				s.RedfishError.Reset()
-				if err := s.RedfishError.Decode(d); err != nil {
+				s.IP.Reset()
+				if err := s.IP.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"redfish_error\"")
+				return errors.Wrap(err, "decode field \"ip\"")
 			}
-		case "status":
+		case "mac":
 			if err := func() error {
-				s.Status.Reset()
-				if err := s.Status.Decode(d); err != nil {
+				s.MAC.Reset()
+				if err := s.MAC.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
+				return errors.Wrap(err, "decode field \"mac\"")
+			}
+		case "updated_at":
+			if err := func() error {
+				s.UpdatedAt.Reset()
+				if err := s.UpdatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"updated_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobMessage")
+		return errors.Wrap(err, "decode DHCPLease")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobMessage) MarshalJSON() ([]byte, error) {
+func (s *DHCPLease) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobMessage) UnmarshalJSON(data []byte) error {
+func (s *DHCPLease) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobMessageRedfishError) Encode(e *jx.Encoder) {
+func (s *DataDump) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobMessageRedfishError) encodeFields(e *jx.Encoder) {
+func (s *DataDump) encodeFields(e *jx.Encoder) {
 	{
-		if s.Code.Set {
-			e.FieldStart("code")
-			s.Code.Encode(e)
+		if s.Hosts != nil {
+			e.FieldStart("Hosts")
+			e.ArrStart()
+			for _, elem := range s.Hosts {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.Error.Set {
-			e.FieldStart("error")
-			s.Error.Encode(e)
+		if s.Images != nil {
+			e.FieldStart("Images")
+			e.ArrStart()
+			for _, elem := range s.Images {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Users != nil {
+			e.FieldStart("Users")
+			e.ArrStart()
+			for _, elem := range s.Users {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfJobMessageRedfishError = [2]string{
-	0: "code",
-	1: "error",
+var jsonFieldsNameOfDataDump = [3]string{
+	0: "Hosts",
+	1: "Images",
+	2: "Users",
 }
 
-// Decode decodes JobMessageRedfishError from json.
-func (s *JobMessageRedfishError) Decode(d *jx.Decoder) error {
+// Decode decodes DataDump from json.
+func (s *DataDump) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobMessageRedfishError to nil")
+		return errors.New("invalid: unable to decode DataDump to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "code":
+		case "Hosts":
 			if err := func() error {
-				s.Code.Reset()
-				if err := s.Code.Decode(d); err != nil {
+				s.Hosts = make([]NilDataDumpHostsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilDataDumpHostsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Hosts = append(s.Hosts, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
+				return errors.Wrap(err, "decode field \"Hosts\"")
 			}
-		case "error":
+		case "Images":
 			if err := func() error {
-				s.Error.Reset()
-				if err := s.Error.Decode(d); err != nil {
+				s.Images = make([]NilDataDumpImagesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilDataDumpImagesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Images = append(s.Images, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+				return errors.Wrap(err, "decode field \"Images\"")
+			}
+		case "Users":
+			if err := func() error {
+				s.Users = make([]DataDumpUsersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem DataDumpUsersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Users = append(s.Users, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Users\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobMessageRedfishError")
+		return errors.Wrap(err, "decode DataDump")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobMessageRedfishError) MarshalJSON() ([]byte, error) {
+func (s *DataDump) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobMessageRedfishError) UnmarshalJSON(data []byte) error {
+func (s *DataDump) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *JobMessageRedfishErrorError) Encode(e *jx.Encoder) {
+func (s *DataDumpHostsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *JobMessageRedfishErrorError) encodeFields(e *jx.Encoder) {
-	{
-		if s.MessageDotExtendedInfo.Set {
-			e.FieldStart("@Message.ExtendedInfo")
-			s.MessageDotExtendedInfo.Encode(e)
-		}
-	}
+func (s *DataDumpHostsItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.Code.Set {
-			e.FieldStart("code")
-			s.Code.Encode(e)
+		if s.Bonds != nil {
+			e.FieldStart("bonds")
+			e.ArrStart()
+			for _, elem := range s.Bonds {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.Message.Set {
-			e.FieldStart("message")
-			s.Message.Encode(e)
+		if s.BootImage.Set {
+			e.FieldStart("boot_image")
+			s.BootImage.Encode(e)
 		}
 	}
-}
-
-var jsonFieldsNameOfJobMessageRedfishErrorError = [3]string{
-	0: "@Message.ExtendedInfo",
-	1: "code",
-	2: "message",
-}
-
-// Decode decodes JobMessageRedfishErrorError from json.
-func (s *JobMessageRedfishErrorError) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode JobMessageRedfishErrorError to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "@Message.ExtendedInfo":
-			if err := func() error {
-				s.MessageDotExtendedInfo.Reset()
-				if err := s.MessageDotExtendedInfo.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@Message.ExtendedInfo\"")
-			}
-		case "code":
-			if err := func() error {
-				s.Code.Reset()
-				if err := s.Code.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"code\"")
-			}
-		case "message":
-			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
-			}
-		default:
-			return d.Skip()
+	{
+		if s.Firmware.Set {
+			e.FieldStart("firmware")
+			s.Firmware.Encode(e)
 		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode JobMessageRedfishErrorError")
 	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *JobMessageRedfishErrorError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobMessageRedfishErrorError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.Message.Set {
-			e.FieldStart("Message")
-			s.Message.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
 	{
-		if s.MessageArgsDotOdataDotCount.Set {
-			e.FieldStart("MessageArgs.@odata.count")
-			s.MessageArgsDotOdataDotCount.Encode(e)
+		if s.Interfaces != nil {
+			e.FieldStart("interfaces")
+			e.ArrStart()
+			for _, elem := range s.Interfaces {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.MessageId.Set {
-			e.FieldStart("MessageId")
-			s.MessageId.Encode(e)
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
 		}
 	}
 	{
-		if s.RelatedPropertiesDotOdataDotCount.Set {
-			e.FieldStart("RelatedProperties.@odata.count")
-			s.RelatedPropertiesDotOdataDotCount.Encode(e)
+		if s.Provision.Set {
+			e.FieldStart("provision")
+			s.Provision.Encode(e)
 		}
 	}
 	{
-		if s.Resolution.Set {
-			e.FieldStart("Resolution")
-			s.Resolution.Encode(e)
+		if s.Tags.Set {
+			e.FieldStart("tags")
+			s.Tags.Encode(e)
 		}
 	}
 	{
-		if s.Severity.Set {
-			e.FieldStart("Severity")
-			s.Severity.Encode(e)
+		if s.UID.Set {
+			e.FieldStart("uid")
+			s.UID.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfJobMessageRedfishErrorErrorMessageDotExtendedInfoItem = [6]string{
-	0: "Message",
-	1: "MessageArgs.@odata.count",
-	2: "MessageId",
-	3: "RelatedProperties.@odata.count",
-	4: "Resolution",
-	5: "Severity",
+var jsonFieldsNameOfDataDumpHostsItem = [9]string{
+	0: "bonds",
+	1: "boot_image",
+	2: "firmware",
+	3: "id",
+	4: "interfaces",
+	5: "name",
+	6: "provision",
+	7: "tags",
+	8: "uid",
 }
 
-// Decode decodes JobMessageRedfishErrorErrorMessageDotExtendedInfoItem from json.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) Decode(d *jx.Decoder) error {
+// Decode decodes DataDumpHostsItem from json.
+func (s *DataDumpHostsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode JobMessageRedfishErrorErrorMessageDotExtendedInfoItem to nil")
+		return errors.New("invalid: unable to decode DataDumpHostsItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Message":
+		case "bonds":
 			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
+				s.Bonds = make([]NilDataDumpHostsItemBondsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilDataDumpHostsItemBondsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Bonds = append(s.Bonds, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Message\"")
+				return errors.Wrap(err, "decode field \"bonds\"")
 			}
-		case "MessageArgs.@odata.count":
+		case "boot_image":
 			if err := func() error {
-				s.MessageArgsDotOdataDotCount.Reset()
-				if err := s.MessageArgsDotOdataDotCount.Decode(d); err != nil {
+				s.BootImage.Reset()
+				if err := s.BootImage.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageArgs.@odata.count\"")
+				return errors.Wrap(err, "decode field \"boot_image\"")
 			}
-		case "MessageId":
+		case "firmware":
 			if err := func() error {
-				s.MessageId.Reset()
-				if err := s.MessageId.Decode(d); err != nil {
+				s.Firmware.Reset()
+				if err := s.Firmware.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageId\"")
+				return errors.Wrap(err, "decode field \"firmware\"")
 			}
-		case "RelatedProperties.@odata.count":
+		case "id":
 			if err := func() error {
-				s.RelatedPropertiesDotOdataDotCount.Reset()
-				if err := s.RelatedPropertiesDotOdataDotCount.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RelatedProperties.@odata.count\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "Resolution":
+		case "interfaces":
 			if err := func() error {
-				s.Resolution.Reset()
-				if err := s.Resolution.Decode(d); err != nil {
+				s.Interfaces = make([]NilDataDumpHostsItemInterfacesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilDataDumpHostsItemInterfacesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Interfaces = append(s.Interfaces, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Resolution\"")
+				return errors.Wrap(err, "decode field \"interfaces\"")
 			}
-		case "Severity":
+		case "name":
 			if err := func() error {
-				s.Severity.Reset()
-				if err := s.Severity.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Severity\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "provision":
+			if err := func() error {
+				s.Provision.Reset()
+				if err := s.Provision.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provision\"")
+			}
+		case "tags":
+			if err := func() error {
+				s.Tags.Reset()
+				if err := s.Tags.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tags\"")
+			}
+		case "uid":
+			if err := func() error {
+				s.UID.Reset()
+				if err := s.UID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uid\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode JobMessageRedfishErrorErrorMessageDotExtendedInfoItem")
+		return errors.Wrap(err, "decode DataDumpHostsItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) MarshalJSON() ([]byte, error) {
+func (s *DataDumpHostsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) UnmarshalJSON(data []byte) error {
+func (s *DataDumpHostsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *LLDP) Encode(e *jx.Encoder) {
+func (s *DataDumpHostsItemBondsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *LLDP) encodeFields(e *jx.Encoder) {
+func (s *DataDumpHostsItemBondsItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.ChassisID.Set {
-			e.FieldStart("chassis_id")
-			s.ChassisID.Encode(e)
+		if s.Bmc.Set {
+			e.FieldStart("bmc")
+			s.Bmc.Encode(e)
 		}
 	}
 	{
-		if s.ChassisIDType.Set {
-			e.FieldStart("chassis_id_type")
-			s.ChassisIDType.Encode(e)
+		if s.Fqdn.Set {
+			e.FieldStart("fqdn")
+			s.Fqdn.Encode(e)
 		}
 	}
 	{
-		if s.ManagementAddress.Set {
-			e.FieldStart("management_address")
-			s.ManagementAddress.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
 	{
-		if s.PortDescription.Set {
-			e.FieldStart("port_description")
-			s.PortDescription.Encode(e)
+		if s.Ifname.Set {
+			e.FieldStart("ifname")
+			s.Ifname.Encode(e)
 		}
 	}
 	{
-		if s.PortID.Set {
-			e.FieldStart("port_id")
-			s.PortID.Encode(e)
+		if s.IP.Set {
+			e.FieldStart("ip")
+			s.IP.Encode(e)
 		}
 	}
 	{
-		if s.PortIDType.Set {
-			e.FieldStart("port_id_type")
-			s.PortIDType.Encode(e)
+		if s.Ip6.Set {
+			e.FieldStart("ip6")
+			s.Ip6.Encode(e)
 		}
 	}
 	{
-		if s.PortName.Set {
-			e.FieldStart("port_name")
-			s.PortName.Encode(e)
+		if s.MAC.Set {
+			e.FieldStart("mac")
+			s.MAC.Encode(e)
 		}
 	}
 	{
-		if s.SystemDescription.Set {
-			e.FieldStart("system_description")
-			s.SystemDescription.Encode(e)
+		if s.Mtu.Set {
+			e.FieldStart("mtu")
+			s.Mtu.Encode(e)
 		}
 	}
 	{
-		if s.SystemName.Set {
-			e.FieldStart("system_name")
-			s.SystemName.Encode(e)
+		if s.Peers != nil {
+			e.FieldStart("peers")
+			e.ArrStart()
+			for _, elem := range s.Peers {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Switch.Set {
+			e.FieldStart("switch")
+			s.Switch.Encode(e)
+		}
+	}
+	{
+		if s.SwitchPort.Set {
+			e.FieldStart("switch_port")
+			s.SwitchPort.Encode(e)
+		}
+	}
+	{
+		if s.Vlan.Set {
+			e.FieldStart("vlan")
+			s.Vlan.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfLLDP = [9]string{
-	0: "chassis_id",
-	1: "chassis_id_type",
-	2: "management_address",
-	3: "port_description",
-	4: "port_id",
-	5: "port_id_type",
-	6: "port_name",
-	7: "system_description",
-	8: "system_name",
+var jsonFieldsNameOfDataDumpHostsItemBondsItem = [12]string{
+	0:  "bmc",
+	1:  "fqdn",
+	2:  "id",
+	3:  "ifname",
+	4:  "ip",
+	5:  "ip6",
+	6:  "mac",
+	7:  "mtu",
+	8:  "peers",
+	9:  "switch",
+	10: "switch_port",
+	11: "vlan",
 }
 
-// Decode decodes LLDP from json.
-func (s *LLDP) Decode(d *jx.Decoder) error {
+// Decode decodes DataDumpHostsItemBondsItem from json.
+func (s *DataDumpHostsItemBondsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode LLDP to nil")
+		return errors.New("invalid: unable to decode DataDumpHostsItemBondsItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "chassis_id":
+		case "bmc":
 			if err := func() error {
-				s.ChassisID.Reset()
-				if err := s.ChassisID.Decode(d); err != nil {
+				s.Bmc.Reset()
+				if err := s.Bmc.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"chassis_id\"")
+				return errors.Wrap(err, "decode field \"bmc\"")
 			}
-		case "chassis_id_type":
+		case "fqdn":
 			if err := func() error {
-				s.ChassisIDType.Reset()
-				if err := s.ChassisIDType.Decode(d); err != nil {
+				s.Fqdn.Reset()
+				if err := s.Fqdn.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"chassis_id_type\"")
+				return errors.Wrap(err, "decode field \"fqdn\"")
 			}
-		case "management_address":
+		case "id":
 			if err := func() error {
-				s.ManagementAddress.Reset()
-				if err := s.ManagementAddress.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"management_address\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "port_description":
+		case "ifname":
 			if err := func() error {
-				s.PortDescription.Reset()
-				if err := s.PortDescription.Decode(d); err != nil {
+				s.Ifname.Reset()
+				if err := s.Ifname.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"port_description\"")
+				return errors.Wrap(err, "decode field \"ifname\"")
 			}
-		case "port_id":
+		case "ip":
 			if err := func() error {
-				s.PortID.Reset()
-				if err := s.PortID.Decode(d); err != nil {
+				s.IP.Reset()
+				if err := s.IP.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"port_id\"")
+				return errors.Wrap(err, "decode field \"ip\"")
 			}
-		case "port_id_type":
+		case "ip6":
 			if err := func() error {
-				s.PortIDType.Reset()
-				if err := s.PortIDType.Decode(d); err != nil {
+				s.Ip6.Reset()
+				if err := s.Ip6.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"port_id_type\"")
+				return errors.Wrap(err, "decode field \"ip6\"")
 			}
-		case "port_name":
+		case "mac":
 			if err := func() error {
-				s.PortName.Reset()
-				if err := s.PortName.Decode(d); err != nil {
+				s.MAC.Reset()
+				if err := s.MAC.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"port_name\"")
+				return errors.Wrap(err, "decode field \"mac\"")
 			}
-		case "system_description":
+		case "mtu":
 			if err := func() error {
-				s.SystemDescription.Reset()
-				if err := s.SystemDescription.Decode(d); err != nil {
+				s.Mtu.Reset()
+				if err := s.Mtu.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"system_description\"")
+				return errors.Wrap(err, "decode field \"mtu\"")
 			}
-		case "system_name":
+		case "peers":
 			if err := func() error {
-				s.SystemName.Reset()
-				if err := s.SystemName.Decode(d); err != nil {
+				s.Peers = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Peers = append(s.Peers, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"system_name\"")
+				return errors.Wrap(err, "decode field \"peers\"")
+			}
+		case "switch":
+			if err := func() error {
+				s.Switch.Reset()
+				if err := s.Switch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"switch\"")
+			}
+		case "switch_port":
+			if err := func() error {
+				s.SwitchPort.Reset()
+				if err := s.SwitchPort.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"switch_port\"")
+			}
+		case "vlan":
+			if err := func() error {
+				s.Vlan.Reset()
+				if err := s.Vlan.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vlan\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode LLDP")
+		return errors.Wrap(err, "decode DataDumpHostsItemBondsItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *LLDP) MarshalJSON() ([]byte, error) {
+func (s *DataDumpHostsItemBondsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LLDP) UnmarshalJSON(data []byte) error {
+func (s *DataDumpHostsItemBondsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes BootImageAddRequestBootImagesItem as json.
-func (o NilBootImageAddRequestBootImagesItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *DataDumpHostsItemInterfacesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes BootImageAddRequestBootImagesItem from json.
-func (o *NilBootImageAddRequestBootImagesItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilBootImageAddRequestBootImagesItem to nil")
+// encodeFields encodes fields.
+func (s *DataDumpHostsItemInterfacesItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Bmc.Set {
+			e.FieldStart("bmc")
+			s.Bmc.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.Fqdn.Set {
+			e.FieldStart("fqdn")
+			s.Fqdn.Encode(e)
 		}
-
-		var v BootImageAddRequestBootImagesItem
-		o.Value = v
-		o.Null = true
-		return nil
 	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilBootImageAddRequestBootImagesItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilBootImageAddRequestBootImagesItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes DataDumpHostsItem as json.
-func (o NilDataDumpHostsItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.Ifname.Set {
+			e.FieldStart("ifname")
+			s.Ifname.Encode(e)
+		}
 	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes DataDumpHostsItem from json.
-func (o *NilDataDumpHostsItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilDataDumpHostsItem to nil")
+	{
+		if s.IP.Set {
+			e.FieldStart("ip")
+			s.IP.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.Ip6.Set {
+			e.FieldStart("ip6")
+			s.Ip6.Encode(e)
 		}
-
-		var v DataDumpHostsItem
-		o.Value = v
-		o.Null = true
-		return nil
 	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.MAC.Set {
+			e.FieldStart("mac")
+			s.MAC.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilDataDumpHostsItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilDataDumpHostsItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes DataDumpHostsItemBondsItem as json.
-func (o NilDataDumpHostsItemBondsItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.Mtu.Set {
+			e.FieldStart("mtu")
+			s.Mtu.Encode(e)
+		}
 	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes DataDumpHostsItemBondsItem from json.
-func (o *NilDataDumpHostsItemBondsItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilDataDumpHostsItemBondsItem to nil")
+	{
+		if s.Switch.Set {
+			e.FieldStart("switch")
+			s.Switch.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.SwitchPort.Set {
+			e.FieldStart("switch_port")
+			s.SwitchPort.Encode(e)
 		}
-
-		var v DataDumpHostsItemBondsItem
-		o.Value = v
-		o.Null = true
-		return nil
 	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Vlan.Set {
+			e.FieldStart("vlan")
+			s.Vlan.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilDataDumpHostsItemBondsItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilDataDumpHostsItemBondsItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes DataDumpHostsItemInterfacesItem as json.
-func (o NilDataDumpHostsItemInterfacesItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
-	}
-	o.Value.Encode(e)
+var jsonFieldsNameOfDataDumpHostsItemInterfacesItem = [11]string{
+	0:  "bmc",
+	1:  "fqdn",
+	2:  "id",
+	3:  "ifname",
+	4:  "ip",
+	5:  "ip6",
+	6:  "mac",
+	7:  "mtu",
+	8:  "switch",
+	9:  "switch_port",
+	10: "vlan",
 }
 
 // Decode decodes DataDumpHostsItemInterfacesItem from json.
-func (o *NilDataDumpHostsItemInterfacesItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilDataDumpHostsItemInterfacesItem to nil")
+func (s *DataDumpHostsItemInterfacesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DataDumpHostsItemInterfacesItem to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
 
-		var v DataDumpHostsItemInterfacesItem
-		o.Value = v
-		o.Null = true
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "bmc":
+			if err := func() error {
+				s.Bmc.Reset()
+				if err := s.Bmc.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bmc\"")
+			}
+		case "fqdn":
+			if err := func() error {
+				s.Fqdn.Reset()
+				if err := s.Fqdn.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fqdn\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "ifname":
+			if err := func() error {
+				s.Ifname.Reset()
+				if err := s.Ifname.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ifname\"")
+			}
+		case "ip":
+			if err := func() error {
+				s.IP.Reset()
+				if err := s.IP.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ip\"")
+			}
+		case "ip6":
+			if err := func() error {
+				s.Ip6.Reset()
+				if err := s.Ip6.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ip6\"")
+			}
+		case "mac":
+			if err := func() error {
+				s.MAC.Reset()
+				if err := s.MAC.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mac\"")
+			}
+		case "mtu":
+			if err := func() error {
+				s.Mtu.Reset()
+				if err := s.Mtu.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mtu\"")
+			}
+		case "switch":
+			if err := func() error {
+				s.Switch.Reset()
+				if err := s.Switch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"switch\"")
+			}
+		case "switch_port":
+			if err := func() error {
+				s.SwitchPort.Reset()
+				if err := s.SwitchPort.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"switch_port\"")
+			}
+		case "vlan":
+			if err := func() error {
+				s.Vlan.Reset()
+				if err := s.Vlan.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vlan\"")
+			}
+		default:
+			return d.Skip()
+		}
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DataDumpHostsItemInterfacesItem")
 	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s NilDataDumpHostsItemInterfacesItem) MarshalJSON() ([]byte, error) {
+func (s *DataDumpHostsItemInterfacesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilDataDumpHostsItemInterfacesItem) UnmarshalJSON(data []byte) error {
+func (s *DataDumpHostsItemInterfacesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes DataDumpImagesItem as json.
-func (o NilDataDumpImagesItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *DataDumpImagesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes DataDumpImagesItem from json.
-func (o *NilDataDumpImagesItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilDataDumpImagesItem to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+// encodeFields encodes fields.
+func (s *DataDumpImagesItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Cmdline.Set {
+			e.FieldStart("cmdline")
+			s.Cmdline.Encode(e)
 		}
-
-		var v DataDumpImagesItem
-		o.Value = v
-		o.Null = true
-		return nil
-	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilDataDumpImagesItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilDataDumpImagesItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes HostBondsItem as json.
-func (o NilHostBondsItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
-	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes HostBondsItem from json.
-func (o *NilHostBondsItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilHostBondsItem to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
-
-		var v HostBondsItem
-		o.Value = v
-		o.Null = true
-		return nil
-	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilHostBondsItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilHostBondsItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes HostInterfacesItem as json.
-func (o NilHostInterfacesItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
 	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes HostInterfacesItem from json.
-func (o *NilHostInterfacesItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilHostInterfacesItem to nil")
+	{
+		if s.Initrd != nil {
+			e.FieldStart("initrd")
+			e.ArrStart()
+			for _, elem := range s.Initrd {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.InitrdSHA256 != nil {
+			e.FieldStart("initrd_sha256")
+			e.ArrStart()
+			for _, elem := range s.InitrdSHA256 {
+				e.Str(elem)
+			}
+			e.ArrEnd()
 		}
-
-		var v HostInterfacesItem
-		o.Value = v
-		o.Null = true
-		return nil
 	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Kernel.Set {
+			e.FieldStart("kernel")
+			s.Kernel.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilHostInterfacesItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilHostInterfacesItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes int as json.
-func (o NilInt) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.KernelSHA256.Set {
+			e.FieldStart("kernel_sha256")
+			s.KernelSHA256.Encode(e)
+		}
 	}
-	e.Int(int(o.Value))
-}
-
-// Decode decodes int from json.
-func (o *NilInt) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilInt to nil")
+	{
+		if s.Liveimg.Set {
+			e.FieldStart("liveimg")
+			s.Liveimg.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.LiveimgSHA256.Set {
+			e.FieldStart("liveimg_sha256")
+			s.LiveimgSHA256.Encode(e)
 		}
-
-		var v int
-		o.Value = v
-		o.Null = true
-		return nil
 	}
-	o.Null = false
-	v, err := d.Int()
-	if err != nil {
-		return err
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
 	}
-	o.Value = int(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilInt) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilInt) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes NodeAddRequestNodeListItem as json.
-func (o NilNodeAddRequestNodeListItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.ProvisionTemplates.Set {
+			e.FieldStart("provision_templates")
+			s.ProvisionTemplates.Encode(e)
+		}
 	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes NodeAddRequestNodeListItem from json.
-func (o *NilNodeAddRequestNodeListItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilNodeAddRequestNodeListItem to nil")
+	{
+		if s.UID.Set {
+			e.FieldStart("uid")
+			s.UID.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.Verify.Set {
+			e.FieldStart("verify")
+			s.Verify.Encode(e)
 		}
-
-		var v NodeAddRequestNodeListItem
-		o.Value = v
-		o.Null = true
-		return nil
 	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilNodeAddRequestNodeListItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilNodeAddRequestNodeListItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfDataDumpImagesItem = [13]string{
+	0:  "cmdline",
+	1:  "id",
+	2:  "initrd",
+	3:  "initrd_sha256",
+	4:  "kernel",
+	5:  "kernel_sha256",
+	6:  "liveimg",
+	7:  "liveimg_sha256",
+	8:  "name",
+	9:  "provision_templates",
+	10: "uid",
+	11: "verify",
+	12: "version",
 }
 
-// Encode encodes NodeAddRequestNodeListItemBondsItem as json.
-func (o NilNodeAddRequestNodeListItemBondsItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
+// Decode decodes DataDumpImagesItem from json.
+func (s *DataDumpImagesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DataDumpImagesItem to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes NodeAddRequestNodeListItemBondsItem from json.
-func (o *NilNodeAddRequestNodeListItemBondsItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilNodeAddRequestNodeListItemBondsItem to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "cmdline":
+			if err := func() error {
+				s.Cmdline.Reset()
+				if err := s.Cmdline.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"cmdline\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "initrd":
+			if err := func() error {
+				s.Initrd = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Initrd = append(s.Initrd, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"initrd\"")
+			}
+		case "initrd_sha256":
+			if err := func() error {
+				s.InitrdSHA256 = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.InitrdSHA256 = append(s.InitrdSHA256, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"initrd_sha256\"")
+			}
+		case "kernel":
+			if err := func() error {
+				s.Kernel.Reset()
+				if err := s.Kernel.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kernel\"")
+			}
+		case "kernel_sha256":
+			if err := func() error {
+				s.KernelSHA256.Reset()
+				if err := s.KernelSHA256.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kernel_sha256\"")
+			}
+		case "liveimg":
+			if err := func() error {
+				s.Liveimg.Reset()
+				if err := s.Liveimg.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"liveimg\"")
+			}
+		case "liveimg_sha256":
+			if err := func() error {
+				s.LiveimgSHA256.Reset()
+				if err := s.LiveimgSHA256.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"liveimg_sha256\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "provision_templates":
+			if err := func() error {
+				s.ProvisionTemplates.Reset()
+				if err := s.ProvisionTemplates.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provision_templates\"")
+			}
+		case "uid":
+			if err := func() error {
+				s.UID.Reset()
+				if err := s.UID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uid\"")
+			}
+		case "verify":
+			if err := func() error {
+				s.Verify.Reset()
+				if err := s.Verify.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"verify\"")
+			}
+		case "version":
+			if err := func() error {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
+			}
+		default:
+			return d.Skip()
 		}
-
-		var v NodeAddRequestNodeListItemBondsItem
-		o.Value = v
-		o.Null = true
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DataDumpImagesItem")
 	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s NilNodeAddRequestNodeListItemBondsItem) MarshalJSON() ([]byte, error) {
+func (s *DataDumpImagesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilNodeAddRequestNodeListItemBondsItem) UnmarshalJSON(data []byte) error {
+func (s *DataDumpImagesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes NodeAddRequestNodeListItemInterfacesItem as json.
-func (o NilNodeAddRequestNodeListItemInterfacesItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
+// Encode implements json.Marshaler.
+func (s DataDumpImagesItemProvisionTemplates) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s DataDumpImagesItemProvisionTemplates) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		elem.Encode(e)
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes NodeAddRequestNodeListItemInterfacesItem from json.
-func (o *NilNodeAddRequestNodeListItemInterfacesItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilNodeAddRequestNodeListItemInterfacesItem to nil")
+// Decode decodes DataDumpImagesItemProvisionTemplates from json.
+func (s *DataDumpImagesItemProvisionTemplates) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DataDumpImagesItemProvisionTemplates to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem NilString
+		if err := func() error {
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
-
-		var v NodeAddRequestNodeListItemInterfacesItem
-		o.Value = v
-		o.Null = true
+		m[string(k)] = elem
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DataDumpImagesItemProvisionTemplates")
 	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s NilNodeAddRequestNodeListItemInterfacesItem) MarshalJSON() ([]byte, error) {
+func (s DataDumpImagesItemProvisionTemplates) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilNodeAddRequestNodeListItemInterfacesItem) UnmarshalJSON(data []byte) error {
+func (s *DataDumpImagesItemProvisionTemplates) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes RedfishJobJobsItem as json.
-func (o NilRedfishJobJobsItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *DataDumpUsersItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes RedfishJobJobsItem from json.
-func (o *NilRedfishJobJobsItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilRedfishJobJobsItem to nil")
+// encodeFields encodes fields.
+func (s *DataDumpUsersItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.Enabled.Set {
+			e.FieldStart("enabled")
+			s.Enabled.Encode(e)
 		}
-
-		var v RedfishJobJobsItem
-		o.Value = v
-		o.Null = true
-		return nil
 	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Hash.Set {
+			e.FieldStart("hash")
+			s.Hash.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilRedfishJobJobsItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilRedfishJobJobsItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes RedfishMetricReportReportsItem as json.
-func (o NilRedfishMetricReportReportsItem) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
-	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes RedfishMetricReportReportsItem from json.
-func (o *NilRedfishMetricReportReportsItem) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilRedfishMetricReportReportsItem to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
-
-		var v RedfishMetricReportReportsItem
-		o.Value = v
-		o.Null = true
-		return nil
-	}
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilRedfishMetricReportReportsItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilRedfishMetricReportReportsItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes string as json.
-func (o NilString) Encode(e *jx.Encoder) {
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.Str(string(o.Value))
-}
-
-// Decode decodes string from json.
-func (o *NilString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode NilString to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.ModifiedAt.Set {
+			e.FieldStart("modified_at")
+			s.ModifiedAt.Encode(e, json.EncodeDateTime)
 		}
-
-		var v string
-		o.Value = v
-		o.Null = true
-		return nil
 	}
-	o.Null = false
-	v, err := d.Str()
-	if err != nil {
-		return err
+	{
+		if s.Role.Set {
+			e.FieldStart("role")
+			s.Role.Encode(e)
+		}
 	}
-	o.Value = string(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s NilString) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NilString) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *NodeAddRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *NodeAddRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.NodeList != nil {
-			e.FieldStart("node_list")
-			e.ArrStart()
-			for _, elem := range s.NodeList {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Username.Set {
+			e.FieldStart("username")
+			s.Username.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfNodeAddRequest = [1]string{
-	0: "node_list",
+var jsonFieldsNameOfDataDumpUsersItem = [7]string{
+	0: "created_at",
+	1: "enabled",
+	2: "hash",
+	3: "id",
+	4: "modified_at",
+	5: "role",
+	6: "username",
 }
 
-// Decode decodes NodeAddRequest from json.
-func (s *NodeAddRequest) Decode(d *jx.Decoder) error {
+// Decode decodes DataDumpUsersItem from json.
+func (s *DataDumpUsersItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NodeAddRequest to nil")
+		return errors.New("invalid: unable to decode DataDumpUsersItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "node_list":
+		case "created_at":
 			if err := func() error {
-				s.NodeList = make([]NilNodeAddRequestNodeListItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NilNodeAddRequestNodeListItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.NodeList = append(s.NodeList, elem)
-					return nil
-				}); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"node_list\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		case "enabled":
+			if err := func() error {
+				s.Enabled.Reset()
+				if err := s.Enabled.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"enabled\"")
+			}
+		case "hash":
+			if err := func() error {
+				s.Hash.Reset()
+				if err := s.Hash.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hash\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "modified_at":
+			if err := func() error {
+				s.ModifiedAt.Reset()
+				if err := s.ModifiedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"modified_at\"")
+			}
+		case "role":
+			if err := func() error {
+				s.Role.Reset()
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		case "username":
+			if err := func() error {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NodeAddRequest")
+		return errors.Wrap(err, "decode DataDumpUsersItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NodeAddRequest) MarshalJSON() ([]byte, error) {
+func (s *DataDumpUsersItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NodeAddRequest) UnmarshalJSON(data []byte) error {
+func (s *DataDumpUsersItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NodeAddRequestNodeListItem) Encode(e *jx.Encoder) {
+func (s *Event) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NodeAddRequestNodeListItem) encodeFields(e *jx.Encoder) {
+func (s *Event) encodeFields(e *jx.Encoder) {
 	{
-		if s.Bonds != nil {
-			e.FieldStart("bonds")
+		if s.Hosts != nil {
+			e.FieldStart("Hosts")
 			e.ArrStart()
-			for _, elem := range s.Bonds {
-				elem.Encode(e)
+			for _, elem := range s.Hosts {
+				e.Str(elem)
 			}
 			e.ArrEnd()
 		}
 	}
 	{
-		if s.BootImage.Set {
-			e.FieldStart("boot_image")
-			s.BootImage.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("ID")
+			s.ID.Encode(e)
 		}
 	}
 	{
-		if s.Firmware.Set {
-			e.FieldStart("firmware")
-			s.Firmware.Encode(e)
+		if s.JobMessages != nil {
+			e.FieldStart("JobMessages")
+			e.ArrStart()
+			for _, elem := range s.JobMessages {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.Interfaces != nil {
-			e.FieldStart("interfaces")
-			e.ArrStart()
-			for _, elem := range s.Interfaces {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
 		}
 	}
 	{
-		if s.Provision.Set {
-			e.FieldStart("provision")
-			s.Provision.Encode(e)
+		if s.Severity.Set {
+			e.FieldStart("Severity")
+			s.Severity.Encode(e)
 		}
 	}
 	{
-		if s.Tags.Set {
-			e.FieldStart("tags")
-			s.Tags.Encode(e)
+		if s.Time.Set {
+			e.FieldStart("Time")
+			s.Time.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.UID.Set {
-			e.FieldStart("uid")
-			s.UID.Encode(e)
+		if s.User.Set {
+			e.FieldStart("User")
+			s.User.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfNodeAddRequestNodeListItem = [9]string{
-	0: "bonds",
-	1: "boot_image",
-	2: "firmware",
-	3: "id",
-	4: "interfaces",
-	5: "name",
-	6: "provision",
-	7: "tags",
-	8: "uid",
+var jsonFieldsNameOfEvent = [7]string{
+	0: "Hosts",
+	1: "ID",
+	2: "JobMessages",
+	3: "Message",
+	4: "Severity",
+	5: "Time",
+	6: "User",
 }
 
-// Decode decodes NodeAddRequestNodeListItem from json.
-func (s *NodeAddRequestNodeListItem) Decode(d *jx.Decoder) error {
+// Decode decodes Event from json.
+func (s *Event) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NodeAddRequestNodeListItem to nil")
+		return errors.New("invalid: unable to decode Event to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "bonds":
+		case "Hosts":
 			if err := func() error {
-				s.Bonds = make([]NilNodeAddRequestNodeListItemBondsItem, 0)
+				s.Hosts = make([]string, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NilNodeAddRequestNodeListItemBondsItem
-					if err := elem.Decode(d); err != nil {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
 						return err
 					}
-					s.Bonds = append(s.Bonds, elem)
+					s.Hosts = append(s.Hosts, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bonds\"")
-			}
-		case "boot_image":
-			if err := func() error {
-				s.BootImage.Reset()
-				if err := s.BootImage.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"boot_image\"")
-			}
-		case "firmware":
-			if err := func() error {
-				s.Firmware.Reset()
-				if err := s.Firmware.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"firmware\"")
+				return errors.Wrap(err, "decode field \"Hosts\"")
 			}
-		case "id":
+		case "ID":
 			if err := func() error {
 				s.ID.Reset()
 				if err := s.ID.Decode(d); err != nil {
@@ -6347,4215 +5984,5073 @@ func (s *NodeAddRequestNodeListItem) Decode(d *jx.Decoder) error {
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"ID\"")
 			}
-		case "interfaces":
+		case "JobMessages":
 			if err := func() error {
-				s.Interfaces = make([]NilNodeAddRequestNodeListItemInterfacesItem, 0)
+				s.JobMessages = make([]EventJobMessagesItem, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NilNodeAddRequestNodeListItemInterfacesItem
+					var elem EventJobMessagesItem
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Interfaces = append(s.Interfaces, elem)
+					s.JobMessages = append(s.JobMessages, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"interfaces\"")
+				return errors.Wrap(err, "decode field \"JobMessages\"")
 			}
-		case "name":
+		case "Message":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"Message\"")
 			}
-		case "provision":
+		case "Severity":
 			if err := func() error {
-				s.Provision.Reset()
-				if err := s.Provision.Decode(d); err != nil {
+				s.Severity.Reset()
+				if err := s.Severity.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provision\"")
+				return errors.Wrap(err, "decode field \"Severity\"")
 			}
-		case "tags":
+		case "Time":
 			if err := func() error {
-				s.Tags.Reset()
-				if err := s.Tags.Decode(d); err != nil {
+				s.Time.Reset()
+				if err := s.Time.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tags\"")
+				return errors.Wrap(err, "decode field \"Time\"")
 			}
-		case "uid":
+		case "User":
 			if err := func() error {
-				s.UID.Reset()
-				if err := s.UID.Decode(d); err != nil {
+				s.User.Reset()
+				if err := s.User.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"uid\"")
+				return errors.Wrap(err, "decode field \"User\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NodeAddRequestNodeListItem")
+		return errors.Wrap(err, "decode Event")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NodeAddRequestNodeListItem) MarshalJSON() ([]byte, error) {
+func (s *Event) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NodeAddRequestNodeListItem) UnmarshalJSON(data []byte) error {
+func (s *Event) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NodeAddRequestNodeListItemBondsItem) Encode(e *jx.Encoder) {
+func (s *EventJobMessagesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NodeAddRequestNodeListItemBondsItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Bmc.Set {
-			e.FieldStart("bmc")
-			s.Bmc.Encode(e)
-		}
-	}
-	{
-		if s.Fqdn.Set {
-			e.FieldStart("fqdn")
-			s.Fqdn.Encode(e)
-		}
-	}
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.Ifname.Set {
-			e.FieldStart("ifname")
-			s.Ifname.Encode(e)
-		}
-	}
+func (s *EventJobMessagesItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.IP.Set {
-			e.FieldStart("ip")
-			s.IP.Encode(e)
+		if s.Data.Set {
+			e.FieldStart("data")
+			s.Data.Encode(e)
 		}
 	}
 	{
-		if s.MAC.Set {
-			e.FieldStart("mac")
-			s.MAC.Encode(e)
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
 		}
 	}
 	{
-		if s.Mtu.Set {
-			e.FieldStart("mtu")
-			s.Mtu.Encode(e)
+		if s.Msg.Set {
+			e.FieldStart("msg")
+			s.Msg.Encode(e)
 		}
 	}
 	{
-		if s.Peers != nil {
-			e.FieldStart("peers")
-			e.ArrStart()
-			for _, elem := range s.Peers {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.RedfishError.Set {
+			e.FieldStart("redfish_error")
+			s.RedfishError.Encode(e)
 		}
 	}
 	{
-		if s.Vlan.Set {
-			e.FieldStart("vlan")
-			s.Vlan.Encode(e)
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfNodeAddRequestNodeListItemBondsItem = [9]string{
-	0: "bmc",
-	1: "fqdn",
-	2: "id",
-	3: "ifname",
-	4: "ip",
-	5: "mac",
-	6: "mtu",
-	7: "peers",
-	8: "vlan",
+var jsonFieldsNameOfEventJobMessagesItem = [5]string{
+	0: "data",
+	1: "host",
+	2: "msg",
+	3: "redfish_error",
+	4: "status",
 }
 
-// Decode decodes NodeAddRequestNodeListItemBondsItem from json.
-func (s *NodeAddRequestNodeListItemBondsItem) Decode(d *jx.Decoder) error {
+// Decode decodes EventJobMessagesItem from json.
+func (s *EventJobMessagesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NodeAddRequestNodeListItemBondsItem to nil")
+		return errors.New("invalid: unable to decode EventJobMessagesItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "bmc":
+		case "data":
 			if err := func() error {
-				s.Bmc.Reset()
-				if err := s.Bmc.Decode(d); err != nil {
+				s.Data.Reset()
+				if err := s.Data.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bmc\"")
+				return errors.Wrap(err, "decode field \"data\"")
 			}
-		case "fqdn":
+		case "host":
 			if err := func() error {
-				s.Fqdn.Reset()
-				if err := s.Fqdn.Decode(d); err != nil {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fqdn\"")
+				return errors.Wrap(err, "decode field \"host\"")
 			}
-		case "id":
+		case "msg":
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				s.Msg.Reset()
+				if err := s.Msg.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"msg\"")
 			}
-		case "ifname":
+		case "redfish_error":
 			if err := func() error {
-				s.Ifname.Reset()
-				if err := s.Ifname.Decode(d); err != nil {
+				s.RedfishError.Reset()
+				if err := s.RedfishError.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ifname\"")
+				return errors.Wrap(err, "decode field \"redfish_error\"")
 			}
-		case "ip":
+		case "status":
 			if err := func() error {
-				s.IP.Reset()
-				if err := s.IP.Decode(d); err != nil {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ip\"")
-			}
-		case "mac":
-			if err := func() error {
-				s.MAC.Reset()
-				if err := s.MAC.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mac\"")
-			}
-		case "mtu":
-			if err := func() error {
-				s.Mtu.Reset()
-				if err := s.Mtu.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mtu\"")
-			}
-		case "peers":
-			if err := func() error {
-				s.Peers = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.Peers = append(s.Peers, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"peers\"")
-			}
-		case "vlan":
-			if err := func() error {
-				s.Vlan.Reset()
-				if err := s.Vlan.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vlan\"")
+				return errors.Wrap(err, "decode field \"status\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NodeAddRequestNodeListItemBondsItem")
+		return errors.Wrap(err, "decode EventJobMessagesItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NodeAddRequestNodeListItemBondsItem) MarshalJSON() ([]byte, error) {
+func (s *EventJobMessagesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NodeAddRequestNodeListItemBondsItem) UnmarshalJSON(data []byte) error {
+func (s *EventJobMessagesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NodeAddRequestNodeListItemInterfacesItem) Encode(e *jx.Encoder) {
+func (s *EventJobMessagesItemRedfishError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NodeAddRequestNodeListItemInterfacesItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Bmc.Set {
-			e.FieldStart("bmc")
-			s.Bmc.Encode(e)
-		}
-	}
-	{
-		if s.Fqdn.Set {
-			e.FieldStart("fqdn")
-			s.Fqdn.Encode(e)
-		}
-	}
-	{
-		if s.ID.Set {
-			e.FieldStart("id")
-			s.ID.Encode(e)
-		}
-	}
-	{
-		if s.Ifname.Set {
-			e.FieldStart("ifname")
-			s.Ifname.Encode(e)
-		}
-	}
-	{
-		if s.IP.Set {
-			e.FieldStart("ip")
-			s.IP.Encode(e)
-		}
-	}
-	{
-		if s.MAC.Set {
-			e.FieldStart("mac")
-			s.MAC.Encode(e)
-		}
-	}
+func (s *EventJobMessagesItemRedfishError) encodeFields(e *jx.Encoder) {
 	{
-		if s.Mtu.Set {
-			e.FieldStart("mtu")
-			s.Mtu.Encode(e)
+		if s.Code.Set {
+			e.FieldStart("code")
+			s.Code.Encode(e)
 		}
 	}
 	{
-		if s.Vlan.Set {
-			e.FieldStart("vlan")
-			s.Vlan.Encode(e)
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfNodeAddRequestNodeListItemInterfacesItem = [8]string{
-	0: "bmc",
-	1: "fqdn",
-	2: "id",
-	3: "ifname",
-	4: "ip",
-	5: "mac",
-	6: "mtu",
-	7: "vlan",
+var jsonFieldsNameOfEventJobMessagesItemRedfishError = [2]string{
+	0: "code",
+	1: "error",
 }
 
-// Decode decodes NodeAddRequestNodeListItemInterfacesItem from json.
-func (s *NodeAddRequestNodeListItemInterfacesItem) Decode(d *jx.Decoder) error {
+// Decode decodes EventJobMessagesItemRedfishError from json.
+func (s *EventJobMessagesItemRedfishError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NodeAddRequestNodeListItemInterfacesItem to nil")
+		return errors.New("invalid: unable to decode EventJobMessagesItemRedfishError to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "bmc":
-			if err := func() error {
-				s.Bmc.Reset()
-				if err := s.Bmc.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bmc\"")
-			}
-		case "fqdn":
-			if err := func() error {
-				s.Fqdn.Reset()
-				if err := s.Fqdn.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"fqdn\"")
-			}
-		case "id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "ifname":
-			if err := func() error {
-				s.Ifname.Reset()
-				if err := s.Ifname.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ifname\"")
-			}
-		case "ip":
-			if err := func() error {
-				s.IP.Reset()
-				if err := s.IP.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ip\"")
-			}
-		case "mac":
-			if err := func() error {
-				s.MAC.Reset()
-				if err := s.MAC.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mac\"")
-			}
-		case "mtu":
+		case "code":
 			if err := func() error {
-				s.Mtu.Reset()
-				if err := s.Mtu.Decode(d); err != nil {
+				s.Code.Reset()
+				if err := s.Code.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"mtu\"")
+				return errors.Wrap(err, "decode field \"code\"")
 			}
-		case "vlan":
+		case "error":
 			if err := func() error {
-				s.Vlan.Reset()
-				if err := s.Vlan.Decode(d); err != nil {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"vlan\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NodeAddRequestNodeListItemInterfacesItem")
+		return errors.Wrap(err, "decode EventJobMessagesItemRedfishError")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NodeAddRequestNodeListItemInterfacesItem) MarshalJSON() ([]byte, error) {
+func (s *EventJobMessagesItemRedfishError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NodeAddRequestNodeListItemInterfacesItem) UnmarshalJSON(data []byte) error {
+func (s *EventJobMessagesItemRedfishError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NodeBootImageRequest) Encode(e *jx.Encoder) {
+func (s *EventJobMessagesItemRedfishErrorError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NodeBootImageRequest) encodeFields(e *jx.Encoder) {
+func (s *EventJobMessagesItemRedfishErrorError) encodeFields(e *jx.Encoder) {
 	{
-		if s.Image.Set {
-			e.FieldStart("image")
-			s.Image.Encode(e)
+		if s.MessageDotExtendedInfo != nil {
+			e.FieldStart("@Message.ExtendedInfo")
+			e.ArrStart()
+			for _, elem := range s.MessageDotExtendedInfo {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Code.Set {
+			e.FieldStart("code")
+			s.Code.Encode(e)
+		}
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfNodeBootImageRequest = [1]string{
-	0: "image",
+var jsonFieldsNameOfEventJobMessagesItemRedfishErrorError = [3]string{
+	0: "@Message.ExtendedInfo",
+	1: "code",
+	2: "message",
 }
 
-// Decode decodes NodeBootImageRequest from json.
-func (s *NodeBootImageRequest) Decode(d *jx.Decoder) error {
+// Decode decodes EventJobMessagesItemRedfishErrorError from json.
+func (s *EventJobMessagesItemRedfishErrorError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NodeBootImageRequest to nil")
+		return errors.New("invalid: unable to decode EventJobMessagesItemRedfishErrorError to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "image":
+		case "@Message.ExtendedInfo":
 			if err := func() error {
-				s.Image.Reset()
-				if err := s.Image.Decode(d); err != nil {
+				s.MessageDotExtendedInfo = make([]EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"image\"")
+				return errors.Wrap(err, "decode field \"@Message.ExtendedInfo\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
+		case "code":
+			if err := func() error {
+				s.Code.Reset()
+				if err := s.Code.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NodeBootImageRequest")
+		return errors.Wrap(err, "decode EventJobMessagesItemRedfishErrorError")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NodeBootImageRequest) MarshalJSON() ([]byte, error) {
+func (s *EventJobMessagesItemRedfishErrorError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NodeBootImageRequest) UnmarshalJSON(data []byte) error {
+func (s *EventJobMessagesItemRedfishErrorError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NodeBootTokenResponse) Encode(e *jx.Encoder) {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NodeBootTokenResponse) encodeFields(e *jx.Encoder) {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.Nodes != nil {
-			e.FieldStart("nodes")
-			e.ArrStart()
-			for _, elem := range s.Nodes {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.MessageArgsDotOdataDotCount.Set {
+			e.FieldStart("MessageArgs.@odata.count")
+			s.MessageArgsDotOdataDotCount.Encode(e)
+		}
+	}
+	{
+		if s.MessageId.Set {
+			e.FieldStart("MessageId")
+			s.MessageId.Encode(e)
+		}
+	}
+	{
+		if s.RelatedPropertiesDotOdataDotCount.Set {
+			e.FieldStart("RelatedProperties.@odata.count")
+			s.RelatedPropertiesDotOdataDotCount.Encode(e)
+		}
+	}
+	{
+		if s.Resolution.Set {
+			e.FieldStart("Resolution")
+			s.Resolution.Encode(e)
+		}
+	}
+	{
+		if s.Severity.Set {
+			e.FieldStart("Severity")
+			s.Severity.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfNodeBootTokenResponse = [1]string{
-	0: "nodes",
+var jsonFieldsNameOfEventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem = [6]string{
+	0: "Message",
+	1: "MessageArgs.@odata.count",
+	2: "MessageId",
+	3: "RelatedProperties.@odata.count",
+	4: "Resolution",
+	5: "Severity",
 }
 
-// Decode decodes NodeBootTokenResponse from json.
-func (s *NodeBootTokenResponse) Decode(d *jx.Decoder) error {
+// Decode decodes EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem from json.
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NodeBootTokenResponse to nil")
+		return errors.New("invalid: unable to decode EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "nodes":
+		case "Message":
 			if err := func() error {
-				s.Nodes = make([]NodeBootTokenResponseNodesItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NodeBootTokenResponseNodesItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Nodes = append(s.Nodes, elem)
-					return nil
-				}); err != nil {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"nodes\"")
+				return errors.Wrap(err, "decode field \"Message\"")
+			}
+		case "MessageArgs.@odata.count":
+			if err := func() error {
+				s.MessageArgsDotOdataDotCount.Reset()
+				if err := s.MessageArgsDotOdataDotCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MessageArgs.@odata.count\"")
+			}
+		case "MessageId":
+			if err := func() error {
+				s.MessageId.Reset()
+				if err := s.MessageId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MessageId\"")
+			}
+		case "RelatedProperties.@odata.count":
+			if err := func() error {
+				s.RelatedPropertiesDotOdataDotCount.Reset()
+				if err := s.RelatedPropertiesDotOdataDotCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RelatedProperties.@odata.count\"")
+			}
+		case "Resolution":
+			if err := func() error {
+				s.Resolution.Reset()
+				if err := s.Resolution.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Resolution\"")
+			}
+		case "Severity":
+			if err := func() error {
+				s.Severity.Reset()
+				if err := s.Severity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Severity\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NodeBootTokenResponse")
+		return errors.Wrap(err, "decode EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NodeBootTokenResponse) MarshalJSON() ([]byte, error) {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NodeBootTokenResponse) UnmarshalJSON(data []byte) error {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NodeBootTokenResponseNodesItem) Encode(e *jx.Encoder) {
+func (s *GenericResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NodeBootTokenResponseNodesItem) encodeFields(e *jx.Encoder) {
+func (s *GenericResponse) encodeFields(e *jx.Encoder) {
 	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
+		if s.Changed.Set {
+			e.FieldStart("changed")
+			s.Changed.Encode(e)
 		}
 	}
 	{
-		if s.Token.Set {
-			e.FieldStart("token")
-			s.Token.Encode(e)
+		if s.Detail.Set {
+			e.FieldStart("detail")
+			s.Detail.Encode(e)
+		}
+	}
+	{
+		if s.Title.Set {
+			e.FieldStart("title")
+			s.Title.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfNodeBootTokenResponseNodesItem = [2]string{
-	0: "name",
-	1: "token",
+var jsonFieldsNameOfGenericResponse = [3]string{
+	0: "changed",
+	1: "detail",
+	2: "title",
 }
 
-// Decode decodes NodeBootTokenResponseNodesItem from json.
-func (s *NodeBootTokenResponseNodesItem) Decode(d *jx.Decoder) error {
+// Decode decodes GenericResponse from json.
+func (s *GenericResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NodeBootTokenResponseNodesItem to nil")
+		return errors.New("invalid: unable to decode GenericResponse to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "changed":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Changed.Reset()
+				if err := s.Changed.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"changed\"")
 			}
-		case "token":
+		case "detail":
 			if err := func() error {
-				s.Token.Reset()
-				if err := s.Token.Decode(d); err != nil {
+				s.Detail.Reset()
+				if err := s.Detail.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"token\"")
+				return errors.Wrap(err, "decode field \"detail\"")
+			}
+		case "title":
+			if err := func() error {
+				s.Title.Reset()
+				if err := s.Title.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NodeBootTokenResponseNodesItem")
+		return errors.Wrap(err, "decode GenericResponse")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NodeBootTokenResponseNodesItem) MarshalJSON() ([]byte, error) {
+func (s *GenericResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NodeBootTokenResponseNodesItem) UnmarshalJSON(data []byte) error {
+func (s *GenericResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NodeProvisionRequest) Encode(e *jx.Encoder) {
+func (s *GetRolesResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NodeProvisionRequest) encodeFields(e *jx.Encoder) {
+func (s *GetRolesResponse) encodeFields(e *jx.Encoder) {
 	{
-		if s.Provision.Set {
-			e.FieldStart("provision")
-			s.Provision.Encode(e)
+		if s.Roles != nil {
+			e.FieldStart("roles")
+			e.ArrStart()
+			for _, elem := range s.Roles {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfNodeProvisionRequest = [1]string{
-	0: "provision",
+var jsonFieldsNameOfGetRolesResponse = [1]string{
+	0: "roles",
 }
 
-// Decode decodes NodeProvisionRequest from json.
-func (s *NodeProvisionRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetRolesResponse from json.
+func (s *GetRolesResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NodeProvisionRequest to nil")
+		return errors.New("invalid: unable to decode GetRolesResponse to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "provision":
+		case "roles":
 			if err := func() error {
-				s.Provision.Reset()
-				if err := s.Provision.Decode(d); err != nil {
-					return err
+				s.Roles = make([]GetRolesResponseRolesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem GetRolesResponseRolesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Roles = append(s.Roles, elem)
+					return nil
+				}); err != nil {
+					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"provision\"")
+				return errors.Wrap(err, "decode field \"roles\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NodeProvisionRequest")
+		return errors.Wrap(err, "decode GetRolesResponse")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NodeProvisionRequest) MarshalJSON() ([]byte, error) {
+func (s *GetRolesResponse) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NodeProvisionRequest) UnmarshalJSON(data []byte) error {
+func (s *GetRolesResponse) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *NodeTagsRequest) Encode(e *jx.Encoder) {
+func (s *GetRolesResponseRolesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *NodeTagsRequest) encodeFields(e *jx.Encoder) {
+func (s *GetRolesResponseRolesItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.Tags.Set {
-			e.FieldStart("tags")
-			s.Tags.Encode(e)
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.PermissionList != nil {
+			e.FieldStart("permission_list")
+			e.ArrStart()
+			for _, elem := range s.PermissionList {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.UnassignedPermissionList != nil {
+			e.FieldStart("unassigned_permission_list")
+			e.ArrStart()
+			for _, elem := range s.UnassignedPermissionList {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 }
 
-var jsonFieldsNameOfNodeTagsRequest = [1]string{
-	0: "tags",
+var jsonFieldsNameOfGetRolesResponseRolesItem = [3]string{
+	0: "name",
+	1: "permission_list",
+	2: "unassigned_permission_list",
 }
 
-// Decode decodes NodeTagsRequest from json.
-func (s *NodeTagsRequest) Decode(d *jx.Decoder) error {
+// Decode decodes GetRolesResponseRolesItem from json.
+func (s *GetRolesResponseRolesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode NodeTagsRequest to nil")
+		return errors.New("invalid: unable to decode GetRolesResponseRolesItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "tags":
+		case "name":
 			if err := func() error {
-				s.Tags.Reset()
-				if err := s.Tags.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tags\"")
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "permission_list":
+			if err := func() error {
+				s.PermissionList = make([]GetRolesResponseRolesItemPermissionListItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem GetRolesResponseRolesItemPermissionListItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.PermissionList = append(s.PermissionList, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"permission_list\"")
+			}
+		case "unassigned_permission_list":
+			if err := func() error {
+				s.UnassignedPermissionList = make([]GetRolesResponseRolesItemUnassignedPermissionListItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem GetRolesResponseRolesItemUnassignedPermissionListItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.UnassignedPermissionList = append(s.UnassignedPermissionList, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unassigned_permission_list\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode NodeTagsRequest")
+		return errors.Wrap(err, "decode GetRolesResponseRolesItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *NodeTagsRequest) MarshalJSON() ([]byte, error) {
+func (s *GetRolesResponseRolesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *NodeTagsRequest) UnmarshalJSON(data []byte) error {
+func (s *GetRolesResponseRolesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes BmcJobDeleteRequestNodeJobList as json.
-func (o OptBmcJobDeleteRequestNodeJobList) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *GetRolesResponseRolesItemPermissionListItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes BmcJobDeleteRequestNodeJobList from json.
-func (o *OptBmcJobDeleteRequestNodeJobList) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptBmcJobDeleteRequestNodeJobList to nil")
+// encodeFields encodes fields.
+func (s *GetRolesResponseRolesItemPermissionListItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Method.Set {
+			e.FieldStart("method")
+			s.Method.Encode(e)
+		}
 	}
-	o.Set = true
-	o.Value = make(BmcJobDeleteRequestNodeJobList)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Path.Set {
+			e.FieldStart("path")
+			s.Path.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptBmcJobDeleteRequestNodeJobList) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBmcJobDeleteRequestNodeJobList) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfGetRolesResponseRolesItemPermissionListItem = [2]string{
+	0: "method",
+	1: "path",
 }
 
-// Encode encodes bool as json.
-func (o OptBool) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes GetRolesResponseRolesItemPermissionListItem from json.
+func (s *GetRolesResponseRolesItemPermissionListItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetRolesResponseRolesItemPermissionListItem to nil")
 	}
-	e.Bool(bool(o.Value))
-}
 
-// Decode decodes bool from json.
-func (o *OptBool) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptBool to nil")
-	}
-	o.Set = true
-	v, err := d.Bool()
-	if err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "method":
+			if err := func() error {
+				s.Method.Reset()
+				if err := s.Method.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"method\"")
+			}
+		case "path":
+			if err := func() error {
+				s.Path.Reset()
+				if err := s.Path.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"path\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GetRolesResponseRolesItemPermissionListItem")
 	}
-	o.Value = bool(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptBool) MarshalJSON() ([]byte, error) {
+func (s *GetRolesResponseRolesItemPermissionListItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBool) UnmarshalJSON(data []byte) error {
+func (s *GetRolesResponseRolesItemPermissionListItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes time.Time as json.
-func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
-	}
-	format(e, o.Value)
+// Encode implements json.Marshaler.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes time.Time from json.
-func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptDateTime to nil")
+// encodeFields encodes fields.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Method.Set {
+			e.FieldStart("method")
+			s.Method.Encode(e)
+		}
 	}
-	o.Set = true
-	v, err := format(d)
-	if err != nil {
-		return err
+	{
+		if s.Path.Set {
+			e.FieldStart("path")
+			s.Path.Encode(e)
+		}
 	}
-	o.Value = v
-	return nil
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptDateTime) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDateTime)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDateTime) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDateTime)
+var jsonFieldsNameOfGetRolesResponseRolesItemUnassignedPermissionListItem = [2]string{
+	0: "method",
+	1: "path",
 }
 
-// Encode encodes EventJobMessagesItemRedfishError as json.
-func (o OptEventJobMessagesItemRedfishError) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes GetRolesResponseRolesItemUnassignedPermissionListItem from json.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetRolesResponseRolesItemUnassignedPermissionListItem to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes EventJobMessagesItemRedfishError from json.
-func (o *OptEventJobMessagesItemRedfishError) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptEventJobMessagesItemRedfishError to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "method":
+			if err := func() error {
+				s.Method.Reset()
+				if err := s.Method.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"method\"")
+			}
+		case "path":
+			if err := func() error {
+				s.Path.Reset()
+				if err := s.Path.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"path\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GetRolesResponseRolesItemUnassignedPermissionListItem")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptEventJobMessagesItemRedfishError) MarshalJSON() ([]byte, error) {
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptEventJobMessagesItemRedfishError) UnmarshalJSON(data []byte) error {
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes EventJobMessagesItemRedfishErrorError as json.
-func (o OptEventJobMessagesItemRedfishErrorError) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *GrendelStatus) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes EventJobMessagesItemRedfishErrorError from json.
-func (o *OptEventJobMessagesItemRedfishErrorError) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptEventJobMessagesItemRedfishErrorError to nil")
+// encodeFields encodes fields.
+func (s *GrendelStatus) encodeFields(e *jx.Encoder) {
+	{
+		if s.Active.Set {
+			e.FieldStart("active")
+			s.Active.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Ha.Set {
+			e.FieldStart("ha")
+			s.Ha.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptEventJobMessagesItemRedfishErrorError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptEventJobMessagesItemRedfishErrorError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes float32 as json.
-func (o OptFloat32) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		if s.Hostname.Set {
+			e.FieldStart("hostname")
+			s.Hostname.Encode(e)
+		}
 	}
-	e.Float32(float32(o.Value))
-}
-
-// Decode decodes float32 from json.
-func (o *OptFloat32) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptFloat32 to nil")
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
-	o.Set = true
-	v, err := d.Float32()
-	if err != nil {
-		return err
+	{
+		if s.Leader.Set {
+			e.FieldStart("leader")
+			s.Leader.Encode(e)
+		}
+	}
+	{
+		if s.LeaderSince.Set {
+			e.FieldStart("leader_since")
+			s.LeaderSince.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.LeaseExpiresAt.Set {
+			e.FieldStart("lease_expires_at")
+			s.LeaseExpiresAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Role.Set {
+			e.FieldStart("role")
+			s.Role.Encode(e)
+		}
+	}
+	{
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
+		}
 	}
-	o.Value = float32(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptFloat32) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptFloat32) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfGrendelStatus = [9]string{
+	0: "active",
+	1: "ha",
+	2: "hostname",
+	3: "id",
+	4: "leader",
+	5: "leader_since",
+	6: "lease_expires_at",
+	7: "role",
+	8: "version",
 }
 
-// Encode encodes HTTPErrorErrorsItemMore as json.
-func (o OptHTTPErrorErrorsItemMore) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes GrendelStatus from json.
+func (s *GrendelStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GrendelStatus to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes HTTPErrorErrorsItemMore from json.
-func (o *OptHTTPErrorErrorsItemMore) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptHTTPErrorErrorsItemMore to nil")
-	}
-	o.Set = true
-	o.Value = make(HTTPErrorErrorsItemMore)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "active":
+			if err := func() error {
+				s.Active.Reset()
+				if err := s.Active.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"active\"")
+			}
+		case "ha":
+			if err := func() error {
+				s.Ha.Reset()
+				if err := s.Ha.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ha\"")
+			}
+		case "hostname":
+			if err := func() error {
+				s.Hostname.Reset()
+				if err := s.Hostname.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hostname\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "leader":
+			if err := func() error {
+				s.Leader.Reset()
+				if err := s.Leader.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"leader\"")
+			}
+		case "leader_since":
+			if err := func() error {
+				s.LeaderSince.Reset()
+				if err := s.LeaderSince.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"leader_since\"")
+			}
+		case "lease_expires_at":
+			if err := func() error {
+				s.LeaseExpiresAt.Reset()
+				if err := s.LeaseExpiresAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"lease_expires_at\"")
+			}
+		case "role":
+			if err := func() error {
+				s.Role.Reset()
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		case "version":
+			if err := func() error {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GrendelStatus")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptHTTPErrorErrorsItemMore) MarshalJSON() ([]byte, error) {
+func (s *GrendelStatus) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptHTTPErrorErrorsItemMore) UnmarshalJSON(data []byte) error {
+func (s *GrendelStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes int as json.
-func (o OptInt) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Int(int(o.Value))
+// Encode implements json.Marshaler.
+func (s *HTTPError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes int from json.
-func (o *OptInt) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInt to nil")
-	}
-	o.Set = true
-	v, err := d.Int()
-	if err != nil {
-		return err
+// encodeFields encodes fields.
+func (s *HTTPError) encodeFields(e *jx.Encoder) {
+	{
+		if s.Detail.Set {
+			e.FieldStart("detail")
+			s.Detail.Encode(e)
+		}
+	}
+	{
+		if s.Errors.Set {
+			e.FieldStart("errors")
+			s.Errors.Encode(e)
+		}
+	}
+	{
+		if s.Instance.Set {
+			e.FieldStart("instance")
+			s.Instance.Encode(e)
+		}
+	}
+	{
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
+		}
+	}
+	{
+		if s.Title.Set {
+			e.FieldStart("title")
+			s.Title.Encode(e)
+		}
+	}
+	{
+		if s.Type.Set {
+			e.FieldStart("type")
+			s.Type.Encode(e)
+		}
 	}
-	o.Value = int(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptInt) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfHTTPError = [6]string{
+	0: "detail",
+	1: "errors",
+	2: "instance",
+	3: "status",
+	4: "title",
+	5: "type",
 }
 
-// Encode encodes int64 as json.
-func (o OptInt64) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes HTTPError from json.
+func (s *HTTPError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HTTPError to nil")
 	}
-	e.Int64(int64(o.Value))
-}
 
-// Decode decodes int64 from json.
-func (o *OptInt64) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInt64 to nil")
-	}
-	o.Set = true
-	v, err := d.Int64()
-	if err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "detail":
+			if err := func() error {
+				s.Detail.Reset()
+				if err := s.Detail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"detail\"")
+			}
+		case "errors":
+			if err := func() error {
+				s.Errors.Reset()
+				if err := s.Errors.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"errors\"")
+			}
+		case "instance":
+			if err := func() error {
+				s.Instance.Reset()
+				if err := s.Instance.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"instance\"")
+			}
+		case "status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "title":
+			if err := func() error {
+				s.Title.Reset()
+				if err := s.Title.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title\"")
+			}
+		case "type":
+			if err := func() error {
+				s.Type.Reset()
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode HTTPError")
 	}
-	o.Value = int64(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptInt64) MarshalJSON() ([]byte, error) {
+func (s *HTTPError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt64) UnmarshalJSON(data []byte) error {
+func (s *HTTPError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes JobMessageRedfishError as json.
-func (o OptJobMessageRedfishError) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *HTTPErrorErrorsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes JobMessageRedfishError from json.
-func (o *OptJobMessageRedfishError) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptJobMessageRedfishError to nil")
+// encodeFields encodes fields.
+func (s *HTTPErrorErrorsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.More.Set {
+			e.FieldStart("more")
+			s.More.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Reason.Set {
+			e.FieldStart("reason")
+			s.Reason.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptJobMessageRedfishError) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptJobMessageRedfishError) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfHTTPErrorErrorsItem = [3]string{
+	0: "more",
+	1: "name",
+	2: "reason",
 }
 
-// Encode encodes JobMessageRedfishErrorError as json.
-func (o OptJobMessageRedfishErrorError) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes HTTPErrorErrorsItem from json.
+func (s *HTTPErrorErrorsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HTTPErrorErrorsItem to nil")
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes JobMessageRedfishErrorError from json.
-func (o *OptJobMessageRedfishErrorError) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptJobMessageRedfishErrorError to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "more":
+			if err := func() error {
+				s.More.Reset()
+				if err := s.More.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"more\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "reason":
+			if err := func() error {
+				s.Reason.Reset()
+				if err := s.Reason.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reason\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode HTTPErrorErrorsItem")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptJobMessageRedfishErrorError) MarshalJSON() ([]byte, error) {
+func (s *HTTPErrorErrorsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptJobMessageRedfishErrorError) UnmarshalJSON(data []byte) error {
+func (s *HTTPErrorErrorsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes BootImageAddRequestBootImagesItemProvisionTemplates as json.
-func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
+// Encode implements json.Marshaler.
+func (s HTTPErrorErrorsItemMore) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s HTTPErrorErrorsItemMore) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
 	}
-	o.Value.Encode(e)
 }
 
-// Decode decodes BootImageAddRequestBootImagesItemProvisionTemplates from json.
-func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilBootImageAddRequestBootImagesItemProvisionTemplates to nil")
+// Decode decodes HTTPErrorErrorsItemMore from json.
+func (s *HTTPErrorErrorsItemMore) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HTTPErrorErrorsItemMore to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
-
-		var v BootImageAddRequestBootImagesItemProvisionTemplates
-		o.Value = v
-		o.Set = true
-		o.Null = true
+		m[string(k)] = elem
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode HTTPErrorErrorsItemMore")
 	}
-	o.Set = true
-	o.Null = false
-	o.Value = make(BootImageAddRequestBootImagesItemProvisionTemplates)
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilBootImageAddRequestBootImagesItemProvisionTemplates) MarshalJSON() ([]byte, error) {
+func (s HTTPErrorErrorsItemMore) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) UnmarshalJSON(data []byte) error {
+func (s *HTTPErrorErrorsItemMore) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes BootImageProvisionTemplates as json.
-func (o OptNilBootImageProvisionTemplates) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *Host) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes BootImageProvisionTemplates from json.
-func (o *OptNilBootImageProvisionTemplates) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilBootImageProvisionTemplates to nil")
+// encodeFields encodes fields.
+func (s *Host) encodeFields(e *jx.Encoder) {
+	{
+		if s.Bonds != nil {
+			e.FieldStart("bonds")
+			e.ArrStart()
+			for _, elem := range s.Bonds {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.BootImage.Set {
+			e.FieldStart("boot_image")
+			s.BootImage.Encode(e)
 		}
-
-		var v BootImageProvisionTemplates
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
 	}
-	o.Set = true
-	o.Null = false
-	o.Value = make(BootImageProvisionTemplates)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Firmware.Set {
+			e.FieldStart("firmware")
+			s.Firmware.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptNilBootImageProvisionTemplates) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilBootImageProvisionTemplates) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes DataDumpImagesItemProvisionTemplates as json.
-func (o OptNilDataDumpImagesItemProvisionTemplates) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.Interfaces != nil {
+			e.FieldStart("interfaces")
+			e.ArrStart()
+			for _, elem := range s.Interfaces {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes DataDumpImagesItemProvisionTemplates from json.
-func (o *OptNilDataDumpImagesItemProvisionTemplates) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilDataDumpImagesItemProvisionTemplates to nil")
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.Provision.Set {
+			e.FieldStart("provision")
+			s.Provision.Encode(e)
 		}
-
-		var v DataDumpImagesItemProvisionTemplates
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
 	}
-	o.Set = true
-	o.Null = false
-	o.Value = make(DataDumpImagesItemProvisionTemplates)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Tags.Set {
+			e.FieldStart("tags")
+			s.Tags.Encode(e)
+		}
+	}
+	{
+		if s.UID.Set {
+			e.FieldStart("uid")
+			s.UID.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptNilDataDumpImagesItemProvisionTemplates) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilDataDumpImagesItemProvisionTemplates) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes float64 as json.
-func (o OptNilFloat64) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.Float64(float64(o.Value))
+var jsonFieldsNameOfHost = [9]string{
+	0: "bonds",
+	1: "boot_image",
+	2: "firmware",
+	3: "id",
+	4: "interfaces",
+	5: "name",
+	6: "provision",
+	7: "tags",
+	8: "uid",
 }
 
-// Decode decodes float64 from json.
-func (o *OptNilFloat64) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilFloat64 to nil")
+// Decode decodes Host from json.
+func (s *Host) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Host to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
 
-		var v float64
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
-	}
-	o.Set = true
-	o.Null = false
-	v, err := d.Float64()
-	if err != nil {
-		return err
-	}
-	o.Value = float64(v)
-	return nil
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "bonds":
+			if err := func() error {
+				s.Bonds = make([]NilHostBondsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilHostBondsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Bonds = append(s.Bonds, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bonds\"")
+			}
+		case "boot_image":
+			if err := func() error {
+				s.BootImage.Reset()
+				if err := s.BootImage.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"boot_image\"")
+			}
+		case "firmware":
+			if err := func() error {
+				s.Firmware.Reset()
+				if err := s.Firmware.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"firmware\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "interfaces":
+			if err := func() error {
+				s.Interfaces = make([]NilHostInterfacesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilHostInterfacesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Interfaces = append(s.Interfaces, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"interfaces\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "provision":
+			if err := func() error {
+				s.Provision.Reset()
+				if err := s.Provision.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"provision\"")
+			}
+		case "tags":
+			if err := func() error {
+				s.Tags.Reset()
+				if err := s.Tags.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tags\"")
+			}
+		case "uid":
+			if err := func() error {
+				s.UID.Reset()
+				if err := s.UID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"uid\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Host")
+	}
+
+	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilFloat64) MarshalJSON() ([]byte, error) {
+func (s *Host) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilFloat64) UnmarshalJSON(data []byte) error {
+func (s *Host) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes []HTTPErrorErrorsItem as json.
-func (o OptNilHTTPErrorErrorsItemArray) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *HostBondsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *HostBondsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Bmc.Set {
+			e.FieldStart("bmc")
+			s.Bmc.Encode(e)
+		}
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.Fqdn.Set {
+			e.FieldStart("fqdn")
+			s.Fqdn.Encode(e)
+		}
 	}
-	e.ArrStart()
-	for _, elem := range o.Value {
-		elem.Encode(e)
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
-	e.ArrEnd()
-}
-
-// Decode decodes []HTTPErrorErrorsItem from json.
-func (o *OptNilHTTPErrorErrorsItemArray) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilHTTPErrorErrorsItemArray to nil")
+	{
+		if s.Ifname.Set {
+			e.FieldStart("ifname")
+			s.Ifname.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.IP.Set {
+			e.FieldStart("ip")
+			s.IP.Encode(e)
 		}
-
-		var v []HTTPErrorErrorsItem
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
 	}
-	o.Set = true
-	o.Null = false
-	o.Value = make([]HTTPErrorErrorsItem, 0)
-	if err := d.Arr(func(d *jx.Decoder) error {
-		var elem HTTPErrorErrorsItem
-		if err := elem.Decode(d); err != nil {
-			return err
+	{
+		if s.Ip6.Set {
+			e.FieldStart("ip6")
+			s.Ip6.Encode(e)
 		}
-		o.Value = append(o.Value, elem)
-		return nil
-	}); err != nil {
-		return err
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptNilHTTPErrorErrorsItemArray) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilHTTPErrorErrorsItemArray) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes int as json.
-func (o OptNilInt) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		if s.MAC.Set {
+			e.FieldStart("mac")
+			s.MAC.Encode(e)
+		}
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.Mtu.Set {
+			e.FieldStart("mtu")
+			s.Mtu.Encode(e)
+		}
 	}
-	e.Int(int(o.Value))
-}
-
-// Decode decodes int from json.
-func (o *OptNilInt) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilInt to nil")
+	{
+		if s.Peers != nil {
+			e.FieldStart("peers")
+			e.ArrStart()
+			for _, elem := range s.Peers {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.Switch.Set {
+			e.FieldStart("switch")
+			s.Switch.Encode(e)
 		}
-
-		var v int
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
 	}
-	o.Set = true
-	o.Null = false
-	v, err := d.Int()
-	if err != nil {
-		return err
+	{
+		if s.SwitchPort.Set {
+			e.FieldStart("switch_port")
+			s.SwitchPort.Encode(e)
+		}
+	}
+	{
+		if s.Vlan.Set {
+			e.FieldStart("vlan")
+			s.Vlan.Encode(e)
+		}
 	}
-	o.Value = int(v)
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptNilInt) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilInt) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfHostBondsItem = [12]string{
+	0:  "bmc",
+	1:  "fqdn",
+	2:  "id",
+	3:  "ifname",
+	4:  "ip",
+	5:  "ip6",
+	6:  "mac",
+	7:  "mtu",
+	8:  "peers",
+	9:  "switch",
+	10: "switch_port",
+	11: "vlan",
 }
 
-// Encode encodes int64 as json.
-func (o OptNilInt64) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
+// Decode decodes HostBondsItem from json.
+func (s *HostBondsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HostBondsItem to nil")
 	}
-	e.Int64(int64(o.Value))
-}
 
-// Decode decodes int64 from json.
-func (o *OptNilInt64) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilInt64 to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "bmc":
+			if err := func() error {
+				s.Bmc.Reset()
+				if err := s.Bmc.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bmc\"")
+			}
+		case "fqdn":
+			if err := func() error {
+				s.Fqdn.Reset()
+				if err := s.Fqdn.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fqdn\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "ifname":
+			if err := func() error {
+				s.Ifname.Reset()
+				if err := s.Ifname.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ifname\"")
+			}
+		case "ip":
+			if err := func() error {
+				s.IP.Reset()
+				if err := s.IP.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ip\"")
+			}
+		case "ip6":
+			if err := func() error {
+				s.Ip6.Reset()
+				if err := s.Ip6.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ip6\"")
+			}
+		case "mac":
+			if err := func() error {
+				s.MAC.Reset()
+				if err := s.MAC.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mac\"")
+			}
+		case "mtu":
+			if err := func() error {
+				s.Mtu.Reset()
+				if err := s.Mtu.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mtu\"")
+			}
+		case "peers":
+			if err := func() error {
+				s.Peers = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Peers = append(s.Peers, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"peers\"")
+			}
+		case "switch":
+			if err := func() error {
+				s.Switch.Reset()
+				if err := s.Switch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"switch\"")
+			}
+		case "switch_port":
+			if err := func() error {
+				s.SwitchPort.Reset()
+				if err := s.SwitchPort.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"switch_port\"")
+			}
+		case "vlan":
+			if err := func() error {
+				s.Vlan.Reset()
+				if err := s.Vlan.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vlan\"")
+			}
+		default:
+			return d.Skip()
 		}
-
-		var v int64
-		o.Value = v
-		o.Set = true
-		o.Null = true
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode HostBondsItem")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := d.Int64()
-	if err != nil {
-		return err
-	}
-	o.Value = int64(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilInt64) MarshalJSON() ([]byte, error) {
+func (s *HostBondsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilInt64) UnmarshalJSON(data []byte) error {
+func (s *HostBondsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem as json.
-func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *HostInterfacesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *HostInterfacesItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Bmc.Set {
+			e.FieldStart("bmc")
+			s.Bmc.Encode(e)
+		}
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.Fqdn.Set {
+			e.FieldStart("fqdn")
+			s.Fqdn.Encode(e)
+		}
 	}
-	e.ArrStart()
-	for _, elem := range o.Value {
-		elem.Encode(e)
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
-	e.ArrEnd()
-}
-
-// Decode decodes []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem from json.
-func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray to nil")
+	{
+		if s.Ifname.Set {
+			e.FieldStart("ifname")
+			s.Ifname.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.IP.Set {
+			e.FieldStart("ip")
+			s.IP.Encode(e)
 		}
-
-		var v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
 	}
-	o.Set = true
-	o.Null = false
-	o.Value = make([]JobMessageRedfishErrorErrorMessageDotExtendedInfoItem, 0)
-	if err := d.Arr(func(d *jx.Decoder) error {
-		var elem JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
-		if err := elem.Decode(d); err != nil {
-			return err
+	{
+		if s.Ip6.Set {
+			e.FieldStart("ip6")
+			s.Ip6.Encode(e)
 		}
-		o.Value = append(o.Value, elem)
-		return nil
-	}); err != nil {
-		return err
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes []NilInt as json.
-func (o OptNilNilIntArray) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		if s.MAC.Set {
+			e.FieldStart("mac")
+			s.MAC.Encode(e)
+		}
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.Mtu.Set {
+			e.FieldStart("mtu")
+			s.Mtu.Encode(e)
+		}
 	}
-	e.ArrStart()
-	for _, elem := range o.Value {
-		elem.Encode(e)
+	{
+		if s.Switch.Set {
+			e.FieldStart("switch")
+			s.Switch.Encode(e)
+		}
 	}
-	e.ArrEnd()
-}
-
-// Decode decodes []NilInt from json.
-func (o *OptNilNilIntArray) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilNilIntArray to nil")
+	{
+		if s.SwitchPort.Set {
+			e.FieldStart("switch_port")
+			s.SwitchPort.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.Vlan.Set {
+			e.FieldStart("vlan")
+			s.Vlan.Encode(e)
 		}
+	}
+}
 
-		var v []NilInt
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
+var jsonFieldsNameOfHostInterfacesItem = [11]string{
+	0:  "bmc",
+	1:  "fqdn",
+	2:  "id",
+	3:  "ifname",
+	4:  "ip",
+	5:  "ip6",
+	6:  "mac",
+	7:  "mtu",
+	8:  "switch",
+	9:  "switch_port",
+	10: "vlan",
+}
+
+// Decode decodes HostInterfacesItem from json.
+func (s *HostInterfacesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode HostInterfacesItem to nil")
 	}
-	o.Set = true
-	o.Null = false
-	o.Value = make([]NilInt, 0)
-	if err := d.Arr(func(d *jx.Decoder) error {
-		var elem NilInt
-		if err := elem.Decode(d); err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "bmc":
+			if err := func() error {
+				s.Bmc.Reset()
+				if err := s.Bmc.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bmc\"")
+			}
+		case "fqdn":
+			if err := func() error {
+				s.Fqdn.Reset()
+				if err := s.Fqdn.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"fqdn\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "ifname":
+			if err := func() error {
+				s.Ifname.Reset()
+				if err := s.Ifname.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ifname\"")
+			}
+		case "ip":
+			if err := func() error {
+				s.IP.Reset()
+				if err := s.IP.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ip\"")
+			}
+		case "ip6":
+			if err := func() error {
+				s.Ip6.Reset()
+				if err := s.Ip6.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ip6\"")
+			}
+		case "mac":
+			if err := func() error {
+				s.MAC.Reset()
+				if err := s.MAC.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mac\"")
+			}
+		case "mtu":
+			if err := func() error {
+				s.Mtu.Reset()
+				if err := s.Mtu.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mtu\"")
+			}
+		case "switch":
+			if err := func() error {
+				s.Switch.Reset()
+				if err := s.Switch.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"switch\"")
+			}
+		case "switch_port":
+			if err := func() error {
+				s.SwitchPort.Reset()
+				if err := s.SwitchPort.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"switch_port\"")
+			}
+		case "vlan":
+			if err := func() error {
+				s.Vlan.Reset()
+				if err := s.Vlan.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"vlan\"")
+			}
+		default:
+			return d.Skip()
 		}
-		o.Value = append(o.Value, elem)
 		return nil
 	}); err != nil {
-		return err
+		return errors.Wrap(err, "decode HostInterfacesItem")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilNilIntArray) MarshalJSON() ([]byte, error) {
+func (s *HostInterfacesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilNilIntArray) UnmarshalJSON(data []byte) error {
+func (s *HostInterfacesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes []NilRedfishJobJobsItem as json.
-func (o OptNilNilRedfishJobJobsItemArray) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *InventoryChange) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *InventoryChange) encodeFields(e *jx.Encoder) {
+	{
+		if s.Change.Set {
+			e.FieldStart("change")
+			s.Change.Encode(e)
+		}
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.DetectedAt.Set {
+			e.FieldStart("detected_at")
+			s.DetectedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
-	e.ArrStart()
-	for _, elem := range o.Value {
-		elem.Encode(e)
+	{
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
+		}
 	}
-	e.ArrEnd()
-}
-
-// Decode decodes []NilRedfishJobJobsItem from json.
-func (o *OptNilNilRedfishJobJobsItemArray) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilNilRedfishJobJobsItemArray to nil")
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.Kind.Set {
+			e.FieldStart("kind")
+			s.Kind.Encode(e)
 		}
-
-		var v []NilRedfishJobJobsItem
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
 	}
-	o.Set = true
-	o.Null = false
-	o.Value = make([]NilRedfishJobJobsItem, 0)
-	if err := d.Arr(func(d *jx.Decoder) error {
-		var elem NilRedfishJobJobsItem
-		if err := elem.Decode(d); err != nil {
-			return err
+	{
+		if s.New.Set {
+			e.FieldStart("new")
+			s.New.Encode(e)
+		}
+	}
+	{
+		if s.Old.Set {
+			e.FieldStart("old")
+			s.Old.Encode(e)
+		}
+	}
+	{
+		if s.Slot.Set {
+			e.FieldStart("slot")
+			s.Slot.Encode(e)
 		}
-		o.Value = append(o.Value, elem)
-		return nil
-	}); err != nil {
-		return err
 	}
-	return nil
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptNilNilRedfishJobJobsItemArray) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
+var jsonFieldsNameOfInventoryChange = [8]string{
+	0: "change",
+	1: "detected_at",
+	2: "host",
+	3: "id",
+	4: "kind",
+	5: "new",
+	6: "old",
+	7: "slot",
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilNilRedfishJobJobsItemArray) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes []NilString as json.
-func (o OptNilNilStringArray) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
-	}
-	e.ArrStart()
-	for _, elem := range o.Value {
-		elem.Encode(e)
-	}
-	e.ArrEnd()
-}
-
-// Decode decodes []NilString from json.
-func (o *OptNilNilStringArray) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilNilStringArray to nil")
+// Decode decodes InventoryChange from json.
+func (s *InventoryChange) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode InventoryChange to nil")
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
-		}
 
-		var v []NilString
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
-	}
-	o.Set = true
-	o.Null = false
-	o.Value = make([]NilString, 0)
-	if err := d.Arr(func(d *jx.Decoder) error {
-		var elem NilString
-		if err := elem.Decode(d); err != nil {
-			return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "change":
+			if err := func() error {
+				s.Change.Reset()
+				if err := s.Change.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"change\"")
+			}
+		case "detected_at":
+			if err := func() error {
+				s.DetectedAt.Reset()
+				if err := s.DetectedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"detected_at\"")
+			}
+		case "host":
+			if err := func() error {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"host\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "kind":
+			if err := func() error {
+				s.Kind.Reset()
+				if err := s.Kind.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kind\"")
+			}
+		case "new":
+			if err := func() error {
+				s.New.Reset()
+				if err := s.New.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"new\"")
+			}
+		case "old":
+			if err := func() error {
+				s.Old.Reset()
+				if err := s.Old.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"old\"")
+			}
+		case "slot":
+			if err := func() error {
+				s.Slot.Reset()
+				if err := s.Slot.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"slot\"")
+			}
+		default:
+			return d.Skip()
 		}
-		o.Value = append(o.Value, elem)
 		return nil
 	}); err != nil {
-		return err
+		return errors.Wrap(err, "decode InventoryChange")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilNilStringArray) MarshalJSON() ([]byte, error) {
+func (s *InventoryChange) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilNilStringArray) UnmarshalJSON(data []byte) error {
+func (s *InventoryChange) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes RedfishSystemOemDell as json.
-func (o OptNilRedfishSystemOemDell) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *InventoryComponent) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes RedfishSystemOemDell from json.
-func (o *OptNilRedfishSystemOemDell) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilRedfishSystemOemDell to nil")
+// encodeFields encodes fields.
+func (s *InventoryComponent) encodeFields(e *jx.Encoder) {
+	{
+		if s.Address.Set {
+			e.FieldStart("address")
+			s.Address.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.Capacity.Set {
+			e.FieldStart("capacity")
+			s.Capacity.Encode(e)
 		}
-
-		var v RedfishSystemOemDell
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
 	}
-	o.Set = true
-	o.Null = false
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.CollectedAt.Set {
+			e.FieldStart("collected_at")
+			s.CollectedAt.Encode(e, json.EncodeDateTime)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptNilRedfishSystemOemDell) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilRedfishSystemOemDell) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes []RedfishSystemOemDellMessageDotExtendedInfoItem as json.
-func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	{
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
+		}
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.Kind.Set {
+			e.FieldStart("kind")
+			s.Kind.Encode(e)
+		}
 	}
-	e.ArrStart()
-	for _, elem := range o.Value {
-		elem.Encode(e)
+	{
+		if s.Manufacturer.Set {
+			e.FieldStart("manufacturer")
+			s.Manufacturer.Encode(e)
+		}
 	}
-	e.ArrEnd()
-}
-
-// Decode decodes []RedfishSystemOemDellMessageDotExtendedInfoItem from json.
-func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray to nil")
+	{
+		if s.Model.Set {
+			e.FieldStart("model")
+			s.Model.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
 		}
-
-		var v []RedfishSystemOemDellMessageDotExtendedInfoItem
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
 	}
-	o.Set = true
-	o.Null = false
-	o.Value = make([]RedfishSystemOemDellMessageDotExtendedInfoItem, 0)
-	if err := d.Arr(func(d *jx.Decoder) error {
-		var elem RedfishSystemOemDellMessageDotExtendedInfoItem
-		if err := elem.Decode(d); err != nil {
-			return err
+	{
+		if s.PartNumber.Set {
+			e.FieldStart("part_number")
+			s.PartNumber.Encode(e)
+		}
+	}
+	{
+		if s.Serial.Set {
+			e.FieldStart("serial")
+			s.Serial.Encode(e)
+		}
+	}
+	{
+		if s.Slot.Set {
+			e.FieldStart("slot")
+			s.Slot.Encode(e)
+		}
+	}
+	{
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
 		}
-		o.Value = append(o.Value, elem)
-		return nil
-	}); err != nil {
-		return err
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfInventoryComponent = [12]string{
+	0:  "address",
+	1:  "capacity",
+	2:  "collected_at",
+	3:  "host",
+	4:  "kind",
+	5:  "manufacturer",
+	6:  "model",
+	7:  "name",
+	8:  "part_number",
+	9:  "serial",
+	10: "slot",
+	11: "version",
 }
 
-// Encode encodes string as json.
-func (o OptNilString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	if o.Null {
-		e.Null()
-		return
+// Decode decodes InventoryComponent from json.
+func (s *InventoryComponent) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode InventoryComponent to nil")
 	}
-	e.Str(string(o.Value))
-}
 
-// Decode decodes string from json.
-func (o *OptNilString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilString to nil")
-	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "address":
+			if err := func() error {
+				s.Address.Reset()
+				if err := s.Address.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"address\"")
+			}
+		case "capacity":
+			if err := func() error {
+				s.Capacity.Reset()
+				if err := s.Capacity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"capacity\"")
+			}
+		case "collected_at":
+			if err := func() error {
+				s.CollectedAt.Reset()
+				if err := s.CollectedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"collected_at\"")
+			}
+		case "host":
+			if err := func() error {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"host\"")
+			}
+		case "kind":
+			if err := func() error {
+				s.Kind.Reset()
+				if err := s.Kind.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kind\"")
+			}
+		case "manufacturer":
+			if err := func() error {
+				s.Manufacturer.Reset()
+				if err := s.Manufacturer.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"manufacturer\"")
+			}
+		case "model":
+			if err := func() error {
+				s.Model.Reset()
+				if err := s.Model.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"model\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "part_number":
+			if err := func() error {
+				s.PartNumber.Reset()
+				if err := s.PartNumber.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"part_number\"")
+			}
+		case "serial":
+			if err := func() error {
+				s.Serial.Reset()
+				if err := s.Serial.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"serial\"")
+			}
+		case "slot":
+			if err := func() error {
+				s.Slot.Reset()
+				if err := s.Slot.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"slot\"")
+			}
+		case "version":
+			if err := func() error {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"version\"")
+			}
+		default:
+			return d.Skip()
 		}
-
-		var v string
-		o.Value = v
-		o.Set = true
-		o.Null = true
 		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode InventoryComponent")
 	}
-	o.Set = true
-	o.Null = false
-	v, err := d.Str()
-	if err != nil {
-		return err
-	}
-	o.Value = string(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilString) MarshalJSON() ([]byte, error) {
+func (s *InventoryComponent) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilString) UnmarshalJSON(data []byte) error {
+func (s *InventoryComponent) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes []string as json.
-func (o OptNilStringArray) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Encode implements json.Marshaler.
+func (s *JobMessage) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *JobMessage) encodeFields(e *jx.Encoder) {
+	{
+		if s.Data.Set {
+			e.FieldStart("data")
+			s.Data.Encode(e)
+		}
 	}
-	if o.Null {
-		e.Null()
-		return
+	{
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
+		}
 	}
-	e.ArrStart()
-	for _, elem := range o.Value {
-		e.Str(elem)
+	{
+		if s.Msg.Set {
+			e.FieldStart("msg")
+			s.Msg.Encode(e)
+		}
 	}
-	e.ArrEnd()
-}
-
-// Decode decodes []string from json.
-func (o *OptNilStringArray) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptNilStringArray to nil")
+	{
+		if s.RedfishError.Set {
+			e.FieldStart("redfish_error")
+			s.RedfishError.Encode(e)
+		}
 	}
-	if d.Next() == jx.Null {
-		if err := d.Null(); err != nil {
-			return err
+	{
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
 		}
+	}
+}
 
-		var v []string
-		o.Value = v
-		o.Set = true
-		o.Null = true
-		return nil
+var jsonFieldsNameOfJobMessage = [5]string{
+	0: "data",
+	1: "host",
+	2: "msg",
+	3: "redfish_error",
+	4: "status",
+}
+
+// Decode decodes JobMessage from json.
+func (s *JobMessage) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobMessage to nil")
 	}
-	o.Set = true
-	o.Null = false
-	o.Value = make([]string, 0)
-	if err := d.Arr(func(d *jx.Decoder) error {
-		var elem string
-		v, err := d.Str()
-		elem = string(v)
-		if err != nil {
-			return err
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "data":
+			if err := func() error {
+				s.Data.Reset()
+				if err := s.Data.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"data\"")
+			}
+		case "host":
+			if err := func() error {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"host\"")
+			}
+		case "msg":
+			if err := func() error {
+				s.Msg.Reset()
+				if err := s.Msg.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"msg\"")
+			}
+		case "redfish_error":
+			if err := func() error {
+				s.RedfishError.Reset()
+				if err := s.RedfishError.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"redfish_error\"")
+			}
+		case "status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		default:
+			return d.Skip()
 		}
-		o.Value = append(o.Value, elem)
 		return nil
 	}); err != nil {
-		return err
+		return errors.Wrap(err, "decode JobMessage")
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptNilStringArray) MarshalJSON() ([]byte, error) {
+func (s *JobMessage) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptNilStringArray) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes RedfishJobJobsItemParameters as json.
-func (o OptRedfishJobJobsItemParameters) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
-}
-
-// Decode decodes RedfishJobJobsItemParameters from json.
-func (o *OptRedfishJobJobsItemParameters) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptRedfishJobJobsItemParameters to nil")
-	}
-	o.Set = true
-	o.Value = make(RedfishJobJobsItemParameters)
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptRedfishJobJobsItemParameters) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptRedfishJobJobsItemParameters) UnmarshalJSON(data []byte) error {
+func (s *JobMessage) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes RedfishJobJobsItemPayload as json.
-func (o OptRedfishJobJobsItemPayload) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *JobMessageRedfishError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes RedfishJobJobsItemPayload from json.
-func (o *OptRedfishJobJobsItemPayload) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptRedfishJobJobsItemPayload to nil")
+// encodeFields encodes fields.
+func (s *JobMessageRedfishError) encodeFields(e *jx.Encoder) {
+	{
+		if s.Code.Set {
+			e.FieldStart("code")
+			s.Code.Encode(e)
+		}
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptRedfishJobJobsItemPayload) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptRedfishJobJobsItemPayload) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes RedfishJobJobsItemSchedule as json.
-func (o OptRedfishJobJobsItemSchedule) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+var jsonFieldsNameOfJobMessageRedfishError = [2]string{
+	0: "code",
+	1: "error",
 }
 
-// Decode decodes RedfishJobJobsItemSchedule from json.
-func (o *OptRedfishJobJobsItemSchedule) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptRedfishJobJobsItemSchedule to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+// Decode decodes JobMessageRedfishError from json.
+func (s *JobMessageRedfishError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode JobMessageRedfishError to nil")
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptRedfishJobJobsItemSchedule) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptRedfishJobJobsItemSchedule) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
 
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "code":
+			if err := func() error {
+				s.Code.Reset()
+				if err := s.Code.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "error":
+			if err := func() error {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode JobMessageRedfishError")
 	}
-	e.Str(string(o.Value))
-}
 
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
-	}
-	o.Set = true
-	v, err := d.Str()
-	if err != nil {
-		return err
-	}
-	o.Value = string(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
+func (s *JobMessageRedfishError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
+func (s *JobMessageRedfishError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PatchRolesRequest) Encode(e *jx.Encoder) {
+func (s *JobMessageRedfishErrorError) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PatchRolesRequest) encodeFields(e *jx.Encoder) {
+func (s *JobMessageRedfishErrorError) encodeFields(e *jx.Encoder) {
 	{
-		if s.PermissionList != nil {
-			e.FieldStart("permission_list")
-			e.ArrStart()
-			for _, elem := range s.PermissionList {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.MessageDotExtendedInfo.Set {
+			e.FieldStart("@Message.ExtendedInfo")
+			s.MessageDotExtendedInfo.Encode(e)
 		}
 	}
 	{
-		if s.Role.Set {
-			e.FieldStart("role")
-			s.Role.Encode(e)
+		if s.Code.Set {
+			e.FieldStart("code")
+			s.Code.Encode(e)
+		}
+	}
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfPatchRolesRequest = [2]string{
-	0: "permission_list",
-	1: "role",
+var jsonFieldsNameOfJobMessageRedfishErrorError = [3]string{
+	0: "@Message.ExtendedInfo",
+	1: "code",
+	2: "message",
 }
 
-// Decode decodes PatchRolesRequest from json.
-func (s *PatchRolesRequest) Decode(d *jx.Decoder) error {
+// Decode decodes JobMessageRedfishErrorError from json.
+func (s *JobMessageRedfishErrorError) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PatchRolesRequest to nil")
+		return errors.New("invalid: unable to decode JobMessageRedfishErrorError to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "permission_list":
+		case "@Message.ExtendedInfo":
 			if err := func() error {
-				s.PermissionList = make([]PatchRolesRequestPermissionListItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PatchRolesRequestPermissionListItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.PermissionList = append(s.PermissionList, elem)
-					return nil
-				}); err != nil {
+				s.MessageDotExtendedInfo.Reset()
+				if err := s.MessageDotExtendedInfo.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"permission_list\"")
+				return errors.Wrap(err, "decode field \"@Message.ExtendedInfo\"")
 			}
-		case "role":
+		case "code":
 			if err := func() error {
-				s.Role.Reset()
-				if err := s.Role.Decode(d); err != nil {
+				s.Code.Reset()
+				if err := s.Code.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"role\"")
+				return errors.Wrap(err, "decode field \"code\"")
+			}
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PatchRolesRequest")
+		return errors.Wrap(err, "decode JobMessageRedfishErrorError")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PatchRolesRequest) MarshalJSON() ([]byte, error) {
+func (s *JobMessageRedfishErrorError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PatchRolesRequest) UnmarshalJSON(data []byte) error {
+func (s *JobMessageRedfishErrorError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PatchRolesRequestPermissionListItem) Encode(e *jx.Encoder) {
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PatchRolesRequestPermissionListItem) encodeFields(e *jx.Encoder) {
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.Method.Set {
-			e.FieldStart("method")
-			s.Method.Encode(e)
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
 		}
 	}
 	{
-		if s.Path.Set {
-			e.FieldStart("path")
-			s.Path.Encode(e)
+		if s.MessageArgsDotOdataDotCount.Set {
+			e.FieldStart("MessageArgs.@odata.count")
+			s.MessageArgsDotOdataDotCount.Encode(e)
+		}
+	}
+	{
+		if s.MessageId.Set {
+			e.FieldStart("MessageId")
+			s.MessageId.Encode(e)
+		}
+	}
+	{
+		if s.RelatedPropertiesDotOdataDotCount.Set {
+			e.FieldStart("RelatedProperties.@odata.count")
+			s.RelatedPropertiesDotOdataDotCount.Encode(e)
+		}
+	}
+	{
+		if s.Resolution.Set {
+			e.FieldStart("Resolution")
+			s.Resolution.Encode(e)
+		}
+	}
+	{
+		if s.Severity.Set {
+			e.FieldStart("Severity")
+			s.Severity.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfPatchRolesRequestPermissionListItem = [2]string{
-	0: "method",
-	1: "path",
+var jsonFieldsNameOfJobMessageRedfishErrorErrorMessageDotExtendedInfoItem = [6]string{
+	0: "Message",
+	1: "MessageArgs.@odata.count",
+	2: "MessageId",
+	3: "RelatedProperties.@odata.count",
+	4: "Resolution",
+	5: "Severity",
 }
 
-// Decode decodes PatchRolesRequestPermissionListItem from json.
-func (s *PatchRolesRequestPermissionListItem) Decode(d *jx.Decoder) error {
+// Decode decodes JobMessageRedfishErrorErrorMessageDotExtendedInfoItem from json.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PatchRolesRequestPermissionListItem to nil")
+		return errors.New("invalid: unable to decode JobMessageRedfishErrorErrorMessageDotExtendedInfoItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "method":
+		case "Message":
 			if err := func() error {
-				s.Method.Reset()
-				if err := s.Method.Decode(d); err != nil {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"method\"")
+				return errors.Wrap(err, "decode field \"Message\"")
 			}
-		case "path":
+		case "MessageArgs.@odata.count":
 			if err := func() error {
-				s.Path.Reset()
-				if err := s.Path.Decode(d); err != nil {
+				s.MessageArgsDotOdataDotCount.Reset()
+				if err := s.MessageArgsDotOdataDotCount.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"path\"")
+				return errors.Wrap(err, "decode field \"MessageArgs.@odata.count\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode PatchRolesRequestPermissionListItem")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *PatchRolesRequestPermissionListItem) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PatchRolesRequestPermissionListItem) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s *PostRolesRequest) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *PostRolesRequest) encodeFields(e *jx.Encoder) {
-	{
-		if s.InheritedRole.Set {
-			e.FieldStart("inherited_role")
-			s.InheritedRole.Encode(e)
-		}
-	}
-	{
-		if s.Role.Set {
-			e.FieldStart("role")
-			s.Role.Encode(e)
-		}
-	}
-}
-
-var jsonFieldsNameOfPostRolesRequest = [2]string{
-	0: "inherited_role",
-	1: "role",
-}
-
-// Decode decodes PostRolesRequest from json.
-func (s *PostRolesRequest) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode PostRolesRequest to nil")
-	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "inherited_role":
+		case "MessageId":
 			if err := func() error {
-				s.InheritedRole.Reset()
-				if err := s.InheritedRole.Decode(d); err != nil {
+				s.MessageId.Reset()
+				if err := s.MessageId.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"inherited_role\"")
+				return errors.Wrap(err, "decode field \"MessageId\"")
 			}
-		case "role":
+		case "RelatedProperties.@odata.count":
 			if err := func() error {
-				s.Role.Reset()
-				if err := s.Role.Decode(d); err != nil {
+				s.RelatedPropertiesDotOdataDotCount.Reset()
+				if err := s.RelatedPropertiesDotOdataDotCount.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"role\"")
+				return errors.Wrap(err, "decode field \"RelatedProperties.@odata.count\"")
+			}
+		case "Resolution":
+			if err := func() error {
+				s.Resolution.Reset()
+				if err := s.Resolution.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Resolution\"")
+			}
+		case "Severity":
+			if err := func() error {
+				s.Severity.Reset()
+				if err := s.Severity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Severity\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PostRolesRequest")
+		return errors.Wrap(err, "decode JobMessageRedfishErrorErrorMessageDotExtendedInfoItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PostRolesRequest) MarshalJSON() ([]byte, error) {
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PostRolesRequest) UnmarshalJSON(data []byte) error {
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RedfishDellUpgradeFirmware) Encode(e *jx.Encoder) {
+func (s *LLDP) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RedfishDellUpgradeFirmware) encodeFields(e *jx.Encoder) {
+func (s *LLDP) encodeFields(e *jx.Encoder) {
 	{
-		if s.Message.Set {
-			e.FieldStart("Message")
-			s.Message.Encode(e)
+		if s.ChassisID.Set {
+			e.FieldStart("chassis_id")
+			s.ChassisID.Encode(e)
 		}
 	}
 	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
+		if s.ChassisIDType.Set {
+			e.FieldStart("chassis_id_type")
+			s.ChassisIDType.Encode(e)
 		}
 	}
 	{
-		if s.Status.Set {
-			e.FieldStart("Status")
-			s.Status.Encode(e)
+		if s.ManagementAddress.Set {
+			e.FieldStart("management_address")
+			s.ManagementAddress.Encode(e)
 		}
 	}
 	{
-		if s.UpdateCount.Set {
-			e.FieldStart("UpdateCount")
-			s.UpdateCount.Encode(e)
+		if s.PortDescription.Set {
+			e.FieldStart("port_description")
+			s.PortDescription.Encode(e)
 		}
 	}
 	{
-		if s.UpdateList != nil {
-			e.FieldStart("UpdateList")
-			e.ArrStart()
-			for _, elem := range s.UpdateList {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.PortID.Set {
+			e.FieldStart("port_id")
+			s.PortID.Encode(e)
 		}
 	}
 	{
-		if s.UpdateRebootType.Set {
-			e.FieldStart("UpdateRebootType")
-			s.UpdateRebootType.Encode(e)
+		if s.PortIDType.Set {
+			e.FieldStart("port_id_type")
+			s.PortIDType.Encode(e)
+		}
+	}
+	{
+		if s.PortName.Set {
+			e.FieldStart("port_name")
+			s.PortName.Encode(e)
+		}
+	}
+	{
+		if s.SystemDescription.Set {
+			e.FieldStart("system_description")
+			s.SystemDescription.Encode(e)
+		}
+	}
+	{
+		if s.SystemName.Set {
+			e.FieldStart("system_name")
+			s.SystemName.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRedfishDellUpgradeFirmware = [6]string{
-	0: "Message",
-	1: "Name",
-	2: "Status",
-	3: "UpdateCount",
-	4: "UpdateList",
-	5: "UpdateRebootType",
+var jsonFieldsNameOfLLDP = [9]string{
+	0: "chassis_id",
+	1: "chassis_id_type",
+	2: "management_address",
+	3: "port_description",
+	4: "port_id",
+	5: "port_id_type",
+	6: "port_name",
+	7: "system_description",
+	8: "system_name",
 }
 
-// Decode decodes RedfishDellUpgradeFirmware from json.
-func (s *RedfishDellUpgradeFirmware) Decode(d *jx.Decoder) error {
+// Decode decodes LLDP from json.
+func (s *LLDP) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RedfishDellUpgradeFirmware to nil")
+		return errors.New("invalid: unable to decode LLDP to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Message":
+		case "chassis_id":
 			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
+				s.ChassisID.Reset()
+				if err := s.ChassisID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Message\"")
+				return errors.Wrap(err, "decode field \"chassis_id\"")
 			}
-		case "Name":
+		case "chassis_id_type":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.ChassisIDType.Reset()
+				if err := s.ChassisIDType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
+				return errors.Wrap(err, "decode field \"chassis_id_type\"")
 			}
-		case "Status":
+		case "management_address":
 			if err := func() error {
-				s.Status.Reset()
-				if err := s.Status.Decode(d); err != nil {
+				s.ManagementAddress.Reset()
+				if err := s.ManagementAddress.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Status\"")
+				return errors.Wrap(err, "decode field \"management_address\"")
 			}
-		case "UpdateCount":
+		case "port_description":
 			if err := func() error {
-				s.UpdateCount.Reset()
-				if err := s.UpdateCount.Decode(d); err != nil {
+				s.PortDescription.Reset()
+				if err := s.PortDescription.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"UpdateCount\"")
+				return errors.Wrap(err, "decode field \"port_description\"")
 			}
-		case "UpdateList":
+		case "port_id":
 			if err := func() error {
-				s.UpdateList = make([]RedfishDellUpgradeFirmwareUpdateListItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishDellUpgradeFirmwareUpdateListItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.UpdateList = append(s.UpdateList, elem)
-					return nil
-				}); err != nil {
+				s.PortID.Reset()
+				if err := s.PortID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"UpdateList\"")
+				return errors.Wrap(err, "decode field \"port_id\"")
 			}
-		case "UpdateRebootType":
+		case "port_id_type":
 			if err := func() error {
-				s.UpdateRebootType.Reset()
-				if err := s.UpdateRebootType.Decode(d); err != nil {
+				s.PortIDType.Reset()
+				if err := s.PortIDType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"UpdateRebootType\"")
+				return errors.Wrap(err, "decode field \"port_id_type\"")
+			}
+		case "port_name":
+			if err := func() error {
+				s.PortName.Reset()
+				if err := s.PortName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"port_name\"")
+			}
+		case "system_description":
+			if err := func() error {
+				s.SystemDescription.Reset()
+				if err := s.SystemDescription.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"system_description\"")
+			}
+		case "system_name":
+			if err := func() error {
+				s.SystemName.Reset()
+				if err := s.SystemName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"system_name\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishDellUpgradeFirmware")
+		return errors.Wrap(err, "decode LLDP")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishDellUpgradeFirmware) MarshalJSON() ([]byte, error) {
+func (s *LLDP) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishDellUpgradeFirmware) UnmarshalJSON(data []byte) error {
+func (s *LLDP) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes BootImageAddRequestBootImagesItem as json.
+func (o NilBootImageAddRequestBootImagesItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.BaseLocation.Set {
-			e.FieldStart("BaseLocation")
-			s.BaseLocation.Encode(e)
-		}
-	}
-	{
-		if s.ComponentID.Set {
-			e.FieldStart("ComponentID")
-			s.ComponentID.Encode(e)
-		}
-	}
-	{
-		if s.ComponentInfoName.Set {
-			e.FieldStart("ComponentInfoName")
-			s.ComponentInfoName.Encode(e)
-		}
-	}
-	{
-		if s.ComponentInfoValue.Set {
-			e.FieldStart("ComponentInfoValue")
-			s.ComponentInfoValue.Encode(e)
-		}
-	}
-	{
-		if s.ComponentType.Set {
-			e.FieldStart("ComponentType")
-			s.ComponentType.Encode(e)
-		}
-	}
-	{
-		if s.Criticality.Set {
-			e.FieldStart("Criticality")
-			s.Criticality.Encode(e)
-		}
-	}
-	{
-		if s.DisplayName.Set {
-			e.FieldStart("DisplayName")
-			s.DisplayName.Encode(e)
-		}
-	}
-	{
-		if s.InstalledVersion.Set {
-			e.FieldStart("InstalledVersion")
-			s.InstalledVersion.Encode(e)
-		}
-	}
-	{
-		if s.JobID.Set {
-			e.FieldStart("JobID")
-			s.JobID.Encode(e)
-		}
+// Decode decodes BootImageAddRequestBootImagesItem from json.
+func (o *NilBootImageAddRequestBootImagesItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilBootImageAddRequestBootImagesItem to nil")
 	}
-	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v BootImageAddRequestBootImagesItem
+		o.Value = v
+		o.Null = true
+		return nil
 	}
-	{
-		if s.PackageName.Set {
-			e.FieldStart("PackageName")
-			s.PackageName.Encode(e)
-		}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.PackagePath.Set {
-			e.FieldStart("PackagePath")
-			s.PackagePath.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilBootImageAddRequestBootImagesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilBootImageAddRequestBootImagesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CablingReportLinksItem as json.
+func (o NilCablingReportLinksItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.PackageVersion.Set {
-			e.FieldStart("PackageVersion")
-			s.PackageVersion.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CablingReportLinksItem from json.
+func (o *NilCablingReportLinksItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilCablingReportLinksItem to nil")
 	}
-	{
-		if s.RebootType.Set {
-			e.FieldStart("RebootType")
-			s.RebootType.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v CablingReportLinksItem
+		o.Value = v
+		o.Null = true
+		return nil
 	}
-	{
-		if s.Target.Set {
-			e.FieldStart("Target")
-			s.Target.Encode(e)
-		}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfRedfishDellUpgradeFirmwareUpdateListItem = [15]string{
-	0:  "BaseLocation",
-	1:  "ComponentID",
-	2:  "ComponentInfoName",
-	3:  "ComponentInfoValue",
-	4:  "ComponentType",
-	5:  "Criticality",
-	6:  "DisplayName",
-	7:  "InstalledVersion",
-	8:  "JobID",
-	9:  "Name",
-	10: "PackageName",
-	11: "PackagePath",
-	12: "PackageVersion",
-	13: "RebootType",
-	14: "Target",
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilCablingReportLinksItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishDellUpgradeFirmwareUpdateListItem from json.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishDellUpgradeFirmwareUpdateListItem to nil")
-	}
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilCablingReportLinksItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "BaseLocation":
-			if err := func() error {
-				s.BaseLocation.Reset()
-				if err := s.BaseLocation.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"BaseLocation\"")
-			}
-		case "ComponentID":
-			if err := func() error {
-				s.ComponentID.Reset()
-				if err := s.ComponentID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ComponentID\"")
-			}
-		case "ComponentInfoName":
-			if err := func() error {
-				s.ComponentInfoName.Reset()
-				if err := s.ComponentInfoName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ComponentInfoName\"")
-			}
-		case "ComponentInfoValue":
-			if err := func() error {
-				s.ComponentInfoValue.Reset()
-				if err := s.ComponentInfoValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ComponentInfoValue\"")
-			}
-		case "ComponentType":
-			if err := func() error {
-				s.ComponentType.Reset()
-				if err := s.ComponentType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ComponentType\"")
-			}
-		case "Criticality":
-			if err := func() error {
-				s.Criticality.Reset()
-				if err := s.Criticality.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Criticality\"")
-			}
-		case "DisplayName":
-			if err := func() error {
-				s.DisplayName.Reset()
-				if err := s.DisplayName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"DisplayName\"")
-			}
-		case "InstalledVersion":
-			if err := func() error {
-				s.InstalledVersion.Reset()
-				if err := s.InstalledVersion.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"InstalledVersion\"")
-			}
-		case "JobID":
-			if err := func() error {
-				s.JobID.Reset()
-				if err := s.JobID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"JobID\"")
-			}
-		case "Name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
-			}
-		case "PackageName":
-			if err := func() error {
-				s.PackageName.Reset()
-				if err := s.PackageName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PackageName\"")
-			}
-		case "PackagePath":
-			if err := func() error {
-				s.PackagePath.Reset()
-				if err := s.PackagePath.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PackagePath\"")
-			}
-		case "PackageVersion":
-			if err := func() error {
-				s.PackageVersion.Reset()
-				if err := s.PackageVersion.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PackageVersion\"")
-			}
-		case "RebootType":
-			if err := func() error {
-				s.RebootType.Reset()
-				if err := s.RebootType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RebootType\"")
-			}
-		case "Target":
-			if err := func() error {
-				s.Target.Reset()
-				if err := s.Target.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Target\"")
-			}
-		default:
-			return d.Skip()
+// Encode encodes DataDumpHostsItem as json.
+func (o NilDataDumpHostsItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes DataDumpHostsItem from json.
+func (o *NilDataDumpHostsItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilDataDumpHostsItem to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v DataDumpHostsItem
+		o.Value = v
+		o.Null = true
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RedfishDellUpgradeFirmwareUpdateListItem")
 	}
-
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) MarshalJSON() ([]byte, error) {
+func (s NilDataDumpHostsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) UnmarshalJSON(data []byte) error {
+func (s *NilDataDumpHostsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishJob) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes DataDumpHostsItemBondsItem as json.
+func (o NilDataDumpHostsItemBondsItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *RedfishJob) encodeFields(e *jx.Encoder) {
-	{
-		if s.Jobs.Set {
-			e.FieldStart("jobs")
-			s.Jobs.Encode(e)
-		}
+// Decode decodes DataDumpHostsItemBondsItem from json.
+func (o *NilDataDumpHostsItemBondsItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilDataDumpHostsItemBondsItem to nil")
 	}
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v DataDumpHostsItemBondsItem
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfRedfishJob = [2]string{
-	0: "jobs",
-	1: "name",
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilDataDumpHostsItemBondsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishJob from json.
-func (s *RedfishJob) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishJob to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilDataDumpHostsItemBondsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes DataDumpHostsItemInterfacesItem as json.
+func (o NilDataDumpHostsItemInterfacesItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
 	}
+	o.Value.Encode(e)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "jobs":
-			if err := func() error {
-				s.Jobs.Reset()
-				if err := s.Jobs.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"jobs\"")
-			}
-		case "name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		default:
-			return d.Skip()
+// Decode decodes DataDumpHostsItemInterfacesItem from json.
+func (o *NilDataDumpHostsItemInterfacesItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilDataDumpHostsItemInterfacesItem to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v DataDumpHostsItemInterfacesItem
+		o.Value = v
+		o.Null = true
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RedfishJob")
 	}
-
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishJob) MarshalJSON() ([]byte, error) {
+func (s NilDataDumpHostsItemInterfacesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishJob) UnmarshalJSON(data []byte) error {
+func (s *NilDataDumpHostsItemInterfacesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishJobJobsItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes DataDumpImagesItem as json.
+func (o NilDataDumpImagesItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *RedfishJobJobsItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.MessageDotExtendedInfo != nil {
-			e.FieldStart("@Message.ExtendedInfo")
-			e.ArrStart()
-			for _, elem := range s.MessageDotExtendedInfo {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+// Decode decodes DataDumpImagesItem from json.
+func (o *NilDataDumpImagesItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilDataDumpImagesItem to nil")
 	}
-	{
-		if s.OdataDotContext.Set {
-			e.FieldStart("@odata.context")
-			s.OdataDotContext.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v DataDumpImagesItem
+		o.Value = v
+		o.Null = true
+		return nil
 	}
-	{
-		if s.OdataDotEtag.Set {
-			e.FieldStart("@odata.etag")
-			s.OdataDotEtag.Encode(e)
-		}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.OdataDotID.Set {
-			e.FieldStart("@odata.id")
-			s.OdataDotID.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilDataDumpImagesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilDataDumpImagesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes HostBondsItem as json.
+func (o NilHostBondsItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.OdataDotType.Set {
-			e.FieldStart("@odata.type")
-			s.OdataDotType.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes HostBondsItem from json.
+func (o *NilHostBondsItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilHostBondsItem to nil")
 	}
-	{
-		if s.CreatedBy.Set {
-			e.FieldStart("CreatedBy")
-			s.CreatedBy.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v HostBondsItem
+		o.Value = v
+		o.Null = true
+		return nil
 	}
-	{
-		if s.CreationTime.Set {
-			e.FieldStart("CreationTime")
-			s.CreationTime.Encode(e)
-		}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.Description.Set {
-			e.FieldStart("Description")
-			s.Description.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilHostBondsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilHostBondsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes HostInterfacesItem as json.
+func (o NilHostInterfacesItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.EndTime.Set {
-			e.FieldStart("EndTime")
-			s.EndTime.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes HostInterfacesItem from json.
+func (o *NilHostInterfacesItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilHostInterfacesItem to nil")
 	}
-	{
-		if s.EstimatedCompletionTime.Set {
-			e.FieldStart("EstimatedCompletionTime")
-			s.EstimatedCompletionTime.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v HostInterfacesItem
+		o.Value = v
+		o.Null = true
+		return nil
 	}
-	{
-		if s.EstimatedDuration.Set {
-			e.FieldStart("EstimatedDuration")
-			s.EstimatedDuration.Encode(e)
-		}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.HidePayload.Set {
-			e.FieldStart("HidePayload")
-			s.HidePayload.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilHostInterfacesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilHostInterfacesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o NilInt) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.ID.Set {
-			e.FieldStart("Id")
-			s.ID.Encode(e)
-		}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *NilInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilInt to nil")
 	}
-	{
-		if s.JobPriority.Set {
-			e.FieldStart("JobPriority")
-			s.JobPriority.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v int
+		o.Value = v
+		o.Null = true
+		return nil
 	}
-	{
-		if s.JobState.Set {
-			e.FieldStart("JobState")
-			s.JobState.Encode(e)
-		}
-	}
-	{
-		if s.JobStatus.Set {
-			e.FieldStart("JobStatus")
-			s.JobStatus.Encode(e)
-		}
+	o.Null = false
+	v, err := d.Int()
+	if err != nil {
+		return err
 	}
-	{
-		if s.JobType.Set {
-			e.FieldStart("JobType")
-			s.JobType.Encode(e)
-		}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeAddRequestNodeListItem as json.
+func (o NilNodeAddRequestNodeListItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.MaxExecutionTime.Set {
-			e.FieldStart("MaxExecutionTime")
-			s.MaxExecutionTime.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes NodeAddRequestNodeListItem from json.
+func (o *NilNodeAddRequestNodeListItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilNodeAddRequestNodeListItem to nil")
 	}
-	{
-		if s.Messages != nil {
-			e.FieldStart("Messages")
-			e.ArrStart()
-			for _, elem := range s.Messages {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v NodeAddRequestNodeListItem
+		o.Value = v
+		o.Null = true
+		return nil
 	}
-	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
-		}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilNodeAddRequestNodeListItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilNodeAddRequestNodeListItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeAddRequestNodeListItemBondsItem as json.
+func (o NilNodeAddRequestNodeListItemBondsItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.Parameters.Set {
-			e.FieldStart("Parameters")
-			s.Parameters.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes NodeAddRequestNodeListItemBondsItem from json.
+func (o *NilNodeAddRequestNodeListItemBondsItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilNodeAddRequestNodeListItemBondsItem to nil")
 	}
-	{
-		if s.Payload.Set {
-			e.FieldStart("Payload")
-			s.Payload.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v NodeAddRequestNodeListItemBondsItem
+		o.Value = v
+		o.Null = true
+		return nil
 	}
-	{
-		if s.PercentComplete.Set {
-			e.FieldStart("PercentComplete")
-			s.PercentComplete.Encode(e)
-		}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		e.FieldStart("RawData")
-		e.Base64(s.RawData)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilNodeAddRequestNodeListItemBondsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilNodeAddRequestNodeListItemBondsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes NodeAddRequestNodeListItemInterfacesItem as json.
+func (o NilNodeAddRequestNodeListItemInterfacesItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.Schedule.Set {
-			e.FieldStart("Schedule")
-			s.Schedule.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes NodeAddRequestNodeListItemInterfacesItem from json.
+func (o *NilNodeAddRequestNodeListItemInterfacesItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilNodeAddRequestNodeListItemInterfacesItem to nil")
 	}
-	{
-		if s.StartTime.Set {
-			e.FieldStart("StartTime")
-			s.StartTime.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v NodeAddRequestNodeListItemInterfacesItem
+		o.Value = v
+		o.Null = true
+		return nil
 	}
-	{
-		if s.StepOrder.Set {
-			e.FieldStart("StepOrder")
-			s.StepOrder.Encode(e)
-		}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfRedfishJobJobsItem = [28]string{
-	0:  "@Message.ExtendedInfo",
-	1:  "@odata.context",
-	2:  "@odata.etag",
-	3:  "@odata.id",
-	4:  "@odata.type",
-	5:  "CreatedBy",
-	6:  "CreationTime",
-	7:  "Description",
-	8:  "EndTime",
-	9:  "EstimatedCompletionTime",
-	10: "EstimatedDuration",
-	11: "HidePayload",
-	12: "Id",
-	13: "JobPriority",
-	14: "JobState",
-	15: "JobStatus",
-	16: "JobType",
-	17: "MaxExecutionTime",
-	18: "Messages",
-	19: "Name",
-	20: "Oem",
-	21: "Parameters",
-	22: "Payload",
-	23: "PercentComplete",
-	24: "RawData",
-	25: "Schedule",
-	26: "StartTime",
-	27: "StepOrder",
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilNodeAddRequestNodeListItemInterfacesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilNodeAddRequestNodeListItemInterfacesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RedfishJobJobsItem as json.
+func (o NilRedfishJobJobsItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
 }
 
 // Decode decodes RedfishJobJobsItem from json.
-func (s *RedfishJobJobsItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishJobJobsItem to nil")
+func (o *NilRedfishJobJobsItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilRedfishJobJobsItem to nil")
 	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "@Message.ExtendedInfo":
-			if err := func() error {
-				s.MessageDotExtendedInfo = make([]RedfishJobJobsItemMessageDotExtendedInfoItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishJobJobsItemMessageDotExtendedInfoItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@Message.ExtendedInfo\"")
+		var v RedfishJobJobsItem
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilRedfishJobJobsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilRedfishJobJobsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RedfishMetricReportReportsItem as json.
+func (o NilRedfishMetricReportReportsItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes RedfishMetricReportReportsItem from json.
+func (o *NilRedfishMetricReportReportsItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilRedfishMetricReportReportsItem to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v RedfishMetricReportReportsItem
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilRedfishMetricReportReportsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilRedfishMetricReportReportsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o NilString) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *NilString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilString to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v string
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SwitchPortReportPortsItem as json.
+func (o NilSwitchPortReportPortsItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes SwitchPortReportPortsItem from json.
+func (o *NilSwitchPortReportPortsItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilSwitchPortReportPortsItem to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v SwitchPortReportPortsItem
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilSwitchPortReportPortsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilSwitchPortReportPortsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TopologyGraphLinksItem as json.
+func (o NilTopologyGraphLinksItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes TopologyGraphLinksItem from json.
+func (o *NilTopologyGraphLinksItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilTopologyGraphLinksItem to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v TopologyGraphLinksItem
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilTopologyGraphLinksItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilTopologyGraphLinksItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes TopologyGraphNodesItem as json.
+func (o NilTopologyGraphNodesItem) Encode(e *jx.Encoder) {
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes TopologyGraphNodesItem from json.
+func (o *NilTopologyGraphNodesItem) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode NilTopologyGraphNodesItem to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v TopologyGraphNodesItem
+		o.Value = v
+		o.Null = true
+		return nil
+	}
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s NilTopologyGraphNodesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NilTopologyGraphNodesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeAddRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeAddRequest) encodeFields(e *jx.Encoder) {
+	{
+		if s.NodeList != nil {
+			e.FieldStart("node_list")
+			e.ArrStart()
+			for _, elem := range s.NodeList {
+				elem.Encode(e)
 			}
-		case "@odata.context":
-			if err := func() error {
-				s.OdataDotContext.Reset()
-				if err := s.OdataDotContext.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.context\"")
-			}
-		case "@odata.etag":
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfNodeAddRequest = [1]string{
+	0: "node_list",
+}
+
+// Decode decodes NodeAddRequest from json.
+func (s *NodeAddRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeAddRequest to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "node_list":
 			if err := func() error {
-				s.OdataDotEtag.Reset()
-				if err := s.OdataDotEtag.Decode(d); err != nil {
+				s.NodeList = make([]NilNodeAddRequestNodeListItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilNodeAddRequestNodeListItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.NodeList = append(s.NodeList, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.etag\"")
+				return errors.Wrap(err, "decode field \"node_list\"")
 			}
-		case "@odata.id":
-			if err := func() error {
-				s.OdataDotID.Reset()
-				if err := s.OdataDotID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.id\"")
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NodeAddRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NodeAddRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeAddRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeAddRequestNodeListItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeAddRequestNodeListItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Bonds != nil {
+			e.FieldStart("bonds")
+			e.ArrStart()
+			for _, elem := range s.Bonds {
+				elem.Encode(e)
 			}
-		case "@odata.type":
-			if err := func() error {
-				s.OdataDotType.Reset()
-				if err := s.OdataDotType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.type\"")
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.BootImage.Set {
+			e.FieldStart("boot_image")
+			s.BootImage.Encode(e)
+		}
+	}
+	{
+		if s.Firmware.Set {
+			e.FieldStart("firmware")
+			s.Firmware.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Interfaces != nil {
+			e.FieldStart("interfaces")
+			e.ArrStart()
+			for _, elem := range s.Interfaces {
+				elem.Encode(e)
 			}
-		case "CreatedBy":
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Provision.Set {
+			e.FieldStart("provision")
+			s.Provision.Encode(e)
+		}
+	}
+	{
+		if s.Tags.Set {
+			e.FieldStart("tags")
+			s.Tags.Encode(e)
+		}
+	}
+	{
+		if s.UID.Set {
+			e.FieldStart("uid")
+			s.UID.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNodeAddRequestNodeListItem = [9]string{
+	0: "bonds",
+	1: "boot_image",
+	2: "firmware",
+	3: "id",
+	4: "interfaces",
+	5: "name",
+	6: "provision",
+	7: "tags",
+	8: "uid",
+}
+
+// Decode decodes NodeAddRequestNodeListItem from json.
+func (s *NodeAddRequestNodeListItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeAddRequestNodeListItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "bonds":
 			if err := func() error {
-				s.CreatedBy.Reset()
-				if err := s.CreatedBy.Decode(d); err != nil {
+				s.Bonds = make([]NilNodeAddRequestNodeListItemBondsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilNodeAddRequestNodeListItemBondsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Bonds = append(s.Bonds, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"CreatedBy\"")
+				return errors.Wrap(err, "decode field \"bonds\"")
 			}
-		case "CreationTime":
+		case "boot_image":
 			if err := func() error {
-				s.CreationTime.Reset()
-				if err := s.CreationTime.Decode(d); err != nil {
+				s.BootImage.Reset()
+				if err := s.BootImage.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"CreationTime\"")
+				return errors.Wrap(err, "decode field \"boot_image\"")
 			}
-		case "Description":
+		case "firmware":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.Firmware.Reset()
+				if err := s.Firmware.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
+				return errors.Wrap(err, "decode field \"firmware\"")
 			}
-		case "EndTime":
+		case "id":
 			if err := func() error {
-				s.EndTime.Reset()
-				if err := s.EndTime.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EndTime\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "EstimatedCompletionTime":
+		case "interfaces":
 			if err := func() error {
-				s.EstimatedCompletionTime.Reset()
-				if err := s.EstimatedCompletionTime.Decode(d); err != nil {
+				s.Interfaces = make([]NilNodeAddRequestNodeListItemInterfacesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilNodeAddRequestNodeListItemInterfacesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Interfaces = append(s.Interfaces, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EstimatedCompletionTime\"")
+				return errors.Wrap(err, "decode field \"interfaces\"")
 			}
-		case "EstimatedDuration":
+		case "name":
 			if err := func() error {
-				s.EstimatedDuration.Reset()
-				if err := s.EstimatedDuration.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EstimatedDuration\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "HidePayload":
+		case "provision":
 			if err := func() error {
-				s.HidePayload.Reset()
-				if err := s.HidePayload.Decode(d); err != nil {
+				s.Provision.Reset()
+				if err := s.Provision.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"HidePayload\"")
+				return errors.Wrap(err, "decode field \"provision\"")
 			}
-		case "Id":
+		case "tags":
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				s.Tags.Reset()
+				if err := s.Tags.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Id\"")
+				return errors.Wrap(err, "decode field \"tags\"")
 			}
-		case "JobPriority":
+		case "uid":
 			if err := func() error {
-				s.JobPriority.Reset()
-				if err := s.JobPriority.Decode(d); err != nil {
+				s.UID.Reset()
+				if err := s.UID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"JobPriority\"")
+				return errors.Wrap(err, "decode field \"uid\"")
 			}
-		case "JobState":
-			if err := func() error {
-				s.JobState.Reset()
-				if err := s.JobState.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"JobState\"")
-			}
-		case "JobStatus":
-			if err := func() error {
-				s.JobStatus.Reset()
-				if err := s.JobStatus.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"JobStatus\"")
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NodeAddRequestNodeListItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NodeAddRequestNodeListItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeAddRequestNodeListItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeAddRequestNodeListItemBondsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeAddRequestNodeListItemBondsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Bmc.Set {
+			e.FieldStart("bmc")
+			s.Bmc.Encode(e)
+		}
+	}
+	{
+		if s.Fqdn.Set {
+			e.FieldStart("fqdn")
+			s.Fqdn.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Ifname.Set {
+			e.FieldStart("ifname")
+			s.Ifname.Encode(e)
+		}
+	}
+	{
+		if s.IP.Set {
+			e.FieldStart("ip")
+			s.IP.Encode(e)
+		}
+	}
+	{
+		if s.Ip6.Set {
+			e.FieldStart("ip6")
+			s.Ip6.Encode(e)
+		}
+	}
+	{
+		if s.MAC.Set {
+			e.FieldStart("mac")
+			s.MAC.Encode(e)
+		}
+	}
+	{
+		if s.Mtu.Set {
+			e.FieldStart("mtu")
+			s.Mtu.Encode(e)
+		}
+	}
+	{
+		if s.Peers != nil {
+			e.FieldStart("peers")
+			e.ArrStart()
+			for _, elem := range s.Peers {
+				e.Str(elem)
 			}
-		case "JobType":
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Switch.Set {
+			e.FieldStart("switch")
+			s.Switch.Encode(e)
+		}
+	}
+	{
+		if s.SwitchPort.Set {
+			e.FieldStart("switch_port")
+			s.SwitchPort.Encode(e)
+		}
+	}
+	{
+		if s.Vlan.Set {
+			e.FieldStart("vlan")
+			s.Vlan.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfNodeAddRequestNodeListItemBondsItem = [12]string{
+	0:  "bmc",
+	1:  "fqdn",
+	2:  "id",
+	3:  "ifname",
+	4:  "ip",
+	5:  "ip6",
+	6:  "mac",
+	7:  "mtu",
+	8:  "peers",
+	9:  "switch",
+	10: "switch_port",
+	11: "vlan",
+}
+
+// Decode decodes NodeAddRequestNodeListItemBondsItem from json.
+func (s *NodeAddRequestNodeListItemBondsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeAddRequestNodeListItemBondsItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "bmc":
 			if err := func() error {
-				s.JobType.Reset()
-				if err := s.JobType.Decode(d); err != nil {
+				s.Bmc.Reset()
+				if err := s.Bmc.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"JobType\"")
+				return errors.Wrap(err, "decode field \"bmc\"")
 			}
-		case "MaxExecutionTime":
+		case "fqdn":
 			if err := func() error {
-				s.MaxExecutionTime.Reset()
-				if err := s.MaxExecutionTime.Decode(d); err != nil {
+				s.Fqdn.Reset()
+				if err := s.Fqdn.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaxExecutionTime\"")
+				return errors.Wrap(err, "decode field \"fqdn\"")
 			}
-		case "Messages":
+		case "id":
 			if err := func() error {
-				s.Messages = make([]RedfishJobJobsItemMessagesItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishJobJobsItemMessagesItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Messages = append(s.Messages, elem)
-					return nil
-				}); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Messages\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "Name":
+		case "ifname":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Ifname.Reset()
+				if err := s.Ifname.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
+				return errors.Wrap(err, "decode field \"ifname\"")
 			}
-		case "Oem":
+		case "ip":
 			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
+				s.IP.Reset()
+				if err := s.IP.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
+				return errors.Wrap(err, "decode field \"ip\"")
 			}
-		case "Parameters":
+		case "ip6":
 			if err := func() error {
-				s.Parameters.Reset()
-				if err := s.Parameters.Decode(d); err != nil {
+				s.Ip6.Reset()
+				if err := s.Ip6.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Parameters\"")
+				return errors.Wrap(err, "decode field \"ip6\"")
 			}
-		case "Payload":
+		case "mac":
 			if err := func() error {
-				s.Payload.Reset()
-				if err := s.Payload.Decode(d); err != nil {
+				s.MAC.Reset()
+				if err := s.MAC.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Payload\"")
+				return errors.Wrap(err, "decode field \"mac\"")
 			}
-		case "PercentComplete":
+		case "mtu":
 			if err := func() error {
-				s.PercentComplete.Reset()
-				if err := s.PercentComplete.Decode(d); err != nil {
+				s.Mtu.Reset()
+				if err := s.Mtu.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PercentComplete\"")
+				return errors.Wrap(err, "decode field \"mtu\"")
 			}
-		case "RawData":
+		case "peers":
 			if err := func() error {
-				v, err := d.Base64()
-				s.RawData = []byte(v)
-				if err != nil {
+				s.Peers = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Peers = append(s.Peers, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RawData\"")
+				return errors.Wrap(err, "decode field \"peers\"")
 			}
-		case "Schedule":
+		case "switch":
 			if err := func() error {
-				s.Schedule.Reset()
-				if err := s.Schedule.Decode(d); err != nil {
+				s.Switch.Reset()
+				if err := s.Switch.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Schedule\"")
+				return errors.Wrap(err, "decode field \"switch\"")
 			}
-		case "StartTime":
+		case "switch_port":
 			if err := func() error {
-				s.StartTime.Reset()
-				if err := s.StartTime.Decode(d); err != nil {
+				s.SwitchPort.Reset()
+				if err := s.SwitchPort.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"StartTime\"")
+				return errors.Wrap(err, "decode field \"switch_port\"")
 			}
-		case "StepOrder":
+		case "vlan":
 			if err := func() error {
-				s.StepOrder.Reset()
-				if err := s.StepOrder.Decode(d); err != nil {
+				s.Vlan.Reset()
+				if err := s.Vlan.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"StepOrder\"")
+				return errors.Wrap(err, "decode field \"vlan\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishJobJobsItem")
+		return errors.Wrap(err, "decode NodeAddRequestNodeListItemBondsItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishJobJobsItem) MarshalJSON() ([]byte, error) {
+func (s *NodeAddRequestNodeListItemBondsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishJobJobsItem) UnmarshalJSON(data []byte) error {
+func (s *NodeAddRequestNodeListItemBondsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) Encode(e *jx.Encoder) {
+func (s *NodeAddRequestNodeListItemInterfacesItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) encodeFields(e *jx.Encoder) {
+func (s *NodeAddRequestNodeListItemInterfacesItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.Message.Set {
-			e.FieldStart("Message")
-			s.Message.Encode(e)
+		if s.Bmc.Set {
+			e.FieldStart("bmc")
+			s.Bmc.Encode(e)
 		}
 	}
 	{
-		if s.MessageArgs != nil {
-			e.FieldStart("MessageArgs")
-			e.ArrStart()
-			for _, elem := range s.MessageArgs {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.Fqdn.Set {
+			e.FieldStart("fqdn")
+			s.Fqdn.Encode(e)
 		}
 	}
 	{
-		if s.MessageId.Set {
-			e.FieldStart("MessageId")
-			s.MessageId.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
 	{
-		if s.MessageSeverity.Set {
-			e.FieldStart("MessageSeverity")
-			s.MessageSeverity.Encode(e)
+		if s.Ifname.Set {
+			e.FieldStart("ifname")
+			s.Ifname.Encode(e)
 		}
 	}
 	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
+		if s.IP.Set {
+			e.FieldStart("ip")
+			s.IP.Encode(e)
 		}
 	}
 	{
-		if s.RelatedProperties.Set {
-			e.FieldStart("RelatedProperties")
-			s.RelatedProperties.Encode(e)
+		if s.Ip6.Set {
+			e.FieldStart("ip6")
+			s.Ip6.Encode(e)
 		}
 	}
 	{
-		if s.Resolution.Set {
-			e.FieldStart("Resolution")
-			s.Resolution.Encode(e)
+		if s.MAC.Set {
+			e.FieldStart("mac")
+			s.MAC.Encode(e)
 		}
 	}
 	{
-		if s.ResolutionSteps != nil {
-			e.FieldStart("ResolutionSteps")
-			e.ArrStart()
-			for _, elem := range s.ResolutionSteps {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Mtu.Set {
+			e.FieldStart("mtu")
+			s.Mtu.Encode(e)
 		}
 	}
 	{
-		if s.Severity.Set {
-			e.FieldStart("Severity")
-			s.Severity.Encode(e)
+		if s.Switch.Set {
+			e.FieldStart("switch")
+			s.Switch.Encode(e)
 		}
 	}
 	{
-		if s.UserAuthenticationSource.Set {
-			e.FieldStart("UserAuthenticationSource")
-			s.UserAuthenticationSource.Encode(e)
+		if s.SwitchPort.Set {
+			e.FieldStart("switch_port")
+			s.SwitchPort.Encode(e)
 		}
 	}
 	{
-		if s.Username.Set {
-			e.FieldStart("Username")
-			s.Username.Encode(e)
+		if s.Vlan.Set {
+			e.FieldStart("vlan")
+			s.Vlan.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRedfishJobJobsItemMessageDotExtendedInfoItem = [11]string{
-	0:  "Message",
-	1:  "MessageArgs",
-	2:  "MessageId",
-	3:  "MessageSeverity",
-	4:  "Oem",
-	5:  "RelatedProperties",
-	6:  "Resolution",
-	7:  "ResolutionSteps",
-	8:  "Severity",
-	9:  "UserAuthenticationSource",
-	10: "Username",
+var jsonFieldsNameOfNodeAddRequestNodeListItemInterfacesItem = [11]string{
+	0:  "bmc",
+	1:  "fqdn",
+	2:  "id",
+	3:  "ifname",
+	4:  "ip",
+	5:  "ip6",
+	6:  "mac",
+	7:  "mtu",
+	8:  "switch",
+	9:  "switch_port",
+	10: "vlan",
 }
 
-// Decode decodes RedfishJobJobsItemMessageDotExtendedInfoItem from json.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) Decode(d *jx.Decoder) error {
+// Decode decodes NodeAddRequestNodeListItemInterfacesItem from json.
+func (s *NodeAddRequestNodeListItemInterfacesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RedfishJobJobsItemMessageDotExtendedInfoItem to nil")
+		return errors.New("invalid: unable to decode NodeAddRequestNodeListItemInterfacesItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Message":
+		case "bmc":
 			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
+				s.Bmc.Reset()
+				if err := s.Bmc.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Message\"")
+				return errors.Wrap(err, "decode field \"bmc\"")
 			}
-		case "MessageArgs":
+		case "fqdn":
 			if err := func() error {
-				s.MessageArgs = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.MessageArgs = append(s.MessageArgs, elem)
-					return nil
-				}); err != nil {
+				s.Fqdn.Reset()
+				if err := s.Fqdn.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageArgs\"")
+				return errors.Wrap(err, "decode field \"fqdn\"")
 			}
-		case "MessageId":
+		case "id":
 			if err := func() error {
-				s.MessageId.Reset()
-				if err := s.MessageId.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageId\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "MessageSeverity":
+		case "ifname":
 			if err := func() error {
-				s.MessageSeverity.Reset()
-				if err := s.MessageSeverity.Decode(d); err != nil {
+				s.Ifname.Reset()
+				if err := s.Ifname.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageSeverity\"")
+				return errors.Wrap(err, "decode field \"ifname\"")
 			}
-		case "Oem":
+		case "ip":
 			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
+				s.IP.Reset()
+				if err := s.IP.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
+				return errors.Wrap(err, "decode field \"ip\"")
 			}
-		case "RelatedProperties":
+		case "ip6":
 			if err := func() error {
-				s.RelatedProperties.Reset()
-				if err := s.RelatedProperties.Decode(d); err != nil {
+				s.Ip6.Reset()
+				if err := s.Ip6.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RelatedProperties\"")
+				return errors.Wrap(err, "decode field \"ip6\"")
 			}
-		case "Resolution":
+		case "mac":
 			if err := func() error {
-				s.Resolution.Reset()
-				if err := s.Resolution.Decode(d); err != nil {
+				s.MAC.Reset()
+				if err := s.MAC.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Resolution\"")
+				return errors.Wrap(err, "decode field \"mac\"")
 			}
-		case "ResolutionSteps":
+		case "mtu":
 			if err := func() error {
-				s.ResolutionSteps = make([]RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.ResolutionSteps = append(s.ResolutionSteps, elem)
-					return nil
-				}); err != nil {
+				s.Mtu.Reset()
+				if err := s.Mtu.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ResolutionSteps\"")
+				return errors.Wrap(err, "decode field \"mtu\"")
 			}
-		case "Severity":
+		case "switch":
 			if err := func() error {
-				s.Severity.Reset()
-				if err := s.Severity.Decode(d); err != nil {
+				s.Switch.Reset()
+				if err := s.Switch.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Severity\"")
+				return errors.Wrap(err, "decode field \"switch\"")
 			}
-		case "UserAuthenticationSource":
+		case "switch_port":
 			if err := func() error {
-				s.UserAuthenticationSource.Reset()
-				if err := s.UserAuthenticationSource.Decode(d); err != nil {
+				s.SwitchPort.Reset()
+				if err := s.SwitchPort.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"UserAuthenticationSource\"")
+				return errors.Wrap(err, "decode field \"switch_port\"")
 			}
-		case "Username":
+		case "vlan":
 			if err := func() error {
-				s.Username.Reset()
-				if err := s.Username.Decode(d); err != nil {
+				s.Vlan.Reset()
+				if err := s.Vlan.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Username\"")
+				return errors.Wrap(err, "decode field \"vlan\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishJobJobsItemMessageDotExtendedInfoItem")
+		return errors.Wrap(err, "decode NodeAddRequestNodeListItemInterfacesItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) MarshalJSON() ([]byte, error) {
+func (s *NodeAddRequestNodeListItemInterfacesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) UnmarshalJSON(data []byte) error {
+func (s *NodeAddRequestNodeListItemInterfacesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) Encode(e *jx.Encoder) {
+func (s *NodeBootImageRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.ActionParameters != nil {
-			e.FieldStart("ActionParameters")
-			e.ArrStart()
-			for _, elem := range s.ActionParameters {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.ActionURI.Set {
-			e.FieldStart("ActionURI")
-			s.ActionURI.Encode(e)
-		}
-	}
-	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
-		}
-	}
-	{
-		if s.Priority.Set {
-			e.FieldStart("Priority")
-			s.Priority.Encode(e)
-		}
-	}
-	{
-		if s.ResolutionType.Set {
-			e.FieldStart("ResolutionType")
-			s.ResolutionType.Encode(e)
-		}
-	}
-	{
-		if s.RetryCount.Set {
-			e.FieldStart("RetryCount")
-			s.RetryCount.Encode(e)
-		}
-	}
-	{
-		if s.RetryIntervalSeconds.Set {
-			e.FieldStart("RetryIntervalSeconds")
-			s.RetryIntervalSeconds.Encode(e)
-		}
-	}
+func (s *NodeBootImageRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.TargetComponentURI.Set {
-			e.FieldStart("TargetComponentURI")
-			s.TargetComponentURI.Encode(e)
+		if s.Image.Set {
+			e.FieldStart("image")
+			s.Image.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem = [8]string{
-	0: "ActionParameters",
-	1: "ActionURI",
-	2: "Oem",
-	3: "Priority",
-	4: "ResolutionType",
-	5: "RetryCount",
-	6: "RetryIntervalSeconds",
-	7: "TargetComponentURI",
+var jsonFieldsNameOfNodeBootImageRequest = [1]string{
+	0: "image",
 }
 
-// Decode decodes RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem from json.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) Decode(d *jx.Decoder) error {
+// Decode decodes NodeBootImageRequest from json.
+func (s *NodeBootImageRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem to nil")
+		return errors.New("invalid: unable to decode NodeBootImageRequest to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ActionParameters":
+		case "image":
 			if err := func() error {
-				s.ActionParameters = make([]RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.ActionParameters = append(s.ActionParameters, elem)
-					return nil
-				}); err != nil {
+				s.Image.Reset()
+				if err := s.Image.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ActionParameters\"")
-			}
-		case "ActionURI":
-			if err := func() error {
-				s.ActionURI.Reset()
-				if err := s.ActionURI.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ActionURI\"")
-			}
-		case "Oem":
-			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
-			}
-		case "Priority":
-			if err := func() error {
-				s.Priority.Reset()
-				if err := s.Priority.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Priority\"")
-			}
-		case "ResolutionType":
-			if err := func() error {
-				s.ResolutionType.Reset()
-				if err := s.ResolutionType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ResolutionType\"")
-			}
-		case "RetryCount":
-			if err := func() error {
-				s.RetryCount.Reset()
-				if err := s.RetryCount.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RetryCount\"")
-			}
-		case "RetryIntervalSeconds":
-			if err := func() error {
-				s.RetryIntervalSeconds.Reset()
-				if err := s.RetryIntervalSeconds.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RetryIntervalSeconds\"")
-			}
-		case "TargetComponentURI":
-			if err := func() error {
-				s.TargetComponentURI.Reset()
-				if err := s.TargetComponentURI.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TargetComponentURI\"")
+				return errors.Wrap(err, "decode field \"image\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem")
+		return errors.Wrap(err, "decode NodeBootImageRequest")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) MarshalJSON() ([]byte, error) {
+func (s *NodeBootImageRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) UnmarshalJSON(data []byte) error {
+func (s *NodeBootImageRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Encode(e *jx.Encoder) {
+func (s *NodeBootTokenResponse) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) encodeFields(e *jx.Encoder) {
+func (s *NodeBootTokenResponse) encodeFields(e *jx.Encoder) {
 	{
-		if s.AllowableNumbers != nil {
-			e.FieldStart("AllowableNumbers")
+		if s.Nodes != nil {
+			e.FieldStart("nodes")
 			e.ArrStart()
-			for _, elem := range s.AllowableNumbers {
-				e.Str(elem)
+			for _, elem := range s.Nodes {
+				elem.Encode(e)
 			}
 			e.ArrEnd()
 		}
 	}
-	{
-		if s.AllowablePattern.Set {
-			e.FieldStart("AllowablePattern")
-			s.AllowablePattern.Encode(e)
-		}
-	}
-	{
-		if s.AllowableValueDescriptions != nil {
-			e.FieldStart("AllowableValueDescriptions")
-			e.ArrStart()
-			for _, elem := range s.AllowableValueDescriptions {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
+}
+
+var jsonFieldsNameOfNodeBootTokenResponse = [1]string{
+	0: "nodes",
+}
+
+// Decode decodes NodeBootTokenResponse from json.
+func (s *NodeBootTokenResponse) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode NodeBootTokenResponse to nil")
 	}
-	{
-		if s.AllowableValues != nil {
-			e.FieldStart("AllowableValues")
-			e.ArrStart()
-			for _, elem := range s.AllowableValues {
-				e.Str(elem)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "nodes":
+			if err := func() error {
+				s.Nodes = make([]NodeBootTokenResponseNodesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NodeBootTokenResponseNodesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Nodes = append(s.Nodes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"nodes\"")
 			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.ArraySizeMaximum.Set {
-			e.FieldStart("ArraySizeMaximum")
-			s.ArraySizeMaximum.Encode(e)
-		}
-	}
-	{
-		if s.ArraySizeMinimum.Set {
-			e.FieldStart("ArraySizeMinimum")
-			s.ArraySizeMinimum.Encode(e)
-		}
-	}
-	{
-		if s.DataType.Set {
-			e.FieldStart("DataType")
-			s.DataType.Encode(e)
-		}
-	}
-	{
-		if s.DefaultValue.Set {
-			e.FieldStart("DefaultValue")
-			s.DefaultValue.Encode(e)
-		}
-	}
-	{
-		if s.MaximumValue.Set {
-			e.FieldStart("MaximumValue")
-			s.MaximumValue.Encode(e)
-		}
-	}
-	{
-		if s.MinimumValue.Set {
-			e.FieldStart("MinimumValue")
-			s.MinimumValue.Encode(e)
+		default:
+			return d.Skip()
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode NodeBootTokenResponse")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *NodeBootTokenResponse) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *NodeBootTokenResponse) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *NodeBootTokenResponseNodesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *NodeBootTokenResponseNodesItem) encodeFields(e *jx.Encoder) {
 	{
 		if s.Name.Set {
-			e.FieldStart("Name")
+			e.FieldStart("name")
 			s.Name.Encode(e)
 		}
 	}
 	{
-		if s.NoDefaultValue.Set {
-			e.FieldStart("NoDefaultValue")
-			s.NoDefaultValue.Encode(e)
-		}
-	}
-	{
-		if s.ObjectDataType.Set {
-			e.FieldStart("ObjectDataType")
-			s.ObjectDataType.Encode(e)
-		}
-	}
-	{
-		if s.Required.Set {
-			e.FieldStart("Required")
-			s.Required.Encode(e)
+		if s.Token.Set {
+			e.FieldStart("token")
+			s.Token.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem = [14]string{
-	0:  "AllowableNumbers",
-	1:  "AllowablePattern",
-	2:  "AllowableValueDescriptions",
-	3:  "AllowableValues",
-	4:  "ArraySizeMaximum",
-	5:  "ArraySizeMinimum",
-	6:  "DataType",
-	7:  "DefaultValue",
-	8:  "MaximumValue",
-	9:  "MinimumValue",
-	10: "Name",
-	11: "NoDefaultValue",
-	12: "ObjectDataType",
-	13: "Required",
+var jsonFieldsNameOfNodeBootTokenResponseNodesItem = [2]string{
+	0: "name",
+	1: "token",
 }
 
-// Decode decodes RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem from json.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Decode(d *jx.Decoder) error {
+// Decode decodes NodeBootTokenResponseNodesItem from json.
+func (s *NodeBootTokenResponseNodesItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem to nil")
+		return errors.New("invalid: unable to decode NodeBootTokenResponseNodesItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "AllowableNumbers":
-			if err := func() error {
-				s.AllowableNumbers = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableNumbers = append(s.AllowableNumbers, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableNumbers\"")
-			}
-		case "AllowablePattern":
-			if err := func() error {
-				s.AllowablePattern.Reset()
-				if err := s.AllowablePattern.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowablePattern\"")
-			}
-		case "AllowableValueDescriptions":
-			if err := func() error {
-				s.AllowableValueDescriptions = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableValueDescriptions\"")
-			}
-		case "AllowableValues":
-			if err := func() error {
-				s.AllowableValues = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableValues = append(s.AllowableValues, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableValues\"")
-			}
-		case "ArraySizeMaximum":
-			if err := func() error {
-				s.ArraySizeMaximum.Reset()
-				if err := s.ArraySizeMaximum.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ArraySizeMaximum\"")
-			}
-		case "ArraySizeMinimum":
-			if err := func() error {
-				s.ArraySizeMinimum.Reset()
-				if err := s.ArraySizeMinimum.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ArraySizeMinimum\"")
-			}
-		case "DataType":
-			if err := func() error {
-				s.DataType.Reset()
-				if err := s.DataType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"DataType\"")
-			}
-		case "DefaultValue":
-			if err := func() error {
-				s.DefaultValue.Reset()
-				if err := s.DefaultValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"DefaultValue\"")
-			}
-		case "MaximumValue":
-			if err := func() error {
-				s.MaximumValue.Reset()
-				if err := s.MaximumValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaximumValue\"")
-			}
-		case "MinimumValue":
-			if err := func() error {
-				s.MinimumValue.Reset()
-				if err := s.MinimumValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MinimumValue\"")
-			}
-		case "Name":
+		case "name":
 			if err := func() error {
 				s.Name.Reset()
 				if err := s.Name.Decode(d); err != nil {
@@ -10563,4550 +11058,10022 @@ func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionPa
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
-			}
-		case "NoDefaultValue":
-			if err := func() error {
-				s.NoDefaultValue.Reset()
-				if err := s.NoDefaultValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"NoDefaultValue\"")
-			}
-		case "ObjectDataType":
-			if err := func() error {
-				s.ObjectDataType.Reset()
-				if err := s.ObjectDataType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ObjectDataType\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "Required":
+		case "token":
 			if err := func() error {
-				s.Required.Reset()
-				if err := s.Required.Decode(d); err != nil {
+				s.Token.Reset()
+				if err := s.Token.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Required\"")
+				return errors.Wrap(err, "decode field \"token\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem")
+		return errors.Wrap(err, "decode NodeBootTokenResponseNodesItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) MarshalJSON() ([]byte, error) {
+func (s *NodeBootTokenResponseNodesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) UnmarshalJSON(data []byte) error {
+func (s *NodeBootTokenResponseNodesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RedfishJobJobsItemMessagesItem) Encode(e *jx.Encoder) {
+func (s *NodeProvisionRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RedfishJobJobsItemMessagesItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Message.Set {
-			e.FieldStart("Message")
-			s.Message.Encode(e)
-		}
-	}
-	{
-		if s.MessageArgs != nil {
-			e.FieldStart("MessageArgs")
-			e.ArrStart()
-			for _, elem := range s.MessageArgs {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.MessageId.Set {
-			e.FieldStart("MessageId")
-			s.MessageId.Encode(e)
-		}
-	}
-	{
-		if s.MessageSeverity.Set {
-			e.FieldStart("MessageSeverity")
-			s.MessageSeverity.Encode(e)
-		}
-	}
-	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
-		}
-	}
-	{
-		if s.RelatedProperties.Set {
-			e.FieldStart("RelatedProperties")
-			s.RelatedProperties.Encode(e)
-		}
-	}
-	{
-		if s.Resolution.Set {
-			e.FieldStart("Resolution")
-			s.Resolution.Encode(e)
-		}
-	}
-	{
-		if s.ResolutionSteps != nil {
-			e.FieldStart("ResolutionSteps")
-			e.ArrStart()
-			for _, elem := range s.ResolutionSteps {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.Severity.Set {
-			e.FieldStart("Severity")
-			s.Severity.Encode(e)
-		}
-	}
-	{
-		if s.UserAuthenticationSource.Set {
-			e.FieldStart("UserAuthenticationSource")
-			s.UserAuthenticationSource.Encode(e)
-		}
-	}
+func (s *NodeProvisionRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.Username.Set {
-			e.FieldStart("Username")
-			s.Username.Encode(e)
+		if s.Provision.Set {
+			e.FieldStart("provision")
+			s.Provision.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRedfishJobJobsItemMessagesItem = [11]string{
-	0:  "Message",
-	1:  "MessageArgs",
-	2:  "MessageId",
-	3:  "MessageSeverity",
-	4:  "Oem",
-	5:  "RelatedProperties",
-	6:  "Resolution",
-	7:  "ResolutionSteps",
-	8:  "Severity",
-	9:  "UserAuthenticationSource",
-	10: "Username",
+var jsonFieldsNameOfNodeProvisionRequest = [1]string{
+	0: "provision",
 }
 
-// Decode decodes RedfishJobJobsItemMessagesItem from json.
-func (s *RedfishJobJobsItemMessagesItem) Decode(d *jx.Decoder) error {
+// Decode decodes NodeProvisionRequest from json.
+func (s *NodeProvisionRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RedfishJobJobsItemMessagesItem to nil")
+		return errors.New("invalid: unable to decode NodeProvisionRequest to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Message":
-			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Message\"")
-			}
-		case "MessageArgs":
-			if err := func() error {
-				s.MessageArgs = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.MessageArgs = append(s.MessageArgs, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageArgs\"")
-			}
-		case "MessageId":
-			if err := func() error {
-				s.MessageId.Reset()
-				if err := s.MessageId.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageId\"")
-			}
-		case "MessageSeverity":
-			if err := func() error {
-				s.MessageSeverity.Reset()
-				if err := s.MessageSeverity.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageSeverity\"")
-			}
-		case "Oem":
-			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
-			}
-		case "RelatedProperties":
-			if err := func() error {
-				s.RelatedProperties.Reset()
-				if err := s.RelatedProperties.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RelatedProperties\"")
-			}
-		case "Resolution":
-			if err := func() error {
-				s.Resolution.Reset()
-				if err := s.Resolution.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Resolution\"")
-			}
-		case "ResolutionSteps":
-			if err := func() error {
-				s.ResolutionSteps = make([]RedfishJobJobsItemMessagesItemResolutionStepsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishJobJobsItemMessagesItemResolutionStepsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.ResolutionSteps = append(s.ResolutionSteps, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ResolutionSteps\"")
-			}
-		case "Severity":
-			if err := func() error {
-				s.Severity.Reset()
-				if err := s.Severity.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Severity\"")
-			}
-		case "UserAuthenticationSource":
-			if err := func() error {
-				s.UserAuthenticationSource.Reset()
-				if err := s.UserAuthenticationSource.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"UserAuthenticationSource\"")
-			}
-		case "Username":
+		case "provision":
 			if err := func() error {
-				s.Username.Reset()
-				if err := s.Username.Decode(d); err != nil {
+				s.Provision.Reset()
+				if err := s.Provision.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Username\"")
+				return errors.Wrap(err, "decode field \"provision\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishJobJobsItemMessagesItem")
+		return errors.Wrap(err, "decode NodeProvisionRequest")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishJobJobsItemMessagesItem) MarshalJSON() ([]byte, error) {
+func (s *NodeProvisionRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishJobJobsItemMessagesItem) UnmarshalJSON(data []byte) error {
+func (s *NodeProvisionRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) Encode(e *jx.Encoder) {
+func (s *NodeTagsRequest) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.ActionParameters != nil {
-			e.FieldStart("ActionParameters")
-			e.ArrStart()
-			for _, elem := range s.ActionParameters {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.ActionURI.Set {
-			e.FieldStart("ActionURI")
-			s.ActionURI.Encode(e)
-		}
-	}
-	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
-		}
-	}
-	{
-		if s.Priority.Set {
-			e.FieldStart("Priority")
-			s.Priority.Encode(e)
-		}
-	}
-	{
-		if s.ResolutionType.Set {
-			e.FieldStart("ResolutionType")
-			s.ResolutionType.Encode(e)
-		}
-	}
-	{
-		if s.RetryCount.Set {
-			e.FieldStart("RetryCount")
-			s.RetryCount.Encode(e)
-		}
-	}
-	{
-		if s.RetryIntervalSeconds.Set {
-			e.FieldStart("RetryIntervalSeconds")
-			s.RetryIntervalSeconds.Encode(e)
-		}
-	}
+func (s *NodeTagsRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.TargetComponentURI.Set {
-			e.FieldStart("TargetComponentURI")
-			s.TargetComponentURI.Encode(e)
+		if s.Tags.Set {
+			e.FieldStart("tags")
+			s.Tags.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRedfishJobJobsItemMessagesItemResolutionStepsItem = [8]string{
-	0: "ActionParameters",
-	1: "ActionURI",
-	2: "Oem",
-	3: "Priority",
-	4: "ResolutionType",
-	5: "RetryCount",
-	6: "RetryIntervalSeconds",
-	7: "TargetComponentURI",
+var jsonFieldsNameOfNodeTagsRequest = [1]string{
+	0: "tags",
 }
 
-// Decode decodes RedfishJobJobsItemMessagesItemResolutionStepsItem from json.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) Decode(d *jx.Decoder) error {
+// Decode decodes NodeTagsRequest from json.
+func (s *NodeTagsRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RedfishJobJobsItemMessagesItemResolutionStepsItem to nil")
+		return errors.New("invalid: unable to decode NodeTagsRequest to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ActionParameters":
-			if err := func() error {
-				s.ActionParameters = make([]RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.ActionParameters = append(s.ActionParameters, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ActionParameters\"")
-			}
-		case "ActionURI":
-			if err := func() error {
-				s.ActionURI.Reset()
-				if err := s.ActionURI.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ActionURI\"")
-			}
-		case "Oem":
-			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
-			}
-		case "Priority":
+		case "tags":
 			if err := func() error {
-				s.Priority.Reset()
-				if err := s.Priority.Decode(d); err != nil {
+				s.Tags.Reset()
+				if err := s.Tags.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Priority\"")
-			}
-		case "ResolutionType":
-			if err := func() error {
-				s.ResolutionType.Reset()
-				if err := s.ResolutionType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ResolutionType\"")
-			}
-		case "RetryCount":
-			if err := func() error {
-				s.RetryCount.Reset()
-				if err := s.RetryCount.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RetryCount\"")
-			}
-		case "RetryIntervalSeconds":
-			if err := func() error {
-				s.RetryIntervalSeconds.Reset()
-				if err := s.RetryIntervalSeconds.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RetryIntervalSeconds\"")
-			}
-		case "TargetComponentURI":
-			if err := func() error {
-				s.TargetComponentURI.Reset()
-				if err := s.TargetComponentURI.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TargetComponentURI\"")
+				return errors.Wrap(err, "decode field \"tags\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishJobJobsItemMessagesItemResolutionStepsItem")
+		return errors.Wrap(err, "decode NodeTagsRequest")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) MarshalJSON() ([]byte, error) {
+func (s *NodeTagsRequest) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) UnmarshalJSON(data []byte) error {
+func (s *NodeTagsRequest) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes BmcFirmwareTasksRequestTasks as json.
+func (o OptBmcFirmwareTasksRequestTasks) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.AllowableNumbers != nil {
-			e.FieldStart("AllowableNumbers")
-			e.ArrStart()
-			for _, elem := range s.AllowableNumbers {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.AllowablePattern.Set {
-			e.FieldStart("AllowablePattern")
-			s.AllowablePattern.Encode(e)
-		}
-	}
-	{
-		if s.AllowableValueDescriptions != nil {
-			e.FieldStart("AllowableValueDescriptions")
-			e.ArrStart()
-			for _, elem := range s.AllowableValueDescriptions {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.AllowableValues != nil {
-			e.FieldStart("AllowableValues")
-			e.ArrStart()
-			for _, elem := range s.AllowableValues {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
+// Decode decodes BmcFirmwareTasksRequestTasks from json.
+func (o *OptBmcFirmwareTasksRequestTasks) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBmcFirmwareTasksRequestTasks to nil")
 	}
-	{
-		if s.ArraySizeMaximum.Set {
-			e.FieldStart("ArraySizeMaximum")
-			s.ArraySizeMaximum.Encode(e)
-		}
+	o.Set = true
+	o.Value = make(BmcFirmwareTasksRequestTasks)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.ArraySizeMinimum.Set {
-			e.FieldStart("ArraySizeMinimum")
-			s.ArraySizeMinimum.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBmcFirmwareTasksRequestTasks) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBmcFirmwareTasksRequestTasks) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BmcJobDeleteRequestNodeJobList as json.
+func (o OptBmcJobDeleteRequestNodeJobList) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.DataType.Set {
-			e.FieldStart("DataType")
-			s.DataType.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes BmcJobDeleteRequestNodeJobList from json.
+func (o *OptBmcJobDeleteRequestNodeJobList) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBmcJobDeleteRequestNodeJobList to nil")
 	}
-	{
-		if s.DefaultValue.Set {
-			e.FieldStart("DefaultValue")
-			s.DefaultValue.Encode(e)
-		}
+	o.Set = true
+	o.Value = make(BmcJobDeleteRequestNodeJobList)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.MaximumValue.Set {
-			e.FieldStart("MaximumValue")
-			s.MaximumValue.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBmcJobDeleteRequestNodeJobList) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBmcJobDeleteRequestNodeJobList) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BmcTaskHostsItemRedfishError as json.
+func (o OptBmcTaskHostsItemRedfishError) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.MinimumValue.Set {
-			e.FieldStart("MinimumValue")
-			s.MinimumValue.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes BmcTaskHostsItemRedfishError from json.
+func (o *OptBmcTaskHostsItemRedfishError) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBmcTaskHostsItemRedfishError to nil")
 	}
-	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
-		}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.NoDefaultValue.Set {
-			e.FieldStart("NoDefaultValue")
-			s.NoDefaultValue.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBmcTaskHostsItemRedfishError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBmcTaskHostsItemRedfishError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BmcTaskHostsItemRedfishErrorError as json.
+func (o OptBmcTaskHostsItemRedfishErrorError) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.ObjectDataType.Set {
-			e.FieldStart("ObjectDataType")
-			s.ObjectDataType.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes BmcTaskHostsItemRedfishErrorError from json.
+func (o *OptBmcTaskHostsItemRedfishErrorError) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBmcTaskHostsItemRedfishErrorError to nil")
 	}
-	{
-		if s.Required.Set {
-			e.FieldStart("Required")
-			s.Required.Encode(e)
-		}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfRedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem = [14]string{
-	0:  "AllowableNumbers",
-	1:  "AllowablePattern",
-	2:  "AllowableValueDescriptions",
-	3:  "AllowableValues",
-	4:  "ArraySizeMaximum",
-	5:  "ArraySizeMinimum",
-	6:  "DataType",
-	7:  "DefaultValue",
-	8:  "MaximumValue",
-	9:  "MinimumValue",
-	10: "Name",
-	11: "NoDefaultValue",
-	12: "ObjectDataType",
-	13: "Required",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBmcTaskHostsItemRedfishErrorError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem from json.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBmcTaskHostsItemRedfishErrorError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	e.Bool(bool(o.Value))
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "AllowableNumbers":
-			if err := func() error {
-				s.AllowableNumbers = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableNumbers = append(s.AllowableNumbers, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableNumbers\"")
-			}
-		case "AllowablePattern":
-			if err := func() error {
-				s.AllowablePattern.Reset()
-				if err := s.AllowablePattern.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowablePattern\"")
-			}
-		case "AllowableValueDescriptions":
-			if err := func() error {
-				s.AllowableValueDescriptions = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableValueDescriptions\"")
-			}
-		case "AllowableValues":
-			if err := func() error {
-				s.AllowableValues = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableValues = append(s.AllowableValues, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableValues\"")
-			}
-		case "ArraySizeMaximum":
-			if err := func() error {
-				s.ArraySizeMaximum.Reset()
-				if err := s.ArraySizeMaximum.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ArraySizeMaximum\"")
-			}
-		case "ArraySizeMinimum":
-			if err := func() error {
-				s.ArraySizeMinimum.Reset()
-				if err := s.ArraySizeMinimum.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ArraySizeMinimum\"")
-			}
-		case "DataType":
-			if err := func() error {
-				s.DataType.Reset()
-				if err := s.DataType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"DataType\"")
-			}
-		case "DefaultValue":
-			if err := func() error {
-				s.DefaultValue.Reset()
-				if err := s.DefaultValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"DefaultValue\"")
-			}
-		case "MaximumValue":
-			if err := func() error {
-				s.MaximumValue.Reset()
-				if err := s.MaximumValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaximumValue\"")
-			}
-		case "MinimumValue":
-			if err := func() error {
-				s.MinimumValue.Reset()
-				if err := s.MinimumValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MinimumValue\"")
-			}
-		case "Name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
-			}
-		case "NoDefaultValue":
-			if err := func() error {
-				s.NoDefaultValue.Reset()
-				if err := s.NoDefaultValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"NoDefaultValue\"")
-			}
-		case "ObjectDataType":
-			if err := func() error {
-				s.ObjectDataType.Reset()
-				if err := s.ObjectDataType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ObjectDataType\"")
-			}
-		case "Required":
-			if err := func() error {
-				s.Required.Reset()
-				if err := s.Required.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Required\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem")
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
 	}
-
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) MarshalJSON() ([]byte, error) {
+func (s OptBool) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) UnmarshalJSON(data []byte) error {
+func (s *OptBool) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s RedfishJobJobsItemParameters) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s RedfishJobJobsItemParameters) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		if len(elem) != 0 {
-			e.Raw(elem)
-		}
+// Encode encodes time.Time as json.
+func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
 	}
+	format(e, o.Value)
 }
 
-// Decode decodes RedfishJobJobsItemParameters from json.
-func (s *RedfishJobJobsItemParameters) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishJobJobsItemParameters to nil")
+// Decode decodes time.Time from json.
+func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDateTime to nil")
 	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem jx.Raw
-		if err := func() error {
-			v, err := d.RawAppend(nil)
-			elem = jx.Raw(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RedfishJobJobsItemParameters")
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
 	}
-
+	o.Value = v
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s RedfishJobJobsItemParameters) MarshalJSON() ([]byte, error) {
+func (s OptDateTime) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
-	s.Encode(&e)
+	s.Encode(&e, json.EncodeDateTime)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishJobJobsItemParameters) UnmarshalJSON(data []byte) error {
+func (s *OptDateTime) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+	return s.Decode(d, json.DecodeDateTime)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishJobJobsItemPayload) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes EventJobMessagesItemRedfishError as json.
+func (o OptEventJobMessagesItemRedfishError) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *RedfishJobJobsItemPayload) encodeFields(e *jx.Encoder) {
-	{
-		if s.HttpHeaders.Set {
-			e.FieldStart("HttpHeaders")
-			s.HttpHeaders.Encode(e)
-		}
-	}
-	{
-		if s.HttpOperation.Set {
-			e.FieldStart("HttpOperation")
-			s.HttpOperation.Encode(e)
-		}
-	}
-	{
-		if s.JsonBody.Set {
-			e.FieldStart("JsonBody")
-			s.JsonBody.Encode(e)
-		}
+// Decode decodes EventJobMessagesItemRedfishError from json.
+func (o *OptEventJobMessagesItemRedfishError) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEventJobMessagesItemRedfishError to nil")
 	}
-	{
-		if s.TargetUri.Set {
-			e.FieldStart("TargetUri")
-			s.TargetUri.Encode(e)
-		}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfRedfishJobJobsItemPayload = [4]string{
-	0: "HttpHeaders",
-	1: "HttpOperation",
-	2: "JsonBody",
-	3: "TargetUri",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEventJobMessagesItemRedfishError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishJobJobsItemPayload from json.
-func (s *RedfishJobJobsItemPayload) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishJobJobsItemPayload to nil")
-	}
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEventJobMessagesItemRedfishError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "HttpHeaders":
-			if err := func() error {
-				s.HttpHeaders.Reset()
-				if err := s.HttpHeaders.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"HttpHeaders\"")
-			}
-		case "HttpOperation":
-			if err := func() error {
-				s.HttpOperation.Reset()
-				if err := s.HttpOperation.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"HttpOperation\"")
-			}
-		case "JsonBody":
-			if err := func() error {
-				s.JsonBody.Reset()
-				if err := s.JsonBody.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"JsonBody\"")
-			}
-		case "TargetUri":
-			if err := func() error {
-				s.TargetUri.Reset()
-				if err := s.TargetUri.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TargetUri\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RedfishJobJobsItemPayload")
+// Encode encodes EventJobMessagesItemRedfishErrorError as json.
+func (o OptEventJobMessagesItemRedfishErrorError) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
+}
 
+// Decode decodes EventJobMessagesItemRedfishErrorError from json.
+func (o *OptEventJobMessagesItemRedfishErrorError) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEventJobMessagesItemRedfishErrorError to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishJobJobsItemPayload) MarshalJSON() ([]byte, error) {
+func (s OptEventJobMessagesItemRedfishErrorError) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishJobJobsItemPayload) UnmarshalJSON(data []byte) error {
+func (s *OptEventJobMessagesItemRedfishErrorError) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishJobJobsItemSchedule) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes float32 as json.
+func (o OptFloat32) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Float32(float32(o.Value))
 }
 
-// encodeFields encodes fields.
-func (s *RedfishJobJobsItemSchedule) encodeFields(e *jx.Encoder) {
-	{
-		if s.EnabledDaysOfMonth.Set {
-			e.FieldStart("EnabledDaysOfMonth")
-			s.EnabledDaysOfMonth.Encode(e)
-		}
-	}
-	{
-		if s.EnabledDaysOfWeek.Set {
-			e.FieldStart("EnabledDaysOfWeek")
-			s.EnabledDaysOfWeek.Encode(e)
-		}
-	}
-	{
-		if s.EnabledIntervals.Set {
-			e.FieldStart("EnabledIntervals")
-			s.EnabledIntervals.Encode(e)
-		}
-	}
-	{
-		if s.EnabledMonthsOfYear.Set {
-			e.FieldStart("EnabledMonthsOfYear")
-			s.EnabledMonthsOfYear.Encode(e)
-		}
-	}
-	{
-		if s.InitialStartTime.Set {
-			e.FieldStart("InitialStartTime")
-			s.InitialStartTime.Encode(e)
-		}
+// Decode decodes float32 from json.
+func (o *OptFloat32) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptFloat32 to nil")
 	}
-	{
-		if s.Lifetime.Set {
-			e.FieldStart("Lifetime")
-			s.Lifetime.Encode(e)
-		}
+	o.Set = true
+	v, err := d.Float32()
+	if err != nil {
+		return err
 	}
-	{
-		if s.MaxOccurrences.Set {
-			e.FieldStart("MaxOccurrences")
-			s.MaxOccurrences.Encode(e)
-		}
+	o.Value = float32(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptFloat32) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptFloat32) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes float64 as json.
+func (o OptFloat64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
-		}
+	e.Float64(float64(o.Value))
+}
+
+// Decode decodes float64 from json.
+func (o *OptFloat64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptFloat64 to nil")
 	}
-	{
-		if s.RecurrenceInterval.Set {
-			e.FieldStart("RecurrenceInterval")
-			s.RecurrenceInterval.Encode(e)
-		}
+	o.Set = true
+	v, err := d.Float64()
+	if err != nil {
+		return err
 	}
+	o.Value = float64(v)
+	return nil
 }
 
-var jsonFieldsNameOfRedfishJobJobsItemSchedule = [9]string{
-	0: "EnabledDaysOfMonth",
-	1: "EnabledDaysOfWeek",
-	2: "EnabledIntervals",
-	3: "EnabledMonthsOfYear",
-	4: "InitialStartTime",
-	5: "Lifetime",
-	6: "MaxOccurrences",
-	7: "Name",
-	8: "RecurrenceInterval",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptFloat64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishJobJobsItemSchedule from json.
-func (s *RedfishJobJobsItemSchedule) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishJobJobsItemSchedule to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptFloat64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes HTTPErrorErrorsItemMore as json.
+func (o OptHTTPErrorErrorsItemMore) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "EnabledDaysOfMonth":
-			if err := func() error {
-				s.EnabledDaysOfMonth.Reset()
-				if err := s.EnabledDaysOfMonth.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EnabledDaysOfMonth\"")
-			}
-		case "EnabledDaysOfWeek":
-			if err := func() error {
-				s.EnabledDaysOfWeek.Reset()
-				if err := s.EnabledDaysOfWeek.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EnabledDaysOfWeek\"")
-			}
-		case "EnabledIntervals":
-			if err := func() error {
-				s.EnabledIntervals.Reset()
-				if err := s.EnabledIntervals.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EnabledIntervals\"")
-			}
-		case "EnabledMonthsOfYear":
-			if err := func() error {
-				s.EnabledMonthsOfYear.Reset()
-				if err := s.EnabledMonthsOfYear.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EnabledMonthsOfYear\"")
-			}
-		case "InitialStartTime":
-			if err := func() error {
-				s.InitialStartTime.Reset()
-				if err := s.InitialStartTime.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"InitialStartTime\"")
-			}
-		case "Lifetime":
-			if err := func() error {
-				s.Lifetime.Reset()
-				if err := s.Lifetime.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Lifetime\"")
-			}
-		case "MaxOccurrences":
-			if err := func() error {
-				s.MaxOccurrences.Reset()
-				if err := s.MaxOccurrences.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaxOccurrences\"")
-			}
-		case "Name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
-			}
-		case "RecurrenceInterval":
-			if err := func() error {
-				s.RecurrenceInterval.Reset()
-				if err := s.RecurrenceInterval.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RecurrenceInterval\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RedfishJobJobsItemSchedule")
+// Decode decodes HTTPErrorErrorsItemMore from json.
+func (o *OptHTTPErrorErrorsItemMore) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptHTTPErrorErrorsItemMore to nil")
+	}
+	o.Set = true
+	o.Value = make(HTTPErrorErrorsItemMore)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishJobJobsItemSchedule) MarshalJSON() ([]byte, error) {
+func (s OptHTTPErrorErrorsItemMore) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishJobJobsItemSchedule) UnmarshalJSON(data []byte) error {
+func (s *OptHTTPErrorErrorsItemMore) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishMetricReport) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
 }
 
-// encodeFields encodes fields.
-func (s *RedfishMetricReport) encodeFields(e *jx.Encoder) {
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
-		}
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
 	}
-	{
-		if s.Reports != nil {
-			e.FieldStart("reports")
-			e.ArrStart()
-			for _, elem := range s.Reports {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
 	}
+	o.Value = int(v)
+	return nil
 }
 
-var jsonFieldsNameOfRedfishMetricReport = [2]string{
-	0: "name",
-	1: "reports",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishMetricReport from json.
-func (s *RedfishMetricReport) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishMetricReport to nil")
-	}
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "reports":
-			if err := func() error {
-				s.Reports = make([]NilRedfishMetricReportReportsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem NilRedfishMetricReportReportsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Reports = append(s.Reports, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"reports\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RedfishMetricReport")
+// Encode encodes int64 as json.
+func (o OptInt64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	e.Int64(int64(o.Value))
+}
 
+// Decode decodes int64 from json.
+func (o *OptInt64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt64 to nil")
+	}
+	o.Set = true
+	v, err := d.Int64()
+	if err != nil {
+		return err
+	}
+	o.Value = int64(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishMetricReport) MarshalJSON() ([]byte, error) {
+func (s OptInt64) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishMetricReport) UnmarshalJSON(data []byte) error {
+func (s *OptInt64) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishMetricReportReportsItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes JobMessageRedfishError as json.
+func (o OptJobMessageRedfishError) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *RedfishMetricReportReportsItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.MessageDotExtendedInfo != nil {
-			e.FieldStart("@Message.ExtendedInfo")
-			e.ArrStart()
-			for _, elem := range s.MessageDotExtendedInfo {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.OdataDotContext.Set {
-			e.FieldStart("@odata.context")
-			s.OdataDotContext.Encode(e)
-		}
+// Decode decodes JobMessageRedfishError from json.
+func (o *OptJobMessageRedfishError) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptJobMessageRedfishError to nil")
 	}
-	{
-		if s.OdataDotEtag.Set {
-			e.FieldStart("@odata.etag")
-			s.OdataDotEtag.Encode(e)
-		}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.OdataDotID.Set {
-			e.FieldStart("@odata.id")
-			s.OdataDotID.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptJobMessageRedfishError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptJobMessageRedfishError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes JobMessageRedfishErrorError as json.
+func (o OptJobMessageRedfishErrorError) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.OdataDotType.Set {
-			e.FieldStart("@odata.type")
-			s.OdataDotType.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes JobMessageRedfishErrorError from json.
+func (o *OptJobMessageRedfishErrorError) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptJobMessageRedfishErrorError to nil")
 	}
-	{
-		if s.Context.Set {
-			e.FieldStart("Context")
-			s.Context.Encode(e)
-		}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.Description.Set {
-			e.FieldStart("Description")
-			s.Description.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptJobMessageRedfishErrorError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptJobMessageRedfishErrorError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BootImageAddRequestBootImagesItemProvisionTemplates as json.
+func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.ID.Set {
-			e.FieldStart("Id")
-			s.ID.Encode(e)
-		}
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.MetricValues != nil {
-			e.FieldStart("MetricValues")
-			e.ArrStart()
-			for _, elem := range s.MetricValues {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes BootImageAddRequestBootImagesItemProvisionTemplates from json.
+func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilBootImageAddRequestBootImagesItemProvisionTemplates to nil")
 	}
-	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v BootImageAddRequestBootImagesItemProvisionTemplates
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
-		}
+	o.Set = true
+	o.Null = false
+	o.Value = make(BootImageAddRequestBootImagesItemProvisionTemplates)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.ReportSequence.Set {
-			e.FieldStart("ReportSequence")
-			s.ReportSequence.Encode(e)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilBootImageAddRequestBootImagesItemProvisionTemplates) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BootImageProvisionTemplates as json.
+func (o OptNilBootImageProvisionTemplates) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes BootImageProvisionTemplates from json.
+func (o *OptNilBootImageProvisionTemplates) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilBootImageProvisionTemplates to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v BootImageProvisionTemplates
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if s.Timestamp.Set {
-			e.FieldStart("Timestamp")
-			s.Timestamp.Encode(e)
+	o.Set = true
+	o.Null = false
+	o.Value = make(BootImageProvisionTemplates)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilBootImageProvisionTemplates) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilBootImageProvisionTemplates) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BootImageVersionImage as json.
+func (o OptNilBootImageVersionImage) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes BootImageVersionImage from json.
+func (o *OptNilBootImageVersionImage) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilBootImageVersionImage to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v BootImageVersionImage
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfRedfishMetricReportReportsItem = [13]string{
-	0:  "@Message.ExtendedInfo",
-	1:  "@odata.context",
-	2:  "@odata.etag",
-	3:  "@odata.id",
-	4:  "@odata.type",
-	5:  "Context",
-	6:  "Description",
-	7:  "Id",
-	8:  "MetricValues",
-	9:  "Name",
-	10: "Oem",
-	11: "ReportSequence",
-	12: "Timestamp",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilBootImageVersionImage) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishMetricReportReportsItem from json.
-func (s *RedfishMetricReportReportsItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishMetricReportReportsItem to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilBootImageVersionImage) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BootImageVersionImageProvisionTemplates as json.
+func (o OptNilBootImageVersionImageProvisionTemplates) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
 	}
+	o.Value.Encode(e)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "@Message.ExtendedInfo":
-			if err := func() error {
-				s.MessageDotExtendedInfo = make([]RedfishMetricReportReportsItemMessageDotExtendedInfoItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@Message.ExtendedInfo\"")
-			}
-		case "@odata.context":
-			if err := func() error {
-				s.OdataDotContext.Reset()
-				if err := s.OdataDotContext.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.context\"")
-			}
-		case "@odata.etag":
-			if err := func() error {
-				s.OdataDotEtag.Reset()
-				if err := s.OdataDotEtag.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.etag\"")
-			}
-		case "@odata.id":
-			if err := func() error {
-				s.OdataDotID.Reset()
-				if err := s.OdataDotID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.id\"")
-			}
-		case "@odata.type":
-			if err := func() error {
-				s.OdataDotType.Reset()
-				if err := s.OdataDotType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.type\"")
-			}
-		case "Context":
-			if err := func() error {
-				s.Context.Reset()
-				if err := s.Context.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Context\"")
-			}
-		case "Description":
-			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
-			}
-		case "Id":
-			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Id\"")
-			}
-		case "MetricValues":
-			if err := func() error {
-				s.MetricValues = make([]RedfishMetricReportReportsItemMetricValuesItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishMetricReportReportsItemMetricValuesItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.MetricValues = append(s.MetricValues, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MetricValues\"")
-			}
-		case "Name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
-			}
-		case "Oem":
-			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
-			}
-		case "ReportSequence":
-			if err := func() error {
-				s.ReportSequence.Reset()
-				if err := s.ReportSequence.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ReportSequence\"")
-			}
-		case "Timestamp":
-			if err := func() error {
-				s.Timestamp.Reset()
-				if err := s.Timestamp.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Timestamp\"")
-			}
-		default:
-			return d.Skip()
+// Decode decodes BootImageVersionImageProvisionTemplates from json.
+func (o *OptNilBootImageVersionImageProvisionTemplates) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilBootImageVersionImageProvisionTemplates to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v BootImageVersionImageProvisionTemplates
+		o.Value = v
+		o.Set = true
+		o.Null = true
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RedfishMetricReportReportsItem")
 	}
-
+	o.Set = true
+	o.Null = false
+	o.Value = make(BootImageVersionImageProvisionTemplates)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishMetricReportReportsItem) MarshalJSON() ([]byte, error) {
+func (s OptNilBootImageVersionImageProvisionTemplates) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishMetricReportReportsItem) UnmarshalJSON(data []byte) error {
+func (s *OptNilBootImageVersionImageProvisionTemplates) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes DataDumpImagesItemProvisionTemplates as json.
+func (o OptNilDataDumpImagesItemProvisionTemplates) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Message.Set {
-			e.FieldStart("Message")
-			s.Message.Encode(e)
-		}
-	}
-	{
-		if s.MessageArgs != nil {
-			e.FieldStart("MessageArgs")
-			e.ArrStart()
-			for _, elem := range s.MessageArgs {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
+// Decode decodes DataDumpImagesItemProvisionTemplates from json.
+func (o *OptNilDataDumpImagesItemProvisionTemplates) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilDataDumpImagesItemProvisionTemplates to nil")
 	}
-	{
-		if s.MessageId.Set {
-			e.FieldStart("MessageId")
-			s.MessageId.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v DataDumpImagesItemProvisionTemplates
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if s.MessageSeverity.Set {
-			e.FieldStart("MessageSeverity")
-			s.MessageSeverity.Encode(e)
-		}
+	o.Set = true
+	o.Null = false
+	o.Value = make(DataDumpImagesItemProvisionTemplates)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilDataDumpImagesItemProvisionTemplates) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilDataDumpImagesItemProvisionTemplates) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes float64 as json.
+func (o OptNilFloat64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.RelatedProperties.Set {
-			e.FieldStart("RelatedProperties")
-			s.RelatedProperties.Encode(e)
-		}
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.Resolution.Set {
-			e.FieldStart("Resolution")
-			s.Resolution.Encode(e)
-		}
-	}
-	{
-		if s.ResolutionSteps != nil {
-			e.FieldStart("ResolutionSteps")
-			e.ArrStart()
-			for _, elem := range s.ResolutionSteps {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.Severity.Set {
-			e.FieldStart("Severity")
-			s.Severity.Encode(e)
-		}
+	e.Float64(float64(o.Value))
+}
+
+// Decode decodes float64 from json.
+func (o *OptNilFloat64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilFloat64 to nil")
 	}
-	{
-		if s.UserAuthenticationSource.Set {
-			e.FieldStart("UserAuthenticationSource")
-			s.UserAuthenticationSource.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v float64
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if s.Username.Set {
-			e.FieldStart("Username")
-			s.Username.Encode(e)
-		}
+	o.Set = true
+	o.Null = false
+	v, err := d.Float64()
+	if err != nil {
+		return err
 	}
+	o.Value = float64(v)
+	return nil
 }
 
-var jsonFieldsNameOfRedfishMetricReportReportsItemMessageDotExtendedInfoItem = [11]string{
-	0:  "Message",
-	1:  "MessageArgs",
-	2:  "MessageId",
-	3:  "MessageSeverity",
-	4:  "Oem",
-	5:  "RelatedProperties",
-	6:  "Resolution",
-	7:  "ResolutionSteps",
-	8:  "Severity",
-	9:  "UserAuthenticationSource",
-	10: "Username",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilFloat64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishMetricReportReportsItemMessageDotExtendedInfoItem from json.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishMetricReportReportsItemMessageDotExtendedInfoItem to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilFloat64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes []HTTPErrorErrorsItem as json.
+func (o OptNilHTTPErrorErrorsItemArray) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.ArrStart()
+	for _, elem := range o.Value {
+		elem.Encode(e)
 	}
+	e.ArrEnd()
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "Message":
-			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Message\"")
-			}
-		case "MessageArgs":
-			if err := func() error {
-				s.MessageArgs = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.MessageArgs = append(s.MessageArgs, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageArgs\"")
-			}
-		case "MessageId":
-			if err := func() error {
-				s.MessageId.Reset()
-				if err := s.MessageId.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageId\"")
-			}
-		case "MessageSeverity":
-			if err := func() error {
-				s.MessageSeverity.Reset()
-				if err := s.MessageSeverity.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageSeverity\"")
-			}
-		case "Oem":
-			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
-			}
-		case "RelatedProperties":
-			if err := func() error {
-				s.RelatedProperties.Reset()
-				if err := s.RelatedProperties.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RelatedProperties\"")
-			}
-		case "Resolution":
-			if err := func() error {
-				s.Resolution.Reset()
-				if err := s.Resolution.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Resolution\"")
-			}
-		case "ResolutionSteps":
-			if err := func() error {
-				s.ResolutionSteps = make([]RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.ResolutionSteps = append(s.ResolutionSteps, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ResolutionSteps\"")
-			}
-		case "Severity":
-			if err := func() error {
-				s.Severity.Reset()
-				if err := s.Severity.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Severity\"")
-			}
-		case "UserAuthenticationSource":
-			if err := func() error {
-				s.UserAuthenticationSource.Reset()
-				if err := s.UserAuthenticationSource.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"UserAuthenticationSource\"")
-			}
-		case "Username":
-			if err := func() error {
-				s.Username.Reset()
-				if err := s.Username.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Username\"")
-			}
-		default:
-			return d.Skip()
+// Decode decodes []HTTPErrorErrorsItem from json.
+func (o *OptNilHTTPErrorErrorsItemArray) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilHTTPErrorErrorsItemArray to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v []HTTPErrorErrorsItem
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	o.Value = make([]HTTPErrorErrorsItem, 0)
+	if err := d.Arr(func(d *jx.Decoder) error {
+		var elem HTTPErrorErrorsItem
+		if err := elem.Decode(d); err != nil {
+			return err
 		}
+		o.Value = append(o.Value, elem)
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishMetricReportReportsItemMessageDotExtendedInfoItem")
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) MarshalJSON() ([]byte, error) {
+func (s OptNilHTTPErrorErrorsItemArray) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) UnmarshalJSON(data []byte) error {
+func (s *OptNilHTTPErrorErrorsItemArray) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields encodes fields.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.ActionParameters != nil {
-			e.FieldStart("ActionParameters")
-			e.ArrStart()
-			for _, elem := range s.ActionParameters {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.ActionURI.Set {
-			e.FieldStart("ActionURI")
-			s.ActionURI.Encode(e)
-		}
-	}
-	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
-		}
-	}
-	{
-		if s.Priority.Set {
-			e.FieldStart("Priority")
-			s.Priority.Encode(e)
-		}
+// Encode encodes int as json.
+func (o OptNilInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.ResolutionType.Set {
-			e.FieldStart("ResolutionType")
-			s.ResolutionType.Encode(e)
-		}
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.RetryCount.Set {
-			e.FieldStart("RetryCount")
-			s.RetryCount.Encode(e)
-		}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptNilInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilInt to nil")
 	}
-	{
-		if s.RetryIntervalSeconds.Set {
-			e.FieldStart("RetryIntervalSeconds")
-			s.RetryIntervalSeconds.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v int
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if s.TargetComponentURI.Set {
-			e.FieldStart("TargetComponentURI")
-			s.TargetComponentURI.Encode(e)
-		}
+	o.Set = true
+	o.Null = false
+	v, err := d.Int()
+	if err != nil {
+		return err
 	}
+	o.Value = int(v)
+	return nil
 }
 
-var jsonFieldsNameOfRedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem = [8]string{
-	0: "ActionParameters",
-	1: "ActionURI",
-	2: "Oem",
-	3: "Priority",
-	4: "ResolutionType",
-	5: "RetryCount",
-	6: "RetryIntervalSeconds",
-	7: "TargetComponentURI",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem from json.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int64 as json.
+func (o OptNilInt64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
 	}
+	e.Int64(int64(o.Value))
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "ActionParameters":
-			if err := func() error {
-				s.ActionParameters = make([]RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.ActionParameters = append(s.ActionParameters, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ActionParameters\"")
-			}
-		case "ActionURI":
-			if err := func() error {
-				s.ActionURI.Reset()
-				if err := s.ActionURI.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ActionURI\"")
-			}
-		case "Oem":
-			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
-			}
-		case "Priority":
-			if err := func() error {
-				s.Priority.Reset()
-				if err := s.Priority.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Priority\"")
-			}
-		case "ResolutionType":
-			if err := func() error {
-				s.ResolutionType.Reset()
-				if err := s.ResolutionType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ResolutionType\"")
-			}
-		case "RetryCount":
-			if err := func() error {
-				s.RetryCount.Reset()
-				if err := s.RetryCount.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RetryCount\"")
-			}
-		case "RetryIntervalSeconds":
-			if err := func() error {
-				s.RetryIntervalSeconds.Reset()
-				if err := s.RetryIntervalSeconds.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RetryIntervalSeconds\"")
-			}
-		case "TargetComponentURI":
-			if err := func() error {
-				s.TargetComponentURI.Reset()
-				if err := s.TargetComponentURI.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TargetComponentURI\"")
-			}
-		default:
-			return d.Skip()
+// Decode decodes int64 from json.
+func (o *OptNilInt64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilInt64 to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v int64
+		o.Value = v
+		o.Set = true
+		o.Null = true
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem")
 	}
-
+	o.Set = true
+	o.Null = false
+	v, err := d.Int64()
+	if err != nil {
+		return err
+	}
+	o.Value = int64(v)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) MarshalJSON() ([]byte, error) {
+func (s OptNilInt64) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) UnmarshalJSON(data []byte) error {
+func (s *OptNilInt64) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem as json.
+func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.ArrStart()
+	for _, elem := range o.Value {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
 }
 
-// encodeFields encodes fields.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.AllowableNumbers != nil {
-			e.FieldStart("AllowableNumbers")
-			e.ArrStart()
-			for _, elem := range s.AllowableNumbers {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
+// Decode decodes []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem from json.
+func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray to nil")
 	}
-	{
-		if s.AllowablePattern.Set {
-			e.FieldStart("AllowablePattern")
-			s.AllowablePattern.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if s.AllowableValueDescriptions != nil {
-			e.FieldStart("AllowableValueDescriptions")
-			e.ArrStart()
-			for _, elem := range s.AllowableValueDescriptions {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+	o.Set = true
+	o.Null = false
+	o.Value = make([]JobMessageRedfishErrorErrorMessageDotExtendedInfoItem, 0)
+	if err := d.Arr(func(d *jx.Decoder) error {
+		var elem JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+		if err := elem.Decode(d); err != nil {
+			return err
 		}
+		o.Value = append(o.Value, elem)
+		return nil
+	}); err != nil {
+		return err
 	}
-	{
-		if s.AllowableValues != nil {
-			e.FieldStart("AllowableValues")
-			e.ArrStart()
-			for _, elem := range s.AllowableValues {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes []NilInt as json.
+func (o OptNilNilIntArray) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.ArraySizeMaximum.Set {
-			e.FieldStart("ArraySizeMaximum")
-			s.ArraySizeMaximum.Encode(e)
-		}
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.ArraySizeMinimum.Set {
-			e.FieldStart("ArraySizeMinimum")
-			s.ArraySizeMinimum.Encode(e)
-		}
+	e.ArrStart()
+	for _, elem := range o.Value {
+		elem.Encode(e)
 	}
-	{
-		if s.DataType.Set {
-			e.FieldStart("DataType")
-			s.DataType.Encode(e)
-		}
+	e.ArrEnd()
+}
+
+// Decode decodes []NilInt from json.
+func (o *OptNilNilIntArray) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilNilIntArray to nil")
 	}
-	{
-		if s.DefaultValue.Set {
-			e.FieldStart("DefaultValue")
-			s.DefaultValue.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v []NilInt
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if s.MaximumValue.Set {
-			e.FieldStart("MaximumValue")
-			s.MaximumValue.Encode(e)
+	o.Set = true
+	o.Null = false
+	o.Value = make([]NilInt, 0)
+	if err := d.Arr(func(d *jx.Decoder) error {
+		var elem NilInt
+		if err := elem.Decode(d); err != nil {
+			return err
 		}
+		o.Value = append(o.Value, elem)
+		return nil
+	}); err != nil {
+		return err
 	}
-	{
-		if s.MinimumValue.Set {
-			e.FieldStart("MinimumValue")
-			s.MinimumValue.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilNilIntArray) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilNilIntArray) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes []NilRedfishJobJobsItem as json.
+func (o OptNilNilRedfishJobJobsItemArray) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
-		}
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.NoDefaultValue.Set {
-			e.FieldStart("NoDefaultValue")
-			s.NoDefaultValue.Encode(e)
-		}
+	e.ArrStart()
+	for _, elem := range o.Value {
+		elem.Encode(e)
 	}
-	{
-		if s.ObjectDataType.Set {
-			e.FieldStart("ObjectDataType")
-			s.ObjectDataType.Encode(e)
+	e.ArrEnd()
+}
+
+// Decode decodes []NilRedfishJobJobsItem from json.
+func (o *OptNilNilRedfishJobJobsItemArray) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilNilRedfishJobJobsItemArray to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v []NilRedfishJobJobsItem
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if s.Required.Set {
-			e.FieldStart("Required")
-			s.Required.Encode(e)
+	o.Set = true
+	o.Null = false
+	o.Value = make([]NilRedfishJobJobsItem, 0)
+	if err := d.Arr(func(d *jx.Decoder) error {
+		var elem NilRedfishJobJobsItem
+		if err := elem.Decode(d); err != nil {
+			return err
 		}
+		o.Value = append(o.Value, elem)
+		return nil
+	}); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfRedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem = [14]string{
-	0:  "AllowableNumbers",
-	1:  "AllowablePattern",
-	2:  "AllowableValueDescriptions",
-	3:  "AllowableValues",
-	4:  "ArraySizeMaximum",
-	5:  "ArraySizeMinimum",
-	6:  "DataType",
-	7:  "DefaultValue",
-	8:  "MaximumValue",
-	9:  "MinimumValue",
-	10: "Name",
-	11: "NoDefaultValue",
-	12: "ObjectDataType",
-	13: "Required",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilNilRedfishJobJobsItemArray) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem from json.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilNilRedfishJobJobsItemArray) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes []NilString as json.
+func (o OptNilNilStringArray) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.ArrStart()
+	for _, elem := range o.Value {
+		elem.Encode(e)
 	}
+	e.ArrEnd()
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "AllowableNumbers":
-			if err := func() error {
-				s.AllowableNumbers = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableNumbers = append(s.AllowableNumbers, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableNumbers\"")
-			}
-		case "AllowablePattern":
-			if err := func() error {
-				s.AllowablePattern.Reset()
-				if err := s.AllowablePattern.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowablePattern\"")
-			}
-		case "AllowableValueDescriptions":
-			if err := func() error {
-				s.AllowableValueDescriptions = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableValueDescriptions\"")
-			}
-		case "AllowableValues":
-			if err := func() error {
-				s.AllowableValues = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableValues = append(s.AllowableValues, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableValues\"")
-			}
-		case "ArraySizeMaximum":
-			if err := func() error {
-				s.ArraySizeMaximum.Reset()
-				if err := s.ArraySizeMaximum.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ArraySizeMaximum\"")
-			}
-		case "ArraySizeMinimum":
-			if err := func() error {
-				s.ArraySizeMinimum.Reset()
-				if err := s.ArraySizeMinimum.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ArraySizeMinimum\"")
-			}
-		case "DataType":
-			if err := func() error {
-				s.DataType.Reset()
-				if err := s.DataType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"DataType\"")
-			}
-		case "DefaultValue":
-			if err := func() error {
-				s.DefaultValue.Reset()
-				if err := s.DefaultValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"DefaultValue\"")
-			}
-		case "MaximumValue":
-			if err := func() error {
-				s.MaximumValue.Reset()
-				if err := s.MaximumValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaximumValue\"")
-			}
-		case "MinimumValue":
-			if err := func() error {
-				s.MinimumValue.Reset()
-				if err := s.MinimumValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MinimumValue\"")
-			}
-		case "Name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
-			}
-		case "NoDefaultValue":
-			if err := func() error {
-				s.NoDefaultValue.Reset()
-				if err := s.NoDefaultValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"NoDefaultValue\"")
-			}
-		case "ObjectDataType":
-			if err := func() error {
-				s.ObjectDataType.Reset()
-				if err := s.ObjectDataType.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ObjectDataType\"")
-			}
-		case "Required":
-			if err := func() error {
-				s.Required.Reset()
-				if err := s.Required.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Required\"")
-			}
-		default:
-			return d.Skip()
+// Decode decodes []NilString from json.
+func (o *OptNilNilStringArray) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilNilStringArray to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v []NilString
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	o.Value = make([]NilString, 0)
+	if err := d.Arr(func(d *jx.Decoder) error {
+		var elem NilString
+		if err := elem.Decode(d); err != nil {
+			return err
+		}
+		o.Value = append(o.Value, elem)
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem")
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) MarshalJSON() ([]byte, error) {
+func (s OptNilNilStringArray) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) UnmarshalJSON(data []byte) error {
+func (s *OptNilNilStringArray) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes RedfishSystemOemDell as json.
+func (o OptNilRedfishSystemOemDell) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.MetricId.Set {
-			e.FieldStart("MetricId")
-			s.MetricId.Encode(e)
-		}
-	}
-	{
-		if s.MetricProperty.Set {
-			e.FieldStart("MetricProperty")
-			s.MetricProperty.Encode(e)
-		}
+// Decode decodes RedfishSystemOemDell from json.
+func (o *OptNilRedfishSystemOemDell) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilRedfishSystemOemDell to nil")
 	}
-	{
-		if s.MetricValue.Set {
-			e.FieldStart("MetricValue")
-			s.MetricValue.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v RedfishSystemOemDell
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
-		}
-	}
-	{
-		if s.Timestamp.Set {
-			e.FieldStart("Timestamp")
-			s.Timestamp.Encode(e)
-		}
+	o.Set = true
+	o.Null = false
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfRedfishMetricReportReportsItemMetricValuesItem = [5]string{
-	0: "MetricId",
-	1: "MetricProperty",
-	2: "MetricValue",
-	3: "Oem",
-	4: "Timestamp",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilRedfishSystemOemDell) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishMetricReportReportsItemMetricValuesItem from json.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishMetricReportReportsItemMetricValuesItem to nil")
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilRedfishSystemOemDell) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes []RedfishSystemOemDellMessageDotExtendedInfoItem as json.
+func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.ArrStart()
+	for _, elem := range o.Value {
+		elem.Encode(e)
 	}
+	e.ArrEnd()
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "MetricId":
-			if err := func() error {
-				s.MetricId.Reset()
-				if err := s.MetricId.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MetricId\"")
-			}
-		case "MetricProperty":
-			if err := func() error {
-				s.MetricProperty.Reset()
-				if err := s.MetricProperty.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MetricProperty\"")
-			}
-		case "MetricValue":
-			if err := func() error {
-				s.MetricValue.Reset()
-				if err := s.MetricValue.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MetricValue\"")
-			}
-		case "Oem":
-			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
-			}
-		case "Timestamp":
-			if err := func() error {
-				s.Timestamp.Reset()
-				if err := s.Timestamp.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Timestamp\"")
-			}
-		default:
-			return d.Skip()
+// Decode decodes []RedfishSystemOemDellMessageDotExtendedInfoItem from json.
+func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v []RedfishSystemOemDellMessageDotExtendedInfoItem
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	o.Value = make([]RedfishSystemOemDellMessageDotExtendedInfoItem, 0)
+	if err := d.Arr(func(d *jx.Decoder) error {
+		var elem RedfishSystemOemDellMessageDotExtendedInfoItem
+		if err := elem.Decode(d); err != nil {
+			return err
 		}
+		o.Value = append(o.Value, elem)
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishMetricReportReportsItemMetricValuesItem")
+		return err
 	}
-
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) MarshalJSON() ([]byte, error) {
+func (s OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) UnmarshalJSON(data []byte) error {
+func (s *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishSystem) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes string as json.
+func (o OptNilString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	e.Str(string(o.Value))
 }
 
-// encodeFields encodes fields.
-func (s *RedfishSystem) encodeFields(e *jx.Encoder) {
-	{
-		if s.BiosVersion.Set {
-			e.FieldStart("bios_version")
-			s.BiosVersion.Encode(e)
-		}
+// Decode decodes string from json.
+func (o *OptNilString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilString to nil")
 	}
-	{
-		if s.BootNext.Set {
-			e.FieldStart("boot_next")
-			s.BootNext.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v string
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if s.BootOrder.Set {
-			e.FieldStart("boot_order")
-			s.BootOrder.Encode(e)
-		}
+	o.Set = true
+	o.Null = false
+	v, err := d.Str()
+	if err != nil {
+		return err
 	}
-	{
-		if s.Health.Set {
-			e.FieldStart("health")
-			s.Health.Encode(e)
-		}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes []string as json.
+func (o OptNilStringArray) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.HostName.Set {
-			e.FieldStart("host_name")
-			s.HostName.Encode(e)
-		}
+	if o.Null {
+		e.Null()
+		return
 	}
-	{
-		if s.Manufacturer.Set {
-			e.FieldStart("manufacturer")
-			s.Manufacturer.Encode(e)
-		}
+	e.ArrStart()
+	for _, elem := range o.Value {
+		e.Str(elem)
 	}
-	{
-		if s.Model.Set {
-			e.FieldStart("model")
-			s.Model.Encode(e)
-		}
+	e.ArrEnd()
+}
+
+// Decode decodes []string from json.
+func (o *OptNilStringArray) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilStringArray to nil")
 	}
-	{
-		if s.Name.Set {
-			e.FieldStart("name")
-			s.Name.Encode(e)
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
 		}
+
+		var v []string
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
 	}
-	{
-		if s.OemDell.Set {
-			e.FieldStart("oem_dell")
-			s.OemDell.Encode(e)
-		}
-	}
-	{
-		if s.PowerStatus.Set {
-			e.FieldStart("power_status")
-			s.PowerStatus.Encode(e)
+	o.Set = true
+	o.Null = false
+	o.Value = make([]string, 0)
+	if err := d.Arr(func(d *jx.Decoder) error {
+		var elem string
+		v, err := d.Str()
+		elem = string(v)
+		if err != nil {
+			return err
 		}
+		o.Value = append(o.Value, elem)
+		return nil
+	}); err != nil {
+		return err
 	}
-	{
-		if s.ProcessorCount.Set {
-			e.FieldStart("processor_count")
-			s.ProcessorCount.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilStringArray) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilStringArray) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RedfishJobJobsItemParameters as json.
+func (o OptRedfishJobJobsItemParameters) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.SerialNumber.Set {
-			e.FieldStart("serial_number")
-			s.SerialNumber.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes RedfishJobJobsItemParameters from json.
+func (o *OptRedfishJobJobsItemParameters) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptRedfishJobJobsItemParameters to nil")
 	}
-	{
-		if s.TotalMemory.Set {
-			e.FieldStart("total_memory")
-			s.TotalMemory.Encode(e)
-		}
+	o.Set = true
+	o.Value = make(RedfishJobJobsItemParameters)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
+	return nil
 }
 
-var jsonFieldsNameOfRedfishSystem = [13]string{
-	0:  "bios_version",
-	1:  "boot_next",
-	2:  "boot_order",
-	3:  "health",
-	4:  "host_name",
-	5:  "manufacturer",
-	6:  "model",
-	7:  "name",
-	8:  "oem_dell",
-	9:  "power_status",
-	10: "processor_count",
-	11: "serial_number",
-	12: "total_memory",
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptRedfishJobJobsItemParameters) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
 }
 
-// Decode decodes RedfishSystem from json.
-func (s *RedfishSystem) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode RedfishSystem to nil")
-	}
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptRedfishJobJobsItemParameters) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
 
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "bios_version":
-			if err := func() error {
-				s.BiosVersion.Reset()
-				if err := s.BiosVersion.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"bios_version\"")
-			}
-		case "boot_next":
-			if err := func() error {
-				s.BootNext.Reset()
-				if err := s.BootNext.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"boot_next\"")
-			}
-		case "boot_order":
-			if err := func() error {
-				s.BootOrder.Reset()
-				if err := s.BootOrder.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"boot_order\"")
-			}
-		case "health":
-			if err := func() error {
-				s.Health.Reset()
-				if err := s.Health.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"health\"")
-			}
-		case "host_name":
-			if err := func() error {
-				s.HostName.Reset()
-				if err := s.HostName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"host_name\"")
-			}
-		case "manufacturer":
-			if err := func() error {
-				s.Manufacturer.Reset()
-				if err := s.Manufacturer.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"manufacturer\"")
-			}
-		case "model":
-			if err := func() error {
-				s.Model.Reset()
-				if err := s.Model.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"model\"")
-			}
-		case "name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "oem_dell":
-			if err := func() error {
-				s.OemDell.Reset()
-				if err := s.OemDell.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"oem_dell\"")
-			}
-		case "power_status":
-			if err := func() error {
-				s.PowerStatus.Reset()
-				if err := s.PowerStatus.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"power_status\"")
-			}
-		case "processor_count":
-			if err := func() error {
-				s.ProcessorCount.Reset()
-				if err := s.ProcessorCount.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"processor_count\"")
-			}
-		case "serial_number":
-			if err := func() error {
-				s.SerialNumber.Reset()
-				if err := s.SerialNumber.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"serial_number\"")
-			}
-		case "total_memory":
-			if err := func() error {
-				s.TotalMemory.Reset()
-				if err := s.TotalMemory.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"total_memory\"")
-			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode RedfishSystem")
+// Encode encodes RedfishJobJobsItemPayload as json.
+func (o OptRedfishJobJobsItemPayload) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
+	o.Value.Encode(e)
+}
 
+// Decode decodes RedfishJobJobsItemPayload from json.
+func (o *OptRedfishJobJobsItemPayload) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptRedfishJobJobsItemPayload to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishSystem) MarshalJSON() ([]byte, error) {
+func (s OptRedfishJobJobsItemPayload) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishSystem) UnmarshalJSON(data []byte) error {
+func (s *OptRedfishJobJobsItemPayload) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *RedfishSystemOemDell) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes RedfishJobJobsItemSchedule as json.
+func (o OptRedfishJobJobsItemSchedule) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
 }
 
-// encodeFields encodes fields.
-func (s *RedfishSystemOemDell) encodeFields(e *jx.Encoder) {
-	{
-		if s.MessageDotExtendedInfo.Set {
-			e.FieldStart("@Message.ExtendedInfo")
-			s.MessageDotExtendedInfo.Encode(e)
-		}
-	}
-	{
-		if s.OdataDotContext.Set {
-			e.FieldStart("@odata.context")
-			s.OdataDotContext.Encode(e)
-		}
+// Decode decodes RedfishJobJobsItemSchedule from json.
+func (o *OptRedfishJobJobsItemSchedule) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptRedfishJobJobsItemSchedule to nil")
 	}
-	{
-		if s.OdataDotEtag.Set {
-			e.FieldStart("@odata.etag")
-			s.OdataDotEtag.Encode(e)
-		}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.OdataDotID.Set {
-			e.FieldStart("@odata.id")
-			s.OdataDotID.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptRedfishJobJobsItemSchedule) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptRedfishJobJobsItemSchedule) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes RolloutPrevious as json.
+func (o OptRolloutPrevious) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.OdataDotType.Set {
-			e.FieldStart("@odata.type")
-			s.OdataDotType.Encode(e)
-		}
+	o.Value.Encode(e)
+}
+
+// Decode decodes RolloutPrevious from json.
+func (o *OptRolloutPrevious) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptRolloutPrevious to nil")
 	}
-	{
-		if s.BIOSReleaseDate.Set {
-			e.FieldStart("BIOSReleaseDate")
-			s.BIOSReleaseDate.Encode(e)
-		}
+	o.Set = true
+	o.Value = make(RolloutPrevious)
+	if err := o.Value.Decode(d); err != nil {
+		return err
 	}
-	{
-		if s.BaseBoardChassisSlot.Set {
-			e.FieldStart("BaseBoardChassisSlot")
-			s.BaseBoardChassisSlot.Encode(e)
-		}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptRolloutPrevious) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptRolloutPrevious) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
 	}
-	{
-		if s.BatteryRollupStatus.Set {
-			e.FieldStart("BatteryRollupStatus")
-			s.BatteryRollupStatus.Encode(e)
-		}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptString to nil")
 	}
-	{
-		if s.BladeGeometry.Set {
-			e.FieldStart("BladeGeometry")
-			s.BladeGeometry.Encode(e)
-		}
+	o.Set = true
+	v, err := d.Str()
+	if err != nil {
+		return err
 	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PatchRolesRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PatchRolesRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.CMCIP.Set {
-			e.FieldStart("CMCIP")
-			s.CMCIP.Encode(e)
+		if s.PermissionList != nil {
+			e.FieldStart("permission_list")
+			e.ArrStart()
+			for _, elem := range s.PermissionList {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.CPURollupStatus.Set {
-			e.FieldStart("CPURollupStatus")
-			s.CPURollupStatus.Encode(e)
+		if s.Role.Set {
+			e.FieldStart("role")
+			s.Role.Encode(e)
 		}
 	}
-	{
-		if s.ChassisModel.Set {
-			e.FieldStart("ChassisModel")
-			s.ChassisModel.Encode(e)
-		}
+}
+
+var jsonFieldsNameOfPatchRolesRequest = [2]string{
+	0: "permission_list",
+	1: "role",
+}
+
+// Decode decodes PatchRolesRequest from json.
+func (s *PatchRolesRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatchRolesRequest to nil")
 	}
-	{
-		if s.ChassisName.Set {
-			e.FieldStart("ChassisName")
-			s.ChassisName.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "permission_list":
+			if err := func() error {
+				s.PermissionList = make([]PatchRolesRequestPermissionListItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PatchRolesRequestPermissionListItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.PermissionList = append(s.PermissionList, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"permission_list\"")
+			}
+		case "role":
+			if err := func() error {
+				s.Role.Reset()
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		default:
+			return d.Skip()
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PatchRolesRequest")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PatchRolesRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PatchRolesRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PatchRolesRequestPermissionListItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PatchRolesRequestPermissionListItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.ChassisServiceTag.Set {
-			e.FieldStart("ChassisServiceTag")
-			s.ChassisServiceTag.Encode(e)
+		if s.Method.Set {
+			e.FieldStart("method")
+			s.Method.Encode(e)
 		}
 	}
 	{
-		if s.ChassisSystemHeightUnit.Set {
-			e.FieldStart("ChassisSystemHeightUnit")
-			s.ChassisSystemHeightUnit.Encode(e)
+		if s.Path.Set {
+			e.FieldStart("path")
+			s.Path.Encode(e)
 		}
 	}
-	{
-		if s.CurrentRollupStatus.Set {
-			e.FieldStart("CurrentRollupStatus")
-			s.CurrentRollupStatus.Encode(e)
-		}
+}
+
+var jsonFieldsNameOfPatchRolesRequestPermissionListItem = [2]string{
+	0: "method",
+	1: "path",
+}
+
+// Decode decodes PatchRolesRequestPermissionListItem from json.
+func (s *PatchRolesRequestPermissionListItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PatchRolesRequestPermissionListItem to nil")
 	}
-	{
-		if s.Description.Set {
-			e.FieldStart("Description")
-			s.Description.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "method":
+			if err := func() error {
+				s.Method.Reset()
+				if err := s.Method.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"method\"")
+			}
+		case "path":
+			if err := func() error {
+				s.Path.Reset()
+				if err := s.Path.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"path\"")
+			}
+		default:
+			return d.Skip()
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PatchRolesRequestPermissionListItem")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PatchRolesRequestPermissionListItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PatchRolesRequestPermissionListItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PostRolesRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PostRolesRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.EstimatedExhaustTemperatureCelsius.Set {
-			e.FieldStart("EstimatedExhaustTemperatureCelsius")
-			s.EstimatedExhaustTemperatureCelsius.Encode(e)
+		if s.InheritedRole.Set {
+			e.FieldStart("inherited_role")
+			s.InheritedRole.Encode(e)
 		}
 	}
 	{
-		if s.EstimatedSystemAirflowCFM.Set {
-			e.FieldStart("EstimatedSystemAirflowCFM")
-			s.EstimatedSystemAirflowCFM.Encode(e)
+		if s.Role.Set {
+			e.FieldStart("role")
+			s.Role.Encode(e)
 		}
 	}
-	{
-		if s.ExpressServiceCode.Set {
-			e.FieldStart("ExpressServiceCode")
-			s.ExpressServiceCode.Encode(e)
-		}
+}
+
+var jsonFieldsNameOfPostRolesRequest = [2]string{
+	0: "inherited_role",
+	1: "role",
+}
+
+// Decode decodes PostRolesRequest from json.
+func (s *PostRolesRequest) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PostRolesRequest to nil")
 	}
-	{
-		if s.FanRollupStatus.Set {
-			e.FieldStart("FanRollupStatus")
-			s.FanRollupStatus.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "inherited_role":
+			if err := func() error {
+				s.InheritedRole.Reset()
+				if err := s.InheritedRole.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"inherited_role\"")
+			}
+		case "role":
+			if err := func() error {
+				s.Role.Reset()
+				if err := s.Role.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"role\"")
+			}
+		default:
+			return d.Skip()
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PostRolesRequest")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PostRolesRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PostRolesRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProvisionEvent) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProvisionEvent) encodeFields(e *jx.Encoder) {
 	{
-		if s.IDSDMRollupStatus.Set {
-			e.FieldStart("IDSDMRollupStatus")
-			s.IDSDMRollupStatus.Encode(e)
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
 		}
 	}
 	{
 		if s.ID.Set {
-			e.FieldStart("Id")
+			e.FieldStart("id")
 			s.ID.Encode(e)
 		}
 	}
 	{
-		if s.IntrusionRollupStatus.Set {
-			e.FieldStart("IntrusionRollupStatus")
-			s.IntrusionRollupStatus.Encode(e)
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
 		}
 	}
 	{
-		if s.IsOEMBranded.Set {
-			e.FieldStart("IsOEMBranded")
-			s.IsOEMBranded.Encode(e)
+		if s.State.Set {
+			e.FieldStart("state")
+			s.State.Encode(e)
 		}
 	}
 	{
-		if s.LastSystemInventoryTime.Set {
-			e.FieldStart("LastSystemInventoryTime")
-			s.LastSystemInventoryTime.Encode(e)
+		if s.Time.Set {
+			e.FieldStart("time")
+			s.Time.Encode(e, json.EncodeDateTime)
 		}
 	}
-	{
-		if s.LastUpdateTime.Set {
-			e.FieldStart("LastUpdateTime")
-			s.LastUpdateTime.Encode(e)
-		}
+}
+
+var jsonFieldsNameOfProvisionEvent = [5]string{
+	0: "host",
+	1: "id",
+	2: "message",
+	3: "state",
+	4: "time",
+}
+
+// Decode decodes ProvisionEvent from json.
+func (s *ProvisionEvent) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProvisionEvent to nil")
 	}
-	{
-		if s.LicensingRollupStatus.Set {
-			e.FieldStart("LicensingRollupStatus")
-			s.LicensingRollupStatus.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "host":
+			if err := func() error {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"host\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "state":
+			if err := func() error {
+				s.State.Reset()
+				if err := s.State.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"state\"")
+			}
+		case "time":
+			if err := func() error {
+				s.Time.Reset()
+				if err := s.Time.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"time\"")
+			}
+		default:
+			return d.Skip()
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProvisionEvent")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProvisionEvent) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProvisionEvent) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ProvisionLog) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ProvisionLog) encodeFields(e *jx.Encoder) {
 	{
-		if s.ManagedSystemSize.Set {
-			e.FieldStart("ManagedSystemSize")
-			s.ManagedSystemSize.Encode(e)
+		if s.Content.Set {
+			e.FieldStart("content")
+			s.Content.Encode(e)
 		}
 	}
 	{
-		if s.MaxCPUSockets.Set {
-			e.FieldStart("MaxCPUSockets")
-			s.MaxCPUSockets.Encode(e)
-		}
-	}
-	{
-		if s.MaxDIMMSlots.Set {
-			e.FieldStart("MaxDIMMSlots")
-			s.MaxDIMMSlots.Encode(e)
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
 		}
 	}
 	{
-		if s.MaxPCIeSlots.Set {
-			e.FieldStart("MaxPCIeSlots")
-			s.MaxPCIeSlots.Encode(e)
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
 		}
 	}
 	{
-		if s.MemoryOperationMode.Set {
-			e.FieldStart("MemoryOperationMode")
-			s.MemoryOperationMode.Encode(e)
+		if s.Kind.Set {
+			e.FieldStart("kind")
+			s.Kind.Encode(e)
 		}
 	}
 	{
 		if s.Name.Set {
-			e.FieldStart("Name")
+			e.FieldStart("name")
 			s.Name.Encode(e)
 		}
 	}
 	{
-		if s.NodeID.Set {
-			e.FieldStart("NodeID")
-			s.NodeID.Encode(e)
-		}
-	}
-	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
-		}
-	}
-	{
-		if s.PSRollupStatus.Set {
-			e.FieldStart("PSRollupStatus")
-			s.PSRollupStatus.Encode(e)
-		}
-	}
-	{
-		if s.PlatformGUID.Set {
-			e.FieldStart("PlatformGUID")
-			s.PlatformGUID.Encode(e)
-		}
-	}
-	{
-		if s.PopulatedDIMMSlots.Set {
-			e.FieldStart("PopulatedDIMMSlots")
-			s.PopulatedDIMMSlots.Encode(e)
-		}
-	}
-	{
-		if s.PopulatedPCIeSlots.Set {
-			e.FieldStart("PopulatedPCIeSlots")
-			s.PopulatedPCIeSlots.Encode(e)
-		}
-	}
-	{
-		if s.PowerCapEnabledState.Set {
-			e.FieldStart("PowerCapEnabledState")
-			s.PowerCapEnabledState.Encode(e)
-		}
-	}
-	{
-		if s.SDCardRollupStatus.Set {
-			e.FieldStart("SDCardRollupStatus")
-			s.SDCardRollupStatus.Encode(e)
-		}
-	}
-	{
-		if s.SELRollupStatus.Set {
-			e.FieldStart("SELRollupStatus")
-			s.SELRollupStatus.Encode(e)
-		}
-	}
-	{
-		if s.ServerAllocationWatts.Set {
-			e.FieldStart("ServerAllocationWatts")
-			s.ServerAllocationWatts.Encode(e)
-		}
-	}
-	{
-		if s.StorageRollupStatus.Set {
-			e.FieldStart("StorageRollupStatus")
-			s.StorageRollupStatus.Encode(e)
-		}
-	}
-	{
-		if s.SysMemErrorMethodology.Set {
-			e.FieldStart("SysMemErrorMethodology")
-			s.SysMemErrorMethodology.Encode(e)
-		}
-	}
-	{
-		if s.SysMemFailOverState.Set {
-			e.FieldStart("SysMemFailOverState")
-			s.SysMemFailOverState.Encode(e)
-		}
-	}
-	{
-		if s.SysMemLocation.Set {
-			e.FieldStart("SysMemLocation")
-			s.SysMemLocation.Encode(e)
-		}
-	}
-	{
-		if s.SysMemPrimaryStatus.Set {
-			e.FieldStart("SysMemPrimaryStatus")
-			s.SysMemPrimaryStatus.Encode(e)
+		if s.Time.Set {
+			e.FieldStart("time")
+			s.Time.Encode(e, json.EncodeDateTime)
 		}
 	}
-	{
-		if s.SystemGeneration.Set {
-			e.FieldStart("SystemGeneration")
-			s.SystemGeneration.Encode(e)
-		}
+}
+
+var jsonFieldsNameOfProvisionLog = [6]string{
+	0: "content",
+	1: "host",
+	2: "id",
+	3: "kind",
+	4: "name",
+	5: "time",
+}
+
+// Decode decodes ProvisionLog from json.
+func (s *ProvisionLog) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProvisionLog to nil")
 	}
-	{
-		if s.SystemID.Set {
-			e.FieldStart("SystemID")
-			s.SystemID.Encode(e)
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "content":
+			if err := func() error {
+				s.Content.Reset()
+				if err := s.Content.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"content\"")
+			}
+		case "host":
+			if err := func() error {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"host\"")
+			}
+		case "id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "kind":
+			if err := func() error {
+				s.Kind.Reset()
+				if err := s.Kind.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"kind\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "time":
+			if err := func() error {
+				s.Time.Reset()
+				if err := s.Time.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"time\"")
+			}
+		default:
+			return d.Skip()
 		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProvisionLog")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ProvisionLog) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ProvisionLog) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishDellUpgradeFirmware) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishDellUpgradeFirmware) encodeFields(e *jx.Encoder) {
 	{
-		if s.SystemRevision.Set {
-			e.FieldStart("SystemRevision")
-			s.SystemRevision.Encode(e)
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
 		}
 	}
 	{
-		if s.TempRollupStatus.Set {
-			e.FieldStart("TempRollupStatus")
-			s.TempRollupStatus.Encode(e)
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
 		}
 	}
 	{
-		if s.TempStatisticsRollupStatus.Set {
-			e.FieldStart("TempStatisticsRollupStatus")
-			s.TempStatisticsRollupStatus.Encode(e)
+		if s.Status.Set {
+			e.FieldStart("Status")
+			s.Status.Encode(e)
 		}
 	}
 	{
-		if s.UUID.Set {
-			e.FieldStart("UUID")
-			s.UUID.Encode(e)
+		if s.UpdateCount.Set {
+			e.FieldStart("UpdateCount")
+			s.UpdateCount.Encode(e)
 		}
 	}
 	{
-		if s.VoltRollupStatus.Set {
-			e.FieldStart("VoltRollupStatus")
-			s.VoltRollupStatus.Encode(e)
+		if s.UpdateList != nil {
+			e.FieldStart("UpdateList")
+			e.ArrStart()
+			for _, elem := range s.UpdateList {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		if s.SmbiosGUID.Set {
-			e.FieldStart("smbiosGUID")
-			s.SmbiosGUID.Encode(e)
+		if s.UpdateRebootType.Set {
+			e.FieldStart("UpdateRebootType")
+			s.UpdateRebootType.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRedfishSystemOemDell = [57]string{
-	0:  "@Message.ExtendedInfo",
-	1:  "@odata.context",
-	2:  "@odata.etag",
-	3:  "@odata.id",
-	4:  "@odata.type",
-	5:  "BIOSReleaseDate",
-	6:  "BaseBoardChassisSlot",
-	7:  "BatteryRollupStatus",
-	8:  "BladeGeometry",
-	9:  "CMCIP",
-	10: "CPURollupStatus",
-	11: "ChassisModel",
-	12: "ChassisName",
-	13: "ChassisServiceTag",
-	14: "ChassisSystemHeightUnit",
-	15: "CurrentRollupStatus",
-	16: "Description",
-	17: "EstimatedExhaustTemperatureCelsius",
-	18: "EstimatedSystemAirflowCFM",
-	19: "ExpressServiceCode",
-	20: "FanRollupStatus",
-	21: "IDSDMRollupStatus",
-	22: "Id",
-	23: "IntrusionRollupStatus",
-	24: "IsOEMBranded",
-	25: "LastSystemInventoryTime",
-	26: "LastUpdateTime",
-	27: "LicensingRollupStatus",
-	28: "ManagedSystemSize",
-	29: "MaxCPUSockets",
-	30: "MaxDIMMSlots",
-	31: "MaxPCIeSlots",
-	32: "MemoryOperationMode",
-	33: "Name",
-	34: "NodeID",
-	35: "Oem",
-	36: "PSRollupStatus",
-	37: "PlatformGUID",
-	38: "PopulatedDIMMSlots",
-	39: "PopulatedPCIeSlots",
-	40: "PowerCapEnabledState",
-	41: "SDCardRollupStatus",
-	42: "SELRollupStatus",
-	43: "ServerAllocationWatts",
-	44: "StorageRollupStatus",
-	45: "SysMemErrorMethodology",
-	46: "SysMemFailOverState",
-	47: "SysMemLocation",
-	48: "SysMemPrimaryStatus",
-	49: "SystemGeneration",
-	50: "SystemID",
-	51: "SystemRevision",
-	52: "TempRollupStatus",
-	53: "TempStatisticsRollupStatus",
-	54: "UUID",
-	55: "VoltRollupStatus",
-	56: "smbiosGUID",
+var jsonFieldsNameOfRedfishDellUpgradeFirmware = [6]string{
+	0: "Message",
+	1: "Name",
+	2: "Status",
+	3: "UpdateCount",
+	4: "UpdateList",
+	5: "UpdateRebootType",
 }
 
-// Decode decodes RedfishSystemOemDell from json.
-func (s *RedfishSystemOemDell) Decode(d *jx.Decoder) error {
+// Decode decodes RedfishDellUpgradeFirmware from json.
+func (s *RedfishDellUpgradeFirmware) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RedfishSystemOemDell to nil")
+		return errors.New("invalid: unable to decode RedfishDellUpgradeFirmware to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "@Message.ExtendedInfo":
+		case "Message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Message\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "Status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Status\"")
+			}
+		case "UpdateCount":
+			if err := func() error {
+				s.UpdateCount.Reset()
+				if err := s.UpdateCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"UpdateCount\"")
+			}
+		case "UpdateList":
+			if err := func() error {
+				s.UpdateList = make([]RedfishDellUpgradeFirmwareUpdateListItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishDellUpgradeFirmwareUpdateListItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.UpdateList = append(s.UpdateList, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"UpdateList\"")
+			}
+		case "UpdateRebootType":
+			if err := func() error {
+				s.UpdateRebootType.Reset()
+				if err := s.UpdateRebootType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"UpdateRebootType\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishDellUpgradeFirmware")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishDellUpgradeFirmware) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishDellUpgradeFirmware) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.BaseLocation.Set {
+			e.FieldStart("BaseLocation")
+			s.BaseLocation.Encode(e)
+		}
+	}
+	{
+		if s.ComponentID.Set {
+			e.FieldStart("ComponentID")
+			s.ComponentID.Encode(e)
+		}
+	}
+	{
+		if s.ComponentInfoName.Set {
+			e.FieldStart("ComponentInfoName")
+			s.ComponentInfoName.Encode(e)
+		}
+	}
+	{
+		if s.ComponentInfoValue.Set {
+			e.FieldStart("ComponentInfoValue")
+			s.ComponentInfoValue.Encode(e)
+		}
+	}
+	{
+		if s.ComponentType.Set {
+			e.FieldStart("ComponentType")
+			s.ComponentType.Encode(e)
+		}
+	}
+	{
+		if s.Criticality.Set {
+			e.FieldStart("Criticality")
+			s.Criticality.Encode(e)
+		}
+	}
+	{
+		if s.DisplayName.Set {
+			e.FieldStart("DisplayName")
+			s.DisplayName.Encode(e)
+		}
+	}
+	{
+		if s.InstalledVersion.Set {
+			e.FieldStart("InstalledVersion")
+			s.InstalledVersion.Encode(e)
+		}
+	}
+	{
+		if s.JobID.Set {
+			e.FieldStart("JobID")
+			s.JobID.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.PackageName.Set {
+			e.FieldStart("PackageName")
+			s.PackageName.Encode(e)
+		}
+	}
+	{
+		if s.PackagePath.Set {
+			e.FieldStart("PackagePath")
+			s.PackagePath.Encode(e)
+		}
+	}
+	{
+		if s.PackageVersion.Set {
+			e.FieldStart("PackageVersion")
+			s.PackageVersion.Encode(e)
+		}
+	}
+	{
+		if s.RebootType.Set {
+			e.FieldStart("RebootType")
+			s.RebootType.Encode(e)
+		}
+	}
+	{
+		if s.Target.Set {
+			e.FieldStart("Target")
+			s.Target.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishDellUpgradeFirmwareUpdateListItem = [15]string{
+	0:  "BaseLocation",
+	1:  "ComponentID",
+	2:  "ComponentInfoName",
+	3:  "ComponentInfoValue",
+	4:  "ComponentType",
+	5:  "Criticality",
+	6:  "DisplayName",
+	7:  "InstalledVersion",
+	8:  "JobID",
+	9:  "Name",
+	10: "PackageName",
+	11: "PackagePath",
+	12: "PackageVersion",
+	13: "RebootType",
+	14: "Target",
+}
+
+// Decode decodes RedfishDellUpgradeFirmwareUpdateListItem from json.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishDellUpgradeFirmwareUpdateListItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "BaseLocation":
+			if err := func() error {
+				s.BaseLocation.Reset()
+				if err := s.BaseLocation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"BaseLocation\"")
+			}
+		case "ComponentID":
+			if err := func() error {
+				s.ComponentID.Reset()
+				if err := s.ComponentID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ComponentID\"")
+			}
+		case "ComponentInfoName":
+			if err := func() error {
+				s.ComponentInfoName.Reset()
+				if err := s.ComponentInfoName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ComponentInfoName\"")
+			}
+		case "ComponentInfoValue":
+			if err := func() error {
+				s.ComponentInfoValue.Reset()
+				if err := s.ComponentInfoValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ComponentInfoValue\"")
+			}
+		case "ComponentType":
+			if err := func() error {
+				s.ComponentType.Reset()
+				if err := s.ComponentType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ComponentType\"")
+			}
+		case "Criticality":
+			if err := func() error {
+				s.Criticality.Reset()
+				if err := s.Criticality.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Criticality\"")
+			}
+		case "DisplayName":
+			if err := func() error {
+				s.DisplayName.Reset()
+				if err := s.DisplayName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DisplayName\"")
+			}
+		case "InstalledVersion":
+			if err := func() error {
+				s.InstalledVersion.Reset()
+				if err := s.InstalledVersion.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"InstalledVersion\"")
+			}
+		case "JobID":
+			if err := func() error {
+				s.JobID.Reset()
+				if err := s.JobID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"JobID\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "PackageName":
+			if err := func() error {
+				s.PackageName.Reset()
+				if err := s.PackageName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PackageName\"")
+			}
+		case "PackagePath":
+			if err := func() error {
+				s.PackagePath.Reset()
+				if err := s.PackagePath.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PackagePath\"")
+			}
+		case "PackageVersion":
+			if err := func() error {
+				s.PackageVersion.Reset()
+				if err := s.PackageVersion.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PackageVersion\"")
+			}
+		case "RebootType":
+			if err := func() error {
+				s.RebootType.Reset()
+				if err := s.RebootType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RebootType\"")
+			}
+		case "Target":
+			if err := func() error {
+				s.Target.Reset()
+				if err := s.Target.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Target\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishDellUpgradeFirmwareUpdateListItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishFirmwareTask) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishFirmwareTask) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.Messages.Set {
+			e.FieldStart("messages")
+			s.Messages.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.PercentComplete.Set {
+			e.FieldStart("percent_complete")
+			s.PercentComplete.Encode(e)
+		}
+	}
+	{
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
+		}
+	}
+	{
+		if s.TaskID.Set {
+			e.FieldStart("task_id")
+			s.TaskID.Encode(e)
+		}
+	}
+	{
+		if s.TaskState.Set {
+			e.FieldStart("task_state")
+			s.TaskState.Encode(e)
+		}
+	}
+	{
+		if s.TaskStatus.Set {
+			e.FieldStart("task_status")
+			s.TaskStatus.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishFirmwareTask = [8]string{
+	0: "message",
+	1: "messages",
+	2: "name",
+	3: "percent_complete",
+	4: "status",
+	5: "task_id",
+	6: "task_state",
+	7: "task_status",
+}
+
+// Decode decodes RedfishFirmwareTask from json.
+func (s *RedfishFirmwareTask) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishFirmwareTask to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "messages":
+			if err := func() error {
+				s.Messages.Reset()
+				if err := s.Messages.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"messages\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "percent_complete":
+			if err := func() error {
+				s.PercentComplete.Reset()
+				if err := s.PercentComplete.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"percent_complete\"")
+			}
+		case "status":
+			if err := func() error {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "task_id":
+			if err := func() error {
+				s.TaskID.Reset()
+				if err := s.TaskID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"task_id\"")
+			}
+		case "task_state":
+			if err := func() error {
+				s.TaskState.Reset()
+				if err := s.TaskState.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"task_state\"")
+			}
+		case "task_status":
+			if err := func() error {
+				s.TaskStatus.Reset()
+				if err := s.TaskStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"task_status\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishFirmwareTask")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishFirmwareTask) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishFirmwareTask) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishJob) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishJob) encodeFields(e *jx.Encoder) {
+	{
+		if s.Jobs.Set {
+			e.FieldStart("jobs")
+			s.Jobs.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishJob = [2]string{
+	0: "jobs",
+	1: "name",
+}
+
+// Decode decodes RedfishJob from json.
+func (s *RedfishJob) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishJob to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "jobs":
+			if err := func() error {
+				s.Jobs.Reset()
+				if err := s.Jobs.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"jobs\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishJob")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishJob) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishJob) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishJobJobsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishJobJobsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.MessageDotExtendedInfo != nil {
+			e.FieldStart("@Message.ExtendedInfo")
+			e.ArrStart()
+			for _, elem := range s.MessageDotExtendedInfo {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.OdataDotContext.Set {
+			e.FieldStart("@odata.context")
+			s.OdataDotContext.Encode(e)
+		}
+	}
+	{
+		if s.OdataDotEtag.Set {
+			e.FieldStart("@odata.etag")
+			s.OdataDotEtag.Encode(e)
+		}
+	}
+	{
+		if s.OdataDotID.Set {
+			e.FieldStart("@odata.id")
+			s.OdataDotID.Encode(e)
+		}
+	}
+	{
+		if s.OdataDotType.Set {
+			e.FieldStart("@odata.type")
+			s.OdataDotType.Encode(e)
+		}
+	}
+	{
+		if s.CreatedBy.Set {
+			e.FieldStart("CreatedBy")
+			s.CreatedBy.Encode(e)
+		}
+	}
+	{
+		if s.CreationTime.Set {
+			e.FieldStart("CreationTime")
+			s.CreationTime.Encode(e)
+		}
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("Description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.EndTime.Set {
+			e.FieldStart("EndTime")
+			s.EndTime.Encode(e)
+		}
+	}
+	{
+		if s.EstimatedCompletionTime.Set {
+			e.FieldStart("EstimatedCompletionTime")
+			s.EstimatedCompletionTime.Encode(e)
+		}
+	}
+	{
+		if s.EstimatedDuration.Set {
+			e.FieldStart("EstimatedDuration")
+			s.EstimatedDuration.Encode(e)
+		}
+	}
+	{
+		if s.HidePayload.Set {
+			e.FieldStart("HidePayload")
+			s.HidePayload.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("Id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.JobPriority.Set {
+			e.FieldStart("JobPriority")
+			s.JobPriority.Encode(e)
+		}
+	}
+	{
+		if s.JobState.Set {
+			e.FieldStart("JobState")
+			s.JobState.Encode(e)
+		}
+	}
+	{
+		if s.JobStatus.Set {
+			e.FieldStart("JobStatus")
+			s.JobStatus.Encode(e)
+		}
+	}
+	{
+		if s.JobType.Set {
+			e.FieldStart("JobType")
+			s.JobType.Encode(e)
+		}
+	}
+	{
+		if s.MaxExecutionTime.Set {
+			e.FieldStart("MaxExecutionTime")
+			s.MaxExecutionTime.Encode(e)
+		}
+	}
+	{
+		if s.Messages != nil {
+			e.FieldStart("Messages")
+			e.ArrStart()
+			for _, elem := range s.Messages {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.Parameters.Set {
+			e.FieldStart("Parameters")
+			s.Parameters.Encode(e)
+		}
+	}
+	{
+		if s.Payload.Set {
+			e.FieldStart("Payload")
+			s.Payload.Encode(e)
+		}
+	}
+	{
+		if s.PercentComplete.Set {
+			e.FieldStart("PercentComplete")
+			s.PercentComplete.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("RawData")
+		e.Base64(s.RawData)
+	}
+	{
+		if s.Schedule.Set {
+			e.FieldStart("Schedule")
+			s.Schedule.Encode(e)
+		}
+	}
+	{
+		if s.StartTime.Set {
+			e.FieldStart("StartTime")
+			s.StartTime.Encode(e)
+		}
+	}
+	{
+		if s.StepOrder.Set {
+			e.FieldStart("StepOrder")
+			s.StepOrder.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishJobJobsItem = [28]string{
+	0:  "@Message.ExtendedInfo",
+	1:  "@odata.context",
+	2:  "@odata.etag",
+	3:  "@odata.id",
+	4:  "@odata.type",
+	5:  "CreatedBy",
+	6:  "CreationTime",
+	7:  "Description",
+	8:  "EndTime",
+	9:  "EstimatedCompletionTime",
+	10: "EstimatedDuration",
+	11: "HidePayload",
+	12: "Id",
+	13: "JobPriority",
+	14: "JobState",
+	15: "JobStatus",
+	16: "JobType",
+	17: "MaxExecutionTime",
+	18: "Messages",
+	19: "Name",
+	20: "Oem",
+	21: "Parameters",
+	22: "Payload",
+	23: "PercentComplete",
+	24: "RawData",
+	25: "Schedule",
+	26: "StartTime",
+	27: "StepOrder",
+}
+
+// Decode decodes RedfishJobJobsItem from json.
+func (s *RedfishJobJobsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishJobJobsItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "@Message.ExtendedInfo":
+			if err := func() error {
+				s.MessageDotExtendedInfo = make([]RedfishJobJobsItemMessageDotExtendedInfoItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishJobJobsItemMessageDotExtendedInfoItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@Message.ExtendedInfo\"")
+			}
+		case "@odata.context":
+			if err := func() error {
+				s.OdataDotContext.Reset()
+				if err := s.OdataDotContext.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.context\"")
+			}
+		case "@odata.etag":
+			if err := func() error {
+				s.OdataDotEtag.Reset()
+				if err := s.OdataDotEtag.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.etag\"")
+			}
+		case "@odata.id":
+			if err := func() error {
+				s.OdataDotID.Reset()
+				if err := s.OdataDotID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.id\"")
+			}
+		case "@odata.type":
+			if err := func() error {
+				s.OdataDotType.Reset()
+				if err := s.OdataDotType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.type\"")
+			}
+		case "CreatedBy":
+			if err := func() error {
+				s.CreatedBy.Reset()
+				if err := s.CreatedBy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"CreatedBy\"")
+			}
+		case "CreationTime":
+			if err := func() error {
+				s.CreationTime.Reset()
+				if err := s.CreationTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"CreationTime\"")
+			}
+		case "Description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "EndTime":
+			if err := func() error {
+				s.EndTime.Reset()
+				if err := s.EndTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EndTime\"")
+			}
+		case "EstimatedCompletionTime":
+			if err := func() error {
+				s.EstimatedCompletionTime.Reset()
+				if err := s.EstimatedCompletionTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EstimatedCompletionTime\"")
+			}
+		case "EstimatedDuration":
+			if err := func() error {
+				s.EstimatedDuration.Reset()
+				if err := s.EstimatedDuration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EstimatedDuration\"")
+			}
+		case "HidePayload":
+			if err := func() error {
+				s.HidePayload.Reset()
+				if err := s.HidePayload.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"HidePayload\"")
+			}
+		case "Id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Id\"")
+			}
+		case "JobPriority":
+			if err := func() error {
+				s.JobPriority.Reset()
+				if err := s.JobPriority.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"JobPriority\"")
+			}
+		case "JobState":
+			if err := func() error {
+				s.JobState.Reset()
+				if err := s.JobState.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"JobState\"")
+			}
+		case "JobStatus":
+			if err := func() error {
+				s.JobStatus.Reset()
+				if err := s.JobStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"JobStatus\"")
+			}
+		case "JobType":
+			if err := func() error {
+				s.JobType.Reset()
+				if err := s.JobType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"JobType\"")
+			}
+		case "MaxExecutionTime":
+			if err := func() error {
+				s.MaxExecutionTime.Reset()
+				if err := s.MaxExecutionTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaxExecutionTime\"")
+			}
+		case "Messages":
+			if err := func() error {
+				s.Messages = make([]RedfishJobJobsItemMessagesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishJobJobsItemMessagesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Messages = append(s.Messages, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Messages\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "Oem":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Oem\"")
+			}
+		case "Parameters":
+			if err := func() error {
+				s.Parameters.Reset()
+				if err := s.Parameters.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Parameters\"")
+			}
+		case "Payload":
+			if err := func() error {
+				s.Payload.Reset()
+				if err := s.Payload.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Payload\"")
+			}
+		case "PercentComplete":
+			if err := func() error {
+				s.PercentComplete.Reset()
+				if err := s.PercentComplete.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PercentComplete\"")
+			}
+		case "RawData":
+			if err := func() error {
+				v, err := d.Base64()
+				s.RawData = []byte(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RawData\"")
+			}
+		case "Schedule":
+			if err := func() error {
+				s.Schedule.Reset()
+				if err := s.Schedule.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Schedule\"")
+			}
+		case "StartTime":
+			if err := func() error {
+				s.StartTime.Reset()
+				if err := s.StartTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"StartTime\"")
+			}
+		case "StepOrder":
+			if err := func() error {
+				s.StepOrder.Reset()
+				if err := s.StepOrder.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"StepOrder\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishJobJobsItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishJobJobsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishJobJobsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.MessageArgs != nil {
+			e.FieldStart("MessageArgs")
+			e.ArrStart()
+			for _, elem := range s.MessageArgs {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.MessageId.Set {
+			e.FieldStart("MessageId")
+			s.MessageId.Encode(e)
+		}
+	}
+	{
+		if s.MessageSeverity.Set {
+			e.FieldStart("MessageSeverity")
+			s.MessageSeverity.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.RelatedProperties.Set {
+			e.FieldStart("RelatedProperties")
+			s.RelatedProperties.Encode(e)
+		}
+	}
+	{
+		if s.Resolution.Set {
+			e.FieldStart("Resolution")
+			s.Resolution.Encode(e)
+		}
+	}
+	{
+		if s.ResolutionSteps != nil {
+			e.FieldStart("ResolutionSteps")
+			e.ArrStart()
+			for _, elem := range s.ResolutionSteps {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Severity.Set {
+			e.FieldStart("Severity")
+			s.Severity.Encode(e)
+		}
+	}
+	{
+		if s.UserAuthenticationSource.Set {
+			e.FieldStart("UserAuthenticationSource")
+			s.UserAuthenticationSource.Encode(e)
+		}
+	}
+	{
+		if s.Username.Set {
+			e.FieldStart("Username")
+			s.Username.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishJobJobsItemMessageDotExtendedInfoItem = [11]string{
+	0:  "Message",
+	1:  "MessageArgs",
+	2:  "MessageId",
+	3:  "MessageSeverity",
+	4:  "Oem",
+	5:  "RelatedProperties",
+	6:  "Resolution",
+	7:  "ResolutionSteps",
+	8:  "Severity",
+	9:  "UserAuthenticationSource",
+	10: "Username",
+}
+
+// Decode decodes RedfishJobJobsItemMessageDotExtendedInfoItem from json.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishJobJobsItemMessageDotExtendedInfoItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Message\"")
+			}
+		case "MessageArgs":
+			if err := func() error {
+				s.MessageArgs = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.MessageArgs = append(s.MessageArgs, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MessageArgs\"")
+			}
+		case "MessageId":
+			if err := func() error {
+				s.MessageId.Reset()
+				if err := s.MessageId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MessageId\"")
+			}
+		case "MessageSeverity":
+			if err := func() error {
+				s.MessageSeverity.Reset()
+				if err := s.MessageSeverity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MessageSeverity\"")
+			}
+		case "Oem":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Oem\"")
+			}
+		case "RelatedProperties":
+			if err := func() error {
+				s.RelatedProperties.Reset()
+				if err := s.RelatedProperties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RelatedProperties\"")
+			}
+		case "Resolution":
+			if err := func() error {
+				s.Resolution.Reset()
+				if err := s.Resolution.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Resolution\"")
+			}
+		case "ResolutionSteps":
+			if err := func() error {
+				s.ResolutionSteps = make([]RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ResolutionSteps = append(s.ResolutionSteps, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ResolutionSteps\"")
+			}
+		case "Severity":
+			if err := func() error {
+				s.Severity.Reset()
+				if err := s.Severity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Severity\"")
+			}
+		case "UserAuthenticationSource":
+			if err := func() error {
+				s.UserAuthenticationSource.Reset()
+				if err := s.UserAuthenticationSource.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"UserAuthenticationSource\"")
+			}
+		case "Username":
+			if err := func() error {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Username\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishJobJobsItemMessageDotExtendedInfoItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.ActionParameters != nil {
+			e.FieldStart("ActionParameters")
+			e.ArrStart()
+			for _, elem := range s.ActionParameters {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.ActionURI.Set {
+			e.FieldStart("ActionURI")
+			s.ActionURI.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.Priority.Set {
+			e.FieldStart("Priority")
+			s.Priority.Encode(e)
+		}
+	}
+	{
+		if s.ResolutionType.Set {
+			e.FieldStart("ResolutionType")
+			s.ResolutionType.Encode(e)
+		}
+	}
+	{
+		if s.RetryCount.Set {
+			e.FieldStart("RetryCount")
+			s.RetryCount.Encode(e)
+		}
+	}
+	{
+		if s.RetryIntervalSeconds.Set {
+			e.FieldStart("RetryIntervalSeconds")
+			s.RetryIntervalSeconds.Encode(e)
+		}
+	}
+	{
+		if s.TargetComponentURI.Set {
+			e.FieldStart("TargetComponentURI")
+			s.TargetComponentURI.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem = [8]string{
+	0: "ActionParameters",
+	1: "ActionURI",
+	2: "Oem",
+	3: "Priority",
+	4: "ResolutionType",
+	5: "RetryCount",
+	6: "RetryIntervalSeconds",
+	7: "TargetComponentURI",
+}
+
+// Decode decodes RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem from json.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ActionParameters":
+			if err := func() error {
+				s.ActionParameters = make([]RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ActionParameters = append(s.ActionParameters, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ActionParameters\"")
+			}
+		case "ActionURI":
+			if err := func() error {
+				s.ActionURI.Reset()
+				if err := s.ActionURI.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ActionURI\"")
+			}
+		case "Oem":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Oem\"")
+			}
+		case "Priority":
+			if err := func() error {
+				s.Priority.Reset()
+				if err := s.Priority.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Priority\"")
+			}
+		case "ResolutionType":
+			if err := func() error {
+				s.ResolutionType.Reset()
+				if err := s.ResolutionType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ResolutionType\"")
+			}
+		case "RetryCount":
+			if err := func() error {
+				s.RetryCount.Reset()
+				if err := s.RetryCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RetryCount\"")
+			}
+		case "RetryIntervalSeconds":
+			if err := func() error {
+				s.RetryIntervalSeconds.Reset()
+				if err := s.RetryIntervalSeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RetryIntervalSeconds\"")
+			}
+		case "TargetComponentURI":
+			if err := func() error {
+				s.TargetComponentURI.Reset()
+				if err := s.TargetComponentURI.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TargetComponentURI\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.AllowableNumbers != nil {
+			e.FieldStart("AllowableNumbers")
+			e.ArrStart()
+			for _, elem := range s.AllowableNumbers {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.AllowablePattern.Set {
+			e.FieldStart("AllowablePattern")
+			s.AllowablePattern.Encode(e)
+		}
+	}
+	{
+		if s.AllowableValueDescriptions != nil {
+			e.FieldStart("AllowableValueDescriptions")
+			e.ArrStart()
+			for _, elem := range s.AllowableValueDescriptions {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.AllowableValues != nil {
+			e.FieldStart("AllowableValues")
+			e.ArrStart()
+			for _, elem := range s.AllowableValues {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.ArraySizeMaximum.Set {
+			e.FieldStart("ArraySizeMaximum")
+			s.ArraySizeMaximum.Encode(e)
+		}
+	}
+	{
+		if s.ArraySizeMinimum.Set {
+			e.FieldStart("ArraySizeMinimum")
+			s.ArraySizeMinimum.Encode(e)
+		}
+	}
+	{
+		if s.DataType.Set {
+			e.FieldStart("DataType")
+			s.DataType.Encode(e)
+		}
+	}
+	{
+		if s.DefaultValue.Set {
+			e.FieldStart("DefaultValue")
+			s.DefaultValue.Encode(e)
+		}
+	}
+	{
+		if s.MaximumValue.Set {
+			e.FieldStart("MaximumValue")
+			s.MaximumValue.Encode(e)
+		}
+	}
+	{
+		if s.MinimumValue.Set {
+			e.FieldStart("MinimumValue")
+			s.MinimumValue.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.NoDefaultValue.Set {
+			e.FieldStart("NoDefaultValue")
+			s.NoDefaultValue.Encode(e)
+		}
+	}
+	{
+		if s.ObjectDataType.Set {
+			e.FieldStart("ObjectDataType")
+			s.ObjectDataType.Encode(e)
+		}
+	}
+	{
+		if s.Required.Set {
+			e.FieldStart("Required")
+			s.Required.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem = [14]string{
+	0:  "AllowableNumbers",
+	1:  "AllowablePattern",
+	2:  "AllowableValueDescriptions",
+	3:  "AllowableValues",
+	4:  "ArraySizeMaximum",
+	5:  "ArraySizeMinimum",
+	6:  "DataType",
+	7:  "DefaultValue",
+	8:  "MaximumValue",
+	9:  "MinimumValue",
+	10: "Name",
+	11: "NoDefaultValue",
+	12: "ObjectDataType",
+	13: "Required",
+}
+
+// Decode decodes RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem from json.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "AllowableNumbers":
+			if err := func() error {
+				s.AllowableNumbers = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableNumbers = append(s.AllowableNumbers, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowableNumbers\"")
+			}
+		case "AllowablePattern":
+			if err := func() error {
+				s.AllowablePattern.Reset()
+				if err := s.AllowablePattern.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowablePattern\"")
+			}
+		case "AllowableValueDescriptions":
+			if err := func() error {
+				s.AllowableValueDescriptions = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowableValueDescriptions\"")
+			}
+		case "AllowableValues":
+			if err := func() error {
+				s.AllowableValues = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableValues = append(s.AllowableValues, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowableValues\"")
+			}
+		case "ArraySizeMaximum":
+			if err := func() error {
+				s.ArraySizeMaximum.Reset()
+				if err := s.ArraySizeMaximum.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ArraySizeMaximum\"")
+			}
+		case "ArraySizeMinimum":
+			if err := func() error {
+				s.ArraySizeMinimum.Reset()
+				if err := s.ArraySizeMinimum.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ArraySizeMinimum\"")
+			}
+		case "DataType":
+			if err := func() error {
+				s.DataType.Reset()
+				if err := s.DataType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DataType\"")
+			}
+		case "DefaultValue":
+			if err := func() error {
+				s.DefaultValue.Reset()
+				if err := s.DefaultValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DefaultValue\"")
+			}
+		case "MaximumValue":
+			if err := func() error {
+				s.MaximumValue.Reset()
+				if err := s.MaximumValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaximumValue\"")
+			}
+		case "MinimumValue":
+			if err := func() error {
+				s.MinimumValue.Reset()
+				if err := s.MinimumValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MinimumValue\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "NoDefaultValue":
+			if err := func() error {
+				s.NoDefaultValue.Reset()
+				if err := s.NoDefaultValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"NoDefaultValue\"")
+			}
+		case "ObjectDataType":
+			if err := func() error {
+				s.ObjectDataType.Reset()
+				if err := s.ObjectDataType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ObjectDataType\"")
+			}
+		case "Required":
+			if err := func() error {
+				s.Required.Reset()
+				if err := s.Required.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Required\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishJobJobsItemMessagesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishJobJobsItemMessagesItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.MessageArgs != nil {
+			e.FieldStart("MessageArgs")
+			e.ArrStart()
+			for _, elem := range s.MessageArgs {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.MessageId.Set {
+			e.FieldStart("MessageId")
+			s.MessageId.Encode(e)
+		}
+	}
+	{
+		if s.MessageSeverity.Set {
+			e.FieldStart("MessageSeverity")
+			s.MessageSeverity.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.RelatedProperties.Set {
+			e.FieldStart("RelatedProperties")
+			s.RelatedProperties.Encode(e)
+		}
+	}
+	{
+		if s.Resolution.Set {
+			e.FieldStart("Resolution")
+			s.Resolution.Encode(e)
+		}
+	}
+	{
+		if s.ResolutionSteps != nil {
+			e.FieldStart("ResolutionSteps")
+			e.ArrStart()
+			for _, elem := range s.ResolutionSteps {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Severity.Set {
+			e.FieldStart("Severity")
+			s.Severity.Encode(e)
+		}
+	}
+	{
+		if s.UserAuthenticationSource.Set {
+			e.FieldStart("UserAuthenticationSource")
+			s.UserAuthenticationSource.Encode(e)
+		}
+	}
+	{
+		if s.Username.Set {
+			e.FieldStart("Username")
+			s.Username.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishJobJobsItemMessagesItem = [11]string{
+	0:  "Message",
+	1:  "MessageArgs",
+	2:  "MessageId",
+	3:  "MessageSeverity",
+	4:  "Oem",
+	5:  "RelatedProperties",
+	6:  "Resolution",
+	7:  "ResolutionSteps",
+	8:  "Severity",
+	9:  "UserAuthenticationSource",
+	10: "Username",
+}
+
+// Decode decodes RedfishJobJobsItemMessagesItem from json.
+func (s *RedfishJobJobsItemMessagesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishJobJobsItemMessagesItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Message\"")
+			}
+		case "MessageArgs":
+			if err := func() error {
+				s.MessageArgs = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.MessageArgs = append(s.MessageArgs, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MessageArgs\"")
+			}
+		case "MessageId":
+			if err := func() error {
+				s.MessageId.Reset()
+				if err := s.MessageId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MessageId\"")
+			}
+		case "MessageSeverity":
+			if err := func() error {
+				s.MessageSeverity.Reset()
+				if err := s.MessageSeverity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MessageSeverity\"")
+			}
+		case "Oem":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Oem\"")
+			}
+		case "RelatedProperties":
+			if err := func() error {
+				s.RelatedProperties.Reset()
+				if err := s.RelatedProperties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RelatedProperties\"")
+			}
+		case "Resolution":
+			if err := func() error {
+				s.Resolution.Reset()
+				if err := s.Resolution.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Resolution\"")
+			}
+		case "ResolutionSteps":
+			if err := func() error {
+				s.ResolutionSteps = make([]RedfishJobJobsItemMessagesItemResolutionStepsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishJobJobsItemMessagesItemResolutionStepsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ResolutionSteps = append(s.ResolutionSteps, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ResolutionSteps\"")
+			}
+		case "Severity":
+			if err := func() error {
+				s.Severity.Reset()
+				if err := s.Severity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Severity\"")
+			}
+		case "UserAuthenticationSource":
+			if err := func() error {
+				s.UserAuthenticationSource.Reset()
+				if err := s.UserAuthenticationSource.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"UserAuthenticationSource\"")
+			}
+		case "Username":
+			if err := func() error {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Username\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishJobJobsItemMessagesItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishJobJobsItemMessagesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishJobJobsItemMessagesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.ActionParameters != nil {
+			e.FieldStart("ActionParameters")
+			e.ArrStart()
+			for _, elem := range s.ActionParameters {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.ActionURI.Set {
+			e.FieldStart("ActionURI")
+			s.ActionURI.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.Priority.Set {
+			e.FieldStart("Priority")
+			s.Priority.Encode(e)
+		}
+	}
+	{
+		if s.ResolutionType.Set {
+			e.FieldStart("ResolutionType")
+			s.ResolutionType.Encode(e)
+		}
+	}
+	{
+		if s.RetryCount.Set {
+			e.FieldStart("RetryCount")
+			s.RetryCount.Encode(e)
+		}
+	}
+	{
+		if s.RetryIntervalSeconds.Set {
+			e.FieldStart("RetryIntervalSeconds")
+			s.RetryIntervalSeconds.Encode(e)
+		}
+	}
+	{
+		if s.TargetComponentURI.Set {
+			e.FieldStart("TargetComponentURI")
+			s.TargetComponentURI.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishJobJobsItemMessagesItemResolutionStepsItem = [8]string{
+	0: "ActionParameters",
+	1: "ActionURI",
+	2: "Oem",
+	3: "Priority",
+	4: "ResolutionType",
+	5: "RetryCount",
+	6: "RetryIntervalSeconds",
+	7: "TargetComponentURI",
+}
+
+// Decode decodes RedfishJobJobsItemMessagesItemResolutionStepsItem from json.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishJobJobsItemMessagesItemResolutionStepsItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ActionParameters":
+			if err := func() error {
+				s.ActionParameters = make([]RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ActionParameters = append(s.ActionParameters, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ActionParameters\"")
+			}
+		case "ActionURI":
+			if err := func() error {
+				s.ActionURI.Reset()
+				if err := s.ActionURI.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ActionURI\"")
+			}
+		case "Oem":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Oem\"")
+			}
+		case "Priority":
+			if err := func() error {
+				s.Priority.Reset()
+				if err := s.Priority.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Priority\"")
+			}
+		case "ResolutionType":
+			if err := func() error {
+				s.ResolutionType.Reset()
+				if err := s.ResolutionType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ResolutionType\"")
+			}
+		case "RetryCount":
+			if err := func() error {
+				s.RetryCount.Reset()
+				if err := s.RetryCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RetryCount\"")
+			}
+		case "RetryIntervalSeconds":
+			if err := func() error {
+				s.RetryIntervalSeconds.Reset()
+				if err := s.RetryIntervalSeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RetryIntervalSeconds\"")
+			}
+		case "TargetComponentURI":
+			if err := func() error {
+				s.TargetComponentURI.Reset()
+				if err := s.TargetComponentURI.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TargetComponentURI\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishJobJobsItemMessagesItemResolutionStepsItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.AllowableNumbers != nil {
+			e.FieldStart("AllowableNumbers")
+			e.ArrStart()
+			for _, elem := range s.AllowableNumbers {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.AllowablePattern.Set {
+			e.FieldStart("AllowablePattern")
+			s.AllowablePattern.Encode(e)
+		}
+	}
+	{
+		if s.AllowableValueDescriptions != nil {
+			e.FieldStart("AllowableValueDescriptions")
+			e.ArrStart()
+			for _, elem := range s.AllowableValueDescriptions {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.AllowableValues != nil {
+			e.FieldStart("AllowableValues")
+			e.ArrStart()
+			for _, elem := range s.AllowableValues {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.ArraySizeMaximum.Set {
+			e.FieldStart("ArraySizeMaximum")
+			s.ArraySizeMaximum.Encode(e)
+		}
+	}
+	{
+		if s.ArraySizeMinimum.Set {
+			e.FieldStart("ArraySizeMinimum")
+			s.ArraySizeMinimum.Encode(e)
+		}
+	}
+	{
+		if s.DataType.Set {
+			e.FieldStart("DataType")
+			s.DataType.Encode(e)
+		}
+	}
+	{
+		if s.DefaultValue.Set {
+			e.FieldStart("DefaultValue")
+			s.DefaultValue.Encode(e)
+		}
+	}
+	{
+		if s.MaximumValue.Set {
+			e.FieldStart("MaximumValue")
+			s.MaximumValue.Encode(e)
+		}
+	}
+	{
+		if s.MinimumValue.Set {
+			e.FieldStart("MinimumValue")
+			s.MinimumValue.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.NoDefaultValue.Set {
+			e.FieldStart("NoDefaultValue")
+			s.NoDefaultValue.Encode(e)
+		}
+	}
+	{
+		if s.ObjectDataType.Set {
+			e.FieldStart("ObjectDataType")
+			s.ObjectDataType.Encode(e)
+		}
+	}
+	{
+		if s.Required.Set {
+			e.FieldStart("Required")
+			s.Required.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem = [14]string{
+	0:  "AllowableNumbers",
+	1:  "AllowablePattern",
+	2:  "AllowableValueDescriptions",
+	3:  "AllowableValues",
+	4:  "ArraySizeMaximum",
+	5:  "ArraySizeMinimum",
+	6:  "DataType",
+	7:  "DefaultValue",
+	8:  "MaximumValue",
+	9:  "MinimumValue",
+	10: "Name",
+	11: "NoDefaultValue",
+	12: "ObjectDataType",
+	13: "Required",
+}
+
+// Decode decodes RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem from json.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "AllowableNumbers":
+			if err := func() error {
+				s.AllowableNumbers = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableNumbers = append(s.AllowableNumbers, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowableNumbers\"")
+			}
+		case "AllowablePattern":
+			if err := func() error {
+				s.AllowablePattern.Reset()
+				if err := s.AllowablePattern.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowablePattern\"")
+			}
+		case "AllowableValueDescriptions":
+			if err := func() error {
+				s.AllowableValueDescriptions = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowableValueDescriptions\"")
+			}
+		case "AllowableValues":
+			if err := func() error {
+				s.AllowableValues = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableValues = append(s.AllowableValues, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowableValues\"")
+			}
+		case "ArraySizeMaximum":
+			if err := func() error {
+				s.ArraySizeMaximum.Reset()
+				if err := s.ArraySizeMaximum.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ArraySizeMaximum\"")
+			}
+		case "ArraySizeMinimum":
+			if err := func() error {
+				s.ArraySizeMinimum.Reset()
+				if err := s.ArraySizeMinimum.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ArraySizeMinimum\"")
+			}
+		case "DataType":
+			if err := func() error {
+				s.DataType.Reset()
+				if err := s.DataType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DataType\"")
+			}
+		case "DefaultValue":
+			if err := func() error {
+				s.DefaultValue.Reset()
+				if err := s.DefaultValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DefaultValue\"")
+			}
+		case "MaximumValue":
+			if err := func() error {
+				s.MaximumValue.Reset()
+				if err := s.MaximumValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaximumValue\"")
+			}
+		case "MinimumValue":
+			if err := func() error {
+				s.MinimumValue.Reset()
+				if err := s.MinimumValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MinimumValue\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "NoDefaultValue":
+			if err := func() error {
+				s.NoDefaultValue.Reset()
+				if err := s.NoDefaultValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"NoDefaultValue\"")
+			}
+		case "ObjectDataType":
+			if err := func() error {
+				s.ObjectDataType.Reset()
+				if err := s.ObjectDataType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ObjectDataType\"")
+			}
+		case "Required":
+			if err := func() error {
+				s.Required.Reset()
+				if err := s.Required.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Required\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s RedfishJobJobsItemParameters) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s RedfishJobJobsItemParameters) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		if len(elem) != 0 {
+			e.Raw(elem)
+		}
+	}
+}
+
+// Decode decodes RedfishJobJobsItemParameters from json.
+func (s *RedfishJobJobsItemParameters) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishJobJobsItemParameters to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem jx.Raw
+		if err := func() error {
+			v, err := d.RawAppend(nil)
+			elem = jx.Raw(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishJobJobsItemParameters")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s RedfishJobJobsItemParameters) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishJobJobsItemParameters) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishJobJobsItemPayload) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishJobJobsItemPayload) encodeFields(e *jx.Encoder) {
+	{
+		if s.HttpHeaders.Set {
+			e.FieldStart("HttpHeaders")
+			s.HttpHeaders.Encode(e)
+		}
+	}
+	{
+		if s.HttpOperation.Set {
+			e.FieldStart("HttpOperation")
+			s.HttpOperation.Encode(e)
+		}
+	}
+	{
+		if s.JsonBody.Set {
+			e.FieldStart("JsonBody")
+			s.JsonBody.Encode(e)
+		}
+	}
+	{
+		if s.TargetUri.Set {
+			e.FieldStart("TargetUri")
+			s.TargetUri.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishJobJobsItemPayload = [4]string{
+	0: "HttpHeaders",
+	1: "HttpOperation",
+	2: "JsonBody",
+	3: "TargetUri",
+}
+
+// Decode decodes RedfishJobJobsItemPayload from json.
+func (s *RedfishJobJobsItemPayload) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishJobJobsItemPayload to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "HttpHeaders":
+			if err := func() error {
+				s.HttpHeaders.Reset()
+				if err := s.HttpHeaders.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"HttpHeaders\"")
+			}
+		case "HttpOperation":
+			if err := func() error {
+				s.HttpOperation.Reset()
+				if err := s.HttpOperation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"HttpOperation\"")
+			}
+		case "JsonBody":
+			if err := func() error {
+				s.JsonBody.Reset()
+				if err := s.JsonBody.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"JsonBody\"")
+			}
+		case "TargetUri":
+			if err := func() error {
+				s.TargetUri.Reset()
+				if err := s.TargetUri.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TargetUri\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishJobJobsItemPayload")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishJobJobsItemPayload) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishJobJobsItemPayload) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishJobJobsItemSchedule) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishJobJobsItemSchedule) encodeFields(e *jx.Encoder) {
+	{
+		if s.EnabledDaysOfMonth.Set {
+			e.FieldStart("EnabledDaysOfMonth")
+			s.EnabledDaysOfMonth.Encode(e)
+		}
+	}
+	{
+		if s.EnabledDaysOfWeek.Set {
+			e.FieldStart("EnabledDaysOfWeek")
+			s.EnabledDaysOfWeek.Encode(e)
+		}
+	}
+	{
+		if s.EnabledIntervals.Set {
+			e.FieldStart("EnabledIntervals")
+			s.EnabledIntervals.Encode(e)
+		}
+	}
+	{
+		if s.EnabledMonthsOfYear.Set {
+			e.FieldStart("EnabledMonthsOfYear")
+			s.EnabledMonthsOfYear.Encode(e)
+		}
+	}
+	{
+		if s.InitialStartTime.Set {
+			e.FieldStart("InitialStartTime")
+			s.InitialStartTime.Encode(e)
+		}
+	}
+	{
+		if s.Lifetime.Set {
+			e.FieldStart("Lifetime")
+			s.Lifetime.Encode(e)
+		}
+	}
+	{
+		if s.MaxOccurrences.Set {
+			e.FieldStart("MaxOccurrences")
+			s.MaxOccurrences.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.RecurrenceInterval.Set {
+			e.FieldStart("RecurrenceInterval")
+			s.RecurrenceInterval.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishJobJobsItemSchedule = [9]string{
+	0: "EnabledDaysOfMonth",
+	1: "EnabledDaysOfWeek",
+	2: "EnabledIntervals",
+	3: "EnabledMonthsOfYear",
+	4: "InitialStartTime",
+	5: "Lifetime",
+	6: "MaxOccurrences",
+	7: "Name",
+	8: "RecurrenceInterval",
+}
+
+// Decode decodes RedfishJobJobsItemSchedule from json.
+func (s *RedfishJobJobsItemSchedule) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishJobJobsItemSchedule to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "EnabledDaysOfMonth":
+			if err := func() error {
+				s.EnabledDaysOfMonth.Reset()
+				if err := s.EnabledDaysOfMonth.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EnabledDaysOfMonth\"")
+			}
+		case "EnabledDaysOfWeek":
+			if err := func() error {
+				s.EnabledDaysOfWeek.Reset()
+				if err := s.EnabledDaysOfWeek.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EnabledDaysOfWeek\"")
+			}
+		case "EnabledIntervals":
+			if err := func() error {
+				s.EnabledIntervals.Reset()
+				if err := s.EnabledIntervals.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EnabledIntervals\"")
+			}
+		case "EnabledMonthsOfYear":
+			if err := func() error {
+				s.EnabledMonthsOfYear.Reset()
+				if err := s.EnabledMonthsOfYear.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EnabledMonthsOfYear\"")
+			}
+		case "InitialStartTime":
+			if err := func() error {
+				s.InitialStartTime.Reset()
+				if err := s.InitialStartTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"InitialStartTime\"")
+			}
+		case "Lifetime":
+			if err := func() error {
+				s.Lifetime.Reset()
+				if err := s.Lifetime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Lifetime\"")
+			}
+		case "MaxOccurrences":
+			if err := func() error {
+				s.MaxOccurrences.Reset()
+				if err := s.MaxOccurrences.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaxOccurrences\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "RecurrenceInterval":
+			if err := func() error {
+				s.RecurrenceInterval.Reset()
+				if err := s.RecurrenceInterval.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RecurrenceInterval\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishJobJobsItemSchedule")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishJobJobsItemSchedule) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishJobJobsItemSchedule) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishMetricReport) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishMetricReport) encodeFields(e *jx.Encoder) {
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Reports != nil {
+			e.FieldStart("reports")
+			e.ArrStart()
+			for _, elem := range s.Reports {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishMetricReport = [2]string{
+	0: "name",
+	1: "reports",
+}
+
+// Decode decodes RedfishMetricReport from json.
+func (s *RedfishMetricReport) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishMetricReport to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "reports":
+			if err := func() error {
+				s.Reports = make([]NilRedfishMetricReportReportsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilRedfishMetricReportReportsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Reports = append(s.Reports, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reports\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishMetricReport")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishMetricReport) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishMetricReport) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishMetricReportReportsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishMetricReportReportsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.MessageDotExtendedInfo != nil {
+			e.FieldStart("@Message.ExtendedInfo")
+			e.ArrStart()
+			for _, elem := range s.MessageDotExtendedInfo {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.OdataDotContext.Set {
+			e.FieldStart("@odata.context")
+			s.OdataDotContext.Encode(e)
+		}
+	}
+	{
+		if s.OdataDotEtag.Set {
+			e.FieldStart("@odata.etag")
+			s.OdataDotEtag.Encode(e)
+		}
+	}
+	{
+		if s.OdataDotID.Set {
+			e.FieldStart("@odata.id")
+			s.OdataDotID.Encode(e)
+		}
+	}
+	{
+		if s.OdataDotType.Set {
+			e.FieldStart("@odata.type")
+			s.OdataDotType.Encode(e)
+		}
+	}
+	{
+		if s.Context.Set {
+			e.FieldStart("Context")
+			s.Context.Encode(e)
+		}
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("Description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("Id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.MetricValues != nil {
+			e.FieldStart("MetricValues")
+			e.ArrStart()
+			for _, elem := range s.MetricValues {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.ReportSequence.Set {
+			e.FieldStart("ReportSequence")
+			s.ReportSequence.Encode(e)
+		}
+	}
+	{
+		if s.Timestamp.Set {
+			e.FieldStart("Timestamp")
+			s.Timestamp.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishMetricReportReportsItem = [13]string{
+	0:  "@Message.ExtendedInfo",
+	1:  "@odata.context",
+	2:  "@odata.etag",
+	3:  "@odata.id",
+	4:  "@odata.type",
+	5:  "Context",
+	6:  "Description",
+	7:  "Id",
+	8:  "MetricValues",
+	9:  "Name",
+	10: "Oem",
+	11: "ReportSequence",
+	12: "Timestamp",
+}
+
+// Decode decodes RedfishMetricReportReportsItem from json.
+func (s *RedfishMetricReportReportsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishMetricReportReportsItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "@Message.ExtendedInfo":
+			if err := func() error {
+				s.MessageDotExtendedInfo = make([]RedfishMetricReportReportsItemMessageDotExtendedInfoItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.MessageDotExtendedInfo = append(s.MessageDotExtendedInfo, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@Message.ExtendedInfo\"")
+			}
+		case "@odata.context":
+			if err := func() error {
+				s.OdataDotContext.Reset()
+				if err := s.OdataDotContext.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.context\"")
+			}
+		case "@odata.etag":
+			if err := func() error {
+				s.OdataDotEtag.Reset()
+				if err := s.OdataDotEtag.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.etag\"")
+			}
+		case "@odata.id":
+			if err := func() error {
+				s.OdataDotID.Reset()
+				if err := s.OdataDotID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.id\"")
+			}
+		case "@odata.type":
+			if err := func() error {
+				s.OdataDotType.Reset()
+				if err := s.OdataDotType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.type\"")
+			}
+		case "Context":
+			if err := func() error {
+				s.Context.Reset()
+				if err := s.Context.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Context\"")
+			}
+		case "Description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "Id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Id\"")
+			}
+		case "MetricValues":
+			if err := func() error {
+				s.MetricValues = make([]RedfishMetricReportReportsItemMetricValuesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishMetricReportReportsItemMetricValuesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.MetricValues = append(s.MetricValues, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MetricValues\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "Oem":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Oem\"")
+			}
+		case "ReportSequence":
+			if err := func() error {
+				s.ReportSequence.Reset()
+				if err := s.ReportSequence.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ReportSequence\"")
+			}
+		case "Timestamp":
+			if err := func() error {
+				s.Timestamp.Reset()
+				if err := s.Timestamp.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Timestamp\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishMetricReportReportsItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishMetricReportReportsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishMetricReportReportsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.MessageArgs != nil {
+			e.FieldStart("MessageArgs")
+			e.ArrStart()
+			for _, elem := range s.MessageArgs {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.MessageId.Set {
+			e.FieldStart("MessageId")
+			s.MessageId.Encode(e)
+		}
+	}
+	{
+		if s.MessageSeverity.Set {
+			e.FieldStart("MessageSeverity")
+			s.MessageSeverity.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.RelatedProperties.Set {
+			e.FieldStart("RelatedProperties")
+			s.RelatedProperties.Encode(e)
+		}
+	}
+	{
+		if s.Resolution.Set {
+			e.FieldStart("Resolution")
+			s.Resolution.Encode(e)
+		}
+	}
+	{
+		if s.ResolutionSteps != nil {
+			e.FieldStart("ResolutionSteps")
+			e.ArrStart()
+			for _, elem := range s.ResolutionSteps {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Severity.Set {
+			e.FieldStart("Severity")
+			s.Severity.Encode(e)
+		}
+	}
+	{
+		if s.UserAuthenticationSource.Set {
+			e.FieldStart("UserAuthenticationSource")
+			s.UserAuthenticationSource.Encode(e)
+		}
+	}
+	{
+		if s.Username.Set {
+			e.FieldStart("Username")
+			s.Username.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishMetricReportReportsItemMessageDotExtendedInfoItem = [11]string{
+	0:  "Message",
+	1:  "MessageArgs",
+	2:  "MessageId",
+	3:  "MessageSeverity",
+	4:  "Oem",
+	5:  "RelatedProperties",
+	6:  "Resolution",
+	7:  "ResolutionSteps",
+	8:  "Severity",
+	9:  "UserAuthenticationSource",
+	10: "Username",
+}
+
+// Decode decodes RedfishMetricReportReportsItemMessageDotExtendedInfoItem from json.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishMetricReportReportsItemMessageDotExtendedInfoItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Message\"")
+			}
+		case "MessageArgs":
+			if err := func() error {
+				s.MessageArgs = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.MessageArgs = append(s.MessageArgs, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MessageArgs\"")
+			}
+		case "MessageId":
+			if err := func() error {
+				s.MessageId.Reset()
+				if err := s.MessageId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MessageId\"")
+			}
+		case "MessageSeverity":
+			if err := func() error {
+				s.MessageSeverity.Reset()
+				if err := s.MessageSeverity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MessageSeverity\"")
+			}
+		case "Oem":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Oem\"")
+			}
+		case "RelatedProperties":
+			if err := func() error {
+				s.RelatedProperties.Reset()
+				if err := s.RelatedProperties.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RelatedProperties\"")
+			}
+		case "Resolution":
+			if err := func() error {
+				s.Resolution.Reset()
+				if err := s.Resolution.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Resolution\"")
+			}
+		case "ResolutionSteps":
+			if err := func() error {
+				s.ResolutionSteps = make([]RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ResolutionSteps = append(s.ResolutionSteps, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ResolutionSteps\"")
+			}
+		case "Severity":
+			if err := func() error {
+				s.Severity.Reset()
+				if err := s.Severity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Severity\"")
+			}
+		case "UserAuthenticationSource":
+			if err := func() error {
+				s.UserAuthenticationSource.Reset()
+				if err := s.UserAuthenticationSource.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"UserAuthenticationSource\"")
+			}
+		case "Username":
+			if err := func() error {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Username\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishMetricReportReportsItemMessageDotExtendedInfoItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.ActionParameters != nil {
+			e.FieldStart("ActionParameters")
+			e.ArrStart()
+			for _, elem := range s.ActionParameters {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.ActionURI.Set {
+			e.FieldStart("ActionURI")
+			s.ActionURI.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.Priority.Set {
+			e.FieldStart("Priority")
+			s.Priority.Encode(e)
+		}
+	}
+	{
+		if s.ResolutionType.Set {
+			e.FieldStart("ResolutionType")
+			s.ResolutionType.Encode(e)
+		}
+	}
+	{
+		if s.RetryCount.Set {
+			e.FieldStart("RetryCount")
+			s.RetryCount.Encode(e)
+		}
+	}
+	{
+		if s.RetryIntervalSeconds.Set {
+			e.FieldStart("RetryIntervalSeconds")
+			s.RetryIntervalSeconds.Encode(e)
+		}
+	}
+	{
+		if s.TargetComponentURI.Set {
+			e.FieldStart("TargetComponentURI")
+			s.TargetComponentURI.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem = [8]string{
+	0: "ActionParameters",
+	1: "ActionURI",
+	2: "Oem",
+	3: "Priority",
+	4: "ResolutionType",
+	5: "RetryCount",
+	6: "RetryIntervalSeconds",
+	7: "TargetComponentURI",
+}
+
+// Decode decodes RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem from json.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ActionParameters":
+			if err := func() error {
+				s.ActionParameters = make([]RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ActionParameters = append(s.ActionParameters, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ActionParameters\"")
+			}
+		case "ActionURI":
+			if err := func() error {
+				s.ActionURI.Reset()
+				if err := s.ActionURI.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ActionURI\"")
+			}
+		case "Oem":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Oem\"")
+			}
+		case "Priority":
+			if err := func() error {
+				s.Priority.Reset()
+				if err := s.Priority.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Priority\"")
+			}
+		case "ResolutionType":
+			if err := func() error {
+				s.ResolutionType.Reset()
+				if err := s.ResolutionType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ResolutionType\"")
+			}
+		case "RetryCount":
+			if err := func() error {
+				s.RetryCount.Reset()
+				if err := s.RetryCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RetryCount\"")
+			}
+		case "RetryIntervalSeconds":
+			if err := func() error {
+				s.RetryIntervalSeconds.Reset()
+				if err := s.RetryIntervalSeconds.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"RetryIntervalSeconds\"")
+			}
+		case "TargetComponentURI":
+			if err := func() error {
+				s.TargetComponentURI.Reset()
+				if err := s.TargetComponentURI.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TargetComponentURI\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.AllowableNumbers != nil {
+			e.FieldStart("AllowableNumbers")
+			e.ArrStart()
+			for _, elem := range s.AllowableNumbers {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.AllowablePattern.Set {
+			e.FieldStart("AllowablePattern")
+			s.AllowablePattern.Encode(e)
+		}
+	}
+	{
+		if s.AllowableValueDescriptions != nil {
+			e.FieldStart("AllowableValueDescriptions")
+			e.ArrStart()
+			for _, elem := range s.AllowableValueDescriptions {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.AllowableValues != nil {
+			e.FieldStart("AllowableValues")
+			e.ArrStart()
+			for _, elem := range s.AllowableValues {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.ArraySizeMaximum.Set {
+			e.FieldStart("ArraySizeMaximum")
+			s.ArraySizeMaximum.Encode(e)
+		}
+	}
+	{
+		if s.ArraySizeMinimum.Set {
+			e.FieldStart("ArraySizeMinimum")
+			s.ArraySizeMinimum.Encode(e)
+		}
+	}
+	{
+		if s.DataType.Set {
+			e.FieldStart("DataType")
+			s.DataType.Encode(e)
+		}
+	}
+	{
+		if s.DefaultValue.Set {
+			e.FieldStart("DefaultValue")
+			s.DefaultValue.Encode(e)
+		}
+	}
+	{
+		if s.MaximumValue.Set {
+			e.FieldStart("MaximumValue")
+			s.MaximumValue.Encode(e)
+		}
+	}
+	{
+		if s.MinimumValue.Set {
+			e.FieldStart("MinimumValue")
+			s.MinimumValue.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.NoDefaultValue.Set {
+			e.FieldStart("NoDefaultValue")
+			s.NoDefaultValue.Encode(e)
+		}
+	}
+	{
+		if s.ObjectDataType.Set {
+			e.FieldStart("ObjectDataType")
+			s.ObjectDataType.Encode(e)
+		}
+	}
+	{
+		if s.Required.Set {
+			e.FieldStart("Required")
+			s.Required.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem = [14]string{
+	0:  "AllowableNumbers",
+	1:  "AllowablePattern",
+	2:  "AllowableValueDescriptions",
+	3:  "AllowableValues",
+	4:  "ArraySizeMaximum",
+	5:  "ArraySizeMinimum",
+	6:  "DataType",
+	7:  "DefaultValue",
+	8:  "MaximumValue",
+	9:  "MinimumValue",
+	10: "Name",
+	11: "NoDefaultValue",
+	12: "ObjectDataType",
+	13: "Required",
+}
+
+// Decode decodes RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem from json.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "AllowableNumbers":
+			if err := func() error {
+				s.AllowableNumbers = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableNumbers = append(s.AllowableNumbers, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowableNumbers\"")
+			}
+		case "AllowablePattern":
+			if err := func() error {
+				s.AllowablePattern.Reset()
+				if err := s.AllowablePattern.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowablePattern\"")
+			}
+		case "AllowableValueDescriptions":
+			if err := func() error {
+				s.AllowableValueDescriptions = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowableValueDescriptions\"")
+			}
+		case "AllowableValues":
+			if err := func() error {
+				s.AllowableValues = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableValues = append(s.AllowableValues, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"AllowableValues\"")
+			}
+		case "ArraySizeMaximum":
+			if err := func() error {
+				s.ArraySizeMaximum.Reset()
+				if err := s.ArraySizeMaximum.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ArraySizeMaximum\"")
+			}
+		case "ArraySizeMinimum":
+			if err := func() error {
+				s.ArraySizeMinimum.Reset()
+				if err := s.ArraySizeMinimum.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ArraySizeMinimum\"")
+			}
+		case "DataType":
+			if err := func() error {
+				s.DataType.Reset()
+				if err := s.DataType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DataType\"")
+			}
+		case "DefaultValue":
+			if err := func() error {
+				s.DefaultValue.Reset()
+				if err := s.DefaultValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"DefaultValue\"")
+			}
+		case "MaximumValue":
+			if err := func() error {
+				s.MaximumValue.Reset()
+				if err := s.MaximumValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaximumValue\"")
+			}
+		case "MinimumValue":
+			if err := func() error {
+				s.MinimumValue.Reset()
+				if err := s.MinimumValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MinimumValue\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "NoDefaultValue":
+			if err := func() error {
+				s.NoDefaultValue.Reset()
+				if err := s.NoDefaultValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"NoDefaultValue\"")
+			}
+		case "ObjectDataType":
+			if err := func() error {
+				s.ObjectDataType.Reset()
+				if err := s.ObjectDataType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ObjectDataType\"")
+			}
+		case "Required":
+			if err := func() error {
+				s.Required.Reset()
+				if err := s.Required.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Required\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.MetricId.Set {
+			e.FieldStart("MetricId")
+			s.MetricId.Encode(e)
+		}
+	}
+	{
+		if s.MetricProperty.Set {
+			e.FieldStart("MetricProperty")
+			s.MetricProperty.Encode(e)
+		}
+	}
+	{
+		if s.MetricValue.Set {
+			e.FieldStart("MetricValue")
+			s.MetricValue.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.Timestamp.Set {
+			e.FieldStart("Timestamp")
+			s.Timestamp.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishMetricReportReportsItemMetricValuesItem = [5]string{
+	0: "MetricId",
+	1: "MetricProperty",
+	2: "MetricValue",
+	3: "Oem",
+	4: "Timestamp",
+}
+
+// Decode decodes RedfishMetricReportReportsItemMetricValuesItem from json.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishMetricReportReportsItemMetricValuesItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "MetricId":
+			if err := func() error {
+				s.MetricId.Reset()
+				if err := s.MetricId.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MetricId\"")
+			}
+		case "MetricProperty":
+			if err := func() error {
+				s.MetricProperty.Reset()
+				if err := s.MetricProperty.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MetricProperty\"")
+			}
+		case "MetricValue":
+			if err := func() error {
+				s.MetricValue.Reset()
+				if err := s.MetricValue.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MetricValue\"")
+			}
+		case "Oem":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Oem\"")
+			}
+		case "Timestamp":
+			if err := func() error {
+				s.Timestamp.Reset()
+				if err := s.Timestamp.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Timestamp\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishMetricReportReportsItemMetricValuesItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishSystem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishSystem) encodeFields(e *jx.Encoder) {
+	{
+		if s.BiosVersion.Set {
+			e.FieldStart("bios_version")
+			s.BiosVersion.Encode(e)
+		}
+	}
+	{
+		if s.BootNext.Set {
+			e.FieldStart("boot_next")
+			s.BootNext.Encode(e)
+		}
+	}
+	{
+		if s.BootOrder.Set {
+			e.FieldStart("boot_order")
+			s.BootOrder.Encode(e)
+		}
+	}
+	{
+		if s.Health.Set {
+			e.FieldStart("health")
+			s.Health.Encode(e)
+		}
+	}
+	{
+		if s.HostName.Set {
+			e.FieldStart("host_name")
+			s.HostName.Encode(e)
+		}
+	}
+	{
+		if s.Manufacturer.Set {
+			e.FieldStart("manufacturer")
+			s.Manufacturer.Encode(e)
+		}
+	}
+	{
+		if s.Model.Set {
+			e.FieldStart("model")
+			s.Model.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.OemDell.Set {
+			e.FieldStart("oem_dell")
+			s.OemDell.Encode(e)
+		}
+	}
+	{
+		if s.PowerStatus.Set {
+			e.FieldStart("power_status")
+			s.PowerStatus.Encode(e)
+		}
+	}
+	{
+		if s.ProcessorCount.Set {
+			e.FieldStart("processor_count")
+			s.ProcessorCount.Encode(e)
+		}
+	}
+	{
+		if s.SerialNumber.Set {
+			e.FieldStart("serial_number")
+			s.SerialNumber.Encode(e)
+		}
+	}
+	{
+		if s.TotalMemory.Set {
+			e.FieldStart("total_memory")
+			s.TotalMemory.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishSystem = [13]string{
+	0:  "bios_version",
+	1:  "boot_next",
+	2:  "boot_order",
+	3:  "health",
+	4:  "host_name",
+	5:  "manufacturer",
+	6:  "model",
+	7:  "name",
+	8:  "oem_dell",
+	9:  "power_status",
+	10: "processor_count",
+	11: "serial_number",
+	12: "total_memory",
+}
+
+// Decode decodes RedfishSystem from json.
+func (s *RedfishSystem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishSystem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "bios_version":
+			if err := func() error {
+				s.BiosVersion.Reset()
+				if err := s.BiosVersion.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"bios_version\"")
+			}
+		case "boot_next":
+			if err := func() error {
+				s.BootNext.Reset()
+				if err := s.BootNext.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"boot_next\"")
+			}
+		case "boot_order":
+			if err := func() error {
+				s.BootOrder.Reset()
+				if err := s.BootOrder.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"boot_order\"")
+			}
+		case "health":
+			if err := func() error {
+				s.Health.Reset()
+				if err := s.Health.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"health\"")
+			}
+		case "host_name":
+			if err := func() error {
+				s.HostName.Reset()
+				if err := s.HostName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"host_name\"")
+			}
+		case "manufacturer":
+			if err := func() error {
+				s.Manufacturer.Reset()
+				if err := s.Manufacturer.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"manufacturer\"")
+			}
+		case "model":
+			if err := func() error {
+				s.Model.Reset()
+				if err := s.Model.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"model\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "oem_dell":
+			if err := func() error {
+				s.OemDell.Reset()
+				if err := s.OemDell.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"oem_dell\"")
+			}
+		case "power_status":
+			if err := func() error {
+				s.PowerStatus.Reset()
+				if err := s.PowerStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"power_status\"")
+			}
+		case "processor_count":
+			if err := func() error {
+				s.ProcessorCount.Reset()
+				if err := s.ProcessorCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"processor_count\"")
+			}
+		case "serial_number":
+			if err := func() error {
+				s.SerialNumber.Reset()
+				if err := s.SerialNumber.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"serial_number\"")
+			}
+		case "total_memory":
+			if err := func() error {
+				s.TotalMemory.Reset()
+				if err := s.TotalMemory.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"total_memory\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishSystem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishSystem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishSystem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishSystemOemDell) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishSystemOemDell) encodeFields(e *jx.Encoder) {
+	{
+		if s.MessageDotExtendedInfo.Set {
+			e.FieldStart("@Message.ExtendedInfo")
+			s.MessageDotExtendedInfo.Encode(e)
+		}
+	}
+	{
+		if s.OdataDotContext.Set {
+			e.FieldStart("@odata.context")
+			s.OdataDotContext.Encode(e)
+		}
+	}
+	{
+		if s.OdataDotEtag.Set {
+			e.FieldStart("@odata.etag")
+			s.OdataDotEtag.Encode(e)
+		}
+	}
+	{
+		if s.OdataDotID.Set {
+			e.FieldStart("@odata.id")
+			s.OdataDotID.Encode(e)
+		}
+	}
+	{
+		if s.OdataDotType.Set {
+			e.FieldStart("@odata.type")
+			s.OdataDotType.Encode(e)
+		}
+	}
+	{
+		if s.BIOSReleaseDate.Set {
+			e.FieldStart("BIOSReleaseDate")
+			s.BIOSReleaseDate.Encode(e)
+		}
+	}
+	{
+		if s.BaseBoardChassisSlot.Set {
+			e.FieldStart("BaseBoardChassisSlot")
+			s.BaseBoardChassisSlot.Encode(e)
+		}
+	}
+	{
+		if s.BatteryRollupStatus.Set {
+			e.FieldStart("BatteryRollupStatus")
+			s.BatteryRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.BladeGeometry.Set {
+			e.FieldStart("BladeGeometry")
+			s.BladeGeometry.Encode(e)
+		}
+	}
+	{
+		if s.CMCIP.Set {
+			e.FieldStart("CMCIP")
+			s.CMCIP.Encode(e)
+		}
+	}
+	{
+		if s.CPURollupStatus.Set {
+			e.FieldStart("CPURollupStatus")
+			s.CPURollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.ChassisModel.Set {
+			e.FieldStart("ChassisModel")
+			s.ChassisModel.Encode(e)
+		}
+	}
+	{
+		if s.ChassisName.Set {
+			e.FieldStart("ChassisName")
+			s.ChassisName.Encode(e)
+		}
+	}
+	{
+		if s.ChassisServiceTag.Set {
+			e.FieldStart("ChassisServiceTag")
+			s.ChassisServiceTag.Encode(e)
+		}
+	}
+	{
+		if s.ChassisSystemHeightUnit.Set {
+			e.FieldStart("ChassisSystemHeightUnit")
+			s.ChassisSystemHeightUnit.Encode(e)
+		}
+	}
+	{
+		if s.CurrentRollupStatus.Set {
+			e.FieldStart("CurrentRollupStatus")
+			s.CurrentRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("Description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.EstimatedExhaustTemperatureCelsius.Set {
+			e.FieldStart("EstimatedExhaustTemperatureCelsius")
+			s.EstimatedExhaustTemperatureCelsius.Encode(e)
+		}
+	}
+	{
+		if s.EstimatedSystemAirflowCFM.Set {
+			e.FieldStart("EstimatedSystemAirflowCFM")
+			s.EstimatedSystemAirflowCFM.Encode(e)
+		}
+	}
+	{
+		if s.ExpressServiceCode.Set {
+			e.FieldStart("ExpressServiceCode")
+			s.ExpressServiceCode.Encode(e)
+		}
+	}
+	{
+		if s.FanRollupStatus.Set {
+			e.FieldStart("FanRollupStatus")
+			s.FanRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.IDSDMRollupStatus.Set {
+			e.FieldStart("IDSDMRollupStatus")
+			s.IDSDMRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("Id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.IntrusionRollupStatus.Set {
+			e.FieldStart("IntrusionRollupStatus")
+			s.IntrusionRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.IsOEMBranded.Set {
+			e.FieldStart("IsOEMBranded")
+			s.IsOEMBranded.Encode(e)
+		}
+	}
+	{
+		if s.LastSystemInventoryTime.Set {
+			e.FieldStart("LastSystemInventoryTime")
+			s.LastSystemInventoryTime.Encode(e)
+		}
+	}
+	{
+		if s.LastUpdateTime.Set {
+			e.FieldStart("LastUpdateTime")
+			s.LastUpdateTime.Encode(e)
+		}
+	}
+	{
+		if s.LicensingRollupStatus.Set {
+			e.FieldStart("LicensingRollupStatus")
+			s.LicensingRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.ManagedSystemSize.Set {
+			e.FieldStart("ManagedSystemSize")
+			s.ManagedSystemSize.Encode(e)
+		}
+	}
+	{
+		if s.MaxCPUSockets.Set {
+			e.FieldStart("MaxCPUSockets")
+			s.MaxCPUSockets.Encode(e)
+		}
+	}
+	{
+		if s.MaxDIMMSlots.Set {
+			e.FieldStart("MaxDIMMSlots")
+			s.MaxDIMMSlots.Encode(e)
+		}
+	}
+	{
+		if s.MaxPCIeSlots.Set {
+			e.FieldStart("MaxPCIeSlots")
+			s.MaxPCIeSlots.Encode(e)
+		}
+	}
+	{
+		if s.MemoryOperationMode.Set {
+			e.FieldStart("MemoryOperationMode")
+			s.MemoryOperationMode.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.NodeID.Set {
+			e.FieldStart("NodeID")
+			s.NodeID.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.PSRollupStatus.Set {
+			e.FieldStart("PSRollupStatus")
+			s.PSRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.PlatformGUID.Set {
+			e.FieldStart("PlatformGUID")
+			s.PlatformGUID.Encode(e)
+		}
+	}
+	{
+		if s.PopulatedDIMMSlots.Set {
+			e.FieldStart("PopulatedDIMMSlots")
+			s.PopulatedDIMMSlots.Encode(e)
+		}
+	}
+	{
+		if s.PopulatedPCIeSlots.Set {
+			e.FieldStart("PopulatedPCIeSlots")
+			s.PopulatedPCIeSlots.Encode(e)
+		}
+	}
+	{
+		if s.PowerCapEnabledState.Set {
+			e.FieldStart("PowerCapEnabledState")
+			s.PowerCapEnabledState.Encode(e)
+		}
+	}
+	{
+		if s.SDCardRollupStatus.Set {
+			e.FieldStart("SDCardRollupStatus")
+			s.SDCardRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.SELRollupStatus.Set {
+			e.FieldStart("SELRollupStatus")
+			s.SELRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.ServerAllocationWatts.Set {
+			e.FieldStart("ServerAllocationWatts")
+			s.ServerAllocationWatts.Encode(e)
+		}
+	}
+	{
+		if s.StorageRollupStatus.Set {
+			e.FieldStart("StorageRollupStatus")
+			s.StorageRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.SysMemErrorMethodology.Set {
+			e.FieldStart("SysMemErrorMethodology")
+			s.SysMemErrorMethodology.Encode(e)
+		}
+	}
+	{
+		if s.SysMemFailOverState.Set {
+			e.FieldStart("SysMemFailOverState")
+			s.SysMemFailOverState.Encode(e)
+		}
+	}
+	{
+		if s.SysMemLocation.Set {
+			e.FieldStart("SysMemLocation")
+			s.SysMemLocation.Encode(e)
+		}
+	}
+	{
+		if s.SysMemPrimaryStatus.Set {
+			e.FieldStart("SysMemPrimaryStatus")
+			s.SysMemPrimaryStatus.Encode(e)
+		}
+	}
+	{
+		if s.SystemGeneration.Set {
+			e.FieldStart("SystemGeneration")
+			s.SystemGeneration.Encode(e)
+		}
+	}
+	{
+		if s.SystemID.Set {
+			e.FieldStart("SystemID")
+			s.SystemID.Encode(e)
+		}
+	}
+	{
+		if s.SystemRevision.Set {
+			e.FieldStart("SystemRevision")
+			s.SystemRevision.Encode(e)
+		}
+	}
+	{
+		if s.TempRollupStatus.Set {
+			e.FieldStart("TempRollupStatus")
+			s.TempRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.TempStatisticsRollupStatus.Set {
+			e.FieldStart("TempStatisticsRollupStatus")
+			s.TempStatisticsRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.UUID.Set {
+			e.FieldStart("UUID")
+			s.UUID.Encode(e)
+		}
+	}
+	{
+		if s.VoltRollupStatus.Set {
+			e.FieldStart("VoltRollupStatus")
+			s.VoltRollupStatus.Encode(e)
+		}
+	}
+	{
+		if s.SmbiosGUID.Set {
+			e.FieldStart("smbiosGUID")
+			s.SmbiosGUID.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishSystemOemDell = [57]string{
+	0:  "@Message.ExtendedInfo",
+	1:  "@odata.context",
+	2:  "@odata.etag",
+	3:  "@odata.id",
+	4:  "@odata.type",
+	5:  "BIOSReleaseDate",
+	6:  "BaseBoardChassisSlot",
+	7:  "BatteryRollupStatus",
+	8:  "BladeGeometry",
+	9:  "CMCIP",
+	10: "CPURollupStatus",
+	11: "ChassisModel",
+	12: "ChassisName",
+	13: "ChassisServiceTag",
+	14: "ChassisSystemHeightUnit",
+	15: "CurrentRollupStatus",
+	16: "Description",
+	17: "EstimatedExhaustTemperatureCelsius",
+	18: "EstimatedSystemAirflowCFM",
+	19: "ExpressServiceCode",
+	20: "FanRollupStatus",
+	21: "IDSDMRollupStatus",
+	22: "Id",
+	23: "IntrusionRollupStatus",
+	24: "IsOEMBranded",
+	25: "LastSystemInventoryTime",
+	26: "LastUpdateTime",
+	27: "LicensingRollupStatus",
+	28: "ManagedSystemSize",
+	29: "MaxCPUSockets",
+	30: "MaxDIMMSlots",
+	31: "MaxPCIeSlots",
+	32: "MemoryOperationMode",
+	33: "Name",
+	34: "NodeID",
+	35: "Oem",
+	36: "PSRollupStatus",
+	37: "PlatformGUID",
+	38: "PopulatedDIMMSlots",
+	39: "PopulatedPCIeSlots",
+	40: "PowerCapEnabledState",
+	41: "SDCardRollupStatus",
+	42: "SELRollupStatus",
+	43: "ServerAllocationWatts",
+	44: "StorageRollupStatus",
+	45: "SysMemErrorMethodology",
+	46: "SysMemFailOverState",
+	47: "SysMemLocation",
+	48: "SysMemPrimaryStatus",
+	49: "SystemGeneration",
+	50: "SystemID",
+	51: "SystemRevision",
+	52: "TempRollupStatus",
+	53: "TempStatisticsRollupStatus",
+	54: "UUID",
+	55: "VoltRollupStatus",
+	56: "smbiosGUID",
+}
+
+// Decode decodes RedfishSystemOemDell from json.
+func (s *RedfishSystemOemDell) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishSystemOemDell to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "@Message.ExtendedInfo":
+			if err := func() error {
+				s.MessageDotExtendedInfo.Reset()
+				if err := s.MessageDotExtendedInfo.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@Message.ExtendedInfo\"")
+			}
+		case "@odata.context":
+			if err := func() error {
+				s.OdataDotContext.Reset()
+				if err := s.OdataDotContext.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.context\"")
+			}
+		case "@odata.etag":
+			if err := func() error {
+				s.OdataDotEtag.Reset()
+				if err := s.OdataDotEtag.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.etag\"")
+			}
+		case "@odata.id":
+			if err := func() error {
+				s.OdataDotID.Reset()
+				if err := s.OdataDotID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.id\"")
+			}
+		case "@odata.type":
+			if err := func() error {
+				s.OdataDotType.Reset()
+				if err := s.OdataDotType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"@odata.type\"")
+			}
+		case "BIOSReleaseDate":
+			if err := func() error {
+				s.BIOSReleaseDate.Reset()
+				if err := s.BIOSReleaseDate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"BIOSReleaseDate\"")
+			}
+		case "BaseBoardChassisSlot":
+			if err := func() error {
+				s.BaseBoardChassisSlot.Reset()
+				if err := s.BaseBoardChassisSlot.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"BaseBoardChassisSlot\"")
+			}
+		case "BatteryRollupStatus":
+			if err := func() error {
+				s.BatteryRollupStatus.Reset()
+				if err := s.BatteryRollupStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"BatteryRollupStatus\"")
+			}
+		case "BladeGeometry":
+			if err := func() error {
+				s.BladeGeometry.Reset()
+				if err := s.BladeGeometry.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"BladeGeometry\"")
+			}
+		case "CMCIP":
+			if err := func() error {
+				s.CMCIP.Reset()
+				if err := s.CMCIP.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"CMCIP\"")
+			}
+		case "CPURollupStatus":
+			if err := func() error {
+				s.CPURollupStatus.Reset()
+				if err := s.CPURollupStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"CPURollupStatus\"")
+			}
+		case "ChassisModel":
+			if err := func() error {
+				s.ChassisModel.Reset()
+				if err := s.ChassisModel.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ChassisModel\"")
+			}
+		case "ChassisName":
+			if err := func() error {
+				s.ChassisName.Reset()
+				if err := s.ChassisName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ChassisName\"")
+			}
+		case "ChassisServiceTag":
+			if err := func() error {
+				s.ChassisServiceTag.Reset()
+				if err := s.ChassisServiceTag.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ChassisServiceTag\"")
+			}
+		case "ChassisSystemHeightUnit":
+			if err := func() error {
+				s.ChassisSystemHeightUnit.Reset()
+				if err := s.ChassisSystemHeightUnit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ChassisSystemHeightUnit\"")
+			}
+		case "CurrentRollupStatus":
+			if err := func() error {
+				s.CurrentRollupStatus.Reset()
+				if err := s.CurrentRollupStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"CurrentRollupStatus\"")
+			}
+		case "Description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Description\"")
+			}
+		case "EstimatedExhaustTemperatureCelsius":
+			if err := func() error {
+				s.EstimatedExhaustTemperatureCelsius.Reset()
+				if err := s.EstimatedExhaustTemperatureCelsius.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EstimatedExhaustTemperatureCelsius\"")
+			}
+		case "EstimatedSystemAirflowCFM":
+			if err := func() error {
+				s.EstimatedSystemAirflowCFM.Reset()
+				if err := s.EstimatedSystemAirflowCFM.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"EstimatedSystemAirflowCFM\"")
+			}
+		case "ExpressServiceCode":
+			if err := func() error {
+				s.ExpressServiceCode.Reset()
+				if err := s.ExpressServiceCode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ExpressServiceCode\"")
+			}
+		case "FanRollupStatus":
+			if err := func() error {
+				s.FanRollupStatus.Reset()
+				if err := s.FanRollupStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"FanRollupStatus\"")
+			}
+		case "IDSDMRollupStatus":
+			if err := func() error {
+				s.IDSDMRollupStatus.Reset()
+				if err := s.IDSDMRollupStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"IDSDMRollupStatus\"")
+			}
+		case "Id":
+			if err := func() error {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Id\"")
+			}
+		case "IntrusionRollupStatus":
+			if err := func() error {
+				s.IntrusionRollupStatus.Reset()
+				if err := s.IntrusionRollupStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"IntrusionRollupStatus\"")
+			}
+		case "IsOEMBranded":
+			if err := func() error {
+				s.IsOEMBranded.Reset()
+				if err := s.IsOEMBranded.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"IsOEMBranded\"")
+			}
+		case "LastSystemInventoryTime":
+			if err := func() error {
+				s.LastSystemInventoryTime.Reset()
+				if err := s.LastSystemInventoryTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"LastSystemInventoryTime\"")
+			}
+		case "LastUpdateTime":
+			if err := func() error {
+				s.LastUpdateTime.Reset()
+				if err := s.LastUpdateTime.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"LastUpdateTime\"")
+			}
+		case "LicensingRollupStatus":
+			if err := func() error {
+				s.LicensingRollupStatus.Reset()
+				if err := s.LicensingRollupStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"LicensingRollupStatus\"")
+			}
+		case "ManagedSystemSize":
+			if err := func() error {
+				s.ManagedSystemSize.Reset()
+				if err := s.ManagedSystemSize.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"ManagedSystemSize\"")
+			}
+		case "MaxCPUSockets":
+			if err := func() error {
+				s.MaxCPUSockets.Reset()
+				if err := s.MaxCPUSockets.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaxCPUSockets\"")
+			}
+		case "MaxDIMMSlots":
+			if err := func() error {
+				s.MaxDIMMSlots.Reset()
+				if err := s.MaxDIMMSlots.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaxDIMMSlots\"")
+			}
+		case "MaxPCIeSlots":
+			if err := func() error {
+				s.MaxPCIeSlots.Reset()
+				if err := s.MaxPCIeSlots.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MaxPCIeSlots\"")
+			}
+		case "MemoryOperationMode":
+			if err := func() error {
+				s.MemoryOperationMode.Reset()
+				if err := s.MemoryOperationMode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"MemoryOperationMode\"")
+			}
+		case "Name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Name\"")
+			}
+		case "NodeID":
+			if err := func() error {
+				s.NodeID.Reset()
+				if err := s.NodeID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"NodeID\"")
+			}
+		case "Oem":
+			if err := func() error {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"Oem\"")
+			}
+		case "PSRollupStatus":
+			if err := func() error {
+				s.PSRollupStatus.Reset()
+				if err := s.PSRollupStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PSRollupStatus\"")
+			}
+		case "PlatformGUID":
+			if err := func() error {
+				s.PlatformGUID.Reset()
+				if err := s.PlatformGUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PlatformGUID\"")
+			}
+		case "PopulatedDIMMSlots":
+			if err := func() error {
+				s.PopulatedDIMMSlots.Reset()
+				if err := s.PopulatedDIMMSlots.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"PopulatedDIMMSlots\"")
+			}
+		case "PopulatedPCIeSlots":
 			if err := func() error {
-				s.MessageDotExtendedInfo.Reset()
-				if err := s.MessageDotExtendedInfo.Decode(d); err != nil {
+				s.PopulatedPCIeSlots.Reset()
+				if err := s.PopulatedPCIeSlots.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@Message.ExtendedInfo\"")
+				return errors.Wrap(err, "decode field \"PopulatedPCIeSlots\"")
 			}
-		case "@odata.context":
+		case "PowerCapEnabledState":
 			if err := func() error {
-				s.OdataDotContext.Reset()
-				if err := s.OdataDotContext.Decode(d); err != nil {
+				s.PowerCapEnabledState.Reset()
+				if err := s.PowerCapEnabledState.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.context\"")
+				return errors.Wrap(err, "decode field \"PowerCapEnabledState\"")
 			}
-		case "@odata.etag":
+		case "SDCardRollupStatus":
 			if err := func() error {
-				s.OdataDotEtag.Reset()
-				if err := s.OdataDotEtag.Decode(d); err != nil {
+				s.SDCardRollupStatus.Reset()
+				if err := s.SDCardRollupStatus.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.etag\"")
+				return errors.Wrap(err, "decode field \"SDCardRollupStatus\"")
 			}
-		case "@odata.id":
+		case "SELRollupStatus":
 			if err := func() error {
-				s.OdataDotID.Reset()
-				if err := s.OdataDotID.Decode(d); err != nil {
+				s.SELRollupStatus.Reset()
+				if err := s.SELRollupStatus.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.id\"")
+				return errors.Wrap(err, "decode field \"SELRollupStatus\"")
 			}
-		case "@odata.type":
+		case "ServerAllocationWatts":
 			if err := func() error {
-				s.OdataDotType.Reset()
-				if err := s.OdataDotType.Decode(d); err != nil {
+				s.ServerAllocationWatts.Reset()
+				if err := s.ServerAllocationWatts.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"@odata.type\"")
+				return errors.Wrap(err, "decode field \"ServerAllocationWatts\"")
 			}
-		case "BIOSReleaseDate":
+		case "StorageRollupStatus":
 			if err := func() error {
-				s.BIOSReleaseDate.Reset()
-				if err := s.BIOSReleaseDate.Decode(d); err != nil {
+				s.StorageRollupStatus.Reset()
+				if err := s.StorageRollupStatus.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"BIOSReleaseDate\"")
+				return errors.Wrap(err, "decode field \"StorageRollupStatus\"")
+			}
+		case "SysMemErrorMethodology":
+			if err := func() error {
+				s.SysMemErrorMethodology.Reset()
+				if err := s.SysMemErrorMethodology.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SysMemErrorMethodology\"")
+			}
+		case "SysMemFailOverState":
+			if err := func() error {
+				s.SysMemFailOverState.Reset()
+				if err := s.SysMemFailOverState.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SysMemFailOverState\"")
+			}
+		case "SysMemLocation":
+			if err := func() error {
+				s.SysMemLocation.Reset()
+				if err := s.SysMemLocation.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SysMemLocation\"")
+			}
+		case "SysMemPrimaryStatus":
+			if err := func() error {
+				s.SysMemPrimaryStatus.Reset()
+				if err := s.SysMemPrimaryStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SysMemPrimaryStatus\"")
+			}
+		case "SystemGeneration":
+			if err := func() error {
+				s.SystemGeneration.Reset()
+				if err := s.SystemGeneration.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SystemGeneration\"")
+			}
+		case "SystemID":
+			if err := func() error {
+				s.SystemID.Reset()
+				if err := s.SystemID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SystemID\"")
+			}
+		case "SystemRevision":
+			if err := func() error {
+				s.SystemRevision.Reset()
+				if err := s.SystemRevision.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"SystemRevision\"")
+			}
+		case "TempRollupStatus":
+			if err := func() error {
+				s.TempRollupStatus.Reset()
+				if err := s.TempRollupStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TempRollupStatus\"")
+			}
+		case "TempStatisticsRollupStatus":
+			if err := func() error {
+				s.TempStatisticsRollupStatus.Reset()
+				if err := s.TempStatisticsRollupStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"TempStatisticsRollupStatus\"")
+			}
+		case "UUID":
+			if err := func() error {
+				s.UUID.Reset()
+				if err := s.UUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"UUID\"")
+			}
+		case "VoltRollupStatus":
+			if err := func() error {
+				s.VoltRollupStatus.Reset()
+				if err := s.VoltRollupStatus.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"VoltRollupStatus\"")
+			}
+		case "smbiosGUID":
+			if err := func() error {
+				s.SmbiosGUID.Reset()
+				if err := s.SmbiosGUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"smbiosGUID\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishSystemOemDell")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishSystemOemDell) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishSystemOemDell) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("Message")
+			s.Message.Encode(e)
+		}
+	}
+	{
+		if s.MessageArgs != nil {
+			e.FieldStart("MessageArgs")
+			e.ArrStart()
+			for _, elem := range s.MessageArgs {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.MessageId.Set {
+			e.FieldStart("MessageId")
+			s.MessageId.Encode(e)
+		}
+	}
+	{
+		if s.MessageSeverity.Set {
+			e.FieldStart("MessageSeverity")
+			s.MessageSeverity.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.RelatedProperties.Set {
+			e.FieldStart("RelatedProperties")
+			s.RelatedProperties.Encode(e)
+		}
+	}
+	{
+		if s.Resolution.Set {
+			e.FieldStart("Resolution")
+			s.Resolution.Encode(e)
+		}
+	}
+	{
+		if s.ResolutionSteps != nil {
+			e.FieldStart("ResolutionSteps")
+			e.ArrStart()
+			for _, elem := range s.ResolutionSteps {
+				elem.Encode(e)
 			}
-		case "BaseBoardChassisSlot":
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Severity.Set {
+			e.FieldStart("Severity")
+			s.Severity.Encode(e)
+		}
+	}
+	{
+		if s.UserAuthenticationSource.Set {
+			e.FieldStart("UserAuthenticationSource")
+			s.UserAuthenticationSource.Encode(e)
+		}
+	}
+	{
+		if s.Username.Set {
+			e.FieldStart("Username")
+			s.Username.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishSystemOemDellMessageDotExtendedInfoItem = [11]string{
+	0:  "Message",
+	1:  "MessageArgs",
+	2:  "MessageId",
+	3:  "MessageSeverity",
+	4:  "Oem",
+	5:  "RelatedProperties",
+	6:  "Resolution",
+	7:  "ResolutionSteps",
+	8:  "Severity",
+	9:  "UserAuthenticationSource",
+	10: "Username",
+}
+
+// Decode decodes RedfishSystemOemDellMessageDotExtendedInfoItem from json.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishSystemOemDellMessageDotExtendedInfoItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "Message":
 			if err := func() error {
-				s.BaseBoardChassisSlot.Reset()
-				if err := s.BaseBoardChassisSlot.Decode(d); err != nil {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"BaseBoardChassisSlot\"")
+				return errors.Wrap(err, "decode field \"Message\"")
 			}
-		case "BatteryRollupStatus":
+		case "MessageArgs":
 			if err := func() error {
-				s.BatteryRollupStatus.Reset()
-				if err := s.BatteryRollupStatus.Decode(d); err != nil {
+				s.MessageArgs = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.MessageArgs = append(s.MessageArgs, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"BatteryRollupStatus\"")
+				return errors.Wrap(err, "decode field \"MessageArgs\"")
 			}
-		case "BladeGeometry":
+		case "MessageId":
 			if err := func() error {
-				s.BladeGeometry.Reset()
-				if err := s.BladeGeometry.Decode(d); err != nil {
+				s.MessageId.Reset()
+				if err := s.MessageId.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"BladeGeometry\"")
+				return errors.Wrap(err, "decode field \"MessageId\"")
 			}
-		case "CMCIP":
+		case "MessageSeverity":
 			if err := func() error {
-				s.CMCIP.Reset()
-				if err := s.CMCIP.Decode(d); err != nil {
+				s.MessageSeverity.Reset()
+				if err := s.MessageSeverity.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"CMCIP\"")
+				return errors.Wrap(err, "decode field \"MessageSeverity\"")
 			}
-		case "CPURollupStatus":
+		case "Oem":
 			if err := func() error {
-				s.CPURollupStatus.Reset()
-				if err := s.CPURollupStatus.Decode(d); err != nil {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"CPURollupStatus\"")
+				return errors.Wrap(err, "decode field \"Oem\"")
 			}
-		case "ChassisModel":
+		case "RelatedProperties":
 			if err := func() error {
-				s.ChassisModel.Reset()
-				if err := s.ChassisModel.Decode(d); err != nil {
+				s.RelatedProperties.Reset()
+				if err := s.RelatedProperties.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ChassisModel\"")
+				return errors.Wrap(err, "decode field \"RelatedProperties\"")
 			}
-		case "ChassisName":
+		case "Resolution":
 			if err := func() error {
-				s.ChassisName.Reset()
-				if err := s.ChassisName.Decode(d); err != nil {
+				s.Resolution.Reset()
+				if err := s.Resolution.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ChassisName\"")
+				return errors.Wrap(err, "decode field \"Resolution\"")
 			}
-		case "ChassisServiceTag":
+		case "ResolutionSteps":
 			if err := func() error {
-				s.ChassisServiceTag.Reset()
-				if err := s.ChassisServiceTag.Decode(d); err != nil {
+				s.ResolutionSteps = make([]RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ResolutionSteps = append(s.ResolutionSteps, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ChassisServiceTag\"")
+				return errors.Wrap(err, "decode field \"ResolutionSteps\"")
 			}
-		case "ChassisSystemHeightUnit":
+		case "Severity":
 			if err := func() error {
-				s.ChassisSystemHeightUnit.Reset()
-				if err := s.ChassisSystemHeightUnit.Decode(d); err != nil {
+				s.Severity.Reset()
+				if err := s.Severity.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ChassisSystemHeightUnit\"")
+				return errors.Wrap(err, "decode field \"Severity\"")
 			}
-		case "CurrentRollupStatus":
+		case "UserAuthenticationSource":
 			if err := func() error {
-				s.CurrentRollupStatus.Reset()
-				if err := s.CurrentRollupStatus.Decode(d); err != nil {
+				s.UserAuthenticationSource.Reset()
+				if err := s.UserAuthenticationSource.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"CurrentRollupStatus\"")
+				return errors.Wrap(err, "decode field \"UserAuthenticationSource\"")
 			}
-		case "Description":
+		case "Username":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.Username.Reset()
+				if err := s.Username.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Description\"")
+				return errors.Wrap(err, "decode field \"Username\"")
 			}
-		case "EstimatedExhaustTemperatureCelsius":
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishSystemOemDellMessageDotExtendedInfoItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.ActionParameters != nil {
+			e.FieldStart("ActionParameters")
+			e.ArrStart()
+			for _, elem := range s.ActionParameters {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.ActionURI.Set {
+			e.FieldStart("ActionURI")
+			s.ActionURI.Encode(e)
+		}
+	}
+	{
+		if len(s.Oem) != 0 {
+			e.FieldStart("Oem")
+			e.Raw(s.Oem)
+		}
+	}
+	{
+		if s.Priority.Set {
+			e.FieldStart("Priority")
+			s.Priority.Encode(e)
+		}
+	}
+	{
+		if s.ResolutionType.Set {
+			e.FieldStart("ResolutionType")
+			s.ResolutionType.Encode(e)
+		}
+	}
+	{
+		if s.RetryCount.Set {
+			e.FieldStart("RetryCount")
+			s.RetryCount.Encode(e)
+		}
+	}
+	{
+		if s.RetryIntervalSeconds.Set {
+			e.FieldStart("RetryIntervalSeconds")
+			s.RetryIntervalSeconds.Encode(e)
+		}
+	}
+	{
+		if s.TargetComponentURI.Set {
+			e.FieldStart("TargetComponentURI")
+			s.TargetComponentURI.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem = [8]string{
+	0: "ActionParameters",
+	1: "ActionURI",
+	2: "Oem",
+	3: "Priority",
+	4: "ResolutionType",
+	5: "RetryCount",
+	6: "RetryIntervalSeconds",
+	7: "TargetComponentURI",
+}
+
+// Decode decodes RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem from json.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "ActionParameters":
 			if err := func() error {
-				s.EstimatedExhaustTemperatureCelsius.Reset()
-				if err := s.EstimatedExhaustTemperatureCelsius.Decode(d); err != nil {
+				s.ActionParameters = make([]RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.ActionParameters = append(s.ActionParameters, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EstimatedExhaustTemperatureCelsius\"")
+				return errors.Wrap(err, "decode field \"ActionParameters\"")
 			}
-		case "EstimatedSystemAirflowCFM":
+		case "ActionURI":
 			if err := func() error {
-				s.EstimatedSystemAirflowCFM.Reset()
-				if err := s.EstimatedSystemAirflowCFM.Decode(d); err != nil {
+				s.ActionURI.Reset()
+				if err := s.ActionURI.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"EstimatedSystemAirflowCFM\"")
+				return errors.Wrap(err, "decode field \"ActionURI\"")
 			}
-		case "ExpressServiceCode":
+		case "Oem":
 			if err := func() error {
-				s.ExpressServiceCode.Reset()
-				if err := s.ExpressServiceCode.Decode(d); err != nil {
+				v, err := d.RawAppend(nil)
+				s.Oem = jx.Raw(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ExpressServiceCode\"")
+				return errors.Wrap(err, "decode field \"Oem\"")
 			}
-		case "FanRollupStatus":
+		case "Priority":
 			if err := func() error {
-				s.FanRollupStatus.Reset()
-				if err := s.FanRollupStatus.Decode(d); err != nil {
+				s.Priority.Reset()
+				if err := s.Priority.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"FanRollupStatus\"")
+				return errors.Wrap(err, "decode field \"Priority\"")
 			}
-		case "IDSDMRollupStatus":
+		case "ResolutionType":
 			if err := func() error {
-				s.IDSDMRollupStatus.Reset()
-				if err := s.IDSDMRollupStatus.Decode(d); err != nil {
+				s.ResolutionType.Reset()
+				if err := s.ResolutionType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"IDSDMRollupStatus\"")
+				return errors.Wrap(err, "decode field \"ResolutionType\"")
 			}
-		case "Id":
+		case "RetryCount":
 			if err := func() error {
-				s.ID.Reset()
-				if err := s.ID.Decode(d); err != nil {
+				s.RetryCount.Reset()
+				if err := s.RetryCount.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Id\"")
+				return errors.Wrap(err, "decode field \"RetryCount\"")
 			}
-		case "IntrusionRollupStatus":
+		case "RetryIntervalSeconds":
 			if err := func() error {
-				s.IntrusionRollupStatus.Reset()
-				if err := s.IntrusionRollupStatus.Decode(d); err != nil {
+				s.RetryIntervalSeconds.Reset()
+				if err := s.RetryIntervalSeconds.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"IntrusionRollupStatus\"")
+				return errors.Wrap(err, "decode field \"RetryIntervalSeconds\"")
 			}
-		case "IsOEMBranded":
+		case "TargetComponentURI":
 			if err := func() error {
-				s.IsOEMBranded.Reset()
-				if err := s.IsOEMBranded.Decode(d); err != nil {
+				s.TargetComponentURI.Reset()
+				if err := s.TargetComponentURI.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"IsOEMBranded\"")
+				return errors.Wrap(err, "decode field \"TargetComponentURI\"")
 			}
-		case "LastSystemInventoryTime":
-			if err := func() error {
-				s.LastSystemInventoryTime.Reset()
-				if err := s.LastSystemInventoryTime.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"LastSystemInventoryTime\"")
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.AllowableNumbers != nil {
+			e.FieldStart("AllowableNumbers")
+			e.ArrStart()
+			for _, elem := range s.AllowableNumbers {
+				e.Str(elem)
 			}
-		case "LastUpdateTime":
-			if err := func() error {
-				s.LastUpdateTime.Reset()
-				if err := s.LastUpdateTime.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"LastUpdateTime\"")
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.AllowablePattern.Set {
+			e.FieldStart("AllowablePattern")
+			s.AllowablePattern.Encode(e)
+		}
+	}
+	{
+		if s.AllowableValueDescriptions != nil {
+			e.FieldStart("AllowableValueDescriptions")
+			e.ArrStart()
+			for _, elem := range s.AllowableValueDescriptions {
+				e.Str(elem)
 			}
-		case "LicensingRollupStatus":
-			if err := func() error {
-				s.LicensingRollupStatus.Reset()
-				if err := s.LicensingRollupStatus.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"LicensingRollupStatus\"")
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.AllowableValues != nil {
+			e.FieldStart("AllowableValues")
+			e.ArrStart()
+			for _, elem := range s.AllowableValues {
+				e.Str(elem)
 			}
-		case "ManagedSystemSize":
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.ArraySizeMaximum.Set {
+			e.FieldStart("ArraySizeMaximum")
+			s.ArraySizeMaximum.Encode(e)
+		}
+	}
+	{
+		if s.ArraySizeMinimum.Set {
+			e.FieldStart("ArraySizeMinimum")
+			s.ArraySizeMinimum.Encode(e)
+		}
+	}
+	{
+		if s.DataType.Set {
+			e.FieldStart("DataType")
+			s.DataType.Encode(e)
+		}
+	}
+	{
+		if s.DefaultValue.Set {
+			e.FieldStart("DefaultValue")
+			s.DefaultValue.Encode(e)
+		}
+	}
+	{
+		if s.MaximumValue.Set {
+			e.FieldStart("MaximumValue")
+			s.MaximumValue.Encode(e)
+		}
+	}
+	{
+		if s.MinimumValue.Set {
+			e.FieldStart("MinimumValue")
+			s.MinimumValue.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("Name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.NoDefaultValue.Set {
+			e.FieldStart("NoDefaultValue")
+			s.NoDefaultValue.Encode(e)
+		}
+	}
+	{
+		if s.ObjectDataType.Set {
+			e.FieldStart("ObjectDataType")
+			s.ObjectDataType.Encode(e)
+		}
+	}
+	{
+		if s.Required.Set {
+			e.FieldStart("Required")
+			s.Required.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem = [14]string{
+	0:  "AllowableNumbers",
+	1:  "AllowablePattern",
+	2:  "AllowableValueDescriptions",
+	3:  "AllowableValues",
+	4:  "ArraySizeMaximum",
+	5:  "ArraySizeMinimum",
+	6:  "DataType",
+	7:  "DefaultValue",
+	8:  "MaximumValue",
+	9:  "MinimumValue",
+	10: "Name",
+	11: "NoDefaultValue",
+	12: "ObjectDataType",
+	13: "Required",
+}
+
+// Decode decodes RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem from json.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "AllowableNumbers":
 			if err := func() error {
-				s.ManagedSystemSize.Reset()
-				if err := s.ManagedSystemSize.Decode(d); err != nil {
+				s.AllowableNumbers = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableNumbers = append(s.AllowableNumbers, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ManagedSystemSize\"")
+				return errors.Wrap(err, "decode field \"AllowableNumbers\"")
 			}
-		case "MaxCPUSockets":
+		case "AllowablePattern":
 			if err := func() error {
-				s.MaxCPUSockets.Reset()
-				if err := s.MaxCPUSockets.Decode(d); err != nil {
+				s.AllowablePattern.Reset()
+				if err := s.AllowablePattern.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaxCPUSockets\"")
+				return errors.Wrap(err, "decode field \"AllowablePattern\"")
 			}
-		case "MaxDIMMSlots":
+		case "AllowableValueDescriptions":
 			if err := func() error {
-				s.MaxDIMMSlots.Reset()
-				if err := s.MaxDIMMSlots.Decode(d); err != nil {
+				s.AllowableValueDescriptions = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaxDIMMSlots\"")
+				return errors.Wrap(err, "decode field \"AllowableValueDescriptions\"")
 			}
-		case "MaxPCIeSlots":
+		case "AllowableValues":
 			if err := func() error {
-				s.MaxPCIeSlots.Reset()
-				if err := s.MaxPCIeSlots.Decode(d); err != nil {
+				s.AllowableValues = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.AllowableValues = append(s.AllowableValues, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaxPCIeSlots\"")
+				return errors.Wrap(err, "decode field \"AllowableValues\"")
 			}
-		case "MemoryOperationMode":
+		case "ArraySizeMaximum":
 			if err := func() error {
-				s.MemoryOperationMode.Reset()
-				if err := s.MemoryOperationMode.Decode(d); err != nil {
+				s.ArraySizeMaximum.Reset()
+				if err := s.ArraySizeMaximum.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MemoryOperationMode\"")
+				return errors.Wrap(err, "decode field \"ArraySizeMaximum\"")
 			}
-		case "Name":
+		case "ArraySizeMinimum":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.ArraySizeMinimum.Reset()
+				if err := s.ArraySizeMinimum.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
+				return errors.Wrap(err, "decode field \"ArraySizeMinimum\"")
 			}
-		case "NodeID":
+		case "DataType":
 			if err := func() error {
-				s.NodeID.Reset()
-				if err := s.NodeID.Decode(d); err != nil {
+				s.DataType.Reset()
+				if err := s.DataType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"NodeID\"")
+				return errors.Wrap(err, "decode field \"DataType\"")
 			}
-		case "Oem":
+		case "DefaultValue":
 			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
+				s.DefaultValue.Reset()
+				if err := s.DefaultValue.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
+				return errors.Wrap(err, "decode field \"DefaultValue\"")
 			}
-		case "PSRollupStatus":
+		case "MaximumValue":
 			if err := func() error {
-				s.PSRollupStatus.Reset()
-				if err := s.PSRollupStatus.Decode(d); err != nil {
+				s.MaximumValue.Reset()
+				if err := s.MaximumValue.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PSRollupStatus\"")
+				return errors.Wrap(err, "decode field \"MaximumValue\"")
 			}
-		case "PlatformGUID":
+		case "MinimumValue":
 			if err := func() error {
-				s.PlatformGUID.Reset()
-				if err := s.PlatformGUID.Decode(d); err != nil {
+				s.MinimumValue.Reset()
+				if err := s.MinimumValue.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PlatformGUID\"")
+				return errors.Wrap(err, "decode field \"MinimumValue\"")
 			}
-		case "PopulatedDIMMSlots":
+		case "Name":
 			if err := func() error {
-				s.PopulatedDIMMSlots.Reset()
-				if err := s.PopulatedDIMMSlots.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PopulatedDIMMSlots\"")
+				return errors.Wrap(err, "decode field \"Name\"")
 			}
-		case "PopulatedPCIeSlots":
+		case "NoDefaultValue":
 			if err := func() error {
-				s.PopulatedPCIeSlots.Reset()
-				if err := s.PopulatedPCIeSlots.Decode(d); err != nil {
+				s.NoDefaultValue.Reset()
+				if err := s.NoDefaultValue.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PopulatedPCIeSlots\"")
+				return errors.Wrap(err, "decode field \"NoDefaultValue\"")
 			}
-		case "PowerCapEnabledState":
+		case "ObjectDataType":
 			if err := func() error {
-				s.PowerCapEnabledState.Reset()
-				if err := s.PowerCapEnabledState.Decode(d); err != nil {
+				s.ObjectDataType.Reset()
+				if err := s.ObjectDataType.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"PowerCapEnabledState\"")
+				return errors.Wrap(err, "decode field \"ObjectDataType\"")
 			}
-		case "SDCardRollupStatus":
+		case "Required":
 			if err := func() error {
-				s.SDCardRollupStatus.Reset()
-				if err := s.SDCardRollupStatus.Decode(d); err != nil {
+				s.Required.Reset()
+				if err := s.Required.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SDCardRollupStatus\"")
+				return errors.Wrap(err, "decode field \"Required\"")
 			}
-		case "SELRollupStatus":
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Rollout) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Rollout) encodeFields(e *jx.Encoder) {
+	{
+		if s.Canary.Set {
+			e.FieldStart("canary")
+			s.Canary.Encode(e)
+		}
+	}
+	{
+		if s.Complete.Set {
+			e.FieldStart("complete")
+			s.Complete.Encode(e)
+		}
+	}
+	{
+		if s.Failed.Set {
+			e.FieldStart("failed")
+			s.Failed.Encode(e)
+		}
+	}
+	{
+		if s.FromVersion.Set {
+			e.FieldStart("from_version")
+			s.FromVersion.Encode(e)
+		}
+	}
+	{
+		if s.ID.Set {
+			e.FieldStart("id")
+			s.ID.Encode(e)
+		}
+	}
+	{
+		if s.Image.Set {
+			e.FieldStart("image")
+			s.Image.Encode(e)
+		}
+	}
+	{
+		if s.Nodes.Set {
+			e.FieldStart("nodes")
+			s.Nodes.Encode(e)
+		}
+	}
+	{
+		if s.Pending.Set {
+			e.FieldStart("pending")
+			s.Pending.Encode(e)
+		}
+	}
+	{
+		if s.Percent.Set {
+			e.FieldStart("percent")
+			s.Percent.Encode(e)
+		}
+	}
+	{
+		if s.Previous.Set {
+			e.FieldStart("previous")
+			s.Previous.Encode(e)
+		}
+	}
+	{
+		if s.State.Set {
+			e.FieldStart("state")
+			s.State.Encode(e)
+		}
+	}
+	{
+		if s.SuccessRate.Set {
+			e.FieldStart("success_rate")
+			s.SuccessRate.Encode(e)
+		}
+	}
+	{
+		if s.Time.Set {
+			e.FieldStart("time")
+			s.Time.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Updated.Set {
+			e.FieldStart("updated")
+			s.Updated.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfRollout = [15]string{
+	0:  "canary",
+	1:  "complete",
+	2:  "failed",
+	3:  "from_version",
+	4:  "id",
+	5:  "image",
+	6:  "nodes",
+	7:  "pending",
+	8:  "percent",
+	9:  "previous",
+	10: "state",
+	11: "success_rate",
+	12: "time",
+	13: "updated",
+	14: "version",
+}
+
+// Decode decodes Rollout from json.
+func (s *Rollout) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Rollout to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "canary":
 			if err := func() error {
-				s.SELRollupStatus.Reset()
-				if err := s.SELRollupStatus.Decode(d); err != nil {
+				s.Canary.Reset()
+				if err := s.Canary.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SELRollupStatus\"")
+				return errors.Wrap(err, "decode field \"canary\"")
 			}
-		case "ServerAllocationWatts":
+		case "complete":
 			if err := func() error {
-				s.ServerAllocationWatts.Reset()
-				if err := s.ServerAllocationWatts.Decode(d); err != nil {
+				s.Complete.Reset()
+				if err := s.Complete.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ServerAllocationWatts\"")
+				return errors.Wrap(err, "decode field \"complete\"")
 			}
-		case "StorageRollupStatus":
+		case "failed":
 			if err := func() error {
-				s.StorageRollupStatus.Reset()
-				if err := s.StorageRollupStatus.Decode(d); err != nil {
+				s.Failed.Reset()
+				if err := s.Failed.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"StorageRollupStatus\"")
+				return errors.Wrap(err, "decode field \"failed\"")
 			}
-		case "SysMemErrorMethodology":
+		case "from_version":
 			if err := func() error {
-				s.SysMemErrorMethodology.Reset()
-				if err := s.SysMemErrorMethodology.Decode(d); err != nil {
+				s.FromVersion.Reset()
+				if err := s.FromVersion.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SysMemErrorMethodology\"")
+				return errors.Wrap(err, "decode field \"from_version\"")
 			}
-		case "SysMemFailOverState":
+		case "id":
 			if err := func() error {
-				s.SysMemFailOverState.Reset()
-				if err := s.SysMemFailOverState.Decode(d); err != nil {
+				s.ID.Reset()
+				if err := s.ID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SysMemFailOverState\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "SysMemLocation":
+		case "image":
 			if err := func() error {
-				s.SysMemLocation.Reset()
-				if err := s.SysMemLocation.Decode(d); err != nil {
+				s.Image.Reset()
+				if err := s.Image.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SysMemLocation\"")
+				return errors.Wrap(err, "decode field \"image\"")
 			}
-		case "SysMemPrimaryStatus":
+		case "nodes":
 			if err := func() error {
-				s.SysMemPrimaryStatus.Reset()
-				if err := s.SysMemPrimaryStatus.Decode(d); err != nil {
+				s.Nodes.Reset()
+				if err := s.Nodes.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SysMemPrimaryStatus\"")
+				return errors.Wrap(err, "decode field \"nodes\"")
 			}
-		case "SystemGeneration":
+		case "pending":
 			if err := func() error {
-				s.SystemGeneration.Reset()
-				if err := s.SystemGeneration.Decode(d); err != nil {
+				s.Pending.Reset()
+				if err := s.Pending.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SystemGeneration\"")
+				return errors.Wrap(err, "decode field \"pending\"")
 			}
-		case "SystemID":
+		case "percent":
 			if err := func() error {
-				s.SystemID.Reset()
-				if err := s.SystemID.Decode(d); err != nil {
+				s.Percent.Reset()
+				if err := s.Percent.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SystemID\"")
+				return errors.Wrap(err, "decode field \"percent\"")
 			}
-		case "SystemRevision":
+		case "previous":
 			if err := func() error {
-				s.SystemRevision.Reset()
-				if err := s.SystemRevision.Decode(d); err != nil {
+				s.Previous.Reset()
+				if err := s.Previous.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"SystemRevision\"")
+				return errors.Wrap(err, "decode field \"previous\"")
 			}
-		case "TempRollupStatus":
+		case "state":
 			if err := func() error {
-				s.TempRollupStatus.Reset()
-				if err := s.TempRollupStatus.Decode(d); err != nil {
+				s.State.Reset()
+				if err := s.State.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TempRollupStatus\"")
+				return errors.Wrap(err, "decode field \"state\"")
 			}
-		case "TempStatisticsRollupStatus":
+		case "success_rate":
 			if err := func() error {
-				s.TempStatisticsRollupStatus.Reset()
-				if err := s.TempStatisticsRollupStatus.Decode(d); err != nil {
+				s.SuccessRate.Reset()
+				if err := s.SuccessRate.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TempStatisticsRollupStatus\"")
+				return errors.Wrap(err, "decode field \"success_rate\"")
 			}
-		case "UUID":
+		case "time":
 			if err := func() error {
-				s.UUID.Reset()
-				if err := s.UUID.Decode(d); err != nil {
+				s.Time.Reset()
+				if err := s.Time.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"UUID\"")
+				return errors.Wrap(err, "decode field \"time\"")
 			}
-		case "VoltRollupStatus":
+		case "updated":
 			if err := func() error {
-				s.VoltRollupStatus.Reset()
-				if err := s.VoltRollupStatus.Decode(d); err != nil {
+				s.Updated.Reset()
+				if err := s.Updated.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"VoltRollupStatus\"")
+				return errors.Wrap(err, "decode field \"updated\"")
 			}
-		case "smbiosGUID":
+		case "version":
 			if err := func() error {
-				s.SmbiosGUID.Reset()
-				if err := s.SmbiosGUID.Decode(d); err != nil {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"smbiosGUID\"")
+				return errors.Wrap(err, "decode field \"version\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishSystemOemDell")
+		return errors.Wrap(err, "decode Rollout")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishSystemOemDell) MarshalJSON() ([]byte, error) {
+func (s *Rollout) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishSystemOemDell) UnmarshalJSON(data []byte) error {
+func (s *Rollout) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) Encode(e *jx.Encoder) {
+func (s RolloutPrevious) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.Message.Set {
-			e.FieldStart("Message")
-			s.Message.Encode(e)
-		}
-	}
-	{
-		if s.MessageArgs != nil {
-			e.FieldStart("MessageArgs")
-			e.ArrStart()
-			for _, elem := range s.MessageArgs {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.MessageId.Set {
-			e.FieldStart("MessageId")
-			s.MessageId.Encode(e)
-		}
-	}
-	{
-		if s.MessageSeverity.Set {
-			e.FieldStart("MessageSeverity")
-			s.MessageSeverity.Encode(e)
-		}
-	}
-	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
-		}
+// encodeFields implements json.Marshaler.
+func (s RolloutPrevious) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
 	}
-	{
-		if s.RelatedProperties.Set {
-			e.FieldStart("RelatedProperties")
-			s.RelatedProperties.Encode(e)
-		}
+}
+
+// Decode decodes RolloutPrevious from json.
+func (s *RolloutPrevious) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode RolloutPrevious to nil")
 	}
-	{
-		if s.Resolution.Set {
-			e.FieldStart("Resolution")
-			s.Resolution.Encode(e)
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RolloutPrevious")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s RolloutPrevious) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RolloutPrevious) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *RolloutStartRequest) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *RolloutStartRequest) encodeFields(e *jx.Encoder) {
 	{
-		if s.ResolutionSteps != nil {
-			e.FieldStart("ResolutionSteps")
-			e.ArrStart()
-			for _, elem := range s.ResolutionSteps {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
+		if s.Image.Set {
+			e.FieldStart("image")
+			s.Image.Encode(e)
 		}
 	}
 	{
-		if s.Severity.Set {
-			e.FieldStart("Severity")
-			s.Severity.Encode(e)
+		if s.Percent.Set {
+			e.FieldStart("percent")
+			s.Percent.Encode(e)
 		}
 	}
 	{
-		if s.UserAuthenticationSource.Set {
-			e.FieldStart("UserAuthenticationSource")
-			s.UserAuthenticationSource.Encode(e)
+		if s.Provision.Set {
+			e.FieldStart("provision")
+			s.Provision.Encode(e)
 		}
 	}
 	{
-		if s.Username.Set {
-			e.FieldStart("Username")
-			s.Username.Encode(e)
+		if s.Version.Set {
+			e.FieldStart("version")
+			s.Version.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRedfishSystemOemDellMessageDotExtendedInfoItem = [11]string{
-	0:  "Message",
-	1:  "MessageArgs",
-	2:  "MessageId",
-	3:  "MessageSeverity",
-	4:  "Oem",
-	5:  "RelatedProperties",
-	6:  "Resolution",
-	7:  "ResolutionSteps",
-	8:  "Severity",
-	9:  "UserAuthenticationSource",
-	10: "Username",
+var jsonFieldsNameOfRolloutStartRequest = [4]string{
+	0: "image",
+	1: "percent",
+	2: "provision",
+	3: "version",
 }
 
-// Decode decodes RedfishSystemOemDellMessageDotExtendedInfoItem from json.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) Decode(d *jx.Decoder) error {
+// Decode decodes RolloutStartRequest from json.
+func (s *RolloutStartRequest) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RedfishSystemOemDellMessageDotExtendedInfoItem to nil")
+		return errors.New("invalid: unable to decode RolloutStartRequest to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "Message":
+		case "image":
 			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
+				s.Image.Reset()
+				if err := s.Image.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Message\"")
+				return errors.Wrap(err, "decode field \"image\"")
 			}
-		case "MessageArgs":
+		case "percent":
 			if err := func() error {
-				s.MessageArgs = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.MessageArgs = append(s.MessageArgs, elem)
-					return nil
-				}); err != nil {
+				s.Percent.Reset()
+				if err := s.Percent.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageArgs\"")
+				return errors.Wrap(err, "decode field \"percent\"")
 			}
-		case "MessageId":
+		case "provision":
 			if err := func() error {
-				s.MessageId.Reset()
-				if err := s.MessageId.Decode(d); err != nil {
+				s.Provision.Reset()
+				if err := s.Provision.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageId\"")
+				return errors.Wrap(err, "decode field \"provision\"")
 			}
-		case "MessageSeverity":
+		case "version":
 			if err := func() error {
-				s.MessageSeverity.Reset()
-				if err := s.MessageSeverity.Decode(d); err != nil {
+				s.Version.Reset()
+				if err := s.Version.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MessageSeverity\"")
+				return errors.Wrap(err, "decode field \"version\"")
 			}
-		case "Oem":
-			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode RolloutStartRequest")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *RolloutStartRequest) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *RolloutStartRequest) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SwitchPortReport) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SwitchPortReport) encodeFields(e *jx.Encoder) {
+	{
+		if s.DryRun.Set {
+			e.FieldStart("dry_run")
+			s.DryRun.Encode(e)
+		}
+	}
+	{
+		if s.Failed != nil {
+			e.FieldStart("failed")
+			e.ArrStart()
+			for _, elem := range s.Failed {
+				e.Str(elem)
 			}
-		case "RelatedProperties":
-			if err := func() error {
-				s.RelatedProperties.Reset()
-				if err := s.RelatedProperties.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RelatedProperties\"")
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Ports != nil {
+			e.FieldStart("ports")
+			e.ArrStart()
+			for _, elem := range s.Ports {
+				elem.Encode(e)
 			}
-		case "Resolution":
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfSwitchPortReport = [3]string{
+	0: "dry_run",
+	1: "failed",
+	2: "ports",
+}
+
+// Decode decodes SwitchPortReport from json.
+func (s *SwitchPortReport) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SwitchPortReport to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "dry_run":
 			if err := func() error {
-				s.Resolution.Reset()
-				if err := s.Resolution.Decode(d); err != nil {
+				s.DryRun.Reset()
+				if err := s.DryRun.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Resolution\"")
+				return errors.Wrap(err, "decode field \"dry_run\"")
 			}
-		case "ResolutionSteps":
+		case "failed":
 			if err := func() error {
-				s.ResolutionSteps = make([]RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem, 0)
+				s.Failed = make([]string, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem
-					if err := elem.Decode(d); err != nil {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
 						return err
 					}
-					s.ResolutionSteps = append(s.ResolutionSteps, elem)
+					s.Failed = append(s.Failed, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ResolutionSteps\"")
-			}
-		case "Severity":
-			if err := func() error {
-				s.Severity.Reset()
-				if err := s.Severity.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Severity\"")
-			}
-		case "UserAuthenticationSource":
-			if err := func() error {
-				s.UserAuthenticationSource.Reset()
-				if err := s.UserAuthenticationSource.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"UserAuthenticationSource\"")
+				return errors.Wrap(err, "decode field \"failed\"")
 			}
-		case "Username":
+		case "ports":
 			if err := func() error {
-				s.Username.Reset()
-				if err := s.Username.Decode(d); err != nil {
+				s.Ports = make([]NilSwitchPortReportPortsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilSwitchPortReportPortsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Ports = append(s.Ports, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Username\"")
+				return errors.Wrap(err, "decode field \"ports\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishSystemOemDellMessageDotExtendedInfoItem")
+		return errors.Wrap(err, "decode SwitchPortReport")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) MarshalJSON() ([]byte, error) {
+func (s *SwitchPortReport) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) UnmarshalJSON(data []byte) error {
+func (s *SwitchPortReport) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) Encode(e *jx.Encoder) {
+func (s *SwitchPortReportPortsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) encodeFields(e *jx.Encoder) {
+func (s *SwitchPortReportPortsItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.ActionParameters != nil {
-			e.FieldStart("ActionParameters")
+		if s.Changes != nil {
+			e.FieldStart("changes")
 			e.ArrStart()
-			for _, elem := range s.ActionParameters {
-				elem.Encode(e)
+			for _, elem := range s.Changes {
+				e.Str(elem)
 			}
 			e.ArrEnd()
 		}
 	}
 	{
-		if s.ActionURI.Set {
-			e.FieldStart("ActionURI")
-			s.ActionURI.Encode(e)
-		}
-	}
-	{
-		if len(s.Oem) != 0 {
-			e.FieldStart("Oem")
-			e.Raw(s.Oem)
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
 		}
 	}
 	{
-		if s.Priority.Set {
-			e.FieldStart("Priority")
-			s.Priority.Encode(e)
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
 		}
 	}
 	{
-		if s.ResolutionType.Set {
-			e.FieldStart("ResolutionType")
-			s.ResolutionType.Encode(e)
+		if s.Ifname.Set {
+			e.FieldStart("ifname")
+			s.Ifname.Encode(e)
 		}
 	}
 	{
-		if s.RetryCount.Set {
-			e.FieldStart("RetryCount")
-			s.RetryCount.Encode(e)
+		if s.Port.Set {
+			e.FieldStart("port")
+			s.Port.Encode(e)
 		}
 	}
 	{
-		if s.RetryIntervalSeconds.Set {
-			e.FieldStart("RetryIntervalSeconds")
-			s.RetryIntervalSeconds.Encode(e)
+		if s.Status.Set {
+			e.FieldStart("status")
+			s.Status.Encode(e)
 		}
 	}
 	{
-		if s.TargetComponentURI.Set {
-			e.FieldStart("TargetComponentURI")
-			s.TargetComponentURI.Encode(e)
+		if s.Switch.Set {
+			e.FieldStart("switch")
+			s.Switch.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem = [8]string{
-	0: "ActionParameters",
-	1: "ActionURI",
-	2: "Oem",
-	3: "Priority",
-	4: "ResolutionType",
-	5: "RetryCount",
-	6: "RetryIntervalSeconds",
-	7: "TargetComponentURI",
+var jsonFieldsNameOfSwitchPortReportPortsItem = [7]string{
+	0: "changes",
+	1: "error",
+	2: "host",
+	3: "ifname",
+	4: "port",
+	5: "status",
+	6: "switch",
 }
 
-// Decode decodes RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem from json.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) Decode(d *jx.Decoder) error {
+// Decode decodes SwitchPortReportPortsItem from json.
+func (s *SwitchPortReportPortsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem to nil")
+		return errors.New("invalid: unable to decode SwitchPortReportPortsItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "ActionParameters":
+		case "changes":
 			if err := func() error {
-				s.ActionParameters = make([]RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem, 0)
+				s.Changes = make([]string, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
-					if err := elem.Decode(d); err != nil {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
 						return err
 					}
-					s.ActionParameters = append(s.ActionParameters, elem)
+					s.Changes = append(s.Changes, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ActionParameters\"")
+				return errors.Wrap(err, "decode field \"changes\"")
 			}
-		case "ActionURI":
+		case "error":
 			if err := func() error {
-				s.ActionURI.Reset()
-				if err := s.ActionURI.Decode(d); err != nil {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ActionURI\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
-		case "Oem":
+		case "host":
 			if err := func() error {
-				v, err := d.RawAppend(nil)
-				s.Oem = jx.Raw(v)
-				if err != nil {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Oem\"")
+				return errors.Wrap(err, "decode field \"host\"")
 			}
-		case "Priority":
+		case "ifname":
 			if err := func() error {
-				s.Priority.Reset()
-				if err := s.Priority.Decode(d); err != nil {
+				s.Ifname.Reset()
+				if err := s.Ifname.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Priority\"")
+				return errors.Wrap(err, "decode field \"ifname\"")
 			}
-		case "ResolutionType":
+		case "port":
 			if err := func() error {
-				s.ResolutionType.Reset()
-				if err := s.ResolutionType.Decode(d); err != nil {
+				s.Port.Reset()
+				if err := s.Port.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ResolutionType\"")
+				return errors.Wrap(err, "decode field \"port\"")
 			}
-		case "RetryCount":
+		case "status":
 			if err := func() error {
-				s.RetryCount.Reset()
-				if err := s.RetryCount.Decode(d); err != nil {
+				s.Status.Reset()
+				if err := s.Status.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RetryCount\"")
+				return errors.Wrap(err, "decode field \"status\"")
 			}
-		case "RetryIntervalSeconds":
+		case "switch":
 			if err := func() error {
-				s.RetryIntervalSeconds.Reset()
-				if err := s.RetryIntervalSeconds.Decode(d); err != nil {
+				s.Switch.Reset()
+				if err := s.Switch.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"RetryIntervalSeconds\"")
+				return errors.Wrap(err, "decode field \"switch\"")
 			}
-		case "TargetComponentURI":
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SwitchPortReportPortsItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *SwitchPortReportPortsItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SwitchPortReportPortsItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TopologyGraph) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TopologyGraph) encodeFields(e *jx.Encoder) {
+	{
+		if s.Links != nil {
+			e.FieldStart("links")
+			e.ArrStart()
+			for _, elem := range s.Links {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Nodes != nil {
+			e.FieldStart("nodes")
+			e.ArrStart()
+			for _, elem := range s.Nodes {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfTopologyGraph = [2]string{
+	0: "links",
+	1: "nodes",
+}
+
+// Decode decodes TopologyGraph from json.
+func (s *TopologyGraph) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TopologyGraph to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "links":
 			if err := func() error {
-				s.TargetComponentURI.Reset()
-				if err := s.TargetComponentURI.Decode(d); err != nil {
+				s.Links = make([]NilTopologyGraphLinksItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilTopologyGraphLinksItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Links = append(s.Links, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"links\"")
+			}
+		case "nodes":
+			if err := func() error {
+				s.Nodes = make([]NilTopologyGraphNodesItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem NilTopologyGraphNodesItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Nodes = append(s.Nodes, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"TargetComponentURI\"")
+				return errors.Wrap(err, "decode field \"nodes\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem")
+		return errors.Wrap(err, "decode TopologyGraph")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) MarshalJSON() ([]byte, error) {
+func (s *TopologyGraph) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) UnmarshalJSON(data []byte) error {
+func (s *TopologyGraph) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Encode(e *jx.Encoder) {
+func (s *TopologyGraphLinksItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) encodeFields(e *jx.Encoder) {
-	{
-		if s.AllowableNumbers != nil {
-			e.FieldStart("AllowableNumbers")
-			e.ArrStart()
-			for _, elem := range s.AllowableNumbers {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.AllowablePattern.Set {
-			e.FieldStart("AllowablePattern")
-			s.AllowablePattern.Encode(e)
-		}
-	}
-	{
-		if s.AllowableValueDescriptions != nil {
-			e.FieldStart("AllowableValueDescriptions")
-			e.ArrStart()
-			for _, elem := range s.AllowableValueDescriptions {
-				e.Str(elem)
-			}
-			e.ArrEnd()
-		}
-	}
+func (s *TopologyGraphLinksItem) encodeFields(e *jx.Encoder) {
 	{
-		if s.AllowableValues != nil {
-			e.FieldStart("AllowableValues")
-			e.ArrStart()
-			for _, elem := range s.AllowableValues {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.ExpectedPort.Set {
+			e.FieldStart("expected_port")
+			s.ExpectedPort.Encode(e)
 		}
 	}
 	{
-		if s.ArraySizeMaximum.Set {
-			e.FieldStart("ArraySizeMaximum")
-			s.ArraySizeMaximum.Encode(e)
+		if s.ExpectedSwitch.Set {
+			e.FieldStart("expected_switch")
+			s.ExpectedSwitch.Encode(e)
 		}
 	}
 	{
-		if s.ArraySizeMinimum.Set {
-			e.FieldStart("ArraySizeMinimum")
-			s.ArraySizeMinimum.Encode(e)
+		if s.Host.Set {
+			e.FieldStart("host")
+			s.Host.Encode(e)
 		}
 	}
 	{
-		if s.DataType.Set {
-			e.FieldStart("DataType")
-			s.DataType.Encode(e)
+		if s.LastSeen.Set {
+			e.FieldStart("last_seen")
+			s.LastSeen.Encode(e, json.EncodeDateTime)
 		}
 	}
 	{
-		if s.DefaultValue.Set {
-			e.FieldStart("DefaultValue")
-			s.DefaultValue.Encode(e)
+		if s.MAC.Set {
+			e.FieldStart("mac")
+			s.MAC.Encode(e)
 		}
 	}
 	{
-		if s.MaximumValue.Set {
-			e.FieldStart("MaximumValue")
-			s.MaximumValue.Encode(e)
+		if s.Moved.Set {
+			e.FieldStart("moved")
+			s.Moved.Encode(e)
 		}
 	}
 	{
-		if s.MinimumValue.Set {
-			e.FieldStart("MinimumValue")
-			s.MinimumValue.Encode(e)
+		if s.Neighbor.Set {
+			e.FieldStart("neighbor")
+			s.Neighbor.Encode(e)
 		}
 	}
 	{
-		if s.Name.Set {
-			e.FieldStart("Name")
-			s.Name.Encode(e)
+		if s.NeighborPort.Set {
+			e.FieldStart("neighbor_port")
+			s.NeighborPort.Encode(e)
 		}
 	}
 	{
-		if s.NoDefaultValue.Set {
-			e.FieldStart("NoDefaultValue")
-			s.NoDefaultValue.Encode(e)
+		if s.Port.Set {
+			e.FieldStart("port")
+			s.Port.Encode(e)
 		}
 	}
 	{
-		if s.ObjectDataType.Set {
-			e.FieldStart("ObjectDataType")
-			s.ObjectDataType.Encode(e)
+		if s.Switch.Set {
+			e.FieldStart("switch")
+			s.Switch.Encode(e)
 		}
 	}
 	{
-		if s.Required.Set {
-			e.FieldStart("Required")
-			s.Required.Encode(e)
+		if s.Vlan.Set {
+			e.FieldStart("vlan")
+			s.Vlan.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfRedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem = [14]string{
-	0:  "AllowableNumbers",
-	1:  "AllowablePattern",
-	2:  "AllowableValueDescriptions",
-	3:  "AllowableValues",
-	4:  "ArraySizeMaximum",
-	5:  "ArraySizeMinimum",
-	6:  "DataType",
-	7:  "DefaultValue",
-	8:  "MaximumValue",
-	9:  "MinimumValue",
-	10: "Name",
-	11: "NoDefaultValue",
-	12: "ObjectDataType",
-	13: "Required",
+var jsonFieldsNameOfTopologyGraphLinksItem = [11]string{
+	0:  "expected_port",
+	1:  "expected_switch",
+	2:  "host",
+	3:  "last_seen",
+	4:  "mac",
+	5:  "moved",
+	6:  "neighbor",
+	7:  "neighbor_port",
+	8:  "port",
+	9:  "switch",
+	10: "vlan",
 }
 
-// Decode decodes RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem from json.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) Decode(d *jx.Decoder) error {
+// Decode decodes TopologyGraphLinksItem from json.
+func (s *TopologyGraphLinksItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem to nil")
+		return errors.New("invalid: unable to decode TopologyGraphLinksItem to nil")
 	}
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "AllowableNumbers":
-			if err := func() error {
-				s.AllowableNumbers = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableNumbers = append(s.AllowableNumbers, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableNumbers\"")
-			}
-		case "AllowablePattern":
+		case "expected_port":
 			if err := func() error {
-				s.AllowablePattern.Reset()
-				if err := s.AllowablePattern.Decode(d); err != nil {
+				s.ExpectedPort.Reset()
+				if err := s.ExpectedPort.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowablePattern\"")
+				return errors.Wrap(err, "decode field \"expected_port\"")
 			}
-		case "AllowableValueDescriptions":
+		case "expected_switch":
 			if err := func() error {
-				s.AllowableValueDescriptions = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableValueDescriptions = append(s.AllowableValueDescriptions, elem)
-					return nil
-				}); err != nil {
+				s.ExpectedSwitch.Reset()
+				if err := s.ExpectedSwitch.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableValueDescriptions\"")
+				return errors.Wrap(err, "decode field \"expected_switch\"")
 			}
-		case "AllowableValues":
+		case "host":
 			if err := func() error {
-				s.AllowableValues = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.AllowableValues = append(s.AllowableValues, elem)
-					return nil
-				}); err != nil {
+				s.Host.Reset()
+				if err := s.Host.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"AllowableValues\"")
+				return errors.Wrap(err, "decode field \"host\"")
 			}
-		case "ArraySizeMaximum":
+		case "last_seen":
 			if err := func() error {
-				s.ArraySizeMaximum.Reset()
-				if err := s.ArraySizeMaximum.Decode(d); err != nil {
+				s.LastSeen.Reset()
+				if err := s.LastSeen.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ArraySizeMaximum\"")
+				return errors.Wrap(err, "decode field \"last_seen\"")
 			}
-		case "ArraySizeMinimum":
+		case "mac":
 			if err := func() error {
-				s.ArraySizeMinimum.Reset()
-				if err := s.ArraySizeMinimum.Decode(d); err != nil {
+				s.MAC.Reset()
+				if err := s.MAC.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ArraySizeMinimum\"")
+				return errors.Wrap(err, "decode field \"mac\"")
 			}
-		case "DataType":
+		case "moved":
 			if err := func() error {
-				s.DataType.Reset()
-				if err := s.DataType.Decode(d); err != nil {
+				s.Moved.Reset()
+				if err := s.Moved.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"DataType\"")
+				return errors.Wrap(err, "decode field \"moved\"")
 			}
-		case "DefaultValue":
+		case "neighbor":
 			if err := func() error {
-				s.DefaultValue.Reset()
-				if err := s.DefaultValue.Decode(d); err != nil {
+				s.Neighbor.Reset()
+				if err := s.Neighbor.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"DefaultValue\"")
+				return errors.Wrap(err, "decode field \"neighbor\"")
 			}
-		case "MaximumValue":
+		case "neighbor_port":
 			if err := func() error {
-				s.MaximumValue.Reset()
-				if err := s.MaximumValue.Decode(d); err != nil {
+				s.NeighborPort.Reset()
+				if err := s.NeighborPort.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MaximumValue\"")
+				return errors.Wrap(err, "decode field \"neighbor_port\"")
 			}
-		case "MinimumValue":
+		case "port":
 			if err := func() error {
-				s.MinimumValue.Reset()
-				if err := s.MinimumValue.Decode(d); err != nil {
+				s.Port.Reset()
+				if err := s.Port.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"MinimumValue\"")
+				return errors.Wrap(err, "decode field \"port\"")
 			}
-		case "Name":
+		case "switch":
 			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
+				s.Switch.Reset()
+				if err := s.Switch.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Name\"")
+				return errors.Wrap(err, "decode field \"switch\"")
 			}
-		case "NoDefaultValue":
+		case "vlan":
 			if err := func() error {
-				s.NoDefaultValue.Reset()
-				if err := s.NoDefaultValue.Decode(d); err != nil {
+				s.Vlan.Reset()
+				if err := s.Vlan.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"NoDefaultValue\"")
+				return errors.Wrap(err, "decode field \"vlan\"")
 			}
-		case "ObjectDataType":
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode TopologyGraphLinksItem")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *TopologyGraphLinksItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *TopologyGraphLinksItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *TopologyGraphNodesItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *TopologyGraphNodesItem) encodeFields(e *jx.Encoder) {
+	{
+		if s.Kind.Set {
+			e.FieldStart("kind")
+			s.Kind.Encode(e)
+		}
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfTopologyGraphNodesItem = [2]string{
+	0: "kind",
+	1: "name",
+}
+
+// Decode decodes TopologyGraphNodesItem from json.
+func (s *TopologyGraphNodesItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode TopologyGraphNodesItem to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "kind":
 			if err := func() error {
-				s.ObjectDataType.Reset()
-				if err := s.ObjectDataType.Decode(d); err != nil {
+				s.Kind.Reset()
+				if err := s.Kind.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"ObjectDataType\"")
+				return errors.Wrap(err, "decode field \"kind\"")
 			}
-		case "Required":
+		case "name":
 			if err := func() error {
-				s.Required.Reset()
-				if err := s.Required.Decode(d); err != nil {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"Required\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem")
+		return errors.Wrap(err, "decode TopologyGraphNodesItem")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) MarshalJSON() ([]byte, error) {
+func (s *TopologyGraphNodesItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) UnmarshalJSON(data []byte) error {
+func (s *TopologyGraphNodesItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -7,28 +7,49 @@ type OperationName = string
 
 const (
 	DELETEV1AuthSignoutOperation                 OperationName = "DELETEV1AuthSignout"
+	DELETEV1BmcCredentialsScopeNameOperation     OperationName = "DELETEV1BmcCredentialsScopeName"
 	DELETEV1BmcJobsOperation                     OperationName = "DELETEV1BmcJobs"
 	DELETEV1BmcJobsJidsOperation                 OperationName = "DELETEV1BmcJobsJids"
+	DELETEV1BmcMediaOperation                    OperationName = "DELETEV1BmcMedia"
 	DELETEV1BmcSelOperation                      OperationName = "DELETEV1BmcSel"
+	DELETEV1BmcTasksIDOperation                  OperationName = "DELETEV1BmcTasksID"
 	DELETEV1ImagesOperation                      OperationName = "DELETEV1Images"
 	DELETEV1NodesOperation                       OperationName = "DELETEV1Nodes"
 	DELETEV1RolesNamesOperation                  OperationName = "DELETEV1RolesNames"
 	DELETEV1UsersUsernamesOperation              OperationName = "DELETEV1UsersUsernames"
 	GETV1BmcOperation                            OperationName = "GETV1Bmc"
+	GETV1BmcCredentialsOperation                 OperationName = "GETV1BmcCredentials"
 	GETV1BmcJobsOperation                        OperationName = "GETV1BmcJobs"
 	GETV1BmcMetricsOperation                     OperationName = "GETV1BmcMetrics"
+	GETV1BmcTasksOperation                       OperationName = "GETV1BmcTasks"
+	GETV1BmcTasksIDOperation                     OperationName = "GETV1BmcTasksID"
 	GETV1BmcUpgradeDellRepoOperation             OperationName = "GETV1BmcUpgradeDellRepo"
 	GETV1DbDumpOperation                         OperationName = "GETV1DbDump"
+	GETV1DhcpLeasesOperation                     OperationName = "GETV1DhcpLeases"
 	GETV1GrendelEventsOperation                  OperationName = "GETV1GrendelEvents"
+	GETV1GrendelStatusOperation                  OperationName = "GETV1GrendelStatus"
 	GETV1ImagesOperation                         OperationName = "GETV1Images"
+	GETV1ImagesArtifactsOperation                OperationName = "GETV1ImagesArtifacts"
 	GETV1ImagesFindOperation                     OperationName = "GETV1ImagesFind"
+	GETV1ImagesRolloutsOperation                 OperationName = "GETV1ImagesRollouts"
+	GETV1ImagesVersionsNameOperation             OperationName = "GETV1ImagesVersionsName"
 	GETV1NodesOperation                          OperationName = "GETV1Nodes"
 	GETV1NodesFindOperation                      OperationName = "GETV1NodesFind"
+	GETV1NodesHistoryNameOperation               OperationName = "GETV1NodesHistoryName"
+	GETV1NodesInventoryOperation                 OperationName = "GETV1NodesInventory"
+	GETV1NodesInventoryChangesOperation          OperationName = "GETV1NodesInventoryChanges"
+	GETV1NodesLogsNameOperation                  OperationName = "GETV1NodesLogsName"
+	GETV1NodesProvisionOperation                 OperationName = "GETV1NodesProvision"
+	GETV1NodesProvisionNameOperation             OperationName = "GETV1NodesProvisionName"
 	GETV1NodesTokenInterfaceOperation            OperationName = "GETV1NodesTokenInterface"
 	GETV1RolesOperation                          OperationName = "GETV1Roles"
 	GETV1SwitchNodesetLldpOperation              OperationName = "GETV1SwitchNodesetLldp"
+	GETV1TopologyOperation                       OperationName = "GETV1Topology"
+	GETV1TopologyCablingOperation                OperationName = "GETV1TopologyCabling"
+	GETV1TopologyDotOperation                    OperationName = "GETV1TopologyDot"
 	GETV1UsersOperation                          OperationName = "GETV1Users"
 	PATCHV1AuthResetOperation                    OperationName = "PATCHV1AuthReset"
+	PATCHV1ImagesCurrentOperation                OperationName = "PATCHV1ImagesCurrent"
 	PATCHV1NodesImageOperation                   OperationName = "PATCHV1NodesImage"
 	PATCHV1NodesProvisionOperation               OperationName = "PATCHV1NodesProvision"
 	PATCHV1NodesTagsActionOperation              OperationName = "PATCHV1NodesTagsAction"
@@ -40,12 +61,26 @@ const (
 	POSTV1AuthTokenOperation                     OperationName = "POSTV1AuthToken"
 	POSTV1BmcConfigureAutoOperation              OperationName = "POSTV1BmcConfigureAuto"
 	POSTV1BmcConfigureImportOperation            OperationName = "POSTV1BmcConfigureImport"
+	POSTV1BmcCredentialsOperation                OperationName = "POSTV1BmcCredentials"
+	POSTV1BmcCredentialsRotateOperation          OperationName = "POSTV1BmcCredentialsRotate"
+	POSTV1BmcInventoryOperation                  OperationName = "POSTV1BmcInventory"
+	POSTV1BmcMediaOperation                      OperationName = "POSTV1BmcMedia"
 	POSTV1BmcPowerBmcOperation                   OperationName = "POSTV1BmcPowerBmc"
 	POSTV1BmcPowerOsOperation                    OperationName = "POSTV1BmcPowerOs"
 	POSTV1BmcUpgradeDellInstallfromrepoOperation OperationName = "POSTV1BmcUpgradeDellInstallfromrepo"
+	POSTV1BmcUpgradeRedfishOperation             OperationName = "POSTV1BmcUpgradeRedfish"
+	POSTV1BmcUpgradeRedfishTasksOperation        OperationName = "POSTV1BmcUpgradeRedfishTasks"
 	POSTV1DbRestoreOperation                     OperationName = "POSTV1DbRestore"
 	POSTV1ImagesOperation                        OperationName = "POSTV1Images"
+	POSTV1ImagesArtifactsOperation               OperationName = "POSTV1ImagesArtifacts"
+	POSTV1ImagesArtifactsGcOperation             OperationName = "POSTV1ImagesArtifactsGc"
+	POSTV1ImagesRolloutsOperation                OperationName = "POSTV1ImagesRollouts"
+	POSTV1ImagesRolloutsImagePromoteOperation    OperationName = "POSTV1ImagesRolloutsImagePromote"
+	POSTV1ImagesRolloutsImageRollbackOperation   OperationName = "POSTV1ImagesRolloutsImageRollback"
 	POSTV1NodesOperation                         OperationName = "POSTV1Nodes"
 	POSTV1RolesOperation                         OperationName = "POSTV1Roles"
+	POSTV1SwitchPortsOperation                   OperationName = "POSTV1SwitchPorts"
+	POSTV1TopologyAcceptOperation                OperationName = "POSTV1TopologyAccept"
+	POSTV1TopologyWalkOperation                  OperationName = "POSTV1TopologyWalk"
 	POSTV1UsersOperation                         OperationName = "POSTV1Users"
 )
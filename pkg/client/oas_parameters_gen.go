@@ -7,12 +7,23 @@ type DELETEV1AuthSignoutParams struct {
 	Accept OptString
 }
 
+// DELETEV1BmcCredentialsScopeNameParams is parameters of DELETE_/v1/bmc/credentials/:scope/:name operation.
+type DELETEV1BmcCredentialsScopeNameParams struct {
+	// Host or tag.
+	Scope string
+	// Node name or tag.
+	Name   string
+	Accept OptString
+}
+
 // DELETEV1BmcJobsParams is parameters of DELETE_/v1/bmc/jobs operation.
 type DELETEV1BmcJobsParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
 	Nodeset OptString
 	// Filter by tags. Minimum of one query parameter is required.
-	Tags   OptString
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
 	Accept OptString
 }
 
@@ -23,7 +34,20 @@ type DELETEV1BmcJobsJidsParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
 	Nodeset OptString
 	// Filter by tags. Minimum of one query parameter is required.
-	Tags   OptString
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
+	Accept OptString
+}
+
+// DELETEV1BmcMediaParams is parameters of DELETE_/v1/bmc/media operation.
+type DELETEV1BmcMediaParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
 	Accept OptString
 }
 
@@ -32,7 +56,16 @@ type DELETEV1BmcSelParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
 	Nodeset OptString
 	// Filter by tags. Minimum of one query parameter is required.
-	Tags   OptString
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
+	Accept OptString
+}
+
+// DELETEV1BmcTasksIDParams is parameters of DELETE_/v1/bmc/tasks/:id operation.
+type DELETEV1BmcTasksIDParams struct {
+	// Task id.
+	ID     string
 	Accept OptString
 }
 
@@ -75,6 +108,11 @@ type GETV1BmcParams struct {
 	Accept OptString
 }
 
+// GETV1BmcCredentialsParams is parameters of GET_/v1/bmc/credentials operation.
+type GETV1BmcCredentialsParams struct {
+	Accept OptString
+}
+
 // GETV1BmcJobsParams is parameters of GET_/v1/bmc/jobs operation.
 type GETV1BmcJobsParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
@@ -93,6 +131,22 @@ type GETV1BmcMetricsParams struct {
 	Accept OptString
 }
 
+// GETV1BmcTasksParams is parameters of GET_/v1/bmc/tasks operation.
+type GETV1BmcTasksParams struct {
+	// Filter by status.
+	Status OptString
+	// Maximum number of tasks to return. Defaults to 50.
+	Limit  OptInt
+	Accept OptString
+}
+
+// GETV1BmcTasksIDParams is parameters of GET_/v1/bmc/tasks/:id operation.
+type GETV1BmcTasksIDParams struct {
+	// Task id.
+	ID     string
+	Accept OptString
+}
+
 // GETV1BmcUpgradeDellRepoParams is parameters of GET_/v1/bmc/upgrade/dell/repo operation.
 type GETV1BmcUpgradeDellRepoParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
@@ -107,8 +161,32 @@ type GETV1DbDumpParams struct {
 	Accept OptString
 }
 
+// GETV1DhcpLeasesParams is parameters of GET_/v1/dhcp/leases operation.
+type GETV1DhcpLeasesParams struct {
+	Accept OptString
+}
+
 // GETV1GrendelEventsParams is parameters of GET_/v1/grendel/events operation.
 type GETV1GrendelEventsParams struct {
+	// Filter by username.
+	User OptString
+	// Filter by severity.
+	Severity OptString
+	// Filter by host name.
+	Host OptString
+	// Only return events at or after this RFC3339 timestamp.
+	Since OptString
+	// Only return events at or before this RFC3339 timestamp.
+	Until OptString
+	// Maximum number of events to return, at most 1000. Defaults to 50.
+	Limit OptInt
+	// Number of events to skip.
+	Offset OptInt
+	Accept OptString
+}
+
+// GETV1GrendelStatusParams is parameters of GET_/v1/grendel/status operation.
+type GETV1GrendelStatusParams struct {
 	Accept OptString
 }
 
@@ -117,6 +195,11 @@ type GETV1ImagesParams struct {
 	Accept OptString
 }
 
+// GETV1ImagesArtifactsParams is parameters of GET_/v1/images/artifacts operation.
+type GETV1ImagesArtifactsParams struct {
+	Accept OptString
+}
+
 // GETV1ImagesFindParams is parameters of GET_/v1/images/find operation.
 type GETV1ImagesFindParams struct {
 	// Filter by name.
@@ -124,6 +207,18 @@ type GETV1ImagesFindParams struct {
 	Accept OptString
 }
 
+// GETV1ImagesRolloutsParams is parameters of GET_/v1/images/rollouts operation.
+type GETV1ImagesRolloutsParams struct {
+	Accept OptString
+}
+
+// GETV1ImagesVersionsNameParams is parameters of GET_/v1/images/versions/:name operation.
+type GETV1ImagesVersionsNameParams struct {
+	// Image name.
+	Name   string
+	Accept OptString
+}
+
 // GETV1NodesParams is parameters of GET_/v1/nodes operation.
 type GETV1NodesParams struct {
 	Accept OptString
@@ -138,6 +233,64 @@ type GETV1NodesFindParams struct {
 	Accept OptString
 }
 
+// GETV1NodesHistoryNameParams is parameters of GET_/v1/nodes/history/:name operation.
+type GETV1NodesHistoryNameParams struct {
+	// Node name.
+	Name string
+	// Only return events after this RFC3339 timestamp. Defaults to the last 24 hours.
+	Since  OptString
+	Accept OptString
+}
+
+// GETV1NodesInventoryParams is parameters of GET_/v1/nodes/inventory operation.
+type GETV1NodesInventoryParams struct {
+	// Filter by nodeset.
+	Nodeset OptString
+	// Filter by tags.
+	Tags OptString
+	// Filter by kind: cpu, dimm, pcie, nic, drive or firmware.
+	Kind OptString
+	// Filter by component name or slot.
+	Name OptString
+	// Only return components with a version older than this.
+	VersionLt OptString
+	Accept    OptString
+}
+
+// GETV1NodesInventoryChangesParams is parameters of GET_/v1/nodes/inventory/changes operation.
+type GETV1NodesInventoryChangesParams struct {
+	// Filter by nodeset.
+	Nodeset OptString
+	// Filter by tags.
+	Tags OptString
+	// Only return changes after this RFC3339 timestamp. Defaults to the last 30 days.
+	Since  OptString
+	Accept OptString
+}
+
+// GETV1NodesLogsNameParams is parameters of GET_/v1/nodes/logs/:name operation.
+type GETV1NodesLogsNameParams struct {
+	// Node name.
+	Name   string
+	Accept OptString
+}
+
+// GETV1NodesProvisionParams is parameters of GET_/v1/nodes/provision operation.
+type GETV1NodesProvisionParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
+// GETV1NodesProvisionNameParams is parameters of GET_/v1/nodes/provision/:name operation.
+type GETV1NodesProvisionNameParams struct {
+	// Node name.
+	Name   string
+	Accept OptString
+}
+
 // GETV1NodesTokenInterfaceParams is parameters of GET_/v1/nodes/token/:interface operation.
 type GETV1NodesTokenInterfaceParams struct {
 	// Interface token will be created for.
@@ -164,6 +317,32 @@ type GETV1SwitchNodesetLldpParams struct {
 	Nodeset string
 }
 
+// GETV1TopologyParams is parameters of GET_/v1/topology operation.
+type GETV1TopologyParams struct {
+	// Only return links seen on this switch.
+	Switch OptString
+	// Only return links of hosts seen on another switch port than expected.
+	Moved  OptBool
+	Accept OptString
+}
+
+// GETV1TopologyCablingParams is parameters of GET_/v1/topology/cabling operation.
+type GETV1TopologyCablingParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
+// GETV1TopologyDotParams is parameters of GET_/v1/topology/dot operation.
+type GETV1TopologyDotParams struct {
+	// Only return links seen on this switch.
+	Switch OptString
+	// Only return links of hosts seen on another switch port than expected.
+	Moved OptBool
+}
+
 // GETV1UsersParams is parameters of GET_/v1/users operation.
 type GETV1UsersParams struct {
 	// Filter by usernames.
@@ -176,6 +355,11 @@ type PATCHV1AuthResetParams struct {
 	Accept OptString
 }
 
+// PATCHV1ImagesCurrentParams is parameters of PATCH_/v1/images/current operation.
+type PATCHV1ImagesCurrentParams struct {
+	Accept OptString
+}
+
 // PATCHV1NodesImageParams is parameters of PATCH_/v1/nodes/image operation.
 type PATCHV1NodesImageParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
@@ -244,7 +428,9 @@ type POSTV1BmcConfigureAutoParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
 	Nodeset OptString
 	// Filter by tags. Minimum of one query parameter is required.
-	Tags   OptString
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
 	Accept OptString
 }
 
@@ -253,7 +439,47 @@ type POSTV1BmcConfigureImportParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
 	Nodeset OptString
 	// Filter by tags. Minimum of one query parameter is required.
-	Tags   OptString
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
+	Accept OptString
+}
+
+// POSTV1BmcCredentialsParams is parameters of POST_/v1/bmc/credentials operation.
+type POSTV1BmcCredentialsParams struct {
+	Accept OptString
+}
+
+// POSTV1BmcCredentialsRotateParams is parameters of POST_/v1/bmc/credentials/rotate operation.
+type POSTV1BmcCredentialsRotateParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
+	Accept OptString
+}
+
+// POSTV1BmcInventoryParams is parameters of POST_/v1/bmc/inventory operation.
+type POSTV1BmcInventoryParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
+	Accept OptString
+}
+
+// POSTV1BmcMediaParams is parameters of POST_/v1/bmc/media operation.
+type POSTV1BmcMediaParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
 	Accept OptString
 }
 
@@ -262,7 +488,9 @@ type POSTV1BmcPowerBmcParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
 	Nodeset OptString
 	// Filter by tags. Minimum of one query parameter is required.
-	Tags   OptString
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
 	Accept OptString
 }
 
@@ -271,7 +499,9 @@ type POSTV1BmcPowerOsParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
 	Nodeset OptString
 	// Filter by tags. Minimum of one query parameter is required.
-	Tags   OptString
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
 	Accept OptString
 }
 
@@ -280,7 +510,25 @@ type POSTV1BmcUpgradeDellInstallfromrepoParams struct {
 	// Filter by nodeset. Minimum of one query parameter is required.
 	Nodeset OptString
 	// Filter by tags. Minimum of one query parameter is required.
-	Tags   OptString
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
+	Accept OptString
+}
+
+// POSTV1BmcUpgradeRedfishParams is parameters of POST_/v1/bmc/upgrade/redfish operation.
+type POSTV1BmcUpgradeRedfishParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Run in the background and return the id of the task in the data of a single queued message.
+	Async  OptBool
+	Accept OptString
+}
+
+// POSTV1BmcUpgradeRedfishTasksParams is parameters of POST_/v1/bmc/upgrade/redfish/tasks operation.
+type POSTV1BmcUpgradeRedfishTasksParams struct {
 	Accept OptString
 }
 
@@ -294,6 +542,34 @@ type POSTV1ImagesParams struct {
 	Accept OptString
 }
 
+// POSTV1ImagesArtifactsGcParams is parameters of POST_/v1/images/artifacts/gc operation.
+type POSTV1ImagesArtifactsGcParams struct {
+	Accept OptString
+}
+
+// POSTV1ImagesRolloutsParams is parameters of POST_/v1/images/rollouts operation.
+type POSTV1ImagesRolloutsParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
+// POSTV1ImagesRolloutsImagePromoteParams is parameters of POST_/v1/images/rollouts/:image/promote operation.
+type POSTV1ImagesRolloutsImagePromoteParams struct {
+	// Image name.
+	Image  string
+	Accept OptString
+}
+
+// POSTV1ImagesRolloutsImageRollbackParams is parameters of POST_/v1/images/rollouts/:image/rollback operation.
+type POSTV1ImagesRolloutsImageRollbackParams struct {
+	// Image name.
+	Image  string
+	Accept OptString
+}
+
 // POSTV1NodesParams is parameters of POST_/v1/nodes operation.
 type POSTV1NodesParams struct {
 	Accept OptString
@@ -304,6 +580,33 @@ type POSTV1RolesParams struct {
 	Accept OptString
 }
 
+// POSTV1SwitchPortsParams is parameters of POST_/v1/switch/ports operation.
+type POSTV1SwitchPortsParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags OptString
+	// Only return the changes without configuring the switches.
+	DryRun OptBool
+	// Also set the admin state of the ports.
+	AdminState OptString
+	Accept     OptString
+}
+
+// POSTV1TopologyAcceptParams is parameters of POST_/v1/topology/accept operation.
+type POSTV1TopologyAcceptParams struct {
+	// Filter by nodeset. Minimum of one query parameter is required.
+	Nodeset OptString
+	// Filter by tags. Minimum of one query parameter is required.
+	Tags   OptString
+	Accept OptString
+}
+
+// POSTV1TopologyWalkParams is parameters of POST_/v1/topology/walk operation.
+type POSTV1TopologyWalkParams struct {
+	Accept OptString
+}
+
 // POSTV1UsersParams is parameters of POST_/v1/users operation.
 type POSTV1UsersParams struct {
 	Accept OptString
@@ -39,6 +39,20 @@ func encodePATCHV1AuthResetRequest(
 	return nil
 }
 
+func encodePATCHV1ImagesCurrentRequest(
+	req *BootImageCurrentRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePATCHV1NodesImageRequest(
 	req *NodeBootImageRequest,
 	r *http.Request,
@@ -179,6 +193,48 @@ func encodePOSTV1BmcConfigureImportRequest(
 	return nil
 }
 
+func encodePOSTV1BmcCredentialsRequest(
+	req *BmcCredentialRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePOSTV1BmcCredentialsRotateRequest(
+	req *BmcCredentialRotateRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePOSTV1BmcMediaRequest(
+	req *BmcMediaInsertRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePOSTV1BmcPowerOsRequest(
 	req *BmcOsPowerBody,
 	r *http.Request,
@@ -207,6 +263,34 @@ func encodePOSTV1BmcUpgradeDellInstallfromrepoRequest(
 	return nil
 }
 
+func encodePOSTV1BmcUpgradeRedfishRequest(
+	req *BmcFirmwareUpdateRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodePOSTV1BmcUpgradeRedfishTasksRequest(
+	req *BmcFirmwareTasksRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePOSTV1DbRestoreRequest(
 	req *DataDump,
 	r *http.Request,
@@ -235,6 +319,30 @@ func encodePOSTV1ImagesRequest(
 	return nil
 }
 
+func encodePOSTV1ImagesArtifactsRequest(
+	req POSTV1ImagesArtifactsReq,
+	r *http.Request,
+) error {
+	const contentType = "application/octet-stream"
+	body := req
+	ht.SetBody(r, body, contentType)
+	return nil
+}
+
+func encodePOSTV1ImagesRolloutsRequest(
+	req *RolloutStartRequest,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePOSTV1NodesRequest(
 	req *NodeAddRequest,
 	r *http.Request,
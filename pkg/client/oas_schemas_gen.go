@@ -4,6 +4,7 @@ package client
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/go-faster/jx"
@@ -13,6 +14,55 @@ func (s *HTTPErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+// Artifact schema.
+// Ref: #/components/schemas/Artifact
+type Artifact struct {
+	Path   OptString   `json:"path"`
+	SHA256 OptString   `json:"sha256"`
+	Size   OptInt64    `json:"size"`
+	Time   OptDateTime `json:"time"`
+}
+
+// GetPath returns the value of Path.
+func (s *Artifact) GetPath() OptString {
+	return s.Path
+}
+
+// GetSHA256 returns the value of SHA256.
+func (s *Artifact) GetSHA256() OptString {
+	return s.SHA256
+}
+
+// GetSize returns the value of Size.
+func (s *Artifact) GetSize() OptInt64 {
+	return s.Size
+}
+
+// GetTime returns the value of Time.
+func (s *Artifact) GetTime() OptDateTime {
+	return s.Time
+}
+
+// SetPath sets the value of Path.
+func (s *Artifact) SetPath(val OptString) {
+	s.Path = val
+}
+
+// SetSHA256 sets the value of SHA256.
+func (s *Artifact) SetSHA256(val OptString) {
+	s.SHA256 = val
+}
+
+// SetSize sets the value of Size.
+func (s *Artifact) SetSize(val OptInt64) {
+	s.Size = val
+}
+
+// SetTime sets the value of Time.
+func (s *Artifact) SetTime(val OptDateTime) {
+	s.Time = val
+}
+
 // AuthRequest schema.
 // Ref: #/components/schemas/AuthRequest
 type AuthRequest struct {
@@ -200,6 +250,170 @@ func (s *AuthTokenRequest) SetUsername(val OptString) {
 	s.Username = val
 }
 
+// BmcCredential schema.
+// Ref: #/components/schemas/BmcCredential
+type BmcCredential struct {
+	Name      OptString    `json:"name"`
+	Password  OptNilString `json:"password"`
+	Scope     OptString    `json:"scope"`
+	UpdatedAt OptDateTime  `json:"updated_at"`
+	Username  OptString    `json:"username"`
+}
+
+// GetName returns the value of Name.
+func (s *BmcCredential) GetName() OptString {
+	return s.Name
+}
+
+// GetPassword returns the value of Password.
+func (s *BmcCredential) GetPassword() OptNilString {
+	return s.Password
+}
+
+// GetScope returns the value of Scope.
+func (s *BmcCredential) GetScope() OptString {
+	return s.Scope
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *BmcCredential) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// GetUsername returns the value of Username.
+func (s *BmcCredential) GetUsername() OptString {
+	return s.Username
+}
+
+// SetName sets the value of Name.
+func (s *BmcCredential) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetPassword sets the value of Password.
+func (s *BmcCredential) SetPassword(val OptNilString) {
+	s.Password = val
+}
+
+// SetScope sets the value of Scope.
+func (s *BmcCredential) SetScope(val OptString) {
+	s.Scope = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *BmcCredential) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// SetUsername sets the value of Username.
+func (s *BmcCredential) SetUsername(val OptString) {
+	s.Username = val
+}
+
+// BmcCredentialRequest schema.
+// Ref: #/components/schemas/BmcCredentialRequest
+type BmcCredentialRequest struct {
+	// Host name or tag.
+	Name     OptString `json:"name"`
+	Password OptString `json:"password"`
+	// Host or tag.
+	Scope    OptString `json:"scope"`
+	Username OptString `json:"username"`
+}
+
+// GetName returns the value of Name.
+func (s *BmcCredentialRequest) GetName() OptString {
+	return s.Name
+}
+
+// GetPassword returns the value of Password.
+func (s *BmcCredentialRequest) GetPassword() OptString {
+	return s.Password
+}
+
+// GetScope returns the value of Scope.
+func (s *BmcCredentialRequest) GetScope() OptString {
+	return s.Scope
+}
+
+// GetUsername returns the value of Username.
+func (s *BmcCredentialRequest) GetUsername() OptString {
+	return s.Username
+}
+
+// SetName sets the value of Name.
+func (s *BmcCredentialRequest) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetPassword sets the value of Password.
+func (s *BmcCredentialRequest) SetPassword(val OptString) {
+	s.Password = val
+}
+
+// SetScope sets the value of Scope.
+func (s *BmcCredentialRequest) SetScope(val OptString) {
+	s.Scope = val
+}
+
+// SetUsername sets the value of Username.
+func (s *BmcCredentialRequest) SetUsername(val OptString) {
+	s.Username = val
+}
+
+// BmcCredentialRotateRequest schema.
+// Ref: #/components/schemas/BmcCredentialRotateRequest
+type BmcCredentialRotateRequest struct {
+	CurrentPassword OptString `json:"current_password"`
+	// Log in with this user name instead of the node's credentials. The password of this account is
+	// rotated.
+	CurrentUsername OptString `json:"current_username"`
+	// Log in with the factory default credentials.
+	Factory OptBool `json:"factory"`
+	// Length of the new passwords.
+	Length OptInt `json:"length"`
+}
+
+// GetCurrentPassword returns the value of CurrentPassword.
+func (s *BmcCredentialRotateRequest) GetCurrentPassword() OptString {
+	return s.CurrentPassword
+}
+
+// GetCurrentUsername returns the value of CurrentUsername.
+func (s *BmcCredentialRotateRequest) GetCurrentUsername() OptString {
+	return s.CurrentUsername
+}
+
+// GetFactory returns the value of Factory.
+func (s *BmcCredentialRotateRequest) GetFactory() OptBool {
+	return s.Factory
+}
+
+// GetLength returns the value of Length.
+func (s *BmcCredentialRotateRequest) GetLength() OptInt {
+	return s.Length
+}
+
+// SetCurrentPassword sets the value of CurrentPassword.
+func (s *BmcCredentialRotateRequest) SetCurrentPassword(val OptString) {
+	s.CurrentPassword = val
+}
+
+// SetCurrentUsername sets the value of CurrentUsername.
+func (s *BmcCredentialRotateRequest) SetCurrentUsername(val OptString) {
+	s.CurrentUsername = val
+}
+
+// SetFactory sets the value of Factory.
+func (s *BmcCredentialRotateRequest) SetFactory(val OptBool) {
+	s.Factory = val
+}
+
+// SetLength sets the value of Length.
+func (s *BmcCredentialRotateRequest) SetLength(val OptInt) {
+	s.Length = val
+}
+
 // BmcDellInstallFromRepoRequest schema.
 // Ref: #/components/schemas/BmcDellInstallFromRepoRequest
 type BmcDellInstallFromRepoRequest struct {
@@ -301,6 +515,101 @@ func (s *BmcDellInstallFromRepoRequest) SetShareType(val OptString) {
 	s.ShareType = val
 }
 
+// BmcFirmwareTasksRequest schema.
+// Ref: #/components/schemas/BmcFirmwareTasksRequest
+type BmcFirmwareTasksRequest struct {
+	// Task URI returned by the firmware update of each node, by node name.
+	Tasks OptBmcFirmwareTasksRequestTasks `json:"tasks"`
+}
+
+// GetTasks returns the value of Tasks.
+func (s *BmcFirmwareTasksRequest) GetTasks() OptBmcFirmwareTasksRequestTasks {
+	return s.Tasks
+}
+
+// SetTasks sets the value of Tasks.
+func (s *BmcFirmwareTasksRequest) SetTasks(val OptBmcFirmwareTasksRequestTasks) {
+	s.Tasks = val
+}
+
+// Task URI returned by the firmware update of each node, by node name.
+type BmcFirmwareTasksRequestTasks map[string]string
+
+func (s *BmcFirmwareTasksRequestTasks) init() BmcFirmwareTasksRequestTasks {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+// BmcFirmwareUpdateRequest schema.
+// Ref: #/components/schemas/BmcFirmwareUpdateRequest
+type BmcFirmwareUpdateRequest struct {
+	// Redfish apply time of push updates, e.g. Immediate or OnReset.
+	ApplyTime OptString `json:"apply_time"`
+	// Bypass update policies such as downgrade protection.
+	ForceUpdate OptBool `json:"force_update"`
+	// Firmware image file in the provision repo dir, or a URL for simple updates.
+	Image OptString `json:"image"`
+	// Simple = BMC downloads the image with SimpleUpdate, push = upload the image to the BMC. Defaults
+	// to simple.
+	Method OptString `json:"method"`
+	// Software inventory URIs to update, all applicable components when empty.
+	Targets []string `json:"targets"`
+}
+
+// GetApplyTime returns the value of ApplyTime.
+func (s *BmcFirmwareUpdateRequest) GetApplyTime() OptString {
+	return s.ApplyTime
+}
+
+// GetForceUpdate returns the value of ForceUpdate.
+func (s *BmcFirmwareUpdateRequest) GetForceUpdate() OptBool {
+	return s.ForceUpdate
+}
+
+// GetImage returns the value of Image.
+func (s *BmcFirmwareUpdateRequest) GetImage() OptString {
+	return s.Image
+}
+
+// GetMethod returns the value of Method.
+func (s *BmcFirmwareUpdateRequest) GetMethod() OptString {
+	return s.Method
+}
+
+// GetTargets returns the value of Targets.
+func (s *BmcFirmwareUpdateRequest) GetTargets() []string {
+	return s.Targets
+}
+
+// SetApplyTime sets the value of ApplyTime.
+func (s *BmcFirmwareUpdateRequest) SetApplyTime(val OptString) {
+	s.ApplyTime = val
+}
+
+// SetForceUpdate sets the value of ForceUpdate.
+func (s *BmcFirmwareUpdateRequest) SetForceUpdate(val OptBool) {
+	s.ForceUpdate = val
+}
+
+// SetImage sets the value of Image.
+func (s *BmcFirmwareUpdateRequest) SetImage(val OptString) {
+	s.Image = val
+}
+
+// SetMethod sets the value of Method.
+func (s *BmcFirmwareUpdateRequest) SetMethod(val OptString) {
+	s.Method = val
+}
+
+// SetTargets sets the value of Targets.
+func (s *BmcFirmwareUpdateRequest) SetTargets(val []string) {
+	s.Targets = val
+}
+
 // BmcImportConfigurationRequest schema.
 // Ref: #/components/schemas/BmcImportConfigurationRequest
 type BmcImportConfigurationRequest struct {
@@ -359,6 +668,35 @@ func (s *BmcJobDeleteRequestNodeJobList) init() BmcJobDeleteRequestNodeJobList {
 	return m
 }
 
+// BmcMediaInsertRequest schema.
+// Ref: #/components/schemas/BmcMediaInsertRequest
+type BmcMediaInsertRequest struct {
+	// Restart the node from the virtual media once inserted.
+	Boot OptBool `json:"boot"`
+	// ISO in the provision repo dir, or a URL.
+	Image OptString `json:"image"`
+}
+
+// GetBoot returns the value of Boot.
+func (s *BmcMediaInsertRequest) GetBoot() OptBool {
+	return s.Boot
+}
+
+// GetImage returns the value of Image.
+func (s *BmcMediaInsertRequest) GetImage() OptString {
+	return s.Image
+}
+
+// SetBoot sets the value of Boot.
+func (s *BmcMediaInsertRequest) SetBoot(val OptBool) {
+	s.Boot = val
+}
+
+// SetImage sets the value of Image.
+func (s *BmcMediaInsertRequest) SetImage(val OptString) {
+	s.Image = val
+}
+
 // BmcOsPowerBody schema.
 // Ref: #/components/schemas/BmcOsPowerBody
 type BmcOsPowerBody struct {
@@ -390,702 +728,855 @@ func (s *BmcOsPowerBody) SetPowerOption(val OptString) {
 	s.PowerOption = val
 }
 
-// BootImage schema.
-// Ref: #/components/schemas/BootImage
-type BootImage struct {
-	Cmdline            OptString                         `json:"cmdline"`
-	ID                 OptNilInt64                       `json:"id"`
-	Initrd             []string                          `json:"initrd"`
-	Kernel             string                            `json:"kernel"`
-	Liveimg            OptString                         `json:"liveimg"`
-	Name               string                            `json:"name"`
-	ProvisionTemplates OptNilBootImageProvisionTemplates `json:"provision_templates"`
-	UID                OptNilString                      `json:"uid"`
-	Verify             OptBool                           `json:"verify"`
+// BmcTask schema.
+// Ref: #/components/schemas/BmcTask
+type BmcTask struct {
+	CreatedAt OptDateTime        `json:"created_at"`
+	Error     OptString          `json:"error"`
+	Hosts     []BmcTaskHostsItem `json:"hosts"`
+	ID        OptString          `json:"id"`
+	Operation OptString          `json:"operation"`
+	Owner     OptString          `json:"owner"`
+	Status    OptString          `json:"status"`
+	UpdatedAt OptDateTime        `json:"updated_at"`
+	User      OptString          `json:"user"`
 }
 
-// GetCmdline returns the value of Cmdline.
-func (s *BootImage) GetCmdline() OptString {
-	return s.Cmdline
+// GetCreatedAt returns the value of CreatedAt.
+func (s *BmcTask) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// GetError returns the value of Error.
+func (s *BmcTask) GetError() OptString {
+	return s.Error
+}
+
+// GetHosts returns the value of Hosts.
+func (s *BmcTask) GetHosts() []BmcTaskHostsItem {
+	return s.Hosts
 }
 
 // GetID returns the value of ID.
-func (s *BootImage) GetID() OptNilInt64 {
+func (s *BmcTask) GetID() OptString {
 	return s.ID
 }
 
-// GetInitrd returns the value of Initrd.
-func (s *BootImage) GetInitrd() []string {
-	return s.Initrd
+// GetOperation returns the value of Operation.
+func (s *BmcTask) GetOperation() OptString {
+	return s.Operation
 }
 
-// GetKernel returns the value of Kernel.
-func (s *BootImage) GetKernel() string {
-	return s.Kernel
+// GetOwner returns the value of Owner.
+func (s *BmcTask) GetOwner() OptString {
+	return s.Owner
 }
 
-// GetLiveimg returns the value of Liveimg.
-func (s *BootImage) GetLiveimg() OptString {
-	return s.Liveimg
+// GetStatus returns the value of Status.
+func (s *BmcTask) GetStatus() OptString {
+	return s.Status
 }
 
-// GetName returns the value of Name.
-func (s *BootImage) GetName() string {
-	return s.Name
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *BmcTask) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// GetProvisionTemplates returns the value of ProvisionTemplates.
-func (s *BootImage) GetProvisionTemplates() OptNilBootImageProvisionTemplates {
-	return s.ProvisionTemplates
+// GetUser returns the value of User.
+func (s *BmcTask) GetUser() OptString {
+	return s.User
 }
 
-// GetUID returns the value of UID.
-func (s *BootImage) GetUID() OptNilString {
-	return s.UID
+// SetCreatedAt sets the value of CreatedAt.
+func (s *BmcTask) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetVerify returns the value of Verify.
-func (s *BootImage) GetVerify() OptBool {
-	return s.Verify
+// SetError sets the value of Error.
+func (s *BmcTask) SetError(val OptString) {
+	s.Error = val
 }
 
-// SetCmdline sets the value of Cmdline.
-func (s *BootImage) SetCmdline(val OptString) {
-	s.Cmdline = val
+// SetHosts sets the value of Hosts.
+func (s *BmcTask) SetHosts(val []BmcTaskHostsItem) {
+	s.Hosts = val
 }
 
 // SetID sets the value of ID.
-func (s *BootImage) SetID(val OptNilInt64) {
+func (s *BmcTask) SetID(val OptString) {
 	s.ID = val
 }
 
-// SetInitrd sets the value of Initrd.
-func (s *BootImage) SetInitrd(val []string) {
-	s.Initrd = val
+// SetOperation sets the value of Operation.
+func (s *BmcTask) SetOperation(val OptString) {
+	s.Operation = val
 }
 
-// SetKernel sets the value of Kernel.
-func (s *BootImage) SetKernel(val string) {
-	s.Kernel = val
+// SetOwner sets the value of Owner.
+func (s *BmcTask) SetOwner(val OptString) {
+	s.Owner = val
 }
 
-// SetLiveimg sets the value of Liveimg.
-func (s *BootImage) SetLiveimg(val OptString) {
-	s.Liveimg = val
+// SetStatus sets the value of Status.
+func (s *BmcTask) SetStatus(val OptString) {
+	s.Status = val
 }
 
-// SetName sets the value of Name.
-func (s *BootImage) SetName(val string) {
-	s.Name = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *BmcTask) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetProvisionTemplates sets the value of ProvisionTemplates.
-func (s *BootImage) SetProvisionTemplates(val OptNilBootImageProvisionTemplates) {
-	s.ProvisionTemplates = val
+// SetUser sets the value of User.
+func (s *BmcTask) SetUser(val OptString) {
+	s.User = val
 }
 
-// SetUID sets the value of UID.
-func (s *BootImage) SetUID(val OptNilString) {
-	s.UID = val
+type BmcTaskHostsItem struct {
+	Data         OptString                       `json:"data"`
+	Host         OptString                       `json:"host"`
+	Msg          OptString                       `json:"msg"`
+	RedfishError OptBmcTaskHostsItemRedfishError `json:"redfish_error"`
+	Status       OptString                       `json:"status"`
 }
 
-// SetVerify sets the value of Verify.
-func (s *BootImage) SetVerify(val OptBool) {
-	s.Verify = val
+// GetData returns the value of Data.
+func (s *BmcTaskHostsItem) GetData() OptString {
+	return s.Data
 }
 
-// BootImageAddRequest schema.
-// Ref: #/components/schemas/BootImageAddRequest
-type BootImageAddRequest struct {
-	BootImages []NilBootImageAddRequestBootImagesItem `json:"boot_images"`
+// GetHost returns the value of Host.
+func (s *BmcTaskHostsItem) GetHost() OptString {
+	return s.Host
 }
 
-// GetBootImages returns the value of BootImages.
-func (s *BootImageAddRequest) GetBootImages() []NilBootImageAddRequestBootImagesItem {
-	return s.BootImages
+// GetMsg returns the value of Msg.
+func (s *BmcTaskHostsItem) GetMsg() OptString {
+	return s.Msg
 }
 
-// SetBootImages sets the value of BootImages.
-func (s *BootImageAddRequest) SetBootImages(val []NilBootImageAddRequestBootImagesItem) {
-	s.BootImages = val
+// GetRedfishError returns the value of RedfishError.
+func (s *BmcTaskHostsItem) GetRedfishError() OptBmcTaskHostsItemRedfishError {
+	return s.RedfishError
 }
 
-type BootImageAddRequestBootImagesItem struct {
-	Cmdline            OptString                                                 `json:"cmdline"`
-	ID                 OptNilInt64                                               `json:"id"`
-	Initrd             []string                                                  `json:"initrd"`
-	Kernel             OptString                                                 `json:"kernel"`
-	Liveimg            OptString                                                 `json:"liveimg"`
-	Name               OptString                                                 `json:"name"`
-	ProvisionTemplates OptNilBootImageAddRequestBootImagesItemProvisionTemplates `json:"provision_templates"`
-	UID                OptNilString                                              `json:"uid"`
-	Verify             OptBool                                                   `json:"verify"`
+// GetStatus returns the value of Status.
+func (s *BmcTaskHostsItem) GetStatus() OptString {
+	return s.Status
+}
+
+// SetData sets the value of Data.
+func (s *BmcTaskHostsItem) SetData(val OptString) {
+	s.Data = val
+}
+
+// SetHost sets the value of Host.
+func (s *BmcTaskHostsItem) SetHost(val OptString) {
+	s.Host = val
+}
+
+// SetMsg sets the value of Msg.
+func (s *BmcTaskHostsItem) SetMsg(val OptString) {
+	s.Msg = val
+}
+
+// SetRedfishError sets the value of RedfishError.
+func (s *BmcTaskHostsItem) SetRedfishError(val OptBmcTaskHostsItemRedfishError) {
+	s.RedfishError = val
+}
+
+// SetStatus sets the value of Status.
+func (s *BmcTaskHostsItem) SetStatus(val OptString) {
+	s.Status = val
+}
+
+type BmcTaskHostsItemRedfishError struct {
+	Code  OptString                            `json:"code"`
+	Error OptBmcTaskHostsItemRedfishErrorError `json:"error"`
+}
+
+// GetCode returns the value of Code.
+func (s *BmcTaskHostsItemRedfishError) GetCode() OptString {
+	return s.Code
+}
+
+// GetError returns the value of Error.
+func (s *BmcTaskHostsItemRedfishError) GetError() OptBmcTaskHostsItemRedfishErrorError {
+	return s.Error
+}
+
+// SetCode sets the value of Code.
+func (s *BmcTaskHostsItemRedfishError) SetCode(val OptString) {
+	s.Code = val
+}
+
+// SetError sets the value of Error.
+func (s *BmcTaskHostsItemRedfishError) SetError(val OptBmcTaskHostsItemRedfishErrorError) {
+	s.Error = val
+}
+
+type BmcTaskHostsItemRedfishErrorError struct {
+	MessageDotExtendedInfo []BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
+	Code                   OptString                                                     `json:"code"`
+	Message                OptString                                                     `json:"message"`
+}
+
+// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
+func (s *BmcTaskHostsItemRedfishErrorError) GetMessageDotExtendedInfo() []BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem {
+	return s.MessageDotExtendedInfo
+}
+
+// GetCode returns the value of Code.
+func (s *BmcTaskHostsItemRedfishErrorError) GetCode() OptString {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *BmcTaskHostsItemRedfishErrorError) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
+func (s *BmcTaskHostsItemRedfishErrorError) SetMessageDotExtendedInfo(val []BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) {
+	s.MessageDotExtendedInfo = val
+}
+
+// SetCode sets the value of Code.
+func (s *BmcTaskHostsItemRedfishErrorError) SetCode(val OptString) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *BmcTaskHostsItemRedfishErrorError) SetMessage(val OptString) {
+	s.Message = val
+}
+
+type BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem struct {
+	Message                           OptString `json:"Message"`
+	MessageArgsDotOdataDotCount       OptInt    `json:"MessageArgs.@odata.count"`
+	MessageId                         OptString `json:"MessageId"`
+	RelatedPropertiesDotOdataDotCount OptInt    `json:"RelatedProperties.@odata.count"`
+	Resolution                        OptString `json:"Resolution"`
+	Severity                          OptString `json:"Severity"`
+}
+
+// GetMessage returns the value of Message.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessage() OptString {
+	return s.Message
+}
+
+// GetMessageArgsDotOdataDotCount returns the value of MessageArgsDotOdataDotCount.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageArgsDotOdataDotCount() OptInt {
+	return s.MessageArgsDotOdataDotCount
+}
+
+// GetMessageId returns the value of MessageId.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageId() OptString {
+	return s.MessageId
+}
+
+// GetRelatedPropertiesDotOdataDotCount returns the value of RelatedPropertiesDotOdataDotCount.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetRelatedPropertiesDotOdataDotCount() OptInt {
+	return s.RelatedPropertiesDotOdataDotCount
+}
+
+// GetResolution returns the value of Resolution.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetResolution() OptString {
+	return s.Resolution
+}
+
+// GetSeverity returns the value of Severity.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) GetSeverity() OptString {
+	return s.Severity
+}
+
+// SetMessage sets the value of Message.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetMessageArgsDotOdataDotCount sets the value of MessageArgsDotOdataDotCount.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageArgsDotOdataDotCount(val OptInt) {
+	s.MessageArgsDotOdataDotCount = val
+}
+
+// SetMessageId sets the value of MessageId.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageId(val OptString) {
+	s.MessageId = val
+}
+
+// SetRelatedPropertiesDotOdataDotCount sets the value of RelatedPropertiesDotOdataDotCount.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetRelatedPropertiesDotOdataDotCount(val OptInt) {
+	s.RelatedPropertiesDotOdataDotCount = val
+}
+
+// SetResolution sets the value of Resolution.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetResolution(val OptString) {
+	s.Resolution = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem) SetSeverity(val OptString) {
+	s.Severity = val
+}
+
+// BootImage schema.
+// Ref: #/components/schemas/BootImage
+type BootImage struct {
+	Cmdline            OptString                         `json:"cmdline"`
+	ID                 OptNilInt64                       `json:"id"`
+	Initrd             []string                          `json:"initrd"`
+	InitrdSHA256       []string                          `json:"initrd_sha256"`
+	Kernel             string                            `json:"kernel"`
+	KernelSHA256       OptString                         `json:"kernel_sha256"`
+	Liveimg            OptString                         `json:"liveimg"`
+	LiveimgSHA256      OptString                         `json:"liveimg_sha256"`
+	Name               string                            `json:"name"`
+	ProvisionTemplates OptNilBootImageProvisionTemplates `json:"provision_templates"`
+	UID                OptNilString                      `json:"uid"`
+	Verify             OptBool                           `json:"verify"`
+	Version            OptInt                            `json:"version"`
 }
 
 // GetCmdline returns the value of Cmdline.
-func (s *BootImageAddRequestBootImagesItem) GetCmdline() OptString {
+func (s *BootImage) GetCmdline() OptString {
 	return s.Cmdline
 }
 
 // GetID returns the value of ID.
-func (s *BootImageAddRequestBootImagesItem) GetID() OptNilInt64 {
+func (s *BootImage) GetID() OptNilInt64 {
 	return s.ID
 }
 
 // GetInitrd returns the value of Initrd.
-func (s *BootImageAddRequestBootImagesItem) GetInitrd() []string {
+func (s *BootImage) GetInitrd() []string {
 	return s.Initrd
 }
 
+// GetInitrdSHA256 returns the value of InitrdSHA256.
+func (s *BootImage) GetInitrdSHA256() []string {
+	return s.InitrdSHA256
+}
+
 // GetKernel returns the value of Kernel.
-func (s *BootImageAddRequestBootImagesItem) GetKernel() OptString {
+func (s *BootImage) GetKernel() string {
 	return s.Kernel
 }
 
+// GetKernelSHA256 returns the value of KernelSHA256.
+func (s *BootImage) GetKernelSHA256() OptString {
+	return s.KernelSHA256
+}
+
 // GetLiveimg returns the value of Liveimg.
-func (s *BootImageAddRequestBootImagesItem) GetLiveimg() OptString {
+func (s *BootImage) GetLiveimg() OptString {
 	return s.Liveimg
 }
 
+// GetLiveimgSHA256 returns the value of LiveimgSHA256.
+func (s *BootImage) GetLiveimgSHA256() OptString {
+	return s.LiveimgSHA256
+}
+
 // GetName returns the value of Name.
-func (s *BootImageAddRequestBootImagesItem) GetName() OptString {
+func (s *BootImage) GetName() string {
 	return s.Name
 }
 
 // GetProvisionTemplates returns the value of ProvisionTemplates.
-func (s *BootImageAddRequestBootImagesItem) GetProvisionTemplates() OptNilBootImageAddRequestBootImagesItemProvisionTemplates {
+func (s *BootImage) GetProvisionTemplates() OptNilBootImageProvisionTemplates {
 	return s.ProvisionTemplates
 }
 
 // GetUID returns the value of UID.
-func (s *BootImageAddRequestBootImagesItem) GetUID() OptNilString {
+func (s *BootImage) GetUID() OptNilString {
 	return s.UID
 }
 
 // GetVerify returns the value of Verify.
-func (s *BootImageAddRequestBootImagesItem) GetVerify() OptBool {
+func (s *BootImage) GetVerify() OptBool {
 	return s.Verify
 }
 
+// GetVersion returns the value of Version.
+func (s *BootImage) GetVersion() OptInt {
+	return s.Version
+}
+
 // SetCmdline sets the value of Cmdline.
-func (s *BootImageAddRequestBootImagesItem) SetCmdline(val OptString) {
+func (s *BootImage) SetCmdline(val OptString) {
 	s.Cmdline = val
 }
 
 // SetID sets the value of ID.
-func (s *BootImageAddRequestBootImagesItem) SetID(val OptNilInt64) {
+func (s *BootImage) SetID(val OptNilInt64) {
 	s.ID = val
 }
 
 // SetInitrd sets the value of Initrd.
-func (s *BootImageAddRequestBootImagesItem) SetInitrd(val []string) {
+func (s *BootImage) SetInitrd(val []string) {
 	s.Initrd = val
 }
 
+// SetInitrdSHA256 sets the value of InitrdSHA256.
+func (s *BootImage) SetInitrdSHA256(val []string) {
+	s.InitrdSHA256 = val
+}
+
 // SetKernel sets the value of Kernel.
-func (s *BootImageAddRequestBootImagesItem) SetKernel(val OptString) {
+func (s *BootImage) SetKernel(val string) {
 	s.Kernel = val
 }
 
+// SetKernelSHA256 sets the value of KernelSHA256.
+func (s *BootImage) SetKernelSHA256(val OptString) {
+	s.KernelSHA256 = val
+}
+
 // SetLiveimg sets the value of Liveimg.
-func (s *BootImageAddRequestBootImagesItem) SetLiveimg(val OptString) {
+func (s *BootImage) SetLiveimg(val OptString) {
 	s.Liveimg = val
 }
 
+// SetLiveimgSHA256 sets the value of LiveimgSHA256.
+func (s *BootImage) SetLiveimgSHA256(val OptString) {
+	s.LiveimgSHA256 = val
+}
+
 // SetName sets the value of Name.
-func (s *BootImageAddRequestBootImagesItem) SetName(val OptString) {
+func (s *BootImage) SetName(val string) {
 	s.Name = val
 }
 
 // SetProvisionTemplates sets the value of ProvisionTemplates.
-func (s *BootImageAddRequestBootImagesItem) SetProvisionTemplates(val OptNilBootImageAddRequestBootImagesItemProvisionTemplates) {
+func (s *BootImage) SetProvisionTemplates(val OptNilBootImageProvisionTemplates) {
 	s.ProvisionTemplates = val
 }
 
 // SetUID sets the value of UID.
-func (s *BootImageAddRequestBootImagesItem) SetUID(val OptNilString) {
+func (s *BootImage) SetUID(val OptNilString) {
 	s.UID = val
 }
 
 // SetVerify sets the value of Verify.
-func (s *BootImageAddRequestBootImagesItem) SetVerify(val OptBool) {
+func (s *BootImage) SetVerify(val OptBool) {
 	s.Verify = val
 }
 
-type BootImageAddRequestBootImagesItemProvisionTemplates map[string]NilString
-
-func (s *BootImageAddRequestBootImagesItemProvisionTemplates) init() BootImageAddRequestBootImagesItemProvisionTemplates {
-	m := *s
-	if m == nil {
-		m = map[string]NilString{}
-		*s = m
-	}
-	return m
+// SetVersion sets the value of Version.
+func (s *BootImage) SetVersion(val OptInt) {
+	s.Version = val
 }
 
-type BootImageProvisionTemplates map[string]NilString
-
-func (s *BootImageProvisionTemplates) init() BootImageProvisionTemplates {
-	m := *s
-	if m == nil {
-		m = map[string]NilString{}
-		*s = m
-	}
-	return m
+// BootImageAddRequest schema.
+// Ref: #/components/schemas/BootImageAddRequest
+type BootImageAddRequest struct {
+	BootImages []NilBootImageAddRequestBootImagesItem `json:"boot_images"`
 }
 
-type CookieAuth struct {
-	Token string
+// GetBootImages returns the value of BootImages.
+func (s *BootImageAddRequest) GetBootImages() []NilBootImageAddRequestBootImagesItem {
+	return s.BootImages
 }
 
-// GetToken returns the value of Token.
-func (s *CookieAuth) GetToken() string {
-	return s.Token
+// SetBootImages sets the value of BootImages.
+func (s *BootImageAddRequest) SetBootImages(val []NilBootImageAddRequestBootImagesItem) {
+	s.BootImages = val
 }
 
-// SetToken sets the value of Token.
-func (s *CookieAuth) SetToken(val string) {
-	s.Token = val
-}
-
-// DataDump schema.
-// Ref: #/components/schemas/DataDump
-type DataDump struct {
-	Hosts  []NilDataDumpHostsItem  `json:"Hosts"`
-	Images []NilDataDumpImagesItem `json:"Images"`
-	Users  []DataDumpUsersItem     `json:"Users"`
+type BootImageAddRequestBootImagesItem struct {
+	Cmdline            OptString                                                 `json:"cmdline"`
+	ID                 OptNilInt64                                               `json:"id"`
+	Initrd             []string                                                  `json:"initrd"`
+	InitrdSHA256       []string                                                  `json:"initrd_sha256"`
+	Kernel             OptString                                                 `json:"kernel"`
+	KernelSHA256       OptString                                                 `json:"kernel_sha256"`
+	Liveimg            OptString                                                 `json:"liveimg"`
+	LiveimgSHA256      OptString                                                 `json:"liveimg_sha256"`
+	Name               OptString                                                 `json:"name"`
+	ProvisionTemplates OptNilBootImageAddRequestBootImagesItemProvisionTemplates `json:"provision_templates"`
+	UID                OptNilString                                              `json:"uid"`
+	Verify             OptBool                                                   `json:"verify"`
+	Version            OptInt                                                    `json:"version"`
 }
 
-// GetHosts returns the value of Hosts.
-func (s *DataDump) GetHosts() []NilDataDumpHostsItem {
-	return s.Hosts
+// GetCmdline returns the value of Cmdline.
+func (s *BootImageAddRequestBootImagesItem) GetCmdline() OptString {
+	return s.Cmdline
 }
 
-// GetImages returns the value of Images.
-func (s *DataDump) GetImages() []NilDataDumpImagesItem {
-	return s.Images
+// GetID returns the value of ID.
+func (s *BootImageAddRequestBootImagesItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// GetUsers returns the value of Users.
-func (s *DataDump) GetUsers() []DataDumpUsersItem {
-	return s.Users
+// GetInitrd returns the value of Initrd.
+func (s *BootImageAddRequestBootImagesItem) GetInitrd() []string {
+	return s.Initrd
 }
 
-// SetHosts sets the value of Hosts.
-func (s *DataDump) SetHosts(val []NilDataDumpHostsItem) {
-	s.Hosts = val
+// GetInitrdSHA256 returns the value of InitrdSHA256.
+func (s *BootImageAddRequestBootImagesItem) GetInitrdSHA256() []string {
+	return s.InitrdSHA256
 }
 
-// SetImages sets the value of Images.
-func (s *DataDump) SetImages(val []NilDataDumpImagesItem) {
-	s.Images = val
+// GetKernel returns the value of Kernel.
+func (s *BootImageAddRequestBootImagesItem) GetKernel() OptString {
+	return s.Kernel
 }
 
-// SetUsers sets the value of Users.
-func (s *DataDump) SetUsers(val []DataDumpUsersItem) {
-	s.Users = val
+// GetKernelSHA256 returns the value of KernelSHA256.
+func (s *BootImageAddRequestBootImagesItem) GetKernelSHA256() OptString {
+	return s.KernelSHA256
 }
 
-type DataDumpHostsItem struct {
-	Bonds      []NilDataDumpHostsItemBondsItem      `json:"bonds"`
-	BootImage  OptString                            `json:"boot_image"`
-	Firmware   OptString                            `json:"firmware"`
-	ID         OptNilInt64                          `json:"id"`
-	Interfaces []NilDataDumpHostsItemInterfacesItem `json:"interfaces"`
-	Name       OptString                            `json:"name"`
-	Provision  OptBool                              `json:"provision"`
-	Tags       OptNilStringArray                    `json:"tags"`
-	UID        OptNilString                         `json:"uid"`
+// GetLiveimg returns the value of Liveimg.
+func (s *BootImageAddRequestBootImagesItem) GetLiveimg() OptString {
+	return s.Liveimg
 }
 
-// GetBonds returns the value of Bonds.
-func (s *DataDumpHostsItem) GetBonds() []NilDataDumpHostsItemBondsItem {
-	return s.Bonds
+// GetLiveimgSHA256 returns the value of LiveimgSHA256.
+func (s *BootImageAddRequestBootImagesItem) GetLiveimgSHA256() OptString {
+	return s.LiveimgSHA256
 }
 
-// GetBootImage returns the value of BootImage.
-func (s *DataDumpHostsItem) GetBootImage() OptString {
-	return s.BootImage
+// GetName returns the value of Name.
+func (s *BootImageAddRequestBootImagesItem) GetName() OptString {
+	return s.Name
 }
 
-// GetFirmware returns the value of Firmware.
-func (s *DataDumpHostsItem) GetFirmware() OptString {
-	return s.Firmware
+// GetProvisionTemplates returns the value of ProvisionTemplates.
+func (s *BootImageAddRequestBootImagesItem) GetProvisionTemplates() OptNilBootImageAddRequestBootImagesItemProvisionTemplates {
+	return s.ProvisionTemplates
 }
 
-// GetID returns the value of ID.
-func (s *DataDumpHostsItem) GetID() OptNilInt64 {
-	return s.ID
+// GetUID returns the value of UID.
+func (s *BootImageAddRequestBootImagesItem) GetUID() OptNilString {
+	return s.UID
 }
 
-// GetInterfaces returns the value of Interfaces.
-func (s *DataDumpHostsItem) GetInterfaces() []NilDataDumpHostsItemInterfacesItem {
-	return s.Interfaces
+// GetVerify returns the value of Verify.
+func (s *BootImageAddRequestBootImagesItem) GetVerify() OptBool {
+	return s.Verify
 }
 
-// GetName returns the value of Name.
-func (s *DataDumpHostsItem) GetName() OptString {
-	return s.Name
+// GetVersion returns the value of Version.
+func (s *BootImageAddRequestBootImagesItem) GetVersion() OptInt {
+	return s.Version
 }
 
-// GetProvision returns the value of Provision.
-func (s *DataDumpHostsItem) GetProvision() OptBool {
-	return s.Provision
+// SetCmdline sets the value of Cmdline.
+func (s *BootImageAddRequestBootImagesItem) SetCmdline(val OptString) {
+	s.Cmdline = val
 }
 
-// GetTags returns the value of Tags.
-func (s *DataDumpHostsItem) GetTags() OptNilStringArray {
-	return s.Tags
+// SetID sets the value of ID.
+func (s *BootImageAddRequestBootImagesItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// GetUID returns the value of UID.
-func (s *DataDumpHostsItem) GetUID() OptNilString {
-	return s.UID
+// SetInitrd sets the value of Initrd.
+func (s *BootImageAddRequestBootImagesItem) SetInitrd(val []string) {
+	s.Initrd = val
 }
 
-// SetBonds sets the value of Bonds.
-func (s *DataDumpHostsItem) SetBonds(val []NilDataDumpHostsItemBondsItem) {
-	s.Bonds = val
+// SetInitrdSHA256 sets the value of InitrdSHA256.
+func (s *BootImageAddRequestBootImagesItem) SetInitrdSHA256(val []string) {
+	s.InitrdSHA256 = val
 }
 
-// SetBootImage sets the value of BootImage.
-func (s *DataDumpHostsItem) SetBootImage(val OptString) {
-	s.BootImage = val
+// SetKernel sets the value of Kernel.
+func (s *BootImageAddRequestBootImagesItem) SetKernel(val OptString) {
+	s.Kernel = val
 }
 
-// SetFirmware sets the value of Firmware.
-func (s *DataDumpHostsItem) SetFirmware(val OptString) {
-	s.Firmware = val
+// SetKernelSHA256 sets the value of KernelSHA256.
+func (s *BootImageAddRequestBootImagesItem) SetKernelSHA256(val OptString) {
+	s.KernelSHA256 = val
 }
 
-// SetID sets the value of ID.
-func (s *DataDumpHostsItem) SetID(val OptNilInt64) {
-	s.ID = val
+// SetLiveimg sets the value of Liveimg.
+func (s *BootImageAddRequestBootImagesItem) SetLiveimg(val OptString) {
+	s.Liveimg = val
 }
 
-// SetInterfaces sets the value of Interfaces.
-func (s *DataDumpHostsItem) SetInterfaces(val []NilDataDumpHostsItemInterfacesItem) {
-	s.Interfaces = val
+// SetLiveimgSHA256 sets the value of LiveimgSHA256.
+func (s *BootImageAddRequestBootImagesItem) SetLiveimgSHA256(val OptString) {
+	s.LiveimgSHA256 = val
 }
 
 // SetName sets the value of Name.
-func (s *DataDumpHostsItem) SetName(val OptString) {
+func (s *BootImageAddRequestBootImagesItem) SetName(val OptString) {
 	s.Name = val
 }
 
-// SetProvision sets the value of Provision.
-func (s *DataDumpHostsItem) SetProvision(val OptBool) {
-	s.Provision = val
-}
-
-// SetTags sets the value of Tags.
-func (s *DataDumpHostsItem) SetTags(val OptNilStringArray) {
-	s.Tags = val
+// SetProvisionTemplates sets the value of ProvisionTemplates.
+func (s *BootImageAddRequestBootImagesItem) SetProvisionTemplates(val OptNilBootImageAddRequestBootImagesItemProvisionTemplates) {
+	s.ProvisionTemplates = val
 }
 
 // SetUID sets the value of UID.
-func (s *DataDumpHostsItem) SetUID(val OptNilString) {
+func (s *BootImageAddRequestBootImagesItem) SetUID(val OptNilString) {
 	s.UID = val
 }
 
-type DataDumpHostsItemBondsItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Peers  []string    `json:"peers"`
-	Vlan   OptString   `json:"vlan"`
+// SetVerify sets the value of Verify.
+func (s *BootImageAddRequestBootImagesItem) SetVerify(val OptBool) {
+	s.Verify = val
 }
 
-// GetBmc returns the value of Bmc.
-func (s *DataDumpHostsItemBondsItem) GetBmc() OptBool {
-	return s.Bmc
+// SetVersion sets the value of Version.
+func (s *BootImageAddRequestBootImagesItem) SetVersion(val OptInt) {
+	s.Version = val
 }
 
-// GetFqdn returns the value of Fqdn.
-func (s *DataDumpHostsItemBondsItem) GetFqdn() OptString {
-	return s.Fqdn
-}
+type BootImageAddRequestBootImagesItemProvisionTemplates map[string]NilString
 
-// GetID returns the value of ID.
-func (s *DataDumpHostsItemBondsItem) GetID() OptNilInt64 {
-	return s.ID
+func (s *BootImageAddRequestBootImagesItemProvisionTemplates) init() BootImageAddRequestBootImagesItemProvisionTemplates {
+	m := *s
+	if m == nil {
+		m = map[string]NilString{}
+		*s = m
+	}
+	return m
 }
 
-// GetIfname returns the value of Ifname.
-func (s *DataDumpHostsItemBondsItem) GetIfname() OptString {
-	return s.Ifname
+// BootImageCurrentRequest schema.
+// Ref: #/components/schemas/BootImageCurrentRequest
+type BootImageCurrentRequest struct {
+	Name    OptString `json:"name"`
+	Version OptInt    `json:"version"`
 }
 
-// GetIP returns the value of IP.
-func (s *DataDumpHostsItemBondsItem) GetIP() OptString {
-	return s.IP
+// GetName returns the value of Name.
+func (s *BootImageCurrentRequest) GetName() OptString {
+	return s.Name
 }
 
-// GetMAC returns the value of MAC.
-func (s *DataDumpHostsItemBondsItem) GetMAC() OptString {
-	return s.MAC
+// GetVersion returns the value of Version.
+func (s *BootImageCurrentRequest) GetVersion() OptInt {
+	return s.Version
 }
 
-// GetMtu returns the value of Mtu.
-func (s *DataDumpHostsItemBondsItem) GetMtu() OptInt {
-	return s.Mtu
+// SetName sets the value of Name.
+func (s *BootImageCurrentRequest) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetPeers returns the value of Peers.
-func (s *DataDumpHostsItemBondsItem) GetPeers() []string {
-	return s.Peers
+// SetVersion sets the value of Version.
+func (s *BootImageCurrentRequest) SetVersion(val OptInt) {
+	s.Version = val
 }
 
-// GetVlan returns the value of Vlan.
-func (s *DataDumpHostsItemBondsItem) GetVlan() OptString {
-	return s.Vlan
-}
+type BootImageProvisionTemplates map[string]NilString
 
-// SetBmc sets the value of Bmc.
-func (s *DataDumpHostsItemBondsItem) SetBmc(val OptBool) {
-	s.Bmc = val
+func (s *BootImageProvisionTemplates) init() BootImageProvisionTemplates {
+	m := *s
+	if m == nil {
+		m = map[string]NilString{}
+		*s = m
+	}
+	return m
 }
 
-// SetFqdn sets the value of Fqdn.
-func (s *DataDumpHostsItemBondsItem) SetFqdn(val OptString) {
-	s.Fqdn = val
+// BootImageVersion schema.
+// Ref: #/components/schemas/BootImageVersion
+type BootImageVersion struct {
+	Current OptBool                     `json:"current"`
+	Image   OptNilBootImageVersionImage `json:"image"`
+	Time    OptDateTime                 `json:"time"`
+	Version OptInt                      `json:"version"`
 }
 
-// SetID sets the value of ID.
-func (s *DataDumpHostsItemBondsItem) SetID(val OptNilInt64) {
-	s.ID = val
+// GetCurrent returns the value of Current.
+func (s *BootImageVersion) GetCurrent() OptBool {
+	return s.Current
 }
 
-// SetIfname sets the value of Ifname.
-func (s *DataDumpHostsItemBondsItem) SetIfname(val OptString) {
-	s.Ifname = val
+// GetImage returns the value of Image.
+func (s *BootImageVersion) GetImage() OptNilBootImageVersionImage {
+	return s.Image
 }
 
-// SetIP sets the value of IP.
-func (s *DataDumpHostsItemBondsItem) SetIP(val OptString) {
-	s.IP = val
+// GetTime returns the value of Time.
+func (s *BootImageVersion) GetTime() OptDateTime {
+	return s.Time
 }
 
-// SetMAC sets the value of MAC.
-func (s *DataDumpHostsItemBondsItem) SetMAC(val OptString) {
-	s.MAC = val
+// GetVersion returns the value of Version.
+func (s *BootImageVersion) GetVersion() OptInt {
+	return s.Version
 }
 
-// SetMtu sets the value of Mtu.
-func (s *DataDumpHostsItemBondsItem) SetMtu(val OptInt) {
-	s.Mtu = val
+// SetCurrent sets the value of Current.
+func (s *BootImageVersion) SetCurrent(val OptBool) {
+	s.Current = val
 }
 
-// SetPeers sets the value of Peers.
-func (s *DataDumpHostsItemBondsItem) SetPeers(val []string) {
-	s.Peers = val
+// SetImage sets the value of Image.
+func (s *BootImageVersion) SetImage(val OptNilBootImageVersionImage) {
+	s.Image = val
 }
 
-// SetVlan sets the value of Vlan.
-func (s *DataDumpHostsItemBondsItem) SetVlan(val OptString) {
-	s.Vlan = val
+// SetTime sets the value of Time.
+func (s *BootImageVersion) SetTime(val OptDateTime) {
+	s.Time = val
 }
 
-type DataDumpHostsItemInterfacesItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Vlan   OptString   `json:"vlan"`
+// SetVersion sets the value of Version.
+func (s *BootImageVersion) SetVersion(val OptInt) {
+	s.Version = val
 }
 
-// GetBmc returns the value of Bmc.
-func (s *DataDumpHostsItemInterfacesItem) GetBmc() OptBool {
-	return s.Bmc
+type BootImageVersionImage struct {
+	Cmdline            OptString                                     `json:"cmdline"`
+	ID                 OptNilInt64                                   `json:"id"`
+	Initrd             []string                                      `json:"initrd"`
+	InitrdSHA256       []string                                      `json:"initrd_sha256"`
+	Kernel             OptString                                     `json:"kernel"`
+	KernelSHA256       OptString                                     `json:"kernel_sha256"`
+	Liveimg            OptString                                     `json:"liveimg"`
+	LiveimgSHA256      OptString                                     `json:"liveimg_sha256"`
+	Name               OptString                                     `json:"name"`
+	ProvisionTemplates OptNilBootImageVersionImageProvisionTemplates `json:"provision_templates"`
+	UID                OptNilString                                  `json:"uid"`
+	Verify             OptBool                                       `json:"verify"`
+	Version            OptInt                                        `json:"version"`
 }
 
-// GetFqdn returns the value of Fqdn.
-func (s *DataDumpHostsItemInterfacesItem) GetFqdn() OptString {
-	return s.Fqdn
+// GetCmdline returns the value of Cmdline.
+func (s *BootImageVersionImage) GetCmdline() OptString {
+	return s.Cmdline
 }
 
 // GetID returns the value of ID.
-func (s *DataDumpHostsItemInterfacesItem) GetID() OptNilInt64 {
+func (s *BootImageVersionImage) GetID() OptNilInt64 {
 	return s.ID
 }
 
-// GetIfname returns the value of Ifname.
-func (s *DataDumpHostsItemInterfacesItem) GetIfname() OptString {
-	return s.Ifname
+// GetInitrd returns the value of Initrd.
+func (s *BootImageVersionImage) GetInitrd() []string {
+	return s.Initrd
 }
 
-// GetIP returns the value of IP.
-func (s *DataDumpHostsItemInterfacesItem) GetIP() OptString {
-	return s.IP
+// GetInitrdSHA256 returns the value of InitrdSHA256.
+func (s *BootImageVersionImage) GetInitrdSHA256() []string {
+	return s.InitrdSHA256
 }
 
-// GetMAC returns the value of MAC.
-func (s *DataDumpHostsItemInterfacesItem) GetMAC() OptString {
-	return s.MAC
+// GetKernel returns the value of Kernel.
+func (s *BootImageVersionImage) GetKernel() OptString {
+	return s.Kernel
 }
 
-// GetMtu returns the value of Mtu.
-func (s *DataDumpHostsItemInterfacesItem) GetMtu() OptInt {
-	return s.Mtu
-}
-
-// GetVlan returns the value of Vlan.
-func (s *DataDumpHostsItemInterfacesItem) GetVlan() OptString {
-	return s.Vlan
-}
-
-// SetBmc sets the value of Bmc.
-func (s *DataDumpHostsItemInterfacesItem) SetBmc(val OptBool) {
-	s.Bmc = val
-}
-
-// SetFqdn sets the value of Fqdn.
-func (s *DataDumpHostsItemInterfacesItem) SetFqdn(val OptString) {
-	s.Fqdn = val
-}
-
-// SetID sets the value of ID.
-func (s *DataDumpHostsItemInterfacesItem) SetID(val OptNilInt64) {
-	s.ID = val
-}
-
-// SetIfname sets the value of Ifname.
-func (s *DataDumpHostsItemInterfacesItem) SetIfname(val OptString) {
-	s.Ifname = val
-}
-
-// SetIP sets the value of IP.
-func (s *DataDumpHostsItemInterfacesItem) SetIP(val OptString) {
-	s.IP = val
-}
-
-// SetMAC sets the value of MAC.
-func (s *DataDumpHostsItemInterfacesItem) SetMAC(val OptString) {
-	s.MAC = val
-}
-
-// SetMtu sets the value of Mtu.
-func (s *DataDumpHostsItemInterfacesItem) SetMtu(val OptInt) {
-	s.Mtu = val
-}
-
-// SetVlan sets the value of Vlan.
-func (s *DataDumpHostsItemInterfacesItem) SetVlan(val OptString) {
-	s.Vlan = val
-}
-
-type DataDumpImagesItem struct {
-	Cmdline            OptString                                  `json:"cmdline"`
-	ID                 OptNilInt64                                `json:"id"`
-	Initrd             []string                                   `json:"initrd"`
-	Kernel             OptString                                  `json:"kernel"`
-	Liveimg            OptString                                  `json:"liveimg"`
-	Name               OptString                                  `json:"name"`
-	ProvisionTemplates OptNilDataDumpImagesItemProvisionTemplates `json:"provision_templates"`
-	UID                OptNilString                               `json:"uid"`
-	Verify             OptBool                                    `json:"verify"`
-}
-
-// GetCmdline returns the value of Cmdline.
-func (s *DataDumpImagesItem) GetCmdline() OptString {
-	return s.Cmdline
-}
-
-// GetID returns the value of ID.
-func (s *DataDumpImagesItem) GetID() OptNilInt64 {
-	return s.ID
-}
-
-// GetInitrd returns the value of Initrd.
-func (s *DataDumpImagesItem) GetInitrd() []string {
-	return s.Initrd
-}
-
-// GetKernel returns the value of Kernel.
-func (s *DataDumpImagesItem) GetKernel() OptString {
-	return s.Kernel
+// GetKernelSHA256 returns the value of KernelSHA256.
+func (s *BootImageVersionImage) GetKernelSHA256() OptString {
+	return s.KernelSHA256
 }
 
 // GetLiveimg returns the value of Liveimg.
-func (s *DataDumpImagesItem) GetLiveimg() OptString {
+func (s *BootImageVersionImage) GetLiveimg() OptString {
 	return s.Liveimg
 }
 
+// GetLiveimgSHA256 returns the value of LiveimgSHA256.
+func (s *BootImageVersionImage) GetLiveimgSHA256() OptString {
+	return s.LiveimgSHA256
+}
+
 // GetName returns the value of Name.
-func (s *DataDumpImagesItem) GetName() OptString {
+func (s *BootImageVersionImage) GetName() OptString {
 	return s.Name
 }
 
 // GetProvisionTemplates returns the value of ProvisionTemplates.
-func (s *DataDumpImagesItem) GetProvisionTemplates() OptNilDataDumpImagesItemProvisionTemplates {
+func (s *BootImageVersionImage) GetProvisionTemplates() OptNilBootImageVersionImageProvisionTemplates {
 	return s.ProvisionTemplates
 }
 
 // GetUID returns the value of UID.
-func (s *DataDumpImagesItem) GetUID() OptNilString {
+func (s *BootImageVersionImage) GetUID() OptNilString {
 	return s.UID
 }
 
 // GetVerify returns the value of Verify.
-func (s *DataDumpImagesItem) GetVerify() OptBool {
+func (s *BootImageVersionImage) GetVerify() OptBool {
 	return s.Verify
 }
 
+// GetVersion returns the value of Version.
+func (s *BootImageVersionImage) GetVersion() OptInt {
+	return s.Version
+}
+
 // SetCmdline sets the value of Cmdline.
-func (s *DataDumpImagesItem) SetCmdline(val OptString) {
+func (s *BootImageVersionImage) SetCmdline(val OptString) {
 	s.Cmdline = val
 }
 
 // SetID sets the value of ID.
-func (s *DataDumpImagesItem) SetID(val OptNilInt64) {
+func (s *BootImageVersionImage) SetID(val OptNilInt64) {
 	s.ID = val
 }
 
 // SetInitrd sets the value of Initrd.
-func (s *DataDumpImagesItem) SetInitrd(val []string) {
+func (s *BootImageVersionImage) SetInitrd(val []string) {
 	s.Initrd = val
 }
 
+// SetInitrdSHA256 sets the value of InitrdSHA256.
+func (s *BootImageVersionImage) SetInitrdSHA256(val []string) {
+	s.InitrdSHA256 = val
+}
+
 // SetKernel sets the value of Kernel.
-func (s *DataDumpImagesItem) SetKernel(val OptString) {
+func (s *BootImageVersionImage) SetKernel(val OptString) {
 	s.Kernel = val
 }
 
+// SetKernelSHA256 sets the value of KernelSHA256.
+func (s *BootImageVersionImage) SetKernelSHA256(val OptString) {
+	s.KernelSHA256 = val
+}
+
 // SetLiveimg sets the value of Liveimg.
-func (s *DataDumpImagesItem) SetLiveimg(val OptString) {
+func (s *BootImageVersionImage) SetLiveimg(val OptString) {
 	s.Liveimg = val
 }
 
+// SetLiveimgSHA256 sets the value of LiveimgSHA256.
+func (s *BootImageVersionImage) SetLiveimgSHA256(val OptString) {
+	s.LiveimgSHA256 = val
+}
+
 // SetName sets the value of Name.
-func (s *DataDumpImagesItem) SetName(val OptString) {
+func (s *BootImageVersionImage) SetName(val OptString) {
 	s.Name = val
 }
 
 // SetProvisionTemplates sets the value of ProvisionTemplates.
-func (s *DataDumpImagesItem) SetProvisionTemplates(val OptNilDataDumpImagesItemProvisionTemplates) {
+func (s *BootImageVersionImage) SetProvisionTemplates(val OptNilBootImageVersionImageProvisionTemplates) {
 	s.ProvisionTemplates = val
 }
 
 // SetUID sets the value of UID.
-func (s *DataDumpImagesItem) SetUID(val OptNilString) {
+func (s *BootImageVersionImage) SetUID(val OptNilString) {
 	s.UID = val
 }
 
 // SetVerify sets the value of Verify.
-func (s *DataDumpImagesItem) SetVerify(val OptBool) {
+func (s *BootImageVersionImage) SetVerify(val OptBool) {
 	s.Verify = val
 }
 
-type DataDumpImagesItemProvisionTemplates map[string]NilString
+// SetVersion sets the value of Version.
+func (s *BootImageVersionImage) SetVersion(val OptInt) {
+	s.Version = val
+}
 
-func (s *DataDumpImagesItemProvisionTemplates) init() DataDumpImagesItemProvisionTemplates {
+type BootImageVersionImageProvisionTemplates map[string]NilString
+
+func (s *BootImageVersionImageProvisionTemplates) init() BootImageVersionImageProvisionTemplates {
 	m := *s
 	if m == nil {
 		m = map[string]NilString{}
@@ -1094,1055 +1585,1140 @@ func (s *DataDumpImagesItemProvisionTemplates) init() DataDumpImagesItemProvisio
 	return m
 }
 
-type DataDumpUsersItem struct {
-	CreatedAt  OptDateTime `json:"created_at"`
-	Enabled    OptBool     `json:"enabled"`
-	Hash       OptString   `json:"hash"`
-	ID         OptInt64    `json:"id"`
-	ModifiedAt OptDateTime `json:"modified_at"`
-	Role       OptString   `json:"role"`
-	Username   OptString   `json:"username"`
-}
-
-// GetCreatedAt returns the value of CreatedAt.
-func (s *DataDumpUsersItem) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// CablingReport schema.
+// Ref: #/components/schemas/CablingReport
+type CablingReport struct {
+	Failed []string                    `json:"failed"`
+	Links  []NilCablingReportLinksItem `json:"links"`
 }
 
-// GetEnabled returns the value of Enabled.
-func (s *DataDumpUsersItem) GetEnabled() OptBool {
-	return s.Enabled
+// GetFailed returns the value of Failed.
+func (s *CablingReport) GetFailed() []string {
+	return s.Failed
 }
 
-// GetHash returns the value of Hash.
-func (s *DataDumpUsersItem) GetHash() OptString {
-	return s.Hash
+// GetLinks returns the value of Links.
+func (s *CablingReport) GetLinks() []NilCablingReportLinksItem {
+	return s.Links
 }
 
-// GetID returns the value of ID.
-func (s *DataDumpUsersItem) GetID() OptInt64 {
-	return s.ID
+// SetFailed sets the value of Failed.
+func (s *CablingReport) SetFailed(val []string) {
+	s.Failed = val
 }
 
-// GetModifiedAt returns the value of ModifiedAt.
-func (s *DataDumpUsersItem) GetModifiedAt() OptDateTime {
-	return s.ModifiedAt
+// SetLinks sets the value of Links.
+func (s *CablingReport) SetLinks(val []NilCablingReportLinksItem) {
+	s.Links = val
 }
 
-// GetRole returns the value of Role.
-func (s *DataDumpUsersItem) GetRole() OptString {
-	return s.Role
+type CablingReportLinksItem struct {
+	ActualPort   OptString `json:"actual_port"`
+	ActualSwitch OptString `json:"actual_switch"`
+	Host         OptString `json:"host"`
+	Ifname       OptString `json:"ifname"`
+	MAC          OptString `json:"mac"`
+	Port         OptString `json:"port"`
+	Status       OptString `json:"status"`
+	Switch       OptString `json:"switch"`
 }
 
-// GetUsername returns the value of Username.
-func (s *DataDumpUsersItem) GetUsername() OptString {
-	return s.Username
+// GetActualPort returns the value of ActualPort.
+func (s *CablingReportLinksItem) GetActualPort() OptString {
+	return s.ActualPort
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *DataDumpUsersItem) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// GetActualSwitch returns the value of ActualSwitch.
+func (s *CablingReportLinksItem) GetActualSwitch() OptString {
+	return s.ActualSwitch
 }
 
-// SetEnabled sets the value of Enabled.
-func (s *DataDumpUsersItem) SetEnabled(val OptBool) {
-	s.Enabled = val
+// GetHost returns the value of Host.
+func (s *CablingReportLinksItem) GetHost() OptString {
+	return s.Host
 }
 
-// SetHash sets the value of Hash.
-func (s *DataDumpUsersItem) SetHash(val OptString) {
-	s.Hash = val
+// GetIfname returns the value of Ifname.
+func (s *CablingReportLinksItem) GetIfname() OptString {
+	return s.Ifname
 }
 
-// SetID sets the value of ID.
-func (s *DataDumpUsersItem) SetID(val OptInt64) {
-	s.ID = val
+// GetMAC returns the value of MAC.
+func (s *CablingReportLinksItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// SetModifiedAt sets the value of ModifiedAt.
-func (s *DataDumpUsersItem) SetModifiedAt(val OptDateTime) {
-	s.ModifiedAt = val
+// GetPort returns the value of Port.
+func (s *CablingReportLinksItem) GetPort() OptString {
+	return s.Port
 }
 
-// SetRole sets the value of Role.
-func (s *DataDumpUsersItem) SetRole(val OptString) {
-	s.Role = val
+// GetStatus returns the value of Status.
+func (s *CablingReportLinksItem) GetStatus() OptString {
+	return s.Status
 }
 
-// SetUsername sets the value of Username.
-func (s *DataDumpUsersItem) SetUsername(val OptString) {
-	s.Username = val
+// GetSwitch returns the value of Switch.
+func (s *CablingReportLinksItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// Event schema.
-// Ref: #/components/schemas/Event
-type Event struct {
-	JobMessages []EventJobMessagesItem `json:"JobMessages"`
-	Message     OptString              `json:"Message"`
-	Severity    OptString              `json:"Severity"`
-	Time        OptDateTime            `json:"Time"`
-	User        OptString              `json:"User"`
+// SetActualPort sets the value of ActualPort.
+func (s *CablingReportLinksItem) SetActualPort(val OptString) {
+	s.ActualPort = val
 }
 
-// GetJobMessages returns the value of JobMessages.
-func (s *Event) GetJobMessages() []EventJobMessagesItem {
-	return s.JobMessages
+// SetActualSwitch sets the value of ActualSwitch.
+func (s *CablingReportLinksItem) SetActualSwitch(val OptString) {
+	s.ActualSwitch = val
 }
 
-// GetMessage returns the value of Message.
-func (s *Event) GetMessage() OptString {
-	return s.Message
+// SetHost sets the value of Host.
+func (s *CablingReportLinksItem) SetHost(val OptString) {
+	s.Host = val
 }
 
-// GetSeverity returns the value of Severity.
-func (s *Event) GetSeverity() OptString {
-	return s.Severity
+// SetIfname sets the value of Ifname.
+func (s *CablingReportLinksItem) SetIfname(val OptString) {
+	s.Ifname = val
 }
 
-// GetTime returns the value of Time.
-func (s *Event) GetTime() OptDateTime {
-	return s.Time
+// SetMAC sets the value of MAC.
+func (s *CablingReportLinksItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// GetUser returns the value of User.
-func (s *Event) GetUser() OptString {
-	return s.User
+// SetPort sets the value of Port.
+func (s *CablingReportLinksItem) SetPort(val OptString) {
+	s.Port = val
 }
 
-// SetJobMessages sets the value of JobMessages.
-func (s *Event) SetJobMessages(val []EventJobMessagesItem) {
-	s.JobMessages = val
+// SetStatus sets the value of Status.
+func (s *CablingReportLinksItem) SetStatus(val OptString) {
+	s.Status = val
 }
 
-// SetMessage sets the value of Message.
-func (s *Event) SetMessage(val OptString) {
-	s.Message = val
+// SetSwitch sets the value of Switch.
+func (s *CablingReportLinksItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// SetSeverity sets the value of Severity.
-func (s *Event) SetSeverity(val OptString) {
-	s.Severity = val
+type CookieAuth struct {
+	Token string
 }
 
-// SetTime sets the value of Time.
-func (s *Event) SetTime(val OptDateTime) {
-	s.Time = val
+// GetToken returns the value of Token.
+func (s *CookieAuth) GetToken() string {
+	return s.Token
 }
 
-// SetUser sets the value of User.
-func (s *Event) SetUser(val OptString) {
-	s.User = val
+// SetToken sets the value of Token.
+func (s *CookieAuth) SetToken(val string) {
+	s.Token = val
 }
 
-type EventJobMessagesItem struct {
-	Data         OptString                           `json:"data"`
-	Host         OptString                           `json:"host"`
-	Msg          OptString                           `json:"msg"`
-	RedfishError OptEventJobMessagesItemRedfishError `json:"redfish_error"`
-	Status       OptString                           `json:"status"`
+// DHCPEvent schema.
+// Ref: #/components/schemas/DHCPEvent
+type DHCPEvent struct {
+	Firmware    OptString   `json:"firmware"`
+	Host        OptString   `json:"host"`
+	ID          OptInt64    `json:"id"`
+	IP          OptString   `json:"ip"`
+	MAC         OptString   `json:"mac"`
+	MessageType OptString   `json:"message_type"`
+	RelayIP     OptString   `json:"relay_ip"`
+	Time        OptDateTime `json:"time"`
 }
 
-// GetData returns the value of Data.
-func (s *EventJobMessagesItem) GetData() OptString {
-	return s.Data
+// GetFirmware returns the value of Firmware.
+func (s *DHCPEvent) GetFirmware() OptString {
+	return s.Firmware
 }
 
 // GetHost returns the value of Host.
-func (s *EventJobMessagesItem) GetHost() OptString {
+func (s *DHCPEvent) GetHost() OptString {
 	return s.Host
 }
 
-// GetMsg returns the value of Msg.
-func (s *EventJobMessagesItem) GetMsg() OptString {
-	return s.Msg
+// GetID returns the value of ID.
+func (s *DHCPEvent) GetID() OptInt64 {
+	return s.ID
 }
 
-// GetRedfishError returns the value of RedfishError.
-func (s *EventJobMessagesItem) GetRedfishError() OptEventJobMessagesItemRedfishError {
-	return s.RedfishError
+// GetIP returns the value of IP.
+func (s *DHCPEvent) GetIP() OptString {
+	return s.IP
 }
 
-// GetStatus returns the value of Status.
-func (s *EventJobMessagesItem) GetStatus() OptString {
-	return s.Status
+// GetMAC returns the value of MAC.
+func (s *DHCPEvent) GetMAC() OptString {
+	return s.MAC
 }
 
-// SetData sets the value of Data.
-func (s *EventJobMessagesItem) SetData(val OptString) {
-	s.Data = val
+// GetMessageType returns the value of MessageType.
+func (s *DHCPEvent) GetMessageType() OptString {
+	return s.MessageType
 }
 
-// SetHost sets the value of Host.
-func (s *EventJobMessagesItem) SetHost(val OptString) {
-	s.Host = val
+// GetRelayIP returns the value of RelayIP.
+func (s *DHCPEvent) GetRelayIP() OptString {
+	return s.RelayIP
 }
 
-// SetMsg sets the value of Msg.
-func (s *EventJobMessagesItem) SetMsg(val OptString) {
-	s.Msg = val
+// GetTime returns the value of Time.
+func (s *DHCPEvent) GetTime() OptDateTime {
+	return s.Time
 }
 
-// SetRedfishError sets the value of RedfishError.
-func (s *EventJobMessagesItem) SetRedfishError(val OptEventJobMessagesItemRedfishError) {
-	s.RedfishError = val
+// SetFirmware sets the value of Firmware.
+func (s *DHCPEvent) SetFirmware(val OptString) {
+	s.Firmware = val
 }
 
-// SetStatus sets the value of Status.
-func (s *EventJobMessagesItem) SetStatus(val OptString) {
-	s.Status = val
+// SetHost sets the value of Host.
+func (s *DHCPEvent) SetHost(val OptString) {
+	s.Host = val
 }
 
-type EventJobMessagesItemRedfishError struct {
-	Code  OptString                                `json:"code"`
-	Error OptEventJobMessagesItemRedfishErrorError `json:"error"`
+// SetID sets the value of ID.
+func (s *DHCPEvent) SetID(val OptInt64) {
+	s.ID = val
 }
 
-// GetCode returns the value of Code.
-func (s *EventJobMessagesItemRedfishError) GetCode() OptString {
-	return s.Code
+// SetIP sets the value of IP.
+func (s *DHCPEvent) SetIP(val OptString) {
+	s.IP = val
 }
 
-// GetError returns the value of Error.
-func (s *EventJobMessagesItemRedfishError) GetError() OptEventJobMessagesItemRedfishErrorError {
-	return s.Error
+// SetMAC sets the value of MAC.
+func (s *DHCPEvent) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// SetCode sets the value of Code.
-func (s *EventJobMessagesItemRedfishError) SetCode(val OptString) {
-	s.Code = val
+// SetMessageType sets the value of MessageType.
+func (s *DHCPEvent) SetMessageType(val OptString) {
+	s.MessageType = val
 }
 
-// SetError sets the value of Error.
-func (s *EventJobMessagesItemRedfishError) SetError(val OptEventJobMessagesItemRedfishErrorError) {
-	s.Error = val
+// SetRelayIP sets the value of RelayIP.
+func (s *DHCPEvent) SetRelayIP(val OptString) {
+	s.RelayIP = val
 }
 
-type EventJobMessagesItemRedfishErrorError struct {
-	MessageDotExtendedInfo []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
-	Code                   OptString                                                         `json:"code"`
-	Message                OptString                                                         `json:"message"`
+// SetTime sets the value of Time.
+func (s *DHCPEvent) SetTime(val OptDateTime) {
+	s.Time = val
 }
 
-// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
-func (s *EventJobMessagesItemRedfishErrorError) GetMessageDotExtendedInfo() []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem {
-	return s.MessageDotExtendedInfo
+// DHCPLease schema.
+// Ref: #/components/schemas/DHCPLease
+type DHCPLease struct {
+	CreatedAt OptDateTime `json:"created_at"`
+	ExpiresAt OptDateTime `json:"expires_at"`
+	ID        OptInt64    `json:"id"`
+	IP        OptString   `json:"ip"`
+	MAC       OptString   `json:"mac"`
+	UpdatedAt OptDateTime `json:"updated_at"`
 }
 
-// GetCode returns the value of Code.
-func (s *EventJobMessagesItemRedfishErrorError) GetCode() OptString {
-	return s.Code
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DHCPLease) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// GetMessage returns the value of Message.
-func (s *EventJobMessagesItemRedfishErrorError) GetMessage() OptString {
-	return s.Message
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *DHCPLease) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
 }
 
-// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
-func (s *EventJobMessagesItemRedfishErrorError) SetMessageDotExtendedInfo(val []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) {
-	s.MessageDotExtendedInfo = val
+// GetID returns the value of ID.
+func (s *DHCPLease) GetID() OptInt64 {
+	return s.ID
 }
 
-// SetCode sets the value of Code.
-func (s *EventJobMessagesItemRedfishErrorError) SetCode(val OptString) {
-	s.Code = val
+// GetIP returns the value of IP.
+func (s *DHCPLease) GetIP() OptString {
+	return s.IP
 }
 
-// SetMessage sets the value of Message.
-func (s *EventJobMessagesItemRedfishErrorError) SetMessage(val OptString) {
-	s.Message = val
+// GetMAC returns the value of MAC.
+func (s *DHCPLease) GetMAC() OptString {
+	return s.MAC
 }
 
-type EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem struct {
-	Message                           OptString `json:"Message"`
-	MessageArgsDotOdataDotCount       OptInt    `json:"MessageArgs.@odata.count"`
-	MessageId                         OptString `json:"MessageId"`
-	RelatedPropertiesDotOdataDotCount OptInt    `json:"RelatedProperties.@odata.count"`
-	Resolution                        OptString `json:"Resolution"`
-	Severity                          OptString `json:"Severity"`
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *DHCPLease) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
 }
 
-// GetMessage returns the value of Message.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessage() OptString {
-	return s.Message
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DHCPLease) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// GetMessageArgsDotOdataDotCount returns the value of MessageArgsDotOdataDotCount.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageArgsDotOdataDotCount() OptInt {
-	return s.MessageArgsDotOdataDotCount
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *DHCPLease) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
 }
 
-// GetMessageId returns the value of MessageId.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageId() OptString {
-	return s.MessageId
+// SetID sets the value of ID.
+func (s *DHCPLease) SetID(val OptInt64) {
+	s.ID = val
 }
 
-// GetRelatedPropertiesDotOdataDotCount returns the value of RelatedPropertiesDotOdataDotCount.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetRelatedPropertiesDotOdataDotCount() OptInt {
-	return s.RelatedPropertiesDotOdataDotCount
+// SetIP sets the value of IP.
+func (s *DHCPLease) SetIP(val OptString) {
+	s.IP = val
 }
 
-// GetResolution returns the value of Resolution.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetResolution() OptString {
-	return s.Resolution
+// SetMAC sets the value of MAC.
+func (s *DHCPLease) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// GetSeverity returns the value of Severity.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetSeverity() OptString {
-	return s.Severity
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *DHCPLease) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
 }
 
-// SetMessage sets the value of Message.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessage(val OptString) {
-	s.Message = val
+// DataDump schema.
+// Ref: #/components/schemas/DataDump
+type DataDump struct {
+	Hosts  []NilDataDumpHostsItem  `json:"Hosts"`
+	Images []NilDataDumpImagesItem `json:"Images"`
+	Users  []DataDumpUsersItem     `json:"Users"`
 }
 
-// SetMessageArgsDotOdataDotCount sets the value of MessageArgsDotOdataDotCount.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageArgsDotOdataDotCount(val OptInt) {
-	s.MessageArgsDotOdataDotCount = val
+// GetHosts returns the value of Hosts.
+func (s *DataDump) GetHosts() []NilDataDumpHostsItem {
+	return s.Hosts
 }
 
-// SetMessageId sets the value of MessageId.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageId(val OptString) {
-	s.MessageId = val
+// GetImages returns the value of Images.
+func (s *DataDump) GetImages() []NilDataDumpImagesItem {
+	return s.Images
 }
 
-// SetRelatedPropertiesDotOdataDotCount sets the value of RelatedPropertiesDotOdataDotCount.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetRelatedPropertiesDotOdataDotCount(val OptInt) {
-	s.RelatedPropertiesDotOdataDotCount = val
+// GetUsers returns the value of Users.
+func (s *DataDump) GetUsers() []DataDumpUsersItem {
+	return s.Users
 }
 
-// SetResolution sets the value of Resolution.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetResolution(val OptString) {
-	s.Resolution = val
+// SetHosts sets the value of Hosts.
+func (s *DataDump) SetHosts(val []NilDataDumpHostsItem) {
+	s.Hosts = val
 }
 
-// SetSeverity sets the value of Severity.
-func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetSeverity(val OptString) {
-	s.Severity = val
+// SetImages sets the value of Images.
+func (s *DataDump) SetImages(val []NilDataDumpImagesItem) {
+	s.Images = val
 }
 
-// GenericResponse schema.
-// Ref: #/components/schemas/GenericResponse
-type GenericResponse struct {
-	Changed OptInt    `json:"changed"`
-	Detail  OptString `json:"detail"`
-	Title   OptString `json:"title"`
+// SetUsers sets the value of Users.
+func (s *DataDump) SetUsers(val []DataDumpUsersItem) {
+	s.Users = val
 }
 
-// GetChanged returns the value of Changed.
-func (s *GenericResponse) GetChanged() OptInt {
-	return s.Changed
+type DataDumpHostsItem struct {
+	Bonds      []NilDataDumpHostsItemBondsItem      `json:"bonds"`
+	BootImage  OptString                            `json:"boot_image"`
+	Firmware   OptString                            `json:"firmware"`
+	ID         OptNilInt64                          `json:"id"`
+	Interfaces []NilDataDumpHostsItemInterfacesItem `json:"interfaces"`
+	Name       OptString                            `json:"name"`
+	Provision  OptBool                              `json:"provision"`
+	Tags       OptNilStringArray                    `json:"tags"`
+	UID        OptNilString                         `json:"uid"`
 }
 
-// GetDetail returns the value of Detail.
-func (s *GenericResponse) GetDetail() OptString {
-	return s.Detail
+// GetBonds returns the value of Bonds.
+func (s *DataDumpHostsItem) GetBonds() []NilDataDumpHostsItemBondsItem {
+	return s.Bonds
 }
 
-// GetTitle returns the value of Title.
-func (s *GenericResponse) GetTitle() OptString {
-	return s.Title
+// GetBootImage returns the value of BootImage.
+func (s *DataDumpHostsItem) GetBootImage() OptString {
+	return s.BootImage
 }
 
-// SetChanged sets the value of Changed.
-func (s *GenericResponse) SetChanged(val OptInt) {
-	s.Changed = val
+// GetFirmware returns the value of Firmware.
+func (s *DataDumpHostsItem) GetFirmware() OptString {
+	return s.Firmware
 }
 
-// SetDetail sets the value of Detail.
-func (s *GenericResponse) SetDetail(val OptString) {
-	s.Detail = val
+// GetID returns the value of ID.
+func (s *DataDumpHostsItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// SetTitle sets the value of Title.
-func (s *GenericResponse) SetTitle(val OptString) {
-	s.Title = val
+// GetInterfaces returns the value of Interfaces.
+func (s *DataDumpHostsItem) GetInterfaces() []NilDataDumpHostsItemInterfacesItem {
+	return s.Interfaces
 }
 
-// GetRolesResponse schema.
-// Ref: #/components/schemas/GetRolesResponse
-type GetRolesResponse struct {
-	Roles []GetRolesResponseRolesItem `json:"roles"`
+// GetName returns the value of Name.
+func (s *DataDumpHostsItem) GetName() OptString {
+	return s.Name
 }
 
-// GetRoles returns the value of Roles.
-func (s *GetRolesResponse) GetRoles() []GetRolesResponseRolesItem {
-	return s.Roles
+// GetProvision returns the value of Provision.
+func (s *DataDumpHostsItem) GetProvision() OptBool {
+	return s.Provision
 }
 
-// SetRoles sets the value of Roles.
-func (s *GetRolesResponse) SetRoles(val []GetRolesResponseRolesItem) {
-	s.Roles = val
+// GetTags returns the value of Tags.
+func (s *DataDumpHostsItem) GetTags() OptNilStringArray {
+	return s.Tags
 }
 
-type GetRolesResponseRolesItem struct {
-	Name                     OptString                                               `json:"name"`
-	PermissionList           []GetRolesResponseRolesItemPermissionListItem           `json:"permission_list"`
-	UnassignedPermissionList []GetRolesResponseRolesItemUnassignedPermissionListItem `json:"unassigned_permission_list"`
+// GetUID returns the value of UID.
+func (s *DataDumpHostsItem) GetUID() OptNilString {
+	return s.UID
 }
 
-// GetName returns the value of Name.
-func (s *GetRolesResponseRolesItem) GetName() OptString {
-	return s.Name
+// SetBonds sets the value of Bonds.
+func (s *DataDumpHostsItem) SetBonds(val []NilDataDumpHostsItemBondsItem) {
+	s.Bonds = val
 }
 
-// GetPermissionList returns the value of PermissionList.
-func (s *GetRolesResponseRolesItem) GetPermissionList() []GetRolesResponseRolesItemPermissionListItem {
-	return s.PermissionList
+// SetBootImage sets the value of BootImage.
+func (s *DataDumpHostsItem) SetBootImage(val OptString) {
+	s.BootImage = val
 }
 
-// GetUnassignedPermissionList returns the value of UnassignedPermissionList.
-func (s *GetRolesResponseRolesItem) GetUnassignedPermissionList() []GetRolesResponseRolesItemUnassignedPermissionListItem {
-	return s.UnassignedPermissionList
+// SetFirmware sets the value of Firmware.
+func (s *DataDumpHostsItem) SetFirmware(val OptString) {
+	s.Firmware = val
 }
 
-// SetName sets the value of Name.
-func (s *GetRolesResponseRolesItem) SetName(val OptString) {
-	s.Name = val
+// SetID sets the value of ID.
+func (s *DataDumpHostsItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// SetPermissionList sets the value of PermissionList.
-func (s *GetRolesResponseRolesItem) SetPermissionList(val []GetRolesResponseRolesItemPermissionListItem) {
-	s.PermissionList = val
+// SetInterfaces sets the value of Interfaces.
+func (s *DataDumpHostsItem) SetInterfaces(val []NilDataDumpHostsItemInterfacesItem) {
+	s.Interfaces = val
 }
 
-// SetUnassignedPermissionList sets the value of UnassignedPermissionList.
-func (s *GetRolesResponseRolesItem) SetUnassignedPermissionList(val []GetRolesResponseRolesItemUnassignedPermissionListItem) {
-	s.UnassignedPermissionList = val
+// SetName sets the value of Name.
+func (s *DataDumpHostsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-type GetRolesResponseRolesItemPermissionListItem struct {
-	Method OptString `json:"method"`
-	Path   OptString `json:"path"`
+// SetProvision sets the value of Provision.
+func (s *DataDumpHostsItem) SetProvision(val OptBool) {
+	s.Provision = val
 }
 
-// GetMethod returns the value of Method.
-func (s *GetRolesResponseRolesItemPermissionListItem) GetMethod() OptString {
-	return s.Method
+// SetTags sets the value of Tags.
+func (s *DataDumpHostsItem) SetTags(val OptNilStringArray) {
+	s.Tags = val
 }
 
-// GetPath returns the value of Path.
-func (s *GetRolesResponseRolesItemPermissionListItem) GetPath() OptString {
-	return s.Path
+// SetUID sets the value of UID.
+func (s *DataDumpHostsItem) SetUID(val OptNilString) {
+	s.UID = val
 }
 
-// SetMethod sets the value of Method.
-func (s *GetRolesResponseRolesItemPermissionListItem) SetMethod(val OptString) {
-	s.Method = val
+type DataDumpHostsItemBondsItem struct {
+	Bmc        OptBool     `json:"bmc"`
+	Fqdn       OptString   `json:"fqdn"`
+	ID         OptNilInt64 `json:"id"`
+	Ifname     OptString   `json:"ifname"`
+	IP         OptString   `json:"ip"`
+	Ip6        OptString   `json:"ip6"`
+	MAC        OptString   `json:"mac"`
+	Mtu        OptInt      `json:"mtu"`
+	Peers      []string    `json:"peers"`
+	Switch     OptString   `json:"switch"`
+	SwitchPort OptString   `json:"switch_port"`
+	Vlan       OptString   `json:"vlan"`
 }
 
-// SetPath sets the value of Path.
-func (s *GetRolesResponseRolesItemPermissionListItem) SetPath(val OptString) {
-	s.Path = val
+// GetBmc returns the value of Bmc.
+func (s *DataDumpHostsItemBondsItem) GetBmc() OptBool {
+	return s.Bmc
 }
 
-type GetRolesResponseRolesItemUnassignedPermissionListItem struct {
-	Method OptString `json:"method"`
-	Path   OptString `json:"path"`
+// GetFqdn returns the value of Fqdn.
+func (s *DataDumpHostsItemBondsItem) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-// GetMethod returns the value of Method.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) GetMethod() OptString {
-	return s.Method
+// GetID returns the value of ID.
+func (s *DataDumpHostsItemBondsItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// GetPath returns the value of Path.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) GetPath() OptString {
-	return s.Path
+// GetIfname returns the value of Ifname.
+func (s *DataDumpHostsItemBondsItem) GetIfname() OptString {
+	return s.Ifname
 }
 
-// SetMethod sets the value of Method.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) SetMethod(val OptString) {
-	s.Method = val
+// GetIP returns the value of IP.
+func (s *DataDumpHostsItemBondsItem) GetIP() OptString {
+	return s.IP
 }
 
-// SetPath sets the value of Path.
-func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) SetPath(val OptString) {
-	s.Path = val
+// GetIp6 returns the value of Ip6.
+func (s *DataDumpHostsItemBondsItem) GetIp6() OptString {
+	return s.Ip6
 }
 
-// HTTPError schema.
-// Ref: #/components/schemas/HTTPError
-type HTTPError struct {
-	// Human readable error message.
-	Detail   OptNilString                   `json:"detail"`
-	Errors   OptNilHTTPErrorErrorsItemArray `json:"errors"`
-	Instance OptNilString                   `json:"instance"`
-	// HTTP status code.
-	Status OptNilInt `json:"status"`
-	// Short title of the error.
-	Title OptNilString `json:"title"`
-	// URL of the error type. Can be used to lookup the error in a documentation.
-	Type OptNilString `json:"type"`
+// GetMAC returns the value of MAC.
+func (s *DataDumpHostsItemBondsItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// GetDetail returns the value of Detail.
-func (s *HTTPError) GetDetail() OptNilString {
-	return s.Detail
+// GetMtu returns the value of Mtu.
+func (s *DataDumpHostsItemBondsItem) GetMtu() OptInt {
+	return s.Mtu
 }
 
-// GetErrors returns the value of Errors.
-func (s *HTTPError) GetErrors() OptNilHTTPErrorErrorsItemArray {
-	return s.Errors
+// GetPeers returns the value of Peers.
+func (s *DataDumpHostsItemBondsItem) GetPeers() []string {
+	return s.Peers
 }
 
-// GetInstance returns the value of Instance.
-func (s *HTTPError) GetInstance() OptNilString {
-	return s.Instance
+// GetSwitch returns the value of Switch.
+func (s *DataDumpHostsItemBondsItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// GetStatus returns the value of Status.
-func (s *HTTPError) GetStatus() OptNilInt {
-	return s.Status
+// GetSwitchPort returns the value of SwitchPort.
+func (s *DataDumpHostsItemBondsItem) GetSwitchPort() OptString {
+	return s.SwitchPort
 }
 
-// GetTitle returns the value of Title.
-func (s *HTTPError) GetTitle() OptNilString {
-	return s.Title
+// GetVlan returns the value of Vlan.
+func (s *DataDumpHostsItemBondsItem) GetVlan() OptString {
+	return s.Vlan
 }
 
-// GetType returns the value of Type.
-func (s *HTTPError) GetType() OptNilString {
-	return s.Type
+// SetBmc sets the value of Bmc.
+func (s *DataDumpHostsItemBondsItem) SetBmc(val OptBool) {
+	s.Bmc = val
 }
 
-// SetDetail sets the value of Detail.
-func (s *HTTPError) SetDetail(val OptNilString) {
-	s.Detail = val
+// SetFqdn sets the value of Fqdn.
+func (s *DataDumpHostsItemBondsItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// SetErrors sets the value of Errors.
-func (s *HTTPError) SetErrors(val OptNilHTTPErrorErrorsItemArray) {
-	s.Errors = val
+// SetID sets the value of ID.
+func (s *DataDumpHostsItemBondsItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// SetInstance sets the value of Instance.
-func (s *HTTPError) SetInstance(val OptNilString) {
-	s.Instance = val
+// SetIfname sets the value of Ifname.
+func (s *DataDumpHostsItemBondsItem) SetIfname(val OptString) {
+	s.Ifname = val
 }
 
-// SetStatus sets the value of Status.
-func (s *HTTPError) SetStatus(val OptNilInt) {
-	s.Status = val
+// SetIP sets the value of IP.
+func (s *DataDumpHostsItemBondsItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// SetTitle sets the value of Title.
-func (s *HTTPError) SetTitle(val OptNilString) {
-	s.Title = val
+// SetIp6 sets the value of Ip6.
+func (s *DataDumpHostsItemBondsItem) SetIp6(val OptString) {
+	s.Ip6 = val
 }
 
-// SetType sets the value of Type.
-func (s *HTTPError) SetType(val OptNilString) {
-	s.Type = val
+// SetMAC sets the value of MAC.
+func (s *DataDumpHostsItemBondsItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-type HTTPErrorErrorsItem struct {
-	More   OptHTTPErrorErrorsItemMore `json:"more"`
-	Name   OptString                  `json:"name"`
-	Reason OptString                  `json:"reason"`
+// SetMtu sets the value of Mtu.
+func (s *DataDumpHostsItemBondsItem) SetMtu(val OptInt) {
+	s.Mtu = val
 }
 
-// GetMore returns the value of More.
-func (s *HTTPErrorErrorsItem) GetMore() OptHTTPErrorErrorsItemMore {
-	return s.More
+// SetPeers sets the value of Peers.
+func (s *DataDumpHostsItemBondsItem) SetPeers(val []string) {
+	s.Peers = val
 }
 
-// GetName returns the value of Name.
-func (s *HTTPErrorErrorsItem) GetName() OptString {
-	return s.Name
+// SetSwitch sets the value of Switch.
+func (s *DataDumpHostsItemBondsItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// GetReason returns the value of Reason.
-func (s *HTTPErrorErrorsItem) GetReason() OptString {
-	return s.Reason
+// SetSwitchPort sets the value of SwitchPort.
+func (s *DataDumpHostsItemBondsItem) SetSwitchPort(val OptString) {
+	s.SwitchPort = val
 }
 
-// SetMore sets the value of More.
-func (s *HTTPErrorErrorsItem) SetMore(val OptHTTPErrorErrorsItemMore) {
-	s.More = val
+// SetVlan sets the value of Vlan.
+func (s *DataDumpHostsItemBondsItem) SetVlan(val OptString) {
+	s.Vlan = val
 }
 
-// SetName sets the value of Name.
-func (s *HTTPErrorErrorsItem) SetName(val OptString) {
-	s.Name = val
+type DataDumpHostsItemInterfacesItem struct {
+	Bmc        OptBool     `json:"bmc"`
+	Fqdn       OptString   `json:"fqdn"`
+	ID         OptNilInt64 `json:"id"`
+	Ifname     OptString   `json:"ifname"`
+	IP         OptString   `json:"ip"`
+	Ip6        OptString   `json:"ip6"`
+	MAC        OptString   `json:"mac"`
+	Mtu        OptInt      `json:"mtu"`
+	Switch     OptString   `json:"switch"`
+	SwitchPort OptString   `json:"switch_port"`
+	Vlan       OptString   `json:"vlan"`
 }
 
-// SetReason sets the value of Reason.
-func (s *HTTPErrorErrorsItem) SetReason(val OptString) {
-	s.Reason = val
+// GetBmc returns the value of Bmc.
+func (s *DataDumpHostsItemInterfacesItem) GetBmc() OptBool {
+	return s.Bmc
 }
 
-type HTTPErrorErrorsItemMore map[string]jx.Raw
-
-func (s *HTTPErrorErrorsItemMore) init() HTTPErrorErrorsItemMore {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// GetFqdn returns the value of Fqdn.
+func (s *DataDumpHostsItemInterfacesItem) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-// HTTPErrorStatusCode wraps HTTPError with StatusCode.
-type HTTPErrorStatusCode struct {
-	StatusCode int
-	Response   HTTPError
+// GetID returns the value of ID.
+func (s *DataDumpHostsItemInterfacesItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *HTTPErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// GetIfname returns the value of Ifname.
+func (s *DataDumpHostsItemInterfacesItem) GetIfname() OptString {
+	return s.Ifname
 }
 
-// GetResponse returns the value of Response.
-func (s *HTTPErrorStatusCode) GetResponse() HTTPError {
-	return s.Response
+// GetIP returns the value of IP.
+func (s *DataDumpHostsItemInterfacesItem) GetIP() OptString {
+	return s.IP
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *HTTPErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// GetIp6 returns the value of Ip6.
+func (s *DataDumpHostsItemInterfacesItem) GetIp6() OptString {
+	return s.Ip6
 }
 
-// SetResponse sets the value of Response.
-func (s *HTTPErrorStatusCode) SetResponse(val HTTPError) {
-	s.Response = val
+// GetMAC returns the value of MAC.
+func (s *DataDumpHostsItemInterfacesItem) GetMAC() OptString {
+	return s.MAC
 }
 
-type HeaderAuth struct {
-	Token string
+// GetMtu returns the value of Mtu.
+func (s *DataDumpHostsItemInterfacesItem) GetMtu() OptInt {
+	return s.Mtu
 }
 
-// GetToken returns the value of Token.
-func (s *HeaderAuth) GetToken() string {
-	return s.Token
+// GetSwitch returns the value of Switch.
+func (s *DataDumpHostsItemInterfacesItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// SetToken sets the value of Token.
-func (s *HeaderAuth) SetToken(val string) {
-	s.Token = val
+// GetSwitchPort returns the value of SwitchPort.
+func (s *DataDumpHostsItemInterfacesItem) GetSwitchPort() OptString {
+	return s.SwitchPort
 }
 
-// Host schema.
-// Ref: #/components/schemas/Host
-type Host struct {
-	Bonds      []NilHostBondsItem      `json:"bonds"`
-	BootImage  OptString               `json:"boot_image"`
-	Firmware   OptString               `json:"firmware"`
-	ID         OptNilInt64             `json:"id"`
-	Interfaces []NilHostInterfacesItem `json:"interfaces"`
-	Name       OptString               `json:"name"`
-	Provision  OptBool                 `json:"provision"`
-	Tags       OptNilStringArray       `json:"tags"`
-	UID        OptNilString            `json:"uid"`
+// GetVlan returns the value of Vlan.
+func (s *DataDumpHostsItemInterfacesItem) GetVlan() OptString {
+	return s.Vlan
 }
 
-// GetBonds returns the value of Bonds.
-func (s *Host) GetBonds() []NilHostBondsItem {
-	return s.Bonds
+// SetBmc sets the value of Bmc.
+func (s *DataDumpHostsItemInterfacesItem) SetBmc(val OptBool) {
+	s.Bmc = val
 }
 
-// GetBootImage returns the value of BootImage.
-func (s *Host) GetBootImage() OptString {
-	return s.BootImage
+// SetFqdn sets the value of Fqdn.
+func (s *DataDumpHostsItemInterfacesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// GetFirmware returns the value of Firmware.
-func (s *Host) GetFirmware() OptString {
-	return s.Firmware
+// SetID sets the value of ID.
+func (s *DataDumpHostsItemInterfacesItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// GetID returns the value of ID.
-func (s *Host) GetID() OptNilInt64 {
-	return s.ID
+// SetIfname sets the value of Ifname.
+func (s *DataDumpHostsItemInterfacesItem) SetIfname(val OptString) {
+	s.Ifname = val
 }
 
-// GetInterfaces returns the value of Interfaces.
-func (s *Host) GetInterfaces() []NilHostInterfacesItem {
-	return s.Interfaces
+// SetIP sets the value of IP.
+func (s *DataDumpHostsItemInterfacesItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// GetName returns the value of Name.
-func (s *Host) GetName() OptString {
-	return s.Name
+// SetIp6 sets the value of Ip6.
+func (s *DataDumpHostsItemInterfacesItem) SetIp6(val OptString) {
+	s.Ip6 = val
 }
 
-// GetProvision returns the value of Provision.
-func (s *Host) GetProvision() OptBool {
-	return s.Provision
+// SetMAC sets the value of MAC.
+func (s *DataDumpHostsItemInterfacesItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// GetTags returns the value of Tags.
-func (s *Host) GetTags() OptNilStringArray {
-	return s.Tags
+// SetMtu sets the value of Mtu.
+func (s *DataDumpHostsItemInterfacesItem) SetMtu(val OptInt) {
+	s.Mtu = val
 }
 
-// GetUID returns the value of UID.
-func (s *Host) GetUID() OptNilString {
-	return s.UID
+// SetSwitch sets the value of Switch.
+func (s *DataDumpHostsItemInterfacesItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// SetBonds sets the value of Bonds.
-func (s *Host) SetBonds(val []NilHostBondsItem) {
-	s.Bonds = val
+// SetSwitchPort sets the value of SwitchPort.
+func (s *DataDumpHostsItemInterfacesItem) SetSwitchPort(val OptString) {
+	s.SwitchPort = val
 }
 
-// SetBootImage sets the value of BootImage.
-func (s *Host) SetBootImage(val OptString) {
-	s.BootImage = val
+// SetVlan sets the value of Vlan.
+func (s *DataDumpHostsItemInterfacesItem) SetVlan(val OptString) {
+	s.Vlan = val
 }
 
-// SetFirmware sets the value of Firmware.
-func (s *Host) SetFirmware(val OptString) {
-	s.Firmware = val
+type DataDumpImagesItem struct {
+	Cmdline            OptString                                  `json:"cmdline"`
+	ID                 OptNilInt64                                `json:"id"`
+	Initrd             []string                                   `json:"initrd"`
+	InitrdSHA256       []string                                   `json:"initrd_sha256"`
+	Kernel             OptString                                  `json:"kernel"`
+	KernelSHA256       OptString                                  `json:"kernel_sha256"`
+	Liveimg            OptString                                  `json:"liveimg"`
+	LiveimgSHA256      OptString                                  `json:"liveimg_sha256"`
+	Name               OptString                                  `json:"name"`
+	ProvisionTemplates OptNilDataDumpImagesItemProvisionTemplates `json:"provision_templates"`
+	UID                OptNilString                               `json:"uid"`
+	Verify             OptBool                                    `json:"verify"`
+	Version            OptInt                                     `json:"version"`
 }
 
-// SetID sets the value of ID.
-func (s *Host) SetID(val OptNilInt64) {
-	s.ID = val
+// GetCmdline returns the value of Cmdline.
+func (s *DataDumpImagesItem) GetCmdline() OptString {
+	return s.Cmdline
 }
 
-// SetInterfaces sets the value of Interfaces.
-func (s *Host) SetInterfaces(val []NilHostInterfacesItem) {
-	s.Interfaces = val
+// GetID returns the value of ID.
+func (s *DataDumpImagesItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// SetName sets the value of Name.
-func (s *Host) SetName(val OptString) {
-	s.Name = val
+// GetInitrd returns the value of Initrd.
+func (s *DataDumpImagesItem) GetInitrd() []string {
+	return s.Initrd
 }
 
-// SetProvision sets the value of Provision.
-func (s *Host) SetProvision(val OptBool) {
-	s.Provision = val
+// GetInitrdSHA256 returns the value of InitrdSHA256.
+func (s *DataDumpImagesItem) GetInitrdSHA256() []string {
+	return s.InitrdSHA256
 }
 
-// SetTags sets the value of Tags.
-func (s *Host) SetTags(val OptNilStringArray) {
-	s.Tags = val
+// GetKernel returns the value of Kernel.
+func (s *DataDumpImagesItem) GetKernel() OptString {
+	return s.Kernel
 }
 
-// SetUID sets the value of UID.
-func (s *Host) SetUID(val OptNilString) {
-	s.UID = val
+// GetKernelSHA256 returns the value of KernelSHA256.
+func (s *DataDumpImagesItem) GetKernelSHA256() OptString {
+	return s.KernelSHA256
 }
 
-type HostBondsItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Peers  []string    `json:"peers"`
-	Vlan   OptString   `json:"vlan"`
+// GetLiveimg returns the value of Liveimg.
+func (s *DataDumpImagesItem) GetLiveimg() OptString {
+	return s.Liveimg
 }
 
-// GetBmc returns the value of Bmc.
-func (s *HostBondsItem) GetBmc() OptBool {
-	return s.Bmc
+// GetLiveimgSHA256 returns the value of LiveimgSHA256.
+func (s *DataDumpImagesItem) GetLiveimgSHA256() OptString {
+	return s.LiveimgSHA256
 }
 
-// GetFqdn returns the value of Fqdn.
-func (s *HostBondsItem) GetFqdn() OptString {
-	return s.Fqdn
+// GetName returns the value of Name.
+func (s *DataDumpImagesItem) GetName() OptString {
+	return s.Name
 }
 
-// GetID returns the value of ID.
-func (s *HostBondsItem) GetID() OptNilInt64 {
-	return s.ID
+// GetProvisionTemplates returns the value of ProvisionTemplates.
+func (s *DataDumpImagesItem) GetProvisionTemplates() OptNilDataDumpImagesItemProvisionTemplates {
+	return s.ProvisionTemplates
 }
 
-// GetIfname returns the value of Ifname.
-func (s *HostBondsItem) GetIfname() OptString {
-	return s.Ifname
+// GetUID returns the value of UID.
+func (s *DataDumpImagesItem) GetUID() OptNilString {
+	return s.UID
 }
 
-// GetIP returns the value of IP.
-func (s *HostBondsItem) GetIP() OptString {
-	return s.IP
+// GetVerify returns the value of Verify.
+func (s *DataDumpImagesItem) GetVerify() OptBool {
+	return s.Verify
 }
 
-// GetMAC returns the value of MAC.
-func (s *HostBondsItem) GetMAC() OptString {
-	return s.MAC
+// GetVersion returns the value of Version.
+func (s *DataDumpImagesItem) GetVersion() OptInt {
+	return s.Version
 }
 
-// GetMtu returns the value of Mtu.
-func (s *HostBondsItem) GetMtu() OptInt {
-	return s.Mtu
+// SetCmdline sets the value of Cmdline.
+func (s *DataDumpImagesItem) SetCmdline(val OptString) {
+	s.Cmdline = val
 }
 
-// GetPeers returns the value of Peers.
-func (s *HostBondsItem) GetPeers() []string {
-	return s.Peers
+// SetID sets the value of ID.
+func (s *DataDumpImagesItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// GetVlan returns the value of Vlan.
-func (s *HostBondsItem) GetVlan() OptString {
-	return s.Vlan
+// SetInitrd sets the value of Initrd.
+func (s *DataDumpImagesItem) SetInitrd(val []string) {
+	s.Initrd = val
 }
 
-// SetBmc sets the value of Bmc.
-func (s *HostBondsItem) SetBmc(val OptBool) {
-	s.Bmc = val
+// SetInitrdSHA256 sets the value of InitrdSHA256.
+func (s *DataDumpImagesItem) SetInitrdSHA256(val []string) {
+	s.InitrdSHA256 = val
 }
 
-// SetFqdn sets the value of Fqdn.
-func (s *HostBondsItem) SetFqdn(val OptString) {
-	s.Fqdn = val
+// SetKernel sets the value of Kernel.
+func (s *DataDumpImagesItem) SetKernel(val OptString) {
+	s.Kernel = val
 }
 
-// SetID sets the value of ID.
-func (s *HostBondsItem) SetID(val OptNilInt64) {
-	s.ID = val
+// SetKernelSHA256 sets the value of KernelSHA256.
+func (s *DataDumpImagesItem) SetKernelSHA256(val OptString) {
+	s.KernelSHA256 = val
 }
 
-// SetIfname sets the value of Ifname.
-func (s *HostBondsItem) SetIfname(val OptString) {
-	s.Ifname = val
+// SetLiveimg sets the value of Liveimg.
+func (s *DataDumpImagesItem) SetLiveimg(val OptString) {
+	s.Liveimg = val
 }
 
-// SetIP sets the value of IP.
-func (s *HostBondsItem) SetIP(val OptString) {
-	s.IP = val
+// SetLiveimgSHA256 sets the value of LiveimgSHA256.
+func (s *DataDumpImagesItem) SetLiveimgSHA256(val OptString) {
+	s.LiveimgSHA256 = val
 }
 
-// SetMAC sets the value of MAC.
-func (s *HostBondsItem) SetMAC(val OptString) {
-	s.MAC = val
+// SetName sets the value of Name.
+func (s *DataDumpImagesItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetMtu sets the value of Mtu.
-func (s *HostBondsItem) SetMtu(val OptInt) {
-	s.Mtu = val
+// SetProvisionTemplates sets the value of ProvisionTemplates.
+func (s *DataDumpImagesItem) SetProvisionTemplates(val OptNilDataDumpImagesItemProvisionTemplates) {
+	s.ProvisionTemplates = val
 }
 
-// SetPeers sets the value of Peers.
-func (s *HostBondsItem) SetPeers(val []string) {
-	s.Peers = val
+// SetUID sets the value of UID.
+func (s *DataDumpImagesItem) SetUID(val OptNilString) {
+	s.UID = val
 }
 
-// SetVlan sets the value of Vlan.
-func (s *HostBondsItem) SetVlan(val OptString) {
-	s.Vlan = val
+// SetVerify sets the value of Verify.
+func (s *DataDumpImagesItem) SetVerify(val OptBool) {
+	s.Verify = val
 }
 
-type HostInterfacesItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Vlan   OptString   `json:"vlan"`
+// SetVersion sets the value of Version.
+func (s *DataDumpImagesItem) SetVersion(val OptInt) {
+	s.Version = val
 }
 
-// GetBmc returns the value of Bmc.
-func (s *HostInterfacesItem) GetBmc() OptBool {
-	return s.Bmc
-}
+type DataDumpImagesItemProvisionTemplates map[string]NilString
 
-// GetFqdn returns the value of Fqdn.
-func (s *HostInterfacesItem) GetFqdn() OptString {
-	return s.Fqdn
+func (s *DataDumpImagesItemProvisionTemplates) init() DataDumpImagesItemProvisionTemplates {
+	m := *s
+	if m == nil {
+		m = map[string]NilString{}
+		*s = m
+	}
+	return m
 }
 
-// GetID returns the value of ID.
-func (s *HostInterfacesItem) GetID() OptNilInt64 {
-	return s.ID
+type DataDumpUsersItem struct {
+	CreatedAt  OptDateTime `json:"created_at"`
+	Enabled    OptBool     `json:"enabled"`
+	Hash       OptString   `json:"hash"`
+	ID         OptInt64    `json:"id"`
+	ModifiedAt OptDateTime `json:"modified_at"`
+	Role       OptString   `json:"role"`
+	Username   OptString   `json:"username"`
 }
 
-// GetIfname returns the value of Ifname.
-func (s *HostInterfacesItem) GetIfname() OptString {
-	return s.Ifname
+// GetCreatedAt returns the value of CreatedAt.
+func (s *DataDumpUsersItem) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
 }
 
-// GetIP returns the value of IP.
-func (s *HostInterfacesItem) GetIP() OptString {
-	return s.IP
+// GetEnabled returns the value of Enabled.
+func (s *DataDumpUsersItem) GetEnabled() OptBool {
+	return s.Enabled
 }
 
-// GetMAC returns the value of MAC.
-func (s *HostInterfacesItem) GetMAC() OptString {
-	return s.MAC
+// GetHash returns the value of Hash.
+func (s *DataDumpUsersItem) GetHash() OptString {
+	return s.Hash
 }
 
-// GetMtu returns the value of Mtu.
-func (s *HostInterfacesItem) GetMtu() OptInt {
-	return s.Mtu
+// GetID returns the value of ID.
+func (s *DataDumpUsersItem) GetID() OptInt64 {
+	return s.ID
 }
 
-// GetVlan returns the value of Vlan.
-func (s *HostInterfacesItem) GetVlan() OptString {
-	return s.Vlan
+// GetModifiedAt returns the value of ModifiedAt.
+func (s *DataDumpUsersItem) GetModifiedAt() OptDateTime {
+	return s.ModifiedAt
 }
 
-// SetBmc sets the value of Bmc.
-func (s *HostInterfacesItem) SetBmc(val OptBool) {
-	s.Bmc = val
+// GetRole returns the value of Role.
+func (s *DataDumpUsersItem) GetRole() OptString {
+	return s.Role
 }
 
-// SetFqdn sets the value of Fqdn.
-func (s *HostInterfacesItem) SetFqdn(val OptString) {
-	s.Fqdn = val
+// GetUsername returns the value of Username.
+func (s *DataDumpUsersItem) GetUsername() OptString {
+	return s.Username
 }
 
-// SetID sets the value of ID.
-func (s *HostInterfacesItem) SetID(val OptNilInt64) {
-	s.ID = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *DataDumpUsersItem) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
 }
 
-// SetIfname sets the value of Ifname.
-func (s *HostInterfacesItem) SetIfname(val OptString) {
-	s.Ifname = val
+// SetEnabled sets the value of Enabled.
+func (s *DataDumpUsersItem) SetEnabled(val OptBool) {
+	s.Enabled = val
 }
 
-// SetIP sets the value of IP.
-func (s *HostInterfacesItem) SetIP(val OptString) {
-	s.IP = val
+// SetHash sets the value of Hash.
+func (s *DataDumpUsersItem) SetHash(val OptString) {
+	s.Hash = val
 }
 
-// SetMAC sets the value of MAC.
-func (s *HostInterfacesItem) SetMAC(val OptString) {
-	s.MAC = val
+// SetID sets the value of ID.
+func (s *DataDumpUsersItem) SetID(val OptInt64) {
+	s.ID = val
 }
 
-// SetMtu sets the value of Mtu.
-func (s *HostInterfacesItem) SetMtu(val OptInt) {
-	s.Mtu = val
+// SetModifiedAt sets the value of ModifiedAt.
+func (s *DataDumpUsersItem) SetModifiedAt(val OptDateTime) {
+	s.ModifiedAt = val
 }
 
-// SetVlan sets the value of Vlan.
-func (s *HostInterfacesItem) SetVlan(val OptString) {
-	s.Vlan = val
+// SetRole sets the value of Role.
+func (s *DataDumpUsersItem) SetRole(val OptString) {
+	s.Role = val
 }
 
-// JobMessage schema.
-// Ref: #/components/schemas/JobMessage
-type JobMessage struct {
-	Data         OptString                 `json:"data"`
-	Host         OptString                 `json:"host"`
-	Msg          OptString                 `json:"msg"`
-	RedfishError OptJobMessageRedfishError `json:"redfish_error"`
-	Status       OptString                 `json:"status"`
+// SetUsername sets the value of Username.
+func (s *DataDumpUsersItem) SetUsername(val OptString) {
+	s.Username = val
+}
+
+// Event schema.
+// Ref: #/components/schemas/Event
+type Event struct {
+	Hosts       []string               `json:"Hosts"`
+	ID          OptInt64               `json:"ID"`
+	JobMessages []EventJobMessagesItem `json:"JobMessages"`
+	Message     OptString              `json:"Message"`
+	Severity    OptString              `json:"Severity"`
+	Time        OptDateTime            `json:"Time"`
+	User        OptString              `json:"User"`
+}
+
+// GetHosts returns the value of Hosts.
+func (s *Event) GetHosts() []string {
+	return s.Hosts
+}
+
+// GetID returns the value of ID.
+func (s *Event) GetID() OptInt64 {
+	return s.ID
+}
+
+// GetJobMessages returns the value of JobMessages.
+func (s *Event) GetJobMessages() []EventJobMessagesItem {
+	return s.JobMessages
+}
+
+// GetMessage returns the value of Message.
+func (s *Event) GetMessage() OptString {
+	return s.Message
+}
+
+// GetSeverity returns the value of Severity.
+func (s *Event) GetSeverity() OptString {
+	return s.Severity
+}
+
+// GetTime returns the value of Time.
+func (s *Event) GetTime() OptDateTime {
+	return s.Time
+}
+
+// GetUser returns the value of User.
+func (s *Event) GetUser() OptString {
+	return s.User
+}
+
+// SetHosts sets the value of Hosts.
+func (s *Event) SetHosts(val []string) {
+	s.Hosts = val
+}
+
+// SetID sets the value of ID.
+func (s *Event) SetID(val OptInt64) {
+	s.ID = val
+}
+
+// SetJobMessages sets the value of JobMessages.
+func (s *Event) SetJobMessages(val []EventJobMessagesItem) {
+	s.JobMessages = val
+}
+
+// SetMessage sets the value of Message.
+func (s *Event) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *Event) SetSeverity(val OptString) {
+	s.Severity = val
+}
+
+// SetTime sets the value of Time.
+func (s *Event) SetTime(val OptDateTime) {
+	s.Time = val
+}
+
+// SetUser sets the value of User.
+func (s *Event) SetUser(val OptString) {
+	s.User = val
+}
+
+type EventJobMessagesItem struct {
+	Data         OptString                           `json:"data"`
+	Host         OptString                           `json:"host"`
+	Msg          OptString                           `json:"msg"`
+	RedfishError OptEventJobMessagesItemRedfishError `json:"redfish_error"`
+	Status       OptString                           `json:"status"`
 }
 
 // GetData returns the value of Data.
-func (s *JobMessage) GetData() OptString {
+func (s *EventJobMessagesItem) GetData() OptString {
 	return s.Data
 }
 
 // GetHost returns the value of Host.
-func (s *JobMessage) GetHost() OptString {
+func (s *EventJobMessagesItem) GetHost() OptString {
 	return s.Host
 }
 
 // GetMsg returns the value of Msg.
-func (s *JobMessage) GetMsg() OptString {
+func (s *EventJobMessagesItem) GetMsg() OptString {
 	return s.Msg
 }
 
 // GetRedfishError returns the value of RedfishError.
-func (s *JobMessage) GetRedfishError() OptJobMessageRedfishError {
+func (s *EventJobMessagesItem) GetRedfishError() OptEventJobMessagesItemRedfishError {
 	return s.RedfishError
 }
 
 // GetStatus returns the value of Status.
-func (s *JobMessage) GetStatus() OptString {
+func (s *EventJobMessagesItem) GetStatus() OptString {
 	return s.Status
 }
 
 // SetData sets the value of Data.
-func (s *JobMessage) SetData(val OptString) {
+func (s *EventJobMessagesItem) SetData(val OptString) {
 	s.Data = val
 }
 
 // SetHost sets the value of Host.
-func (s *JobMessage) SetHost(val OptString) {
+func (s *EventJobMessagesItem) SetHost(val OptString) {
 	s.Host = val
 }
 
 // SetMsg sets the value of Msg.
-func (s *JobMessage) SetMsg(val OptString) {
+func (s *EventJobMessagesItem) SetMsg(val OptString) {
 	s.Msg = val
 }
 
 // SetRedfishError sets the value of RedfishError.
-func (s *JobMessage) SetRedfishError(val OptJobMessageRedfishError) {
+func (s *EventJobMessagesItem) SetRedfishError(val OptEventJobMessagesItemRedfishError) {
 	s.RedfishError = val
 }
 
 // SetStatus sets the value of Status.
-func (s *JobMessage) SetStatus(val OptString) {
+func (s *EventJobMessagesItem) SetStatus(val OptString) {
 	s.Status = val
 }
 
-type JobMessageRedfishError struct {
-	Code  OptString                      `json:"code"`
-	Error OptJobMessageRedfishErrorError `json:"error"`
+type EventJobMessagesItemRedfishError struct {
+	Code  OptString                                `json:"code"`
+	Error OptEventJobMessagesItemRedfishErrorError `json:"error"`
 }
 
 // GetCode returns the value of Code.
-func (s *JobMessageRedfishError) GetCode() OptString {
+func (s *EventJobMessagesItemRedfishError) GetCode() OptString {
 	return s.Code
 }
 
 // GetError returns the value of Error.
-func (s *JobMessageRedfishError) GetError() OptJobMessageRedfishErrorError {
+func (s *EventJobMessagesItemRedfishError) GetError() OptEventJobMessagesItemRedfishErrorError {
 	return s.Error
 }
 
 // SetCode sets the value of Code.
-func (s *JobMessageRedfishError) SetCode(val OptString) {
+func (s *EventJobMessagesItemRedfishError) SetCode(val OptString) {
 	s.Code = val
 }
 
 // SetError sets the value of Error.
-func (s *JobMessageRedfishError) SetError(val OptJobMessageRedfishErrorError) {
+func (s *EventJobMessagesItemRedfishError) SetError(val OptEventJobMessagesItemRedfishErrorError) {
 	s.Error = val
 }
 
-type JobMessageRedfishErrorError struct {
-	MessageDotExtendedInfo OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray `json:"@Message.ExtendedInfo"`
-	Code                   OptString                                                        `json:"code"`
-	Message                OptString                                                        `json:"message"`
+type EventJobMessagesItemRedfishErrorError struct {
+	MessageDotExtendedInfo []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
+	Code                   OptString                                                         `json:"code"`
+	Message                OptString                                                         `json:"message"`
 }
 
 // GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
-func (s *JobMessageRedfishErrorError) GetMessageDotExtendedInfo() OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray {
+func (s *EventJobMessagesItemRedfishErrorError) GetMessageDotExtendedInfo() []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem {
 	return s.MessageDotExtendedInfo
 }
 
 // GetCode returns the value of Code.
-func (s *JobMessageRedfishErrorError) GetCode() OptString {
+func (s *EventJobMessagesItemRedfishErrorError) GetCode() OptString {
 	return s.Code
 }
 
 // GetMessage returns the value of Message.
-func (s *JobMessageRedfishErrorError) GetMessage() OptString {
+func (s *EventJobMessagesItemRedfishErrorError) GetMessage() OptString {
 	return s.Message
 }
 
 // SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
-func (s *JobMessageRedfishErrorError) SetMessageDotExtendedInfo(val OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) {
+func (s *EventJobMessagesItemRedfishErrorError) SetMessageDotExtendedInfo(val []EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) {
 	s.MessageDotExtendedInfo = val
 }
 
 // SetCode sets the value of Code.
-func (s *JobMessageRedfishErrorError) SetCode(val OptString) {
+func (s *EventJobMessagesItemRedfishErrorError) SetCode(val OptString) {
 	s.Code = val
 }
 
 // SetMessage sets the value of Message.
-func (s *JobMessageRedfishErrorError) SetMessage(val OptString) {
+func (s *EventJobMessagesItemRedfishErrorError) SetMessage(val OptString) {
 	s.Message = val
 }
 
-type JobMessageRedfishErrorErrorMessageDotExtendedInfoItem struct {
+type EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem struct {
 	Message                           OptString `json:"Message"`
 	MessageArgsDotOdataDotCount       OptInt    `json:"MessageArgs.@odata.count"`
 	MessageId                         OptString `json:"MessageId"`
@@ -2152,2573 +2728,4396 @@ type JobMessageRedfishErrorErrorMessageDotExtendedInfoItem struct {
 }
 
 // GetMessage returns the value of Message.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessage() OptString {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessage() OptString {
 	return s.Message
 }
 
 // GetMessageArgsDotOdataDotCount returns the value of MessageArgsDotOdataDotCount.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageArgsDotOdataDotCount() OptInt {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageArgsDotOdataDotCount() OptInt {
 	return s.MessageArgsDotOdataDotCount
 }
 
 // GetMessageId returns the value of MessageId.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageId() OptString {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageId() OptString {
 	return s.MessageId
 }
 
 // GetRelatedPropertiesDotOdataDotCount returns the value of RelatedPropertiesDotOdataDotCount.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetRelatedPropertiesDotOdataDotCount() OptInt {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetRelatedPropertiesDotOdataDotCount() OptInt {
 	return s.RelatedPropertiesDotOdataDotCount
 }
 
 // GetResolution returns the value of Resolution.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetResolution() OptString {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetResolution() OptString {
 	return s.Resolution
 }
 
 // GetSeverity returns the value of Severity.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetSeverity() OptString {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) GetSeverity() OptString {
 	return s.Severity
 }
 
 // SetMessage sets the value of Message.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessage(val OptString) {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessage(val OptString) {
 	s.Message = val
 }
 
 // SetMessageArgsDotOdataDotCount sets the value of MessageArgsDotOdataDotCount.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageArgsDotOdataDotCount(val OptInt) {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageArgsDotOdataDotCount(val OptInt) {
 	s.MessageArgsDotOdataDotCount = val
 }
 
 // SetMessageId sets the value of MessageId.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageId(val OptString) {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageId(val OptString) {
 	s.MessageId = val
 }
 
 // SetRelatedPropertiesDotOdataDotCount sets the value of RelatedPropertiesDotOdataDotCount.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetRelatedPropertiesDotOdataDotCount(val OptInt) {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetRelatedPropertiesDotOdataDotCount(val OptInt) {
 	s.RelatedPropertiesDotOdataDotCount = val
 }
 
 // SetResolution sets the value of Resolution.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetResolution(val OptString) {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetResolution(val OptString) {
 	s.Resolution = val
 }
 
 // SetSeverity sets the value of Severity.
-func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetSeverity(val OptString) {
+func (s *EventJobMessagesItemRedfishErrorErrorMessageDotExtendedInfoItem) SetSeverity(val OptString) {
 	s.Severity = val
 }
 
-// LLDP schema.
-// Ref: #/components/schemas/LLDP
-type LLDP struct {
-	ChassisID         OptString `json:"chassis_id"`
-	ChassisIDType     OptString `json:"chassis_id_type"`
-	ManagementAddress OptString `json:"management_address"`
-	PortDescription   OptString `json:"port_description"`
-	PortID            OptString `json:"port_id"`
-	PortIDType        OptString `json:"port_id_type"`
-	PortName          OptString `json:"port_name"`
-	SystemDescription OptString `json:"system_description"`
-	SystemName        OptString `json:"system_name"`
+// String schema.
+// Ref: #/components/schemas/string
+type GETV1TopologyDotOK struct {
+	Data io.Reader
 }
 
-// GetChassisID returns the value of ChassisID.
-func (s *LLDP) GetChassisID() OptString {
-	return s.ChassisID
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GETV1TopologyDotOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
 }
 
-// GetChassisIDType returns the value of ChassisIDType.
-func (s *LLDP) GetChassisIDType() OptString {
-	return s.ChassisIDType
+// GenericResponse schema.
+// Ref: #/components/schemas/GenericResponse
+type GenericResponse struct {
+	Changed OptInt    `json:"changed"`
+	Detail  OptString `json:"detail"`
+	Title   OptString `json:"title"`
 }
 
-// GetManagementAddress returns the value of ManagementAddress.
-func (s *LLDP) GetManagementAddress() OptString {
-	return s.ManagementAddress
+// GetChanged returns the value of Changed.
+func (s *GenericResponse) GetChanged() OptInt {
+	return s.Changed
 }
 
-// GetPortDescription returns the value of PortDescription.
-func (s *LLDP) GetPortDescription() OptString {
-	return s.PortDescription
+// GetDetail returns the value of Detail.
+func (s *GenericResponse) GetDetail() OptString {
+	return s.Detail
 }
 
-// GetPortID returns the value of PortID.
-func (s *LLDP) GetPortID() OptString {
-	return s.PortID
+// GetTitle returns the value of Title.
+func (s *GenericResponse) GetTitle() OptString {
+	return s.Title
 }
 
-// GetPortIDType returns the value of PortIDType.
-func (s *LLDP) GetPortIDType() OptString {
-	return s.PortIDType
+// SetChanged sets the value of Changed.
+func (s *GenericResponse) SetChanged(val OptInt) {
+	s.Changed = val
 }
 
-// GetPortName returns the value of PortName.
-func (s *LLDP) GetPortName() OptString {
-	return s.PortName
+// SetDetail sets the value of Detail.
+func (s *GenericResponse) SetDetail(val OptString) {
+	s.Detail = val
 }
 
-// GetSystemDescription returns the value of SystemDescription.
-func (s *LLDP) GetSystemDescription() OptString {
-	return s.SystemDescription
-}
-
-// GetSystemName returns the value of SystemName.
-func (s *LLDP) GetSystemName() OptString {
-	return s.SystemName
+// SetTitle sets the value of Title.
+func (s *GenericResponse) SetTitle(val OptString) {
+	s.Title = val
 }
 
-// SetChassisID sets the value of ChassisID.
-func (s *LLDP) SetChassisID(val OptString) {
-	s.ChassisID = val
+// GetRolesResponse schema.
+// Ref: #/components/schemas/GetRolesResponse
+type GetRolesResponse struct {
+	Roles []GetRolesResponseRolesItem `json:"roles"`
 }
 
-// SetChassisIDType sets the value of ChassisIDType.
-func (s *LLDP) SetChassisIDType(val OptString) {
-	s.ChassisIDType = val
+// GetRoles returns the value of Roles.
+func (s *GetRolesResponse) GetRoles() []GetRolesResponseRolesItem {
+	return s.Roles
 }
 
-// SetManagementAddress sets the value of ManagementAddress.
-func (s *LLDP) SetManagementAddress(val OptString) {
-	s.ManagementAddress = val
+// SetRoles sets the value of Roles.
+func (s *GetRolesResponse) SetRoles(val []GetRolesResponseRolesItem) {
+	s.Roles = val
 }
 
-// SetPortDescription sets the value of PortDescription.
-func (s *LLDP) SetPortDescription(val OptString) {
-	s.PortDescription = val
+type GetRolesResponseRolesItem struct {
+	Name                     OptString                                               `json:"name"`
+	PermissionList           []GetRolesResponseRolesItemPermissionListItem           `json:"permission_list"`
+	UnassignedPermissionList []GetRolesResponseRolesItemUnassignedPermissionListItem `json:"unassigned_permission_list"`
 }
 
-// SetPortID sets the value of PortID.
-func (s *LLDP) SetPortID(val OptString) {
-	s.PortID = val
+// GetName returns the value of Name.
+func (s *GetRolesResponseRolesItem) GetName() OptString {
+	return s.Name
 }
 
-// SetPortIDType sets the value of PortIDType.
-func (s *LLDP) SetPortIDType(val OptString) {
-	s.PortIDType = val
+// GetPermissionList returns the value of PermissionList.
+func (s *GetRolesResponseRolesItem) GetPermissionList() []GetRolesResponseRolesItemPermissionListItem {
+	return s.PermissionList
 }
 
-// SetPortName sets the value of PortName.
-func (s *LLDP) SetPortName(val OptString) {
-	s.PortName = val
+// GetUnassignedPermissionList returns the value of UnassignedPermissionList.
+func (s *GetRolesResponseRolesItem) GetUnassignedPermissionList() []GetRolesResponseRolesItemUnassignedPermissionListItem {
+	return s.UnassignedPermissionList
 }
 
-// SetSystemDescription sets the value of SystemDescription.
-func (s *LLDP) SetSystemDescription(val OptString) {
-	s.SystemDescription = val
+// SetName sets the value of Name.
+func (s *GetRolesResponseRolesItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetSystemName sets the value of SystemName.
-func (s *LLDP) SetSystemName(val OptString) {
-	s.SystemName = val
+// SetPermissionList sets the value of PermissionList.
+func (s *GetRolesResponseRolesItem) SetPermissionList(val []GetRolesResponseRolesItemPermissionListItem) {
+	s.PermissionList = val
 }
 
-// NewNilBootImageAddRequestBootImagesItem returns new NilBootImageAddRequestBootImagesItem with value set to v.
-func NewNilBootImageAddRequestBootImagesItem(v BootImageAddRequestBootImagesItem) NilBootImageAddRequestBootImagesItem {
-	return NilBootImageAddRequestBootImagesItem{
-		Value: v,
-	}
+// SetUnassignedPermissionList sets the value of UnassignedPermissionList.
+func (s *GetRolesResponseRolesItem) SetUnassignedPermissionList(val []GetRolesResponseRolesItemUnassignedPermissionListItem) {
+	s.UnassignedPermissionList = val
 }
 
-// NilBootImageAddRequestBootImagesItem is nullable BootImageAddRequestBootImagesItem.
-type NilBootImageAddRequestBootImagesItem struct {
-	Value BootImageAddRequestBootImagesItem
-	Null  bool
+type GetRolesResponseRolesItemPermissionListItem struct {
+	Method OptString `json:"method"`
+	Path   OptString `json:"path"`
 }
 
-// SetTo sets value to v.
-func (o *NilBootImageAddRequestBootImagesItem) SetTo(v BootImageAddRequestBootImagesItem) {
-	o.Null = false
-	o.Value = v
+// GetMethod returns the value of Method.
+func (s *GetRolesResponseRolesItemPermissionListItem) GetMethod() OptString {
+	return s.Method
 }
 
-// IsSet returns true if value is Null.
-func (o NilBootImageAddRequestBootImagesItem) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilBootImageAddRequestBootImagesItem) SetToNull() {
-	o.Null = true
-	var v BootImageAddRequestBootImagesItem
-	o.Value = v
+// GetPath returns the value of Path.
+func (s *GetRolesResponseRolesItemPermissionListItem) GetPath() OptString {
+	return s.Path
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilBootImageAddRequestBootImagesItem) Get() (v BootImageAddRequestBootImagesItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetMethod sets the value of Method.
+func (s *GetRolesResponseRolesItemPermissionListItem) SetMethod(val OptString) {
+	s.Method = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilBootImageAddRequestBootImagesItem) Or(d BootImageAddRequestBootImagesItem) BootImageAddRequestBootImagesItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetPath sets the value of Path.
+func (s *GetRolesResponseRolesItemPermissionListItem) SetPath(val OptString) {
+	s.Path = val
 }
 
-// NewNilDataDumpHostsItem returns new NilDataDumpHostsItem with value set to v.
-func NewNilDataDumpHostsItem(v DataDumpHostsItem) NilDataDumpHostsItem {
-	return NilDataDumpHostsItem{
-		Value: v,
-	}
+type GetRolesResponseRolesItemUnassignedPermissionListItem struct {
+	Method OptString `json:"method"`
+	Path   OptString `json:"path"`
 }
 
-// NilDataDumpHostsItem is nullable DataDumpHostsItem.
-type NilDataDumpHostsItem struct {
-	Value DataDumpHostsItem
-	Null  bool
+// GetMethod returns the value of Method.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) GetMethod() OptString {
+	return s.Method
 }
 
-// SetTo sets value to v.
-func (o *NilDataDumpHostsItem) SetTo(v DataDumpHostsItem) {
-	o.Null = false
-	o.Value = v
+// GetPath returns the value of Path.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) GetPath() OptString {
+	return s.Path
 }
 
-// IsSet returns true if value is Null.
-func (o NilDataDumpHostsItem) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilDataDumpHostsItem) SetToNull() {
-	o.Null = true
-	var v DataDumpHostsItem
-	o.Value = v
+// SetMethod sets the value of Method.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) SetMethod(val OptString) {
+	s.Method = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilDataDumpHostsItem) Get() (v DataDumpHostsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetPath sets the value of Path.
+func (s *GetRolesResponseRolesItemUnassignedPermissionListItem) SetPath(val OptString) {
+	s.Path = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilDataDumpHostsItem) Or(d DataDumpHostsItem) DataDumpHostsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GrendelStatus schema.
+// Ref: #/components/schemas/GrendelStatus
+type GrendelStatus struct {
+	Active         OptBool     `json:"active"`
+	Ha             OptBool     `json:"ha"`
+	Hostname       OptString   `json:"hostname"`
+	ID             OptString   `json:"id"`
+	Leader         OptString   `json:"leader"`
+	LeaderSince    OptDateTime `json:"leader_since"`
+	LeaseExpiresAt OptDateTime `json:"lease_expires_at"`
+	Role           OptString   `json:"role"`
+	Version        OptString   `json:"version"`
 }
 
-// NewNilDataDumpHostsItemBondsItem returns new NilDataDumpHostsItemBondsItem with value set to v.
-func NewNilDataDumpHostsItemBondsItem(v DataDumpHostsItemBondsItem) NilDataDumpHostsItemBondsItem {
-	return NilDataDumpHostsItemBondsItem{
-		Value: v,
-	}
+// GetActive returns the value of Active.
+func (s *GrendelStatus) GetActive() OptBool {
+	return s.Active
 }
 
-// NilDataDumpHostsItemBondsItem is nullable DataDumpHostsItemBondsItem.
-type NilDataDumpHostsItemBondsItem struct {
-	Value DataDumpHostsItemBondsItem
-	Null  bool
+// GetHa returns the value of Ha.
+func (s *GrendelStatus) GetHa() OptBool {
+	return s.Ha
 }
 
-// SetTo sets value to v.
-func (o *NilDataDumpHostsItemBondsItem) SetTo(v DataDumpHostsItemBondsItem) {
-	o.Null = false
-	o.Value = v
+// GetHostname returns the value of Hostname.
+func (s *GrendelStatus) GetHostname() OptString {
+	return s.Hostname
 }
 
-// IsSet returns true if value is Null.
-func (o NilDataDumpHostsItemBondsItem) IsNull() bool { return o.Null }
+// GetID returns the value of ID.
+func (s *GrendelStatus) GetID() OptString {
+	return s.ID
+}
 
-// SetNull sets value to null.
-func (o *NilDataDumpHostsItemBondsItem) SetToNull() {
-	o.Null = true
-	var v DataDumpHostsItemBondsItem
-	o.Value = v
+// GetLeader returns the value of Leader.
+func (s *GrendelStatus) GetLeader() OptString {
+	return s.Leader
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilDataDumpHostsItemBondsItem) Get() (v DataDumpHostsItemBondsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetLeaderSince returns the value of LeaderSince.
+func (s *GrendelStatus) GetLeaderSince() OptDateTime {
+	return s.LeaderSince
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilDataDumpHostsItemBondsItem) Or(d DataDumpHostsItemBondsItem) DataDumpHostsItemBondsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetLeaseExpiresAt returns the value of LeaseExpiresAt.
+func (s *GrendelStatus) GetLeaseExpiresAt() OptDateTime {
+	return s.LeaseExpiresAt
 }
 
-// NewNilDataDumpHostsItemInterfacesItem returns new NilDataDumpHostsItemInterfacesItem with value set to v.
-func NewNilDataDumpHostsItemInterfacesItem(v DataDumpHostsItemInterfacesItem) NilDataDumpHostsItemInterfacesItem {
-	return NilDataDumpHostsItemInterfacesItem{
-		Value: v,
-	}
+// GetRole returns the value of Role.
+func (s *GrendelStatus) GetRole() OptString {
+	return s.Role
 }
 
-// NilDataDumpHostsItemInterfacesItem is nullable DataDumpHostsItemInterfacesItem.
-type NilDataDumpHostsItemInterfacesItem struct {
-	Value DataDumpHostsItemInterfacesItem
-	Null  bool
+// GetVersion returns the value of Version.
+func (s *GrendelStatus) GetVersion() OptString {
+	return s.Version
 }
 
-// SetTo sets value to v.
-func (o *NilDataDumpHostsItemInterfacesItem) SetTo(v DataDumpHostsItemInterfacesItem) {
-	o.Null = false
-	o.Value = v
+// SetActive sets the value of Active.
+func (s *GrendelStatus) SetActive(val OptBool) {
+	s.Active = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilDataDumpHostsItemInterfacesItem) IsNull() bool { return o.Null }
+// SetHa sets the value of Ha.
+func (s *GrendelStatus) SetHa(val OptBool) {
+	s.Ha = val
+}
 
-// SetNull sets value to null.
-func (o *NilDataDumpHostsItemInterfacesItem) SetToNull() {
-	o.Null = true
-	var v DataDumpHostsItemInterfacesItem
-	o.Value = v
+// SetHostname sets the value of Hostname.
+func (s *GrendelStatus) SetHostname(val OptString) {
+	s.Hostname = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilDataDumpHostsItemInterfacesItem) Get() (v DataDumpHostsItemInterfacesItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetID sets the value of ID.
+func (s *GrendelStatus) SetID(val OptString) {
+	s.ID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilDataDumpHostsItemInterfacesItem) Or(d DataDumpHostsItemInterfacesItem) DataDumpHostsItemInterfacesItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetLeader sets the value of Leader.
+func (s *GrendelStatus) SetLeader(val OptString) {
+	s.Leader = val
 }
 
-// NewNilDataDumpImagesItem returns new NilDataDumpImagesItem with value set to v.
-func NewNilDataDumpImagesItem(v DataDumpImagesItem) NilDataDumpImagesItem {
-	return NilDataDumpImagesItem{
-		Value: v,
-	}
+// SetLeaderSince sets the value of LeaderSince.
+func (s *GrendelStatus) SetLeaderSince(val OptDateTime) {
+	s.LeaderSince = val
 }
 
-// NilDataDumpImagesItem is nullable DataDumpImagesItem.
-type NilDataDumpImagesItem struct {
-	Value DataDumpImagesItem
-	Null  bool
+// SetLeaseExpiresAt sets the value of LeaseExpiresAt.
+func (s *GrendelStatus) SetLeaseExpiresAt(val OptDateTime) {
+	s.LeaseExpiresAt = val
 }
 
-// SetTo sets value to v.
-func (o *NilDataDumpImagesItem) SetTo(v DataDumpImagesItem) {
-	o.Null = false
-	o.Value = v
+// SetRole sets the value of Role.
+func (s *GrendelStatus) SetRole(val OptString) {
+	s.Role = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilDataDumpImagesItem) IsNull() bool { return o.Null }
+// SetVersion sets the value of Version.
+func (s *GrendelStatus) SetVersion(val OptString) {
+	s.Version = val
+}
 
-// SetNull sets value to null.
-func (o *NilDataDumpImagesItem) SetToNull() {
-	o.Null = true
-	var v DataDumpImagesItem
-	o.Value = v
+// HTTPError schema.
+// Ref: #/components/schemas/HTTPError
+type HTTPError struct {
+	// Human readable error message.
+	Detail   OptNilString                   `json:"detail"`
+	Errors   OptNilHTTPErrorErrorsItemArray `json:"errors"`
+	Instance OptNilString                   `json:"instance"`
+	// HTTP status code.
+	Status OptNilInt `json:"status"`
+	// Short title of the error.
+	Title OptNilString `json:"title"`
+	// URL of the error type. Can be used to lookup the error in a documentation.
+	Type OptNilString `json:"type"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilDataDumpImagesItem) Get() (v DataDumpImagesItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetDetail returns the value of Detail.
+func (s *HTTPError) GetDetail() OptNilString {
+	return s.Detail
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilDataDumpImagesItem) Or(d DataDumpImagesItem) DataDumpImagesItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetErrors returns the value of Errors.
+func (s *HTTPError) GetErrors() OptNilHTTPErrorErrorsItemArray {
+	return s.Errors
 }
 
-// NewNilHostBondsItem returns new NilHostBondsItem with value set to v.
-func NewNilHostBondsItem(v HostBondsItem) NilHostBondsItem {
-	return NilHostBondsItem{
-		Value: v,
-	}
+// GetInstance returns the value of Instance.
+func (s *HTTPError) GetInstance() OptNilString {
+	return s.Instance
 }
 
-// NilHostBondsItem is nullable HostBondsItem.
-type NilHostBondsItem struct {
-	Value HostBondsItem
-	Null  bool
+// GetStatus returns the value of Status.
+func (s *HTTPError) GetStatus() OptNilInt {
+	return s.Status
 }
 
-// SetTo sets value to v.
-func (o *NilHostBondsItem) SetTo(v HostBondsItem) {
-	o.Null = false
-	o.Value = v
+// GetTitle returns the value of Title.
+func (s *HTTPError) GetTitle() OptNilString {
+	return s.Title
 }
 
-// IsSet returns true if value is Null.
-func (o NilHostBondsItem) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilHostBondsItem) SetToNull() {
-	o.Null = true
-	var v HostBondsItem
-	o.Value = v
+// GetType returns the value of Type.
+func (s *HTTPError) GetType() OptNilString {
+	return s.Type
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilHostBondsItem) Get() (v HostBondsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetDetail sets the value of Detail.
+func (s *HTTPError) SetDetail(val OptNilString) {
+	s.Detail = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilHostBondsItem) Or(d HostBondsItem) HostBondsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetErrors sets the value of Errors.
+func (s *HTTPError) SetErrors(val OptNilHTTPErrorErrorsItemArray) {
+	s.Errors = val
 }
 
-// NewNilHostInterfacesItem returns new NilHostInterfacesItem with value set to v.
-func NewNilHostInterfacesItem(v HostInterfacesItem) NilHostInterfacesItem {
-	return NilHostInterfacesItem{
-		Value: v,
-	}
+// SetInstance sets the value of Instance.
+func (s *HTTPError) SetInstance(val OptNilString) {
+	s.Instance = val
 }
 
-// NilHostInterfacesItem is nullable HostInterfacesItem.
-type NilHostInterfacesItem struct {
-	Value HostInterfacesItem
-	Null  bool
+// SetStatus sets the value of Status.
+func (s *HTTPError) SetStatus(val OptNilInt) {
+	s.Status = val
 }
 
-// SetTo sets value to v.
-func (o *NilHostInterfacesItem) SetTo(v HostInterfacesItem) {
-	o.Null = false
-	o.Value = v
+// SetTitle sets the value of Title.
+func (s *HTTPError) SetTitle(val OptNilString) {
+	s.Title = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilHostInterfacesItem) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilHostInterfacesItem) SetToNull() {
-	o.Null = true
-	var v HostInterfacesItem
-	o.Value = v
+// SetType sets the value of Type.
+func (s *HTTPError) SetType(val OptNilString) {
+	s.Type = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilHostInterfacesItem) Get() (v HostInterfacesItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+type HTTPErrorErrorsItem struct {
+	More   OptHTTPErrorErrorsItemMore `json:"more"`
+	Name   OptString                  `json:"name"`
+	Reason OptString                  `json:"reason"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilHostInterfacesItem) Or(d HostInterfacesItem) HostInterfacesItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetMore returns the value of More.
+func (s *HTTPErrorErrorsItem) GetMore() OptHTTPErrorErrorsItemMore {
+	return s.More
 }
 
-// NewNilInt returns new NilInt with value set to v.
-func NewNilInt(v int) NilInt {
-	return NilInt{
-		Value: v,
-	}
+// GetName returns the value of Name.
+func (s *HTTPErrorErrorsItem) GetName() OptString {
+	return s.Name
 }
 
-// NilInt is nullable int.
-type NilInt struct {
-	Value int
-	Null  bool
+// GetReason returns the value of Reason.
+func (s *HTTPErrorErrorsItem) GetReason() OptString {
+	return s.Reason
 }
 
-// SetTo sets value to v.
-func (o *NilInt) SetTo(v int) {
-	o.Null = false
-	o.Value = v
+// SetMore sets the value of More.
+func (s *HTTPErrorErrorsItem) SetMore(val OptHTTPErrorErrorsItemMore) {
+	s.More = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilInt) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilInt) SetToNull() {
-	o.Null = true
-	var v int
-	o.Value = v
+// SetName sets the value of Name.
+func (s *HTTPErrorErrorsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilInt) Get() (v int, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetReason sets the value of Reason.
+func (s *HTTPErrorErrorsItem) SetReason(val OptString) {
+	s.Reason = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
-}
+type HTTPErrorErrorsItemMore map[string]jx.Raw
 
-// NewNilNodeAddRequestNodeListItem returns new NilNodeAddRequestNodeListItem with value set to v.
-func NewNilNodeAddRequestNodeListItem(v NodeAddRequestNodeListItem) NilNodeAddRequestNodeListItem {
-	return NilNodeAddRequestNodeListItem{
-		Value: v,
+func (s *HTTPErrorErrorsItemMore) init() HTTPErrorErrorsItemMore {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
+	return m
 }
 
-// NilNodeAddRequestNodeListItem is nullable NodeAddRequestNodeListItem.
-type NilNodeAddRequestNodeListItem struct {
-	Value NodeAddRequestNodeListItem
-	Null  bool
+// HTTPErrorStatusCode wraps HTTPError with StatusCode.
+type HTTPErrorStatusCode struct {
+	StatusCode int
+	Response   HTTPError
 }
 
-// SetTo sets value to v.
-func (o *NilNodeAddRequestNodeListItem) SetTo(v NodeAddRequestNodeListItem) {
-	o.Null = false
-	o.Value = v
+// GetStatusCode returns the value of StatusCode.
+func (s *HTTPErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
 }
 
-// IsSet returns true if value is Null.
-func (o NilNodeAddRequestNodeListItem) IsNull() bool { return o.Null }
+// GetResponse returns the value of Response.
+func (s *HTTPErrorStatusCode) GetResponse() HTTPError {
+	return s.Response
+}
 
-// SetNull sets value to null.
-func (o *NilNodeAddRequestNodeListItem) SetToNull() {
-	o.Null = true
-	var v NodeAddRequestNodeListItem
-	o.Value = v
+// SetStatusCode sets the value of StatusCode.
+func (s *HTTPErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilNodeAddRequestNodeListItem) Get() (v NodeAddRequestNodeListItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetResponse sets the value of Response.
+func (s *HTTPErrorStatusCode) SetResponse(val HTTPError) {
+	s.Response = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilNodeAddRequestNodeListItem) Or(d NodeAddRequestNodeListItem) NodeAddRequestNodeListItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+type HeaderAuth struct {
+	Token string
 }
 
-// NewNilNodeAddRequestNodeListItemBondsItem returns new NilNodeAddRequestNodeListItemBondsItem with value set to v.
-func NewNilNodeAddRequestNodeListItemBondsItem(v NodeAddRequestNodeListItemBondsItem) NilNodeAddRequestNodeListItemBondsItem {
-	return NilNodeAddRequestNodeListItemBondsItem{
-		Value: v,
-	}
+// GetToken returns the value of Token.
+func (s *HeaderAuth) GetToken() string {
+	return s.Token
 }
 
-// NilNodeAddRequestNodeListItemBondsItem is nullable NodeAddRequestNodeListItemBondsItem.
-type NilNodeAddRequestNodeListItemBondsItem struct {
-	Value NodeAddRequestNodeListItemBondsItem
-	Null  bool
+// SetToken sets the value of Token.
+func (s *HeaderAuth) SetToken(val string) {
+	s.Token = val
 }
 
-// SetTo sets value to v.
-func (o *NilNodeAddRequestNodeListItemBondsItem) SetTo(v NodeAddRequestNodeListItemBondsItem) {
-	o.Null = false
-	o.Value = v
+// Host schema.
+// Ref: #/components/schemas/Host
+type Host struct {
+	Bonds      []NilHostBondsItem      `json:"bonds"`
+	BootImage  OptString               `json:"boot_image"`
+	Firmware   OptString               `json:"firmware"`
+	ID         OptNilInt64             `json:"id"`
+	Interfaces []NilHostInterfacesItem `json:"interfaces"`
+	Name       OptString               `json:"name"`
+	Provision  OptBool                 `json:"provision"`
+	Tags       OptNilStringArray       `json:"tags"`
+	UID        OptNilString            `json:"uid"`
 }
 
-// IsSet returns true if value is Null.
-func (o NilNodeAddRequestNodeListItemBondsItem) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilNodeAddRequestNodeListItemBondsItem) SetToNull() {
-	o.Null = true
-	var v NodeAddRequestNodeListItemBondsItem
-	o.Value = v
+// GetBonds returns the value of Bonds.
+func (s *Host) GetBonds() []NilHostBondsItem {
+	return s.Bonds
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilNodeAddRequestNodeListItemBondsItem) Get() (v NodeAddRequestNodeListItemBondsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetBootImage returns the value of BootImage.
+func (s *Host) GetBootImage() OptString {
+	return s.BootImage
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilNodeAddRequestNodeListItemBondsItem) Or(d NodeAddRequestNodeListItemBondsItem) NodeAddRequestNodeListItemBondsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetFirmware returns the value of Firmware.
+func (s *Host) GetFirmware() OptString {
+	return s.Firmware
 }
 
-// NewNilNodeAddRequestNodeListItemInterfacesItem returns new NilNodeAddRequestNodeListItemInterfacesItem with value set to v.
-func NewNilNodeAddRequestNodeListItemInterfacesItem(v NodeAddRequestNodeListItemInterfacesItem) NilNodeAddRequestNodeListItemInterfacesItem {
-	return NilNodeAddRequestNodeListItemInterfacesItem{
-		Value: v,
-	}
+// GetID returns the value of ID.
+func (s *Host) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// NilNodeAddRequestNodeListItemInterfacesItem is nullable NodeAddRequestNodeListItemInterfacesItem.
-type NilNodeAddRequestNodeListItemInterfacesItem struct {
-	Value NodeAddRequestNodeListItemInterfacesItem
-	Null  bool
+// GetInterfaces returns the value of Interfaces.
+func (s *Host) GetInterfaces() []NilHostInterfacesItem {
+	return s.Interfaces
 }
 
-// SetTo sets value to v.
-func (o *NilNodeAddRequestNodeListItemInterfacesItem) SetTo(v NodeAddRequestNodeListItemInterfacesItem) {
-	o.Null = false
-	o.Value = v
+// GetName returns the value of Name.
+func (s *Host) GetName() OptString {
+	return s.Name
 }
 
-// IsSet returns true if value is Null.
-func (o NilNodeAddRequestNodeListItemInterfacesItem) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilNodeAddRequestNodeListItemInterfacesItem) SetToNull() {
-	o.Null = true
-	var v NodeAddRequestNodeListItemInterfacesItem
-	o.Value = v
+// GetProvision returns the value of Provision.
+func (s *Host) GetProvision() OptBool {
+	return s.Provision
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilNodeAddRequestNodeListItemInterfacesItem) Get() (v NodeAddRequestNodeListItemInterfacesItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetTags returns the value of Tags.
+func (s *Host) GetTags() OptNilStringArray {
+	return s.Tags
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilNodeAddRequestNodeListItemInterfacesItem) Or(d NodeAddRequestNodeListItemInterfacesItem) NodeAddRequestNodeListItemInterfacesItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetUID returns the value of UID.
+func (s *Host) GetUID() OptNilString {
+	return s.UID
 }
 
-// NewNilRedfishJobJobsItem returns new NilRedfishJobJobsItem with value set to v.
-func NewNilRedfishJobJobsItem(v RedfishJobJobsItem) NilRedfishJobJobsItem {
-	return NilRedfishJobJobsItem{
-		Value: v,
-	}
+// SetBonds sets the value of Bonds.
+func (s *Host) SetBonds(val []NilHostBondsItem) {
+	s.Bonds = val
 }
 
-// NilRedfishJobJobsItem is nullable RedfishJobJobsItem.
-type NilRedfishJobJobsItem struct {
-	Value RedfishJobJobsItem
-	Null  bool
+// SetBootImage sets the value of BootImage.
+func (s *Host) SetBootImage(val OptString) {
+	s.BootImage = val
 }
 
-// SetTo sets value to v.
-func (o *NilRedfishJobJobsItem) SetTo(v RedfishJobJobsItem) {
-	o.Null = false
-	o.Value = v
+// SetFirmware sets the value of Firmware.
+func (s *Host) SetFirmware(val OptString) {
+	s.Firmware = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilRedfishJobJobsItem) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilRedfishJobJobsItem) SetToNull() {
-	o.Null = true
-	var v RedfishJobJobsItem
-	o.Value = v
+// SetID sets the value of ID.
+func (s *Host) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilRedfishJobJobsItem) Get() (v RedfishJobJobsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetInterfaces sets the value of Interfaces.
+func (s *Host) SetInterfaces(val []NilHostInterfacesItem) {
+	s.Interfaces = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilRedfishJobJobsItem) Or(d RedfishJobJobsItem) RedfishJobJobsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetName sets the value of Name.
+func (s *Host) SetName(val OptString) {
+	s.Name = val
 }
 
-// NewNilRedfishMetricReportReportsItem returns new NilRedfishMetricReportReportsItem with value set to v.
-func NewNilRedfishMetricReportReportsItem(v RedfishMetricReportReportsItem) NilRedfishMetricReportReportsItem {
-	return NilRedfishMetricReportReportsItem{
-		Value: v,
-	}
+// SetProvision sets the value of Provision.
+func (s *Host) SetProvision(val OptBool) {
+	s.Provision = val
 }
 
-// NilRedfishMetricReportReportsItem is nullable RedfishMetricReportReportsItem.
-type NilRedfishMetricReportReportsItem struct {
-	Value RedfishMetricReportReportsItem
-	Null  bool
+// SetTags sets the value of Tags.
+func (s *Host) SetTags(val OptNilStringArray) {
+	s.Tags = val
 }
 
-// SetTo sets value to v.
-func (o *NilRedfishMetricReportReportsItem) SetTo(v RedfishMetricReportReportsItem) {
-	o.Null = false
-	o.Value = v
+// SetUID sets the value of UID.
+func (s *Host) SetUID(val OptNilString) {
+	s.UID = val
 }
 
-// IsSet returns true if value is Null.
-func (o NilRedfishMetricReportReportsItem) IsNull() bool { return o.Null }
-
-// SetNull sets value to null.
-func (o *NilRedfishMetricReportReportsItem) SetToNull() {
-	o.Null = true
-	var v RedfishMetricReportReportsItem
-	o.Value = v
+type HostBondsItem struct {
+	Bmc        OptBool     `json:"bmc"`
+	Fqdn       OptString   `json:"fqdn"`
+	ID         OptNilInt64 `json:"id"`
+	Ifname     OptString   `json:"ifname"`
+	IP         OptString   `json:"ip"`
+	Ip6        OptString   `json:"ip6"`
+	MAC        OptString   `json:"mac"`
+	Mtu        OptInt      `json:"mtu"`
+	Peers      []string    `json:"peers"`
+	Switch     OptString   `json:"switch"`
+	SwitchPort OptString   `json:"switch_port"`
+	Vlan       OptString   `json:"vlan"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilRedfishMetricReportReportsItem) Get() (v RedfishMetricReportReportsItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetBmc returns the value of Bmc.
+func (s *HostBondsItem) GetBmc() OptBool {
+	return s.Bmc
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilRedfishMetricReportReportsItem) Or(d RedfishMetricReportReportsItem) RedfishMetricReportReportsItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetFqdn returns the value of Fqdn.
+func (s *HostBondsItem) GetFqdn() OptString {
+	return s.Fqdn
 }
 
-// NewNilString returns new NilString with value set to v.
-func NewNilString(v string) NilString {
-	return NilString{
-		Value: v,
-	}
+// GetID returns the value of ID.
+func (s *HostBondsItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// NilString is nullable string.
-type NilString struct {
-	Value string
-	Null  bool
+// GetIfname returns the value of Ifname.
+func (s *HostBondsItem) GetIfname() OptString {
+	return s.Ifname
 }
 
-// SetTo sets value to v.
-func (o *NilString) SetTo(v string) {
-	o.Null = false
-	o.Value = v
+// GetIP returns the value of IP.
+func (s *HostBondsItem) GetIP() OptString {
+	return s.IP
 }
 
-// IsSet returns true if value is Null.
-func (o NilString) IsNull() bool { return o.Null }
+// GetIp6 returns the value of Ip6.
+func (s *HostBondsItem) GetIp6() OptString {
+	return s.Ip6
+}
 
-// SetNull sets value to null.
-func (o *NilString) SetToNull() {
-	o.Null = true
-	var v string
-	o.Value = v
+// GetMAC returns the value of MAC.
+func (s *HostBondsItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// GetMtu returns the value of Mtu.
+func (s *HostBondsItem) GetMtu() OptInt {
+	return s.Mtu
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetPeers returns the value of Peers.
+func (s *HostBondsItem) GetPeers() []string {
+	return s.Peers
 }
 
-// NodeAddRequest schema.
-// Ref: #/components/schemas/NodeAddRequest
-type NodeAddRequest struct {
-	NodeList []NilNodeAddRequestNodeListItem `json:"node_list"`
+// GetSwitch returns the value of Switch.
+func (s *HostBondsItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// GetNodeList returns the value of NodeList.
-func (s *NodeAddRequest) GetNodeList() []NilNodeAddRequestNodeListItem {
-	return s.NodeList
+// GetSwitchPort returns the value of SwitchPort.
+func (s *HostBondsItem) GetSwitchPort() OptString {
+	return s.SwitchPort
 }
 
-// SetNodeList sets the value of NodeList.
-func (s *NodeAddRequest) SetNodeList(val []NilNodeAddRequestNodeListItem) {
-	s.NodeList = val
-}
-
-type NodeAddRequestNodeListItem struct {
-	Bonds      []NilNodeAddRequestNodeListItemBondsItem      `json:"bonds"`
-	BootImage  OptString                                     `json:"boot_image"`
-	Firmware   OptString                                     `json:"firmware"`
-	ID         OptNilInt64                                   `json:"id"`
-	Interfaces []NilNodeAddRequestNodeListItemInterfacesItem `json:"interfaces"`
-	Name       OptString                                     `json:"name"`
-	Provision  OptBool                                       `json:"provision"`
-	Tags       OptNilStringArray                             `json:"tags"`
-	UID        OptNilString                                  `json:"uid"`
-}
-
-// GetBonds returns the value of Bonds.
-func (s *NodeAddRequestNodeListItem) GetBonds() []NilNodeAddRequestNodeListItemBondsItem {
-	return s.Bonds
-}
-
-// GetBootImage returns the value of BootImage.
-func (s *NodeAddRequestNodeListItem) GetBootImage() OptString {
-	return s.BootImage
-}
-
-// GetFirmware returns the value of Firmware.
-func (s *NodeAddRequestNodeListItem) GetFirmware() OptString {
-	return s.Firmware
-}
-
-// GetID returns the value of ID.
-func (s *NodeAddRequestNodeListItem) GetID() OptNilInt64 {
-	return s.ID
-}
-
-// GetInterfaces returns the value of Interfaces.
-func (s *NodeAddRequestNodeListItem) GetInterfaces() []NilNodeAddRequestNodeListItemInterfacesItem {
-	return s.Interfaces
-}
-
-// GetName returns the value of Name.
-func (s *NodeAddRequestNodeListItem) GetName() OptString {
-	return s.Name
+// GetVlan returns the value of Vlan.
+func (s *HostBondsItem) GetVlan() OptString {
+	return s.Vlan
 }
 
-// GetProvision returns the value of Provision.
-func (s *NodeAddRequestNodeListItem) GetProvision() OptBool {
-	return s.Provision
+// SetBmc sets the value of Bmc.
+func (s *HostBondsItem) SetBmc(val OptBool) {
+	s.Bmc = val
 }
 
-// GetTags returns the value of Tags.
-func (s *NodeAddRequestNodeListItem) GetTags() OptNilStringArray {
-	return s.Tags
+// SetFqdn sets the value of Fqdn.
+func (s *HostBondsItem) SetFqdn(val OptString) {
+	s.Fqdn = val
 }
 
-// GetUID returns the value of UID.
-func (s *NodeAddRequestNodeListItem) GetUID() OptNilString {
-	return s.UID
+// SetID sets the value of ID.
+func (s *HostBondsItem) SetID(val OptNilInt64) {
+	s.ID = val
 }
 
-// SetBonds sets the value of Bonds.
-func (s *NodeAddRequestNodeListItem) SetBonds(val []NilNodeAddRequestNodeListItemBondsItem) {
-	s.Bonds = val
+// SetIfname sets the value of Ifname.
+func (s *HostBondsItem) SetIfname(val OptString) {
+	s.Ifname = val
 }
 
-// SetBootImage sets the value of BootImage.
-func (s *NodeAddRequestNodeListItem) SetBootImage(val OptString) {
-	s.BootImage = val
+// SetIP sets the value of IP.
+func (s *HostBondsItem) SetIP(val OptString) {
+	s.IP = val
 }
 
-// SetFirmware sets the value of Firmware.
-func (s *NodeAddRequestNodeListItem) SetFirmware(val OptString) {
-	s.Firmware = val
+// SetIp6 sets the value of Ip6.
+func (s *HostBondsItem) SetIp6(val OptString) {
+	s.Ip6 = val
 }
 
-// SetID sets the value of ID.
-func (s *NodeAddRequestNodeListItem) SetID(val OptNilInt64) {
-	s.ID = val
+// SetMAC sets the value of MAC.
+func (s *HostBondsItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// SetInterfaces sets the value of Interfaces.
-func (s *NodeAddRequestNodeListItem) SetInterfaces(val []NilNodeAddRequestNodeListItemInterfacesItem) {
-	s.Interfaces = val
+// SetMtu sets the value of Mtu.
+func (s *HostBondsItem) SetMtu(val OptInt) {
+	s.Mtu = val
 }
 
-// SetName sets the value of Name.
-func (s *NodeAddRequestNodeListItem) SetName(val OptString) {
-	s.Name = val
+// SetPeers sets the value of Peers.
+func (s *HostBondsItem) SetPeers(val []string) {
+	s.Peers = val
 }
 
-// SetProvision sets the value of Provision.
-func (s *NodeAddRequestNodeListItem) SetProvision(val OptBool) {
-	s.Provision = val
+// SetSwitch sets the value of Switch.
+func (s *HostBondsItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// SetTags sets the value of Tags.
-func (s *NodeAddRequestNodeListItem) SetTags(val OptNilStringArray) {
-	s.Tags = val
+// SetSwitchPort sets the value of SwitchPort.
+func (s *HostBondsItem) SetSwitchPort(val OptString) {
+	s.SwitchPort = val
 }
 
-// SetUID sets the value of UID.
-func (s *NodeAddRequestNodeListItem) SetUID(val OptNilString) {
-	s.UID = val
+// SetVlan sets the value of Vlan.
+func (s *HostBondsItem) SetVlan(val OptString) {
+	s.Vlan = val
 }
 
-type NodeAddRequestNodeListItemBondsItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Peers  []string    `json:"peers"`
-	Vlan   OptString   `json:"vlan"`
+type HostInterfacesItem struct {
+	Bmc        OptBool     `json:"bmc"`
+	Fqdn       OptString   `json:"fqdn"`
+	ID         OptNilInt64 `json:"id"`
+	Ifname     OptString   `json:"ifname"`
+	IP         OptString   `json:"ip"`
+	Ip6        OptString   `json:"ip6"`
+	MAC        OptString   `json:"mac"`
+	Mtu        OptInt      `json:"mtu"`
+	Switch     OptString   `json:"switch"`
+	SwitchPort OptString   `json:"switch_port"`
+	Vlan       OptString   `json:"vlan"`
 }
 
 // GetBmc returns the value of Bmc.
-func (s *NodeAddRequestNodeListItemBondsItem) GetBmc() OptBool {
+func (s *HostInterfacesItem) GetBmc() OptBool {
 	return s.Bmc
 }
 
 // GetFqdn returns the value of Fqdn.
-func (s *NodeAddRequestNodeListItemBondsItem) GetFqdn() OptString {
+func (s *HostInterfacesItem) GetFqdn() OptString {
 	return s.Fqdn
 }
 
 // GetID returns the value of ID.
-func (s *NodeAddRequestNodeListItemBondsItem) GetID() OptNilInt64 {
+func (s *HostInterfacesItem) GetID() OptNilInt64 {
 	return s.ID
 }
 
 // GetIfname returns the value of Ifname.
-func (s *NodeAddRequestNodeListItemBondsItem) GetIfname() OptString {
+func (s *HostInterfacesItem) GetIfname() OptString {
 	return s.Ifname
 }
 
 // GetIP returns the value of IP.
-func (s *NodeAddRequestNodeListItemBondsItem) GetIP() OptString {
+func (s *HostInterfacesItem) GetIP() OptString {
 	return s.IP
 }
 
+// GetIp6 returns the value of Ip6.
+func (s *HostInterfacesItem) GetIp6() OptString {
+	return s.Ip6
+}
+
 // GetMAC returns the value of MAC.
-func (s *NodeAddRequestNodeListItemBondsItem) GetMAC() OptString {
+func (s *HostInterfacesItem) GetMAC() OptString {
 	return s.MAC
 }
 
 // GetMtu returns the value of Mtu.
-func (s *NodeAddRequestNodeListItemBondsItem) GetMtu() OptInt {
+func (s *HostInterfacesItem) GetMtu() OptInt {
 	return s.Mtu
 }
 
-// GetPeers returns the value of Peers.
-func (s *NodeAddRequestNodeListItemBondsItem) GetPeers() []string {
-	return s.Peers
+// GetSwitch returns the value of Switch.
+func (s *HostInterfacesItem) GetSwitch() OptString {
+	return s.Switch
+}
+
+// GetSwitchPort returns the value of SwitchPort.
+func (s *HostInterfacesItem) GetSwitchPort() OptString {
+	return s.SwitchPort
 }
 
 // GetVlan returns the value of Vlan.
-func (s *NodeAddRequestNodeListItemBondsItem) GetVlan() OptString {
+func (s *HostInterfacesItem) GetVlan() OptString {
 	return s.Vlan
 }
 
 // SetBmc sets the value of Bmc.
-func (s *NodeAddRequestNodeListItemBondsItem) SetBmc(val OptBool) {
+func (s *HostInterfacesItem) SetBmc(val OptBool) {
 	s.Bmc = val
 }
 
 // SetFqdn sets the value of Fqdn.
-func (s *NodeAddRequestNodeListItemBondsItem) SetFqdn(val OptString) {
+func (s *HostInterfacesItem) SetFqdn(val OptString) {
 	s.Fqdn = val
 }
 
 // SetID sets the value of ID.
-func (s *NodeAddRequestNodeListItemBondsItem) SetID(val OptNilInt64) {
+func (s *HostInterfacesItem) SetID(val OptNilInt64) {
 	s.ID = val
 }
 
 // SetIfname sets the value of Ifname.
-func (s *NodeAddRequestNodeListItemBondsItem) SetIfname(val OptString) {
+func (s *HostInterfacesItem) SetIfname(val OptString) {
 	s.Ifname = val
 }
 
 // SetIP sets the value of IP.
-func (s *NodeAddRequestNodeListItemBondsItem) SetIP(val OptString) {
+func (s *HostInterfacesItem) SetIP(val OptString) {
 	s.IP = val
 }
 
+// SetIp6 sets the value of Ip6.
+func (s *HostInterfacesItem) SetIp6(val OptString) {
+	s.Ip6 = val
+}
+
 // SetMAC sets the value of MAC.
-func (s *NodeAddRequestNodeListItemBondsItem) SetMAC(val OptString) {
+func (s *HostInterfacesItem) SetMAC(val OptString) {
 	s.MAC = val
 }
 
 // SetMtu sets the value of Mtu.
-func (s *NodeAddRequestNodeListItemBondsItem) SetMtu(val OptInt) {
+func (s *HostInterfacesItem) SetMtu(val OptInt) {
 	s.Mtu = val
 }
 
-// SetPeers sets the value of Peers.
-func (s *NodeAddRequestNodeListItemBondsItem) SetPeers(val []string) {
-	s.Peers = val
+// SetSwitch sets the value of Switch.
+func (s *HostInterfacesItem) SetSwitch(val OptString) {
+	s.Switch = val
+}
+
+// SetSwitchPort sets the value of SwitchPort.
+func (s *HostInterfacesItem) SetSwitchPort(val OptString) {
+	s.SwitchPort = val
 }
 
 // SetVlan sets the value of Vlan.
-func (s *NodeAddRequestNodeListItemBondsItem) SetVlan(val OptString) {
+func (s *HostInterfacesItem) SetVlan(val OptString) {
 	s.Vlan = val
 }
 
-type NodeAddRequestNodeListItemInterfacesItem struct {
-	Bmc    OptBool     `json:"bmc"`
-	Fqdn   OptString   `json:"fqdn"`
-	ID     OptNilInt64 `json:"id"`
-	Ifname OptString   `json:"ifname"`
-	IP     OptString   `json:"ip"`
-	MAC    OptString   `json:"mac"`
-	Mtu    OptInt      `json:"mtu"`
-	Vlan   OptString   `json:"vlan"`
+// InventoryChange schema.
+// Ref: #/components/schemas/InventoryChange
+type InventoryChange struct {
+	Change     OptString   `json:"change"`
+	DetectedAt OptDateTime `json:"detected_at"`
+	Host       OptString   `json:"host"`
+	ID         OptInt64    `json:"id"`
+	Kind       OptString   `json:"kind"`
+	New        OptString   `json:"new"`
+	Old        OptString   `json:"old"`
+	Slot       OptString   `json:"slot"`
 }
 
-// GetBmc returns the value of Bmc.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetBmc() OptBool {
-	return s.Bmc
+// GetChange returns the value of Change.
+func (s *InventoryChange) GetChange() OptString {
+	return s.Change
 }
 
-// GetFqdn returns the value of Fqdn.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetFqdn() OptString {
-	return s.Fqdn
+// GetDetectedAt returns the value of DetectedAt.
+func (s *InventoryChange) GetDetectedAt() OptDateTime {
+	return s.DetectedAt
+}
+
+// GetHost returns the value of Host.
+func (s *InventoryChange) GetHost() OptString {
+	return s.Host
 }
 
 // GetID returns the value of ID.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetID() OptNilInt64 {
+func (s *InventoryChange) GetID() OptInt64 {
 	return s.ID
 }
 
-// GetIfname returns the value of Ifname.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetIfname() OptString {
-	return s.Ifname
+// GetKind returns the value of Kind.
+func (s *InventoryChange) GetKind() OptString {
+	return s.Kind
 }
 
-// GetIP returns the value of IP.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetIP() OptString {
-	return s.IP
+// GetNew returns the value of New.
+func (s *InventoryChange) GetNew() OptString {
+	return s.New
 }
 
-// GetMAC returns the value of MAC.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetMAC() OptString {
-	return s.MAC
+// GetOld returns the value of Old.
+func (s *InventoryChange) GetOld() OptString {
+	return s.Old
 }
 
-// GetMtu returns the value of Mtu.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetMtu() OptInt {
-	return s.Mtu
+// GetSlot returns the value of Slot.
+func (s *InventoryChange) GetSlot() OptString {
+	return s.Slot
 }
 
-// GetVlan returns the value of Vlan.
-func (s *NodeAddRequestNodeListItemInterfacesItem) GetVlan() OptString {
-	return s.Vlan
+// SetChange sets the value of Change.
+func (s *InventoryChange) SetChange(val OptString) {
+	s.Change = val
 }
 
-// SetBmc sets the value of Bmc.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetBmc(val OptBool) {
-	s.Bmc = val
+// SetDetectedAt sets the value of DetectedAt.
+func (s *InventoryChange) SetDetectedAt(val OptDateTime) {
+	s.DetectedAt = val
 }
 
-// SetFqdn sets the value of Fqdn.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetFqdn(val OptString) {
-	s.Fqdn = val
+// SetHost sets the value of Host.
+func (s *InventoryChange) SetHost(val OptString) {
+	s.Host = val
 }
 
 // SetID sets the value of ID.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetID(val OptNilInt64) {
+func (s *InventoryChange) SetID(val OptInt64) {
 	s.ID = val
 }
 
-// SetIfname sets the value of Ifname.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetIfname(val OptString) {
-	s.Ifname = val
+// SetKind sets the value of Kind.
+func (s *InventoryChange) SetKind(val OptString) {
+	s.Kind = val
 }
 
-// SetIP sets the value of IP.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetIP(val OptString) {
-	s.IP = val
+// SetNew sets the value of New.
+func (s *InventoryChange) SetNew(val OptString) {
+	s.New = val
 }
 
-// SetMAC sets the value of MAC.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetMAC(val OptString) {
-	s.MAC = val
+// SetOld sets the value of Old.
+func (s *InventoryChange) SetOld(val OptString) {
+	s.Old = val
 }
 
-// SetMtu sets the value of Mtu.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetMtu(val OptInt) {
-	s.Mtu = val
+// SetSlot sets the value of Slot.
+func (s *InventoryChange) SetSlot(val OptString) {
+	s.Slot = val
 }
 
-// SetVlan sets the value of Vlan.
-func (s *NodeAddRequestNodeListItemInterfacesItem) SetVlan(val OptString) {
-	s.Vlan = val
+// Ref: #/components/schemas/InventoryComponent
+type InventoryComponent struct {
+	Address      OptString   `json:"address"`
+	Capacity     OptInt64    `json:"capacity"`
+	CollectedAt  OptDateTime `json:"collected_at"`
+	Host         OptString   `json:"host"`
+	Kind         OptString   `json:"kind"`
+	Manufacturer OptString   `json:"manufacturer"`
+	Model        OptString   `json:"model"`
+	Name         OptString   `json:"name"`
+	PartNumber   OptString   `json:"part_number"`
+	Serial       OptString   `json:"serial"`
+	Slot         OptString   `json:"slot"`
+	Version      OptString   `json:"version"`
 }
 
-// NodeBootImageRequest schema.
-// Ref: #/components/schemas/NodeBootImageRequest
-type NodeBootImageRequest struct {
-	Image OptString `json:"image"`
+// GetAddress returns the value of Address.
+func (s *InventoryComponent) GetAddress() OptString {
+	return s.Address
 }
 
-// GetImage returns the value of Image.
-func (s *NodeBootImageRequest) GetImage() OptString {
-	return s.Image
+// GetCapacity returns the value of Capacity.
+func (s *InventoryComponent) GetCapacity() OptInt64 {
+	return s.Capacity
 }
 
-// SetImage sets the value of Image.
-func (s *NodeBootImageRequest) SetImage(val OptString) {
-	s.Image = val
+// GetCollectedAt returns the value of CollectedAt.
+func (s *InventoryComponent) GetCollectedAt() OptDateTime {
+	return s.CollectedAt
 }
 
-// NodeBootTokenResponse schema.
-// Ref: #/components/schemas/NodeBootTokenResponse
-type NodeBootTokenResponse struct {
-	Nodes []NodeBootTokenResponseNodesItem `json:"nodes"`
+// GetHost returns the value of Host.
+func (s *InventoryComponent) GetHost() OptString {
+	return s.Host
 }
 
-// GetNodes returns the value of Nodes.
-func (s *NodeBootTokenResponse) GetNodes() []NodeBootTokenResponseNodesItem {
-	return s.Nodes
+// GetKind returns the value of Kind.
+func (s *InventoryComponent) GetKind() OptString {
+	return s.Kind
 }
 
-// SetNodes sets the value of Nodes.
-func (s *NodeBootTokenResponse) SetNodes(val []NodeBootTokenResponseNodesItem) {
-	s.Nodes = val
+// GetManufacturer returns the value of Manufacturer.
+func (s *InventoryComponent) GetManufacturer() OptString {
+	return s.Manufacturer
 }
 
-type NodeBootTokenResponseNodesItem struct {
-	Name  OptString `json:"name"`
-	Token OptString `json:"token"`
+// GetModel returns the value of Model.
+func (s *InventoryComponent) GetModel() OptString {
+	return s.Model
 }
 
 // GetName returns the value of Name.
-func (s *NodeBootTokenResponseNodesItem) GetName() OptString {
+func (s *InventoryComponent) GetName() OptString {
 	return s.Name
 }
 
-// GetToken returns the value of Token.
-func (s *NodeBootTokenResponseNodesItem) GetToken() OptString {
-	return s.Token
+// GetPartNumber returns the value of PartNumber.
+func (s *InventoryComponent) GetPartNumber() OptString {
+	return s.PartNumber
 }
 
-// SetName sets the value of Name.
-func (s *NodeBootTokenResponseNodesItem) SetName(val OptString) {
-	s.Name = val
+// GetSerial returns the value of Serial.
+func (s *InventoryComponent) GetSerial() OptString {
+	return s.Serial
 }
 
-// SetToken sets the value of Token.
-func (s *NodeBootTokenResponseNodesItem) SetToken(val OptString) {
-	s.Token = val
+// GetSlot returns the value of Slot.
+func (s *InventoryComponent) GetSlot() OptString {
+	return s.Slot
 }
 
-// NodeProvisionRequest schema.
-// Ref: #/components/schemas/NodeProvisionRequest
-type NodeProvisionRequest struct {
-	Provision OptBool `json:"provision"`
+// GetVersion returns the value of Version.
+func (s *InventoryComponent) GetVersion() OptString {
+	return s.Version
 }
 
-// GetProvision returns the value of Provision.
-func (s *NodeProvisionRequest) GetProvision() OptBool {
-	return s.Provision
+// SetAddress sets the value of Address.
+func (s *InventoryComponent) SetAddress(val OptString) {
+	s.Address = val
 }
 
-// SetProvision sets the value of Provision.
-func (s *NodeProvisionRequest) SetProvision(val OptBool) {
-	s.Provision = val
+// SetCapacity sets the value of Capacity.
+func (s *InventoryComponent) SetCapacity(val OptInt64) {
+	s.Capacity = val
 }
 
-// NodeTagsRequest schema.
-// Ref: #/components/schemas/NodeTagsRequest
-type NodeTagsRequest struct {
-	// Comma separated list of tags.
-	Tags OptString `json:"tags"`
+// SetCollectedAt sets the value of CollectedAt.
+func (s *InventoryComponent) SetCollectedAt(val OptDateTime) {
+	s.CollectedAt = val
 }
 
-// GetTags returns the value of Tags.
-func (s *NodeTagsRequest) GetTags() OptString {
-	return s.Tags
+// SetHost sets the value of Host.
+func (s *InventoryComponent) SetHost(val OptString) {
+	s.Host = val
 }
 
-// SetTags sets the value of Tags.
-func (s *NodeTagsRequest) SetTags(val OptString) {
-	s.Tags = val
+// SetKind sets the value of Kind.
+func (s *InventoryComponent) SetKind(val OptString) {
+	s.Kind = val
 }
 
-// NewOptBmcJobDeleteRequestNodeJobList returns new OptBmcJobDeleteRequestNodeJobList with value set to v.
-func NewOptBmcJobDeleteRequestNodeJobList(v BmcJobDeleteRequestNodeJobList) OptBmcJobDeleteRequestNodeJobList {
-	return OptBmcJobDeleteRequestNodeJobList{
-		Value: v,
-		Set:   true,
-	}
+// SetManufacturer sets the value of Manufacturer.
+func (s *InventoryComponent) SetManufacturer(val OptString) {
+	s.Manufacturer = val
 }
 
-// OptBmcJobDeleteRequestNodeJobList is optional BmcJobDeleteRequestNodeJobList.
-type OptBmcJobDeleteRequestNodeJobList struct {
-	Value BmcJobDeleteRequestNodeJobList
-	Set   bool
+// SetModel sets the value of Model.
+func (s *InventoryComponent) SetModel(val OptString) {
+	s.Model = val
 }
 
-// IsSet returns true if OptBmcJobDeleteRequestNodeJobList was set.
-func (o OptBmcJobDeleteRequestNodeJobList) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptBmcJobDeleteRequestNodeJobList) Reset() {
-	var v BmcJobDeleteRequestNodeJobList
-	o.Value = v
-	o.Set = false
+// SetName sets the value of Name.
+func (s *InventoryComponent) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetTo sets value to v.
-func (o *OptBmcJobDeleteRequestNodeJobList) SetTo(v BmcJobDeleteRequestNodeJobList) {
-	o.Set = true
-	o.Value = v
+// SetPartNumber sets the value of PartNumber.
+func (s *InventoryComponent) SetPartNumber(val OptString) {
+	s.PartNumber = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBmcJobDeleteRequestNodeJobList) Get() (v BmcJobDeleteRequestNodeJobList, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetSerial sets the value of Serial.
+func (s *InventoryComponent) SetSerial(val OptString) {
+	s.Serial = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBmcJobDeleteRequestNodeJobList) Or(d BmcJobDeleteRequestNodeJobList) BmcJobDeleteRequestNodeJobList {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetSlot sets the value of Slot.
+func (s *InventoryComponent) SetSlot(val OptString) {
+	s.Slot = val
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
+// SetVersion sets the value of Version.
+func (s *InventoryComponent) SetVersion(val OptString) {
+	s.Version = val
 }
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
+// JobMessage schema.
+// Ref: #/components/schemas/JobMessage
+type JobMessage struct {
+	Data         OptString                 `json:"data"`
+	Host         OptString                 `json:"host"`
+	Msg          OptString                 `json:"msg"`
+	RedfishError OptJobMessageRedfishError `json:"redfish_error"`
+	Status       OptString                 `json:"status"`
 }
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
+// GetData returns the value of Data.
+func (s *JobMessage) GetData() OptString {
+	return s.Data
 }
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
+// GetHost returns the value of Host.
+func (s *JobMessage) GetHost() OptString {
+	return s.Host
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetMsg returns the value of Msg.
+func (s *JobMessage) GetMsg() OptString {
+	return s.Msg
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetRedfishError returns the value of RedfishError.
+func (s *JobMessage) GetRedfishError() OptJobMessageRedfishError {
+	return s.RedfishError
 }
 
-// NewOptDateTime returns new OptDateTime with value set to v.
-func NewOptDateTime(v time.Time) OptDateTime {
-	return OptDateTime{
-		Value: v,
-		Set:   true,
-	}
+// GetStatus returns the value of Status.
+func (s *JobMessage) GetStatus() OptString {
+	return s.Status
 }
 
-// OptDateTime is optional time.Time.
-type OptDateTime struct {
-	Value time.Time
-	Set   bool
+// SetData sets the value of Data.
+func (s *JobMessage) SetData(val OptString) {
+	s.Data = val
 }
 
-// IsSet returns true if OptDateTime was set.
-func (o OptDateTime) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptDateTime) Reset() {
-	var v time.Time
-	o.Value = v
-	o.Set = false
+// SetHost sets the value of Host.
+func (s *JobMessage) SetHost(val OptString) {
+	s.Host = val
 }
 
-// SetTo sets value to v.
-func (o *OptDateTime) SetTo(v time.Time) {
-	o.Set = true
-	o.Value = v
+// SetMsg sets the value of Msg.
+func (s *JobMessage) SetMsg(val OptString) {
+	s.Msg = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptDateTime) Get() (v time.Time, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetRedfishError sets the value of RedfishError.
+func (s *JobMessage) SetRedfishError(val OptJobMessageRedfishError) {
+	s.RedfishError = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptDateTime) Or(d time.Time) time.Time {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetStatus sets the value of Status.
+func (s *JobMessage) SetStatus(val OptString) {
+	s.Status = val
 }
 
-// NewOptEventJobMessagesItemRedfishError returns new OptEventJobMessagesItemRedfishError with value set to v.
-func NewOptEventJobMessagesItemRedfishError(v EventJobMessagesItemRedfishError) OptEventJobMessagesItemRedfishError {
-	return OptEventJobMessagesItemRedfishError{
-		Value: v,
-		Set:   true,
-	}
+type JobMessageRedfishError struct {
+	Code  OptString                      `json:"code"`
+	Error OptJobMessageRedfishErrorError `json:"error"`
 }
 
-// OptEventJobMessagesItemRedfishError is optional EventJobMessagesItemRedfishError.
-type OptEventJobMessagesItemRedfishError struct {
-	Value EventJobMessagesItemRedfishError
-	Set   bool
+// GetCode returns the value of Code.
+func (s *JobMessageRedfishError) GetCode() OptString {
+	return s.Code
 }
 
-// IsSet returns true if OptEventJobMessagesItemRedfishError was set.
-func (o OptEventJobMessagesItemRedfishError) IsSet() bool { return o.Set }
+// GetError returns the value of Error.
+func (s *JobMessageRedfishError) GetError() OptJobMessageRedfishErrorError {
+	return s.Error
+}
 
-// Reset unsets value.
-func (o *OptEventJobMessagesItemRedfishError) Reset() {
-	var v EventJobMessagesItemRedfishError
-	o.Value = v
-	o.Set = false
+// SetCode sets the value of Code.
+func (s *JobMessageRedfishError) SetCode(val OptString) {
+	s.Code = val
 }
 
-// SetTo sets value to v.
-func (o *OptEventJobMessagesItemRedfishError) SetTo(v EventJobMessagesItemRedfishError) {
-	o.Set = true
-	o.Value = v
+// SetError sets the value of Error.
+func (s *JobMessageRedfishError) SetError(val OptJobMessageRedfishErrorError) {
+	s.Error = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptEventJobMessagesItemRedfishError) Get() (v EventJobMessagesItemRedfishError, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+type JobMessageRedfishErrorError struct {
+	MessageDotExtendedInfo OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray `json:"@Message.ExtendedInfo"`
+	Code                   OptString                                                        `json:"code"`
+	Message                OptString                                                        `json:"message"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptEventJobMessagesItemRedfishError) Or(d EventJobMessagesItemRedfishError) EventJobMessagesItemRedfishError {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
+func (s *JobMessageRedfishErrorError) GetMessageDotExtendedInfo() OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray {
+	return s.MessageDotExtendedInfo
 }
 
-// NewOptEventJobMessagesItemRedfishErrorError returns new OptEventJobMessagesItemRedfishErrorError with value set to v.
-func NewOptEventJobMessagesItemRedfishErrorError(v EventJobMessagesItemRedfishErrorError) OptEventJobMessagesItemRedfishErrorError {
-	return OptEventJobMessagesItemRedfishErrorError{
-		Value: v,
-		Set:   true,
-	}
+// GetCode returns the value of Code.
+func (s *JobMessageRedfishErrorError) GetCode() OptString {
+	return s.Code
 }
 
-// OptEventJobMessagesItemRedfishErrorError is optional EventJobMessagesItemRedfishErrorError.
-type OptEventJobMessagesItemRedfishErrorError struct {
-	Value EventJobMessagesItemRedfishErrorError
-	Set   bool
+// GetMessage returns the value of Message.
+func (s *JobMessageRedfishErrorError) GetMessage() OptString {
+	return s.Message
 }
 
-// IsSet returns true if OptEventJobMessagesItemRedfishErrorError was set.
-func (o OptEventJobMessagesItemRedfishErrorError) IsSet() bool { return o.Set }
+// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
+func (s *JobMessageRedfishErrorError) SetMessageDotExtendedInfo(val OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) {
+	s.MessageDotExtendedInfo = val
+}
 
-// Reset unsets value.
-func (o *OptEventJobMessagesItemRedfishErrorError) Reset() {
-	var v EventJobMessagesItemRedfishErrorError
-	o.Value = v
-	o.Set = false
+// SetCode sets the value of Code.
+func (s *JobMessageRedfishErrorError) SetCode(val OptString) {
+	s.Code = val
 }
 
-// SetTo sets value to v.
-func (o *OptEventJobMessagesItemRedfishErrorError) SetTo(v EventJobMessagesItemRedfishErrorError) {
-	o.Set = true
-	o.Value = v
+// SetMessage sets the value of Message.
+func (s *JobMessageRedfishErrorError) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptEventJobMessagesItemRedfishErrorError) Get() (v EventJobMessagesItemRedfishErrorError, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+type JobMessageRedfishErrorErrorMessageDotExtendedInfoItem struct {
+	Message                           OptString `json:"Message"`
+	MessageArgsDotOdataDotCount       OptInt    `json:"MessageArgs.@odata.count"`
+	MessageId                         OptString `json:"MessageId"`
+	RelatedPropertiesDotOdataDotCount OptInt    `json:"RelatedProperties.@odata.count"`
+	Resolution                        OptString `json:"Resolution"`
+	Severity                          OptString `json:"Severity"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptEventJobMessagesItemRedfishErrorError) Or(d EventJobMessagesItemRedfishErrorError) EventJobMessagesItemRedfishErrorError {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetMessage returns the value of Message.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessage() OptString {
+	return s.Message
 }
 
-// NewOptFloat32 returns new OptFloat32 with value set to v.
-func NewOptFloat32(v float32) OptFloat32 {
-	return OptFloat32{
+// GetMessageArgsDotOdataDotCount returns the value of MessageArgsDotOdataDotCount.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageArgsDotOdataDotCount() OptInt {
+	return s.MessageArgsDotOdataDotCount
+}
+
+// GetMessageId returns the value of MessageId.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetMessageId() OptString {
+	return s.MessageId
+}
+
+// GetRelatedPropertiesDotOdataDotCount returns the value of RelatedPropertiesDotOdataDotCount.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetRelatedPropertiesDotOdataDotCount() OptInt {
+	return s.RelatedPropertiesDotOdataDotCount
+}
+
+// GetResolution returns the value of Resolution.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetResolution() OptString {
+	return s.Resolution
+}
+
+// GetSeverity returns the value of Severity.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) GetSeverity() OptString {
+	return s.Severity
+}
+
+// SetMessage sets the value of Message.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetMessageArgsDotOdataDotCount sets the value of MessageArgsDotOdataDotCount.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageArgsDotOdataDotCount(val OptInt) {
+	s.MessageArgsDotOdataDotCount = val
+}
+
+// SetMessageId sets the value of MessageId.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetMessageId(val OptString) {
+	s.MessageId = val
+}
+
+// SetRelatedPropertiesDotOdataDotCount sets the value of RelatedPropertiesDotOdataDotCount.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetRelatedPropertiesDotOdataDotCount(val OptInt) {
+	s.RelatedPropertiesDotOdataDotCount = val
+}
+
+// SetResolution sets the value of Resolution.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetResolution(val OptString) {
+	s.Resolution = val
+}
+
+// SetSeverity sets the value of Severity.
+func (s *JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) SetSeverity(val OptString) {
+	s.Severity = val
+}
+
+// LLDP schema.
+// Ref: #/components/schemas/LLDP
+type LLDP struct {
+	ChassisID         OptString `json:"chassis_id"`
+	ChassisIDType     OptString `json:"chassis_id_type"`
+	ManagementAddress OptString `json:"management_address"`
+	PortDescription   OptString `json:"port_description"`
+	PortID            OptString `json:"port_id"`
+	PortIDType        OptString `json:"port_id_type"`
+	PortName          OptString `json:"port_name"`
+	SystemDescription OptString `json:"system_description"`
+	SystemName        OptString `json:"system_name"`
+}
+
+// GetChassisID returns the value of ChassisID.
+func (s *LLDP) GetChassisID() OptString {
+	return s.ChassisID
+}
+
+// GetChassisIDType returns the value of ChassisIDType.
+func (s *LLDP) GetChassisIDType() OptString {
+	return s.ChassisIDType
+}
+
+// GetManagementAddress returns the value of ManagementAddress.
+func (s *LLDP) GetManagementAddress() OptString {
+	return s.ManagementAddress
+}
+
+// GetPortDescription returns the value of PortDescription.
+func (s *LLDP) GetPortDescription() OptString {
+	return s.PortDescription
+}
+
+// GetPortID returns the value of PortID.
+func (s *LLDP) GetPortID() OptString {
+	return s.PortID
+}
+
+// GetPortIDType returns the value of PortIDType.
+func (s *LLDP) GetPortIDType() OptString {
+	return s.PortIDType
+}
+
+// GetPortName returns the value of PortName.
+func (s *LLDP) GetPortName() OptString {
+	return s.PortName
+}
+
+// GetSystemDescription returns the value of SystemDescription.
+func (s *LLDP) GetSystemDescription() OptString {
+	return s.SystemDescription
+}
+
+// GetSystemName returns the value of SystemName.
+func (s *LLDP) GetSystemName() OptString {
+	return s.SystemName
+}
+
+// SetChassisID sets the value of ChassisID.
+func (s *LLDP) SetChassisID(val OptString) {
+	s.ChassisID = val
+}
+
+// SetChassisIDType sets the value of ChassisIDType.
+func (s *LLDP) SetChassisIDType(val OptString) {
+	s.ChassisIDType = val
+}
+
+// SetManagementAddress sets the value of ManagementAddress.
+func (s *LLDP) SetManagementAddress(val OptString) {
+	s.ManagementAddress = val
+}
+
+// SetPortDescription sets the value of PortDescription.
+func (s *LLDP) SetPortDescription(val OptString) {
+	s.PortDescription = val
+}
+
+// SetPortID sets the value of PortID.
+func (s *LLDP) SetPortID(val OptString) {
+	s.PortID = val
+}
+
+// SetPortIDType sets the value of PortIDType.
+func (s *LLDP) SetPortIDType(val OptString) {
+	s.PortIDType = val
+}
+
+// SetPortName sets the value of PortName.
+func (s *LLDP) SetPortName(val OptString) {
+	s.PortName = val
+}
+
+// SetSystemDescription sets the value of SystemDescription.
+func (s *LLDP) SetSystemDescription(val OptString) {
+	s.SystemDescription = val
+}
+
+// SetSystemName sets the value of SystemName.
+func (s *LLDP) SetSystemName(val OptString) {
+	s.SystemName = val
+}
+
+// NewNilBootImageAddRequestBootImagesItem returns new NilBootImageAddRequestBootImagesItem with value set to v.
+func NewNilBootImageAddRequestBootImagesItem(v BootImageAddRequestBootImagesItem) NilBootImageAddRequestBootImagesItem {
+	return NilBootImageAddRequestBootImagesItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptFloat32 is optional float32.
-type OptFloat32 struct {
-	Value float32
-	Set   bool
+// NilBootImageAddRequestBootImagesItem is nullable BootImageAddRequestBootImagesItem.
+type NilBootImageAddRequestBootImagesItem struct {
+	Value BootImageAddRequestBootImagesItem
+	Null  bool
 }
 
-// IsSet returns true if OptFloat32 was set.
-func (o OptFloat32) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptFloat32) Reset() {
-	var v float32
+// SetTo sets value to v.
+func (o *NilBootImageAddRequestBootImagesItem) SetTo(v BootImageAddRequestBootImagesItem) {
+	o.Null = false
 	o.Value = v
-	o.Set = false
 }
 
-// SetTo sets value to v.
-func (o *OptFloat32) SetTo(v float32) {
-	o.Set = true
+// IsSet returns true if value is Null.
+func (o NilBootImageAddRequestBootImagesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilBootImageAddRequestBootImagesItem) SetToNull() {
+	o.Null = true
+	var v BootImageAddRequestBootImagesItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptFloat32) Get() (v float32, ok bool) {
-	if !o.Set {
+func (o NilBootImageAddRequestBootImagesItem) Get() (v BootImageAddRequestBootImagesItem, ok bool) {
+	if o.Null {
 		return v, false
 	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptFloat32) Or(d float32) float32 {
+func (o NilBootImageAddRequestBootImagesItem) Or(d BootImageAddRequestBootImagesItem) BootImageAddRequestBootImagesItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptHTTPErrorErrorsItemMore returns new OptHTTPErrorErrorsItemMore with value set to v.
-func NewOptHTTPErrorErrorsItemMore(v HTTPErrorErrorsItemMore) OptHTTPErrorErrorsItemMore {
-	return OptHTTPErrorErrorsItemMore{
+// NewNilCablingReportLinksItem returns new NilCablingReportLinksItem with value set to v.
+func NewNilCablingReportLinksItem(v CablingReportLinksItem) NilCablingReportLinksItem {
+	return NilCablingReportLinksItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptHTTPErrorErrorsItemMore is optional HTTPErrorErrorsItemMore.
-type OptHTTPErrorErrorsItemMore struct {
-	Value HTTPErrorErrorsItemMore
-	Set   bool
+// NilCablingReportLinksItem is nullable CablingReportLinksItem.
+type NilCablingReportLinksItem struct {
+	Value CablingReportLinksItem
+	Null  bool
 }
 
-// IsSet returns true if OptHTTPErrorErrorsItemMore was set.
-func (o OptHTTPErrorErrorsItemMore) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptHTTPErrorErrorsItemMore) Reset() {
-	var v HTTPErrorErrorsItemMore
+// SetTo sets value to v.
+func (o *NilCablingReportLinksItem) SetTo(v CablingReportLinksItem) {
+	o.Null = false
 	o.Value = v
-	o.Set = false
 }
 
-// SetTo sets value to v.
-func (o *OptHTTPErrorErrorsItemMore) SetTo(v HTTPErrorErrorsItemMore) {
-	o.Set = true
+// IsSet returns true if value is Null.
+func (o NilCablingReportLinksItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilCablingReportLinksItem) SetToNull() {
+	o.Null = true
+	var v CablingReportLinksItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptHTTPErrorErrorsItemMore) Get() (v HTTPErrorErrorsItemMore, ok bool) {
-	if !o.Set {
+func (o NilCablingReportLinksItem) Get() (v CablingReportLinksItem, ok bool) {
+	if o.Null {
 		return v, false
 	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptHTTPErrorErrorsItemMore) Or(d HTTPErrorErrorsItemMore) HTTPErrorErrorsItemMore {
+func (o NilCablingReportLinksItem) Or(d CablingReportLinksItem) CablingReportLinksItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
+// NewNilDataDumpHostsItem returns new NilDataDumpHostsItem with value set to v.
+func NewNilDataDumpHostsItem(v DataDumpHostsItem) NilDataDumpHostsItem {
+	return NilDataDumpHostsItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// NilDataDumpHostsItem is nullable DataDumpHostsItem.
+type NilDataDumpHostsItem struct {
+	Value DataDumpHostsItem
+	Null  bool
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
+// SetTo sets value to v.
+func (o *NilDataDumpHostsItem) SetTo(v DataDumpHostsItem) {
+	o.Null = false
 	o.Value = v
-	o.Set = false
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
+// IsSet returns true if value is Null.
+func (o NilDataDumpHostsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpHostsItem) SetToNull() {
+	o.Null = true
+	var v DataDumpHostsItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
+func (o NilDataDumpHostsItem) Get() (v DataDumpHostsItem, ok bool) {
+	if o.Null {
 		return v, false
 	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
+func (o NilDataDumpHostsItem) Or(d DataDumpHostsItem) DataDumpHostsItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptInt64 returns new OptInt64 with value set to v.
-func NewOptInt64(v int64) OptInt64 {
-	return OptInt64{
+// NewNilDataDumpHostsItemBondsItem returns new NilDataDumpHostsItemBondsItem with value set to v.
+func NewNilDataDumpHostsItemBondsItem(v DataDumpHostsItemBondsItem) NilDataDumpHostsItemBondsItem {
+	return NilDataDumpHostsItemBondsItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptInt64 is optional int64.
-type OptInt64 struct {
-	Value int64
-	Set   bool
+// NilDataDumpHostsItemBondsItem is nullable DataDumpHostsItemBondsItem.
+type NilDataDumpHostsItemBondsItem struct {
+	Value DataDumpHostsItemBondsItem
+	Null  bool
 }
 
-// IsSet returns true if OptInt64 was set.
-func (o OptInt64) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptInt64) Reset() {
-	var v int64
+// SetTo sets value to v.
+func (o *NilDataDumpHostsItemBondsItem) SetTo(v DataDumpHostsItemBondsItem) {
+	o.Null = false
 	o.Value = v
-	o.Set = false
 }
 
-// SetTo sets value to v.
-func (o *OptInt64) SetTo(v int64) {
-	o.Set = true
+// IsSet returns true if value is Null.
+func (o NilDataDumpHostsItemBondsItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpHostsItemBondsItem) SetToNull() {
+	o.Null = true
+	var v DataDumpHostsItemBondsItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptInt64) Get() (v int64, ok bool) {
-	if !o.Set {
+func (o NilDataDumpHostsItemBondsItem) Get() (v DataDumpHostsItemBondsItem, ok bool) {
+	if o.Null {
 		return v, false
 	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptInt64) Or(d int64) int64 {
+func (o NilDataDumpHostsItemBondsItem) Or(d DataDumpHostsItemBondsItem) DataDumpHostsItemBondsItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptJobMessageRedfishError returns new OptJobMessageRedfishError with value set to v.
-func NewOptJobMessageRedfishError(v JobMessageRedfishError) OptJobMessageRedfishError {
-	return OptJobMessageRedfishError{
+// NewNilDataDumpHostsItemInterfacesItem returns new NilDataDumpHostsItemInterfacesItem with value set to v.
+func NewNilDataDumpHostsItemInterfacesItem(v DataDumpHostsItemInterfacesItem) NilDataDumpHostsItemInterfacesItem {
+	return NilDataDumpHostsItemInterfacesItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptJobMessageRedfishError is optional JobMessageRedfishError.
-type OptJobMessageRedfishError struct {
-	Value JobMessageRedfishError
-	Set   bool
+// NilDataDumpHostsItemInterfacesItem is nullable DataDumpHostsItemInterfacesItem.
+type NilDataDumpHostsItemInterfacesItem struct {
+	Value DataDumpHostsItemInterfacesItem
+	Null  bool
 }
 
-// IsSet returns true if OptJobMessageRedfishError was set.
-func (o OptJobMessageRedfishError) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptJobMessageRedfishError) Reset() {
-	var v JobMessageRedfishError
+// SetTo sets value to v.
+func (o *NilDataDumpHostsItemInterfacesItem) SetTo(v DataDumpHostsItemInterfacesItem) {
+	o.Null = false
 	o.Value = v
-	o.Set = false
 }
 
-// SetTo sets value to v.
-func (o *OptJobMessageRedfishError) SetTo(v JobMessageRedfishError) {
-	o.Set = true
+// IsSet returns true if value is Null.
+func (o NilDataDumpHostsItemInterfacesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpHostsItemInterfacesItem) SetToNull() {
+	o.Null = true
+	var v DataDumpHostsItemInterfacesItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptJobMessageRedfishError) Get() (v JobMessageRedfishError, ok bool) {
-	if !o.Set {
+func (o NilDataDumpHostsItemInterfacesItem) Get() (v DataDumpHostsItemInterfacesItem, ok bool) {
+	if o.Null {
 		return v, false
 	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptJobMessageRedfishError) Or(d JobMessageRedfishError) JobMessageRedfishError {
+func (o NilDataDumpHostsItemInterfacesItem) Or(d DataDumpHostsItemInterfacesItem) DataDumpHostsItemInterfacesItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptJobMessageRedfishErrorError returns new OptJobMessageRedfishErrorError with value set to v.
-func NewOptJobMessageRedfishErrorError(v JobMessageRedfishErrorError) OptJobMessageRedfishErrorError {
-	return OptJobMessageRedfishErrorError{
+// NewNilDataDumpImagesItem returns new NilDataDumpImagesItem with value set to v.
+func NewNilDataDumpImagesItem(v DataDumpImagesItem) NilDataDumpImagesItem {
+	return NilDataDumpImagesItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptJobMessageRedfishErrorError is optional JobMessageRedfishErrorError.
-type OptJobMessageRedfishErrorError struct {
-	Value JobMessageRedfishErrorError
-	Set   bool
+// NilDataDumpImagesItem is nullable DataDumpImagesItem.
+type NilDataDumpImagesItem struct {
+	Value DataDumpImagesItem
+	Null  bool
 }
 
-// IsSet returns true if OptJobMessageRedfishErrorError was set.
-func (o OptJobMessageRedfishErrorError) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptJobMessageRedfishErrorError) Reset() {
-	var v JobMessageRedfishErrorError
+// SetTo sets value to v.
+func (o *NilDataDumpImagesItem) SetTo(v DataDumpImagesItem) {
+	o.Null = false
 	o.Value = v
-	o.Set = false
 }
 
-// SetTo sets value to v.
-func (o *OptJobMessageRedfishErrorError) SetTo(v JobMessageRedfishErrorError) {
-	o.Set = true
+// IsSet returns true if value is Null.
+func (o NilDataDumpImagesItem) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *NilDataDumpImagesItem) SetToNull() {
+	o.Null = true
+	var v DataDumpImagesItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptJobMessageRedfishErrorError) Get() (v JobMessageRedfishErrorError, ok bool) {
-	if !o.Set {
+func (o NilDataDumpImagesItem) Get() (v DataDumpImagesItem, ok bool) {
+	if o.Null {
 		return v, false
 	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptJobMessageRedfishErrorError) Or(d JobMessageRedfishErrorError) JobMessageRedfishErrorError {
+func (o NilDataDumpImagesItem) Or(d DataDumpImagesItem) DataDumpImagesItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilBootImageAddRequestBootImagesItemProvisionTemplates returns new OptNilBootImageAddRequestBootImagesItemProvisionTemplates with value set to v.
-func NewOptNilBootImageAddRequestBootImagesItemProvisionTemplates(v BootImageAddRequestBootImagesItemProvisionTemplates) OptNilBootImageAddRequestBootImagesItemProvisionTemplates {
-	return OptNilBootImageAddRequestBootImagesItemProvisionTemplates{
+// NewNilHostBondsItem returns new NilHostBondsItem with value set to v.
+func NewNilHostBondsItem(v HostBondsItem) NilHostBondsItem {
+	return NilHostBondsItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilBootImageAddRequestBootImagesItemProvisionTemplates is optional nullable BootImageAddRequestBootImagesItemProvisionTemplates.
-type OptNilBootImageAddRequestBootImagesItemProvisionTemplates struct {
-	Value BootImageAddRequestBootImagesItemProvisionTemplates
-	Set   bool
+// NilHostBondsItem is nullable HostBondsItem.
+type NilHostBondsItem struct {
+	Value HostBondsItem
 	Null  bool
 }
 
-// IsSet returns true if OptNilBootImageAddRequestBootImagesItemProvisionTemplates was set.
-func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Reset() {
-	var v BootImageAddRequestBootImagesItemProvisionTemplates
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) SetTo(v BootImageAddRequestBootImagesItemProvisionTemplates) {
-	o.Set = true
+func (o *NilHostBondsItem) SetTo(v HostBondsItem) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) IsNull() bool { return o.Null }
+func (o NilHostBondsItem) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) SetToNull() {
-	o.Set = true
+func (o *NilHostBondsItem) SetToNull() {
 	o.Null = true
-	var v BootImageAddRequestBootImagesItemProvisionTemplates
+	var v HostBondsItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Get() (v BootImageAddRequestBootImagesItemProvisionTemplates, ok bool) {
+func (o NilHostBondsItem) Get() (v HostBondsItem, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Or(d BootImageAddRequestBootImagesItemProvisionTemplates) BootImageAddRequestBootImagesItemProvisionTemplates {
+func (o NilHostBondsItem) Or(d HostBondsItem) HostBondsItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilBootImageProvisionTemplates returns new OptNilBootImageProvisionTemplates with value set to v.
-func NewOptNilBootImageProvisionTemplates(v BootImageProvisionTemplates) OptNilBootImageProvisionTemplates {
-	return OptNilBootImageProvisionTemplates{
+// NewNilHostInterfacesItem returns new NilHostInterfacesItem with value set to v.
+func NewNilHostInterfacesItem(v HostInterfacesItem) NilHostInterfacesItem {
+	return NilHostInterfacesItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilBootImageProvisionTemplates is optional nullable BootImageProvisionTemplates.
-type OptNilBootImageProvisionTemplates struct {
-	Value BootImageProvisionTemplates
-	Set   bool
+// NilHostInterfacesItem is nullable HostInterfacesItem.
+type NilHostInterfacesItem struct {
+	Value HostInterfacesItem
 	Null  bool
 }
 
-// IsSet returns true if OptNilBootImageProvisionTemplates was set.
-func (o OptNilBootImageProvisionTemplates) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilBootImageProvisionTemplates) Reset() {
-	var v BootImageProvisionTemplates
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilBootImageProvisionTemplates) SetTo(v BootImageProvisionTemplates) {
-	o.Set = true
+func (o *NilHostInterfacesItem) SetTo(v HostInterfacesItem) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilBootImageProvisionTemplates) IsNull() bool { return o.Null }
+func (o NilHostInterfacesItem) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilBootImageProvisionTemplates) SetToNull() {
-	o.Set = true
+func (o *NilHostInterfacesItem) SetToNull() {
 	o.Null = true
-	var v BootImageProvisionTemplates
+	var v HostInterfacesItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilBootImageProvisionTemplates) Get() (v BootImageProvisionTemplates, ok bool) {
+func (o NilHostInterfacesItem) Get() (v HostInterfacesItem, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilBootImageProvisionTemplates) Or(d BootImageProvisionTemplates) BootImageProvisionTemplates {
+func (o NilHostInterfacesItem) Or(d HostInterfacesItem) HostInterfacesItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilDataDumpImagesItemProvisionTemplates returns new OptNilDataDumpImagesItemProvisionTemplates with value set to v.
-func NewOptNilDataDumpImagesItemProvisionTemplates(v DataDumpImagesItemProvisionTemplates) OptNilDataDumpImagesItemProvisionTemplates {
-	return OptNilDataDumpImagesItemProvisionTemplates{
+// NewNilInt returns new NilInt with value set to v.
+func NewNilInt(v int) NilInt {
+	return NilInt{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilDataDumpImagesItemProvisionTemplates is optional nullable DataDumpImagesItemProvisionTemplates.
-type OptNilDataDumpImagesItemProvisionTemplates struct {
-	Value DataDumpImagesItemProvisionTemplates
-	Set   bool
+// NilInt is nullable int.
+type NilInt struct {
+	Value int
 	Null  bool
 }
 
-// IsSet returns true if OptNilDataDumpImagesItemProvisionTemplates was set.
-func (o OptNilDataDumpImagesItemProvisionTemplates) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilDataDumpImagesItemProvisionTemplates) Reset() {
-	var v DataDumpImagesItemProvisionTemplates
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilDataDumpImagesItemProvisionTemplates) SetTo(v DataDumpImagesItemProvisionTemplates) {
-	o.Set = true
+func (o *NilInt) SetTo(v int) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilDataDumpImagesItemProvisionTemplates) IsNull() bool { return o.Null }
+func (o NilInt) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilDataDumpImagesItemProvisionTemplates) SetToNull() {
-	o.Set = true
+func (o *NilInt) SetToNull() {
 	o.Null = true
-	var v DataDumpImagesItemProvisionTemplates
+	var v int
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilDataDumpImagesItemProvisionTemplates) Get() (v DataDumpImagesItemProvisionTemplates, ok bool) {
+func (o NilInt) Get() (v int, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilDataDumpImagesItemProvisionTemplates) Or(d DataDumpImagesItemProvisionTemplates) DataDumpImagesItemProvisionTemplates {
+func (o NilInt) Or(d int) int {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilFloat64 returns new OptNilFloat64 with value set to v.
-func NewOptNilFloat64(v float64) OptNilFloat64 {
-	return OptNilFloat64{
+// NewNilNodeAddRequestNodeListItem returns new NilNodeAddRequestNodeListItem with value set to v.
+func NewNilNodeAddRequestNodeListItem(v NodeAddRequestNodeListItem) NilNodeAddRequestNodeListItem {
+	return NilNodeAddRequestNodeListItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilFloat64 is optional nullable float64.
-type OptNilFloat64 struct {
-	Value float64
-	Set   bool
+// NilNodeAddRequestNodeListItem is nullable NodeAddRequestNodeListItem.
+type NilNodeAddRequestNodeListItem struct {
+	Value NodeAddRequestNodeListItem
 	Null  bool
 }
 
-// IsSet returns true if OptNilFloat64 was set.
-func (o OptNilFloat64) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilFloat64) Reset() {
-	var v float64
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilFloat64) SetTo(v float64) {
-	o.Set = true
+func (o *NilNodeAddRequestNodeListItem) SetTo(v NodeAddRequestNodeListItem) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilFloat64) IsNull() bool { return o.Null }
+func (o NilNodeAddRequestNodeListItem) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilFloat64) SetToNull() {
-	o.Set = true
+func (o *NilNodeAddRequestNodeListItem) SetToNull() {
 	o.Null = true
-	var v float64
+	var v NodeAddRequestNodeListItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilFloat64) Get() (v float64, ok bool) {
+func (o NilNodeAddRequestNodeListItem) Get() (v NodeAddRequestNodeListItem, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilFloat64) Or(d float64) float64 {
+func (o NilNodeAddRequestNodeListItem) Or(d NodeAddRequestNodeListItem) NodeAddRequestNodeListItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilHTTPErrorErrorsItemArray returns new OptNilHTTPErrorErrorsItemArray with value set to v.
-func NewOptNilHTTPErrorErrorsItemArray(v []HTTPErrorErrorsItem) OptNilHTTPErrorErrorsItemArray {
-	return OptNilHTTPErrorErrorsItemArray{
+// NewNilNodeAddRequestNodeListItemBondsItem returns new NilNodeAddRequestNodeListItemBondsItem with value set to v.
+func NewNilNodeAddRequestNodeListItemBondsItem(v NodeAddRequestNodeListItemBondsItem) NilNodeAddRequestNodeListItemBondsItem {
+	return NilNodeAddRequestNodeListItemBondsItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilHTTPErrorErrorsItemArray is optional nullable []HTTPErrorErrorsItem.
-type OptNilHTTPErrorErrorsItemArray struct {
-	Value []HTTPErrorErrorsItem
-	Set   bool
+// NilNodeAddRequestNodeListItemBondsItem is nullable NodeAddRequestNodeListItemBondsItem.
+type NilNodeAddRequestNodeListItemBondsItem struct {
+	Value NodeAddRequestNodeListItemBondsItem
 	Null  bool
 }
 
-// IsSet returns true if OptNilHTTPErrorErrorsItemArray was set.
-func (o OptNilHTTPErrorErrorsItemArray) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilHTTPErrorErrorsItemArray) Reset() {
-	var v []HTTPErrorErrorsItem
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilHTTPErrorErrorsItemArray) SetTo(v []HTTPErrorErrorsItem) {
-	o.Set = true
+func (o *NilNodeAddRequestNodeListItemBondsItem) SetTo(v NodeAddRequestNodeListItemBondsItem) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilHTTPErrorErrorsItemArray) IsNull() bool { return o.Null }
+func (o NilNodeAddRequestNodeListItemBondsItem) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilHTTPErrorErrorsItemArray) SetToNull() {
-	o.Set = true
+func (o *NilNodeAddRequestNodeListItemBondsItem) SetToNull() {
 	o.Null = true
-	var v []HTTPErrorErrorsItem
+	var v NodeAddRequestNodeListItemBondsItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilHTTPErrorErrorsItemArray) Get() (v []HTTPErrorErrorsItem, ok bool) {
+func (o NilNodeAddRequestNodeListItemBondsItem) Get() (v NodeAddRequestNodeListItemBondsItem, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilHTTPErrorErrorsItemArray) Or(d []HTTPErrorErrorsItem) []HTTPErrorErrorsItem {
+func (o NilNodeAddRequestNodeListItemBondsItem) Or(d NodeAddRequestNodeListItemBondsItem) NodeAddRequestNodeListItemBondsItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilInt returns new OptNilInt with value set to v.
-func NewOptNilInt(v int) OptNilInt {
-	return OptNilInt{
+// NewNilNodeAddRequestNodeListItemInterfacesItem returns new NilNodeAddRequestNodeListItemInterfacesItem with value set to v.
+func NewNilNodeAddRequestNodeListItemInterfacesItem(v NodeAddRequestNodeListItemInterfacesItem) NilNodeAddRequestNodeListItemInterfacesItem {
+	return NilNodeAddRequestNodeListItemInterfacesItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilInt is optional nullable int.
-type OptNilInt struct {
-	Value int
-	Set   bool
+// NilNodeAddRequestNodeListItemInterfacesItem is nullable NodeAddRequestNodeListItemInterfacesItem.
+type NilNodeAddRequestNodeListItemInterfacesItem struct {
+	Value NodeAddRequestNodeListItemInterfacesItem
 	Null  bool
 }
 
-// IsSet returns true if OptNilInt was set.
-func (o OptNilInt) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilInt) SetTo(v int) {
-	o.Set = true
+func (o *NilNodeAddRequestNodeListItemInterfacesItem) SetTo(v NodeAddRequestNodeListItemInterfacesItem) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilInt) IsNull() bool { return o.Null }
+func (o NilNodeAddRequestNodeListItemInterfacesItem) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilInt) SetToNull() {
-	o.Set = true
+func (o *NilNodeAddRequestNodeListItemInterfacesItem) SetToNull() {
 	o.Null = true
-	var v int
+	var v NodeAddRequestNodeListItemInterfacesItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilInt) Get() (v int, ok bool) {
+func (o NilNodeAddRequestNodeListItemInterfacesItem) Get() (v NodeAddRequestNodeListItemInterfacesItem, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilInt) Or(d int) int {
+func (o NilNodeAddRequestNodeListItemInterfacesItem) Or(d NodeAddRequestNodeListItemInterfacesItem) NodeAddRequestNodeListItemInterfacesItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilInt64 returns new OptNilInt64 with value set to v.
-func NewOptNilInt64(v int64) OptNilInt64 {
-	return OptNilInt64{
+// NewNilRedfishJobJobsItem returns new NilRedfishJobJobsItem with value set to v.
+func NewNilRedfishJobJobsItem(v RedfishJobJobsItem) NilRedfishJobJobsItem {
+	return NilRedfishJobJobsItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilInt64 is optional nullable int64.
-type OptNilInt64 struct {
-	Value int64
-	Set   bool
+// NilRedfishJobJobsItem is nullable RedfishJobJobsItem.
+type NilRedfishJobJobsItem struct {
+	Value RedfishJobJobsItem
 	Null  bool
 }
 
-// IsSet returns true if OptNilInt64 was set.
-func (o OptNilInt64) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilInt64) Reset() {
-	var v int64
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilInt64) SetTo(v int64) {
-	o.Set = true
+func (o *NilRedfishJobJobsItem) SetTo(v RedfishJobJobsItem) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilInt64) IsNull() bool { return o.Null }
+func (o NilRedfishJobJobsItem) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilInt64) SetToNull() {
-	o.Set = true
+func (o *NilRedfishJobJobsItem) SetToNull() {
 	o.Null = true
-	var v int64
+	var v RedfishJobJobsItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilInt64) Get() (v int64, ok bool) {
+func (o NilRedfishJobJobsItem) Get() (v RedfishJobJobsItem, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilInt64) Or(d int64) int64 {
+func (o NilRedfishJobJobsItem) Or(d RedfishJobJobsItem) RedfishJobJobsItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray returns new OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray with value set to v.
-func NewOptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray(v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray {
-	return OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray{
+// NewNilRedfishMetricReportReportsItem returns new NilRedfishMetricReportReportsItem with value set to v.
+func NewNilRedfishMetricReportReportsItem(v RedfishMetricReportReportsItem) NilRedfishMetricReportReportsItem {
+	return NilRedfishMetricReportReportsItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray is optional nullable []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem.
-type OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray struct {
-	Value []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
-	Set   bool
+// NilRedfishMetricReportReportsItem is nullable RedfishMetricReportReportsItem.
+type NilRedfishMetricReportReportsItem struct {
+	Value RedfishMetricReportReportsItem
 	Null  bool
 }
 
-// IsSet returns true if OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray was set.
-func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Reset() {
-	var v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) SetTo(v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) {
-	o.Set = true
+func (o *NilRedfishMetricReportReportsItem) SetTo(v RedfishMetricReportReportsItem) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) IsNull() bool {
-	return o.Null
-}
+func (o NilRedfishMetricReportReportsItem) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) SetToNull() {
-	o.Set = true
+func (o *NilRedfishMetricReportReportsItem) SetToNull() {
 	o.Null = true
-	var v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+	var v RedfishMetricReportReportsItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Get() (v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem, ok bool) {
+func (o NilRedfishMetricReportReportsItem) Get() (v RedfishMetricReportReportsItem, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Or(d []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem {
+func (o NilRedfishMetricReportReportsItem) Or(d RedfishMetricReportReportsItem) RedfishMetricReportReportsItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilNilIntArray returns new OptNilNilIntArray with value set to v.
-func NewOptNilNilIntArray(v []NilInt) OptNilNilIntArray {
-	return OptNilNilIntArray{
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilNilIntArray is optional nullable []NilInt.
-type OptNilNilIntArray struct {
-	Value []NilInt
-	Set   bool
+// NilString is nullable string.
+type NilString struct {
+	Value string
 	Null  bool
 }
 
-// IsSet returns true if OptNilNilIntArray was set.
-func (o OptNilNilIntArray) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilNilIntArray) Reset() {
-	var v []NilInt
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilNilIntArray) SetTo(v []NilInt) {
-	o.Set = true
+func (o *NilString) SetTo(v string) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilNilIntArray) IsNull() bool { return o.Null }
+func (o NilString) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilNilIntArray) SetToNull() {
-	o.Set = true
+func (o *NilString) SetToNull() {
 	o.Null = true
-	var v []NilInt
+	var v string
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilNilIntArray) Get() (v []NilInt, ok bool) {
+func (o NilString) Get() (v string, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilNilIntArray) Or(d []NilInt) []NilInt {
+func (o NilString) Or(d string) string {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilNilRedfishJobJobsItemArray returns new OptNilNilRedfishJobJobsItemArray with value set to v.
-func NewOptNilNilRedfishJobJobsItemArray(v []NilRedfishJobJobsItem) OptNilNilRedfishJobJobsItemArray {
-	return OptNilNilRedfishJobJobsItemArray{
+// NewNilSwitchPortReportPortsItem returns new NilSwitchPortReportPortsItem with value set to v.
+func NewNilSwitchPortReportPortsItem(v SwitchPortReportPortsItem) NilSwitchPortReportPortsItem {
+	return NilSwitchPortReportPortsItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilNilRedfishJobJobsItemArray is optional nullable []NilRedfishJobJobsItem.
-type OptNilNilRedfishJobJobsItemArray struct {
-	Value []NilRedfishJobJobsItem
-	Set   bool
+// NilSwitchPortReportPortsItem is nullable SwitchPortReportPortsItem.
+type NilSwitchPortReportPortsItem struct {
+	Value SwitchPortReportPortsItem
 	Null  bool
 }
 
-// IsSet returns true if OptNilNilRedfishJobJobsItemArray was set.
-func (o OptNilNilRedfishJobJobsItemArray) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilNilRedfishJobJobsItemArray) Reset() {
-	var v []NilRedfishJobJobsItem
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilNilRedfishJobJobsItemArray) SetTo(v []NilRedfishJobJobsItem) {
-	o.Set = true
+func (o *NilSwitchPortReportPortsItem) SetTo(v SwitchPortReportPortsItem) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilNilRedfishJobJobsItemArray) IsNull() bool { return o.Null }
+func (o NilSwitchPortReportPortsItem) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilNilRedfishJobJobsItemArray) SetToNull() {
-	o.Set = true
+func (o *NilSwitchPortReportPortsItem) SetToNull() {
 	o.Null = true
-	var v []NilRedfishJobJobsItem
+	var v SwitchPortReportPortsItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilNilRedfishJobJobsItemArray) Get() (v []NilRedfishJobJobsItem, ok bool) {
+func (o NilSwitchPortReportPortsItem) Get() (v SwitchPortReportPortsItem, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilNilRedfishJobJobsItemArray) Or(d []NilRedfishJobJobsItem) []NilRedfishJobJobsItem {
+func (o NilSwitchPortReportPortsItem) Or(d SwitchPortReportPortsItem) SwitchPortReportPortsItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilNilStringArray returns new OptNilNilStringArray with value set to v.
-func NewOptNilNilStringArray(v []NilString) OptNilNilStringArray {
-	return OptNilNilStringArray{
+// NewNilTopologyGraphLinksItem returns new NilTopologyGraphLinksItem with value set to v.
+func NewNilTopologyGraphLinksItem(v TopologyGraphLinksItem) NilTopologyGraphLinksItem {
+	return NilTopologyGraphLinksItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilNilStringArray is optional nullable []NilString.
-type OptNilNilStringArray struct {
-	Value []NilString
-	Set   bool
+// NilTopologyGraphLinksItem is nullable TopologyGraphLinksItem.
+type NilTopologyGraphLinksItem struct {
+	Value TopologyGraphLinksItem
 	Null  bool
 }
 
-// IsSet returns true if OptNilNilStringArray was set.
-func (o OptNilNilStringArray) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilNilStringArray) Reset() {
-	var v []NilString
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilNilStringArray) SetTo(v []NilString) {
-	o.Set = true
+func (o *NilTopologyGraphLinksItem) SetTo(v TopologyGraphLinksItem) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilNilStringArray) IsNull() bool { return o.Null }
+func (o NilTopologyGraphLinksItem) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilNilStringArray) SetToNull() {
-	o.Set = true
+func (o *NilTopologyGraphLinksItem) SetToNull() {
 	o.Null = true
-	var v []NilString
+	var v TopologyGraphLinksItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilNilStringArray) Get() (v []NilString, ok bool) {
+func (o NilTopologyGraphLinksItem) Get() (v TopologyGraphLinksItem, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilNilStringArray) Or(d []NilString) []NilString {
+func (o NilTopologyGraphLinksItem) Or(d TopologyGraphLinksItem) TopologyGraphLinksItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilRedfishSystemOemDell returns new OptNilRedfishSystemOemDell with value set to v.
-func NewOptNilRedfishSystemOemDell(v RedfishSystemOemDell) OptNilRedfishSystemOemDell {
-	return OptNilRedfishSystemOemDell{
+// NewNilTopologyGraphNodesItem returns new NilTopologyGraphNodesItem with value set to v.
+func NewNilTopologyGraphNodesItem(v TopologyGraphNodesItem) NilTopologyGraphNodesItem {
+	return NilTopologyGraphNodesItem{
 		Value: v,
-		Set:   true,
 	}
 }
 
-// OptNilRedfishSystemOemDell is optional nullable RedfishSystemOemDell.
-type OptNilRedfishSystemOemDell struct {
-	Value RedfishSystemOemDell
-	Set   bool
+// NilTopologyGraphNodesItem is nullable TopologyGraphNodesItem.
+type NilTopologyGraphNodesItem struct {
+	Value TopologyGraphNodesItem
 	Null  bool
 }
 
-// IsSet returns true if OptNilRedfishSystemOemDell was set.
-func (o OptNilRedfishSystemOemDell) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilRedfishSystemOemDell) Reset() {
-	var v RedfishSystemOemDell
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
-
 // SetTo sets value to v.
-func (o *OptNilRedfishSystemOemDell) SetTo(v RedfishSystemOemDell) {
-	o.Set = true
+func (o *NilTopologyGraphNodesItem) SetTo(v TopologyGraphNodesItem) {
 	o.Null = false
 	o.Value = v
 }
 
 // IsSet returns true if value is Null.
-func (o OptNilRedfishSystemOemDell) IsNull() bool { return o.Null }
+func (o NilTopologyGraphNodesItem) IsNull() bool { return o.Null }
 
 // SetNull sets value to null.
-func (o *OptNilRedfishSystemOemDell) SetToNull() {
-	o.Set = true
+func (o *NilTopologyGraphNodesItem) SetToNull() {
 	o.Null = true
-	var v RedfishSystemOemDell
+	var v TopologyGraphNodesItem
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilRedfishSystemOemDell) Get() (v RedfishSystemOemDell, ok bool) {
+func (o NilTopologyGraphNodesItem) Get() (v TopologyGraphNodesItem, ok bool) {
 	if o.Null {
 		return v, false
 	}
-	if !o.Set {
-		return v, false
-	}
 	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilRedfishSystemOemDell) Or(d RedfishSystemOemDell) RedfishSystemOemDell {
+func (o NilTopologyGraphNodesItem) Or(d TopologyGraphNodesItem) TopologyGraphNodesItem {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray returns new OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray with value set to v.
-func NewOptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray(v []RedfishSystemOemDellMessageDotExtendedInfoItem) OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray {
-	return OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray{
-		Value: v,
-		Set:   true,
-	}
+// NodeAddRequest schema.
+// Ref: #/components/schemas/NodeAddRequest
+type NodeAddRequest struct {
+	NodeList []NilNodeAddRequestNodeListItem `json:"node_list"`
 }
 
-// OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray is optional nullable []RedfishSystemOemDellMessageDotExtendedInfoItem.
-type OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray struct {
-	Value []RedfishSystemOemDellMessageDotExtendedInfoItem
-	Set   bool
-	Null  bool
+// GetNodeList returns the value of NodeList.
+func (s *NodeAddRequest) GetNodeList() []NilNodeAddRequestNodeListItem {
+	return s.NodeList
 }
 
-// IsSet returns true if OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray was set.
-func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) IsSet() bool { return o.Set }
+// SetNodeList sets the value of NodeList.
+func (s *NodeAddRequest) SetNodeList(val []NilNodeAddRequestNodeListItem) {
+	s.NodeList = val
+}
 
-// Reset unsets value.
-func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Reset() {
-	var v []RedfishSystemOemDellMessageDotExtendedInfoItem
-	o.Value = v
-	o.Set = false
-	o.Null = false
+type NodeAddRequestNodeListItem struct {
+	Bonds      []NilNodeAddRequestNodeListItemBondsItem      `json:"bonds"`
+	BootImage  OptString                                     `json:"boot_image"`
+	Firmware   OptString                                     `json:"firmware"`
+	ID         OptNilInt64                                   `json:"id"`
+	Interfaces []NilNodeAddRequestNodeListItemInterfacesItem `json:"interfaces"`
+	Name       OptString                                     `json:"name"`
+	Provision  OptBool                                       `json:"provision"`
+	Tags       OptNilStringArray                             `json:"tags"`
+	UID        OptNilString                                  `json:"uid"`
 }
 
-// SetTo sets value to v.
-func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) SetTo(v []RedfishSystemOemDellMessageDotExtendedInfoItem) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetBonds returns the value of Bonds.
+func (s *NodeAddRequestNodeListItem) GetBonds() []NilNodeAddRequestNodeListItemBondsItem {
+	return s.Bonds
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) IsNull() bool { return o.Null }
+// GetBootImage returns the value of BootImage.
+func (s *NodeAddRequestNodeListItem) GetBootImage() OptString {
+	return s.BootImage
+}
 
-// SetNull sets value to null.
-func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v []RedfishSystemOemDellMessageDotExtendedInfoItem
-	o.Value = v
+// GetFirmware returns the value of Firmware.
+func (s *NodeAddRequestNodeListItem) GetFirmware() OptString {
+	return s.Firmware
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Get() (v []RedfishSystemOemDellMessageDotExtendedInfoItem, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetID returns the value of ID.
+func (s *NodeAddRequestNodeListItem) GetID() OptNilInt64 {
+	return s.ID
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Or(d []RedfishSystemOemDellMessageDotExtendedInfoItem) []RedfishSystemOemDellMessageDotExtendedInfoItem {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetInterfaces returns the value of Interfaces.
+func (s *NodeAddRequestNodeListItem) GetInterfaces() []NilNodeAddRequestNodeListItemInterfacesItem {
+	return s.Interfaces
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
-		Value: v,
-		Set:   true,
-	}
+// GetName returns the value of Name.
+func (s *NodeAddRequestNodeListItem) GetName() OptString {
+	return s.Name
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
-	Set   bool
-	Null  bool
+// GetProvision returns the value of Provision.
+func (s *NodeAddRequestNodeListItem) GetProvision() OptBool {
+	return s.Provision
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// GetTags returns the value of Tags.
+func (s *NodeAddRequestNodeListItem) GetTags() OptNilStringArray {
+	return s.Tags
+}
 
-// Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetUID returns the value of UID.
+func (s *NodeAddRequestNodeListItem) GetUID() OptNilString {
+	return s.UID
 }
 
-// SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetBonds sets the value of Bonds.
+func (s *NodeAddRequestNodeListItem) SetBonds(val []NilNodeAddRequestNodeListItemBondsItem) {
+	s.Bonds = val
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
+// SetBootImage sets the value of BootImage.
+func (s *NodeAddRequestNodeListItem) SetBootImage(val OptString) {
+	s.BootImage = val
+}
 
-// SetNull sets value to null.
-func (o *OptNilString) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v string
-	o.Value = v
+// SetFirmware sets the value of Firmware.
+func (s *NodeAddRequestNodeListItem) SetFirmware(val OptString) {
+	s.Firmware = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
+// SetID sets the value of ID.
+func (s *NodeAddRequestNodeListItem) SetID(val OptNilInt64) {
+	s.ID = val
+}
+
+// SetInterfaces sets the value of Interfaces.
+func (s *NodeAddRequestNodeListItem) SetInterfaces(val []NilNodeAddRequestNodeListItemInterfacesItem) {
+	s.Interfaces = val
+}
+
+// SetName sets the value of Name.
+func (s *NodeAddRequestNodeListItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetProvision sets the value of Provision.
+func (s *NodeAddRequestNodeListItem) SetProvision(val OptBool) {
+	s.Provision = val
+}
+
+// SetTags sets the value of Tags.
+func (s *NodeAddRequestNodeListItem) SetTags(val OptNilStringArray) {
+	s.Tags = val
+}
+
+// SetUID sets the value of UID.
+func (s *NodeAddRequestNodeListItem) SetUID(val OptNilString) {
+	s.UID = val
+}
+
+type NodeAddRequestNodeListItemBondsItem struct {
+	Bmc        OptBool     `json:"bmc"`
+	Fqdn       OptString   `json:"fqdn"`
+	ID         OptNilInt64 `json:"id"`
+	Ifname     OptString   `json:"ifname"`
+	IP         OptString   `json:"ip"`
+	Ip6        OptString   `json:"ip6"`
+	MAC        OptString   `json:"mac"`
+	Mtu        OptInt      `json:"mtu"`
+	Peers      []string    `json:"peers"`
+	Switch     OptString   `json:"switch"`
+	SwitchPort OptString   `json:"switch_port"`
+	Vlan       OptString   `json:"vlan"`
+}
+
+// GetBmc returns the value of Bmc.
+func (s *NodeAddRequestNodeListItemBondsItem) GetBmc() OptBool {
+	return s.Bmc
+}
+
+// GetFqdn returns the value of Fqdn.
+func (s *NodeAddRequestNodeListItemBondsItem) GetFqdn() OptString {
+	return s.Fqdn
+}
+
+// GetID returns the value of ID.
+func (s *NodeAddRequestNodeListItemBondsItem) GetID() OptNilInt64 {
+	return s.ID
+}
+
+// GetIfname returns the value of Ifname.
+func (s *NodeAddRequestNodeListItemBondsItem) GetIfname() OptString {
+	return s.Ifname
+}
+
+// GetIP returns the value of IP.
+func (s *NodeAddRequestNodeListItemBondsItem) GetIP() OptString {
+	return s.IP
+}
+
+// GetIp6 returns the value of Ip6.
+func (s *NodeAddRequestNodeListItemBondsItem) GetIp6() OptString {
+	return s.Ip6
+}
+
+// GetMAC returns the value of MAC.
+func (s *NodeAddRequestNodeListItemBondsItem) GetMAC() OptString {
+	return s.MAC
+}
+
+// GetMtu returns the value of Mtu.
+func (s *NodeAddRequestNodeListItemBondsItem) GetMtu() OptInt {
+	return s.Mtu
+}
+
+// GetPeers returns the value of Peers.
+func (s *NodeAddRequestNodeListItemBondsItem) GetPeers() []string {
+	return s.Peers
+}
+
+// GetSwitch returns the value of Switch.
+func (s *NodeAddRequestNodeListItemBondsItem) GetSwitch() OptString {
+	return s.Switch
+}
+
+// GetSwitchPort returns the value of SwitchPort.
+func (s *NodeAddRequestNodeListItemBondsItem) GetSwitchPort() OptString {
+	return s.SwitchPort
+}
+
+// GetVlan returns the value of Vlan.
+func (s *NodeAddRequestNodeListItemBondsItem) GetVlan() OptString {
+	return s.Vlan
+}
+
+// SetBmc sets the value of Bmc.
+func (s *NodeAddRequestNodeListItemBondsItem) SetBmc(val OptBool) {
+	s.Bmc = val
+}
+
+// SetFqdn sets the value of Fqdn.
+func (s *NodeAddRequestNodeListItemBondsItem) SetFqdn(val OptString) {
+	s.Fqdn = val
+}
+
+// SetID sets the value of ID.
+func (s *NodeAddRequestNodeListItemBondsItem) SetID(val OptNilInt64) {
+	s.ID = val
+}
+
+// SetIfname sets the value of Ifname.
+func (s *NodeAddRequestNodeListItemBondsItem) SetIfname(val OptString) {
+	s.Ifname = val
+}
+
+// SetIP sets the value of IP.
+func (s *NodeAddRequestNodeListItemBondsItem) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetIp6 sets the value of Ip6.
+func (s *NodeAddRequestNodeListItemBondsItem) SetIp6(val OptString) {
+	s.Ip6 = val
+}
+
+// SetMAC sets the value of MAC.
+func (s *NodeAddRequestNodeListItemBondsItem) SetMAC(val OptString) {
+	s.MAC = val
+}
+
+// SetMtu sets the value of Mtu.
+func (s *NodeAddRequestNodeListItemBondsItem) SetMtu(val OptInt) {
+	s.Mtu = val
+}
+
+// SetPeers sets the value of Peers.
+func (s *NodeAddRequestNodeListItemBondsItem) SetPeers(val []string) {
+	s.Peers = val
+}
+
+// SetSwitch sets the value of Switch.
+func (s *NodeAddRequestNodeListItemBondsItem) SetSwitch(val OptString) {
+	s.Switch = val
+}
+
+// SetSwitchPort sets the value of SwitchPort.
+func (s *NodeAddRequestNodeListItemBondsItem) SetSwitchPort(val OptString) {
+	s.SwitchPort = val
+}
+
+// SetVlan sets the value of Vlan.
+func (s *NodeAddRequestNodeListItemBondsItem) SetVlan(val OptString) {
+	s.Vlan = val
+}
+
+type NodeAddRequestNodeListItemInterfacesItem struct {
+	Bmc        OptBool     `json:"bmc"`
+	Fqdn       OptString   `json:"fqdn"`
+	ID         OptNilInt64 `json:"id"`
+	Ifname     OptString   `json:"ifname"`
+	IP         OptString   `json:"ip"`
+	Ip6        OptString   `json:"ip6"`
+	MAC        OptString   `json:"mac"`
+	Mtu        OptInt      `json:"mtu"`
+	Switch     OptString   `json:"switch"`
+	SwitchPort OptString   `json:"switch_port"`
+	Vlan       OptString   `json:"vlan"`
+}
+
+// GetBmc returns the value of Bmc.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetBmc() OptBool {
+	return s.Bmc
+}
+
+// GetFqdn returns the value of Fqdn.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetFqdn() OptString {
+	return s.Fqdn
+}
+
+// GetID returns the value of ID.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetID() OptNilInt64 {
+	return s.ID
+}
+
+// GetIfname returns the value of Ifname.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetIfname() OptString {
+	return s.Ifname
+}
+
+// GetIP returns the value of IP.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetIP() OptString {
+	return s.IP
+}
+
+// GetIp6 returns the value of Ip6.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetIp6() OptString {
+	return s.Ip6
+}
+
+// GetMAC returns the value of MAC.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetMAC() OptString {
+	return s.MAC
+}
+
+// GetMtu returns the value of Mtu.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetMtu() OptInt {
+	return s.Mtu
+}
+
+// GetSwitch returns the value of Switch.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetSwitch() OptString {
+	return s.Switch
+}
+
+// GetSwitchPort returns the value of SwitchPort.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetSwitchPort() OptString {
+	return s.SwitchPort
+}
+
+// GetVlan returns the value of Vlan.
+func (s *NodeAddRequestNodeListItemInterfacesItem) GetVlan() OptString {
+	return s.Vlan
+}
+
+// SetBmc sets the value of Bmc.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetBmc(val OptBool) {
+	s.Bmc = val
+}
+
+// SetFqdn sets the value of Fqdn.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetFqdn(val OptString) {
+	s.Fqdn = val
+}
+
+// SetID sets the value of ID.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetID(val OptNilInt64) {
+	s.ID = val
+}
+
+// SetIfname sets the value of Ifname.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetIfname(val OptString) {
+	s.Ifname = val
+}
+
+// SetIP sets the value of IP.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetIP(val OptString) {
+	s.IP = val
+}
+
+// SetIp6 sets the value of Ip6.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetIp6(val OptString) {
+	s.Ip6 = val
+}
+
+// SetMAC sets the value of MAC.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetMAC(val OptString) {
+	s.MAC = val
+}
+
+// SetMtu sets the value of Mtu.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetMtu(val OptInt) {
+	s.Mtu = val
+}
+
+// SetSwitch sets the value of Switch.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetSwitch(val OptString) {
+	s.Switch = val
+}
+
+// SetSwitchPort sets the value of SwitchPort.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetSwitchPort(val OptString) {
+	s.SwitchPort = val
+}
+
+// SetVlan sets the value of Vlan.
+func (s *NodeAddRequestNodeListItemInterfacesItem) SetVlan(val OptString) {
+	s.Vlan = val
+}
+
+// NodeBootImageRequest schema.
+// Ref: #/components/schemas/NodeBootImageRequest
+type NodeBootImageRequest struct {
+	Image OptString `json:"image"`
+}
+
+// GetImage returns the value of Image.
+func (s *NodeBootImageRequest) GetImage() OptString {
+	return s.Image
+}
+
+// SetImage sets the value of Image.
+func (s *NodeBootImageRequest) SetImage(val OptString) {
+	s.Image = val
+}
+
+// NodeBootTokenResponse schema.
+// Ref: #/components/schemas/NodeBootTokenResponse
+type NodeBootTokenResponse struct {
+	Nodes []NodeBootTokenResponseNodesItem `json:"nodes"`
+}
+
+// GetNodes returns the value of Nodes.
+func (s *NodeBootTokenResponse) GetNodes() []NodeBootTokenResponseNodesItem {
+	return s.Nodes
+}
+
+// SetNodes sets the value of Nodes.
+func (s *NodeBootTokenResponse) SetNodes(val []NodeBootTokenResponseNodesItem) {
+	s.Nodes = val
+}
+
+type NodeBootTokenResponseNodesItem struct {
+	Name  OptString `json:"name"`
+	Token OptString `json:"token"`
+}
+
+// GetName returns the value of Name.
+func (s *NodeBootTokenResponseNodesItem) GetName() OptString {
+	return s.Name
+}
+
+// GetToken returns the value of Token.
+func (s *NodeBootTokenResponseNodesItem) GetToken() OptString {
+	return s.Token
+}
+
+// SetName sets the value of Name.
+func (s *NodeBootTokenResponseNodesItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetToken sets the value of Token.
+func (s *NodeBootTokenResponseNodesItem) SetToken(val OptString) {
+	s.Token = val
+}
+
+// NodeProvisionRequest schema.
+// Ref: #/components/schemas/NodeProvisionRequest
+type NodeProvisionRequest struct {
+	Provision OptBool `json:"provision"`
+}
+
+// GetProvision returns the value of Provision.
+func (s *NodeProvisionRequest) GetProvision() OptBool {
+	return s.Provision
+}
+
+// SetProvision sets the value of Provision.
+func (s *NodeProvisionRequest) SetProvision(val OptBool) {
+	s.Provision = val
+}
+
+// NodeTagsRequest schema.
+// Ref: #/components/schemas/NodeTagsRequest
+type NodeTagsRequest struct {
+	// Comma separated list of tags.
+	Tags OptString `json:"tags"`
+}
+
+// GetTags returns the value of Tags.
+func (s *NodeTagsRequest) GetTags() OptString {
+	return s.Tags
+}
+
+// SetTags sets the value of Tags.
+func (s *NodeTagsRequest) SetTags(val OptString) {
+	s.Tags = val
+}
+
+// NewOptBmcFirmwareTasksRequestTasks returns new OptBmcFirmwareTasksRequestTasks with value set to v.
+func NewOptBmcFirmwareTasksRequestTasks(v BmcFirmwareTasksRequestTasks) OptBmcFirmwareTasksRequestTasks {
+	return OptBmcFirmwareTasksRequestTasks{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBmcFirmwareTasksRequestTasks is optional BmcFirmwareTasksRequestTasks.
+type OptBmcFirmwareTasksRequestTasks struct {
+	Value BmcFirmwareTasksRequestTasks
+	Set   bool
+}
+
+// IsSet returns true if OptBmcFirmwareTasksRequestTasks was set.
+func (o OptBmcFirmwareTasksRequestTasks) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBmcFirmwareTasksRequestTasks) Reset() {
+	var v BmcFirmwareTasksRequestTasks
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBmcFirmwareTasksRequestTasks) SetTo(v BmcFirmwareTasksRequestTasks) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBmcFirmwareTasksRequestTasks) Get() (v BmcFirmwareTasksRequestTasks, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBmcFirmwareTasksRequestTasks) Or(d BmcFirmwareTasksRequestTasks) BmcFirmwareTasksRequestTasks {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBmcJobDeleteRequestNodeJobList returns new OptBmcJobDeleteRequestNodeJobList with value set to v.
+func NewOptBmcJobDeleteRequestNodeJobList(v BmcJobDeleteRequestNodeJobList) OptBmcJobDeleteRequestNodeJobList {
+	return OptBmcJobDeleteRequestNodeJobList{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBmcJobDeleteRequestNodeJobList is optional BmcJobDeleteRequestNodeJobList.
+type OptBmcJobDeleteRequestNodeJobList struct {
+	Value BmcJobDeleteRequestNodeJobList
+	Set   bool
+}
+
+// IsSet returns true if OptBmcJobDeleteRequestNodeJobList was set.
+func (o OptBmcJobDeleteRequestNodeJobList) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBmcJobDeleteRequestNodeJobList) Reset() {
+	var v BmcJobDeleteRequestNodeJobList
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBmcJobDeleteRequestNodeJobList) SetTo(v BmcJobDeleteRequestNodeJobList) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBmcJobDeleteRequestNodeJobList) Get() (v BmcJobDeleteRequestNodeJobList, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBmcJobDeleteRequestNodeJobList) Or(d BmcJobDeleteRequestNodeJobList) BmcJobDeleteRequestNodeJobList {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBmcTaskHostsItemRedfishError returns new OptBmcTaskHostsItemRedfishError with value set to v.
+func NewOptBmcTaskHostsItemRedfishError(v BmcTaskHostsItemRedfishError) OptBmcTaskHostsItemRedfishError {
+	return OptBmcTaskHostsItemRedfishError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBmcTaskHostsItemRedfishError is optional BmcTaskHostsItemRedfishError.
+type OptBmcTaskHostsItemRedfishError struct {
+	Value BmcTaskHostsItemRedfishError
+	Set   bool
+}
+
+// IsSet returns true if OptBmcTaskHostsItemRedfishError was set.
+func (o OptBmcTaskHostsItemRedfishError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBmcTaskHostsItemRedfishError) Reset() {
+	var v BmcTaskHostsItemRedfishError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBmcTaskHostsItemRedfishError) SetTo(v BmcTaskHostsItemRedfishError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBmcTaskHostsItemRedfishError) Get() (v BmcTaskHostsItemRedfishError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBmcTaskHostsItemRedfishError) Or(d BmcTaskHostsItemRedfishError) BmcTaskHostsItemRedfishError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBmcTaskHostsItemRedfishErrorError returns new OptBmcTaskHostsItemRedfishErrorError with value set to v.
+func NewOptBmcTaskHostsItemRedfishErrorError(v BmcTaskHostsItemRedfishErrorError) OptBmcTaskHostsItemRedfishErrorError {
+	return OptBmcTaskHostsItemRedfishErrorError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBmcTaskHostsItemRedfishErrorError is optional BmcTaskHostsItemRedfishErrorError.
+type OptBmcTaskHostsItemRedfishErrorError struct {
+	Value BmcTaskHostsItemRedfishErrorError
+	Set   bool
+}
+
+// IsSet returns true if OptBmcTaskHostsItemRedfishErrorError was set.
+func (o OptBmcTaskHostsItemRedfishErrorError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBmcTaskHostsItemRedfishErrorError) Reset() {
+	var v BmcTaskHostsItemRedfishErrorError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBmcTaskHostsItemRedfishErrorError) SetTo(v BmcTaskHostsItemRedfishErrorError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBmcTaskHostsItemRedfishErrorError) Get() (v BmcTaskHostsItemRedfishErrorError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBmcTaskHostsItemRedfishErrorError) Or(d BmcTaskHostsItemRedfishErrorError) BmcTaskHostsItemRedfishErrorError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEventJobMessagesItemRedfishError returns new OptEventJobMessagesItemRedfishError with value set to v.
+func NewOptEventJobMessagesItemRedfishError(v EventJobMessagesItemRedfishError) OptEventJobMessagesItemRedfishError {
+	return OptEventJobMessagesItemRedfishError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEventJobMessagesItemRedfishError is optional EventJobMessagesItemRedfishError.
+type OptEventJobMessagesItemRedfishError struct {
+	Value EventJobMessagesItemRedfishError
+	Set   bool
+}
+
+// IsSet returns true if OptEventJobMessagesItemRedfishError was set.
+func (o OptEventJobMessagesItemRedfishError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEventJobMessagesItemRedfishError) Reset() {
+	var v EventJobMessagesItemRedfishError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEventJobMessagesItemRedfishError) SetTo(v EventJobMessagesItemRedfishError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEventJobMessagesItemRedfishError) Get() (v EventJobMessagesItemRedfishError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEventJobMessagesItemRedfishError) Or(d EventJobMessagesItemRedfishError) EventJobMessagesItemRedfishError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptEventJobMessagesItemRedfishErrorError returns new OptEventJobMessagesItemRedfishErrorError with value set to v.
+func NewOptEventJobMessagesItemRedfishErrorError(v EventJobMessagesItemRedfishErrorError) OptEventJobMessagesItemRedfishErrorError {
+	return OptEventJobMessagesItemRedfishErrorError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptEventJobMessagesItemRedfishErrorError is optional EventJobMessagesItemRedfishErrorError.
+type OptEventJobMessagesItemRedfishErrorError struct {
+	Value EventJobMessagesItemRedfishErrorError
+	Set   bool
+}
+
+// IsSet returns true if OptEventJobMessagesItemRedfishErrorError was set.
+func (o OptEventJobMessagesItemRedfishErrorError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptEventJobMessagesItemRedfishErrorError) Reset() {
+	var v EventJobMessagesItemRedfishErrorError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptEventJobMessagesItemRedfishErrorError) SetTo(v EventJobMessagesItemRedfishErrorError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptEventJobMessagesItemRedfishErrorError) Get() (v EventJobMessagesItemRedfishErrorError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptEventJobMessagesItemRedfishErrorError) Or(d EventJobMessagesItemRedfishErrorError) EventJobMessagesItemRedfishErrorError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat32 returns new OptFloat32 with value set to v.
+func NewOptFloat32(v float32) OptFloat32 {
+	return OptFloat32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat32 is optional float32.
+type OptFloat32 struct {
+	Value float32
+	Set   bool
+}
+
+// IsSet returns true if OptFloat32 was set.
+func (o OptFloat32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat32) Reset() {
+	var v float32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat32) SetTo(v float32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat32) Get() (v float32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat32) Or(d float32) float32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptHTTPErrorErrorsItemMore returns new OptHTTPErrorErrorsItemMore with value set to v.
+func NewOptHTTPErrorErrorsItemMore(v HTTPErrorErrorsItemMore) OptHTTPErrorErrorsItemMore {
+	return OptHTTPErrorErrorsItemMore{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptHTTPErrorErrorsItemMore is optional HTTPErrorErrorsItemMore.
+type OptHTTPErrorErrorsItemMore struct {
+	Value HTTPErrorErrorsItemMore
+	Set   bool
+}
+
+// IsSet returns true if OptHTTPErrorErrorsItemMore was set.
+func (o OptHTTPErrorErrorsItemMore) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptHTTPErrorErrorsItemMore) Reset() {
+	var v HTTPErrorErrorsItemMore
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptHTTPErrorErrorsItemMore) SetTo(v HTTPErrorErrorsItemMore) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptHTTPErrorErrorsItemMore) Get() (v HTTPErrorErrorsItemMore, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptHTTPErrorErrorsItemMore) Or(d HTTPErrorErrorsItemMore) HTTPErrorErrorsItemMore {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptJobMessageRedfishError returns new OptJobMessageRedfishError with value set to v.
+func NewOptJobMessageRedfishError(v JobMessageRedfishError) OptJobMessageRedfishError {
+	return OptJobMessageRedfishError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptJobMessageRedfishError is optional JobMessageRedfishError.
+type OptJobMessageRedfishError struct {
+	Value JobMessageRedfishError
+	Set   bool
+}
+
+// IsSet returns true if OptJobMessageRedfishError was set.
+func (o OptJobMessageRedfishError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptJobMessageRedfishError) Reset() {
+	var v JobMessageRedfishError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptJobMessageRedfishError) SetTo(v JobMessageRedfishError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptJobMessageRedfishError) Get() (v JobMessageRedfishError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptJobMessageRedfishError) Or(d JobMessageRedfishError) JobMessageRedfishError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptJobMessageRedfishErrorError returns new OptJobMessageRedfishErrorError with value set to v.
+func NewOptJobMessageRedfishErrorError(v JobMessageRedfishErrorError) OptJobMessageRedfishErrorError {
+	return OptJobMessageRedfishErrorError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptJobMessageRedfishErrorError is optional JobMessageRedfishErrorError.
+type OptJobMessageRedfishErrorError struct {
+	Value JobMessageRedfishErrorError
+	Set   bool
+}
+
+// IsSet returns true if OptJobMessageRedfishErrorError was set.
+func (o OptJobMessageRedfishErrorError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptJobMessageRedfishErrorError) Reset() {
+	var v JobMessageRedfishErrorError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptJobMessageRedfishErrorError) SetTo(v JobMessageRedfishErrorError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptJobMessageRedfishErrorError) Get() (v JobMessageRedfishErrorError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptJobMessageRedfishErrorError) Or(d JobMessageRedfishErrorError) JobMessageRedfishErrorError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBootImageAddRequestBootImagesItemProvisionTemplates returns new OptNilBootImageAddRequestBootImagesItemProvisionTemplates with value set to v.
+func NewOptNilBootImageAddRequestBootImagesItemProvisionTemplates(v BootImageAddRequestBootImagesItemProvisionTemplates) OptNilBootImageAddRequestBootImagesItemProvisionTemplates {
+	return OptNilBootImageAddRequestBootImagesItemProvisionTemplates{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBootImageAddRequestBootImagesItemProvisionTemplates is optional nullable BootImageAddRequestBootImagesItemProvisionTemplates.
+type OptNilBootImageAddRequestBootImagesItemProvisionTemplates struct {
+	Value BootImageAddRequestBootImagesItemProvisionTemplates
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBootImageAddRequestBootImagesItemProvisionTemplates was set.
+func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Reset() {
+	var v BootImageAddRequestBootImagesItemProvisionTemplates
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) SetTo(v BootImageAddRequestBootImagesItemProvisionTemplates) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilBootImageAddRequestBootImagesItemProvisionTemplates) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v BootImageAddRequestBootImagesItemProvisionTemplates
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Get() (v BootImageAddRequestBootImagesItemProvisionTemplates, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBootImageAddRequestBootImagesItemProvisionTemplates) Or(d BootImageAddRequestBootImagesItemProvisionTemplates) BootImageAddRequestBootImagesItemProvisionTemplates {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBootImageProvisionTemplates returns new OptNilBootImageProvisionTemplates with value set to v.
+func NewOptNilBootImageProvisionTemplates(v BootImageProvisionTemplates) OptNilBootImageProvisionTemplates {
+	return OptNilBootImageProvisionTemplates{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBootImageProvisionTemplates is optional nullable BootImageProvisionTemplates.
+type OptNilBootImageProvisionTemplates struct {
+	Value BootImageProvisionTemplates
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBootImageProvisionTemplates was set.
+func (o OptNilBootImageProvisionTemplates) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBootImageProvisionTemplates) Reset() {
+	var v BootImageProvisionTemplates
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBootImageProvisionTemplates) SetTo(v BootImageProvisionTemplates) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilBootImageProvisionTemplates) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilBootImageProvisionTemplates) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v BootImageProvisionTemplates
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBootImageProvisionTemplates) Get() (v BootImageProvisionTemplates, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBootImageProvisionTemplates) Or(d BootImageProvisionTemplates) BootImageProvisionTemplates {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBootImageVersionImage returns new OptNilBootImageVersionImage with value set to v.
+func NewOptNilBootImageVersionImage(v BootImageVersionImage) OptNilBootImageVersionImage {
+	return OptNilBootImageVersionImage{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBootImageVersionImage is optional nullable BootImageVersionImage.
+type OptNilBootImageVersionImage struct {
+	Value BootImageVersionImage
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBootImageVersionImage was set.
+func (o OptNilBootImageVersionImage) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBootImageVersionImage) Reset() {
+	var v BootImageVersionImage
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBootImageVersionImage) SetTo(v BootImageVersionImage) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilBootImageVersionImage) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilBootImageVersionImage) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v BootImageVersionImage
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBootImageVersionImage) Get() (v BootImageVersionImage, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBootImageVersionImage) Or(d BootImageVersionImage) BootImageVersionImage {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBootImageVersionImageProvisionTemplates returns new OptNilBootImageVersionImageProvisionTemplates with value set to v.
+func NewOptNilBootImageVersionImageProvisionTemplates(v BootImageVersionImageProvisionTemplates) OptNilBootImageVersionImageProvisionTemplates {
+	return OptNilBootImageVersionImageProvisionTemplates{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBootImageVersionImageProvisionTemplates is optional nullable BootImageVersionImageProvisionTemplates.
+type OptNilBootImageVersionImageProvisionTemplates struct {
+	Value BootImageVersionImageProvisionTemplates
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBootImageVersionImageProvisionTemplates was set.
+func (o OptNilBootImageVersionImageProvisionTemplates) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBootImageVersionImageProvisionTemplates) Reset() {
+	var v BootImageVersionImageProvisionTemplates
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBootImageVersionImageProvisionTemplates) SetTo(v BootImageVersionImageProvisionTemplates) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilBootImageVersionImageProvisionTemplates) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilBootImageVersionImageProvisionTemplates) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v BootImageVersionImageProvisionTemplates
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBootImageVersionImageProvisionTemplates) Get() (v BootImageVersionImageProvisionTemplates, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBootImageVersionImageProvisionTemplates) Or(d BootImageVersionImageProvisionTemplates) BootImageVersionImageProvisionTemplates {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDataDumpImagesItemProvisionTemplates returns new OptNilDataDumpImagesItemProvisionTemplates with value set to v.
+func NewOptNilDataDumpImagesItemProvisionTemplates(v DataDumpImagesItemProvisionTemplates) OptNilDataDumpImagesItemProvisionTemplates {
+	return OptNilDataDumpImagesItemProvisionTemplates{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDataDumpImagesItemProvisionTemplates is optional nullable DataDumpImagesItemProvisionTemplates.
+type OptNilDataDumpImagesItemProvisionTemplates struct {
+	Value DataDumpImagesItemProvisionTemplates
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDataDumpImagesItemProvisionTemplates was set.
+func (o OptNilDataDumpImagesItemProvisionTemplates) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDataDumpImagesItemProvisionTemplates) Reset() {
+	var v DataDumpImagesItemProvisionTemplates
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDataDumpImagesItemProvisionTemplates) SetTo(v DataDumpImagesItemProvisionTemplates) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilDataDumpImagesItemProvisionTemplates) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilDataDumpImagesItemProvisionTemplates) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v DataDumpImagesItemProvisionTemplates
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDataDumpImagesItemProvisionTemplates) Get() (v DataDumpImagesItemProvisionTemplates, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDataDumpImagesItemProvisionTemplates) Or(d DataDumpImagesItemProvisionTemplates) DataDumpImagesItemProvisionTemplates {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilFloat64 returns new OptNilFloat64 with value set to v.
+func NewOptNilFloat64(v float64) OptNilFloat64 {
+	return OptNilFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilFloat64 is optional nullable float64.
+type OptNilFloat64 struct {
+	Value float64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilFloat64 was set.
+func (o OptNilFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilFloat64) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilFloat64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v float64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilHTTPErrorErrorsItemArray returns new OptNilHTTPErrorErrorsItemArray with value set to v.
+func NewOptNilHTTPErrorErrorsItemArray(v []HTTPErrorErrorsItem) OptNilHTTPErrorErrorsItemArray {
+	return OptNilHTTPErrorErrorsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilHTTPErrorErrorsItemArray is optional nullable []HTTPErrorErrorsItem.
+type OptNilHTTPErrorErrorsItemArray struct {
+	Value []HTTPErrorErrorsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilHTTPErrorErrorsItemArray was set.
+func (o OptNilHTTPErrorErrorsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilHTTPErrorErrorsItemArray) Reset() {
+	var v []HTTPErrorErrorsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilHTTPErrorErrorsItemArray) SetTo(v []HTTPErrorErrorsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilHTTPErrorErrorsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilHTTPErrorErrorsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []HTTPErrorErrorsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilHTTPErrorErrorsItemArray) Get() (v []HTTPErrorErrorsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilHTTPErrorErrorsItemArray) Or(d []HTTPErrorErrorsItem) []HTTPErrorErrorsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt was set.
+func (o OptNilInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt) SetTo(v int) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilInt) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilInt) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt64 returns new OptNilInt64 with value set to v.
+func NewOptNilInt64(v int64) OptNilInt64 {
+	return OptNilInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt64 is optional nullable int64.
+type OptNilInt64 struct {
+	Value int64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt64 was set.
+func (o OptNilInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt64) SetTo(v int64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilInt64) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilInt64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt64) Get() (v int64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray returns new OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray with value set to v.
+func NewOptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray(v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray {
+	return OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray is optional nullable []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem.
+type OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray struct {
+	Value []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray was set.
+func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Reset() {
+	var v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) SetTo(v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) IsNull() bool {
+	return o.Null
+}
+
+// SetNull sets value to null.
+func (o *OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Get() (v []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilJobMessageRedfishErrorErrorMessageDotExtendedInfoItemArray) Or(d []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem) []JobMessageRedfishErrorErrorMessageDotExtendedInfoItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilIntArray returns new OptNilNilIntArray with value set to v.
+func NewOptNilNilIntArray(v []NilInt) OptNilNilIntArray {
+	return OptNilNilIntArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilIntArray is optional nullable []NilInt.
+type OptNilNilIntArray struct {
+	Value []NilInt
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilIntArray was set.
+func (o OptNilNilIntArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilIntArray) Reset() {
+	var v []NilInt
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilIntArray) SetTo(v []NilInt) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilIntArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilIntArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilInt
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilIntArray) Get() (v []NilInt, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilIntArray) Or(d []NilInt) []NilInt {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilRedfishJobJobsItemArray returns new OptNilNilRedfishJobJobsItemArray with value set to v.
+func NewOptNilNilRedfishJobJobsItemArray(v []NilRedfishJobJobsItem) OptNilNilRedfishJobJobsItemArray {
+	return OptNilNilRedfishJobJobsItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilRedfishJobJobsItemArray is optional nullable []NilRedfishJobJobsItem.
+type OptNilNilRedfishJobJobsItemArray struct {
+	Value []NilRedfishJobJobsItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilRedfishJobJobsItemArray was set.
+func (o OptNilNilRedfishJobJobsItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilRedfishJobJobsItemArray) Reset() {
+	var v []NilRedfishJobJobsItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilRedfishJobJobsItemArray) SetTo(v []NilRedfishJobJobsItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilRedfishJobJobsItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilRedfishJobJobsItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilRedfishJobJobsItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilRedfishJobJobsItemArray) Get() (v []NilRedfishJobJobsItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilRedfishJobJobsItemArray) Or(d []NilRedfishJobJobsItem) []NilRedfishJobJobsItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilNilStringArray returns new OptNilNilStringArray with value set to v.
+func NewOptNilNilStringArray(v []NilString) OptNilNilStringArray {
+	return OptNilNilStringArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilNilStringArray is optional nullable []NilString.
+type OptNilNilStringArray struct {
+	Value []NilString
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilNilStringArray was set.
+func (o OptNilNilStringArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilNilStringArray) Reset() {
+	var v []NilString
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilNilStringArray) SetTo(v []NilString) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilNilStringArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilNilStringArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []NilString
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilNilStringArray) Get() (v []NilString, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilNilStringArray) Or(d []NilString) []NilString {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilRedfishSystemOemDell returns new OptNilRedfishSystemOemDell with value set to v.
+func NewOptNilRedfishSystemOemDell(v RedfishSystemOemDell) OptNilRedfishSystemOemDell {
+	return OptNilRedfishSystemOemDell{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilRedfishSystemOemDell is optional nullable RedfishSystemOemDell.
+type OptNilRedfishSystemOemDell struct {
+	Value RedfishSystemOemDell
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilRedfishSystemOemDell was set.
+func (o OptNilRedfishSystemOemDell) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilRedfishSystemOemDell) Reset() {
+	var v RedfishSystemOemDell
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilRedfishSystemOemDell) SetTo(v RedfishSystemOemDell) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilRedfishSystemOemDell) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilRedfishSystemOemDell) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v RedfishSystemOemDell
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilRedfishSystemOemDell) Get() (v RedfishSystemOemDell, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilRedfishSystemOemDell) Or(d RedfishSystemOemDell) RedfishSystemOemDell {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray returns new OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray with value set to v.
+func NewOptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray(v []RedfishSystemOemDellMessageDotExtendedInfoItem) OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray {
+	return OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray is optional nullable []RedfishSystemOemDellMessageDotExtendedInfoItem.
+type OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray struct {
+	Value []RedfishSystemOemDellMessageDotExtendedInfoItem
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray was set.
+func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Reset() {
+	var v []RedfishSystemOemDellMessageDotExtendedInfoItem
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) SetTo(v []RedfishSystemOemDellMessageDotExtendedInfoItem) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []RedfishSystemOemDellMessageDotExtendedInfoItem
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Get() (v []RedfishSystemOemDellMessageDotExtendedInfoItem, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) Or(d []RedfishSystemOemDellMessageDotExtendedInfoItem) []RedfishSystemOemDellMessageDotExtendedInfoItem {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilStringArray returns new OptNilStringArray with value set to v.
+func NewOptNilStringArray(v []string) OptNilStringArray {
+	return OptNilStringArray{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilStringArray is optional nullable []string.
+type OptNilStringArray struct {
+	Value []string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilStringArray was set.
+func (o OptNilStringArray) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilStringArray) Reset() {
+	var v []string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilStringArray) SetTo(v []string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsSet returns true if value is Null.
+func (o OptNilStringArray) IsNull() bool { return o.Null }
+
+// SetNull sets value to null.
+func (o *OptNilStringArray) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v []string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilStringArray) Get() (v []string, ok bool) {
 	if o.Null {
 		return v, false
 	}
@@ -4728,1505 +7127,2431 @@ func (o OptNilString) Get() (v string, ok bool) {
 	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Or returns value if set, or given parameter if does not.
+func (o OptNilStringArray) Or(d []string) []string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRedfishJobJobsItemParameters returns new OptRedfishJobJobsItemParameters with value set to v.
+func NewOptRedfishJobJobsItemParameters(v RedfishJobJobsItemParameters) OptRedfishJobJobsItemParameters {
+	return OptRedfishJobJobsItemParameters{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRedfishJobJobsItemParameters is optional RedfishJobJobsItemParameters.
+type OptRedfishJobJobsItemParameters struct {
+	Value RedfishJobJobsItemParameters
+	Set   bool
+}
+
+// IsSet returns true if OptRedfishJobJobsItemParameters was set.
+func (o OptRedfishJobJobsItemParameters) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRedfishJobJobsItemParameters) Reset() {
+	var v RedfishJobJobsItemParameters
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRedfishJobJobsItemParameters) SetTo(v RedfishJobJobsItemParameters) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRedfishJobJobsItemParameters) Get() (v RedfishJobJobsItemParameters, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRedfishJobJobsItemParameters) Or(d RedfishJobJobsItemParameters) RedfishJobJobsItemParameters {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRedfishJobJobsItemPayload returns new OptRedfishJobJobsItemPayload with value set to v.
+func NewOptRedfishJobJobsItemPayload(v RedfishJobJobsItemPayload) OptRedfishJobJobsItemPayload {
+	return OptRedfishJobJobsItemPayload{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRedfishJobJobsItemPayload is optional RedfishJobJobsItemPayload.
+type OptRedfishJobJobsItemPayload struct {
+	Value RedfishJobJobsItemPayload
+	Set   bool
+}
+
+// IsSet returns true if OptRedfishJobJobsItemPayload was set.
+func (o OptRedfishJobJobsItemPayload) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRedfishJobJobsItemPayload) Reset() {
+	var v RedfishJobJobsItemPayload
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRedfishJobJobsItemPayload) SetTo(v RedfishJobJobsItemPayload) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRedfishJobJobsItemPayload) Get() (v RedfishJobJobsItemPayload, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRedfishJobJobsItemPayload) Or(d RedfishJobJobsItemPayload) RedfishJobJobsItemPayload {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRedfishJobJobsItemSchedule returns new OptRedfishJobJobsItemSchedule with value set to v.
+func NewOptRedfishJobJobsItemSchedule(v RedfishJobJobsItemSchedule) OptRedfishJobJobsItemSchedule {
+	return OptRedfishJobJobsItemSchedule{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRedfishJobJobsItemSchedule is optional RedfishJobJobsItemSchedule.
+type OptRedfishJobJobsItemSchedule struct {
+	Value RedfishJobJobsItemSchedule
+	Set   bool
+}
+
+// IsSet returns true if OptRedfishJobJobsItemSchedule was set.
+func (o OptRedfishJobJobsItemSchedule) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRedfishJobJobsItemSchedule) Reset() {
+	var v RedfishJobJobsItemSchedule
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRedfishJobJobsItemSchedule) SetTo(v RedfishJobJobsItemSchedule) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRedfishJobJobsItemSchedule) Get() (v RedfishJobJobsItemSchedule, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRedfishJobJobsItemSchedule) Or(d RedfishJobJobsItemSchedule) RedfishJobJobsItemSchedule {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRolloutPrevious returns new OptRolloutPrevious with value set to v.
+func NewOptRolloutPrevious(v RolloutPrevious) OptRolloutPrevious {
+	return OptRolloutPrevious{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRolloutPrevious is optional RolloutPrevious.
+type OptRolloutPrevious struct {
+	Value RolloutPrevious
+	Set   bool
+}
+
+// IsSet returns true if OptRolloutPrevious was set.
+func (o OptRolloutPrevious) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRolloutPrevious) Reset() {
+	var v RolloutPrevious
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRolloutPrevious) SetTo(v RolloutPrevious) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRolloutPrevious) Get() (v RolloutPrevious, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRolloutPrevious) Or(d RolloutPrevious) RolloutPrevious {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+type POSTV1ImagesArtifactsReq struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s POSTV1ImagesArtifactsReq) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+// PatchRolesRequest schema.
+// Ref: #/components/schemas/PatchRolesRequest
+type PatchRolesRequest struct {
+	PermissionList []PatchRolesRequestPermissionListItem `json:"permission_list"`
+	Role           OptString                             `json:"role"`
+}
+
+// GetPermissionList returns the value of PermissionList.
+func (s *PatchRolesRequest) GetPermissionList() []PatchRolesRequestPermissionListItem {
+	return s.PermissionList
+}
+
+// GetRole returns the value of Role.
+func (s *PatchRolesRequest) GetRole() OptString {
+	return s.Role
+}
+
+// SetPermissionList sets the value of PermissionList.
+func (s *PatchRolesRequest) SetPermissionList(val []PatchRolesRequestPermissionListItem) {
+	s.PermissionList = val
+}
+
+// SetRole sets the value of Role.
+func (s *PatchRolesRequest) SetRole(val OptString) {
+	s.Role = val
+}
+
+type PatchRolesRequestPermissionListItem struct {
+	Method OptString `json:"method"`
+	Path   OptString `json:"path"`
+}
+
+// GetMethod returns the value of Method.
+func (s *PatchRolesRequestPermissionListItem) GetMethod() OptString {
+	return s.Method
+}
+
+// GetPath returns the value of Path.
+func (s *PatchRolesRequestPermissionListItem) GetPath() OptString {
+	return s.Path
+}
+
+// SetMethod sets the value of Method.
+func (s *PatchRolesRequestPermissionListItem) SetMethod(val OptString) {
+	s.Method = val
+}
+
+// SetPath sets the value of Path.
+func (s *PatchRolesRequestPermissionListItem) SetPath(val OptString) {
+	s.Path = val
+}
+
+// PostRolesRequest schema.
+// Ref: #/components/schemas/PostRolesRequest
+type PostRolesRequest struct {
+	InheritedRole OptString `json:"inherited_role"`
+	Role          OptString `json:"role"`
+}
+
+// GetInheritedRole returns the value of InheritedRole.
+func (s *PostRolesRequest) GetInheritedRole() OptString {
+	return s.InheritedRole
+}
+
+// GetRole returns the value of Role.
+func (s *PostRolesRequest) GetRole() OptString {
+	return s.Role
+}
+
+// SetInheritedRole sets the value of InheritedRole.
+func (s *PostRolesRequest) SetInheritedRole(val OptString) {
+	s.InheritedRole = val
+}
+
+// SetRole sets the value of Role.
+func (s *PostRolesRequest) SetRole(val OptString) {
+	s.Role = val
+}
+
+// ProvisionEvent schema.
+// Ref: #/components/schemas/ProvisionEvent
+type ProvisionEvent struct {
+	Host    OptString   `json:"host"`
+	ID      OptInt64    `json:"id"`
+	Message OptString   `json:"message"`
+	State   OptString   `json:"state"`
+	Time    OptDateTime `json:"time"`
+}
+
+// GetHost returns the value of Host.
+func (s *ProvisionEvent) GetHost() OptString {
+	return s.Host
+}
+
+// GetID returns the value of ID.
+func (s *ProvisionEvent) GetID() OptInt64 {
+	return s.ID
+}
+
+// GetMessage returns the value of Message.
+func (s *ProvisionEvent) GetMessage() OptString {
+	return s.Message
+}
+
+// GetState returns the value of State.
+func (s *ProvisionEvent) GetState() OptString {
+	return s.State
+}
+
+// GetTime returns the value of Time.
+func (s *ProvisionEvent) GetTime() OptDateTime {
+	return s.Time
+}
+
+// SetHost sets the value of Host.
+func (s *ProvisionEvent) SetHost(val OptString) {
+	s.Host = val
+}
+
+// SetID sets the value of ID.
+func (s *ProvisionEvent) SetID(val OptInt64) {
+	s.ID = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ProvisionEvent) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetState sets the value of State.
+func (s *ProvisionEvent) SetState(val OptString) {
+	s.State = val
+}
+
+// SetTime sets the value of Time.
+func (s *ProvisionEvent) SetTime(val OptDateTime) {
+	s.Time = val
+}
+
+// ProvisionLog schema.
+// Ref: #/components/schemas/ProvisionLog
+type ProvisionLog struct {
+	Content OptString   `json:"content"`
+	Host    OptString   `json:"host"`
+	ID      OptInt64    `json:"id"`
+	Kind    OptString   `json:"kind"`
+	Name    OptString   `json:"name"`
+	Time    OptDateTime `json:"time"`
+}
+
+// GetContent returns the value of Content.
+func (s *ProvisionLog) GetContent() OptString {
+	return s.Content
+}
+
+// GetHost returns the value of Host.
+func (s *ProvisionLog) GetHost() OptString {
+	return s.Host
+}
+
+// GetID returns the value of ID.
+func (s *ProvisionLog) GetID() OptInt64 {
+	return s.ID
+}
+
+// GetKind returns the value of Kind.
+func (s *ProvisionLog) GetKind() OptString {
+	return s.Kind
+}
+
+// GetName returns the value of Name.
+func (s *ProvisionLog) GetName() OptString {
+	return s.Name
+}
+
+// GetTime returns the value of Time.
+func (s *ProvisionLog) GetTime() OptDateTime {
+	return s.Time
+}
+
+// SetContent sets the value of Content.
+func (s *ProvisionLog) SetContent(val OptString) {
+	s.Content = val
+}
+
+// SetHost sets the value of Host.
+func (s *ProvisionLog) SetHost(val OptString) {
+	s.Host = val
+}
+
+// SetID sets the value of ID.
+func (s *ProvisionLog) SetID(val OptInt64) {
+	s.ID = val
+}
+
+// SetKind sets the value of Kind.
+func (s *ProvisionLog) SetKind(val OptString) {
+	s.Kind = val
+}
+
+// SetName sets the value of Name.
+func (s *ProvisionLog) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetTime sets the value of Time.
+func (s *ProvisionLog) SetTime(val OptDateTime) {
+	s.Time = val
+}
+
+// RedfishDellUpgradeFirmware schema.
+// Ref: #/components/schemas/RedfishDellUpgradeFirmware
+type RedfishDellUpgradeFirmware struct {
+	Message          OptString                                  `json:"Message"`
+	Name             OptString                                  `json:"Name"`
+	Status           OptString                                  `json:"Status"`
+	UpdateCount      OptInt                                     `json:"UpdateCount"`
+	UpdateList       []RedfishDellUpgradeFirmwareUpdateListItem `json:"UpdateList"`
+	UpdateRebootType OptString                                  `json:"UpdateRebootType"`
+}
+
+// GetMessage returns the value of Message.
+func (s *RedfishDellUpgradeFirmware) GetMessage() OptString {
+	return s.Message
+}
+
+// GetName returns the value of Name.
+func (s *RedfishDellUpgradeFirmware) GetName() OptString {
+	return s.Name
+}
+
+// GetStatus returns the value of Status.
+func (s *RedfishDellUpgradeFirmware) GetStatus() OptString {
+	return s.Status
+}
+
+// GetUpdateCount returns the value of UpdateCount.
+func (s *RedfishDellUpgradeFirmware) GetUpdateCount() OptInt {
+	return s.UpdateCount
+}
+
+// GetUpdateList returns the value of UpdateList.
+func (s *RedfishDellUpgradeFirmware) GetUpdateList() []RedfishDellUpgradeFirmwareUpdateListItem {
+	return s.UpdateList
+}
+
+// GetUpdateRebootType returns the value of UpdateRebootType.
+func (s *RedfishDellUpgradeFirmware) GetUpdateRebootType() OptString {
+	return s.UpdateRebootType
+}
+
+// SetMessage sets the value of Message.
+func (s *RedfishDellUpgradeFirmware) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishDellUpgradeFirmware) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetStatus sets the value of Status.
+func (s *RedfishDellUpgradeFirmware) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// SetUpdateCount sets the value of UpdateCount.
+func (s *RedfishDellUpgradeFirmware) SetUpdateCount(val OptInt) {
+	s.UpdateCount = val
+}
+
+// SetUpdateList sets the value of UpdateList.
+func (s *RedfishDellUpgradeFirmware) SetUpdateList(val []RedfishDellUpgradeFirmwareUpdateListItem) {
+	s.UpdateList = val
+}
+
+// SetUpdateRebootType sets the value of UpdateRebootType.
+func (s *RedfishDellUpgradeFirmware) SetUpdateRebootType(val OptString) {
+	s.UpdateRebootType = val
+}
+
+type RedfishDellUpgradeFirmwareUpdateListItem struct {
+	BaseLocation       OptString `json:"BaseLocation"`
+	ComponentID        OptString `json:"ComponentID"`
+	ComponentInfoName  OptString `json:"ComponentInfoName"`
+	ComponentInfoValue OptString `json:"ComponentInfoValue"`
+	ComponentType      OptString `json:"ComponentType"`
+	Criticality        OptString `json:"Criticality"`
+	DisplayName        OptString `json:"DisplayName"`
+	InstalledVersion   OptString `json:"InstalledVersion"`
+	JobID              OptString `json:"JobID"`
+	Name               OptString `json:"Name"`
+	PackageName        OptString `json:"PackageName"`
+	PackagePath        OptString `json:"PackagePath"`
+	PackageVersion     OptString `json:"PackageVersion"`
+	RebootType         OptString `json:"RebootType"`
+	Target             OptString `json:"Target"`
+}
+
+// GetBaseLocation returns the value of BaseLocation.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetBaseLocation() OptString {
+	return s.BaseLocation
+}
+
+// GetComponentID returns the value of ComponentID.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentID() OptString {
+	return s.ComponentID
+}
+
+// GetComponentInfoName returns the value of ComponentInfoName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentInfoName() OptString {
+	return s.ComponentInfoName
+}
+
+// GetComponentInfoValue returns the value of ComponentInfoValue.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentInfoValue() OptString {
+	return s.ComponentInfoValue
+}
+
+// GetComponentType returns the value of ComponentType.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentType() OptString {
+	return s.ComponentType
+}
+
+// GetCriticality returns the value of Criticality.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetCriticality() OptString {
+	return s.Criticality
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetDisplayName() OptString {
+	return s.DisplayName
+}
+
+// GetInstalledVersion returns the value of InstalledVersion.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetInstalledVersion() OptString {
+	return s.InstalledVersion
+}
+
+// GetJobID returns the value of JobID.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetJobID() OptString {
+	return s.JobID
+}
+
+// GetName returns the value of Name.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetName() OptString {
+	return s.Name
+}
+
+// GetPackageName returns the value of PackageName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackageName() OptString {
+	return s.PackageName
+}
+
+// GetPackagePath returns the value of PackagePath.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackagePath() OptString {
+	return s.PackagePath
+}
+
+// GetPackageVersion returns the value of PackageVersion.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackageVersion() OptString {
+	return s.PackageVersion
+}
+
+// GetRebootType returns the value of RebootType.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetRebootType() OptString {
+	return s.RebootType
+}
+
+// GetTarget returns the value of Target.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetTarget() OptString {
+	return s.Target
+}
+
+// SetBaseLocation sets the value of BaseLocation.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetBaseLocation(val OptString) {
+	s.BaseLocation = val
+}
+
+// SetComponentID sets the value of ComponentID.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentID(val OptString) {
+	s.ComponentID = val
+}
+
+// SetComponentInfoName sets the value of ComponentInfoName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentInfoName(val OptString) {
+	s.ComponentInfoName = val
+}
+
+// SetComponentInfoValue sets the value of ComponentInfoValue.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentInfoValue(val OptString) {
+	s.ComponentInfoValue = val
+}
+
+// SetComponentType sets the value of ComponentType.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentType(val OptString) {
+	s.ComponentType = val
+}
+
+// SetCriticality sets the value of Criticality.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetCriticality(val OptString) {
+	s.Criticality = val
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetDisplayName(val OptString) {
+	s.DisplayName = val
+}
+
+// SetInstalledVersion sets the value of InstalledVersion.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetInstalledVersion(val OptString) {
+	s.InstalledVersion = val
+}
+
+// SetJobID sets the value of JobID.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetJobID(val OptString) {
+	s.JobID = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetPackageName sets the value of PackageName.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackageName(val OptString) {
+	s.PackageName = val
+}
+
+// SetPackagePath sets the value of PackagePath.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackagePath(val OptString) {
+	s.PackagePath = val
+}
+
+// SetPackageVersion sets the value of PackageVersion.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackageVersion(val OptString) {
+	s.PackageVersion = val
+}
+
+// SetRebootType sets the value of RebootType.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetRebootType(val OptString) {
+	s.RebootType = val
+}
+
+// SetTarget sets the value of Target.
+func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetTarget(val OptString) {
+	s.Target = val
+}
+
+// RedfishFirmwareTask schema.
+// Ref: #/components/schemas/RedfishFirmwareTask
+type RedfishFirmwareTask struct {
+	Message         OptString            `json:"message"`
+	Messages        OptNilNilStringArray `json:"messages"`
+	Name            OptString            `json:"name"`
+	PercentComplete OptInt               `json:"percent_complete"`
+	Status          OptString            `json:"status"`
+	TaskID          OptString            `json:"task_id"`
+	TaskState       OptString            `json:"task_state"`
+	TaskStatus      OptString            `json:"task_status"`
+}
+
+// GetMessage returns the value of Message.
+func (s *RedfishFirmwareTask) GetMessage() OptString {
+	return s.Message
+}
+
+// GetMessages returns the value of Messages.
+func (s *RedfishFirmwareTask) GetMessages() OptNilNilStringArray {
+	return s.Messages
+}
+
+// GetName returns the value of Name.
+func (s *RedfishFirmwareTask) GetName() OptString {
+	return s.Name
+}
+
+// GetPercentComplete returns the value of PercentComplete.
+func (s *RedfishFirmwareTask) GetPercentComplete() OptInt {
+	return s.PercentComplete
+}
+
+// GetStatus returns the value of Status.
+func (s *RedfishFirmwareTask) GetStatus() OptString {
+	return s.Status
+}
+
+// GetTaskID returns the value of TaskID.
+func (s *RedfishFirmwareTask) GetTaskID() OptString {
+	return s.TaskID
+}
+
+// GetTaskState returns the value of TaskState.
+func (s *RedfishFirmwareTask) GetTaskState() OptString {
+	return s.TaskState
+}
+
+// GetTaskStatus returns the value of TaskStatus.
+func (s *RedfishFirmwareTask) GetTaskStatus() OptString {
+	return s.TaskStatus
+}
+
+// SetMessage sets the value of Message.
+func (s *RedfishFirmwareTask) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// SetMessages sets the value of Messages.
+func (s *RedfishFirmwareTask) SetMessages(val OptNilNilStringArray) {
+	s.Messages = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishFirmwareTask) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetPercentComplete sets the value of PercentComplete.
+func (s *RedfishFirmwareTask) SetPercentComplete(val OptInt) {
+	s.PercentComplete = val
+}
+
+// SetStatus sets the value of Status.
+func (s *RedfishFirmwareTask) SetStatus(val OptString) {
+	s.Status = val
+}
+
+// SetTaskID sets the value of TaskID.
+func (s *RedfishFirmwareTask) SetTaskID(val OptString) {
+	s.TaskID = val
+}
+
+// SetTaskState sets the value of TaskState.
+func (s *RedfishFirmwareTask) SetTaskState(val OptString) {
+	s.TaskState = val
+}
+
+// SetTaskStatus sets the value of TaskStatus.
+func (s *RedfishFirmwareTask) SetTaskStatus(val OptString) {
+	s.TaskStatus = val
+}
+
+// RedfishJob schema.
+// Ref: #/components/schemas/RedfishJob
+type RedfishJob struct {
+	Jobs OptNilNilRedfishJobJobsItemArray `json:"jobs"`
+	Name OptString                        `json:"name"`
+}
+
+// GetJobs returns the value of Jobs.
+func (s *RedfishJob) GetJobs() OptNilNilRedfishJobJobsItemArray {
+	return s.Jobs
+}
+
+// GetName returns the value of Name.
+func (s *RedfishJob) GetName() OptString {
+	return s.Name
+}
+
+// SetJobs sets the value of Jobs.
+func (s *RedfishJob) SetJobs(val OptNilNilRedfishJobJobsItemArray) {
+	s.Jobs = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishJob) SetName(val OptString) {
+	s.Name = val
+}
+
+type RedfishJobJobsItem struct {
+	MessageDotExtendedInfo  []RedfishJobJobsItemMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
+	OdataDotContext         OptString                                      `json:"@odata.context"`
+	OdataDotEtag            OptString                                      `json:"@odata.etag"`
+	OdataDotID              OptString                                      `json:"@odata.id"`
+	OdataDotType            OptString                                      `json:"@odata.type"`
+	CreatedBy               OptString                                      `json:"CreatedBy"`
+	CreationTime            OptString                                      `json:"CreationTime"`
+	Description             OptString                                      `json:"Description"`
+	EndTime                 OptString                                      `json:"EndTime"`
+	EstimatedCompletionTime OptString                                      `json:"EstimatedCompletionTime"`
+	EstimatedDuration       OptString                                      `json:"EstimatedDuration"`
+	HidePayload             OptBool                                        `json:"HidePayload"`
+	ID                      OptString                                      `json:"Id"`
+	JobPriority             OptNilInt                                      `json:"JobPriority"`
+	JobState                OptString                                      `json:"JobState"`
+	JobStatus               OptString                                      `json:"JobStatus"`
+	JobType                 OptString                                      `json:"JobType"`
+	MaxExecutionTime        OptString                                      `json:"MaxExecutionTime"`
+	Messages                []RedfishJobJobsItemMessagesItem               `json:"Messages"`
+	Name                    OptString                                      `json:"Name"`
+	Oem                     jx.Raw                                         `json:"Oem"`
+	Parameters              OptRedfishJobJobsItemParameters                `json:"Parameters"`
+	Payload                 OptRedfishJobJobsItemPayload                   `json:"Payload"`
+	PercentComplete         OptNilInt                                      `json:"PercentComplete"`
+	RawData                 []byte                                         `json:"RawData"`
+	Schedule                OptRedfishJobJobsItemSchedule                  `json:"Schedule"`
+	StartTime               OptString                                      `json:"StartTime"`
+	StepOrder               OptNilNilStringArray                           `json:"StepOrder"`
+}
+
+// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
+func (s *RedfishJobJobsItem) GetMessageDotExtendedInfo() []RedfishJobJobsItemMessageDotExtendedInfoItem {
+	return s.MessageDotExtendedInfo
+}
+
+// GetOdataDotContext returns the value of OdataDotContext.
+func (s *RedfishJobJobsItem) GetOdataDotContext() OptString {
+	return s.OdataDotContext
+}
+
+// GetOdataDotEtag returns the value of OdataDotEtag.
+func (s *RedfishJobJobsItem) GetOdataDotEtag() OptString {
+	return s.OdataDotEtag
+}
+
+// GetOdataDotID returns the value of OdataDotID.
+func (s *RedfishJobJobsItem) GetOdataDotID() OptString {
+	return s.OdataDotID
+}
+
+// GetOdataDotType returns the value of OdataDotType.
+func (s *RedfishJobJobsItem) GetOdataDotType() OptString {
+	return s.OdataDotType
+}
+
+// GetCreatedBy returns the value of CreatedBy.
+func (s *RedfishJobJobsItem) GetCreatedBy() OptString {
+	return s.CreatedBy
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *RedfishJobJobsItem) GetCreationTime() OptString {
+	return s.CreationTime
+}
+
+// GetDescription returns the value of Description.
+func (s *RedfishJobJobsItem) GetDescription() OptString {
+	return s.Description
 }
 
-// NewOptNilStringArray returns new OptNilStringArray with value set to v.
-func NewOptNilStringArray(v []string) OptNilStringArray {
-	return OptNilStringArray{
-		Value: v,
-		Set:   true,
-	}
+// GetEndTime returns the value of EndTime.
+func (s *RedfishJobJobsItem) GetEndTime() OptString {
+	return s.EndTime
 }
 
-// OptNilStringArray is optional nullable []string.
-type OptNilStringArray struct {
-	Value []string
-	Set   bool
-	Null  bool
+// GetEstimatedCompletionTime returns the value of EstimatedCompletionTime.
+func (s *RedfishJobJobsItem) GetEstimatedCompletionTime() OptString {
+	return s.EstimatedCompletionTime
 }
 
-// IsSet returns true if OptNilStringArray was set.
-func (o OptNilStringArray) IsSet() bool { return o.Set }
+// GetEstimatedDuration returns the value of EstimatedDuration.
+func (s *RedfishJobJobsItem) GetEstimatedDuration() OptString {
+	return s.EstimatedDuration
+}
 
-// Reset unsets value.
-func (o *OptNilStringArray) Reset() {
-	var v []string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetHidePayload returns the value of HidePayload.
+func (s *RedfishJobJobsItem) GetHidePayload() OptBool {
+	return s.HidePayload
 }
 
-// SetTo sets value to v.
-func (o *OptNilStringArray) SetTo(v []string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetID returns the value of ID.
+func (s *RedfishJobJobsItem) GetID() OptString {
+	return s.ID
 }
 
-// IsSet returns true if value is Null.
-func (o OptNilStringArray) IsNull() bool { return o.Null }
+// GetJobPriority returns the value of JobPriority.
+func (s *RedfishJobJobsItem) GetJobPriority() OptNilInt {
+	return s.JobPriority
+}
 
-// SetNull sets value to null.
-func (o *OptNilStringArray) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v []string
-	o.Value = v
+// GetJobState returns the value of JobState.
+func (s *RedfishJobJobsItem) GetJobState() OptString {
+	return s.JobState
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilStringArray) Get() (v []string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetJobStatus returns the value of JobStatus.
+func (s *RedfishJobJobsItem) GetJobStatus() OptString {
+	return s.JobStatus
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilStringArray) Or(d []string) []string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetJobType returns the value of JobType.
+func (s *RedfishJobJobsItem) GetJobType() OptString {
+	return s.JobType
 }
 
-// NewOptRedfishJobJobsItemParameters returns new OptRedfishJobJobsItemParameters with value set to v.
-func NewOptRedfishJobJobsItemParameters(v RedfishJobJobsItemParameters) OptRedfishJobJobsItemParameters {
-	return OptRedfishJobJobsItemParameters{
-		Value: v,
-		Set:   true,
-	}
+// GetMaxExecutionTime returns the value of MaxExecutionTime.
+func (s *RedfishJobJobsItem) GetMaxExecutionTime() OptString {
+	return s.MaxExecutionTime
 }
 
-// OptRedfishJobJobsItemParameters is optional RedfishJobJobsItemParameters.
-type OptRedfishJobJobsItemParameters struct {
-	Value RedfishJobJobsItemParameters
-	Set   bool
+// GetMessages returns the value of Messages.
+func (s *RedfishJobJobsItem) GetMessages() []RedfishJobJobsItemMessagesItem {
+	return s.Messages
 }
 
-// IsSet returns true if OptRedfishJobJobsItemParameters was set.
-func (o OptRedfishJobJobsItemParameters) IsSet() bool { return o.Set }
+// GetName returns the value of Name.
+func (s *RedfishJobJobsItem) GetName() OptString {
+	return s.Name
+}
 
-// Reset unsets value.
-func (o *OptRedfishJobJobsItemParameters) Reset() {
-	var v RedfishJobJobsItemParameters
-	o.Value = v
-	o.Set = false
+// GetOem returns the value of Oem.
+func (s *RedfishJobJobsItem) GetOem() jx.Raw {
+	return s.Oem
 }
 
-// SetTo sets value to v.
-func (o *OptRedfishJobJobsItemParameters) SetTo(v RedfishJobJobsItemParameters) {
-	o.Set = true
-	o.Value = v
+// GetParameters returns the value of Parameters.
+func (s *RedfishJobJobsItem) GetParameters() OptRedfishJobJobsItemParameters {
+	return s.Parameters
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptRedfishJobJobsItemParameters) Get() (v RedfishJobJobsItemParameters, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetPayload returns the value of Payload.
+func (s *RedfishJobJobsItem) GetPayload() OptRedfishJobJobsItemPayload {
+	return s.Payload
+}
+
+// GetPercentComplete returns the value of PercentComplete.
+func (s *RedfishJobJobsItem) GetPercentComplete() OptNilInt {
+	return s.PercentComplete
+}
+
+// GetRawData returns the value of RawData.
+func (s *RedfishJobJobsItem) GetRawData() []byte {
+	return s.RawData
+}
+
+// GetSchedule returns the value of Schedule.
+func (s *RedfishJobJobsItem) GetSchedule() OptRedfishJobJobsItemSchedule {
+	return s.Schedule
+}
+
+// GetStartTime returns the value of StartTime.
+func (s *RedfishJobJobsItem) GetStartTime() OptString {
+	return s.StartTime
+}
+
+// GetStepOrder returns the value of StepOrder.
+func (s *RedfishJobJobsItem) GetStepOrder() OptNilNilStringArray {
+	return s.StepOrder
+}
+
+// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
+func (s *RedfishJobJobsItem) SetMessageDotExtendedInfo(val []RedfishJobJobsItemMessageDotExtendedInfoItem) {
+	s.MessageDotExtendedInfo = val
+}
+
+// SetOdataDotContext sets the value of OdataDotContext.
+func (s *RedfishJobJobsItem) SetOdataDotContext(val OptString) {
+	s.OdataDotContext = val
+}
+
+// SetOdataDotEtag sets the value of OdataDotEtag.
+func (s *RedfishJobJobsItem) SetOdataDotEtag(val OptString) {
+	s.OdataDotEtag = val
+}
+
+// SetOdataDotID sets the value of OdataDotID.
+func (s *RedfishJobJobsItem) SetOdataDotID(val OptString) {
+	s.OdataDotID = val
+}
+
+// SetOdataDotType sets the value of OdataDotType.
+func (s *RedfishJobJobsItem) SetOdataDotType(val OptString) {
+	s.OdataDotType = val
+}
+
+// SetCreatedBy sets the value of CreatedBy.
+func (s *RedfishJobJobsItem) SetCreatedBy(val OptString) {
+	s.CreatedBy = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *RedfishJobJobsItem) SetCreationTime(val OptString) {
+	s.CreationTime = val
+}
+
+// SetDescription sets the value of Description.
+func (s *RedfishJobJobsItem) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetEndTime sets the value of EndTime.
+func (s *RedfishJobJobsItem) SetEndTime(val OptString) {
+	s.EndTime = val
+}
+
+// SetEstimatedCompletionTime sets the value of EstimatedCompletionTime.
+func (s *RedfishJobJobsItem) SetEstimatedCompletionTime(val OptString) {
+	s.EstimatedCompletionTime = val
+}
+
+// SetEstimatedDuration sets the value of EstimatedDuration.
+func (s *RedfishJobJobsItem) SetEstimatedDuration(val OptString) {
+	s.EstimatedDuration = val
+}
+
+// SetHidePayload sets the value of HidePayload.
+func (s *RedfishJobJobsItem) SetHidePayload(val OptBool) {
+	s.HidePayload = val
+}
+
+// SetID sets the value of ID.
+func (s *RedfishJobJobsItem) SetID(val OptString) {
+	s.ID = val
+}
+
+// SetJobPriority sets the value of JobPriority.
+func (s *RedfishJobJobsItem) SetJobPriority(val OptNilInt) {
+	s.JobPriority = val
+}
+
+// SetJobState sets the value of JobState.
+func (s *RedfishJobJobsItem) SetJobState(val OptString) {
+	s.JobState = val
+}
+
+// SetJobStatus sets the value of JobStatus.
+func (s *RedfishJobJobsItem) SetJobStatus(val OptString) {
+	s.JobStatus = val
+}
+
+// SetJobType sets the value of JobType.
+func (s *RedfishJobJobsItem) SetJobType(val OptString) {
+	s.JobType = val
+}
+
+// SetMaxExecutionTime sets the value of MaxExecutionTime.
+func (s *RedfishJobJobsItem) SetMaxExecutionTime(val OptString) {
+	s.MaxExecutionTime = val
+}
+
+// SetMessages sets the value of Messages.
+func (s *RedfishJobJobsItem) SetMessages(val []RedfishJobJobsItemMessagesItem) {
+	s.Messages = val
+}
+
+// SetName sets the value of Name.
+func (s *RedfishJobJobsItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptRedfishJobJobsItemParameters) Or(d RedfishJobJobsItemParameters) RedfishJobJobsItemParameters {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetOem sets the value of Oem.
+func (s *RedfishJobJobsItem) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// NewOptRedfishJobJobsItemPayload returns new OptRedfishJobJobsItemPayload with value set to v.
-func NewOptRedfishJobJobsItemPayload(v RedfishJobJobsItemPayload) OptRedfishJobJobsItemPayload {
-	return OptRedfishJobJobsItemPayload{
-		Value: v,
-		Set:   true,
-	}
+// SetParameters sets the value of Parameters.
+func (s *RedfishJobJobsItem) SetParameters(val OptRedfishJobJobsItemParameters) {
+	s.Parameters = val
 }
 
-// OptRedfishJobJobsItemPayload is optional RedfishJobJobsItemPayload.
-type OptRedfishJobJobsItemPayload struct {
-	Value RedfishJobJobsItemPayload
-	Set   bool
+// SetPayload sets the value of Payload.
+func (s *RedfishJobJobsItem) SetPayload(val OptRedfishJobJobsItemPayload) {
+	s.Payload = val
 }
 
-// IsSet returns true if OptRedfishJobJobsItemPayload was set.
-func (o OptRedfishJobJobsItemPayload) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptRedfishJobJobsItemPayload) Reset() {
-	var v RedfishJobJobsItemPayload
-	o.Value = v
-	o.Set = false
+// SetPercentComplete sets the value of PercentComplete.
+func (s *RedfishJobJobsItem) SetPercentComplete(val OptNilInt) {
+	s.PercentComplete = val
 }
 
-// SetTo sets value to v.
-func (o *OptRedfishJobJobsItemPayload) SetTo(v RedfishJobJobsItemPayload) {
-	o.Set = true
-	o.Value = v
+// SetRawData sets the value of RawData.
+func (s *RedfishJobJobsItem) SetRawData(val []byte) {
+	s.RawData = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptRedfishJobJobsItemPayload) Get() (v RedfishJobJobsItemPayload, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetSchedule sets the value of Schedule.
+func (s *RedfishJobJobsItem) SetSchedule(val OptRedfishJobJobsItemSchedule) {
+	s.Schedule = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptRedfishJobJobsItemPayload) Or(d RedfishJobJobsItemPayload) RedfishJobJobsItemPayload {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetStartTime sets the value of StartTime.
+func (s *RedfishJobJobsItem) SetStartTime(val OptString) {
+	s.StartTime = val
 }
 
-// NewOptRedfishJobJobsItemSchedule returns new OptRedfishJobJobsItemSchedule with value set to v.
-func NewOptRedfishJobJobsItemSchedule(v RedfishJobJobsItemSchedule) OptRedfishJobJobsItemSchedule {
-	return OptRedfishJobJobsItemSchedule{
-		Value: v,
-		Set:   true,
-	}
+// SetStepOrder sets the value of StepOrder.
+func (s *RedfishJobJobsItem) SetStepOrder(val OptNilNilStringArray) {
+	s.StepOrder = val
 }
 
-// OptRedfishJobJobsItemSchedule is optional RedfishJobJobsItemSchedule.
-type OptRedfishJobJobsItemSchedule struct {
-	Value RedfishJobJobsItemSchedule
-	Set   bool
+type RedfishJobJobsItemMessageDotExtendedInfoItem struct {
+	Message                  OptString                                                         `json:"Message"`
+	MessageArgs              []string                                                          `json:"MessageArgs"`
+	MessageId                OptString                                                         `json:"MessageId"`
+	MessageSeverity          OptString                                                         `json:"MessageSeverity"`
+	Oem                      jx.Raw                                                            `json:"Oem"`
+	RelatedProperties        OptNilNilStringArray                                              `json:"RelatedProperties"`
+	Resolution               OptString                                                         `json:"Resolution"`
+	ResolutionSteps          []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
+	Severity                 OptString                                                         `json:"Severity"`
+	UserAuthenticationSource OptString                                                         `json:"UserAuthenticationSource"`
+	Username                 OptString                                                         `json:"Username"`
 }
 
-// IsSet returns true if OptRedfishJobJobsItemSchedule was set.
-func (o OptRedfishJobJobsItemSchedule) IsSet() bool { return o.Set }
+// GetMessage returns the value of Message.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessage() OptString {
+	return s.Message
+}
 
-// Reset unsets value.
-func (o *OptRedfishJobJobsItemSchedule) Reset() {
-	var v RedfishJobJobsItemSchedule
-	o.Value = v
-	o.Set = false
+// GetMessageArgs returns the value of MessageArgs.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageArgs() []string {
+	return s.MessageArgs
 }
 
-// SetTo sets value to v.
-func (o *OptRedfishJobJobsItemSchedule) SetTo(v RedfishJobJobsItemSchedule) {
-	o.Set = true
-	o.Value = v
+// GetMessageId returns the value of MessageId.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageId() OptString {
+	return s.MessageId
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptRedfishJobJobsItemSchedule) Get() (v RedfishJobJobsItemSchedule, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetMessageSeverity returns the value of MessageSeverity.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
+	return s.MessageSeverity
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptRedfishJobJobsItemSchedule) Or(d RedfishJobJobsItemSchedule) RedfishJobJobsItemSchedule {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetOem returns the value of Oem.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetOem() jx.Raw {
+	return s.Oem
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetRelatedProperties returns the value of RelatedProperties.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
+	return s.RelatedProperties
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetResolution returns the value of Resolution.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetResolution() OptString {
+	return s.Resolution
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// GetResolutionSteps returns the value of ResolutionSteps.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem {
+	return s.ResolutionSteps
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// GetSeverity returns the value of Severity.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetSeverity() OptString {
+	return s.Severity
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
+	return s.UserAuthenticationSource
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetUsername returns the value of Username.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetUsername() OptString {
+	return s.Username
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetMessage sets the value of Message.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// PatchRolesRequest schema.
-// Ref: #/components/schemas/PatchRolesRequest
-type PatchRolesRequest struct {
-	PermissionList []PatchRolesRequestPermissionListItem `json:"permission_list"`
-	Role           OptString                             `json:"role"`
+// SetMessageArgs sets the value of MessageArgs.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
+	s.MessageArgs = val
 }
 
-// GetPermissionList returns the value of PermissionList.
-func (s *PatchRolesRequest) GetPermissionList() []PatchRolesRequestPermissionListItem {
-	return s.PermissionList
+// SetMessageId sets the value of MessageId.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageId(val OptString) {
+	s.MessageId = val
 }
 
-// GetRole returns the value of Role.
-func (s *PatchRolesRequest) GetRole() OptString {
-	return s.Role
+// SetMessageSeverity sets the value of MessageSeverity.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
+	s.MessageSeverity = val
 }
 
-// SetPermissionList sets the value of PermissionList.
-func (s *PatchRolesRequest) SetPermissionList(val []PatchRolesRequestPermissionListItem) {
-	s.PermissionList = val
+// SetOem sets the value of Oem.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// SetRole sets the value of Role.
-func (s *PatchRolesRequest) SetRole(val OptString) {
-	s.Role = val
+// SetRelatedProperties sets the value of RelatedProperties.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
+	s.RelatedProperties = val
 }
 
-type PatchRolesRequestPermissionListItem struct {
-	Method OptString `json:"method"`
-	Path   OptString `json:"path"`
+// SetResolution sets the value of Resolution.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetResolution(val OptString) {
+	s.Resolution = val
 }
 
-// GetMethod returns the value of Method.
-func (s *PatchRolesRequestPermissionListItem) GetMethod() OptString {
-	return s.Method
+// SetResolutionSteps sets the value of ResolutionSteps.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) {
+	s.ResolutionSteps = val
 }
 
-// GetPath returns the value of Path.
-func (s *PatchRolesRequestPermissionListItem) GetPath() OptString {
-	return s.Path
+// SetSeverity sets the value of Severity.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetSeverity(val OptString) {
+	s.Severity = val
 }
 
-// SetMethod sets the value of Method.
-func (s *PatchRolesRequestPermissionListItem) SetMethod(val OptString) {
-	s.Method = val
+// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
+	s.UserAuthenticationSource = val
 }
 
-// SetPath sets the value of Path.
-func (s *PatchRolesRequestPermissionListItem) SetPath(val OptString) {
-	s.Path = val
+// SetUsername sets the value of Username.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetUsername(val OptString) {
+	s.Username = val
 }
 
-// PostRolesRequest schema.
-// Ref: #/components/schemas/PostRolesRequest
-type PostRolesRequest struct {
-	InheritedRole OptString `json:"inherited_role"`
-	Role          OptString `json:"role"`
+type RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem struct {
+	ActionParameters     []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
+	ActionURI            OptString                                                                             `json:"ActionURI"`
+	Oem                  jx.Raw                                                                                `json:"Oem"`
+	Priority             OptNilInt                                                                             `json:"Priority"`
+	ResolutionType       OptString                                                                             `json:"ResolutionType"`
+	RetryCount           OptNilInt                                                                             `json:"RetryCount"`
+	RetryIntervalSeconds OptNilInt                                                                             `json:"RetryIntervalSeconds"`
+	TargetComponentURI   OptString                                                                             `json:"TargetComponentURI"`
 }
 
-// GetInheritedRole returns the value of InheritedRole.
-func (s *PostRolesRequest) GetInheritedRole() OptString {
-	return s.InheritedRole
+// GetActionParameters returns the value of ActionParameters.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
+	return s.ActionParameters
 }
 
-// GetRole returns the value of Role.
-func (s *PostRolesRequest) GetRole() OptString {
-	return s.Role
+// GetActionURI returns the value of ActionURI.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
+	return s.ActionURI
 }
 
-// SetInheritedRole sets the value of InheritedRole.
-func (s *PostRolesRequest) SetInheritedRole(val OptString) {
-	s.InheritedRole = val
+// GetOem returns the value of Oem.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
+	return s.Oem
 }
 
-// SetRole sets the value of Role.
-func (s *PostRolesRequest) SetRole(val OptString) {
-	s.Role = val
+// GetPriority returns the value of Priority.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
+	return s.Priority
 }
 
-// RedfishDellUpgradeFirmware schema.
-// Ref: #/components/schemas/RedfishDellUpgradeFirmware
-type RedfishDellUpgradeFirmware struct {
-	Message          OptString                                  `json:"Message"`
-	Name             OptString                                  `json:"Name"`
-	Status           OptString                                  `json:"Status"`
-	UpdateCount      OptInt                                     `json:"UpdateCount"`
-	UpdateList       []RedfishDellUpgradeFirmwareUpdateListItem `json:"UpdateList"`
-	UpdateRebootType OptString                                  `json:"UpdateRebootType"`
+// GetResolutionType returns the value of ResolutionType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
+	return s.ResolutionType
 }
 
-// GetMessage returns the value of Message.
-func (s *RedfishDellUpgradeFirmware) GetMessage() OptString {
-	return s.Message
+// GetRetryCount returns the value of RetryCount.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
+	return s.RetryCount
 }
 
-// GetName returns the value of Name.
-func (s *RedfishDellUpgradeFirmware) GetName() OptString {
-	return s.Name
+// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
+	return s.RetryIntervalSeconds
 }
 
-// GetStatus returns the value of Status.
-func (s *RedfishDellUpgradeFirmware) GetStatus() OptString {
-	return s.Status
+// GetTargetComponentURI returns the value of TargetComponentURI.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
+	return s.TargetComponentURI
 }
 
-// GetUpdateCount returns the value of UpdateCount.
-func (s *RedfishDellUpgradeFirmware) GetUpdateCount() OptInt {
-	return s.UpdateCount
+// SetActionParameters sets the value of ActionParameters.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
+	s.ActionParameters = val
 }
 
-// GetUpdateList returns the value of UpdateList.
-func (s *RedfishDellUpgradeFirmware) GetUpdateList() []RedfishDellUpgradeFirmwareUpdateListItem {
-	return s.UpdateList
+// SetActionURI sets the value of ActionURI.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
+	s.ActionURI = val
 }
 
-// GetUpdateRebootType returns the value of UpdateRebootType.
-func (s *RedfishDellUpgradeFirmware) GetUpdateRebootType() OptString {
-	return s.UpdateRebootType
+// SetOem sets the value of Oem.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// SetMessage sets the value of Message.
-func (s *RedfishDellUpgradeFirmware) SetMessage(val OptString) {
-	s.Message = val
+// SetPriority sets the value of Priority.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
+	s.Priority = val
 }
 
-// SetName sets the value of Name.
-func (s *RedfishDellUpgradeFirmware) SetName(val OptString) {
-	s.Name = val
+// SetResolutionType sets the value of ResolutionType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
+	s.ResolutionType = val
 }
 
-// SetStatus sets the value of Status.
-func (s *RedfishDellUpgradeFirmware) SetStatus(val OptString) {
-	s.Status = val
+// SetRetryCount sets the value of RetryCount.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
+	s.RetryCount = val
 }
 
-// SetUpdateCount sets the value of UpdateCount.
-func (s *RedfishDellUpgradeFirmware) SetUpdateCount(val OptInt) {
-	s.UpdateCount = val
+// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
+	s.RetryIntervalSeconds = val
 }
 
-// SetUpdateList sets the value of UpdateList.
-func (s *RedfishDellUpgradeFirmware) SetUpdateList(val []RedfishDellUpgradeFirmwareUpdateListItem) {
-	s.UpdateList = val
+// SetTargetComponentURI sets the value of TargetComponentURI.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
+	s.TargetComponentURI = val
 }
 
-// SetUpdateRebootType sets the value of UpdateRebootType.
-func (s *RedfishDellUpgradeFirmware) SetUpdateRebootType(val OptString) {
-	s.UpdateRebootType = val
+type RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
+	AllowableNumbers           []string      `json:"AllowableNumbers"`
+	AllowablePattern           OptString     `json:"AllowablePattern"`
+	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
+	AllowableValues            []string      `json:"AllowableValues"`
+	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
+	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
+	DataType                   OptString     `json:"DataType"`
+	DefaultValue               OptString     `json:"DefaultValue"`
+	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
+	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
+	Name                       OptString     `json:"Name"`
+	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
+	ObjectDataType             OptString     `json:"ObjectDataType"`
+	Required                   OptBool       `json:"Required"`
 }
 
-type RedfishDellUpgradeFirmwareUpdateListItem struct {
-	BaseLocation       OptString `json:"BaseLocation"`
-	ComponentID        OptString `json:"ComponentID"`
-	ComponentInfoName  OptString `json:"ComponentInfoName"`
-	ComponentInfoValue OptString `json:"ComponentInfoValue"`
-	ComponentType      OptString `json:"ComponentType"`
-	Criticality        OptString `json:"Criticality"`
-	DisplayName        OptString `json:"DisplayName"`
-	InstalledVersion   OptString `json:"InstalledVersion"`
-	JobID              OptString `json:"JobID"`
-	Name               OptString `json:"Name"`
-	PackageName        OptString `json:"PackageName"`
-	PackagePath        OptString `json:"PackagePath"`
-	PackageVersion     OptString `json:"PackageVersion"`
-	RebootType         OptString `json:"RebootType"`
-	Target             OptString `json:"Target"`
+// GetAllowableNumbers returns the value of AllowableNumbers.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
+	return s.AllowableNumbers
 }
 
-// GetBaseLocation returns the value of BaseLocation.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetBaseLocation() OptString {
-	return s.BaseLocation
+// GetAllowablePattern returns the value of AllowablePattern.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
+	return s.AllowablePattern
 }
 
-// GetComponentID returns the value of ComponentID.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentID() OptString {
-	return s.ComponentID
+// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
+	return s.AllowableValueDescriptions
 }
 
-// GetComponentInfoName returns the value of ComponentInfoName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentInfoName() OptString {
-	return s.ComponentInfoName
+// GetAllowableValues returns the value of AllowableValues.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
+	return s.AllowableValues
 }
 
-// GetComponentInfoValue returns the value of ComponentInfoValue.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentInfoValue() OptString {
-	return s.ComponentInfoValue
+// GetArraySizeMaximum returns the value of ArraySizeMaximum.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
+	return s.ArraySizeMaximum
 }
 
-// GetComponentType returns the value of ComponentType.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetComponentType() OptString {
-	return s.ComponentType
+// GetArraySizeMinimum returns the value of ArraySizeMinimum.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
+	return s.ArraySizeMinimum
 }
 
-// GetCriticality returns the value of Criticality.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetCriticality() OptString {
-	return s.Criticality
+// GetDataType returns the value of DataType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
+	return s.DataType
 }
 
-// GetDisplayName returns the value of DisplayName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetDisplayName() OptString {
-	return s.DisplayName
+// GetDefaultValue returns the value of DefaultValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
+	return s.DefaultValue
 }
 
-// GetInstalledVersion returns the value of InstalledVersion.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetInstalledVersion() OptString {
-	return s.InstalledVersion
+// GetMaximumValue returns the value of MaximumValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
+	return s.MaximumValue
 }
 
-// GetJobID returns the value of JobID.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetJobID() OptString {
-	return s.JobID
+// GetMinimumValue returns the value of MinimumValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
+	return s.MinimumValue
 }
 
 // GetName returns the value of Name.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetName() OptString {
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
 	return s.Name
 }
 
-// GetPackageName returns the value of PackageName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackageName() OptString {
-	return s.PackageName
-}
-
-// GetPackagePath returns the value of PackagePath.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackagePath() OptString {
-	return s.PackagePath
-}
-
-// GetPackageVersion returns the value of PackageVersion.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetPackageVersion() OptString {
-	return s.PackageVersion
-}
-
-// GetRebootType returns the value of RebootType.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetRebootType() OptString {
-	return s.RebootType
+// GetNoDefaultValue returns the value of NoDefaultValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
+	return s.NoDefaultValue
 }
 
-// GetTarget returns the value of Target.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) GetTarget() OptString {
-	return s.Target
+// GetObjectDataType returns the value of ObjectDataType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
+	return s.ObjectDataType
 }
 
-// SetBaseLocation sets the value of BaseLocation.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetBaseLocation(val OptString) {
-	s.BaseLocation = val
+// GetRequired returns the value of Required.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
+	return s.Required
 }
 
-// SetComponentID sets the value of ComponentID.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentID(val OptString) {
-	s.ComponentID = val
+// SetAllowableNumbers sets the value of AllowableNumbers.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
+	s.AllowableNumbers = val
 }
 
-// SetComponentInfoName sets the value of ComponentInfoName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentInfoName(val OptString) {
-	s.ComponentInfoName = val
+// SetAllowablePattern sets the value of AllowablePattern.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
+	s.AllowablePattern = val
 }
 
-// SetComponentInfoValue sets the value of ComponentInfoValue.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentInfoValue(val OptString) {
-	s.ComponentInfoValue = val
+// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
+	s.AllowableValueDescriptions = val
 }
 
-// SetComponentType sets the value of ComponentType.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetComponentType(val OptString) {
-	s.ComponentType = val
+// SetAllowableValues sets the value of AllowableValues.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
+	s.AllowableValues = val
 }
-
-// SetCriticality sets the value of Criticality.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetCriticality(val OptString) {
-	s.Criticality = val
+
+// SetArraySizeMaximum sets the value of ArraySizeMaximum.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
+	s.ArraySizeMaximum = val
 }
 
-// SetDisplayName sets the value of DisplayName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetDisplayName(val OptString) {
-	s.DisplayName = val
+// SetArraySizeMinimum sets the value of ArraySizeMinimum.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
+	s.ArraySizeMinimum = val
 }
 
-// SetInstalledVersion sets the value of InstalledVersion.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetInstalledVersion(val OptString) {
-	s.InstalledVersion = val
+// SetDataType sets the value of DataType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
+	s.DataType = val
 }
 
-// SetJobID sets the value of JobID.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetJobID(val OptString) {
-	s.JobID = val
+// SetDefaultValue sets the value of DefaultValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
+	s.DefaultValue = val
 }
 
-// SetName sets the value of Name.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetName(val OptString) {
-	s.Name = val
+// SetMaximumValue sets the value of MaximumValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
+	s.MaximumValue = val
 }
 
-// SetPackageName sets the value of PackageName.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackageName(val OptString) {
-	s.PackageName = val
+// SetMinimumValue sets the value of MinimumValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
+	s.MinimumValue = val
 }
 
-// SetPackagePath sets the value of PackagePath.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackagePath(val OptString) {
-	s.PackagePath = val
+// SetName sets the value of Name.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetPackageVersion sets the value of PackageVersion.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetPackageVersion(val OptString) {
-	s.PackageVersion = val
+// SetNoDefaultValue sets the value of NoDefaultValue.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
+	s.NoDefaultValue = val
 }
 
-// SetRebootType sets the value of RebootType.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetRebootType(val OptString) {
-	s.RebootType = val
+// SetObjectDataType sets the value of ObjectDataType.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
+	s.ObjectDataType = val
 }
 
-// SetTarget sets the value of Target.
-func (s *RedfishDellUpgradeFirmwareUpdateListItem) SetTarget(val OptString) {
-	s.Target = val
+// SetRequired sets the value of Required.
+func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
+	s.Required = val
 }
 
-// RedfishJob schema.
-// Ref: #/components/schemas/RedfishJob
-type RedfishJob struct {
-	Jobs OptNilNilRedfishJobJobsItemArray `json:"jobs"`
-	Name OptString                        `json:"name"`
+type RedfishJobJobsItemMessagesItem struct {
+	Message                  OptString                                           `json:"Message"`
+	MessageArgs              []string                                            `json:"MessageArgs"`
+	MessageId                OptString                                           `json:"MessageId"`
+	MessageSeverity          OptString                                           `json:"MessageSeverity"`
+	Oem                      jx.Raw                                              `json:"Oem"`
+	RelatedProperties        OptNilNilStringArray                                `json:"RelatedProperties"`
+	Resolution               OptString                                           `json:"Resolution"`
+	ResolutionSteps          []RedfishJobJobsItemMessagesItemResolutionStepsItem `json:"ResolutionSteps"`
+	Severity                 OptString                                           `json:"Severity"`
+	UserAuthenticationSource OptString                                           `json:"UserAuthenticationSource"`
+	Username                 OptString                                           `json:"Username"`
 }
 
-// GetJobs returns the value of Jobs.
-func (s *RedfishJob) GetJobs() OptNilNilRedfishJobJobsItemArray {
-	return s.Jobs
+// GetMessage returns the value of Message.
+func (s *RedfishJobJobsItemMessagesItem) GetMessage() OptString {
+	return s.Message
 }
 
-// GetName returns the value of Name.
-func (s *RedfishJob) GetName() OptString {
-	return s.Name
+// GetMessageArgs returns the value of MessageArgs.
+func (s *RedfishJobJobsItemMessagesItem) GetMessageArgs() []string {
+	return s.MessageArgs
 }
 
-// SetJobs sets the value of Jobs.
-func (s *RedfishJob) SetJobs(val OptNilNilRedfishJobJobsItemArray) {
-	s.Jobs = val
+// GetMessageId returns the value of MessageId.
+func (s *RedfishJobJobsItemMessagesItem) GetMessageId() OptString {
+	return s.MessageId
 }
 
-// SetName sets the value of Name.
-func (s *RedfishJob) SetName(val OptString) {
-	s.Name = val
+// GetMessageSeverity returns the value of MessageSeverity.
+func (s *RedfishJobJobsItemMessagesItem) GetMessageSeverity() OptString {
+	return s.MessageSeverity
 }
 
-type RedfishJobJobsItem struct {
-	MessageDotExtendedInfo  []RedfishJobJobsItemMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
-	OdataDotContext         OptString                                      `json:"@odata.context"`
-	OdataDotEtag            OptString                                      `json:"@odata.etag"`
-	OdataDotID              OptString                                      `json:"@odata.id"`
-	OdataDotType            OptString                                      `json:"@odata.type"`
-	CreatedBy               OptString                                      `json:"CreatedBy"`
-	CreationTime            OptString                                      `json:"CreationTime"`
-	Description             OptString                                      `json:"Description"`
-	EndTime                 OptString                                      `json:"EndTime"`
-	EstimatedCompletionTime OptString                                      `json:"EstimatedCompletionTime"`
-	EstimatedDuration       OptString                                      `json:"EstimatedDuration"`
-	HidePayload             OptBool                                        `json:"HidePayload"`
-	ID                      OptString                                      `json:"Id"`
-	JobPriority             OptNilInt                                      `json:"JobPriority"`
-	JobState                OptString                                      `json:"JobState"`
-	JobStatus               OptString                                      `json:"JobStatus"`
-	JobType                 OptString                                      `json:"JobType"`
-	MaxExecutionTime        OptString                                      `json:"MaxExecutionTime"`
-	Messages                []RedfishJobJobsItemMessagesItem               `json:"Messages"`
-	Name                    OptString                                      `json:"Name"`
-	Oem                     jx.Raw                                         `json:"Oem"`
-	Parameters              OptRedfishJobJobsItemParameters                `json:"Parameters"`
-	Payload                 OptRedfishJobJobsItemPayload                   `json:"Payload"`
-	PercentComplete         OptNilInt                                      `json:"PercentComplete"`
-	RawData                 []byte                                         `json:"RawData"`
-	Schedule                OptRedfishJobJobsItemSchedule                  `json:"Schedule"`
-	StartTime               OptString                                      `json:"StartTime"`
-	StepOrder               OptNilNilStringArray                           `json:"StepOrder"`
+// GetOem returns the value of Oem.
+func (s *RedfishJobJobsItemMessagesItem) GetOem() jx.Raw {
+	return s.Oem
 }
 
-// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
-func (s *RedfishJobJobsItem) GetMessageDotExtendedInfo() []RedfishJobJobsItemMessageDotExtendedInfoItem {
-	return s.MessageDotExtendedInfo
+// GetRelatedProperties returns the value of RelatedProperties.
+func (s *RedfishJobJobsItemMessagesItem) GetRelatedProperties() OptNilNilStringArray {
+	return s.RelatedProperties
 }
 
-// GetOdataDotContext returns the value of OdataDotContext.
-func (s *RedfishJobJobsItem) GetOdataDotContext() OptString {
-	return s.OdataDotContext
+// GetResolution returns the value of Resolution.
+func (s *RedfishJobJobsItemMessagesItem) GetResolution() OptString {
+	return s.Resolution
 }
 
-// GetOdataDotEtag returns the value of OdataDotEtag.
-func (s *RedfishJobJobsItem) GetOdataDotEtag() OptString {
-	return s.OdataDotEtag
+// GetResolutionSteps returns the value of ResolutionSteps.
+func (s *RedfishJobJobsItemMessagesItem) GetResolutionSteps() []RedfishJobJobsItemMessagesItemResolutionStepsItem {
+	return s.ResolutionSteps
 }
 
-// GetOdataDotID returns the value of OdataDotID.
-func (s *RedfishJobJobsItem) GetOdataDotID() OptString {
-	return s.OdataDotID
+// GetSeverity returns the value of Severity.
+func (s *RedfishJobJobsItemMessagesItem) GetSeverity() OptString {
+	return s.Severity
 }
 
-// GetOdataDotType returns the value of OdataDotType.
-func (s *RedfishJobJobsItem) GetOdataDotType() OptString {
-	return s.OdataDotType
+// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
+func (s *RedfishJobJobsItemMessagesItem) GetUserAuthenticationSource() OptString {
+	return s.UserAuthenticationSource
 }
 
-// GetCreatedBy returns the value of CreatedBy.
-func (s *RedfishJobJobsItem) GetCreatedBy() OptString {
-	return s.CreatedBy
+// GetUsername returns the value of Username.
+func (s *RedfishJobJobsItemMessagesItem) GetUsername() OptString {
+	return s.Username
 }
 
-// GetCreationTime returns the value of CreationTime.
-func (s *RedfishJobJobsItem) GetCreationTime() OptString {
-	return s.CreationTime
+// SetMessage sets the value of Message.
+func (s *RedfishJobJobsItemMessagesItem) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// GetDescription returns the value of Description.
-func (s *RedfishJobJobsItem) GetDescription() OptString {
-	return s.Description
+// SetMessageArgs sets the value of MessageArgs.
+func (s *RedfishJobJobsItemMessagesItem) SetMessageArgs(val []string) {
+	s.MessageArgs = val
 }
 
-// GetEndTime returns the value of EndTime.
-func (s *RedfishJobJobsItem) GetEndTime() OptString {
-	return s.EndTime
+// SetMessageId sets the value of MessageId.
+func (s *RedfishJobJobsItemMessagesItem) SetMessageId(val OptString) {
+	s.MessageId = val
 }
 
-// GetEstimatedCompletionTime returns the value of EstimatedCompletionTime.
-func (s *RedfishJobJobsItem) GetEstimatedCompletionTime() OptString {
-	return s.EstimatedCompletionTime
+// SetMessageSeverity sets the value of MessageSeverity.
+func (s *RedfishJobJobsItemMessagesItem) SetMessageSeverity(val OptString) {
+	s.MessageSeverity = val
 }
 
-// GetEstimatedDuration returns the value of EstimatedDuration.
-func (s *RedfishJobJobsItem) GetEstimatedDuration() OptString {
-	return s.EstimatedDuration
+// SetOem sets the value of Oem.
+func (s *RedfishJobJobsItemMessagesItem) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// GetHidePayload returns the value of HidePayload.
-func (s *RedfishJobJobsItem) GetHidePayload() OptBool {
-	return s.HidePayload
+// SetRelatedProperties sets the value of RelatedProperties.
+func (s *RedfishJobJobsItemMessagesItem) SetRelatedProperties(val OptNilNilStringArray) {
+	s.RelatedProperties = val
 }
 
-// GetID returns the value of ID.
-func (s *RedfishJobJobsItem) GetID() OptString {
-	return s.ID
+// SetResolution sets the value of Resolution.
+func (s *RedfishJobJobsItemMessagesItem) SetResolution(val OptString) {
+	s.Resolution = val
 }
 
-// GetJobPriority returns the value of JobPriority.
-func (s *RedfishJobJobsItem) GetJobPriority() OptNilInt {
-	return s.JobPriority
+// SetResolutionSteps sets the value of ResolutionSteps.
+func (s *RedfishJobJobsItemMessagesItem) SetResolutionSteps(val []RedfishJobJobsItemMessagesItemResolutionStepsItem) {
+	s.ResolutionSteps = val
 }
 
-// GetJobState returns the value of JobState.
-func (s *RedfishJobJobsItem) GetJobState() OptString {
-	return s.JobState
+// SetSeverity sets the value of Severity.
+func (s *RedfishJobJobsItemMessagesItem) SetSeverity(val OptString) {
+	s.Severity = val
 }
 
-// GetJobStatus returns the value of JobStatus.
-func (s *RedfishJobJobsItem) GetJobStatus() OptString {
-	return s.JobStatus
+// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
+func (s *RedfishJobJobsItemMessagesItem) SetUserAuthenticationSource(val OptString) {
+	s.UserAuthenticationSource = val
 }
 
-// GetJobType returns the value of JobType.
-func (s *RedfishJobJobsItem) GetJobType() OptString {
-	return s.JobType
+// SetUsername sets the value of Username.
+func (s *RedfishJobJobsItemMessagesItem) SetUsername(val OptString) {
+	s.Username = val
 }
 
-// GetMaxExecutionTime returns the value of MaxExecutionTime.
-func (s *RedfishJobJobsItem) GetMaxExecutionTime() OptString {
-	return s.MaxExecutionTime
+type RedfishJobJobsItemMessagesItemResolutionStepsItem struct {
+	ActionParameters     []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
+	ActionURI            OptString                                                               `json:"ActionURI"`
+	Oem                  jx.Raw                                                                  `json:"Oem"`
+	Priority             OptNilInt                                                               `json:"Priority"`
+	ResolutionType       OptString                                                               `json:"ResolutionType"`
+	RetryCount           OptNilInt                                                               `json:"RetryCount"`
+	RetryIntervalSeconds OptNilInt                                                               `json:"RetryIntervalSeconds"`
+	TargetComponentURI   OptString                                                               `json:"TargetComponentURI"`
 }
 
-// GetMessages returns the value of Messages.
-func (s *RedfishJobJobsItem) GetMessages() []RedfishJobJobsItemMessagesItem {
-	return s.Messages
+// GetActionParameters returns the value of ActionParameters.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetActionParameters() []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem {
+	return s.ActionParameters
 }
 
-// GetName returns the value of Name.
-func (s *RedfishJobJobsItem) GetName() OptString {
-	return s.Name
+// GetActionURI returns the value of ActionURI.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetActionURI() OptString {
+	return s.ActionURI
 }
 
 // GetOem returns the value of Oem.
-func (s *RedfishJobJobsItem) GetOem() jx.Raw {
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetOem() jx.Raw {
 	return s.Oem
 }
 
-// GetParameters returns the value of Parameters.
-func (s *RedfishJobJobsItem) GetParameters() OptRedfishJobJobsItemParameters {
-	return s.Parameters
-}
-
-// GetPayload returns the value of Payload.
-func (s *RedfishJobJobsItem) GetPayload() OptRedfishJobJobsItemPayload {
-	return s.Payload
+// GetPriority returns the value of Priority.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetPriority() OptNilInt {
+	return s.Priority
 }
 
-// GetPercentComplete returns the value of PercentComplete.
-func (s *RedfishJobJobsItem) GetPercentComplete() OptNilInt {
-	return s.PercentComplete
+// GetResolutionType returns the value of ResolutionType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetResolutionType() OptString {
+	return s.ResolutionType
 }
 
-// GetRawData returns the value of RawData.
-func (s *RedfishJobJobsItem) GetRawData() []byte {
-	return s.RawData
+// GetRetryCount returns the value of RetryCount.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetRetryCount() OptNilInt {
+	return s.RetryCount
 }
 
-// GetSchedule returns the value of Schedule.
-func (s *RedfishJobJobsItem) GetSchedule() OptRedfishJobJobsItemSchedule {
-	return s.Schedule
+// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
+	return s.RetryIntervalSeconds
 }
 
-// GetStartTime returns the value of StartTime.
-func (s *RedfishJobJobsItem) GetStartTime() OptString {
-	return s.StartTime
+// GetTargetComponentURI returns the value of TargetComponentURI.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetTargetComponentURI() OptString {
+	return s.TargetComponentURI
 }
 
-// GetStepOrder returns the value of StepOrder.
-func (s *RedfishJobJobsItem) GetStepOrder() OptNilNilStringArray {
-	return s.StepOrder
+// SetActionParameters sets the value of ActionParameters.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetActionParameters(val []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) {
+	s.ActionParameters = val
 }
 
-// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
-func (s *RedfishJobJobsItem) SetMessageDotExtendedInfo(val []RedfishJobJobsItemMessageDotExtendedInfoItem) {
-	s.MessageDotExtendedInfo = val
+// SetActionURI sets the value of ActionURI.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetActionURI(val OptString) {
+	s.ActionURI = val
 }
 
-// SetOdataDotContext sets the value of OdataDotContext.
-func (s *RedfishJobJobsItem) SetOdataDotContext(val OptString) {
-	s.OdataDotContext = val
+// SetOem sets the value of Oem.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// SetOdataDotEtag sets the value of OdataDotEtag.
-func (s *RedfishJobJobsItem) SetOdataDotEtag(val OptString) {
-	s.OdataDotEtag = val
+// SetPriority sets the value of Priority.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetPriority(val OptNilInt) {
+	s.Priority = val
 }
 
-// SetOdataDotID sets the value of OdataDotID.
-func (s *RedfishJobJobsItem) SetOdataDotID(val OptString) {
-	s.OdataDotID = val
+// SetResolutionType sets the value of ResolutionType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetResolutionType(val OptString) {
+	s.ResolutionType = val
 }
 
-// SetOdataDotType sets the value of OdataDotType.
-func (s *RedfishJobJobsItem) SetOdataDotType(val OptString) {
-	s.OdataDotType = val
+// SetRetryCount sets the value of RetryCount.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
+	s.RetryCount = val
 }
 
-// SetCreatedBy sets the value of CreatedBy.
-func (s *RedfishJobJobsItem) SetCreatedBy(val OptString) {
-	s.CreatedBy = val
+// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
+	s.RetryIntervalSeconds = val
 }
 
-// SetCreationTime sets the value of CreationTime.
-func (s *RedfishJobJobsItem) SetCreationTime(val OptString) {
-	s.CreationTime = val
+// SetTargetComponentURI sets the value of TargetComponentURI.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
+	s.TargetComponentURI = val
 }
 
-// SetDescription sets the value of Description.
-func (s *RedfishJobJobsItem) SetDescription(val OptString) {
-	s.Description = val
+type RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem struct {
+	AllowableNumbers           []string      `json:"AllowableNumbers"`
+	AllowablePattern           OptString     `json:"AllowablePattern"`
+	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
+	AllowableValues            []string      `json:"AllowableValues"`
+	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
+	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
+	DataType                   OptString     `json:"DataType"`
+	DefaultValue               OptString     `json:"DefaultValue"`
+	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
+	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
+	Name                       OptString     `json:"Name"`
+	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
+	ObjectDataType             OptString     `json:"ObjectDataType"`
+	Required                   OptBool       `json:"Required"`
 }
 
-// SetEndTime sets the value of EndTime.
-func (s *RedfishJobJobsItem) SetEndTime(val OptString) {
-	s.EndTime = val
+// GetAllowableNumbers returns the value of AllowableNumbers.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
+	return s.AllowableNumbers
 }
 
-// SetEstimatedCompletionTime sets the value of EstimatedCompletionTime.
-func (s *RedfishJobJobsItem) SetEstimatedCompletionTime(val OptString) {
-	s.EstimatedCompletionTime = val
+// GetAllowablePattern returns the value of AllowablePattern.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
+	return s.AllowablePattern
 }
 
-// SetEstimatedDuration sets the value of EstimatedDuration.
-func (s *RedfishJobJobsItem) SetEstimatedDuration(val OptString) {
-	s.EstimatedDuration = val
+// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
+	return s.AllowableValueDescriptions
 }
 
-// SetHidePayload sets the value of HidePayload.
-func (s *RedfishJobJobsItem) SetHidePayload(val OptBool) {
-	s.HidePayload = val
+// GetAllowableValues returns the value of AllowableValues.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
+	return s.AllowableValues
 }
 
-// SetID sets the value of ID.
-func (s *RedfishJobJobsItem) SetID(val OptString) {
-	s.ID = val
+// GetArraySizeMaximum returns the value of ArraySizeMaximum.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
+	return s.ArraySizeMaximum
 }
 
-// SetJobPriority sets the value of JobPriority.
-func (s *RedfishJobJobsItem) SetJobPriority(val OptNilInt) {
-	s.JobPriority = val
+// GetArraySizeMinimum returns the value of ArraySizeMinimum.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
+	return s.ArraySizeMinimum
 }
 
-// SetJobState sets the value of JobState.
-func (s *RedfishJobJobsItem) SetJobState(val OptString) {
-	s.JobState = val
+// GetDataType returns the value of DataType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
+	return s.DataType
 }
 
-// SetJobStatus sets the value of JobStatus.
-func (s *RedfishJobJobsItem) SetJobStatus(val OptString) {
-	s.JobStatus = val
+// GetDefaultValue returns the value of DefaultValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
+	return s.DefaultValue
 }
 
-// SetJobType sets the value of JobType.
-func (s *RedfishJobJobsItem) SetJobType(val OptString) {
-	s.JobType = val
+// GetMaximumValue returns the value of MaximumValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
+	return s.MaximumValue
 }
 
-// SetMaxExecutionTime sets the value of MaxExecutionTime.
-func (s *RedfishJobJobsItem) SetMaxExecutionTime(val OptString) {
-	s.MaxExecutionTime = val
+// GetMinimumValue returns the value of MinimumValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
+	return s.MinimumValue
 }
 
-// SetMessages sets the value of Messages.
-func (s *RedfishJobJobsItem) SetMessages(val []RedfishJobJobsItemMessagesItem) {
-	s.Messages = val
+// GetName returns the value of Name.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetName() OptString {
+	return s.Name
 }
 
-// SetName sets the value of Name.
-func (s *RedfishJobJobsItem) SetName(val OptString) {
-	s.Name = val
+// GetNoDefaultValue returns the value of NoDefaultValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
+	return s.NoDefaultValue
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishJobJobsItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// GetObjectDataType returns the value of ObjectDataType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
+	return s.ObjectDataType
 }
 
-// SetParameters sets the value of Parameters.
-func (s *RedfishJobJobsItem) SetParameters(val OptRedfishJobJobsItemParameters) {
-	s.Parameters = val
+// GetRequired returns the value of Required.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
+	return s.Required
 }
 
-// SetPayload sets the value of Payload.
-func (s *RedfishJobJobsItem) SetPayload(val OptRedfishJobJobsItemPayload) {
-	s.Payload = val
+// SetAllowableNumbers sets the value of AllowableNumbers.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
+	s.AllowableNumbers = val
 }
 
-// SetPercentComplete sets the value of PercentComplete.
-func (s *RedfishJobJobsItem) SetPercentComplete(val OptNilInt) {
-	s.PercentComplete = val
+// SetAllowablePattern sets the value of AllowablePattern.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
+	s.AllowablePattern = val
 }
 
-// SetRawData sets the value of RawData.
-func (s *RedfishJobJobsItem) SetRawData(val []byte) {
-	s.RawData = val
+// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
+	s.AllowableValueDescriptions = val
 }
 
-// SetSchedule sets the value of Schedule.
-func (s *RedfishJobJobsItem) SetSchedule(val OptRedfishJobJobsItemSchedule) {
-	s.Schedule = val
+// SetAllowableValues sets the value of AllowableValues.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
+	s.AllowableValues = val
 }
 
-// SetStartTime sets the value of StartTime.
-func (s *RedfishJobJobsItem) SetStartTime(val OptString) {
-	s.StartTime = val
+// SetArraySizeMaximum sets the value of ArraySizeMaximum.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
+	s.ArraySizeMaximum = val
 }
 
-// SetStepOrder sets the value of StepOrder.
-func (s *RedfishJobJobsItem) SetStepOrder(val OptNilNilStringArray) {
-	s.StepOrder = val
+// SetArraySizeMinimum sets the value of ArraySizeMinimum.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
+	s.ArraySizeMinimum = val
 }
 
-type RedfishJobJobsItemMessageDotExtendedInfoItem struct {
-	Message                  OptString                                                         `json:"Message"`
-	MessageArgs              []string                                                          `json:"MessageArgs"`
-	MessageId                OptString                                                         `json:"MessageId"`
-	MessageSeverity          OptString                                                         `json:"MessageSeverity"`
-	Oem                      jx.Raw                                                            `json:"Oem"`
-	RelatedProperties        OptNilNilStringArray                                              `json:"RelatedProperties"`
-	Resolution               OptString                                                         `json:"Resolution"`
-	ResolutionSteps          []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
-	Severity                 OptString                                                         `json:"Severity"`
-	UserAuthenticationSource OptString                                                         `json:"UserAuthenticationSource"`
-	Username                 OptString                                                         `json:"Username"`
+// SetDataType sets the value of DataType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
+	s.DataType = val
 }
 
-// GetMessage returns the value of Message.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessage() OptString {
-	return s.Message
+// SetDefaultValue sets the value of DefaultValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
+	s.DefaultValue = val
 }
 
-// GetMessageArgs returns the value of MessageArgs.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageArgs() []string {
-	return s.MessageArgs
+// SetMaximumValue sets the value of MaximumValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
+	s.MaximumValue = val
 }
 
-// GetMessageId returns the value of MessageId.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageId() OptString {
-	return s.MessageId
+// SetMinimumValue sets the value of MinimumValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
+	s.MinimumValue = val
 }
 
-// GetMessageSeverity returns the value of MessageSeverity.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
-	return s.MessageSeverity
+// SetName sets the value of Name.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetOem() jx.Raw {
-	return s.Oem
+// SetNoDefaultValue sets the value of NoDefaultValue.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
+	s.NoDefaultValue = val
 }
 
-// GetRelatedProperties returns the value of RelatedProperties.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
-	return s.RelatedProperties
+// SetObjectDataType sets the value of ObjectDataType.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
+	s.ObjectDataType = val
 }
 
-// GetResolution returns the value of Resolution.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetResolution() OptString {
-	return s.Resolution
+// SetRequired sets the value of Required.
+func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
+	s.Required = val
 }
 
-// GetResolutionSteps returns the value of ResolutionSteps.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem {
-	return s.ResolutionSteps
+type RedfishJobJobsItemParameters map[string]jx.Raw
+
+func (s *RedfishJobJobsItemParameters) init() RedfishJobJobsItemParameters {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// GetSeverity returns the value of Severity.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetSeverity() OptString {
-	return s.Severity
+type RedfishJobJobsItemPayload struct {
+	HttpHeaders   OptNilNilStringArray `json:"HttpHeaders"`
+	HttpOperation OptString            `json:"HttpOperation"`
+	JsonBody      OptString            `json:"JsonBody"`
+	TargetUri     OptString            `json:"TargetUri"`
 }
 
-// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
-	return s.UserAuthenticationSource
+// GetHttpHeaders returns the value of HttpHeaders.
+func (s *RedfishJobJobsItemPayload) GetHttpHeaders() OptNilNilStringArray {
+	return s.HttpHeaders
 }
 
-// GetUsername returns the value of Username.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) GetUsername() OptString {
-	return s.Username
+// GetHttpOperation returns the value of HttpOperation.
+func (s *RedfishJobJobsItemPayload) GetHttpOperation() OptString {
+	return s.HttpOperation
 }
 
-// SetMessage sets the value of Message.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessage(val OptString) {
-	s.Message = val
+// GetJsonBody returns the value of JsonBody.
+func (s *RedfishJobJobsItemPayload) GetJsonBody() OptString {
+	return s.JsonBody
 }
 
-// SetMessageArgs sets the value of MessageArgs.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
-	s.MessageArgs = val
+// GetTargetUri returns the value of TargetUri.
+func (s *RedfishJobJobsItemPayload) GetTargetUri() OptString {
+	return s.TargetUri
 }
 
-// SetMessageId sets the value of MessageId.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageId(val OptString) {
-	s.MessageId = val
+// SetHttpHeaders sets the value of HttpHeaders.
+func (s *RedfishJobJobsItemPayload) SetHttpHeaders(val OptNilNilStringArray) {
+	s.HttpHeaders = val
 }
 
-// SetMessageSeverity sets the value of MessageSeverity.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
-	s.MessageSeverity = val
+// SetHttpOperation sets the value of HttpOperation.
+func (s *RedfishJobJobsItemPayload) SetHttpOperation(val OptString) {
+	s.HttpOperation = val
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// SetJsonBody sets the value of JsonBody.
+func (s *RedfishJobJobsItemPayload) SetJsonBody(val OptString) {
+	s.JsonBody = val
 }
 
-// SetRelatedProperties sets the value of RelatedProperties.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
-	s.RelatedProperties = val
+// SetTargetUri sets the value of TargetUri.
+func (s *RedfishJobJobsItemPayload) SetTargetUri(val OptString) {
+	s.TargetUri = val
 }
 
-// SetResolution sets the value of Resolution.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetResolution(val OptString) {
-	s.Resolution = val
+type RedfishJobJobsItemSchedule struct {
+	EnabledDaysOfMonth  OptNilNilIntArray    `json:"EnabledDaysOfMonth"`
+	EnabledDaysOfWeek   OptNilNilStringArray `json:"EnabledDaysOfWeek"`
+	EnabledIntervals    OptNilNilStringArray `json:"EnabledIntervals"`
+	EnabledMonthsOfYear OptNilNilStringArray `json:"EnabledMonthsOfYear"`
+	InitialStartTime    OptString            `json:"InitialStartTime"`
+	Lifetime            OptString            `json:"Lifetime"`
+	MaxOccurrences      OptNilInt            `json:"MaxOccurrences"`
+	Name                OptString            `json:"Name"`
+	RecurrenceInterval  OptString            `json:"RecurrenceInterval"`
 }
 
-// SetResolutionSteps sets the value of ResolutionSteps.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) {
-	s.ResolutionSteps = val
+// GetEnabledDaysOfMonth returns the value of EnabledDaysOfMonth.
+func (s *RedfishJobJobsItemSchedule) GetEnabledDaysOfMonth() OptNilNilIntArray {
+	return s.EnabledDaysOfMonth
 }
 
-// SetSeverity sets the value of Severity.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetSeverity(val OptString) {
-	s.Severity = val
+// GetEnabledDaysOfWeek returns the value of EnabledDaysOfWeek.
+func (s *RedfishJobJobsItemSchedule) GetEnabledDaysOfWeek() OptNilNilStringArray {
+	return s.EnabledDaysOfWeek
 }
 
-// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
-	s.UserAuthenticationSource = val
+// GetEnabledIntervals returns the value of EnabledIntervals.
+func (s *RedfishJobJobsItemSchedule) GetEnabledIntervals() OptNilNilStringArray {
+	return s.EnabledIntervals
 }
 
-// SetUsername sets the value of Username.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItem) SetUsername(val OptString) {
-	s.Username = val
+// GetEnabledMonthsOfYear returns the value of EnabledMonthsOfYear.
+func (s *RedfishJobJobsItemSchedule) GetEnabledMonthsOfYear() OptNilNilStringArray {
+	return s.EnabledMonthsOfYear
 }
 
-type RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem struct {
-	ActionParameters     []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
-	ActionURI            OptString                                                                             `json:"ActionURI"`
-	Oem                  jx.Raw                                                                                `json:"Oem"`
-	Priority             OptNilInt                                                                             `json:"Priority"`
-	ResolutionType       OptString                                                                             `json:"ResolutionType"`
-	RetryCount           OptNilInt                                                                             `json:"RetryCount"`
-	RetryIntervalSeconds OptNilInt                                                                             `json:"RetryIntervalSeconds"`
-	TargetComponentURI   OptString                                                                             `json:"TargetComponentURI"`
+// GetInitialStartTime returns the value of InitialStartTime.
+func (s *RedfishJobJobsItemSchedule) GetInitialStartTime() OptString {
+	return s.InitialStartTime
 }
 
-// GetActionParameters returns the value of ActionParameters.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
-	return s.ActionParameters
+// GetLifetime returns the value of Lifetime.
+func (s *RedfishJobJobsItemSchedule) GetLifetime() OptString {
+	return s.Lifetime
 }
 
-// GetActionURI returns the value of ActionURI.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
-	return s.ActionURI
+// GetMaxOccurrences returns the value of MaxOccurrences.
+func (s *RedfishJobJobsItemSchedule) GetMaxOccurrences() OptNilInt {
+	return s.MaxOccurrences
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
-	return s.Oem
+// GetName returns the value of Name.
+func (s *RedfishJobJobsItemSchedule) GetName() OptString {
+	return s.Name
 }
 
-// GetPriority returns the value of Priority.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
-	return s.Priority
+// GetRecurrenceInterval returns the value of RecurrenceInterval.
+func (s *RedfishJobJobsItemSchedule) GetRecurrenceInterval() OptString {
+	return s.RecurrenceInterval
 }
 
-// GetResolutionType returns the value of ResolutionType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
-	return s.ResolutionType
+// SetEnabledDaysOfMonth sets the value of EnabledDaysOfMonth.
+func (s *RedfishJobJobsItemSchedule) SetEnabledDaysOfMonth(val OptNilNilIntArray) {
+	s.EnabledDaysOfMonth = val
 }
 
-// GetRetryCount returns the value of RetryCount.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
-	return s.RetryCount
+// SetEnabledDaysOfWeek sets the value of EnabledDaysOfWeek.
+func (s *RedfishJobJobsItemSchedule) SetEnabledDaysOfWeek(val OptNilNilStringArray) {
+	s.EnabledDaysOfWeek = val
 }
 
-// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
-	return s.RetryIntervalSeconds
+// SetEnabledIntervals sets the value of EnabledIntervals.
+func (s *RedfishJobJobsItemSchedule) SetEnabledIntervals(val OptNilNilStringArray) {
+	s.EnabledIntervals = val
 }
 
-// GetTargetComponentURI returns the value of TargetComponentURI.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
-	return s.TargetComponentURI
+// SetEnabledMonthsOfYear sets the value of EnabledMonthsOfYear.
+func (s *RedfishJobJobsItemSchedule) SetEnabledMonthsOfYear(val OptNilNilStringArray) {
+	s.EnabledMonthsOfYear = val
 }
 
-// SetActionParameters sets the value of ActionParameters.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
-	s.ActionParameters = val
+// SetInitialStartTime sets the value of InitialStartTime.
+func (s *RedfishJobJobsItemSchedule) SetInitialStartTime(val OptString) {
+	s.InitialStartTime = val
 }
 
-// SetActionURI sets the value of ActionURI.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
-	s.ActionURI = val
+// SetLifetime sets the value of Lifetime.
+func (s *RedfishJobJobsItemSchedule) SetLifetime(val OptString) {
+	s.Lifetime = val
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// SetMaxOccurrences sets the value of MaxOccurrences.
+func (s *RedfishJobJobsItemSchedule) SetMaxOccurrences(val OptNilInt) {
+	s.MaxOccurrences = val
 }
 
-// SetPriority sets the value of Priority.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
-	s.Priority = val
+// SetName sets the value of Name.
+func (s *RedfishJobJobsItemSchedule) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetResolutionType sets the value of ResolutionType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
-	s.ResolutionType = val
+// SetRecurrenceInterval sets the value of RecurrenceInterval.
+func (s *RedfishJobJobsItemSchedule) SetRecurrenceInterval(val OptString) {
+	s.RecurrenceInterval = val
 }
 
-// SetRetryCount sets the value of RetryCount.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
-	s.RetryCount = val
+// RedfishMetricReport schema.
+// Ref: #/components/schemas/RedfishMetricReport
+type RedfishMetricReport struct {
+	Name    OptString                           `json:"name"`
+	Reports []NilRedfishMetricReportReportsItem `json:"reports"`
 }
 
-// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
-	s.RetryIntervalSeconds = val
+// GetName returns the value of Name.
+func (s *RedfishMetricReport) GetName() OptString {
+	return s.Name
 }
 
-// SetTargetComponentURI sets the value of TargetComponentURI.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
-	s.TargetComponentURI = val
+// GetReports returns the value of Reports.
+func (s *RedfishMetricReport) GetReports() []NilRedfishMetricReportReportsItem {
+	return s.Reports
 }
 
-type RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
-	AllowableNumbers           []string      `json:"AllowableNumbers"`
-	AllowablePattern           OptString     `json:"AllowablePattern"`
-	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
-	AllowableValues            []string      `json:"AllowableValues"`
-	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
-	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
-	DataType                   OptString     `json:"DataType"`
-	DefaultValue               OptString     `json:"DefaultValue"`
-	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
-	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
-	Name                       OptString     `json:"Name"`
-	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
-	ObjectDataType             OptString     `json:"ObjectDataType"`
-	Required                   OptBool       `json:"Required"`
+// SetName sets the value of Name.
+func (s *RedfishMetricReport) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetAllowableNumbers returns the value of AllowableNumbers.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
-	return s.AllowableNumbers
+// SetReports sets the value of Reports.
+func (s *RedfishMetricReport) SetReports(val []NilRedfishMetricReportReportsItem) {
+	s.Reports = val
 }
 
-// GetAllowablePattern returns the value of AllowablePattern.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
-	return s.AllowablePattern
+type RedfishMetricReportReportsItem struct {
+	MessageDotExtendedInfo []RedfishMetricReportReportsItemMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
+	OdataDotContext        OptString                                                  `json:"@odata.context"`
+	OdataDotEtag           OptString                                                  `json:"@odata.etag"`
+	OdataDotID             OptString                                                  `json:"@odata.id"`
+	OdataDotType           OptString                                                  `json:"@odata.type"`
+	Context                OptString                                                  `json:"Context"`
+	Description            OptString                                                  `json:"Description"`
+	ID                     OptString                                                  `json:"Id"`
+	MetricValues           []RedfishMetricReportReportsItemMetricValuesItem           `json:"MetricValues"`
+	Name                   OptString                                                  `json:"Name"`
+	Oem                    jx.Raw                                                     `json:"Oem"`
+	ReportSequence         OptString                                                  `json:"ReportSequence"`
+	Timestamp              OptString                                                  `json:"Timestamp"`
 }
 
-// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
-	return s.AllowableValueDescriptions
+// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
+func (s *RedfishMetricReportReportsItem) GetMessageDotExtendedInfo() []RedfishMetricReportReportsItemMessageDotExtendedInfoItem {
+	return s.MessageDotExtendedInfo
 }
 
-// GetAllowableValues returns the value of AllowableValues.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
-	return s.AllowableValues
+// GetOdataDotContext returns the value of OdataDotContext.
+func (s *RedfishMetricReportReportsItem) GetOdataDotContext() OptString {
+	return s.OdataDotContext
 }
 
-// GetArraySizeMaximum returns the value of ArraySizeMaximum.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
-	return s.ArraySizeMaximum
+// GetOdataDotEtag returns the value of OdataDotEtag.
+func (s *RedfishMetricReportReportsItem) GetOdataDotEtag() OptString {
+	return s.OdataDotEtag
 }
 
-// GetArraySizeMinimum returns the value of ArraySizeMinimum.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
-	return s.ArraySizeMinimum
+// GetOdataDotID returns the value of OdataDotID.
+func (s *RedfishMetricReportReportsItem) GetOdataDotID() OptString {
+	return s.OdataDotID
 }
 
-// GetDataType returns the value of DataType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
-	return s.DataType
+// GetOdataDotType returns the value of OdataDotType.
+func (s *RedfishMetricReportReportsItem) GetOdataDotType() OptString {
+	return s.OdataDotType
 }
 
-// GetDefaultValue returns the value of DefaultValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
-	return s.DefaultValue
+// GetContext returns the value of Context.
+func (s *RedfishMetricReportReportsItem) GetContext() OptString {
+	return s.Context
 }
 
-// GetMaximumValue returns the value of MaximumValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
-	return s.MaximumValue
+// GetDescription returns the value of Description.
+func (s *RedfishMetricReportReportsItem) GetDescription() OptString {
+	return s.Description
 }
 
-// GetMinimumValue returns the value of MinimumValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
-	return s.MinimumValue
+// GetID returns the value of ID.
+func (s *RedfishMetricReportReportsItem) GetID() OptString {
+	return s.ID
 }
 
-// GetName returns the value of Name.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
-	return s.Name
+// GetMetricValues returns the value of MetricValues.
+func (s *RedfishMetricReportReportsItem) GetMetricValues() []RedfishMetricReportReportsItemMetricValuesItem {
+	return s.MetricValues
 }
 
-// GetNoDefaultValue returns the value of NoDefaultValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
-	return s.NoDefaultValue
+// GetName returns the value of Name.
+func (s *RedfishMetricReportReportsItem) GetName() OptString {
+	return s.Name
 }
 
-// GetObjectDataType returns the value of ObjectDataType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
-	return s.ObjectDataType
+// GetOem returns the value of Oem.
+func (s *RedfishMetricReportReportsItem) GetOem() jx.Raw {
+	return s.Oem
 }
 
-// GetRequired returns the value of Required.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
-	return s.Required
+// GetReportSequence returns the value of ReportSequence.
+func (s *RedfishMetricReportReportsItem) GetReportSequence() OptString {
+	return s.ReportSequence
 }
 
-// SetAllowableNumbers sets the value of AllowableNumbers.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
-	s.AllowableNumbers = val
+// GetTimestamp returns the value of Timestamp.
+func (s *RedfishMetricReportReportsItem) GetTimestamp() OptString {
+	return s.Timestamp
 }
 
-// SetAllowablePattern sets the value of AllowablePattern.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
-	s.AllowablePattern = val
+// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
+func (s *RedfishMetricReportReportsItem) SetMessageDotExtendedInfo(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItem) {
+	s.MessageDotExtendedInfo = val
 }
 
-// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
-	s.AllowableValueDescriptions = val
+// SetOdataDotContext sets the value of OdataDotContext.
+func (s *RedfishMetricReportReportsItem) SetOdataDotContext(val OptString) {
+	s.OdataDotContext = val
 }
 
-// SetAllowableValues sets the value of AllowableValues.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
-	s.AllowableValues = val
+// SetOdataDotEtag sets the value of OdataDotEtag.
+func (s *RedfishMetricReportReportsItem) SetOdataDotEtag(val OptString) {
+	s.OdataDotEtag = val
 }
 
-// SetArraySizeMaximum sets the value of ArraySizeMaximum.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
-	s.ArraySizeMaximum = val
+// SetOdataDotID sets the value of OdataDotID.
+func (s *RedfishMetricReportReportsItem) SetOdataDotID(val OptString) {
+	s.OdataDotID = val
 }
 
-// SetArraySizeMinimum sets the value of ArraySizeMinimum.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
-	s.ArraySizeMinimum = val
+// SetOdataDotType sets the value of OdataDotType.
+func (s *RedfishMetricReportReportsItem) SetOdataDotType(val OptString) {
+	s.OdataDotType = val
 }
 
-// SetDataType sets the value of DataType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
-	s.DataType = val
+// SetContext sets the value of Context.
+func (s *RedfishMetricReportReportsItem) SetContext(val OptString) {
+	s.Context = val
 }
 
-// SetDefaultValue sets the value of DefaultValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
-	s.DefaultValue = val
+// SetDescription sets the value of Description.
+func (s *RedfishMetricReportReportsItem) SetDescription(val OptString) {
+	s.Description = val
 }
 
-// SetMaximumValue sets the value of MaximumValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
-	s.MaximumValue = val
+// SetID sets the value of ID.
+func (s *RedfishMetricReportReportsItem) SetID(val OptString) {
+	s.ID = val
 }
 
-// SetMinimumValue sets the value of MinimumValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
-	s.MinimumValue = val
+// SetMetricValues sets the value of MetricValues.
+func (s *RedfishMetricReportReportsItem) SetMetricValues(val []RedfishMetricReportReportsItemMetricValuesItem) {
+	s.MetricValues = val
 }
 
 // SetName sets the value of Name.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
+func (s *RedfishMetricReportReportsItem) SetName(val OptString) {
 	s.Name = val
 }
 
-// SetNoDefaultValue sets the value of NoDefaultValue.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
-	s.NoDefaultValue = val
+// SetOem sets the value of Oem.
+func (s *RedfishMetricReportReportsItem) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// SetObjectDataType sets the value of ObjectDataType.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
-	s.ObjectDataType = val
+// SetReportSequence sets the value of ReportSequence.
+func (s *RedfishMetricReportReportsItem) SetReportSequence(val OptString) {
+	s.ReportSequence = val
 }
 
-// SetRequired sets the value of Required.
-func (s *RedfishJobJobsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
-	s.Required = val
+// SetTimestamp sets the value of Timestamp.
+func (s *RedfishMetricReportReportsItem) SetTimestamp(val OptString) {
+	s.Timestamp = val
 }
 
-type RedfishJobJobsItemMessagesItem struct {
-	Message                  OptString                                           `json:"Message"`
-	MessageArgs              []string                                            `json:"MessageArgs"`
-	MessageId                OptString                                           `json:"MessageId"`
-	MessageSeverity          OptString                                           `json:"MessageSeverity"`
-	Oem                      jx.Raw                                              `json:"Oem"`
-	RelatedProperties        OptNilNilStringArray                                `json:"RelatedProperties"`
-	Resolution               OptString                                           `json:"Resolution"`
-	ResolutionSteps          []RedfishJobJobsItemMessagesItemResolutionStepsItem `json:"ResolutionSteps"`
-	Severity                 OptString                                           `json:"Severity"`
-	UserAuthenticationSource OptString                                           `json:"UserAuthenticationSource"`
-	Username                 OptString                                           `json:"Username"`
+type RedfishMetricReportReportsItemMessageDotExtendedInfoItem struct {
+	Message                  OptString                                                                     `json:"Message"`
+	MessageArgs              []string                                                                      `json:"MessageArgs"`
+	MessageId                OptString                                                                     `json:"MessageId"`
+	MessageSeverity          OptString                                                                     `json:"MessageSeverity"`
+	Oem                      jx.Raw                                                                        `json:"Oem"`
+	RelatedProperties        OptNilNilStringArray                                                          `json:"RelatedProperties"`
+	Resolution               OptString                                                                     `json:"Resolution"`
+	ResolutionSteps          []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
+	Severity                 OptString                                                                     `json:"Severity"`
+	UserAuthenticationSource OptString                                                                     `json:"UserAuthenticationSource"`
+	Username                 OptString                                                                     `json:"Username"`
 }
 
 // GetMessage returns the value of Message.
-func (s *RedfishJobJobsItemMessagesItem) GetMessage() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessage() OptString {
 	return s.Message
 }
 
 // GetMessageArgs returns the value of MessageArgs.
-func (s *RedfishJobJobsItemMessagesItem) GetMessageArgs() []string {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageArgs() []string {
 	return s.MessageArgs
 }
 
 // GetMessageId returns the value of MessageId.
-func (s *RedfishJobJobsItemMessagesItem) GetMessageId() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageId() OptString {
 	return s.MessageId
 }
 
 // GetMessageSeverity returns the value of MessageSeverity.
-func (s *RedfishJobJobsItemMessagesItem) GetMessageSeverity() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
 	return s.MessageSeverity
 }
 
 // GetOem returns the value of Oem.
-func (s *RedfishJobJobsItemMessagesItem) GetOem() jx.Raw {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetOem() jx.Raw {
 	return s.Oem
 }
 
 // GetRelatedProperties returns the value of RelatedProperties.
-func (s *RedfishJobJobsItemMessagesItem) GetRelatedProperties() OptNilNilStringArray {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
 	return s.RelatedProperties
 }
 
 // GetResolution returns the value of Resolution.
-func (s *RedfishJobJobsItemMessagesItem) GetResolution() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetResolution() OptString {
 	return s.Resolution
 }
 
 // GetResolutionSteps returns the value of ResolutionSteps.
-func (s *RedfishJobJobsItemMessagesItem) GetResolutionSteps() []RedfishJobJobsItemMessagesItemResolutionStepsItem {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem {
 	return s.ResolutionSteps
 }
 
 // GetSeverity returns the value of Severity.
-func (s *RedfishJobJobsItemMessagesItem) GetSeverity() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetSeverity() OptString {
 	return s.Severity
 }
 
 // GetUserAuthenticationSource returns the value of UserAuthenticationSource.
-func (s *RedfishJobJobsItemMessagesItem) GetUserAuthenticationSource() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
 	return s.UserAuthenticationSource
 }
 
 // GetUsername returns the value of Username.
-func (s *RedfishJobJobsItemMessagesItem) GetUsername() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetUsername() OptString {
 	return s.Username
 }
 
 // SetMessage sets the value of Message.
-func (s *RedfishJobJobsItemMessagesItem) SetMessage(val OptString) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessage(val OptString) {
 	s.Message = val
 }
 
 // SetMessageArgs sets the value of MessageArgs.
-func (s *RedfishJobJobsItemMessagesItem) SetMessageArgs(val []string) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
 	s.MessageArgs = val
 }
 
 // SetMessageId sets the value of MessageId.
-func (s *RedfishJobJobsItemMessagesItem) SetMessageId(val OptString) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageId(val OptString) {
 	s.MessageId = val
 }
 
 // SetMessageSeverity sets the value of MessageSeverity.
-func (s *RedfishJobJobsItemMessagesItem) SetMessageSeverity(val OptString) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
 	s.MessageSeverity = val
 }
 
 // SetOem sets the value of Oem.
-func (s *RedfishJobJobsItemMessagesItem) SetOem(val jx.Raw) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
 	s.Oem = val
 }
 
 // SetRelatedProperties sets the value of RelatedProperties.
-func (s *RedfishJobJobsItemMessagesItem) SetRelatedProperties(val OptNilNilStringArray) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
 	s.RelatedProperties = val
 }
 
 // SetResolution sets the value of Resolution.
-func (s *RedfishJobJobsItemMessagesItem) SetResolution(val OptString) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetResolution(val OptString) {
 	s.Resolution = val
 }
 
 // SetResolutionSteps sets the value of ResolutionSteps.
-func (s *RedfishJobJobsItemMessagesItem) SetResolutionSteps(val []RedfishJobJobsItemMessagesItemResolutionStepsItem) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) {
 	s.ResolutionSteps = val
 }
 
 // SetSeverity sets the value of Severity.
-func (s *RedfishJobJobsItemMessagesItem) SetSeverity(val OptString) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetSeverity(val OptString) {
 	s.Severity = val
 }
 
 // SetUserAuthenticationSource sets the value of UserAuthenticationSource.
-func (s *RedfishJobJobsItemMessagesItem) SetUserAuthenticationSource(val OptString) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
 	s.UserAuthenticationSource = val
 }
 
 // SetUsername sets the value of Username.
-func (s *RedfishJobJobsItemMessagesItem) SetUsername(val OptString) {
-	s.Username = val
-}
-
-type RedfishJobJobsItemMessagesItemResolutionStepsItem struct {
-	ActionParameters     []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
-	ActionURI            OptString                                                               `json:"ActionURI"`
-	Oem                  jx.Raw                                                                  `json:"Oem"`
-	Priority             OptNilInt                                                               `json:"Priority"`
-	ResolutionType       OptString                                                               `json:"ResolutionType"`
-	RetryCount           OptNilInt                                                               `json:"RetryCount"`
-	RetryIntervalSeconds OptNilInt                                                               `json:"RetryIntervalSeconds"`
-	TargetComponentURI   OptString                                                               `json:"TargetComponentURI"`
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetUsername(val OptString) {
+	s.Username = val
+}
+
+type RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem struct {
+	ActionParameters     []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
+	ActionURI            OptString                                                                                         `json:"ActionURI"`
+	Oem                  jx.Raw                                                                                            `json:"Oem"`
+	Priority             OptNilInt                                                                                         `json:"Priority"`
+	ResolutionType       OptString                                                                                         `json:"ResolutionType"`
+	RetryCount           OptNilInt                                                                                         `json:"RetryCount"`
+	RetryIntervalSeconds OptNilInt                                                                                         `json:"RetryIntervalSeconds"`
+	TargetComponentURI   OptString                                                                                         `json:"TargetComponentURI"`
 }
 
 // GetActionParameters returns the value of ActionParameters.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetActionParameters() []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
 	return s.ActionParameters
 }
 
 // GetActionURI returns the value of ActionURI.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetActionURI() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
 	return s.ActionURI
 }
 
 // GetOem returns the value of Oem.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetOem() jx.Raw {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
 	return s.Oem
 }
 
 // GetPriority returns the value of Priority.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetPriority() OptNilInt {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
 	return s.Priority
 }
 
 // GetResolutionType returns the value of ResolutionType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetResolutionType() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
 	return s.ResolutionType
 }
 
 // GetRetryCount returns the value of RetryCount.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetRetryCount() OptNilInt {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
 	return s.RetryCount
 }
 
 // GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
 	return s.RetryIntervalSeconds
 }
 
 // GetTargetComponentURI returns the value of TargetComponentURI.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) GetTargetComponentURI() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
 	return s.TargetComponentURI
 }
 
 // SetActionParameters sets the value of ActionParameters.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetActionParameters(val []RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
 	s.ActionParameters = val
 }
 
 // SetActionURI sets the value of ActionURI.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetActionURI(val OptString) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
 	s.ActionURI = val
 }
 
 // SetOem sets the value of Oem.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetOem(val jx.Raw) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
 	s.Oem = val
 }
 
 // SetPriority sets the value of Priority.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetPriority(val OptNilInt) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
 	s.Priority = val
 }
 
 // SetResolutionType sets the value of ResolutionType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetResolutionType(val OptString) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
 	s.ResolutionType = val
 }
 
 // SetRetryCount sets the value of RetryCount.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
 	s.RetryCount = val
 }
 
 // SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
 	s.RetryIntervalSeconds = val
 }
 
 // SetTargetComponentURI sets the value of TargetComponentURI.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
 	s.TargetComponentURI = val
 }
 
-type RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem struct {
+type RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
 	AllowableNumbers           []string      `json:"AllowableNumbers"`
 	AllowablePattern           OptString     `json:"AllowablePattern"`
 	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
@@ -6244,2056 +9569,1875 @@ type RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem struc
 }
 
 // GetAllowableNumbers returns the value of AllowableNumbers.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
 	return s.AllowableNumbers
 }
 
 // GetAllowablePattern returns the value of AllowablePattern.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
 	return s.AllowablePattern
 }
 
 // GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
 	return s.AllowableValueDescriptions
 }
 
 // GetAllowableValues returns the value of AllowableValues.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
 	return s.AllowableValues
 }
 
 // GetArraySizeMaximum returns the value of ArraySizeMaximum.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
 	return s.ArraySizeMaximum
 }
-
-// GetArraySizeMinimum returns the value of ArraySizeMinimum.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
-	return s.ArraySizeMinimum
-}
-
-// GetDataType returns the value of DataType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
-	return s.DataType
-}
-
-// GetDefaultValue returns the value of DefaultValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
-	return s.DefaultValue
-}
-
-// GetMaximumValue returns the value of MaximumValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
-	return s.MaximumValue
-}
-
-// GetMinimumValue returns the value of MinimumValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
-	return s.MinimumValue
-}
-
-// GetName returns the value of Name.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetName() OptString {
-	return s.Name
-}
-
-// GetNoDefaultValue returns the value of NoDefaultValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
-	return s.NoDefaultValue
-}
-
-// GetObjectDataType returns the value of ObjectDataType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
-	return s.ObjectDataType
-}
-
-// GetRequired returns the value of Required.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
-	return s.Required
-}
-
-// SetAllowableNumbers sets the value of AllowableNumbers.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
-	s.AllowableNumbers = val
-}
-
-// SetAllowablePattern sets the value of AllowablePattern.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
-	s.AllowablePattern = val
-}
-
-// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
-	s.AllowableValueDescriptions = val
-}
-
-// SetAllowableValues sets the value of AllowableValues.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
-	s.AllowableValues = val
-}
-
-// SetArraySizeMaximum sets the value of ArraySizeMaximum.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
-	s.ArraySizeMaximum = val
-}
-
-// SetArraySizeMinimum sets the value of ArraySizeMinimum.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
-	s.ArraySizeMinimum = val
-}
-
-// SetDataType sets the value of DataType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
-	s.DataType = val
-}
-
-// SetDefaultValue sets the value of DefaultValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
-	s.DefaultValue = val
-}
-
-// SetMaximumValue sets the value of MaximumValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
-	s.MaximumValue = val
-}
-
-// SetMinimumValue sets the value of MinimumValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
-	s.MinimumValue = val
-}
-
-// SetName sets the value of Name.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
-	s.Name = val
-}
-
-// SetNoDefaultValue sets the value of NoDefaultValue.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
-	s.NoDefaultValue = val
-}
-
-// SetObjectDataType sets the value of ObjectDataType.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
-	s.ObjectDataType = val
-}
-
-// SetRequired sets the value of Required.
-func (s *RedfishJobJobsItemMessagesItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
-	s.Required = val
-}
-
-type RedfishJobJobsItemParameters map[string]jx.Raw
-
-func (s *RedfishJobJobsItemParameters) init() RedfishJobJobsItemParameters {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
-}
-
-type RedfishJobJobsItemPayload struct {
-	HttpHeaders   OptNilNilStringArray `json:"HttpHeaders"`
-	HttpOperation OptString            `json:"HttpOperation"`
-	JsonBody      OptString            `json:"JsonBody"`
-	TargetUri     OptString            `json:"TargetUri"`
-}
-
-// GetHttpHeaders returns the value of HttpHeaders.
-func (s *RedfishJobJobsItemPayload) GetHttpHeaders() OptNilNilStringArray {
-	return s.HttpHeaders
-}
-
-// GetHttpOperation returns the value of HttpOperation.
-func (s *RedfishJobJobsItemPayload) GetHttpOperation() OptString {
-	return s.HttpOperation
-}
-
-// GetJsonBody returns the value of JsonBody.
-func (s *RedfishJobJobsItemPayload) GetJsonBody() OptString {
-	return s.JsonBody
-}
-
-// GetTargetUri returns the value of TargetUri.
-func (s *RedfishJobJobsItemPayload) GetTargetUri() OptString {
-	return s.TargetUri
-}
-
-// SetHttpHeaders sets the value of HttpHeaders.
-func (s *RedfishJobJobsItemPayload) SetHttpHeaders(val OptNilNilStringArray) {
-	s.HttpHeaders = val
-}
-
-// SetHttpOperation sets the value of HttpOperation.
-func (s *RedfishJobJobsItemPayload) SetHttpOperation(val OptString) {
-	s.HttpOperation = val
-}
-
-// SetJsonBody sets the value of JsonBody.
-func (s *RedfishJobJobsItemPayload) SetJsonBody(val OptString) {
-	s.JsonBody = val
-}
-
-// SetTargetUri sets the value of TargetUri.
-func (s *RedfishJobJobsItemPayload) SetTargetUri(val OptString) {
-	s.TargetUri = val
-}
-
-type RedfishJobJobsItemSchedule struct {
-	EnabledDaysOfMonth  OptNilNilIntArray    `json:"EnabledDaysOfMonth"`
-	EnabledDaysOfWeek   OptNilNilStringArray `json:"EnabledDaysOfWeek"`
-	EnabledIntervals    OptNilNilStringArray `json:"EnabledIntervals"`
-	EnabledMonthsOfYear OptNilNilStringArray `json:"EnabledMonthsOfYear"`
-	InitialStartTime    OptString            `json:"InitialStartTime"`
-	Lifetime            OptString            `json:"Lifetime"`
-	MaxOccurrences      OptNilInt            `json:"MaxOccurrences"`
-	Name                OptString            `json:"Name"`
-	RecurrenceInterval  OptString            `json:"RecurrenceInterval"`
-}
-
-// GetEnabledDaysOfMonth returns the value of EnabledDaysOfMonth.
-func (s *RedfishJobJobsItemSchedule) GetEnabledDaysOfMonth() OptNilNilIntArray {
-	return s.EnabledDaysOfMonth
-}
-
-// GetEnabledDaysOfWeek returns the value of EnabledDaysOfWeek.
-func (s *RedfishJobJobsItemSchedule) GetEnabledDaysOfWeek() OptNilNilStringArray {
-	return s.EnabledDaysOfWeek
-}
-
-// GetEnabledIntervals returns the value of EnabledIntervals.
-func (s *RedfishJobJobsItemSchedule) GetEnabledIntervals() OptNilNilStringArray {
-	return s.EnabledIntervals
+
+// GetArraySizeMinimum returns the value of ArraySizeMinimum.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
+	return s.ArraySizeMinimum
 }
 
-// GetEnabledMonthsOfYear returns the value of EnabledMonthsOfYear.
-func (s *RedfishJobJobsItemSchedule) GetEnabledMonthsOfYear() OptNilNilStringArray {
-	return s.EnabledMonthsOfYear
+// GetDataType returns the value of DataType.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
+	return s.DataType
 }
 
-// GetInitialStartTime returns the value of InitialStartTime.
-func (s *RedfishJobJobsItemSchedule) GetInitialStartTime() OptString {
-	return s.InitialStartTime
+// GetDefaultValue returns the value of DefaultValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
+	return s.DefaultValue
 }
 
-// GetLifetime returns the value of Lifetime.
-func (s *RedfishJobJobsItemSchedule) GetLifetime() OptString {
-	return s.Lifetime
+// GetMaximumValue returns the value of MaximumValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
+	return s.MaximumValue
 }
 
-// GetMaxOccurrences returns the value of MaxOccurrences.
-func (s *RedfishJobJobsItemSchedule) GetMaxOccurrences() OptNilInt {
-	return s.MaxOccurrences
+// GetMinimumValue returns the value of MinimumValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
+	return s.MinimumValue
 }
 
 // GetName returns the value of Name.
-func (s *RedfishJobJobsItemSchedule) GetName() OptString {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
 	return s.Name
 }
 
-// GetRecurrenceInterval returns the value of RecurrenceInterval.
-func (s *RedfishJobJobsItemSchedule) GetRecurrenceInterval() OptString {
-	return s.RecurrenceInterval
+// GetNoDefaultValue returns the value of NoDefaultValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
+	return s.NoDefaultValue
 }
 
-// SetEnabledDaysOfMonth sets the value of EnabledDaysOfMonth.
-func (s *RedfishJobJobsItemSchedule) SetEnabledDaysOfMonth(val OptNilNilIntArray) {
-	s.EnabledDaysOfMonth = val
+// GetObjectDataType returns the value of ObjectDataType.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
+	return s.ObjectDataType
 }
 
-// SetEnabledDaysOfWeek sets the value of EnabledDaysOfWeek.
-func (s *RedfishJobJobsItemSchedule) SetEnabledDaysOfWeek(val OptNilNilStringArray) {
-	s.EnabledDaysOfWeek = val
+// GetRequired returns the value of Required.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
+	return s.Required
 }
 
-// SetEnabledIntervals sets the value of EnabledIntervals.
-func (s *RedfishJobJobsItemSchedule) SetEnabledIntervals(val OptNilNilStringArray) {
-	s.EnabledIntervals = val
+// SetAllowableNumbers sets the value of AllowableNumbers.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
+	s.AllowableNumbers = val
 }
 
-// SetEnabledMonthsOfYear sets the value of EnabledMonthsOfYear.
-func (s *RedfishJobJobsItemSchedule) SetEnabledMonthsOfYear(val OptNilNilStringArray) {
-	s.EnabledMonthsOfYear = val
+// SetAllowablePattern sets the value of AllowablePattern.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
+	s.AllowablePattern = val
 }
 
-// SetInitialStartTime sets the value of InitialStartTime.
-func (s *RedfishJobJobsItemSchedule) SetInitialStartTime(val OptString) {
-	s.InitialStartTime = val
+// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
+	s.AllowableValueDescriptions = val
 }
 
-// SetLifetime sets the value of Lifetime.
-func (s *RedfishJobJobsItemSchedule) SetLifetime(val OptString) {
-	s.Lifetime = val
+// SetAllowableValues sets the value of AllowableValues.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
+	s.AllowableValues = val
 }
 
-// SetMaxOccurrences sets the value of MaxOccurrences.
-func (s *RedfishJobJobsItemSchedule) SetMaxOccurrences(val OptNilInt) {
-	s.MaxOccurrences = val
+// SetArraySizeMaximum sets the value of ArraySizeMaximum.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
+	s.ArraySizeMaximum = val
 }
 
-// SetName sets the value of Name.
-func (s *RedfishJobJobsItemSchedule) SetName(val OptString) {
-	s.Name = val
+// SetArraySizeMinimum sets the value of ArraySizeMinimum.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
+	s.ArraySizeMinimum = val
 }
 
-// SetRecurrenceInterval sets the value of RecurrenceInterval.
-func (s *RedfishJobJobsItemSchedule) SetRecurrenceInterval(val OptString) {
-	s.RecurrenceInterval = val
+// SetDataType sets the value of DataType.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
+	s.DataType = val
 }
 
-// RedfishMetricReport schema.
-// Ref: #/components/schemas/RedfishMetricReport
-type RedfishMetricReport struct {
-	Name    OptString                           `json:"name"`
-	Reports []NilRedfishMetricReportReportsItem `json:"reports"`
+// SetDefaultValue sets the value of DefaultValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
+	s.DefaultValue = val
 }
 
-// GetName returns the value of Name.
-func (s *RedfishMetricReport) GetName() OptString {
-	return s.Name
+// SetMaximumValue sets the value of MaximumValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
+	s.MaximumValue = val
 }
 
-// GetReports returns the value of Reports.
-func (s *RedfishMetricReport) GetReports() []NilRedfishMetricReportReportsItem {
-	return s.Reports
+// SetMinimumValue sets the value of MinimumValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
+	s.MinimumValue = val
 }
 
 // SetName sets the value of Name.
-func (s *RedfishMetricReport) SetName(val OptString) {
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
 	s.Name = val
 }
 
-// SetReports sets the value of Reports.
-func (s *RedfishMetricReport) SetReports(val []NilRedfishMetricReportReportsItem) {
-	s.Reports = val
+// SetNoDefaultValue sets the value of NoDefaultValue.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
+	s.NoDefaultValue = val
 }
 
-type RedfishMetricReportReportsItem struct {
-	MessageDotExtendedInfo []RedfishMetricReportReportsItemMessageDotExtendedInfoItem `json:"@Message.ExtendedInfo"`
-	OdataDotContext        OptString                                                  `json:"@odata.context"`
-	OdataDotEtag           OptString                                                  `json:"@odata.etag"`
-	OdataDotID             OptString                                                  `json:"@odata.id"`
-	OdataDotType           OptString                                                  `json:"@odata.type"`
-	Context                OptString                                                  `json:"Context"`
-	Description            OptString                                                  `json:"Description"`
-	ID                     OptString                                                  `json:"Id"`
-	MetricValues           []RedfishMetricReportReportsItemMetricValuesItem           `json:"MetricValues"`
-	Name                   OptString                                                  `json:"Name"`
-	Oem                    jx.Raw                                                     `json:"Oem"`
-	ReportSequence         OptString                                                  `json:"ReportSequence"`
-	Timestamp              OptString                                                  `json:"Timestamp"`
+// SetObjectDataType sets the value of ObjectDataType.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
+	s.ObjectDataType = val
 }
 
-// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
-func (s *RedfishMetricReportReportsItem) GetMessageDotExtendedInfo() []RedfishMetricReportReportsItemMessageDotExtendedInfoItem {
-	return s.MessageDotExtendedInfo
+// SetRequired sets the value of Required.
+func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
+	s.Required = val
 }
 
-// GetOdataDotContext returns the value of OdataDotContext.
-func (s *RedfishMetricReportReportsItem) GetOdataDotContext() OptString {
-	return s.OdataDotContext
+type RedfishMetricReportReportsItemMetricValuesItem struct {
+	MetricId       OptString `json:"MetricId"`
+	MetricProperty OptString `json:"MetricProperty"`
+	MetricValue    OptString `json:"MetricValue"`
+	Oem            jx.Raw    `json:"Oem"`
+	Timestamp      OptString `json:"Timestamp"`
 }
 
-// GetOdataDotEtag returns the value of OdataDotEtag.
-func (s *RedfishMetricReportReportsItem) GetOdataDotEtag() OptString {
-	return s.OdataDotEtag
+// GetMetricId returns the value of MetricId.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricId() OptString {
+	return s.MetricId
 }
 
-// GetOdataDotID returns the value of OdataDotID.
-func (s *RedfishMetricReportReportsItem) GetOdataDotID() OptString {
-	return s.OdataDotID
+// GetMetricProperty returns the value of MetricProperty.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricProperty() OptString {
+	return s.MetricProperty
 }
 
-// GetOdataDotType returns the value of OdataDotType.
-func (s *RedfishMetricReportReportsItem) GetOdataDotType() OptString {
-	return s.OdataDotType
+// GetMetricValue returns the value of MetricValue.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricValue() OptString {
+	return s.MetricValue
 }
 
-// GetContext returns the value of Context.
-func (s *RedfishMetricReportReportsItem) GetContext() OptString {
-	return s.Context
+// GetOem returns the value of Oem.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) GetOem() jx.Raw {
+	return s.Oem
 }
 
-// GetDescription returns the value of Description.
-func (s *RedfishMetricReportReportsItem) GetDescription() OptString {
-	return s.Description
+// GetTimestamp returns the value of Timestamp.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) GetTimestamp() OptString {
+	return s.Timestamp
 }
 
-// GetID returns the value of ID.
-func (s *RedfishMetricReportReportsItem) GetID() OptString {
-	return s.ID
+// SetMetricId sets the value of MetricId.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricId(val OptString) {
+	s.MetricId = val
 }
 
-// GetMetricValues returns the value of MetricValues.
-func (s *RedfishMetricReportReportsItem) GetMetricValues() []RedfishMetricReportReportsItemMetricValuesItem {
-	return s.MetricValues
+// SetMetricProperty sets the value of MetricProperty.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricProperty(val OptString) {
+	s.MetricProperty = val
 }
 
-// GetName returns the value of Name.
-func (s *RedfishMetricReportReportsItem) GetName() OptString {
-	return s.Name
+// SetMetricValue sets the value of MetricValue.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricValue(val OptString) {
+	s.MetricValue = val
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishMetricReportReportsItem) GetOem() jx.Raw {
-	return s.Oem
+// SetOem sets the value of Oem.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// GetReportSequence returns the value of ReportSequence.
-func (s *RedfishMetricReportReportsItem) GetReportSequence() OptString {
-	return s.ReportSequence
+// SetTimestamp sets the value of Timestamp.
+func (s *RedfishMetricReportReportsItemMetricValuesItem) SetTimestamp(val OptString) {
+	s.Timestamp = val
 }
 
-// GetTimestamp returns the value of Timestamp.
-func (s *RedfishMetricReportReportsItem) GetTimestamp() OptString {
-	return s.Timestamp
+// RedfishSystem schema.
+// Ref: #/components/schemas/RedfishSystem
+type RedfishSystem struct {
+	BiosVersion    OptString                  `json:"bios_version"`
+	BootNext       OptString                  `json:"boot_next"`
+	BootOrder      OptNilNilStringArray       `json:"boot_order"`
+	Health         OptString                  `json:"health"`
+	HostName       OptString                  `json:"host_name"`
+	Manufacturer   OptString                  `json:"manufacturer"`
+	Model          OptString                  `json:"model"`
+	Name           OptString                  `json:"name"`
+	OemDell        OptNilRedfishSystemOemDell `json:"oem_dell"`
+	PowerStatus    OptString                  `json:"power_status"`
+	ProcessorCount OptInt                     `json:"processor_count"`
+	SerialNumber   OptString                  `json:"serial_number"`
+	TotalMemory    OptFloat32                 `json:"total_memory"`
 }
 
-// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
-func (s *RedfishMetricReportReportsItem) SetMessageDotExtendedInfo(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItem) {
-	s.MessageDotExtendedInfo = val
+// GetBiosVersion returns the value of BiosVersion.
+func (s *RedfishSystem) GetBiosVersion() OptString {
+	return s.BiosVersion
 }
 
-// SetOdataDotContext sets the value of OdataDotContext.
-func (s *RedfishMetricReportReportsItem) SetOdataDotContext(val OptString) {
-	s.OdataDotContext = val
+// GetBootNext returns the value of BootNext.
+func (s *RedfishSystem) GetBootNext() OptString {
+	return s.BootNext
+}
+
+// GetBootOrder returns the value of BootOrder.
+func (s *RedfishSystem) GetBootOrder() OptNilNilStringArray {
+	return s.BootOrder
+}
+
+// GetHealth returns the value of Health.
+func (s *RedfishSystem) GetHealth() OptString {
+	return s.Health
+}
+
+// GetHostName returns the value of HostName.
+func (s *RedfishSystem) GetHostName() OptString {
+	return s.HostName
 }
 
-// SetOdataDotEtag sets the value of OdataDotEtag.
-func (s *RedfishMetricReportReportsItem) SetOdataDotEtag(val OptString) {
-	s.OdataDotEtag = val
+// GetManufacturer returns the value of Manufacturer.
+func (s *RedfishSystem) GetManufacturer() OptString {
+	return s.Manufacturer
 }
 
-// SetOdataDotID sets the value of OdataDotID.
-func (s *RedfishMetricReportReportsItem) SetOdataDotID(val OptString) {
-	s.OdataDotID = val
+// GetModel returns the value of Model.
+func (s *RedfishSystem) GetModel() OptString {
+	return s.Model
 }
 
-// SetOdataDotType sets the value of OdataDotType.
-func (s *RedfishMetricReportReportsItem) SetOdataDotType(val OptString) {
-	s.OdataDotType = val
+// GetName returns the value of Name.
+func (s *RedfishSystem) GetName() OptString {
+	return s.Name
 }
 
-// SetContext sets the value of Context.
-func (s *RedfishMetricReportReportsItem) SetContext(val OptString) {
-	s.Context = val
+// GetOemDell returns the value of OemDell.
+func (s *RedfishSystem) GetOemDell() OptNilRedfishSystemOemDell {
+	return s.OemDell
 }
 
-// SetDescription sets the value of Description.
-func (s *RedfishMetricReportReportsItem) SetDescription(val OptString) {
-	s.Description = val
+// GetPowerStatus returns the value of PowerStatus.
+func (s *RedfishSystem) GetPowerStatus() OptString {
+	return s.PowerStatus
 }
 
-// SetID sets the value of ID.
-func (s *RedfishMetricReportReportsItem) SetID(val OptString) {
-	s.ID = val
+// GetProcessorCount returns the value of ProcessorCount.
+func (s *RedfishSystem) GetProcessorCount() OptInt {
+	return s.ProcessorCount
 }
 
-// SetMetricValues sets the value of MetricValues.
-func (s *RedfishMetricReportReportsItem) SetMetricValues(val []RedfishMetricReportReportsItemMetricValuesItem) {
-	s.MetricValues = val
+// GetSerialNumber returns the value of SerialNumber.
+func (s *RedfishSystem) GetSerialNumber() OptString {
+	return s.SerialNumber
 }
 
-// SetName sets the value of Name.
-func (s *RedfishMetricReportReportsItem) SetName(val OptString) {
-	s.Name = val
+// GetTotalMemory returns the value of TotalMemory.
+func (s *RedfishSystem) GetTotalMemory() OptFloat32 {
+	return s.TotalMemory
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishMetricReportReportsItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// SetBiosVersion sets the value of BiosVersion.
+func (s *RedfishSystem) SetBiosVersion(val OptString) {
+	s.BiosVersion = val
 }
 
-// SetReportSequence sets the value of ReportSequence.
-func (s *RedfishMetricReportReportsItem) SetReportSequence(val OptString) {
-	s.ReportSequence = val
+// SetBootNext sets the value of BootNext.
+func (s *RedfishSystem) SetBootNext(val OptString) {
+	s.BootNext = val
 }
 
-// SetTimestamp sets the value of Timestamp.
-func (s *RedfishMetricReportReportsItem) SetTimestamp(val OptString) {
-	s.Timestamp = val
+// SetBootOrder sets the value of BootOrder.
+func (s *RedfishSystem) SetBootOrder(val OptNilNilStringArray) {
+	s.BootOrder = val
 }
 
-type RedfishMetricReportReportsItemMessageDotExtendedInfoItem struct {
-	Message                  OptString                                                                     `json:"Message"`
-	MessageArgs              []string                                                                      `json:"MessageArgs"`
-	MessageId                OptString                                                                     `json:"MessageId"`
-	MessageSeverity          OptString                                                                     `json:"MessageSeverity"`
-	Oem                      jx.Raw                                                                        `json:"Oem"`
-	RelatedProperties        OptNilNilStringArray                                                          `json:"RelatedProperties"`
-	Resolution               OptString                                                                     `json:"Resolution"`
-	ResolutionSteps          []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
-	Severity                 OptString                                                                     `json:"Severity"`
-	UserAuthenticationSource OptString                                                                     `json:"UserAuthenticationSource"`
-	Username                 OptString                                                                     `json:"Username"`
+// SetHealth sets the value of Health.
+func (s *RedfishSystem) SetHealth(val OptString) {
+	s.Health = val
 }
 
-// GetMessage returns the value of Message.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessage() OptString {
-	return s.Message
+// SetHostName sets the value of HostName.
+func (s *RedfishSystem) SetHostName(val OptString) {
+	s.HostName = val
 }
 
-// GetMessageArgs returns the value of MessageArgs.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageArgs() []string {
-	return s.MessageArgs
+// SetManufacturer sets the value of Manufacturer.
+func (s *RedfishSystem) SetManufacturer(val OptString) {
+	s.Manufacturer = val
 }
 
-// GetMessageId returns the value of MessageId.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageId() OptString {
-	return s.MessageId
+// SetModel sets the value of Model.
+func (s *RedfishSystem) SetModel(val OptString) {
+	s.Model = val
 }
 
-// GetMessageSeverity returns the value of MessageSeverity.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
-	return s.MessageSeverity
+// SetName sets the value of Name.
+func (s *RedfishSystem) SetName(val OptString) {
+	s.Name = val
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetOem() jx.Raw {
-	return s.Oem
+// SetOemDell sets the value of OemDell.
+func (s *RedfishSystem) SetOemDell(val OptNilRedfishSystemOemDell) {
+	s.OemDell = val
 }
 
-// GetRelatedProperties returns the value of RelatedProperties.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
-	return s.RelatedProperties
+// SetPowerStatus sets the value of PowerStatus.
+func (s *RedfishSystem) SetPowerStatus(val OptString) {
+	s.PowerStatus = val
 }
 
-// GetResolution returns the value of Resolution.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetResolution() OptString {
-	return s.Resolution
+// SetProcessorCount sets the value of ProcessorCount.
+func (s *RedfishSystem) SetProcessorCount(val OptInt) {
+	s.ProcessorCount = val
 }
 
-// GetResolutionSteps returns the value of ResolutionSteps.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem {
-	return s.ResolutionSteps
+// SetSerialNumber sets the value of SerialNumber.
+func (s *RedfishSystem) SetSerialNumber(val OptString) {
+	s.SerialNumber = val
 }
 
-// GetSeverity returns the value of Severity.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetSeverity() OptString {
-	return s.Severity
+// SetTotalMemory sets the value of TotalMemory.
+func (s *RedfishSystem) SetTotalMemory(val OptFloat32) {
+	s.TotalMemory = val
 }
 
-// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
-	return s.UserAuthenticationSource
+type RedfishSystemOemDell struct {
+	MessageDotExtendedInfo             OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray `json:"@Message.ExtendedInfo"`
+	OdataDotContext                    OptString                                                 `json:"@odata.context"`
+	OdataDotEtag                       OptNilString                                              `json:"@odata.etag"`
+	OdataDotID                         OptString                                                 `json:"@odata.id"`
+	OdataDotType                       OptString                                                 `json:"@odata.type"`
+	BIOSReleaseDate                    OptString                                                 `json:"BIOSReleaseDate"`
+	BaseBoardChassisSlot               OptString                                                 `json:"BaseBoardChassisSlot"`
+	BatteryRollupStatus                OptString                                                 `json:"BatteryRollupStatus"`
+	BladeGeometry                      OptString                                                 `json:"BladeGeometry"`
+	CMCIP                              OptString                                                 `json:"CMCIP"`
+	CPURollupStatus                    OptString                                                 `json:"CPURollupStatus"`
+	ChassisModel                       OptString                                                 `json:"ChassisModel"`
+	ChassisName                        OptString                                                 `json:"ChassisName"`
+	ChassisServiceTag                  OptString                                                 `json:"ChassisServiceTag"`
+	ChassisSystemHeightUnit            OptInt                                                    `json:"ChassisSystemHeightUnit"`
+	CurrentRollupStatus                OptString                                                 `json:"CurrentRollupStatus"`
+	Description                        OptString                                                 `json:"Description"`
+	EstimatedExhaustTemperatureCelsius OptInt                                                    `json:"EstimatedExhaustTemperatureCelsius"`
+	EstimatedSystemAirflowCFM          OptInt                                                    `json:"EstimatedSystemAirflowCFM"`
+	ExpressServiceCode                 OptString                                                 `json:"ExpressServiceCode"`
+	FanRollupStatus                    OptString                                                 `json:"FanRollupStatus"`
+	IDSDMRollupStatus                  OptString                                                 `json:"IDSDMRollupStatus"`
+	ID                                 OptString                                                 `json:"Id"`
+	IntrusionRollupStatus              OptString                                                 `json:"IntrusionRollupStatus"`
+	IsOEMBranded                       OptString                                                 `json:"IsOEMBranded"`
+	LastSystemInventoryTime            OptString                                                 `json:"LastSystemInventoryTime"`
+	LastUpdateTime                     OptString                                                 `json:"LastUpdateTime"`
+	LicensingRollupStatus              OptString                                                 `json:"LicensingRollupStatus"`
+	ManagedSystemSize                  OptString                                                 `json:"ManagedSystemSize"`
+	MaxCPUSockets                      OptInt                                                    `json:"MaxCPUSockets"`
+	MaxDIMMSlots                       OptInt                                                    `json:"MaxDIMMSlots"`
+	MaxPCIeSlots                       OptInt                                                    `json:"MaxPCIeSlots"`
+	MemoryOperationMode                OptString                                                 `json:"MemoryOperationMode"`
+	Name                               OptString                                                 `json:"Name"`
+	NodeID                             OptString                                                 `json:"NodeID"`
+	Oem                                jx.Raw                                                    `json:"Oem"`
+	PSRollupStatus                     OptString                                                 `json:"PSRollupStatus"`
+	PlatformGUID                       OptString                                                 `json:"PlatformGUID"`
+	PopulatedDIMMSlots                 OptInt                                                    `json:"PopulatedDIMMSlots"`
+	PopulatedPCIeSlots                 OptInt                                                    `json:"PopulatedPCIeSlots"`
+	PowerCapEnabledState               OptString                                                 `json:"PowerCapEnabledState"`
+	SDCardRollupStatus                 OptString                                                 `json:"SDCardRollupStatus"`
+	SELRollupStatus                    OptString                                                 `json:"SELRollupStatus"`
+	ServerAllocationWatts              OptInt                                                    `json:"ServerAllocationWatts"`
+	StorageRollupStatus                OptString                                                 `json:"StorageRollupStatus"`
+	SysMemErrorMethodology             OptString                                                 `json:"SysMemErrorMethodology"`
+	SysMemFailOverState                OptString                                                 `json:"SysMemFailOverState"`
+	SysMemLocation                     OptString                                                 `json:"SysMemLocation"`
+	SysMemPrimaryStatus                OptString                                                 `json:"SysMemPrimaryStatus"`
+	SystemGeneration                   OptString                                                 `json:"SystemGeneration"`
+	SystemID                           OptInt                                                    `json:"SystemID"`
+	SystemRevision                     OptString                                                 `json:"SystemRevision"`
+	TempRollupStatus                   OptString                                                 `json:"TempRollupStatus"`
+	TempStatisticsRollupStatus         OptString                                                 `json:"TempStatisticsRollupStatus"`
+	UUID                               OptString                                                 `json:"UUID"`
+	VoltRollupStatus                   OptString                                                 `json:"VoltRollupStatus"`
+	SmbiosGUID                         OptString                                                 `json:"smbiosGUID"`
 }
 
-// GetUsername returns the value of Username.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) GetUsername() OptString {
-	return s.Username
+// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
+func (s *RedfishSystemOemDell) GetMessageDotExtendedInfo() OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray {
+	return s.MessageDotExtendedInfo
 }
 
-// SetMessage sets the value of Message.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessage(val OptString) {
-	s.Message = val
+// GetOdataDotContext returns the value of OdataDotContext.
+func (s *RedfishSystemOemDell) GetOdataDotContext() OptString {
+	return s.OdataDotContext
 }
 
-// SetMessageArgs sets the value of MessageArgs.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
-	s.MessageArgs = val
+// GetOdataDotEtag returns the value of OdataDotEtag.
+func (s *RedfishSystemOemDell) GetOdataDotEtag() OptNilString {
+	return s.OdataDotEtag
 }
 
-// SetMessageId sets the value of MessageId.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageId(val OptString) {
-	s.MessageId = val
+// GetOdataDotID returns the value of OdataDotID.
+func (s *RedfishSystemOemDell) GetOdataDotID() OptString {
+	return s.OdataDotID
 }
 
-// SetMessageSeverity sets the value of MessageSeverity.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
-	s.MessageSeverity = val
+// GetOdataDotType returns the value of OdataDotType.
+func (s *RedfishSystemOemDell) GetOdataDotType() OptString {
+	return s.OdataDotType
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// GetBIOSReleaseDate returns the value of BIOSReleaseDate.
+func (s *RedfishSystemOemDell) GetBIOSReleaseDate() OptString {
+	return s.BIOSReleaseDate
 }
 
-// SetRelatedProperties sets the value of RelatedProperties.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
-	s.RelatedProperties = val
+// GetBaseBoardChassisSlot returns the value of BaseBoardChassisSlot.
+func (s *RedfishSystemOemDell) GetBaseBoardChassisSlot() OptString {
+	return s.BaseBoardChassisSlot
 }
 
-// SetResolution sets the value of Resolution.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetResolution(val OptString) {
-	s.Resolution = val
+// GetBatteryRollupStatus returns the value of BatteryRollupStatus.
+func (s *RedfishSystemOemDell) GetBatteryRollupStatus() OptString {
+	return s.BatteryRollupStatus
 }
 
-// SetResolutionSteps sets the value of ResolutionSteps.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) {
-	s.ResolutionSteps = val
+// GetBladeGeometry returns the value of BladeGeometry.
+func (s *RedfishSystemOemDell) GetBladeGeometry() OptString {
+	return s.BladeGeometry
 }
 
-// SetSeverity sets the value of Severity.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetSeverity(val OptString) {
-	s.Severity = val
+// GetCMCIP returns the value of CMCIP.
+func (s *RedfishSystemOemDell) GetCMCIP() OptString {
+	return s.CMCIP
 }
 
-// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
-	s.UserAuthenticationSource = val
+// GetCPURollupStatus returns the value of CPURollupStatus.
+func (s *RedfishSystemOemDell) GetCPURollupStatus() OptString {
+	return s.CPURollupStatus
 }
 
-// SetUsername sets the value of Username.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItem) SetUsername(val OptString) {
-	s.Username = val
+// GetChassisModel returns the value of ChassisModel.
+func (s *RedfishSystemOemDell) GetChassisModel() OptString {
+	return s.ChassisModel
 }
 
-type RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem struct {
-	ActionParameters     []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
-	ActionURI            OptString                                                                                         `json:"ActionURI"`
-	Oem                  jx.Raw                                                                                            `json:"Oem"`
-	Priority             OptNilInt                                                                                         `json:"Priority"`
-	ResolutionType       OptString                                                                                         `json:"ResolutionType"`
-	RetryCount           OptNilInt                                                                                         `json:"RetryCount"`
-	RetryIntervalSeconds OptNilInt                                                                                         `json:"RetryIntervalSeconds"`
-	TargetComponentURI   OptString                                                                                         `json:"TargetComponentURI"`
+// GetChassisName returns the value of ChassisName.
+func (s *RedfishSystemOemDell) GetChassisName() OptString {
+	return s.ChassisName
 }
 
-// GetActionParameters returns the value of ActionParameters.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
-	return s.ActionParameters
+// GetChassisServiceTag returns the value of ChassisServiceTag.
+func (s *RedfishSystemOemDell) GetChassisServiceTag() OptString {
+	return s.ChassisServiceTag
 }
 
-// GetActionURI returns the value of ActionURI.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
-	return s.ActionURI
+// GetChassisSystemHeightUnit returns the value of ChassisSystemHeightUnit.
+func (s *RedfishSystemOemDell) GetChassisSystemHeightUnit() OptInt {
+	return s.ChassisSystemHeightUnit
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
-	return s.Oem
+// GetCurrentRollupStatus returns the value of CurrentRollupStatus.
+func (s *RedfishSystemOemDell) GetCurrentRollupStatus() OptString {
+	return s.CurrentRollupStatus
 }
 
-// GetPriority returns the value of Priority.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
-	return s.Priority
+// GetDescription returns the value of Description.
+func (s *RedfishSystemOemDell) GetDescription() OptString {
+	return s.Description
 }
 
-// GetResolutionType returns the value of ResolutionType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
-	return s.ResolutionType
+// GetEstimatedExhaustTemperatureCelsius returns the value of EstimatedExhaustTemperatureCelsius.
+func (s *RedfishSystemOemDell) GetEstimatedExhaustTemperatureCelsius() OptInt {
+	return s.EstimatedExhaustTemperatureCelsius
 }
 
-// GetRetryCount returns the value of RetryCount.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
-	return s.RetryCount
+// GetEstimatedSystemAirflowCFM returns the value of EstimatedSystemAirflowCFM.
+func (s *RedfishSystemOemDell) GetEstimatedSystemAirflowCFM() OptInt {
+	return s.EstimatedSystemAirflowCFM
 }
 
-// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
-	return s.RetryIntervalSeconds
+// GetExpressServiceCode returns the value of ExpressServiceCode.
+func (s *RedfishSystemOemDell) GetExpressServiceCode() OptString {
+	return s.ExpressServiceCode
 }
 
-// GetTargetComponentURI returns the value of TargetComponentURI.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
-	return s.TargetComponentURI
+// GetFanRollupStatus returns the value of FanRollupStatus.
+func (s *RedfishSystemOemDell) GetFanRollupStatus() OptString {
+	return s.FanRollupStatus
 }
 
-// SetActionParameters sets the value of ActionParameters.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
-	s.ActionParameters = val
+// GetIDSDMRollupStatus returns the value of IDSDMRollupStatus.
+func (s *RedfishSystemOemDell) GetIDSDMRollupStatus() OptString {
+	return s.IDSDMRollupStatus
 }
 
-// SetActionURI sets the value of ActionURI.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
-	s.ActionURI = val
+// GetID returns the value of ID.
+func (s *RedfishSystemOemDell) GetID() OptString {
+	return s.ID
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// GetIntrusionRollupStatus returns the value of IntrusionRollupStatus.
+func (s *RedfishSystemOemDell) GetIntrusionRollupStatus() OptString {
+	return s.IntrusionRollupStatus
 }
 
-// SetPriority sets the value of Priority.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
-	s.Priority = val
+// GetIsOEMBranded returns the value of IsOEMBranded.
+func (s *RedfishSystemOemDell) GetIsOEMBranded() OptString {
+	return s.IsOEMBranded
 }
 
-// SetResolutionType sets the value of ResolutionType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
-	s.ResolutionType = val
+// GetLastSystemInventoryTime returns the value of LastSystemInventoryTime.
+func (s *RedfishSystemOemDell) GetLastSystemInventoryTime() OptString {
+	return s.LastSystemInventoryTime
 }
 
-// SetRetryCount sets the value of RetryCount.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
-	s.RetryCount = val
+// GetLastUpdateTime returns the value of LastUpdateTime.
+func (s *RedfishSystemOemDell) GetLastUpdateTime() OptString {
+	return s.LastUpdateTime
 }
 
-// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
-	s.RetryIntervalSeconds = val
+// GetLicensingRollupStatus returns the value of LicensingRollupStatus.
+func (s *RedfishSystemOemDell) GetLicensingRollupStatus() OptString {
+	return s.LicensingRollupStatus
 }
 
-// SetTargetComponentURI sets the value of TargetComponentURI.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
-	s.TargetComponentURI = val
+// GetManagedSystemSize returns the value of ManagedSystemSize.
+func (s *RedfishSystemOemDell) GetManagedSystemSize() OptString {
+	return s.ManagedSystemSize
 }
 
-type RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
-	AllowableNumbers           []string      `json:"AllowableNumbers"`
-	AllowablePattern           OptString     `json:"AllowablePattern"`
-	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
-	AllowableValues            []string      `json:"AllowableValues"`
-	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
-	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
-	DataType                   OptString     `json:"DataType"`
-	DefaultValue               OptString     `json:"DefaultValue"`
-	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
-	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
-	Name                       OptString     `json:"Name"`
-	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
-	ObjectDataType             OptString     `json:"ObjectDataType"`
-	Required                   OptBool       `json:"Required"`
+// GetMaxCPUSockets returns the value of MaxCPUSockets.
+func (s *RedfishSystemOemDell) GetMaxCPUSockets() OptInt {
+	return s.MaxCPUSockets
 }
 
-// GetAllowableNumbers returns the value of AllowableNumbers.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
-	return s.AllowableNumbers
+// GetMaxDIMMSlots returns the value of MaxDIMMSlots.
+func (s *RedfishSystemOemDell) GetMaxDIMMSlots() OptInt {
+	return s.MaxDIMMSlots
 }
 
-// GetAllowablePattern returns the value of AllowablePattern.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
-	return s.AllowablePattern
+// GetMaxPCIeSlots returns the value of MaxPCIeSlots.
+func (s *RedfishSystemOemDell) GetMaxPCIeSlots() OptInt {
+	return s.MaxPCIeSlots
 }
 
-// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
-	return s.AllowableValueDescriptions
+// GetMemoryOperationMode returns the value of MemoryOperationMode.
+func (s *RedfishSystemOemDell) GetMemoryOperationMode() OptString {
+	return s.MemoryOperationMode
 }
 
-// GetAllowableValues returns the value of AllowableValues.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
-	return s.AllowableValues
+// GetName returns the value of Name.
+func (s *RedfishSystemOemDell) GetName() OptString {
+	return s.Name
 }
 
-// GetArraySizeMaximum returns the value of ArraySizeMaximum.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
-	return s.ArraySizeMaximum
+// GetNodeID returns the value of NodeID.
+func (s *RedfishSystemOemDell) GetNodeID() OptString {
+	return s.NodeID
 }
 
-// GetArraySizeMinimum returns the value of ArraySizeMinimum.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
-	return s.ArraySizeMinimum
+// GetOem returns the value of Oem.
+func (s *RedfishSystemOemDell) GetOem() jx.Raw {
+	return s.Oem
 }
 
-// GetDataType returns the value of DataType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
-	return s.DataType
+// GetPSRollupStatus returns the value of PSRollupStatus.
+func (s *RedfishSystemOemDell) GetPSRollupStatus() OptString {
+	return s.PSRollupStatus
 }
 
-// GetDefaultValue returns the value of DefaultValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
-	return s.DefaultValue
+// GetPlatformGUID returns the value of PlatformGUID.
+func (s *RedfishSystemOemDell) GetPlatformGUID() OptString {
+	return s.PlatformGUID
 }
 
-// GetMaximumValue returns the value of MaximumValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
-	return s.MaximumValue
+// GetPopulatedDIMMSlots returns the value of PopulatedDIMMSlots.
+func (s *RedfishSystemOemDell) GetPopulatedDIMMSlots() OptInt {
+	return s.PopulatedDIMMSlots
 }
 
-// GetMinimumValue returns the value of MinimumValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
-	return s.MinimumValue
+// GetPopulatedPCIeSlots returns the value of PopulatedPCIeSlots.
+func (s *RedfishSystemOemDell) GetPopulatedPCIeSlots() OptInt {
+	return s.PopulatedPCIeSlots
 }
 
-// GetName returns the value of Name.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
-	return s.Name
+// GetPowerCapEnabledState returns the value of PowerCapEnabledState.
+func (s *RedfishSystemOemDell) GetPowerCapEnabledState() OptString {
+	return s.PowerCapEnabledState
 }
 
-// GetNoDefaultValue returns the value of NoDefaultValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
-	return s.NoDefaultValue
+// GetSDCardRollupStatus returns the value of SDCardRollupStatus.
+func (s *RedfishSystemOemDell) GetSDCardRollupStatus() OptString {
+	return s.SDCardRollupStatus
 }
 
-// GetObjectDataType returns the value of ObjectDataType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
-	return s.ObjectDataType
+// GetSELRollupStatus returns the value of SELRollupStatus.
+func (s *RedfishSystemOemDell) GetSELRollupStatus() OptString {
+	return s.SELRollupStatus
 }
 
-// GetRequired returns the value of Required.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
-	return s.Required
+// GetServerAllocationWatts returns the value of ServerAllocationWatts.
+func (s *RedfishSystemOemDell) GetServerAllocationWatts() OptInt {
+	return s.ServerAllocationWatts
 }
 
-// SetAllowableNumbers sets the value of AllowableNumbers.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
-	s.AllowableNumbers = val
+// GetStorageRollupStatus returns the value of StorageRollupStatus.
+func (s *RedfishSystemOemDell) GetStorageRollupStatus() OptString {
+	return s.StorageRollupStatus
 }
 
-// SetAllowablePattern sets the value of AllowablePattern.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
-	s.AllowablePattern = val
+// GetSysMemErrorMethodology returns the value of SysMemErrorMethodology.
+func (s *RedfishSystemOemDell) GetSysMemErrorMethodology() OptString {
+	return s.SysMemErrorMethodology
 }
 
-// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
-	s.AllowableValueDescriptions = val
+// GetSysMemFailOverState returns the value of SysMemFailOverState.
+func (s *RedfishSystemOemDell) GetSysMemFailOverState() OptString {
+	return s.SysMemFailOverState
 }
 
-// SetAllowableValues sets the value of AllowableValues.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
-	s.AllowableValues = val
+// GetSysMemLocation returns the value of SysMemLocation.
+func (s *RedfishSystemOemDell) GetSysMemLocation() OptString {
+	return s.SysMemLocation
 }
 
-// SetArraySizeMaximum sets the value of ArraySizeMaximum.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
-	s.ArraySizeMaximum = val
+// GetSysMemPrimaryStatus returns the value of SysMemPrimaryStatus.
+func (s *RedfishSystemOemDell) GetSysMemPrimaryStatus() OptString {
+	return s.SysMemPrimaryStatus
 }
 
-// SetArraySizeMinimum sets the value of ArraySizeMinimum.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
-	s.ArraySizeMinimum = val
+// GetSystemGeneration returns the value of SystemGeneration.
+func (s *RedfishSystemOemDell) GetSystemGeneration() OptString {
+	return s.SystemGeneration
 }
 
-// SetDataType sets the value of DataType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
-	s.DataType = val
+// GetSystemID returns the value of SystemID.
+func (s *RedfishSystemOemDell) GetSystemID() OptInt {
+	return s.SystemID
 }
 
-// SetDefaultValue sets the value of DefaultValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
-	s.DefaultValue = val
+// GetSystemRevision returns the value of SystemRevision.
+func (s *RedfishSystemOemDell) GetSystemRevision() OptString {
+	return s.SystemRevision
 }
 
-// SetMaximumValue sets the value of MaximumValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
-	s.MaximumValue = val
+// GetTempRollupStatus returns the value of TempRollupStatus.
+func (s *RedfishSystemOemDell) GetTempRollupStatus() OptString {
+	return s.TempRollupStatus
 }
 
-// SetMinimumValue sets the value of MinimumValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
-	s.MinimumValue = val
+// GetTempStatisticsRollupStatus returns the value of TempStatisticsRollupStatus.
+func (s *RedfishSystemOemDell) GetTempStatisticsRollupStatus() OptString {
+	return s.TempStatisticsRollupStatus
 }
 
-// SetName sets the value of Name.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
-	s.Name = val
+// GetUUID returns the value of UUID.
+func (s *RedfishSystemOemDell) GetUUID() OptString {
+	return s.UUID
 }
 
-// SetNoDefaultValue sets the value of NoDefaultValue.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
-	s.NoDefaultValue = val
+// GetVoltRollupStatus returns the value of VoltRollupStatus.
+func (s *RedfishSystemOemDell) GetVoltRollupStatus() OptString {
+	return s.VoltRollupStatus
 }
 
-// SetObjectDataType sets the value of ObjectDataType.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
-	s.ObjectDataType = val
+// GetSmbiosGUID returns the value of SmbiosGUID.
+func (s *RedfishSystemOemDell) GetSmbiosGUID() OptString {
+	return s.SmbiosGUID
 }
 
-// SetRequired sets the value of Required.
-func (s *RedfishMetricReportReportsItemMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
-	s.Required = val
+// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
+func (s *RedfishSystemOemDell) SetMessageDotExtendedInfo(val OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) {
+	s.MessageDotExtendedInfo = val
 }
 
-type RedfishMetricReportReportsItemMetricValuesItem struct {
-	MetricId       OptString `json:"MetricId"`
-	MetricProperty OptString `json:"MetricProperty"`
-	MetricValue    OptString `json:"MetricValue"`
-	Oem            jx.Raw    `json:"Oem"`
-	Timestamp      OptString `json:"Timestamp"`
+// SetOdataDotContext sets the value of OdataDotContext.
+func (s *RedfishSystemOemDell) SetOdataDotContext(val OptString) {
+	s.OdataDotContext = val
 }
 
-// GetMetricId returns the value of MetricId.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricId() OptString {
-	return s.MetricId
+// SetOdataDotEtag sets the value of OdataDotEtag.
+func (s *RedfishSystemOemDell) SetOdataDotEtag(val OptNilString) {
+	s.OdataDotEtag = val
 }
 
-// GetMetricProperty returns the value of MetricProperty.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricProperty() OptString {
-	return s.MetricProperty
+// SetOdataDotID sets the value of OdataDotID.
+func (s *RedfishSystemOemDell) SetOdataDotID(val OptString) {
+	s.OdataDotID = val
 }
 
-// GetMetricValue returns the value of MetricValue.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) GetMetricValue() OptString {
-	return s.MetricValue
+// SetOdataDotType sets the value of OdataDotType.
+func (s *RedfishSystemOemDell) SetOdataDotType(val OptString) {
+	s.OdataDotType = val
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) GetOem() jx.Raw {
-	return s.Oem
+// SetBIOSReleaseDate sets the value of BIOSReleaseDate.
+func (s *RedfishSystemOemDell) SetBIOSReleaseDate(val OptString) {
+	s.BIOSReleaseDate = val
 }
 
-// GetTimestamp returns the value of Timestamp.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) GetTimestamp() OptString {
-	return s.Timestamp
+// SetBaseBoardChassisSlot sets the value of BaseBoardChassisSlot.
+func (s *RedfishSystemOemDell) SetBaseBoardChassisSlot(val OptString) {
+	s.BaseBoardChassisSlot = val
 }
 
-// SetMetricId sets the value of MetricId.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricId(val OptString) {
-	s.MetricId = val
+// SetBatteryRollupStatus sets the value of BatteryRollupStatus.
+func (s *RedfishSystemOemDell) SetBatteryRollupStatus(val OptString) {
+	s.BatteryRollupStatus = val
 }
 
-// SetMetricProperty sets the value of MetricProperty.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricProperty(val OptString) {
-	s.MetricProperty = val
+// SetBladeGeometry sets the value of BladeGeometry.
+func (s *RedfishSystemOemDell) SetBladeGeometry(val OptString) {
+	s.BladeGeometry = val
 }
 
-// SetMetricValue sets the value of MetricValue.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) SetMetricValue(val OptString) {
-	s.MetricValue = val
+// SetCMCIP sets the value of CMCIP.
+func (s *RedfishSystemOemDell) SetCMCIP(val OptString) {
+	s.CMCIP = val
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// SetCPURollupStatus sets the value of CPURollupStatus.
+func (s *RedfishSystemOemDell) SetCPURollupStatus(val OptString) {
+	s.CPURollupStatus = val
 }
 
-// SetTimestamp sets the value of Timestamp.
-func (s *RedfishMetricReportReportsItemMetricValuesItem) SetTimestamp(val OptString) {
-	s.Timestamp = val
+// SetChassisModel sets the value of ChassisModel.
+func (s *RedfishSystemOemDell) SetChassisModel(val OptString) {
+	s.ChassisModel = val
 }
 
-// RedfishSystem schema.
-// Ref: #/components/schemas/RedfishSystem
-type RedfishSystem struct {
-	BiosVersion    OptString                  `json:"bios_version"`
-	BootNext       OptString                  `json:"boot_next"`
-	BootOrder      OptNilNilStringArray       `json:"boot_order"`
-	Health         OptString                  `json:"health"`
-	HostName       OptString                  `json:"host_name"`
-	Manufacturer   OptString                  `json:"manufacturer"`
-	Model          OptString                  `json:"model"`
-	Name           OptString                  `json:"name"`
-	OemDell        OptNilRedfishSystemOemDell `json:"oem_dell"`
-	PowerStatus    OptString                  `json:"power_status"`
-	ProcessorCount OptInt                     `json:"processor_count"`
-	SerialNumber   OptString                  `json:"serial_number"`
-	TotalMemory    OptFloat32                 `json:"total_memory"`
+// SetChassisName sets the value of ChassisName.
+func (s *RedfishSystemOemDell) SetChassisName(val OptString) {
+	s.ChassisName = val
 }
 
-// GetBiosVersion returns the value of BiosVersion.
-func (s *RedfishSystem) GetBiosVersion() OptString {
-	return s.BiosVersion
+// SetChassisServiceTag sets the value of ChassisServiceTag.
+func (s *RedfishSystemOemDell) SetChassisServiceTag(val OptString) {
+	s.ChassisServiceTag = val
 }
 
-// GetBootNext returns the value of BootNext.
-func (s *RedfishSystem) GetBootNext() OptString {
-	return s.BootNext
+// SetChassisSystemHeightUnit sets the value of ChassisSystemHeightUnit.
+func (s *RedfishSystemOemDell) SetChassisSystemHeightUnit(val OptInt) {
+	s.ChassisSystemHeightUnit = val
 }
 
-// GetBootOrder returns the value of BootOrder.
-func (s *RedfishSystem) GetBootOrder() OptNilNilStringArray {
-	return s.BootOrder
+// SetCurrentRollupStatus sets the value of CurrentRollupStatus.
+func (s *RedfishSystemOemDell) SetCurrentRollupStatus(val OptString) {
+	s.CurrentRollupStatus = val
 }
 
-// GetHealth returns the value of Health.
-func (s *RedfishSystem) GetHealth() OptString {
-	return s.Health
+// SetDescription sets the value of Description.
+func (s *RedfishSystemOemDell) SetDescription(val OptString) {
+	s.Description = val
 }
 
-// GetHostName returns the value of HostName.
-func (s *RedfishSystem) GetHostName() OptString {
-	return s.HostName
+// SetEstimatedExhaustTemperatureCelsius sets the value of EstimatedExhaustTemperatureCelsius.
+func (s *RedfishSystemOemDell) SetEstimatedExhaustTemperatureCelsius(val OptInt) {
+	s.EstimatedExhaustTemperatureCelsius = val
 }
 
-// GetManufacturer returns the value of Manufacturer.
-func (s *RedfishSystem) GetManufacturer() OptString {
-	return s.Manufacturer
+// SetEstimatedSystemAirflowCFM sets the value of EstimatedSystemAirflowCFM.
+func (s *RedfishSystemOemDell) SetEstimatedSystemAirflowCFM(val OptInt) {
+	s.EstimatedSystemAirflowCFM = val
 }
 
-// GetModel returns the value of Model.
-func (s *RedfishSystem) GetModel() OptString {
-	return s.Model
+// SetExpressServiceCode sets the value of ExpressServiceCode.
+func (s *RedfishSystemOemDell) SetExpressServiceCode(val OptString) {
+	s.ExpressServiceCode = val
 }
 
-// GetName returns the value of Name.
-func (s *RedfishSystem) GetName() OptString {
-	return s.Name
+// SetFanRollupStatus sets the value of FanRollupStatus.
+func (s *RedfishSystemOemDell) SetFanRollupStatus(val OptString) {
+	s.FanRollupStatus = val
 }
 
-// GetOemDell returns the value of OemDell.
-func (s *RedfishSystem) GetOemDell() OptNilRedfishSystemOemDell {
-	return s.OemDell
+// SetIDSDMRollupStatus sets the value of IDSDMRollupStatus.
+func (s *RedfishSystemOemDell) SetIDSDMRollupStatus(val OptString) {
+	s.IDSDMRollupStatus = val
 }
 
-// GetPowerStatus returns the value of PowerStatus.
-func (s *RedfishSystem) GetPowerStatus() OptString {
-	return s.PowerStatus
+// SetID sets the value of ID.
+func (s *RedfishSystemOemDell) SetID(val OptString) {
+	s.ID = val
 }
 
-// GetProcessorCount returns the value of ProcessorCount.
-func (s *RedfishSystem) GetProcessorCount() OptInt {
-	return s.ProcessorCount
+// SetIntrusionRollupStatus sets the value of IntrusionRollupStatus.
+func (s *RedfishSystemOemDell) SetIntrusionRollupStatus(val OptString) {
+	s.IntrusionRollupStatus = val
 }
 
-// GetSerialNumber returns the value of SerialNumber.
-func (s *RedfishSystem) GetSerialNumber() OptString {
-	return s.SerialNumber
+// SetIsOEMBranded sets the value of IsOEMBranded.
+func (s *RedfishSystemOemDell) SetIsOEMBranded(val OptString) {
+	s.IsOEMBranded = val
 }
 
-// GetTotalMemory returns the value of TotalMemory.
-func (s *RedfishSystem) GetTotalMemory() OptFloat32 {
-	return s.TotalMemory
+// SetLastSystemInventoryTime sets the value of LastSystemInventoryTime.
+func (s *RedfishSystemOemDell) SetLastSystemInventoryTime(val OptString) {
+	s.LastSystemInventoryTime = val
 }
 
-// SetBiosVersion sets the value of BiosVersion.
-func (s *RedfishSystem) SetBiosVersion(val OptString) {
-	s.BiosVersion = val
+// SetLastUpdateTime sets the value of LastUpdateTime.
+func (s *RedfishSystemOemDell) SetLastUpdateTime(val OptString) {
+	s.LastUpdateTime = val
 }
 
-// SetBootNext sets the value of BootNext.
-func (s *RedfishSystem) SetBootNext(val OptString) {
-	s.BootNext = val
+// SetLicensingRollupStatus sets the value of LicensingRollupStatus.
+func (s *RedfishSystemOemDell) SetLicensingRollupStatus(val OptString) {
+	s.LicensingRollupStatus = val
 }
 
-// SetBootOrder sets the value of BootOrder.
-func (s *RedfishSystem) SetBootOrder(val OptNilNilStringArray) {
-	s.BootOrder = val
+// SetManagedSystemSize sets the value of ManagedSystemSize.
+func (s *RedfishSystemOemDell) SetManagedSystemSize(val OptString) {
+	s.ManagedSystemSize = val
 }
 
-// SetHealth sets the value of Health.
-func (s *RedfishSystem) SetHealth(val OptString) {
-	s.Health = val
+// SetMaxCPUSockets sets the value of MaxCPUSockets.
+func (s *RedfishSystemOemDell) SetMaxCPUSockets(val OptInt) {
+	s.MaxCPUSockets = val
 }
 
-// SetHostName sets the value of HostName.
-func (s *RedfishSystem) SetHostName(val OptString) {
-	s.HostName = val
+// SetMaxDIMMSlots sets the value of MaxDIMMSlots.
+func (s *RedfishSystemOemDell) SetMaxDIMMSlots(val OptInt) {
+	s.MaxDIMMSlots = val
 }
 
-// SetManufacturer sets the value of Manufacturer.
-func (s *RedfishSystem) SetManufacturer(val OptString) {
-	s.Manufacturer = val
+// SetMaxPCIeSlots sets the value of MaxPCIeSlots.
+func (s *RedfishSystemOemDell) SetMaxPCIeSlots(val OptInt) {
+	s.MaxPCIeSlots = val
 }
-
-// SetModel sets the value of Model.
-func (s *RedfishSystem) SetModel(val OptString) {
-	s.Model = val
+
+// SetMemoryOperationMode sets the value of MemoryOperationMode.
+func (s *RedfishSystemOemDell) SetMemoryOperationMode(val OptString) {
+	s.MemoryOperationMode = val
 }
 
 // SetName sets the value of Name.
-func (s *RedfishSystem) SetName(val OptString) {
+func (s *RedfishSystemOemDell) SetName(val OptString) {
 	s.Name = val
 }
 
-// SetOemDell sets the value of OemDell.
-func (s *RedfishSystem) SetOemDell(val OptNilRedfishSystemOemDell) {
-	s.OemDell = val
+// SetNodeID sets the value of NodeID.
+func (s *RedfishSystemOemDell) SetNodeID(val OptString) {
+	s.NodeID = val
 }
 
-// SetPowerStatus sets the value of PowerStatus.
-func (s *RedfishSystem) SetPowerStatus(val OptString) {
-	s.PowerStatus = val
+// SetOem sets the value of Oem.
+func (s *RedfishSystemOemDell) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// SetProcessorCount sets the value of ProcessorCount.
-func (s *RedfishSystem) SetProcessorCount(val OptInt) {
-	s.ProcessorCount = val
+// SetPSRollupStatus sets the value of PSRollupStatus.
+func (s *RedfishSystemOemDell) SetPSRollupStatus(val OptString) {
+	s.PSRollupStatus = val
 }
 
-// SetSerialNumber sets the value of SerialNumber.
-func (s *RedfishSystem) SetSerialNumber(val OptString) {
-	s.SerialNumber = val
+// SetPlatformGUID sets the value of PlatformGUID.
+func (s *RedfishSystemOemDell) SetPlatformGUID(val OptString) {
+	s.PlatformGUID = val
 }
 
-// SetTotalMemory sets the value of TotalMemory.
-func (s *RedfishSystem) SetTotalMemory(val OptFloat32) {
-	s.TotalMemory = val
+// SetPopulatedDIMMSlots sets the value of PopulatedDIMMSlots.
+func (s *RedfishSystemOemDell) SetPopulatedDIMMSlots(val OptInt) {
+	s.PopulatedDIMMSlots = val
 }
 
-type RedfishSystemOemDell struct {
-	MessageDotExtendedInfo             OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray `json:"@Message.ExtendedInfo"`
-	OdataDotContext                    OptString                                                 `json:"@odata.context"`
-	OdataDotEtag                       OptNilString                                              `json:"@odata.etag"`
-	OdataDotID                         OptString                                                 `json:"@odata.id"`
-	OdataDotType                       OptString                                                 `json:"@odata.type"`
-	BIOSReleaseDate                    OptString                                                 `json:"BIOSReleaseDate"`
-	BaseBoardChassisSlot               OptString                                                 `json:"BaseBoardChassisSlot"`
-	BatteryRollupStatus                OptString                                                 `json:"BatteryRollupStatus"`
-	BladeGeometry                      OptString                                                 `json:"BladeGeometry"`
-	CMCIP                              OptString                                                 `json:"CMCIP"`
-	CPURollupStatus                    OptString                                                 `json:"CPURollupStatus"`
-	ChassisModel                       OptString                                                 `json:"ChassisModel"`
-	ChassisName                        OptString                                                 `json:"ChassisName"`
-	ChassisServiceTag                  OptString                                                 `json:"ChassisServiceTag"`
-	ChassisSystemHeightUnit            OptInt                                                    `json:"ChassisSystemHeightUnit"`
-	CurrentRollupStatus                OptString                                                 `json:"CurrentRollupStatus"`
-	Description                        OptString                                                 `json:"Description"`
-	EstimatedExhaustTemperatureCelsius OptInt                                                    `json:"EstimatedExhaustTemperatureCelsius"`
-	EstimatedSystemAirflowCFM          OptInt                                                    `json:"EstimatedSystemAirflowCFM"`
-	ExpressServiceCode                 OptString                                                 `json:"ExpressServiceCode"`
-	FanRollupStatus                    OptString                                                 `json:"FanRollupStatus"`
-	IDSDMRollupStatus                  OptString                                                 `json:"IDSDMRollupStatus"`
-	ID                                 OptString                                                 `json:"Id"`
-	IntrusionRollupStatus              OptString                                                 `json:"IntrusionRollupStatus"`
-	IsOEMBranded                       OptString                                                 `json:"IsOEMBranded"`
-	LastSystemInventoryTime            OptString                                                 `json:"LastSystemInventoryTime"`
-	LastUpdateTime                     OptString                                                 `json:"LastUpdateTime"`
-	LicensingRollupStatus              OptString                                                 `json:"LicensingRollupStatus"`
-	ManagedSystemSize                  OptString                                                 `json:"ManagedSystemSize"`
-	MaxCPUSockets                      OptInt                                                    `json:"MaxCPUSockets"`
-	MaxDIMMSlots                       OptInt                                                    `json:"MaxDIMMSlots"`
-	MaxPCIeSlots                       OptInt                                                    `json:"MaxPCIeSlots"`
-	MemoryOperationMode                OptString                                                 `json:"MemoryOperationMode"`
-	Name                               OptString                                                 `json:"Name"`
-	NodeID                             OptString                                                 `json:"NodeID"`
-	Oem                                jx.Raw                                                    `json:"Oem"`
-	PSRollupStatus                     OptString                                                 `json:"PSRollupStatus"`
-	PlatformGUID                       OptString                                                 `json:"PlatformGUID"`
-	PopulatedDIMMSlots                 OptInt                                                    `json:"PopulatedDIMMSlots"`
-	PopulatedPCIeSlots                 OptInt                                                    `json:"PopulatedPCIeSlots"`
-	PowerCapEnabledState               OptString                                                 `json:"PowerCapEnabledState"`
-	SDCardRollupStatus                 OptString                                                 `json:"SDCardRollupStatus"`
-	SELRollupStatus                    OptString                                                 `json:"SELRollupStatus"`
-	ServerAllocationWatts              OptInt                                                    `json:"ServerAllocationWatts"`
-	StorageRollupStatus                OptString                                                 `json:"StorageRollupStatus"`
-	SysMemErrorMethodology             OptString                                                 `json:"SysMemErrorMethodology"`
-	SysMemFailOverState                OptString                                                 `json:"SysMemFailOverState"`
-	SysMemLocation                     OptString                                                 `json:"SysMemLocation"`
-	SysMemPrimaryStatus                OptString                                                 `json:"SysMemPrimaryStatus"`
-	SystemGeneration                   OptString                                                 `json:"SystemGeneration"`
-	SystemID                           OptInt                                                    `json:"SystemID"`
-	SystemRevision                     OptString                                                 `json:"SystemRevision"`
-	TempRollupStatus                   OptString                                                 `json:"TempRollupStatus"`
-	TempStatisticsRollupStatus         OptString                                                 `json:"TempStatisticsRollupStatus"`
-	UUID                               OptString                                                 `json:"UUID"`
-	VoltRollupStatus                   OptString                                                 `json:"VoltRollupStatus"`
-	SmbiosGUID                         OptString                                                 `json:"smbiosGUID"`
+// SetPopulatedPCIeSlots sets the value of PopulatedPCIeSlots.
+func (s *RedfishSystemOemDell) SetPopulatedPCIeSlots(val OptInt) {
+	s.PopulatedPCIeSlots = val
 }
 
-// GetMessageDotExtendedInfo returns the value of MessageDotExtendedInfo.
-func (s *RedfishSystemOemDell) GetMessageDotExtendedInfo() OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray {
-	return s.MessageDotExtendedInfo
+// SetPowerCapEnabledState sets the value of PowerCapEnabledState.
+func (s *RedfishSystemOemDell) SetPowerCapEnabledState(val OptString) {
+	s.PowerCapEnabledState = val
 }
 
-// GetOdataDotContext returns the value of OdataDotContext.
-func (s *RedfishSystemOemDell) GetOdataDotContext() OptString {
-	return s.OdataDotContext
+// SetSDCardRollupStatus sets the value of SDCardRollupStatus.
+func (s *RedfishSystemOemDell) SetSDCardRollupStatus(val OptString) {
+	s.SDCardRollupStatus = val
 }
 
-// GetOdataDotEtag returns the value of OdataDotEtag.
-func (s *RedfishSystemOemDell) GetOdataDotEtag() OptNilString {
-	return s.OdataDotEtag
+// SetSELRollupStatus sets the value of SELRollupStatus.
+func (s *RedfishSystemOemDell) SetSELRollupStatus(val OptString) {
+	s.SELRollupStatus = val
 }
 
-// GetOdataDotID returns the value of OdataDotID.
-func (s *RedfishSystemOemDell) GetOdataDotID() OptString {
-	return s.OdataDotID
+// SetServerAllocationWatts sets the value of ServerAllocationWatts.
+func (s *RedfishSystemOemDell) SetServerAllocationWatts(val OptInt) {
+	s.ServerAllocationWatts = val
 }
 
-// GetOdataDotType returns the value of OdataDotType.
-func (s *RedfishSystemOemDell) GetOdataDotType() OptString {
-	return s.OdataDotType
+// SetStorageRollupStatus sets the value of StorageRollupStatus.
+func (s *RedfishSystemOemDell) SetStorageRollupStatus(val OptString) {
+	s.StorageRollupStatus = val
 }
 
-// GetBIOSReleaseDate returns the value of BIOSReleaseDate.
-func (s *RedfishSystemOemDell) GetBIOSReleaseDate() OptString {
-	return s.BIOSReleaseDate
+// SetSysMemErrorMethodology sets the value of SysMemErrorMethodology.
+func (s *RedfishSystemOemDell) SetSysMemErrorMethodology(val OptString) {
+	s.SysMemErrorMethodology = val
 }
 
-// GetBaseBoardChassisSlot returns the value of BaseBoardChassisSlot.
-func (s *RedfishSystemOemDell) GetBaseBoardChassisSlot() OptString {
-	return s.BaseBoardChassisSlot
+// SetSysMemFailOverState sets the value of SysMemFailOverState.
+func (s *RedfishSystemOemDell) SetSysMemFailOverState(val OptString) {
+	s.SysMemFailOverState = val
 }
 
-// GetBatteryRollupStatus returns the value of BatteryRollupStatus.
-func (s *RedfishSystemOemDell) GetBatteryRollupStatus() OptString {
-	return s.BatteryRollupStatus
+// SetSysMemLocation sets the value of SysMemLocation.
+func (s *RedfishSystemOemDell) SetSysMemLocation(val OptString) {
+	s.SysMemLocation = val
 }
 
-// GetBladeGeometry returns the value of BladeGeometry.
-func (s *RedfishSystemOemDell) GetBladeGeometry() OptString {
-	return s.BladeGeometry
+// SetSysMemPrimaryStatus sets the value of SysMemPrimaryStatus.
+func (s *RedfishSystemOemDell) SetSysMemPrimaryStatus(val OptString) {
+	s.SysMemPrimaryStatus = val
 }
 
-// GetCMCIP returns the value of CMCIP.
-func (s *RedfishSystemOemDell) GetCMCIP() OptString {
-	return s.CMCIP
+// SetSystemGeneration sets the value of SystemGeneration.
+func (s *RedfishSystemOemDell) SetSystemGeneration(val OptString) {
+	s.SystemGeneration = val
 }
 
-// GetCPURollupStatus returns the value of CPURollupStatus.
-func (s *RedfishSystemOemDell) GetCPURollupStatus() OptString {
-	return s.CPURollupStatus
+// SetSystemID sets the value of SystemID.
+func (s *RedfishSystemOemDell) SetSystemID(val OptInt) {
+	s.SystemID = val
 }
 
-// GetChassisModel returns the value of ChassisModel.
-func (s *RedfishSystemOemDell) GetChassisModel() OptString {
-	return s.ChassisModel
+// SetSystemRevision sets the value of SystemRevision.
+func (s *RedfishSystemOemDell) SetSystemRevision(val OptString) {
+	s.SystemRevision = val
 }
 
-// GetChassisName returns the value of ChassisName.
-func (s *RedfishSystemOemDell) GetChassisName() OptString {
-	return s.ChassisName
+// SetTempRollupStatus sets the value of TempRollupStatus.
+func (s *RedfishSystemOemDell) SetTempRollupStatus(val OptString) {
+	s.TempRollupStatus = val
 }
 
-// GetChassisServiceTag returns the value of ChassisServiceTag.
-func (s *RedfishSystemOemDell) GetChassisServiceTag() OptString {
-	return s.ChassisServiceTag
+// SetTempStatisticsRollupStatus sets the value of TempStatisticsRollupStatus.
+func (s *RedfishSystemOemDell) SetTempStatisticsRollupStatus(val OptString) {
+	s.TempStatisticsRollupStatus = val
 }
 
-// GetChassisSystemHeightUnit returns the value of ChassisSystemHeightUnit.
-func (s *RedfishSystemOemDell) GetChassisSystemHeightUnit() OptInt {
-	return s.ChassisSystemHeightUnit
+// SetUUID sets the value of UUID.
+func (s *RedfishSystemOemDell) SetUUID(val OptString) {
+	s.UUID = val
 }
 
-// GetCurrentRollupStatus returns the value of CurrentRollupStatus.
-func (s *RedfishSystemOemDell) GetCurrentRollupStatus() OptString {
-	return s.CurrentRollupStatus
+// SetVoltRollupStatus sets the value of VoltRollupStatus.
+func (s *RedfishSystemOemDell) SetVoltRollupStatus(val OptString) {
+	s.VoltRollupStatus = val
 }
 
-// GetDescription returns the value of Description.
-func (s *RedfishSystemOemDell) GetDescription() OptString {
-	return s.Description
+// SetSmbiosGUID sets the value of SmbiosGUID.
+func (s *RedfishSystemOemDell) SetSmbiosGUID(val OptString) {
+	s.SmbiosGUID = val
 }
 
-// GetEstimatedExhaustTemperatureCelsius returns the value of EstimatedExhaustTemperatureCelsius.
-func (s *RedfishSystemOemDell) GetEstimatedExhaustTemperatureCelsius() OptInt {
-	return s.EstimatedExhaustTemperatureCelsius
+type RedfishSystemOemDellMessageDotExtendedInfoItem struct {
+	Message                  OptString                                                           `json:"Message"`
+	MessageArgs              []string                                                            `json:"MessageArgs"`
+	MessageId                OptString                                                           `json:"MessageId"`
+	MessageSeverity          OptString                                                           `json:"MessageSeverity"`
+	Oem                      jx.Raw                                                              `json:"Oem"`
+	RelatedProperties        OptNilNilStringArray                                                `json:"RelatedProperties"`
+	Resolution               OptString                                                           `json:"Resolution"`
+	ResolutionSteps          []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
+	Severity                 OptString                                                           `json:"Severity"`
+	UserAuthenticationSource OptString                                                           `json:"UserAuthenticationSource"`
+	Username                 OptString                                                           `json:"Username"`
 }
 
-// GetEstimatedSystemAirflowCFM returns the value of EstimatedSystemAirflowCFM.
-func (s *RedfishSystemOemDell) GetEstimatedSystemAirflowCFM() OptInt {
-	return s.EstimatedSystemAirflowCFM
+// GetMessage returns the value of Message.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessage() OptString {
+	return s.Message
 }
 
-// GetExpressServiceCode returns the value of ExpressServiceCode.
-func (s *RedfishSystemOemDell) GetExpressServiceCode() OptString {
-	return s.ExpressServiceCode
+// GetMessageArgs returns the value of MessageArgs.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageArgs() []string {
+	return s.MessageArgs
 }
 
-// GetFanRollupStatus returns the value of FanRollupStatus.
-func (s *RedfishSystemOemDell) GetFanRollupStatus() OptString {
-	return s.FanRollupStatus
+// GetMessageId returns the value of MessageId.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageId() OptString {
+	return s.MessageId
 }
 
-// GetIDSDMRollupStatus returns the value of IDSDMRollupStatus.
-func (s *RedfishSystemOemDell) GetIDSDMRollupStatus() OptString {
-	return s.IDSDMRollupStatus
+// GetMessageSeverity returns the value of MessageSeverity.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
+	return s.MessageSeverity
 }
 
-// GetID returns the value of ID.
-func (s *RedfishSystemOemDell) GetID() OptString {
-	return s.ID
+// GetOem returns the value of Oem.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetOem() jx.Raw {
+	return s.Oem
 }
 
-// GetIntrusionRollupStatus returns the value of IntrusionRollupStatus.
-func (s *RedfishSystemOemDell) GetIntrusionRollupStatus() OptString {
-	return s.IntrusionRollupStatus
+// GetRelatedProperties returns the value of RelatedProperties.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
+	return s.RelatedProperties
 }
 
-// GetIsOEMBranded returns the value of IsOEMBranded.
-func (s *RedfishSystemOemDell) GetIsOEMBranded() OptString {
-	return s.IsOEMBranded
+// GetResolution returns the value of Resolution.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetResolution() OptString {
+	return s.Resolution
 }
 
-// GetLastSystemInventoryTime returns the value of LastSystemInventoryTime.
-func (s *RedfishSystemOemDell) GetLastSystemInventoryTime() OptString {
-	return s.LastSystemInventoryTime
+// GetResolutionSteps returns the value of ResolutionSteps.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem {
+	return s.ResolutionSteps
 }
 
-// GetLastUpdateTime returns the value of LastUpdateTime.
-func (s *RedfishSystemOemDell) GetLastUpdateTime() OptString {
-	return s.LastUpdateTime
+// GetSeverity returns the value of Severity.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetSeverity() OptString {
+	return s.Severity
 }
 
-// GetLicensingRollupStatus returns the value of LicensingRollupStatus.
-func (s *RedfishSystemOemDell) GetLicensingRollupStatus() OptString {
-	return s.LicensingRollupStatus
+// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
+	return s.UserAuthenticationSource
 }
 
-// GetManagedSystemSize returns the value of ManagedSystemSize.
-func (s *RedfishSystemOemDell) GetManagedSystemSize() OptString {
-	return s.ManagedSystemSize
+// GetUsername returns the value of Username.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetUsername() OptString {
+	return s.Username
 }
 
-// GetMaxCPUSockets returns the value of MaxCPUSockets.
-func (s *RedfishSystemOemDell) GetMaxCPUSockets() OptInt {
-	return s.MaxCPUSockets
+// SetMessage sets the value of Message.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessage(val OptString) {
+	s.Message = val
 }
 
-// GetMaxDIMMSlots returns the value of MaxDIMMSlots.
-func (s *RedfishSystemOemDell) GetMaxDIMMSlots() OptInt {
-	return s.MaxDIMMSlots
+// SetMessageArgs sets the value of MessageArgs.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
+	s.MessageArgs = val
 }
 
-// GetMaxPCIeSlots returns the value of MaxPCIeSlots.
-func (s *RedfishSystemOemDell) GetMaxPCIeSlots() OptInt {
-	return s.MaxPCIeSlots
+// SetMessageId sets the value of MessageId.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageId(val OptString) {
+	s.MessageId = val
 }
 
-// GetMemoryOperationMode returns the value of MemoryOperationMode.
-func (s *RedfishSystemOemDell) GetMemoryOperationMode() OptString {
-	return s.MemoryOperationMode
+// SetMessageSeverity sets the value of MessageSeverity.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
+	s.MessageSeverity = val
 }
 
-// GetName returns the value of Name.
-func (s *RedfishSystemOemDell) GetName() OptString {
-	return s.Name
+// SetOem sets the value of Oem.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// GetNodeID returns the value of NodeID.
-func (s *RedfishSystemOemDell) GetNodeID() OptString {
-	return s.NodeID
+// SetRelatedProperties sets the value of RelatedProperties.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
+	s.RelatedProperties = val
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishSystemOemDell) GetOem() jx.Raw {
-	return s.Oem
+// SetResolution sets the value of Resolution.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetResolution(val OptString) {
+	s.Resolution = val
 }
 
-// GetPSRollupStatus returns the value of PSRollupStatus.
-func (s *RedfishSystemOemDell) GetPSRollupStatus() OptString {
-	return s.PSRollupStatus
+// SetResolutionSteps sets the value of ResolutionSteps.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) {
+	s.ResolutionSteps = val
 }
 
-// GetPlatformGUID returns the value of PlatformGUID.
-func (s *RedfishSystemOemDell) GetPlatformGUID() OptString {
-	return s.PlatformGUID
+// SetSeverity sets the value of Severity.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetSeverity(val OptString) {
+	s.Severity = val
 }
 
-// GetPopulatedDIMMSlots returns the value of PopulatedDIMMSlots.
-func (s *RedfishSystemOemDell) GetPopulatedDIMMSlots() OptInt {
-	return s.PopulatedDIMMSlots
+// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
+	s.UserAuthenticationSource = val
 }
 
-// GetPopulatedPCIeSlots returns the value of PopulatedPCIeSlots.
-func (s *RedfishSystemOemDell) GetPopulatedPCIeSlots() OptInt {
-	return s.PopulatedPCIeSlots
+// SetUsername sets the value of Username.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetUsername(val OptString) {
+	s.Username = val
 }
 
-// GetPowerCapEnabledState returns the value of PowerCapEnabledState.
-func (s *RedfishSystemOemDell) GetPowerCapEnabledState() OptString {
-	return s.PowerCapEnabledState
+type RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem struct {
+	ActionParameters     []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
+	ActionURI            OptString                                                                               `json:"ActionURI"`
+	Oem                  jx.Raw                                                                                  `json:"Oem"`
+	Priority             OptNilInt                                                                               `json:"Priority"`
+	ResolutionType       OptString                                                                               `json:"ResolutionType"`
+	RetryCount           OptNilInt                                                                               `json:"RetryCount"`
+	RetryIntervalSeconds OptNilInt                                                                               `json:"RetryIntervalSeconds"`
+	TargetComponentURI   OptString                                                                               `json:"TargetComponentURI"`
 }
 
-// GetSDCardRollupStatus returns the value of SDCardRollupStatus.
-func (s *RedfishSystemOemDell) GetSDCardRollupStatus() OptString {
-	return s.SDCardRollupStatus
+// GetActionParameters returns the value of ActionParameters.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
+	return s.ActionParameters
 }
 
-// GetSELRollupStatus returns the value of SELRollupStatus.
-func (s *RedfishSystemOemDell) GetSELRollupStatus() OptString {
-	return s.SELRollupStatus
+// GetActionURI returns the value of ActionURI.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
+	return s.ActionURI
 }
 
-// GetServerAllocationWatts returns the value of ServerAllocationWatts.
-func (s *RedfishSystemOemDell) GetServerAllocationWatts() OptInt {
-	return s.ServerAllocationWatts
+// GetOem returns the value of Oem.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
+	return s.Oem
 }
 
-// GetStorageRollupStatus returns the value of StorageRollupStatus.
-func (s *RedfishSystemOemDell) GetStorageRollupStatus() OptString {
-	return s.StorageRollupStatus
+// GetPriority returns the value of Priority.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
+	return s.Priority
 }
 
-// GetSysMemErrorMethodology returns the value of SysMemErrorMethodology.
-func (s *RedfishSystemOemDell) GetSysMemErrorMethodology() OptString {
-	return s.SysMemErrorMethodology
+// GetResolutionType returns the value of ResolutionType.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
+	return s.ResolutionType
 }
 
-// GetSysMemFailOverState returns the value of SysMemFailOverState.
-func (s *RedfishSystemOemDell) GetSysMemFailOverState() OptString {
-	return s.SysMemFailOverState
+// GetRetryCount returns the value of RetryCount.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
+	return s.RetryCount
 }
 
-// GetSysMemLocation returns the value of SysMemLocation.
-func (s *RedfishSystemOemDell) GetSysMemLocation() OptString {
-	return s.SysMemLocation
+// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
+	return s.RetryIntervalSeconds
 }
 
-// GetSysMemPrimaryStatus returns the value of SysMemPrimaryStatus.
-func (s *RedfishSystemOemDell) GetSysMemPrimaryStatus() OptString {
-	return s.SysMemPrimaryStatus
+// GetTargetComponentURI returns the value of TargetComponentURI.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
+	return s.TargetComponentURI
 }
 
-// GetSystemGeneration returns the value of SystemGeneration.
-func (s *RedfishSystemOemDell) GetSystemGeneration() OptString {
-	return s.SystemGeneration
+// SetActionParameters sets the value of ActionParameters.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
+	s.ActionParameters = val
 }
 
-// GetSystemID returns the value of SystemID.
-func (s *RedfishSystemOemDell) GetSystemID() OptInt {
-	return s.SystemID
+// SetActionURI sets the value of ActionURI.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
+	s.ActionURI = val
 }
 
-// GetSystemRevision returns the value of SystemRevision.
-func (s *RedfishSystemOemDell) GetSystemRevision() OptString {
-	return s.SystemRevision
+// SetOem sets the value of Oem.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
+	s.Oem = val
 }
 
-// GetTempRollupStatus returns the value of TempRollupStatus.
-func (s *RedfishSystemOemDell) GetTempRollupStatus() OptString {
-	return s.TempRollupStatus
+// SetPriority sets the value of Priority.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
+	s.Priority = val
 }
 
-// GetTempStatisticsRollupStatus returns the value of TempStatisticsRollupStatus.
-func (s *RedfishSystemOemDell) GetTempStatisticsRollupStatus() OptString {
-	return s.TempStatisticsRollupStatus
+// SetResolutionType sets the value of ResolutionType.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
+	s.ResolutionType = val
 }
 
-// GetUUID returns the value of UUID.
-func (s *RedfishSystemOemDell) GetUUID() OptString {
-	return s.UUID
+// SetRetryCount sets the value of RetryCount.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
+	s.RetryCount = val
 }
 
-// GetVoltRollupStatus returns the value of VoltRollupStatus.
-func (s *RedfishSystemOemDell) GetVoltRollupStatus() OptString {
-	return s.VoltRollupStatus
+// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
+	s.RetryIntervalSeconds = val
 }
 
-// GetSmbiosGUID returns the value of SmbiosGUID.
-func (s *RedfishSystemOemDell) GetSmbiosGUID() OptString {
-	return s.SmbiosGUID
+// SetTargetComponentURI sets the value of TargetComponentURI.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
+	s.TargetComponentURI = val
 }
 
-// SetMessageDotExtendedInfo sets the value of MessageDotExtendedInfo.
-func (s *RedfishSystemOemDell) SetMessageDotExtendedInfo(val OptNilRedfishSystemOemDellMessageDotExtendedInfoItemArray) {
-	s.MessageDotExtendedInfo = val
+type RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
+	AllowableNumbers           []string      `json:"AllowableNumbers"`
+	AllowablePattern           OptString     `json:"AllowablePattern"`
+	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
+	AllowableValues            []string      `json:"AllowableValues"`
+	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
+	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
+	DataType                   OptString     `json:"DataType"`
+	DefaultValue               OptString     `json:"DefaultValue"`
+	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
+	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
+	Name                       OptString     `json:"Name"`
+	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
+	ObjectDataType             OptString     `json:"ObjectDataType"`
+	Required                   OptBool       `json:"Required"`
 }
 
-// SetOdataDotContext sets the value of OdataDotContext.
-func (s *RedfishSystemOemDell) SetOdataDotContext(val OptString) {
-	s.OdataDotContext = val
+// GetAllowableNumbers returns the value of AllowableNumbers.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
+	return s.AllowableNumbers
 }
 
-// SetOdataDotEtag sets the value of OdataDotEtag.
-func (s *RedfishSystemOemDell) SetOdataDotEtag(val OptNilString) {
-	s.OdataDotEtag = val
+// GetAllowablePattern returns the value of AllowablePattern.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
+	return s.AllowablePattern
 }
 
-// SetOdataDotID sets the value of OdataDotID.
-func (s *RedfishSystemOemDell) SetOdataDotID(val OptString) {
-	s.OdataDotID = val
+// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
+	return s.AllowableValueDescriptions
 }
 
-// SetOdataDotType sets the value of OdataDotType.
-func (s *RedfishSystemOemDell) SetOdataDotType(val OptString) {
-	s.OdataDotType = val
+// GetAllowableValues returns the value of AllowableValues.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
+	return s.AllowableValues
 }
 
-// SetBIOSReleaseDate sets the value of BIOSReleaseDate.
-func (s *RedfishSystemOemDell) SetBIOSReleaseDate(val OptString) {
-	s.BIOSReleaseDate = val
+// GetArraySizeMaximum returns the value of ArraySizeMaximum.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
+	return s.ArraySizeMaximum
 }
 
-// SetBaseBoardChassisSlot sets the value of BaseBoardChassisSlot.
-func (s *RedfishSystemOemDell) SetBaseBoardChassisSlot(val OptString) {
-	s.BaseBoardChassisSlot = val
+// GetArraySizeMinimum returns the value of ArraySizeMinimum.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
+	return s.ArraySizeMinimum
 }
 
-// SetBatteryRollupStatus sets the value of BatteryRollupStatus.
-func (s *RedfishSystemOemDell) SetBatteryRollupStatus(val OptString) {
-	s.BatteryRollupStatus = val
+// GetDataType returns the value of DataType.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
+	return s.DataType
 }
 
-// SetBladeGeometry sets the value of BladeGeometry.
-func (s *RedfishSystemOemDell) SetBladeGeometry(val OptString) {
-	s.BladeGeometry = val
+// GetDefaultValue returns the value of DefaultValue.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
+	return s.DefaultValue
 }
 
-// SetCMCIP sets the value of CMCIP.
-func (s *RedfishSystemOemDell) SetCMCIP(val OptString) {
-	s.CMCIP = val
+// GetMaximumValue returns the value of MaximumValue.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
+	return s.MaximumValue
 }
 
-// SetCPURollupStatus sets the value of CPURollupStatus.
-func (s *RedfishSystemOemDell) SetCPURollupStatus(val OptString) {
-	s.CPURollupStatus = val
+// GetMinimumValue returns the value of MinimumValue.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
+	return s.MinimumValue
 }
 
-// SetChassisModel sets the value of ChassisModel.
-func (s *RedfishSystemOemDell) SetChassisModel(val OptString) {
-	s.ChassisModel = val
+// GetName returns the value of Name.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
+	return s.Name
 }
 
-// SetChassisName sets the value of ChassisName.
-func (s *RedfishSystemOemDell) SetChassisName(val OptString) {
-	s.ChassisName = val
+// GetNoDefaultValue returns the value of NoDefaultValue.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
+	return s.NoDefaultValue
 }
 
-// SetChassisServiceTag sets the value of ChassisServiceTag.
-func (s *RedfishSystemOemDell) SetChassisServiceTag(val OptString) {
-	s.ChassisServiceTag = val
+// GetObjectDataType returns the value of ObjectDataType.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
+	return s.ObjectDataType
 }
 
-// SetChassisSystemHeightUnit sets the value of ChassisSystemHeightUnit.
-func (s *RedfishSystemOemDell) SetChassisSystemHeightUnit(val OptInt) {
-	s.ChassisSystemHeightUnit = val
+// GetRequired returns the value of Required.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
+	return s.Required
 }
 
-// SetCurrentRollupStatus sets the value of CurrentRollupStatus.
-func (s *RedfishSystemOemDell) SetCurrentRollupStatus(val OptString) {
-	s.CurrentRollupStatus = val
+// SetAllowableNumbers sets the value of AllowableNumbers.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
+	s.AllowableNumbers = val
 }
 
-// SetDescription sets the value of Description.
-func (s *RedfishSystemOemDell) SetDescription(val OptString) {
-	s.Description = val
+// SetAllowablePattern sets the value of AllowablePattern.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
+	s.AllowablePattern = val
 }
 
-// SetEstimatedExhaustTemperatureCelsius sets the value of EstimatedExhaustTemperatureCelsius.
-func (s *RedfishSystemOemDell) SetEstimatedExhaustTemperatureCelsius(val OptInt) {
-	s.EstimatedExhaustTemperatureCelsius = val
+// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
+	s.AllowableValueDescriptions = val
 }
 
-// SetEstimatedSystemAirflowCFM sets the value of EstimatedSystemAirflowCFM.
-func (s *RedfishSystemOemDell) SetEstimatedSystemAirflowCFM(val OptInt) {
-	s.EstimatedSystemAirflowCFM = val
+// SetAllowableValues sets the value of AllowableValues.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
+	s.AllowableValues = val
 }
 
-// SetExpressServiceCode sets the value of ExpressServiceCode.
-func (s *RedfishSystemOemDell) SetExpressServiceCode(val OptString) {
-	s.ExpressServiceCode = val
+// SetArraySizeMaximum sets the value of ArraySizeMaximum.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
+	s.ArraySizeMaximum = val
 }
 
-// SetFanRollupStatus sets the value of FanRollupStatus.
-func (s *RedfishSystemOemDell) SetFanRollupStatus(val OptString) {
-	s.FanRollupStatus = val
+// SetArraySizeMinimum sets the value of ArraySizeMinimum.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
+	s.ArraySizeMinimum = val
 }
 
-// SetIDSDMRollupStatus sets the value of IDSDMRollupStatus.
-func (s *RedfishSystemOemDell) SetIDSDMRollupStatus(val OptString) {
-	s.IDSDMRollupStatus = val
+// SetDataType sets the value of DataType.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
+	s.DataType = val
 }
 
-// SetID sets the value of ID.
-func (s *RedfishSystemOemDell) SetID(val OptString) {
-	s.ID = val
+// SetDefaultValue sets the value of DefaultValue.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
+	s.DefaultValue = val
 }
 
-// SetIntrusionRollupStatus sets the value of IntrusionRollupStatus.
-func (s *RedfishSystemOemDell) SetIntrusionRollupStatus(val OptString) {
-	s.IntrusionRollupStatus = val
+// SetMaximumValue sets the value of MaximumValue.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
+	s.MaximumValue = val
 }
 
-// SetIsOEMBranded sets the value of IsOEMBranded.
-func (s *RedfishSystemOemDell) SetIsOEMBranded(val OptString) {
-	s.IsOEMBranded = val
+// SetMinimumValue sets the value of MinimumValue.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
+	s.MinimumValue = val
 }
 
-// SetLastSystemInventoryTime sets the value of LastSystemInventoryTime.
-func (s *RedfishSystemOemDell) SetLastSystemInventoryTime(val OptString) {
-	s.LastSystemInventoryTime = val
+// SetName sets the value of Name.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
+	s.Name = val
 }
 
-// SetLastUpdateTime sets the value of LastUpdateTime.
-func (s *RedfishSystemOemDell) SetLastUpdateTime(val OptString) {
-	s.LastUpdateTime = val
+// SetNoDefaultValue sets the value of NoDefaultValue.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
+	s.NoDefaultValue = val
 }
 
-// SetLicensingRollupStatus sets the value of LicensingRollupStatus.
-func (s *RedfishSystemOemDell) SetLicensingRollupStatus(val OptString) {
-	s.LicensingRollupStatus = val
+// SetObjectDataType sets the value of ObjectDataType.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
+	s.ObjectDataType = val
 }
 
-// SetManagedSystemSize sets the value of ManagedSystemSize.
-func (s *RedfishSystemOemDell) SetManagedSystemSize(val OptString) {
-	s.ManagedSystemSize = val
+// SetRequired sets the value of Required.
+func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
+	s.Required = val
 }
 
-// SetMaxCPUSockets sets the value of MaxCPUSockets.
-func (s *RedfishSystemOemDell) SetMaxCPUSockets(val OptInt) {
-	s.MaxCPUSockets = val
+// Rollout schema.
+// Ref: #/components/schemas/Rollout
+type Rollout struct {
+	Canary      OptString          `json:"canary"`
+	Complete    OptInt             `json:"complete"`
+	Failed      OptInt             `json:"failed"`
+	FromVersion OptInt             `json:"from_version"`
+	ID          OptInt64           `json:"id"`
+	Image       OptString          `json:"image"`
+	Nodes       OptString          `json:"nodes"`
+	Pending     OptInt             `json:"pending"`
+	Percent     OptInt             `json:"percent"`
+	Previous    OptRolloutPrevious `json:"previous"`
+	State       OptString          `json:"state"`
+	SuccessRate OptFloat64         `json:"success_rate"`
+	Time        OptDateTime        `json:"time"`
+	Updated     OptDateTime        `json:"updated"`
+	Version     OptInt             `json:"version"`
 }
 
-// SetMaxDIMMSlots sets the value of MaxDIMMSlots.
-func (s *RedfishSystemOemDell) SetMaxDIMMSlots(val OptInt) {
-	s.MaxDIMMSlots = val
+// GetCanary returns the value of Canary.
+func (s *Rollout) GetCanary() OptString {
+	return s.Canary
 }
 
-// SetMaxPCIeSlots sets the value of MaxPCIeSlots.
-func (s *RedfishSystemOemDell) SetMaxPCIeSlots(val OptInt) {
-	s.MaxPCIeSlots = val
+// GetComplete returns the value of Complete.
+func (s *Rollout) GetComplete() OptInt {
+	return s.Complete
 }
 
-// SetMemoryOperationMode sets the value of MemoryOperationMode.
-func (s *RedfishSystemOemDell) SetMemoryOperationMode(val OptString) {
-	s.MemoryOperationMode = val
+// GetFailed returns the value of Failed.
+func (s *Rollout) GetFailed() OptInt {
+	return s.Failed
 }
 
-// SetName sets the value of Name.
-func (s *RedfishSystemOemDell) SetName(val OptString) {
-	s.Name = val
+// GetFromVersion returns the value of FromVersion.
+func (s *Rollout) GetFromVersion() OptInt {
+	return s.FromVersion
 }
 
-// SetNodeID sets the value of NodeID.
-func (s *RedfishSystemOemDell) SetNodeID(val OptString) {
-	s.NodeID = val
+// GetID returns the value of ID.
+func (s *Rollout) GetID() OptInt64 {
+	return s.ID
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishSystemOemDell) SetOem(val jx.Raw) {
-	s.Oem = val
+// GetImage returns the value of Image.
+func (s *Rollout) GetImage() OptString {
+	return s.Image
 }
 
-// SetPSRollupStatus sets the value of PSRollupStatus.
-func (s *RedfishSystemOemDell) SetPSRollupStatus(val OptString) {
-	s.PSRollupStatus = val
+// GetNodes returns the value of Nodes.
+func (s *Rollout) GetNodes() OptString {
+	return s.Nodes
 }
 
-// SetPlatformGUID sets the value of PlatformGUID.
-func (s *RedfishSystemOemDell) SetPlatformGUID(val OptString) {
-	s.PlatformGUID = val
+// GetPending returns the value of Pending.
+func (s *Rollout) GetPending() OptInt {
+	return s.Pending
 }
 
-// SetPopulatedDIMMSlots sets the value of PopulatedDIMMSlots.
-func (s *RedfishSystemOemDell) SetPopulatedDIMMSlots(val OptInt) {
-	s.PopulatedDIMMSlots = val
+// GetPercent returns the value of Percent.
+func (s *Rollout) GetPercent() OptInt {
+	return s.Percent
 }
 
-// SetPopulatedPCIeSlots sets the value of PopulatedPCIeSlots.
-func (s *RedfishSystemOemDell) SetPopulatedPCIeSlots(val OptInt) {
-	s.PopulatedPCIeSlots = val
+// GetPrevious returns the value of Previous.
+func (s *Rollout) GetPrevious() OptRolloutPrevious {
+	return s.Previous
 }
 
-// SetPowerCapEnabledState sets the value of PowerCapEnabledState.
-func (s *RedfishSystemOemDell) SetPowerCapEnabledState(val OptString) {
-	s.PowerCapEnabledState = val
+// GetState returns the value of State.
+func (s *Rollout) GetState() OptString {
+	return s.State
 }
 
-// SetSDCardRollupStatus sets the value of SDCardRollupStatus.
-func (s *RedfishSystemOemDell) SetSDCardRollupStatus(val OptString) {
-	s.SDCardRollupStatus = val
+// GetSuccessRate returns the value of SuccessRate.
+func (s *Rollout) GetSuccessRate() OptFloat64 {
+	return s.SuccessRate
 }
 
-// SetSELRollupStatus sets the value of SELRollupStatus.
-func (s *RedfishSystemOemDell) SetSELRollupStatus(val OptString) {
-	s.SELRollupStatus = val
+// GetTime returns the value of Time.
+func (s *Rollout) GetTime() OptDateTime {
+	return s.Time
 }
 
-// SetServerAllocationWatts sets the value of ServerAllocationWatts.
-func (s *RedfishSystemOemDell) SetServerAllocationWatts(val OptInt) {
-	s.ServerAllocationWatts = val
+// GetUpdated returns the value of Updated.
+func (s *Rollout) GetUpdated() OptDateTime {
+	return s.Updated
 }
 
-// SetStorageRollupStatus sets the value of StorageRollupStatus.
-func (s *RedfishSystemOemDell) SetStorageRollupStatus(val OptString) {
-	s.StorageRollupStatus = val
+// GetVersion returns the value of Version.
+func (s *Rollout) GetVersion() OptInt {
+	return s.Version
 }
 
-// SetSysMemErrorMethodology sets the value of SysMemErrorMethodology.
-func (s *RedfishSystemOemDell) SetSysMemErrorMethodology(val OptString) {
-	s.SysMemErrorMethodology = val
+// SetCanary sets the value of Canary.
+func (s *Rollout) SetCanary(val OptString) {
+	s.Canary = val
 }
 
-// SetSysMemFailOverState sets the value of SysMemFailOverState.
-func (s *RedfishSystemOemDell) SetSysMemFailOverState(val OptString) {
-	s.SysMemFailOverState = val
+// SetComplete sets the value of Complete.
+func (s *Rollout) SetComplete(val OptInt) {
+	s.Complete = val
 }
 
-// SetSysMemLocation sets the value of SysMemLocation.
-func (s *RedfishSystemOemDell) SetSysMemLocation(val OptString) {
-	s.SysMemLocation = val
+// SetFailed sets the value of Failed.
+func (s *Rollout) SetFailed(val OptInt) {
+	s.Failed = val
 }
 
-// SetSysMemPrimaryStatus sets the value of SysMemPrimaryStatus.
-func (s *RedfishSystemOemDell) SetSysMemPrimaryStatus(val OptString) {
-	s.SysMemPrimaryStatus = val
+// SetFromVersion sets the value of FromVersion.
+func (s *Rollout) SetFromVersion(val OptInt) {
+	s.FromVersion = val
 }
 
-// SetSystemGeneration sets the value of SystemGeneration.
-func (s *RedfishSystemOemDell) SetSystemGeneration(val OptString) {
-	s.SystemGeneration = val
+// SetID sets the value of ID.
+func (s *Rollout) SetID(val OptInt64) {
+	s.ID = val
 }
 
-// SetSystemID sets the value of SystemID.
-func (s *RedfishSystemOemDell) SetSystemID(val OptInt) {
-	s.SystemID = val
+// SetImage sets the value of Image.
+func (s *Rollout) SetImage(val OptString) {
+	s.Image = val
 }
 
-// SetSystemRevision sets the value of SystemRevision.
-func (s *RedfishSystemOemDell) SetSystemRevision(val OptString) {
-	s.SystemRevision = val
+// SetNodes sets the value of Nodes.
+func (s *Rollout) SetNodes(val OptString) {
+	s.Nodes = val
 }
 
-// SetTempRollupStatus sets the value of TempRollupStatus.
-func (s *RedfishSystemOemDell) SetTempRollupStatus(val OptString) {
-	s.TempRollupStatus = val
+// SetPending sets the value of Pending.
+func (s *Rollout) SetPending(val OptInt) {
+	s.Pending = val
 }
 
-// SetTempStatisticsRollupStatus sets the value of TempStatisticsRollupStatus.
-func (s *RedfishSystemOemDell) SetTempStatisticsRollupStatus(val OptString) {
-	s.TempStatisticsRollupStatus = val
+// SetPercent sets the value of Percent.
+func (s *Rollout) SetPercent(val OptInt) {
+	s.Percent = val
 }
 
-// SetUUID sets the value of UUID.
-func (s *RedfishSystemOemDell) SetUUID(val OptString) {
-	s.UUID = val
+// SetPrevious sets the value of Previous.
+func (s *Rollout) SetPrevious(val OptRolloutPrevious) {
+	s.Previous = val
 }
 
-// SetVoltRollupStatus sets the value of VoltRollupStatus.
-func (s *RedfishSystemOemDell) SetVoltRollupStatus(val OptString) {
-	s.VoltRollupStatus = val
+// SetState sets the value of State.
+func (s *Rollout) SetState(val OptString) {
+	s.State = val
 }
 
-// SetSmbiosGUID sets the value of SmbiosGUID.
-func (s *RedfishSystemOemDell) SetSmbiosGUID(val OptString) {
-	s.SmbiosGUID = val
+// SetSuccessRate sets the value of SuccessRate.
+func (s *Rollout) SetSuccessRate(val OptFloat64) {
+	s.SuccessRate = val
 }
 
-type RedfishSystemOemDellMessageDotExtendedInfoItem struct {
-	Message                  OptString                                                           `json:"Message"`
-	MessageArgs              []string                                                            `json:"MessageArgs"`
-	MessageId                OptString                                                           `json:"MessageId"`
-	MessageSeverity          OptString                                                           `json:"MessageSeverity"`
-	Oem                      jx.Raw                                                              `json:"Oem"`
-	RelatedProperties        OptNilNilStringArray                                                `json:"RelatedProperties"`
-	Resolution               OptString                                                           `json:"Resolution"`
-	ResolutionSteps          []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem `json:"ResolutionSteps"`
-	Severity                 OptString                                                           `json:"Severity"`
-	UserAuthenticationSource OptString                                                           `json:"UserAuthenticationSource"`
-	Username                 OptString                                                           `json:"Username"`
+// SetTime sets the value of Time.
+func (s *Rollout) SetTime(val OptDateTime) {
+	s.Time = val
 }
 
-// GetMessage returns the value of Message.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessage() OptString {
-	return s.Message
+// SetUpdated sets the value of Updated.
+func (s *Rollout) SetUpdated(val OptDateTime) {
+	s.Updated = val
 }
 
-// GetMessageArgs returns the value of MessageArgs.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageArgs() []string {
-	return s.MessageArgs
+// SetVersion sets the value of Version.
+func (s *Rollout) SetVersion(val OptInt) {
+	s.Version = val
 }
 
-// GetMessageId returns the value of MessageId.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageId() OptString {
-	return s.MessageId
-}
+type RolloutPrevious map[string]string
 
-// GetMessageSeverity returns the value of MessageSeverity.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetMessageSeverity() OptString {
-	return s.MessageSeverity
+func (s *RolloutPrevious) init() RolloutPrevious {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetOem() jx.Raw {
-	return s.Oem
+// RolloutStartRequest schema.
+// Ref: #/components/schemas/RolloutStartRequest
+type RolloutStartRequest struct {
+	Image     OptString `json:"image"`
+	Percent   OptInt    `json:"percent"`
+	Provision OptBool   `json:"provision"`
+	Version   OptInt    `json:"version"`
 }
 
-// GetRelatedProperties returns the value of RelatedProperties.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetRelatedProperties() OptNilNilStringArray {
-	return s.RelatedProperties
+// GetImage returns the value of Image.
+func (s *RolloutStartRequest) GetImage() OptString {
+	return s.Image
 }
 
-// GetResolution returns the value of Resolution.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetResolution() OptString {
-	return s.Resolution
+// GetPercent returns the value of Percent.
+func (s *RolloutStartRequest) GetPercent() OptInt {
+	return s.Percent
 }
 
-// GetResolutionSteps returns the value of ResolutionSteps.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetResolutionSteps() []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem {
-	return s.ResolutionSteps
+// GetProvision returns the value of Provision.
+func (s *RolloutStartRequest) GetProvision() OptBool {
+	return s.Provision
 }
 
-// GetSeverity returns the value of Severity.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetSeverity() OptString {
-	return s.Severity
+// GetVersion returns the value of Version.
+func (s *RolloutStartRequest) GetVersion() OptInt {
+	return s.Version
 }
 
-// GetUserAuthenticationSource returns the value of UserAuthenticationSource.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetUserAuthenticationSource() OptString {
-	return s.UserAuthenticationSource
+// SetImage sets the value of Image.
+func (s *RolloutStartRequest) SetImage(val OptString) {
+	s.Image = val
 }
 
-// GetUsername returns the value of Username.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) GetUsername() OptString {
-	return s.Username
+// SetPercent sets the value of Percent.
+func (s *RolloutStartRequest) SetPercent(val OptInt) {
+	s.Percent = val
 }
 
-// SetMessage sets the value of Message.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessage(val OptString) {
-	s.Message = val
+// SetProvision sets the value of Provision.
+func (s *RolloutStartRequest) SetProvision(val OptBool) {
+	s.Provision = val
 }
 
-// SetMessageArgs sets the value of MessageArgs.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageArgs(val []string) {
-	s.MessageArgs = val
+// SetVersion sets the value of Version.
+func (s *RolloutStartRequest) SetVersion(val OptInt) {
+	s.Version = val
 }
 
-// SetMessageId sets the value of MessageId.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageId(val OptString) {
-	s.MessageId = val
+// SwitchPortReport schema.
+// Ref: #/components/schemas/SwitchPortReport
+type SwitchPortReport struct {
+	DryRun OptBool                        `json:"dry_run"`
+	Failed []string                       `json:"failed"`
+	Ports  []NilSwitchPortReportPortsItem `json:"ports"`
 }
 
-// SetMessageSeverity sets the value of MessageSeverity.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetMessageSeverity(val OptString) {
-	s.MessageSeverity = val
+// GetDryRun returns the value of DryRun.
+func (s *SwitchPortReport) GetDryRun() OptBool {
+	return s.DryRun
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// GetFailed returns the value of Failed.
+func (s *SwitchPortReport) GetFailed() []string {
+	return s.Failed
 }
 
-// SetRelatedProperties sets the value of RelatedProperties.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetRelatedProperties(val OptNilNilStringArray) {
-	s.RelatedProperties = val
+// GetPorts returns the value of Ports.
+func (s *SwitchPortReport) GetPorts() []NilSwitchPortReportPortsItem {
+	return s.Ports
 }
 
-// SetResolution sets the value of Resolution.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetResolution(val OptString) {
-	s.Resolution = val
+// SetDryRun sets the value of DryRun.
+func (s *SwitchPortReport) SetDryRun(val OptBool) {
+	s.DryRun = val
 }
 
-// SetResolutionSteps sets the value of ResolutionSteps.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetResolutionSteps(val []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) {
-	s.ResolutionSteps = val
+// SetFailed sets the value of Failed.
+func (s *SwitchPortReport) SetFailed(val []string) {
+	s.Failed = val
 }
 
-// SetSeverity sets the value of Severity.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetSeverity(val OptString) {
-	s.Severity = val
+// SetPorts sets the value of Ports.
+func (s *SwitchPortReport) SetPorts(val []NilSwitchPortReportPortsItem) {
+	s.Ports = val
 }
 
-// SetUserAuthenticationSource sets the value of UserAuthenticationSource.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetUserAuthenticationSource(val OptString) {
-	s.UserAuthenticationSource = val
+type SwitchPortReportPortsItem struct {
+	Changes []string  `json:"changes"`
+	Error   OptString `json:"error"`
+	Host    OptString `json:"host"`
+	Ifname  OptString `json:"ifname"`
+	Port    OptString `json:"port"`
+	Status  OptString `json:"status"`
+	Switch  OptString `json:"switch"`
 }
 
-// SetUsername sets the value of Username.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItem) SetUsername(val OptString) {
-	s.Username = val
+// GetChanges returns the value of Changes.
+func (s *SwitchPortReportPortsItem) GetChanges() []string {
+	return s.Changes
 }
 
-type RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem struct {
-	ActionParameters     []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem `json:"ActionParameters"`
-	ActionURI            OptString                                                                               `json:"ActionURI"`
-	Oem                  jx.Raw                                                                                  `json:"Oem"`
-	Priority             OptNilInt                                                                               `json:"Priority"`
-	ResolutionType       OptString                                                                               `json:"ResolutionType"`
-	RetryCount           OptNilInt                                                                               `json:"RetryCount"`
-	RetryIntervalSeconds OptNilInt                                                                               `json:"RetryIntervalSeconds"`
-	TargetComponentURI   OptString                                                                               `json:"TargetComponentURI"`
+// GetError returns the value of Error.
+func (s *SwitchPortReportPortsItem) GetError() OptString {
+	return s.Error
 }
 
-// GetActionParameters returns the value of ActionParameters.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetActionParameters() []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem {
-	return s.ActionParameters
+// GetHost returns the value of Host.
+func (s *SwitchPortReportPortsItem) GetHost() OptString {
+	return s.Host
 }
 
-// GetActionURI returns the value of ActionURI.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetActionURI() OptString {
-	return s.ActionURI
+// GetIfname returns the value of Ifname.
+func (s *SwitchPortReportPortsItem) GetIfname() OptString {
+	return s.Ifname
 }
 
-// GetOem returns the value of Oem.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetOem() jx.Raw {
-	return s.Oem
+// GetPort returns the value of Port.
+func (s *SwitchPortReportPortsItem) GetPort() OptString {
+	return s.Port
 }
 
-// GetPriority returns the value of Priority.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetPriority() OptNilInt {
-	return s.Priority
+// GetStatus returns the value of Status.
+func (s *SwitchPortReportPortsItem) GetStatus() OptString {
+	return s.Status
 }
 
-// GetResolutionType returns the value of ResolutionType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetResolutionType() OptString {
-	return s.ResolutionType
+// GetSwitch returns the value of Switch.
+func (s *SwitchPortReportPortsItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// GetRetryCount returns the value of RetryCount.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetRetryCount() OptNilInt {
-	return s.RetryCount
+// SetChanges sets the value of Changes.
+func (s *SwitchPortReportPortsItem) SetChanges(val []string) {
+	s.Changes = val
 }
 
-// GetRetryIntervalSeconds returns the value of RetryIntervalSeconds.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetRetryIntervalSeconds() OptNilInt {
-	return s.RetryIntervalSeconds
+// SetError sets the value of Error.
+func (s *SwitchPortReportPortsItem) SetError(val OptString) {
+	s.Error = val
 }
 
-// GetTargetComponentURI returns the value of TargetComponentURI.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) GetTargetComponentURI() OptString {
-	return s.TargetComponentURI
+// SetHost sets the value of Host.
+func (s *SwitchPortReportPortsItem) SetHost(val OptString) {
+	s.Host = val
 }
 
-// SetActionParameters sets the value of ActionParameters.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetActionParameters(val []RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) {
-	s.ActionParameters = val
+// SetIfname sets the value of Ifname.
+func (s *SwitchPortReportPortsItem) SetIfname(val OptString) {
+	s.Ifname = val
 }
 
-// SetActionURI sets the value of ActionURI.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetActionURI(val OptString) {
-	s.ActionURI = val
+// SetPort sets the value of Port.
+func (s *SwitchPortReportPortsItem) SetPort(val OptString) {
+	s.Port = val
 }
 
-// SetOem sets the value of Oem.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetOem(val jx.Raw) {
-	s.Oem = val
+// SetStatus sets the value of Status.
+func (s *SwitchPortReportPortsItem) SetStatus(val OptString) {
+	s.Status = val
 }
 
-// SetPriority sets the value of Priority.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetPriority(val OptNilInt) {
-	s.Priority = val
+// SetSwitch sets the value of Switch.
+func (s *SwitchPortReportPortsItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// SetResolutionType sets the value of ResolutionType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetResolutionType(val OptString) {
-	s.ResolutionType = val
+// TopologyGraph schema.
+// Ref: #/components/schemas/TopologyGraph
+type TopologyGraph struct {
+	Links []NilTopologyGraphLinksItem `json:"links"`
+	Nodes []NilTopologyGraphNodesItem `json:"nodes"`
 }
 
-// SetRetryCount sets the value of RetryCount.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetRetryCount(val OptNilInt) {
-	s.RetryCount = val
+// GetLinks returns the value of Links.
+func (s *TopologyGraph) GetLinks() []NilTopologyGraphLinksItem {
+	return s.Links
 }
 
-// SetRetryIntervalSeconds sets the value of RetryIntervalSeconds.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetRetryIntervalSeconds(val OptNilInt) {
-	s.RetryIntervalSeconds = val
+// GetNodes returns the value of Nodes.
+func (s *TopologyGraph) GetNodes() []NilTopologyGraphNodesItem {
+	return s.Nodes
 }
 
-// SetTargetComponentURI sets the value of TargetComponentURI.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItem) SetTargetComponentURI(val OptString) {
-	s.TargetComponentURI = val
+// SetLinks sets the value of Links.
+func (s *TopologyGraph) SetLinks(val []NilTopologyGraphLinksItem) {
+	s.Links = val
 }
 
-type RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem struct {
-	AllowableNumbers           []string      `json:"AllowableNumbers"`
-	AllowablePattern           OptString     `json:"AllowablePattern"`
-	AllowableValueDescriptions []string      `json:"AllowableValueDescriptions"`
-	AllowableValues            []string      `json:"AllowableValues"`
-	ArraySizeMaximum           OptNilInt     `json:"ArraySizeMaximum"`
-	ArraySizeMinimum           OptNilInt     `json:"ArraySizeMinimum"`
-	DataType                   OptString     `json:"DataType"`
-	DefaultValue               OptString     `json:"DefaultValue"`
-	MaximumValue               OptNilFloat64 `json:"MaximumValue"`
-	MinimumValue               OptNilFloat64 `json:"MinimumValue"`
-	Name                       OptString     `json:"Name"`
-	NoDefaultValue             OptBool       `json:"NoDefaultValue"`
-	ObjectDataType             OptString     `json:"ObjectDataType"`
-	Required                   OptBool       `json:"Required"`
+// SetNodes sets the value of Nodes.
+func (s *TopologyGraph) SetNodes(val []NilTopologyGraphNodesItem) {
+	s.Nodes = val
 }
 
-// GetAllowableNumbers returns the value of AllowableNumbers.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableNumbers() []string {
-	return s.AllowableNumbers
+type TopologyGraphLinksItem struct {
+	ExpectedPort   OptString   `json:"expected_port"`
+	ExpectedSwitch OptString   `json:"expected_switch"`
+	Host           OptString   `json:"host"`
+	LastSeen       OptDateTime `json:"last_seen"`
+	MAC            OptString   `json:"mac"`
+	Moved          OptBool     `json:"moved"`
+	Neighbor       OptString   `json:"neighbor"`
+	NeighborPort   OptString   `json:"neighbor_port"`
+	Port           OptString   `json:"port"`
+	Switch         OptString   `json:"switch"`
+	Vlan           OptString   `json:"vlan"`
 }
 
-// GetAllowablePattern returns the value of AllowablePattern.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowablePattern() OptString {
-	return s.AllowablePattern
+// GetExpectedPort returns the value of ExpectedPort.
+func (s *TopologyGraphLinksItem) GetExpectedPort() OptString {
+	return s.ExpectedPort
 }
 
-// GetAllowableValueDescriptions returns the value of AllowableValueDescriptions.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValueDescriptions() []string {
-	return s.AllowableValueDescriptions
+// GetExpectedSwitch returns the value of ExpectedSwitch.
+func (s *TopologyGraphLinksItem) GetExpectedSwitch() OptString {
+	return s.ExpectedSwitch
 }
 
-// GetAllowableValues returns the value of AllowableValues.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetAllowableValues() []string {
-	return s.AllowableValues
+// GetHost returns the value of Host.
+func (s *TopologyGraphLinksItem) GetHost() OptString {
+	return s.Host
 }
 
-// GetArraySizeMaximum returns the value of ArraySizeMaximum.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMaximum() OptNilInt {
-	return s.ArraySizeMaximum
+// GetLastSeen returns the value of LastSeen.
+func (s *TopologyGraphLinksItem) GetLastSeen() OptDateTime {
+	return s.LastSeen
 }
 
-// GetArraySizeMinimum returns the value of ArraySizeMinimum.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetArraySizeMinimum() OptNilInt {
-	return s.ArraySizeMinimum
+// GetMAC returns the value of MAC.
+func (s *TopologyGraphLinksItem) GetMAC() OptString {
+	return s.MAC
 }
 
-// GetDataType returns the value of DataType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDataType() OptString {
-	return s.DataType
+// GetMoved returns the value of Moved.
+func (s *TopologyGraphLinksItem) GetMoved() OptBool {
+	return s.Moved
 }
 
-// GetDefaultValue returns the value of DefaultValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetDefaultValue() OptString {
-	return s.DefaultValue
+// GetNeighbor returns the value of Neighbor.
+func (s *TopologyGraphLinksItem) GetNeighbor() OptString {
+	return s.Neighbor
 }
 
-// GetMaximumValue returns the value of MaximumValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMaximumValue() OptNilFloat64 {
-	return s.MaximumValue
+// GetNeighborPort returns the value of NeighborPort.
+func (s *TopologyGraphLinksItem) GetNeighborPort() OptString {
+	return s.NeighborPort
 }
 
-// GetMinimumValue returns the value of MinimumValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetMinimumValue() OptNilFloat64 {
-	return s.MinimumValue
+// GetPort returns the value of Port.
+func (s *TopologyGraphLinksItem) GetPort() OptString {
+	return s.Port
 }
 
-// GetName returns the value of Name.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetName() OptString {
-	return s.Name
+// GetSwitch returns the value of Switch.
+func (s *TopologyGraphLinksItem) GetSwitch() OptString {
+	return s.Switch
 }
 
-// GetNoDefaultValue returns the value of NoDefaultValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetNoDefaultValue() OptBool {
-	return s.NoDefaultValue
+// GetVlan returns the value of Vlan.
+func (s *TopologyGraphLinksItem) GetVlan() OptString {
+	return s.Vlan
 }
 
-// GetObjectDataType returns the value of ObjectDataType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetObjectDataType() OptString {
-	return s.ObjectDataType
+// SetExpectedPort sets the value of ExpectedPort.
+func (s *TopologyGraphLinksItem) SetExpectedPort(val OptString) {
+	s.ExpectedPort = val
 }
 
-// GetRequired returns the value of Required.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) GetRequired() OptBool {
-	return s.Required
+// SetExpectedSwitch sets the value of ExpectedSwitch.
+func (s *TopologyGraphLinksItem) SetExpectedSwitch(val OptString) {
+	s.ExpectedSwitch = val
 }
 
-// SetAllowableNumbers sets the value of AllowableNumbers.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableNumbers(val []string) {
-	s.AllowableNumbers = val
+// SetHost sets the value of Host.
+func (s *TopologyGraphLinksItem) SetHost(val OptString) {
+	s.Host = val
 }
 
-// SetAllowablePattern sets the value of AllowablePattern.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowablePattern(val OptString) {
-	s.AllowablePattern = val
+// SetLastSeen sets the value of LastSeen.
+func (s *TopologyGraphLinksItem) SetLastSeen(val OptDateTime) {
+	s.LastSeen = val
 }
 
-// SetAllowableValueDescriptions sets the value of AllowableValueDescriptions.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValueDescriptions(val []string) {
-	s.AllowableValueDescriptions = val
+// SetMAC sets the value of MAC.
+func (s *TopologyGraphLinksItem) SetMAC(val OptString) {
+	s.MAC = val
 }
 
-// SetAllowableValues sets the value of AllowableValues.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetAllowableValues(val []string) {
-	s.AllowableValues = val
+// SetMoved sets the value of Moved.
+func (s *TopologyGraphLinksItem) SetMoved(val OptBool) {
+	s.Moved = val
 }
 
-// SetArraySizeMaximum sets the value of ArraySizeMaximum.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMaximum(val OptNilInt) {
-	s.ArraySizeMaximum = val
+// SetNeighbor sets the value of Neighbor.
+func (s *TopologyGraphLinksItem) SetNeighbor(val OptString) {
+	s.Neighbor = val
 }
 
-// SetArraySizeMinimum sets the value of ArraySizeMinimum.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetArraySizeMinimum(val OptNilInt) {
-	s.ArraySizeMinimum = val
+// SetNeighborPort sets the value of NeighborPort.
+func (s *TopologyGraphLinksItem) SetNeighborPort(val OptString) {
+	s.NeighborPort = val
 }
 
-// SetDataType sets the value of DataType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDataType(val OptString) {
-	s.DataType = val
+// SetPort sets the value of Port.
+func (s *TopologyGraphLinksItem) SetPort(val OptString) {
+	s.Port = val
 }
 
-// SetDefaultValue sets the value of DefaultValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetDefaultValue(val OptString) {
-	s.DefaultValue = val
+// SetSwitch sets the value of Switch.
+func (s *TopologyGraphLinksItem) SetSwitch(val OptString) {
+	s.Switch = val
 }
 
-// SetMaximumValue sets the value of MaximumValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMaximumValue(val OptNilFloat64) {
-	s.MaximumValue = val
+// SetVlan sets the value of Vlan.
+func (s *TopologyGraphLinksItem) SetVlan(val OptString) {
+	s.Vlan = val
 }
 
-// SetMinimumValue sets the value of MinimumValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetMinimumValue(val OptNilFloat64) {
-	s.MinimumValue = val
+type TopologyGraphNodesItem struct {
+	Kind OptString `json:"kind"`
+	Name OptString `json:"name"`
 }
 
-// SetName sets the value of Name.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetName(val OptString) {
-	s.Name = val
+// GetKind returns the value of Kind.
+func (s *TopologyGraphNodesItem) GetKind() OptString {
+	return s.Kind
 }
 
-// SetNoDefaultValue sets the value of NoDefaultValue.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetNoDefaultValue(val OptBool) {
-	s.NoDefaultValue = val
+// GetName returns the value of Name.
+func (s *TopologyGraphNodesItem) GetName() OptString {
+	return s.Name
 }
 
-// SetObjectDataType sets the value of ObjectDataType.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetObjectDataType(val OptString) {
-	s.ObjectDataType = val
+// SetKind sets the value of Kind.
+func (s *TopologyGraphNodesItem) SetKind(val OptString) {
+	s.Kind = val
 }
 
-// SetRequired sets the value of Required.
-func (s *RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem) SetRequired(val OptBool) {
-	s.Required = val
+// SetName sets the value of Name.
+func (s *TopologyGraphNodesItem) SetName(val OptString) {
+	s.Name = val
 }
 
 // User schema.
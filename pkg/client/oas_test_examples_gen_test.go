@@ -11,6 +11,18 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestArtifact_EncodeDecode(t *testing.T) {
+	var typ Artifact
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Artifact
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestAuthRequest_EncodeDecode(t *testing.T) {
 	var typ AuthRequest
 	typ.SetFake()
@@ -83,6 +95,42 @@ func TestAuthTokenRequest_EncodeDecode(t *testing.T) {
 	var typ2 AuthTokenRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestBmcCredential_EncodeDecode(t *testing.T) {
+	var typ BmcCredential
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcCredential
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBmcCredentialRequest_EncodeDecode(t *testing.T) {
+	var typ BmcCredentialRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcCredentialRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBmcCredentialRotateRequest_EncodeDecode(t *testing.T) {
+	var typ BmcCredentialRotateRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcCredentialRotateRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestBmcDellInstallFromRepoRequest_EncodeDecode(t *testing.T) {
 	var typ BmcDellInstallFromRepoRequest
 	typ.SetFake()
@@ -95,6 +143,44 @@ func TestBmcDellInstallFromRepoRequest_EncodeDecode(t *testing.T) {
 	var typ2 BmcDellInstallFromRepoRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestBmcFirmwareTasksRequest_EncodeDecode(t *testing.T) {
+	var typ BmcFirmwareTasksRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcFirmwareTasksRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBmcFirmwareTasksRequestTasks_EncodeDecode(t *testing.T) {
+	var typ BmcFirmwareTasksRequestTasks
+	typ = make(BmcFirmwareTasksRequestTasks)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcFirmwareTasksRequestTasks
+	typ2 = make(BmcFirmwareTasksRequestTasks)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBmcFirmwareUpdateRequest_EncodeDecode(t *testing.T) {
+	var typ BmcFirmwareUpdateRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcFirmwareUpdateRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestBmcImportConfigurationRequest_EncodeDecode(t *testing.T) {
 	var typ BmcImportConfigurationRequest
 	typ.SetFake()
@@ -133,6 +219,18 @@ func TestBmcJobDeleteRequestNodeJobList_EncodeDecode(t *testing.T) {
 	typ2 = make(BmcJobDeleteRequestNodeJobList)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestBmcMediaInsertRequest_EncodeDecode(t *testing.T) {
+	var typ BmcMediaInsertRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcMediaInsertRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestBmcOsPowerBody_EncodeDecode(t *testing.T) {
 	var typ BmcOsPowerBody
 	typ.SetFake()
@@ -145,6 +243,66 @@ func TestBmcOsPowerBody_EncodeDecode(t *testing.T) {
 	var typ2 BmcOsPowerBody
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestBmcTask_EncodeDecode(t *testing.T) {
+	var typ BmcTask
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcTask
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBmcTaskHostsItem_EncodeDecode(t *testing.T) {
+	var typ BmcTaskHostsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcTaskHostsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBmcTaskHostsItemRedfishError_EncodeDecode(t *testing.T) {
+	var typ BmcTaskHostsItemRedfishError
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcTaskHostsItemRedfishError
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBmcTaskHostsItemRedfishErrorError_EncodeDecode(t *testing.T) {
+	var typ BmcTaskHostsItemRedfishErrorError
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcTaskHostsItemRedfishErrorError
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem_EncodeDecode(t *testing.T) {
+	var typ BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BmcTaskHostsItemRedfishErrorErrorMessageDotExtendedInfoItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestBootImage_EncodeDecode(t *testing.T) {
 	var typ BootImage
 	typ.SetFake()
@@ -195,6 +353,18 @@ func TestBootImageAddRequestBootImagesItemProvisionTemplates_EncodeDecode(t *tes
 	typ2 = make(BootImageAddRequestBootImagesItemProvisionTemplates)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestBootImageCurrentRequest_EncodeDecode(t *testing.T) {
+	var typ BootImageCurrentRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BootImageCurrentRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestBootImageProvisionTemplates_EncodeDecode(t *testing.T) {
 	var typ BootImageProvisionTemplates
 	typ = make(BootImageProvisionTemplates)
@@ -209,6 +379,92 @@ func TestBootImageProvisionTemplates_EncodeDecode(t *testing.T) {
 	typ2 = make(BootImageProvisionTemplates)
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestBootImageVersion_EncodeDecode(t *testing.T) {
+	var typ BootImageVersion
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BootImageVersion
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBootImageVersionImage_EncodeDecode(t *testing.T) {
+	var typ BootImageVersionImage
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BootImageVersionImage
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestBootImageVersionImageProvisionTemplates_EncodeDecode(t *testing.T) {
+	var typ BootImageVersionImageProvisionTemplates
+	typ = make(BootImageVersionImageProvisionTemplates)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 BootImageVersionImageProvisionTemplates
+	typ2 = make(BootImageVersionImageProvisionTemplates)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestCablingReport_EncodeDecode(t *testing.T) {
+	var typ CablingReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 CablingReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestCablingReportLinksItem_EncodeDecode(t *testing.T) {
+	var typ CablingReportLinksItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 CablingReportLinksItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestDHCPEvent_EncodeDecode(t *testing.T) {
+	var typ DHCPEvent
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 DHCPEvent
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestDHCPLease_EncodeDecode(t *testing.T) {
+	var typ DHCPLease
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 DHCPLease
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestDataDump_EncodeDecode(t *testing.T) {
 	var typ DataDump
 	typ.SetFake()
@@ -415,6 +671,18 @@ func TestGetRolesResponseRolesItemUnassignedPermissionListItem_EncodeDecode(t *t
 	var typ2 GetRolesResponseRolesItemUnassignedPermissionListItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestGrendelStatus_EncodeDecode(t *testing.T) {
+	var typ GrendelStatus
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 GrendelStatus
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestHTTPError_EncodeDecode(t *testing.T) {
 	var typ HTTPError
 	typ.SetFake()
@@ -489,6 +757,30 @@ func TestHostInterfacesItem_EncodeDecode(t *testing.T) {
 	var typ2 HostInterfacesItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestInventoryChange_EncodeDecode(t *testing.T) {
+	var typ InventoryChange
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 InventoryChange
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestInventoryComponent_EncodeDecode(t *testing.T) {
+	var typ InventoryComponent
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 InventoryComponent
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestJobMessage_EncodeDecode(t *testing.T) {
 	var typ JobMessage
 	typ.SetFake()
@@ -693,6 +985,30 @@ func TestPostRolesRequest_EncodeDecode(t *testing.T) {
 	var typ2 PostRolesRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestProvisionEvent_EncodeDecode(t *testing.T) {
+	var typ ProvisionEvent
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ProvisionEvent
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestProvisionLog_EncodeDecode(t *testing.T) {
+	var typ ProvisionLog
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ProvisionLog
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestRedfishDellUpgradeFirmware_EncodeDecode(t *testing.T) {
 	var typ RedfishDellUpgradeFirmware
 	typ.SetFake()
@@ -717,6 +1033,18 @@ func TestRedfishDellUpgradeFirmwareUpdateListItem_EncodeDecode(t *testing.T) {
 	var typ2 RedfishDellUpgradeFirmwareUpdateListItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestRedfishFirmwareTask_EncodeDecode(t *testing.T) {
+	var typ RedfishFirmwareTask
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RedfishFirmwareTask
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestRedfishJob_EncodeDecode(t *testing.T) {
 	var typ RedfishJob
 	typ.SetFake()
@@ -983,6 +1311,104 @@ func TestRedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemAction
 	var typ2 RedfishSystemOemDellMessageDotExtendedInfoItemResolutionStepsItemActionParametersItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestRollout_EncodeDecode(t *testing.T) {
+	var typ Rollout
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Rollout
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRolloutPrevious_EncodeDecode(t *testing.T) {
+	var typ RolloutPrevious
+	typ = make(RolloutPrevious)
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RolloutPrevious
+	typ2 = make(RolloutPrevious)
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRolloutStartRequest_EncodeDecode(t *testing.T) {
+	var typ RolloutStartRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RolloutStartRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSwitchPortReport_EncodeDecode(t *testing.T) {
+	var typ SwitchPortReport
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SwitchPortReport
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestSwitchPortReportPortsItem_EncodeDecode(t *testing.T) {
+	var typ SwitchPortReportPortsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 SwitchPortReportPortsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTopologyGraph_EncodeDecode(t *testing.T) {
+	var typ TopologyGraph
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TopologyGraph
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTopologyGraphLinksItem_EncodeDecode(t *testing.T) {
+	var typ TopologyGraphLinksItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TopologyGraphLinksItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTopologyGraphNodesItem_EncodeDecode(t *testing.T) {
+	var typ TopologyGraphNodesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TopologyGraphNodesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestUser_EncodeDecode(t *testing.T) {
 	var typ User
 	typ.SetFake()
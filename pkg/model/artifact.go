@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+type ArtifactList []*Artifact
+
+// Artifact is a boot image file stored by Grendel under its SHA-256 checksum
+type Artifact struct {
+	SHA256 string    `json:"sha256"`
+	Size   int64     `json:"size"`
+	Path   string    `json:"path"`
+	Time   time.Time `json:"time"`
+}
@@ -64,6 +64,18 @@ type RedfishDellUpgradeFirmware struct {
 	UpdateList       dell.UpdateList //`oai3:"nullable"`
 }
 
+type RedfishFirmwareTaskList []RedfishFirmwareTask
+type RedfishFirmwareTask struct {
+	Name            string   `json:"name"`
+	Status          string   `json:"status"`
+	Message         string   `json:"message"`
+	TaskID          string   `json:"task_id"`
+	TaskState       string   `json:"task_state"`
+	TaskStatus      string   `json:"task_status"`
+	PercentComplete int      `json:"percent_complete"`
+	Messages        []string `json:"messages" oai3:"nullable"`
+}
+
 // TODO: verify correct json parsing
 type RedfishError struct {
 	Code  string `json:"code"`
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+// Scope of a BMC credential. Host credentials take precedence over tag
+// credentials, which take precedence over the global bmc.user and
+// bmc.password. Pending credentials hold a rotated password until the BMC
+// is known to use it and are never used to log in.
+const (
+	BmcCredentialHost    = "host"
+	BmcCredentialTag     = "tag"
+	BmcCredentialPending = "pending"
+)
+
+type BmcCredentialList []*BmcCredential
+
+// BmcCredential is the BMC user name and password of a single host or of all
+// hosts with a tag. The password is encrypted in the store and never
+// returned by the API.
+type BmcCredential struct {
+	Scope     string    `json:"scope"`
+	Name      string    `json:"name"`
+	Username  string    `json:"username"`
+	Password  string    `json:"password,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
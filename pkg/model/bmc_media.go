@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+// BmcMedia is the virtual media Grendel inserted on the BMC of a host, which
+// is ejected when the host completes provisioning
+type BmcMedia struct {
+	Host       string    `json:"host"`
+	Image      string    `json:"image"`
+	InsertedAt time.Time `json:"inserted_at"`
+}
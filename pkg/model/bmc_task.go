@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+// Status of a BMC task
+const (
+	BmcTaskQueued   = "queued"
+	BmcTaskRunning  = "running"
+	BmcTaskComplete = "complete"
+	BmcTaskCanceled = "canceled"
+	BmcTaskFailed   = "failed"
+)
+
+// Status of a host in a BMC task before its result is known. Finished hosts
+// have the status of their JobMessage, success or error, or canceled.
+const (
+	BmcTaskHostPending  = "pending"
+	BmcTaskHostCanceled = "canceled"
+)
+
+type BmcTaskList []*BmcTask
+
+// BmcTask is a BMC job run in the background. The status of each host is
+// updated as the job runs on it.
+type BmcTask struct {
+	ID        string         `json:"id"`
+	Operation string         `json:"operation"`
+	Status    string         `json:"status"`
+	User      string         `json:"user"`
+	Owner     string         `json:"owner"`
+	Error     string         `json:"error"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	Hosts     JobMessageList `json:"hosts"`
+}
+
+// Done returns true if the task is no longer queued or running
+func (t *BmcTask) Done() bool {
+	return t.Status != BmcTaskQueued && t.Status != BmcTaskRunning
+}
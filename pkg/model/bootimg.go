@@ -10,6 +10,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/segmentio/ksuid"
 )
@@ -20,14 +23,47 @@ type BootImage struct {
 	ID                 int64             `json:"id" oai3:"nullable"`
 	UID                ksuid.KSUID       `json:"uid" oai3:"typeStr,nullable"`
 	Name               string            `json:"name" validate:"required"`
+	Version            int               `json:"version"`
 	KernelPath         string            `json:"kernel" validate:"required"`
 	InitrdPaths        []string          `json:"initrd"`
 	LiveImage          string            `json:"liveimg"`
+	KernelSHA256       string            `json:"kernel_sha256"`
+	InitrdSHA256       []string          `json:"initrd_sha256"`
+	LiveImageSHA256    string            `json:"liveimg_sha256"`
 	CommandLine        string            `json:"cmdline"`
 	Verify             bool              `json:"verify"`
 	ProvisionTemplates map[string]string `json:"provision_templates" oai3:"nullable"`
 }
 
+type BootImageVersionList []*BootImageVersion
+
+// BootImageVersion is an immutable snapshot of a boot image. A new version is
+// recorded each time the kernel, initrds, command line or templates of an image
+// change.
+type BootImageVersion struct {
+	Version int        `json:"version"`
+	Current bool       `json:"current"`
+	Image   *BootImage `json:"image"`
+	Time    time.Time  `json:"time"`
+}
+
+// ParseBootImageName splits a boot image name of the form name@version, which
+// pins a host to a version of the image, into the image name and version. The
+// version is 0 if the name has none.
+func ParseBootImageName(s string) (string, int, error) {
+	name, version, found := strings.Cut(s, "@")
+	if !found {
+		return s, 0, nil
+	}
+
+	v, err := strconv.Atoi(version)
+	if err != nil || v < 1 {
+		return "", 0, fmt.Errorf("invalid boot image version: %s", s)
+	}
+
+	return name, v, nil
+}
+
 func NewBootImageList() BootImageList {
 	return make(BootImageList, 0)
 }
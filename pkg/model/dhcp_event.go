@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"net/netip"
+	"time"
+)
+
+type DHCPEventList []*DHCPEvent
+
+// DHCPEvent records a single DHCP message received from or sent to a client
+type DHCPEvent struct {
+	ID          int64      `json:"id"`
+	MessageType string     `json:"message_type"`
+	MAC         string     `json:"mac"`
+	Host        string     `json:"host"`
+	IP          netip.Addr `json:"ip" oai3:"typeStr"`
+	RelayIP     netip.Addr `json:"relay_ip" oai3:"typeStr"`
+	Firmware    string     `json:"firmware"`
+	Time        time.Time  `json:"time"`
+}
@@ -9,13 +9,26 @@ import "time"
 type EventList []Event
 
 type Event struct {
+	ID          int64
 	Severity    string
 	Time        time.Time
 	User        string
 	Message     string
+	Hosts       []string
 	JobMessages JobMessageList
 }
 
+// EventFilter selects audit events. Empty fields match any event.
+type EventFilter struct {
+	User     string
+	Severity string
+	Host     string
+	Since    time.Time
+	Until    time.Time
+	Limit    int
+	Offset   int
+}
+
 type Severity string
 
 const (
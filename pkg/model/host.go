@@ -31,6 +31,36 @@ type Host struct {
 	Tags       []string        `json:"tags" oai3:"nullable,typeStrArr"`
 }
 
+// NewDiscoveryHost returns a placeholder host for an unknown MAC address that
+// was handed a temporary address from a dynamic DHCP pool. Discovery hosts are
+// never stored and have no UID.
+func NewDiscoveryHost(mac net.HardwareAddr, ip netip.Prefix, bootImage string) *Host {
+	return &Host{
+		Name:      DiscoveryHostName(mac),
+		Provision: bootImage != "",
+		BootImage: bootImage,
+		Interfaces: []*NetInterface{
+			{
+				MAC: mac,
+				IP:  ip,
+			},
+		},
+		Bonds: []*Bond{},
+	}
+}
+
+// DiscoveryHostName returns the name of the placeholder host for an unknown
+// MAC address
+func DiscoveryHostName(mac net.HardwareAddr) string {
+	return "discovery-" + strings.ReplaceAll(mac.String(), ":", "")
+}
+
+// IsDiscovery returns true if the host is a placeholder for an unknown MAC
+// address
+func (h *Host) IsDiscovery() bool {
+	return h.UID.IsNil()
+}
+
 func (h *Host) Scan(value interface{}) error {
 	data, ok := value.(string)
 	if !ok {
@@ -143,6 +173,9 @@ func (h *Host) FromJSON(hostJSON string) {
 		nic.VLAN = i.Get("vlan").String()
 		nic.MTU = uint16(i.Get("mtu").Int())
 		nic.IP, _ = netip.ParsePrefix(i.Get("ip").String())
+		nic.IPv6, _ = netip.ParsePrefix(i.Get("ip6").String())
+		nic.Switch = i.Get("switch").String()
+		nic.SwitchPort = i.Get("switch_port").String()
 		nic.MAC, _ = net.ParseMAC(i.Get("mac").String())
 		h.Interfaces = append(h.Interfaces, nic)
 	}
@@ -158,6 +191,9 @@ func (h *Host) FromJSON(hostJSON string) {
 		bond.VLAN = i.Get("vlan").String()
 		bond.MTU = uint16(i.Get("mtu").Int())
 		bond.IP, _ = netip.ParsePrefix(i.Get("ip").String())
+		bond.IPv6, _ = netip.ParsePrefix(i.Get("ip6").String())
+		bond.Switch = i.Get("switch").String()
+		bond.SwitchPort = i.Get("switch_port").String()
 		bond.MAC, _ = net.ParseMAC(i.Get("mac").String())
 		for _, p := range i.Get("peers").Array() {
 			bond.Peers = append(bond.Peers, p.String())
@@ -188,13 +224,16 @@ func (h *Host) ToJSON() string {
 
 	for _, nic := range h.Interfaces {
 		n := map[string]interface{}{
-			"mac":    nic.MAC.String(),
-			"ip":     nic.CIDR(),
-			"ifname": nic.Name,
-			"fqdn":   nic.FQDN,
-			"bmc":    nic.BMC,
-			"vlan":   nic.VLAN,
-			"mtu":    nic.MTU,
+			"mac":         nic.MAC.String(),
+			"ip":          nic.CIDR(),
+			"ip6":         nic.CIDR6(),
+			"ifname":      nic.Name,
+			"fqdn":        nic.FQDN,
+			"bmc":         nic.BMC,
+			"vlan":        nic.VLAN,
+			"mtu":         nic.MTU,
+			"switch":      nic.Switch,
+			"switch_port": nic.SwitchPort,
 		}
 		if nic.ID != 0 {
 			n["id"] = nic.ID
@@ -204,14 +243,17 @@ func (h *Host) ToJSON() string {
 
 	for _, bond := range h.Bonds {
 		b := map[string]interface{}{
-			"peers":  bond.Peers,
-			"mac":    bond.MAC.String(),
-			"ip":     bond.CIDR(),
-			"ifname": bond.Name,
-			"fqdn":   bond.FQDN,
-			"bmc":    bond.BMC,
-			"vlan":   bond.VLAN,
-			"mtu":    bond.MTU,
+			"peers":       bond.Peers,
+			"mac":         bond.MAC.String(),
+			"ip":          bond.CIDR(),
+			"ip6":         bond.CIDR6(),
+			"ifname":      bond.Name,
+			"fqdn":        bond.FQDN,
+			"bmc":         bond.BMC,
+			"vlan":        bond.VLAN,
+			"mtu":         bond.MTU,
+			"switch":      bond.Switch,
+			"switch_port": bond.SwitchPort,
 		}
 		if bond.ID != 0 {
 			b["id"] = bond.ID
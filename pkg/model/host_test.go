@@ -6,6 +6,7 @@ package model_test
 
 import (
 	"encoding/json"
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -36,6 +37,30 @@ func TestHostBonds(t *testing.T) {
 	assert.Equal(host.Bonds[0].AddrString(), host.Bonds[0].IP.Addr().String())
 }
 
+func TestHostIPv6(t *testing.T) {
+	assert := assert.New(t)
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Interfaces[0].IPv6 = netip.MustParsePrefix("2001:db8::10/64")
+
+	testHost := &model.Host{}
+	testHost.FromJSON(host.ToJSON())
+	assert.Equal(host.Interfaces[0].IPv6, testHost.Interfaces[0].IPv6)
+	assert.Equal("2001:db8::10", testHost.Interfaces[0].Addr6String())
+
+	data, err := json.Marshal(host)
+	if assert.NoError(err) {
+		var jsonHost model.Host
+		err = json.Unmarshal(data, &jsonHost)
+		assert.NoError(err)
+		assert.Equal(host.Interfaces[0].IPv6, jsonHost.Interfaces[0].IPv6)
+	}
+
+	nic := &model.NetInterface{}
+	err = json.Unmarshal([]byte(`{"ip6": "10.1.1.1/24"}`), nic)
+	assert.Error(err)
+}
+
 func BenchmarkGJSONUnmarshall(b *testing.B) {
 	jsonStr := string(tests.TestHostJSON)
 	b.ResetTimer()
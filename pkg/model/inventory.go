@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Kinds of inventory components
+const (
+	InventoryCPU      = "cpu"
+	InventoryDIMM     = "dimm"
+	InventoryPCIe     = "pcie"
+	InventoryNIC      = "nic"
+	InventoryDrive    = "drive"
+	InventoryFirmware = "firmware"
+)
+
+// Inventory changes found between two collections
+const (
+	InventoryAdded    = "added"
+	InventoryRemoved  = "removed"
+	InventoryReplaced = "replaced"
+	InventoryUpdated  = "updated"
+)
+
+type InventoryComponentList []*InventoryComponent
+
+// InventoryComponent is a part of a host collected from its BMC. Slot
+// identifies the component within its kind on the host, e.g. the DIMM socket
+// or the firmware inventory id. Capacity is the size in bytes of DIMMs and
+// drives, Address the MAC address of NICs.
+type InventoryComponent struct {
+	Host         string    `json:"host"`
+	Kind         string    `json:"kind"`
+	Slot         string    `json:"slot"`
+	Name         string    `json:"name"`
+	Manufacturer string    `json:"manufacturer"`
+	Model        string    `json:"model"`
+	Serial       string    `json:"serial"`
+	PartNumber   string    `json:"part_number"`
+	Version      string    `json:"version"`
+	Capacity     int64     `json:"capacity"`
+	Address      string    `json:"address"`
+	CollectedAt  time.Time `json:"collected_at"`
+}
+
+// Key returns the kind and slot of the component
+func (c *InventoryComponent) Key() string {
+	return c.Kind + "/" + c.Slot
+}
+
+// Description returns the manufacturer, model, serial and address of the
+// component
+func (c *InventoryComponent) Description() string {
+	var parts []string
+	for _, p := range []string{c.Manufacturer, c.Model, c.Serial, c.Address} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+type InventoryChangeList []*InventoryChange
+
+// InventoryChange is a component added, removed, replaced or with a new
+// version since the previous inventory collection of the host
+type InventoryChange struct {
+	ID         int64     `json:"id"`
+	Host       string    `json:"host"`
+	Kind       string    `json:"kind"`
+	Slot       string    `json:"slot"`
+	Change     string    `json:"change"`
+	Old        string    `json:"old"`
+	New        string    `json:"new"`
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// DiffInventory returns the changes from the old to the new components of a
+// host. A component is replaced when its serial, model or address changes,
+// and updated when only its version changes.
+func DiffInventory(host string, old, new InventoryComponentList, now time.Time) InventoryChangeList {
+	prev := make(map[string]*InventoryComponent, len(old))
+	for _, c := range old {
+		prev[c.Key()] = c
+	}
+
+	changes := make(InventoryChangeList, 0)
+	change := func(c *InventoryComponent, kind, o, n string) {
+		changes = append(changes, &InventoryChange{
+			Host:       host,
+			Kind:       c.Kind,
+			Slot:       c.Slot,
+			Change:     kind,
+			Old:        o,
+			New:        n,
+			DetectedAt: now,
+		})
+	}
+
+	for _, c := range new {
+		p, ok := prev[c.Key()]
+		if !ok {
+			change(c, InventoryAdded, "", c.Description())
+			continue
+		}
+		delete(prev, c.Key())
+
+		switch {
+		case p.Serial != c.Serial || p.Model != c.Model || p.Manufacturer != c.Manufacturer ||
+			p.Capacity != c.Capacity || p.Address != c.Address:
+			change(c, InventoryReplaced, p.Description(), c.Description())
+		case p.Version != c.Version:
+			change(c, InventoryUpdated, p.Version, c.Version)
+		}
+	}
+
+	for _, c := range old {
+		if _, ok := prev[c.Key()]; ok {
+			change(c, InventoryRemoved, c.Description(), "")
+		}
+	}
+
+	return changes
+}
+
+// CompareVersions compares firmware versions, returning -1, 0 or 1. Versions
+// are split into runs of digits and letters, digits compare numerically, so
+// 2.10.0 is newer than 2.9.1.
+func CompareVersions(a, b string) int {
+	as := versionParts(a)
+	bs := versionParts(b)
+
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aerr := strconv.ParseUint(as[i], 10, 64)
+		bn, berr := strconv.ParseUint(bs[i], 10, 64)
+
+		var c int
+		if aerr == nil && berr == nil {
+			c = cmp.Compare(an, bn)
+		} else {
+			c = cmp.Compare(strings.ToLower(as[i]), strings.ToLower(bs[i]))
+		}
+		if c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(len(as), len(bs))
+}
+
+func versionParts(v string) []string {
+	var parts []string
+	start := -1
+	digit := false
+	for i, r := range v {
+		alnum := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if start >= 0 && (!alnum || unicode.IsDigit(r) != digit) {
+			parts = append(parts, v[start:i])
+			start = -1
+		}
+		if alnum && start < 0 {
+			start = i
+			digit = unicode.IsDigit(r)
+		}
+	}
+	if start >= 0 {
+		parts = append(parts, v[start:])
+	}
+
+	return parts
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/grendel/pkg/model"
+)
+
+func TestCompareVersions(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(-1, model.CompareVersions("2.9.1", "2.10.0"))
+	assert.Equal(1, model.CompareVersions("2.19.1", "2.2"))
+	assert.Equal(0, model.CompareVersions("1.4.2", "1.4.2"))
+	assert.Equal(-1, model.CompareVersions("1.4", "1.4.1"))
+	assert.Equal(-1, model.CompareVersions("A09", "A10"))
+	assert.Equal(1, model.CompareVersions("U46 v2.90", "U46 v2.10"))
+	assert.Equal(0, model.CompareVersions("7.00.00.171", "7.00.00.00171"))
+}
+
+func TestDiffInventory(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	old := model.InventoryComponentList{
+		{Kind: model.InventoryDIMM, Slot: "A1", Serial: "111"},
+		{Kind: model.InventoryDIMM, Slot: "A2", Serial: "222"},
+		{Kind: model.InventoryFirmware, Slot: "BIOS", Version: "2.9.1"},
+		{Kind: model.InventoryDrive, Slot: "Disk.0", Serial: "D0"},
+	}
+	new := model.InventoryComponentList{
+		{Kind: model.InventoryDIMM, Slot: "A1", Serial: "111"},
+		{Kind: model.InventoryDIMM, Slot: "A2", Serial: "333"},
+		{Kind: model.InventoryFirmware, Slot: "BIOS", Version: "2.10.0"},
+		{Kind: model.InventoryNIC, Slot: "NIC.1", Address: "00:11:22:33:44:55"},
+	}
+
+	changes := model.DiffInventory("cpn-01", old, new, now)
+	if assert.Len(changes, 4) {
+		assert.Equal(model.InventoryReplaced, changes[0].Change)
+		assert.Equal("A2", changes[0].Slot)
+		assert.Equal("222", changes[0].Old)
+		assert.Equal("333", changes[0].New)
+
+		assert.Equal(model.InventoryUpdated, changes[1].Change)
+		assert.Equal("2.9.1", changes[1].Old)
+		assert.Equal("2.10.0", changes[1].New)
+
+		assert.Equal(model.InventoryAdded, changes[2].Change)
+		assert.Equal("00:11:22:33:44:55", changes[2].New)
+
+		assert.Equal(model.InventoryRemoved, changes[3].Change)
+		assert.Equal("Disk.0", changes[3].Slot)
+		assert.Equal("cpn-01", changes[3].Host)
+	}
+
+	assert.Empty(model.DiffInventory("cpn-01", new, new, now))
+}
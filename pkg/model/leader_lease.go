@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+// LeaderLease is held by the Grendel instance that is currently the leader of
+// a group of instances sharing the same database. The holder renews the lease
+// before it expires, once expired any other instance may acquire it.
+type LeaderLease struct {
+	Name       string    `json:"name"`
+	Holder     string    `json:"holder"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// Expired returns true if the lease expired before the given time
+func (l *LeaderLease) Expired(now time.Time) bool {
+	return !l.ExpiresAt.After(now)
+}
+
+// HeldBy returns true if the lease is held by the given holder and has not
+// expired
+func (l *LeaderLease) HeldBy(holder string, now time.Time) bool {
+	return l.Holder == holder && !l.Expired(now)
+}
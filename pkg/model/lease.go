@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"net/netip"
+	"time"
+)
+
+type DHCPLeaseList []*DHCPLease
+
+// DHCPLease is a temporary address handed out from a dynamic subnet pool to a
+// MAC address that is not assigned to any host
+type DHCPLease struct {
+	ID        int64      `json:"id"`
+	MAC       string     `json:"mac"`
+	IP        netip.Addr `json:"ip" oai3:"typeStr"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// Expired returns true if the lease expired before the given time
+func (l *DHCPLease) Expired(now time.Time) bool {
+	return !l.ExpiresAt.After(now)
+}
@@ -37,10 +37,14 @@ type NetInterface struct {
 	MAC  net.HardwareAddr `json:"mac" oai3:"typeStr,formatNone"`
 	Name string           `json:"ifname"`
 	IP   netip.Prefix     `json:"ip" oai3:"typeStr"`
+	IPv6 netip.Prefix     `json:"ip6" oai3:"typeStr"`
 	FQDN string           `json:"fqdn"`
 	BMC  bool             `json:"bmc"`
 	VLAN string           `json:"vlan"`
 	MTU  uint16           `json:"mtu,omitempty"`
+	// Switch and SwitchPort are where the interface is intended to be cabled
+	Switch     string `json:"switch"`
+	SwitchPort string `json:"switch_port"`
 }
 
 // Return the string of a NicType
@@ -89,12 +93,14 @@ func (n *NetInterfaceList) Scan(value interface{}) error {
 func (n *NetInterface) MarshalJSON() ([]byte, error) {
 	type Alias NetInterface
 	return json.Marshal(&struct {
-		MAC string `json:"mac"`
-		IP  string `json:"ip"`
+		MAC  string `json:"mac"`
+		IP   string `json:"ip"`
+		IPv6 string `json:"ip6"`
 		*Alias
 	}{
 		MAC:   n.MAC.String(),
 		IP:    n.CIDR(),
+		IPv6:  n.CIDR6(),
 		Alias: (*Alias)(n),
 	})
 }
@@ -102,8 +108,9 @@ func (n *NetInterface) MarshalJSON() ([]byte, error) {
 func (n *NetInterface) UnmarshalJSON(data []byte) error {
 	type Alias NetInterface
 	aux := &struct {
-		MAC string `json:"mac"`
-		IP  string `json:"ip"`
+		MAC  string `json:"mac"`
+		IP   string `json:"ip"`
+		IPv6 string `json:"ip6"`
 		*Alias
 	}{
 		Alias: (*Alias)(n),
@@ -128,6 +135,19 @@ func (n *NetInterface) UnmarshalJSON(data []byte) error {
 
 		n.IP = ip
 	}
+
+	if aux.IPv6 != "" {
+		ip, err := netip.ParsePrefix(aux.IPv6)
+		if err != nil {
+			return fmt.Errorf("Invalid IPv6 address %s: %s", aux.IPv6, err)
+		}
+
+		if !ip.Addr().Is6() || ip.Addr().Is4In6() {
+			return fmt.Errorf("Invalid IPv6 address %s: not an IPv6 address", aux.IPv6)
+		}
+
+		n.IPv6 = ip
+	}
 	return nil
 }
 
@@ -155,6 +175,34 @@ func (n *NetInterface) Addr() netip.Addr {
 	return n.IP.Addr()
 }
 
+func (n *NetInterface) CIDR6() string {
+	if !n.IPv6.IsValid() {
+		return ""
+	}
+
+	return n.IPv6.String()
+}
+
+func (n *NetInterface) Addr6String() string {
+	if !n.IPv6.IsValid() {
+		return ""
+	}
+
+	return n.IPv6.Addr().String()
+}
+
+func (n *NetInterface) Addr6() netip.Addr {
+	return n.IPv6.Addr()
+}
+
+func (n *NetInterface) ToStdAddr6() net.IP {
+	if !n.IPv6.IsValid() {
+		return net.ParseIP("")
+	}
+
+	return net.IP(n.IPv6.Addr().AsSlice())
+}
+
 func (n *NetInterface) ToStdAddr() net.IP {
 	if !n.IP.IsValid() {
 		return net.ParseIP("")
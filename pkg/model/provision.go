@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+// Kinds of provision logs sent by hosts while they install
+const (
+	ProvisionLogProgress = "progress"
+	ProvisionLogFile     = "file"
+)
+
+type ProvisionEventList []*ProvisionEvent
+
+// ProvisionEvent records a host entering a provision state
+type ProvisionEvent struct {
+	ID      int64     `json:"id"`
+	Host    string    `json:"host"`
+	State   string    `json:"state"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+type ProvisionLogList []*ProvisionLog
+
+// ProvisionLog is a progress message or log file sent by a host while it
+// installs
+type ProvisionLog struct {
+	ID      int64     `json:"id"`
+	Host    string    `json:"host"`
+	Kind    string    `json:"kind"`
+	Name    string    `json:"name"`
+	Content string    `json:"content"`
+	Time    time.Time `json:"time"`
+}
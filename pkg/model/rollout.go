@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+// States of a boot image rollout
+const (
+	RolloutRunning    = "running"
+	RolloutPromoted   = "promoted"
+	RolloutRolledBack = "rolled_back"
+)
+
+type RolloutList []*Rollout
+
+// Rollout moves a canary subset of the hosts booting a boot image to a version
+// of the image. The canary hosts are pinned to the version until the rollout is
+// promoted, which makes the version current for all hosts following the image,
+// or rolled back, which restores the previous boot image of each canary host.
+type Rollout struct {
+	ID          int64     `json:"id"`
+	Image       string    `json:"image"`
+	Version     int       `json:"version"`
+	FromVersion int       `json:"from_version"`
+	Nodes       string    `json:"nodes"`
+	Canary      string    `json:"canary"`
+	Percent     int       `json:"percent"`
+	State       string    `json:"state"`
+	Time        time.Time `json:"time"`
+	Updated     time.Time `json:"updated"`
+
+	// Boot image of each canary host before the rollout
+	Previous map[string]string `json:"previous"`
+
+	// Provision states of the canary hosts, set when the rollout is listed
+	Complete    int     `json:"complete"`
+	Failed      int     `json:"failed"`
+	Pending     int     `json:"pending"`
+	SuccessRate float64 `json:"success_rate"`
+}
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+// GrendelStatus is the status of a Grendel instance. When high availability is
+// enabled only the leader is active and answers DHCP, all other services run
+// on every instance.
+type GrendelStatus struct {
+	Version        string    `json:"version"`
+	Hostname       string    `json:"hostname"`
+	HA             bool      `json:"ha"`
+	ID             string    `json:"id"`
+	Role           string    `json:"role"`
+	Active         bool      `json:"active"`
+	Leader         string    `json:"leader"`
+	LeaderSince    time.Time `json:"leader_since"`
+	LeaseExpiresAt time.Time `json:"lease_expires_at"`
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import "time"
+
+// Types of messages sent on the event stream
+const (
+	StreamTypeEvent     = "event"
+	StreamTypeJob       = "job"
+	StreamTypeProvision = "provision"
+)
+
+// Provision states of a host, recorded by the provision server and sent on
+// the event stream
+const (
+	ProvisionStateRequested  = "requested"
+	ProvisionStateBooting    = "booting"
+	ProvisionStateKernel     = "kernel"
+	ProvisionStateInstalling = "installing"
+	ProvisionStateComplete   = "complete"
+	ProvisionStateFailed     = "failed"
+)
+
+// StreamMessage is a message sent on the event stream. Exactly one of Event,
+// Job or State is set depending on the Type.
+type StreamMessage struct {
+	Type  string      `json:"type"`
+	Time  time.Time   `json:"time"`
+	Host  string      `json:"host,omitempty"`
+	JobID string      `json:"job_id,omitempty"`
+	Event *Event      `json:"event,omitempty"`
+	Job   *JobMessage `json:"job,omitempty"`
+	State string      `json:"state,omitempty"`
+}
@@ -2,7 +2,9 @@ package model
 
 import (
 	"encoding/json"
+	"fmt"
 	"net"
+	"strings"
 )
 
 type InterfaceStatus struct {
@@ -82,3 +84,78 @@ func (mt MACTable) String() string {
 	data, _ := json.MarshalIndent(mt, "", "    ")
 	return string(data)
 }
+
+// Admin states of switch ports
+const (
+	PortAdminUp   = "up"
+	PortAdminDown = "down"
+)
+
+// Outcomes of pushing the configuration of a switch port
+const (
+	PortUnchanged = "unchanged"
+	PortPending   = "pending"
+	PortChanged   = "changed"
+	PortFailed    = "failed"
+)
+
+// SwitchPortConfig is the configuration of a switch port. VLAN is the access
+// VLAN id. Empty fields are left unchanged when pushed to a switch.
+type SwitchPortConfig struct {
+	Description string `json:"description"`
+	VLAN        string `json:"vlan"`
+	MTU         int    `json:"mtu"`
+	AdminState  string `json:"admin_state"`
+}
+
+// Changes returns the fields of c that differ from the current configuration
+// of a port, and a description of each change
+func (c *SwitchPortConfig) Changes(current *SwitchPortConfig) (*SwitchPortConfig, []string) {
+	if current == nil {
+		current = &SwitchPortConfig{}
+	}
+
+	changed := &SwitchPortConfig{}
+	changes := make([]string, 0)
+	if c.Description != "" && c.Description != current.Description {
+		changed.Description = c.Description
+		changes = append(changes, fmt.Sprintf("description: %q -> %q", current.Description, c.Description))
+	}
+	if vlan := strings.TrimPrefix(strings.ToLower(c.VLAN), "vlan"); vlan != "" && vlan != strings.TrimPrefix(strings.ToLower(current.VLAN), "vlan") {
+		changed.VLAN = vlan
+		changes = append(changes, fmt.Sprintf("vlan: %s -> %s", current.VLAN, vlan))
+	}
+	if c.MTU != 0 && c.MTU != current.MTU {
+		changed.MTU = c.MTU
+		changes = append(changes, fmt.Sprintf("mtu: %d -> %d", current.MTU, c.MTU))
+	}
+	if c.AdminState != "" && c.AdminState != current.AdminState {
+		changed.AdminState = c.AdminState
+		changes = append(changes, fmt.Sprintf("admin state: %s -> %s", current.AdminState, c.AdminState))
+	}
+
+	return changed, changes
+}
+
+type SwitchPortChangeList []*SwitchPortChange
+
+// SwitchPortChange is the configuration pushed to the intended switch port of
+// a host interface. Status is unchanged, pending in a dry run, changed or
+// failed.
+type SwitchPortChange struct {
+	Host      string   `json:"host"`
+	Interface string   `json:"ifname"`
+	Switch    string   `json:"switch"`
+	Port      string   `json:"port"`
+	Changes   []string `json:"changes"`
+	Status    string   `json:"status"`
+	Error     string   `json:"error"`
+}
+
+// SwitchPortReport is the outcome of pushing the configuration of switch
+// ports. Failed are the switches that couldn't be queried or configured.
+type SwitchPortReport struct {
+	Ports  SwitchPortChangeList `json:"ports"`
+	DryRun bool                 `json:"dry_run"`
+	Failed []string             `json:"failed"`
+}
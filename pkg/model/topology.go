@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: (C) 2019 Grendel Authors
+//
+// SPDX-License-Identifier: GPL-3.0-or-later
+
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Kinds of topology graph nodes
+const (
+	TopologySwitch  = "switch"
+	TopologyHost    = "host"
+	TopologyUnknown = "unknown"
+)
+
+type TopologyLinkList []*TopologyLink
+
+// TopologyLink is a MAC address or LLDP neighbor seen on a switch port. Host
+// is the host with an interface with the MAC address, or named by the LLDP
+// neighbor. ExpectedSwitch and ExpectedPort are where the MAC address of a
+// host was first seen, Moved is set when it is now seen on another port.
+type TopologyLink struct {
+	Switch         string    `json:"switch"`
+	Port           string    `json:"port"`
+	MAC            string    `json:"mac"`
+	VLAN           string    `json:"vlan"`
+	Host           string    `json:"host"`
+	Neighbor       string    `json:"neighbor"`
+	NeighborPort   string    `json:"neighbor_port"`
+	ExpectedSwitch string    `json:"expected_switch"`
+	ExpectedPort   string    `json:"expected_port"`
+	Moved          bool      `json:"moved"`
+	LastSeen       time.Time `json:"last_seen"`
+}
+
+// Target returns the name of what the switch port is linked to: the host,
+// else the LLDP neighbor, else the MAC address
+func (l *TopologyLink) Target() string {
+	switch {
+	case l.Host != "":
+		return l.Host
+	case l.Neighbor != "":
+		return l.Neighbor
+	default:
+		return l.MAC
+	}
+}
+
+// TopologyNode is a switch, host or unknown device in the topology graph
+type TopologyNode struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+// TopologyGraph is the network topology built from the links seen on switch
+// ports
+type TopologyGraph struct {
+	Nodes []*TopologyNode  `json:"nodes"`
+	Links TopologyLinkList `json:"links"`
+}
+
+// NewTopologyGraph returns the graph of the given links with a node for every
+// switch and link target
+func NewTopologyGraph(links TopologyLinkList) *TopologyGraph {
+	kinds := make(map[string]string)
+	for _, l := range links {
+		kinds[l.Switch] = TopologySwitch
+	}
+
+	for _, l := range links {
+		target := l.Target()
+		if target == "" || kinds[target] != "" {
+			continue
+		}
+		if l.Host != "" {
+			kinds[target] = TopologyHost
+		} else {
+			kinds[target] = TopologyUnknown
+		}
+	}
+
+	g := &TopologyGraph{
+		Nodes: make([]*TopologyNode, 0, len(kinds)),
+		Links: links,
+	}
+	for name, kind := range kinds {
+		g.Nodes = append(g.Nodes, &TopologyNode{Name: name, Kind: kind})
+	}
+	slices.SortFunc(g.Nodes, func(a, b *TopologyNode) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return g
+}
+
+// DOT returns the graph in the Graphviz DOT language. Switches are boxes,
+// unknown devices dashed and links to moved MAC addresses red.
+func (g *TopologyGraph) DOT() string {
+	var b strings.Builder
+
+	b.WriteString("graph topology {\n")
+	for _, n := range g.Nodes {
+		switch n.Kind {
+		case TopologySwitch:
+			fmt.Fprintf(&b, "  %q [shape=box];\n", n.Name)
+		case TopologyUnknown:
+			fmt.Fprintf(&b, "  %q [style=dashed];\n", n.Name)
+		default:
+			fmt.Fprintf(&b, "  %q;\n", n.Name)
+		}
+	}
+
+	kinds := make(map[string]string, len(g.Nodes))
+	for _, n := range g.Nodes {
+		kinds[n.Name] = n.Kind
+	}
+
+	// Links between switches are seen from both ends
+	uplinks := make(map[string]bool)
+	for _, l := range g.Links {
+		target := l.Target()
+		if target == "" {
+			continue
+		}
+
+		if kinds[target] == TopologySwitch {
+			ends := []string{l.Switch + " " + l.Port, target + " " + l.NeighborPort}
+			slices.Sort(ends)
+			key := strings.Join(ends, " -- ")
+			if uplinks[key] {
+				continue
+			}
+			uplinks[key] = true
+		}
+
+		label := l.Port
+		if l.NeighborPort != "" {
+			label += " - " + l.NeighborPort
+		}
+		if l.Moved {
+			label += fmt.Sprintf(" (expected %s %s)", l.ExpectedSwitch, l.ExpectedPort)
+			fmt.Fprintf(&b, "  %q -- %q [label=%q, color=red];\n", l.Switch, target, label)
+			continue
+		}
+		fmt.Fprintf(&b, "  %q -- %q [label=%q];\n", l.Switch, target, label)
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// Cabling validation statuses
+const (
+	CablingOK         = "ok"
+	CablingMiswired   = "miswired"
+	CablingMissing    = "missing"
+	CablingUnexpected = "unexpected"
+)
+
+type CablingLinkList []*CablingLink
+
+// CablingLink compares where a host interface is intended to be cabled with
+// where it is seen. Switch and Port are the intended switch port, ActualSwitch
+// and ActualPort where the interface is seen. Unexpected links are seen on a
+// switch port no interface is intended to be cabled to.
+type CablingLink struct {
+	Host         string `json:"host"`
+	Interface    string `json:"ifname"`
+	MAC          string `json:"mac"`
+	Switch       string `json:"switch"`
+	Port         string `json:"port"`
+	ActualSwitch string `json:"actual_switch"`
+	ActualPort   string `json:"actual_port"`
+	Status       string `json:"status"`
+}
+
+// CablingReport is the outcome of validating the cabling of hosts. Failed are
+// the switches that couldn't be queried.
+type CablingReport struct {
+	Links  CablingLinkList `json:"links"`
+	Failed []string        `json:"failed"`
+}
+
+// Problems returns the links that are not cabled as intended
+func (r *CablingReport) Problems() CablingLinkList {
+	problems := make(CablingLinkList, 0)
+	for _, l := range r.Links {
+		if l.Status != CablingOK {
+			problems = append(problems, l)
+		}
+	}
+
+	return problems
+}
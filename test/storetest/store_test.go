@@ -11,6 +11,7 @@ import (
 	"net/netip"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/ubccr/grendel/internal/store"
@@ -215,6 +216,594 @@ func (s *StoreTestSuite) TestReverseResolveIPv4() {
 	}
 }
 
+func (s *StoreTestSuite) TestResolveIPv6() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Interfaces[0].FQDN = "test1.example.com"
+	host.Interfaces[0].IPv6 = netip.MustParsePrefix("2001:db8::17/64")
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testIPs, err := s.db.ResolveIPv6("test1.example.com")
+	if s.Assert().NoError(err) {
+		if s.Assert().Equal(1, len(testIPs)) {
+			s.Assert().Equal("2001:db8::17", testIPs[0].String())
+		}
+	}
+
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(host.Interfaces[0].IPv6, testHost.Interfaces[0].IPv6)
+	}
+
+	names, err := s.db.ReverseResolve("2001:0db8:0000:0000:0000:0000:0000:0017")
+	if s.Assert().NoError(err) {
+		if s.Assert().Equal(1, len(names)) {
+			s.Assert().Equal("test1.example.com", names[0])
+		}
+	}
+}
+
+func (s *StoreTestSuite) TestCabling() {
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.Interfaces[0].Switch = "swe-d13-01"
+	host.Interfaces[0].SwitchPort = "ethernet1/1/17"
+
+	err := s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("swe-d13-01", testHost.Interfaces[0].Switch)
+		s.Assert().Equal("ethernet1/1/17", testHost.Interfaces[0].SwitchPort)
+	}
+
+	host.Interfaces[0].Switch = ""
+	host.Interfaces[0].SwitchPort = ""
+	err = s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	testHost, err = s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Empty(testHost.Interfaces[0].Switch)
+		s.Assert().Empty(testHost.Interfaces[0].SwitchPort)
+	}
+}
+
+func (s *StoreTestSuite) TestDHCPLease() {
+	lease := &model.DHCPLease{
+		MAC:       "aa:bb:cc:dd:ee:01",
+		IP:        netip.MustParseAddr("10.1.0.100"),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	err := s.db.StoreDHCPLease(lease)
+	s.Assert().NoError(err)
+	s.Assert().NotZero(lease.ID)
+
+	testLease, err := s.db.LoadDHCPLease(lease.MAC)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(lease.IP, testLease.IP)
+		s.Assert().False(testLease.Expired(time.Now()))
+	}
+
+	// Storing the same address for a different MAC replaces the old lease
+	other := &model.DHCPLease{
+		MAC:       "aa:bb:cc:dd:ee:02",
+		IP:        lease.IP,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	err = s.db.StoreDHCPLease(other)
+	s.Assert().NoError(err)
+
+	_, err = s.db.LoadDHCPLease(lease.MAC)
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	leases, err := s.db.DHCPLeases()
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(1, len(leases))
+	}
+
+	err = s.db.DeleteDHCPLease(other.MAC)
+	s.Assert().NoError(err)
+
+	_, err = s.db.LoadDHCPLease(other.MAC)
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+}
+
+func (s *StoreTestSuite) TestDHCPEvent() {
+	now := time.Now()
+	for i, mt := range []string{"DISCOVER", "OFFER", "REQUEST", "ACK"} {
+		err := s.db.StoreDHCPEvent(&model.DHCPEvent{
+			MessageType: mt,
+			MAC:         "aa:bb:cc:dd:ee:01",
+			Host:        "tux01",
+			IP:          netip.MustParseAddr("10.1.0.10"),
+			RelayIP:     netip.MustParseAddr("10.1.0.254"),
+			Firmware:    "ipxe.efi",
+			Time:        now.Add(time.Duration(i-3) * time.Hour),
+		})
+		s.Assert().NoError(err)
+	}
+
+	err := s.db.StoreDHCPEvent(&model.DHCPEvent{MAC: "aa:bb:cc:dd:ee:01"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	events, err := s.db.DHCPEvents("tux01", now.Add(-90*time.Minute))
+	if s.Assert().NoError(err) && s.Assert().Equal(2, len(events)) {
+		s.Assert().Equal("REQUEST", events[0].MessageType)
+		s.Assert().Equal("ACK", events[1].MessageType)
+		s.Assert().Equal("10.1.0.254", events[1].RelayIP.String())
+		s.Assert().Equal("ipxe.efi", events[1].Firmware)
+	}
+
+	events, err = s.db.DHCPEvents("tux02", now.Add(-24*time.Hour))
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(0, len(events))
+	}
+
+	err = s.db.PruneDHCPEvents(now.Add(-90 * time.Minute))
+	s.Assert().NoError(err)
+
+	events, err = s.db.DHCPEvents("tux01", now.Add(-24*time.Hour))
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(2, len(events))
+	}
+}
+
+func (s *StoreTestSuite) TestProvisionEvent() {
+	for _, e := range []struct{ host, state string }{
+		{"tux01", model.ProvisionStateRequested},
+		{"tux01", model.ProvisionStateBooting},
+		{"tux01", model.ProvisionStateBooting},
+		{"tux01", model.ProvisionStateKernel},
+		{"tux02", model.ProvisionStateRequested},
+		{"tux01", model.ProvisionStateInstalling},
+		{"tux01", model.ProvisionStateFailed},
+	} {
+		err := s.db.StoreProvisionEvent(&model.ProvisionEvent{Host: e.host, State: e.state})
+		s.Assert().NoError(err)
+	}
+
+	err := s.db.StoreProvisionEvent(&model.ProvisionEvent{Host: "tux01"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	events, err := s.db.ProvisionEvents("tux01")
+	if s.Assert().NoError(err) && s.Assert().Equal(5, len(events)) {
+		s.Assert().Equal(model.ProvisionStateRequested, events[0].State)
+		s.Assert().Equal(model.ProvisionStateBooting, events[1].State)
+		s.Assert().Equal(model.ProvisionStateFailed, events[4].State)
+	}
+
+	states, err := s.db.ProvisionStates()
+	if s.Assert().NoError(err) && s.Assert().Equal(2, len(states)) {
+		s.Assert().Equal("tux01", states[0].Host)
+		s.Assert().Equal(model.ProvisionStateFailed, states[0].State)
+		s.Assert().Equal(model.ProvisionStateRequested, states[1].State)
+	}
+
+	err = s.db.StoreProvisionEvent(&model.ProvisionEvent{Host: "tux01", State: model.ProvisionStateRequested})
+	s.Assert().NoError(err)
+
+	events, err = s.db.ProvisionEvents("tux01")
+	if s.Assert().NoError(err) && s.Assert().Equal(1, len(events)) {
+		s.Assert().Equal(model.ProvisionStateRequested, events[0].State)
+	}
+}
+
+func (s *StoreTestSuite) TestProvisionLog() {
+	for _, l := range []*model.ProvisionLog{
+		{Host: "tux01", Kind: model.ProvisionLogProgress, Content: "partitioning disks"},
+		{Host: "tux01", Kind: model.ProvisionLogFile, Name: "anaconda.log", Content: "INFO anaconda: starting"},
+		{Host: "tux02", Kind: model.ProvisionLogProgress, Content: "installing packages", Time: time.Now().Add(-48 * time.Hour)},
+		{Host: "tux01", Kind: model.ProvisionLogFile, Name: "anaconda.log", Content: "INFO anaconda: done"},
+	} {
+		err := s.db.StoreProvisionLog(l)
+		s.Assert().NoError(err)
+	}
+
+	err := s.db.StoreProvisionLog(&model.ProvisionLog{Host: "tux01"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	logs, err := s.db.ProvisionLogs("tux01")
+	if s.Assert().NoError(err) && s.Assert().Equal(2, len(logs)) {
+		s.Assert().Equal("partitioning disks", logs[0].Content)
+		s.Assert().Equal("anaconda.log", logs[1].Name)
+		s.Assert().Equal(model.ProvisionLogFile, logs[1].Kind)
+		s.Assert().Equal("INFO anaconda: done", logs[1].Content)
+	}
+
+	size, err := s.db.ProvisionLogSize("tux01")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(int64(len("partitioning disks")+len("INFO anaconda: done")), size)
+	}
+
+	err = s.db.DeleteProvisionLogs("tux01")
+	s.Assert().NoError(err)
+
+	logs, err = s.db.ProvisionLogs("tux01")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(0, len(logs))
+	}
+
+	logs, err = s.db.ProvisionLogs("tux02")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(1, len(logs))
+	}
+
+	n, err := s.db.PruneProvisionLogs(time.Now().Add(-24 * time.Hour))
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(int64(1), n)
+	}
+}
+
+func (s *StoreTestSuite) TestEvents() {
+	now := time.Now()
+	for i, user := range []string{"admin", "admin", "alice", "bob"} {
+		err := s.db.StoreEvent(&model.Event{
+			Severity: model.SeveritySuccess.String(),
+			User:     user,
+			Message:  fmt.Sprintf("event %d", i),
+			Time:     now.Add(time.Duration(i-3) * time.Hour),
+			Hosts:    []string{fmt.Sprintf("tux%02d", i)},
+			JobMessages: model.JobMessageList{
+				{Status: "success", Host: fmt.Sprintf("tux%02d", i), Msg: "Sent power command"},
+			},
+		})
+		s.Assert().NoError(err)
+	}
+
+	err := s.db.StoreEvent(&model.Event{User: "admin"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	events, err := s.db.Events(model.EventFilter{})
+	if s.Assert().NoError(err) && s.Assert().Equal(4, len(events)) {
+		s.Assert().Equal("event 3", events[0].Message)
+		s.Assert().Equal("bob", events[0].User)
+		s.Assert().Equal([]string{"tux03"}, events[0].Hosts)
+		s.Assert().Equal("Sent power command", events[0].JobMessages[0].Msg)
+	}
+
+	events, err = s.db.Events(model.EventFilter{User: "admin"})
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(2, len(events))
+	}
+
+	events, err = s.db.Events(model.EventFilter{Host: "tux02"})
+	if s.Assert().NoError(err) && s.Assert().Equal(1, len(events)) {
+		s.Assert().Equal("alice", events[0].User)
+	}
+
+	events, err = s.db.Events(model.EventFilter{Since: now.Add(-150 * time.Minute), Until: now.Add(-30 * time.Minute)})
+	if s.Assert().NoError(err) && s.Assert().Equal(2, len(events)) {
+		s.Assert().Equal("event 2", events[0].Message)
+		s.Assert().Equal("event 1", events[1].Message)
+	}
+
+	events, err = s.db.Events(model.EventFilter{Limit: 2, Offset: 1})
+	if s.Assert().NoError(err) && s.Assert().Equal(2, len(events)) {
+		s.Assert().Equal("event 2", events[0].Message)
+		s.Assert().Equal("event 1", events[1].Message)
+	}
+
+	events, err = s.db.Events(model.EventFilter{Severity: model.SeverityError.String()})
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(0, len(events))
+	}
+
+	n, err := s.db.PruneEvents(now.Add(-90 * time.Minute))
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(int64(2), n)
+	}
+
+	events, err = s.db.Events(model.EventFilter{Host: "tux00"})
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(0, len(events))
+	}
+
+	// The hosts of more events than fit in one query are fetched in batches
+	for i := 0; i < 1100; i++ {
+		err := s.db.StoreEvent(&model.Event{
+			Severity: model.SeveritySuccess.String(),
+			User:     "admin",
+			Message:  fmt.Sprintf("batch %d", i),
+			Time:     now,
+			Hosts:    []string{"tux99"},
+		})
+		s.Assert().NoError(err)
+	}
+
+	events, err = s.db.Events(model.EventFilter{Host: "tux99"})
+	if s.Assert().NoError(err) && s.Assert().Equal(1100, len(events)) {
+		s.Assert().Equal([]string{"tux99"}, events[1099].Hosts)
+	}
+}
+
+func (s *StoreTestSuite) TestBmcTasks() {
+	err := s.db.StoreBmcTask(&model.BmcTask{Operation: "power_os"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	for _, id := range []string{"task1", "task2"} {
+		err := s.db.StoreBmcTask(&model.BmcTask{
+			ID:        id,
+			Operation: "power_os",
+			User:      "admin",
+			Owner:     "grendel-a",
+			Hosts:     model.JobMessageList{{Host: "tux01"}, {Host: "tux02"}},
+		})
+		s.Assert().NoError(err)
+	}
+
+	_, err = s.db.LoadBmcTask("missing")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	task, err := s.db.LoadBmcTask("task1")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(model.BmcTaskQueued, task.Status)
+		s.Assert().Equal("admin", task.User)
+		s.Assert().False(task.Done())
+		if s.Assert().Equal(2, len(task.Hosts)) {
+			s.Assert().Equal(model.BmcTaskHostPending, task.Hosts[0].Status)
+		}
+	}
+
+	s.Assert().NoError(s.db.UpdateBmcTask("task1", model.BmcTaskRunning, ""))
+	s.Assert().NoError(s.db.StoreBmcTaskResult("task1", model.JobMessage{Status: "success", Host: "tux01", Msg: "Sent power command"}))
+
+	task, err = s.db.LoadBmcTask("task1")
+	if s.Assert().NoError(err) && s.Assert().Equal(2, len(task.Hosts)) {
+		s.Assert().Equal(model.BmcTaskRunning, task.Status)
+		s.Assert().Equal("success", task.Hosts[0].Status)
+		s.Assert().Equal("Sent power command", task.Hosts[0].Msg)
+		s.Assert().Equal(model.BmcTaskHostPending, task.Hosts[1].Status)
+	}
+
+	s.Assert().NoError(s.db.UpdateBmcTask("task2", model.BmcTaskComplete, ""))
+
+	tasks, err := s.db.BmcTasks("", 0)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(2, len(tasks))
+	}
+
+	tasks, err = s.db.BmcTasks(model.BmcTaskRunning, 10)
+	if s.Assert().NoError(err) && s.Assert().Equal(1, len(tasks)) {
+		s.Assert().Equal("task1", tasks[0].ID)
+	}
+
+	// Unfinished tasks of another owner are left alone
+	n, err := s.db.InterruptBmcTasks("grendel-b")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(int64(0), n)
+	}
+
+	n, err = s.db.InterruptBmcTasks("grendel-a")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(int64(1), n)
+	}
+
+	task, err = s.db.LoadBmcTask("task1")
+	if s.Assert().NoError(err) && s.Assert().Equal(2, len(task.Hosts)) {
+		s.Assert().Equal(model.BmcTaskFailed, task.Status)
+		s.Assert().True(task.Done())
+		s.Assert().Equal("success", task.Hosts[0].Status)
+		s.Assert().Equal(model.BmcTaskHostCanceled, task.Hosts[1].Status)
+	}
+
+	n, err = s.db.PruneBmcTasks(time.Now().Add(time.Minute))
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(int64(2), n)
+	}
+}
+
+func (s *StoreTestSuite) TestBmcCredentials() {
+	err := s.db.StoreBmcCredential(&model.BmcCredential{Scope: "vendor", Name: "dell", Username: "root", Password: "x"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	err = s.db.StoreBmcCredential(&model.BmcCredential{Scope: model.BmcCredentialTag, Name: "dell", Username: "root"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	s.Assert().NoError(s.db.StoreBmcCredential(&model.BmcCredential{Scope: model.BmcCredentialTag, Name: "dell", Username: "root", Password: "enc1"}))
+	s.Assert().NoError(s.db.StoreBmcCredential(&model.BmcCredential{Scope: model.BmcCredentialHost, Name: "tux01", Username: "admin", Password: "enc2"}))
+
+	// Storing again replaces the credential
+	s.Assert().NoError(s.db.StoreBmcCredential(&model.BmcCredential{Scope: model.BmcCredentialHost, Name: "tux01", Username: "admin", Password: "enc3"}))
+
+	cred, err := s.db.LoadBmcCredential(model.BmcCredentialHost, "tux01")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("admin", cred.Username)
+		s.Assert().Equal("enc3", cred.Password)
+	}
+
+	_, err = s.db.LoadBmcCredential(model.BmcCredentialHost, "tux02")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	creds, err := s.db.BmcCredentials()
+	if s.Assert().NoError(err) && s.Assert().Equal(2, len(creds)) {
+		s.Assert().Equal(model.BmcCredentialHost, creds[0].Scope)
+		s.Assert().Equal("dell", creds[1].Name)
+	}
+
+	s.Assert().NoError(s.db.DeleteBmcCredential(model.BmcCredentialTag, "dell"))
+	s.Assert().ErrorIs(s.db.DeleteBmcCredential(model.BmcCredentialTag, "dell"), store.ErrNotFound)
+}
+
+func (s *StoreTestSuite) TestBmcMedia() {
+	err := s.db.StoreBmcMedia(&model.BmcMedia{Image: "rescue.iso"})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	_, err = s.db.LoadBmcMedia("tux01")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	s.Assert().NoError(s.db.StoreBmcMedia(&model.BmcMedia{Host: "tux01", Image: "rescue.iso"}))
+	s.Assert().NoError(s.db.StoreBmcMedia(&model.BmcMedia{Host: "tux01", Image: "install.iso"}))
+
+	media, err := s.db.LoadBmcMedia("tux01")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("install.iso", media.Image)
+		s.Assert().False(media.InsertedAt.IsZero())
+	}
+
+	s.Assert().NoError(s.db.DeleteBmcMedia("tux01"))
+	s.Assert().ErrorIs(s.db.DeleteBmcMedia("tux01"), store.ErrNotFound)
+}
+
+func (s *StoreTestSuite) TestInventory() {
+	_, err := s.db.StoreInventory("", nil)
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	start := time.Now().Add(-time.Second)
+	first := model.InventoryComponentList{
+		{Kind: model.InventoryDIMM, Slot: "A1", Serial: "111", Capacity: 32 << 30},
+		{Kind: model.InventoryFirmware, Slot: "BIOS", Name: "BIOS", Version: "2.9.1"},
+	}
+	changes, err := s.db.StoreInventory("tux01", first)
+	if s.Assert().NoError(err) {
+		s.Assert().Empty(changes)
+	}
+
+	s.Assert().NoError(func() error {
+		_, err := s.db.StoreInventory("tux02", model.InventoryComponentList{
+			{Kind: model.InventoryFirmware, Slot: "BIOS", Name: "BIOS", Version: "2.10.0"},
+		})
+		return err
+	}())
+
+	second := model.InventoryComponentList{
+		{Kind: model.InventoryDIMM, Slot: "A1", Serial: "222", Capacity: 32 << 30},
+		{Kind: model.InventoryFirmware, Slot: "BIOS", Name: "BIOS", Version: "2.10.0"},
+	}
+	changes, err = s.db.StoreInventory("tux01", second)
+	if s.Assert().NoError(err) {
+		s.Assert().Len(changes, 2)
+	}
+
+	inv, err := s.db.Inventory("tux01", "")
+	if s.Assert().NoError(err) && s.Assert().Len(inv, 2) {
+		s.Assert().Equal(model.InventoryDIMM, inv[0].Kind)
+		s.Assert().Equal("222", inv[0].Serial)
+		s.Assert().Equal(int64(32<<30), inv[0].Capacity)
+	}
+
+	inv, err = s.db.Inventory("", model.InventoryFirmware)
+	if s.Assert().NoError(err) && s.Assert().Len(inv, 2) {
+		s.Assert().Equal("tux01", inv[0].Host)
+		s.Assert().Equal("tux02", inv[1].Host)
+	}
+
+	history, err := s.db.InventoryChanges("tux01", start)
+	if s.Assert().NoError(err) && s.Assert().Len(history, 2) {
+		s.Assert().Equal(model.InventoryReplaced, history[0].Change)
+		s.Assert().Equal("111", history[0].Old)
+		s.Assert().Equal("222", history[0].New)
+		s.Assert().Equal(model.InventoryUpdated, history[1].Change)
+	}
+
+	history, err = s.db.InventoryChanges("tux02", start)
+	if s.Assert().NoError(err) {
+		s.Assert().Empty(history)
+	}
+}
+
+func (s *StoreTestSuite) TestTopology() {
+	s.Assert().ErrorIs(s.db.StoreTopology("", nil), store.ErrInvalidData)
+
+	err := s.db.StoreTopology("swe-01", model.TopologyLinkList{
+		{Port: "ethernet1/1/1", MAC: "aa:bb:cc:00:00:01", VLAN: "1001", Host: "tux01"},
+		{Port: "ethernet1/1/2", MAC: "aa:bb:cc:00:00:02", VLAN: "1001"},
+		{Port: "ethernet1/1/54", Host: "swe-02", Neighbor: "swe-02", NeighborPort: "ethernet1/1/54"},
+	})
+	s.Assert().NoError(err)
+
+	links, err := s.db.Topology("swe-01")
+	if s.Assert().NoError(err) && s.Assert().Len(links, 3) {
+		s.Assert().Equal("tux01", links[0].Host)
+		s.Assert().Equal("swe-01", links[0].ExpectedSwitch)
+		s.Assert().Equal("ethernet1/1/1", links[0].ExpectedPort)
+		s.Assert().False(links[0].Moved)
+		s.Assert().Empty(links[1].ExpectedSwitch)
+		s.Assert().Equal("ethernet1/1/54", links[2].NeighborPort)
+	}
+
+	// tux01 moves to another port
+	err = s.db.StoreTopology("swe-01", model.TopologyLinkList{
+		{Port: "ethernet1/1/3", MAC: "aa:bb:cc:00:00:01", VLAN: "1001", Host: "tux01"},
+	})
+	s.Assert().NoError(err)
+
+	links, err = s.db.Topology("")
+	if s.Assert().NoError(err) && s.Assert().Len(links, 1) {
+		s.Assert().Equal("ethernet1/1/3", links[0].Port)
+		s.Assert().Equal("ethernet1/1/1", links[0].ExpectedPort)
+		s.Assert().True(links[0].Moved)
+	}
+
+	n, err := s.db.AcceptTopology([]string{"tux01"})
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(1, n)
+	}
+
+	links, err = s.db.Topology("swe-01")
+	if s.Assert().NoError(err) && s.Assert().Len(links, 1) {
+		s.Assert().Equal("ethernet1/1/3", links[0].ExpectedPort)
+		s.Assert().False(links[0].Moved)
+	}
+
+	n, err = s.db.AcceptTopology(nil)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(1, n)
+	}
+}
+
+func (s *StoreTestSuite) TestLeaderLease() {
+	_, err := s.db.LoadLeaderLease("grendel")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	lease, err := s.db.AcquireLeaderLease("grendel", "node-a", time.Minute)
+	if s.Assert().NoError(err) {
+		s.Assert().True(lease.HeldBy("node-a", time.Now()))
+	}
+	acquired := lease.AcquiredAt
+
+	// Another holder can't take an active lease
+	lease, err = s.db.AcquireLeaderLease("grendel", "node-b", time.Minute)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("node-a", lease.Holder)
+		s.Assert().False(lease.HeldBy("node-b", time.Now()))
+	}
+
+	// Renewing keeps the time the lease was first acquired
+	lease, err = s.db.AcquireLeaderLease("grendel", "node-a", -time.Second)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("node-a", lease.Holder)
+		s.Assert().True(acquired.Equal(lease.AcquiredAt))
+		s.Assert().True(lease.Expired(time.Now()))
+	}
+
+	// Expired leases can be taken over
+	lease, err = s.db.AcquireLeaderLease("grendel", "node-b", time.Minute)
+	if s.Assert().NoError(err) {
+		s.Assert().True(lease.HeldBy("node-b", time.Now()))
+	}
+
+	_, err = s.db.AcquireLeaderLease("grendel", "", time.Minute)
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	// Only the holder can release the lease
+	err = s.db.ReleaseLeaderLease("grendel", "node-a")
+	s.Assert().NoError(err)
+
+	lease, err = s.db.LoadLeaderLease("grendel")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("node-b", lease.Holder)
+	}
+
+	err = s.db.ReleaseLeaderLease("grendel", "node-b")
+	s.Assert().NoError(err)
+
+	_, err = s.db.LoadLeaderLease("grendel")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+}
+
 func (s *StoreTestSuite) TestIfname() {
 	host := tests.HostFactory.MustCreate().(*model.Host)
 
@@ -522,6 +1111,56 @@ func (s *StoreTestSuite) TestBootImage() {
 	}
 }
 
+func (s *StoreTestSuite) TestArtifact() {
+	kernelSum := strings.Repeat("a", 64)
+	initrdSum := strings.Repeat("b", 64)
+	orphanSum := strings.Repeat("c", 64)
+	for _, sum := range []string{kernelSum, initrdSum, orphanSum} {
+		err := s.db.StoreArtifact(&model.Artifact{SHA256: sum, Size: 1024, Time: time.Now().Add(-2 * time.Hour)})
+		s.Assert().NoError(err)
+	}
+
+	err := s.db.StoreArtifact(&model.Artifact{})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	image.LiveImage = "/var/lib/grendel/liveimg.squashfs"
+	image.KernelSHA256 = kernelSum
+	image.InitrdPaths = []string{"/var/lib/grendel/initrd.img"}
+	image.InitrdSHA256 = []string{initrdSum}
+	err = s.db.StoreBootImage(image)
+	s.Assert().NoError(err)
+
+	testImage, err := s.db.LoadBootImage(image.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(image.LiveImage, testImage.LiveImage)
+		s.Assert().Equal(kernelSum, testImage.KernelSHA256)
+		s.Assert().Equal([]string{initrdSum}, testImage.InitrdSHA256)
+	}
+
+	image.InitrdSHA256 = []string{initrdSum, orphanSum}
+	err = s.db.StoreBootImage(image)
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	unreferenced, err := s.db.UnreferencedArtifacts(time.Now())
+	if s.Assert().NoError(err) && s.Assert().Equal(1, len(unreferenced)) {
+		s.Assert().Equal(orphanSum, unreferenced[0].SHA256)
+	}
+
+	unreferenced, err = s.db.UnreferencedArtifacts(time.Now().Add(-3 * time.Hour))
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(0, len(unreferenced))
+	}
+
+	err = s.db.DeleteArtifact(orphanSum)
+	s.Assert().NoError(err)
+
+	artifacts, err := s.db.Artifacts()
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(2, len(artifacts))
+	}
+}
+
 func (s *StoreTestSuite) TestBootImageDelete() {
 	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
 
@@ -588,6 +1227,123 @@ func (s *StoreTestSuite) TestBootImageUpdate() {
 	}
 }
 
+func (s *StoreTestSuite) TestBootImageVersion() {
+	image := tests.BootImageFactory.MustCreate().(*model.BootImage)
+	image.Name = "rocky9"
+	image.KernelPath = "/var/lib/grendel/vmlinuz-1"
+	err := s.db.StoreBootImage(image)
+	s.Assert().NoError(err)
+	s.Assert().Equal(1, image.Version)
+
+	// storing an image with no changes keeps the version
+	err = s.db.StoreBootImage(image)
+	s.Assert().NoError(err)
+	s.Assert().Equal(1, image.Version)
+
+	image.KernelPath = "/var/lib/grendel/vmlinuz-2"
+	err = s.db.StoreBootImage(image)
+	s.Assert().NoError(err)
+	s.Assert().Equal(2, image.Version)
+
+	versions, err := s.db.BootImageVersions(image.Name)
+	if s.Assert().NoError(err) && s.Assert().Equal(2, len(versions)) {
+		s.Assert().False(versions[0].Current)
+		s.Assert().Equal("/var/lib/grendel/vmlinuz-1", versions[0].Image.KernelPath)
+		s.Assert().True(versions[1].Current)
+	}
+
+	testImage, err := s.db.LoadBootImage("rocky9@1")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("rocky9", testImage.Name)
+		s.Assert().Equal(1, testImage.Version)
+		s.Assert().Equal("/var/lib/grendel/vmlinuz-1", testImage.KernelPath)
+	}
+
+	_, err = s.db.LoadBootImage("rocky9@3")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	_, err = s.db.LoadBootImage("rocky9@x")
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	host := tests.HostFactory.MustCreate().(*model.Host)
+	host.BootImage = "rocky9@1"
+	err = s.db.StoreHost(host)
+	s.Assert().NoError(err)
+
+	ns, err := nodeset.NewNodeSet(host.Name)
+	s.Assert().NoError(err)
+
+	testHost, err := s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("rocky9@1", testHost.BootImage)
+	}
+
+	err = s.db.SetBootImage(ns, "rocky9@3")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	err = s.db.SetBootImage(ns, "rocky9")
+	s.Assert().NoError(err)
+
+	testHost, err = s.db.LoadHostFromName(host.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal("rocky9", testHost.BootImage)
+	}
+
+	// restoring an old version makes it current without a new version
+	err = s.db.SetBootImageVersion(image.Name, 1)
+	s.Assert().NoError(err)
+
+	testImage, err = s.db.LoadBootImage(image.Name)
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(1, testImage.Version)
+		s.Assert().Equal("/var/lib/grendel/vmlinuz-1", testImage.KernelPath)
+	}
+
+	versions, err = s.db.BootImageVersions(image.Name)
+	if s.Assert().NoError(err) && s.Assert().Equal(2, len(versions)) {
+		s.Assert().True(versions[0].Current)
+	}
+}
+
+func (s *StoreTestSuite) TestRollout() {
+	_, err := s.db.LoadRollout("centos9")
+	s.Assert().ErrorIs(err, store.ErrNotFound)
+
+	err = s.db.StoreRollout(&model.Rollout{})
+	s.Assert().ErrorIs(err, store.ErrInvalidData)
+
+	rollout := &model.Rollout{
+		Image:       "centos9",
+		Version:     2,
+		FromVersion: 1,
+		Nodes:       "cpn-[01-10]",
+		Canary:      "cpn-01",
+		Percent:     10,
+		State:       model.RolloutRunning,
+		Previous:    map[string]string{"cpn-01": "centos9@1"},
+	}
+	err = s.db.StoreRollout(rollout)
+	s.Assert().NoError(err)
+	s.Assert().NotEqual(int64(0), rollout.ID)
+
+	err = s.db.SetRolloutState(rollout.ID, model.RolloutPromoted)
+	s.Assert().NoError(err)
+
+	testRollout, err := s.db.LoadRollout("centos9")
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(rollout.ID, testRollout.ID)
+		s.Assert().Equal(model.RolloutPromoted, testRollout.State)
+		s.Assert().Equal("cpn-01", testRollout.Canary)
+		s.Assert().Equal(2, testRollout.Version)
+		s.Assert().Equal("centos9@1", testRollout.Previous["cpn-01"])
+	}
+
+	rollouts, err := s.db.Rollouts()
+	if s.Assert().NoError(err) {
+		s.Assert().Equal(1, len(rollouts))
+	}
+}
+
 func (s *StoreTestSuite) TestHostUpdate() {
 	host := tests.HostFactory.MustCreate().(*model.Host)
 